@@ -35,6 +35,14 @@ func main() {
 	ast := cmd.NewGenerateAstCommand()
 	root.AddCommand(ast)
 
+	// Add fmt command
+	format := cmd.NewFormatCommand()
+	root.AddCommand(format)
+
+	// Add lint command
+	lint := cmd.NewLintCommand()
+	root.AddCommand(lint)
+
 	// Add migrate command
 	migrate := cmd.NewMigrateCommand()
 	root.AddCommand(migrate)
@@ -51,6 +59,26 @@ func main() {
 	repair := cmd.NewRepairCommand()
 	root.AddCommand(repair)
 
+	// Add decisions command
+	decisions := cmd.NewDecisionsCommand()
+	root.AddCommand(decisions)
+
+	// Add report command
+	report := cmd.NewReportCommand()
+	root.AddCommand(report)
+
+	// Add import command
+	importData := cmd.NewImportCommand()
+	root.AddCommand(importData)
+
+	// Add history command
+	history := cmd.NewHistoryCommand()
+	root.AddCommand(history)
+
+	// Add data command
+	data := cmd.NewDataCommand()
+	root.AddCommand(data)
+
 	if err := root.Execute(); err != nil {
 		os.Exit(1)
 	}
@@ -0,0 +1,297 @@
+package analyzer
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"slices"
+
+	"github.com/Permify/permify/internal/schema"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+const (
+	// DefaultPageSize - Number of tuples read in a batch when the request sets none
+	DefaultPageSize = 1000
+	// DefaultTop - Number of entities and subjects listed by fan-out and by fan-in when the request sets none
+	DefaultTop = 10
+	// MaxCycles - Number of cycles of a relation listed in the analysis, the rest are only counted
+	MaxCycles = 100
+)
+
+// Analyzer - Scans the tuples of a tenant at a single snapshot and follows the tuples of the recursive relations of the
+// schema to find their cycles and longest chains. The tuples of the recursive relations are held in memory for the
+// duration of the analysis, the hotspots are grouped and counted by the storage.
+type Analyzer struct {
+	schemaReader storage.SchemaReader
+	dataReader   storage.DataReader
+
+	tenantID string
+	version  string
+	snap     string
+	pageSize uint32
+	top      int
+}
+
+// relationKey - Entity type and name of a recursive relation
+type relationKey struct {
+	entityType string
+	relation   string
+}
+
+// step - Entity on the path of the search and the index of the next of its subjects to follow
+type step struct {
+	id   string
+	next int
+}
+
+// recursiveRelation - Tuples of a recursive relation, as the ids of the subjects of every entity, and their analysis.
+// A relation walked by a permission follows the tuples whose subject is an entity of its own type, a relation whose
+// subjects include its own userset follows the tuples whose subject is that userset.
+type recursiveRelation struct {
+	walked  bool
+	userset bool
+	edges   map[string][]string
+	result  *base.DataRecursiveRelation
+}
+
+// NewAnalyzer - Starts an analysis of the tuples of a tenant. The tuples are read at the snap token of the metadata and
+// the recursive relations are read from its schema version, or at the head snapshot and version when they are empty.
+func NewAnalyzer(ctx context.Context, schemaReader storage.SchemaReader, dataReader storage.DataReader, request *base.DataAnalyzeRequest) (*Analyzer, error) {
+	version := request.GetMetadata().GetSchemaVersion()
+	if version == "" {
+		v, err := schemaReader.HeadVersion(ctx, request.GetTenantId())
+		if err != nil {
+			return nil, err
+		}
+		version = v
+	}
+
+	snap := request.GetMetadata().GetSnapToken()
+	if snap == "" {
+		st, err := dataReader.HeadSnapshot(ctx, request.GetTenantId())
+		if err != nil {
+			return nil, err
+		}
+		snap = st.Encode().String()
+	}
+
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+
+	top := int(request.GetTop())
+	if top == 0 {
+		top = DefaultTop
+	}
+
+	return &Analyzer{
+		schemaReader: schemaReader,
+		dataReader:   dataReader,
+		tenantID:     request.GetTenantId(),
+		version:      version,
+		snap:         snap,
+		pageSize:     pageSize,
+		top:          top,
+	}, nil
+}
+
+// Run - Analyzes the tuples of the tenant
+func (a *Analyzer) Run(ctx context.Context) (*base.DataAnalyzeResponse, error) {
+	sch, err := a.schemaReader.ReadSchema(ctx, a.tenantID, a.version)
+	if err != nil {
+		return nil, err
+	}
+	keys, recursive := recursiveRelations(sch)
+
+	response := &base.DataAnalyzeResponse{
+		SchemaVersion: a.version,
+		SnapToken:     a.snap,
+	}
+
+	ct := ""
+	for {
+		collection, next, err := a.dataReader.ReadRelationships(ctx, a.tenantID, &base.TupleFilter{}, a.snap, database.NewPagination(database.Size(a.pageSize), database.Token(ct)))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tup := range collection.GetTuples() {
+			response.Relationships++
+
+			r, ok := recursive[relationKey{entityType: tup.GetEntity().GetType(), relation: tup.GetRelation()}]
+			if !ok || !r.follows(tup) {
+				continue
+			}
+			r.edges[tup.GetEntity().GetId()] = append(r.edges[tup.GetEntity().GetId()], tup.GetSubject().GetId())
+			r.result.Tuples++
+		}
+
+		ct = next.String()
+		if ct == "" {
+			break
+		}
+	}
+
+	for _, key := range keys {
+		r := recursive[key]
+		r.walk()
+		response.RecursiveRelations = append(response.RecursiveRelations, r.result)
+	}
+
+	response.FanOut, err = a.dataReader.QueryRelationshipHotspots(ctx, a.tenantID, storage.FanOut, a.snap, uint32(a.top))
+	if err != nil {
+		return nil, err
+	}
+	response.FanIn, err = a.dataReader.QueryRelationshipHotspots(ctx, a.tenantID, storage.FanIn, a.snap, uint32(a.top))
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "data analyzed",
+		slog.String("tenant_id", a.tenantID),
+		slog.String("schema_version", a.version),
+		slog.Uint64("relationships", response.GetRelationships()),
+		slog.Int("recursive_relations", len(response.GetRecursiveRelations())))
+
+	return response, nil
+}
+
+// recursiveRelations returns the relations through which a permission of the schema refers to itself and the relations
+// whose subjects include their own userset, in the order of their entity types and names
+func recursiveRelations(sch *base.SchemaDefinition) ([]relationKey, map[relationKey]*recursiveRelation) {
+	graph := schema.NewLinkedGraph(sch)
+
+	var keys []relationKey
+	relations := map[relationKey]*recursiveRelation{}
+	get := func(entityType, relation string) *recursiveRelation {
+		key := relationKey{entityType: entityType, relation: relation}
+		r, ok := relations[key]
+		if !ok {
+			r = &recursiveRelation{
+				edges:  map[string][]string{},
+				result: &base.DataRecursiveRelation{EntityType: entityType, Relation: relation},
+			}
+			relations[key] = r
+			keys = append(keys, key)
+		}
+		return r
+	}
+
+	for _, entityType := range sortedKeys(sch.GetEntityDefinitions()) {
+		definition := sch.GetEntityDefinitions()[entityType]
+		for _, permission := range sortedKeys(definition.GetPermissions()) {
+			for _, relation := range graph.SelfCycleRelationsForPermission(entityType, permission) {
+				r := get(entityType, relation)
+				r.walked = true
+				r.result.Permissions = append(r.result.Permissions, permission)
+			}
+		}
+		for _, relation := range sortedKeys(definition.GetRelations()) {
+			for _, reference := range definition.GetRelations()[relation].GetRelationReferences() {
+				if reference.GetType() == entityType && reference.GetRelation() == relation {
+					get(entityType, relation).userset = true
+				}
+			}
+		}
+	}
+
+	slices.SortFunc(keys, func(a, b relationKey) int {
+		return cmp.Or(cmp.Compare(a.entityType, b.entityType), cmp.Compare(a.relation, b.relation))
+	})
+	return keys, relations
+}
+
+// follows reports whether a tuple of the relation is an edge of the search: a tuple whose subject is an entity of the
+// same type when a permission walks the relation, or a tuple whose subject is the userset of the relation itself
+func (r *recursiveRelation) follows(tup *base.Tuple) bool {
+	if tup.GetSubject().GetType() != tup.GetEntity().GetType() {
+		return false
+	}
+	switch tup.GetSubject().GetRelation() {
+	case "":
+		return r.walked
+	case tup.GetRelation():
+		return r.userset
+	default:
+		return false
+	}
+}
+
+// walk follows the tuples of the relation from every entity in a depth first search. A tuple leading back to an entity
+// on the path closes a cycle, the longest chain is measured without the tuples closing cycles. The search keeps its own
+// stack rather than recursing, since chains may be millions of entities long.
+func (r *recursiveRelation) walk() {
+	const (
+		unvisited = iota
+		onPath
+		done
+	)
+	state := map[string]int{}
+	// depth is the number of tuples of the longest chain starting from an entity
+	depth := map[string]uint32{}
+
+	for _, root := range sortedKeys(r.edges) {
+		if state[root] != unvisited {
+			continue
+		}
+		state[root] = onPath
+		path := []step{{id: root}}
+
+		for len(path) > 0 {
+			top := &path[len(path)-1]
+			if top.next < len(r.edges[top.id]) {
+				subject := r.edges[top.id][top.next]
+				top.next++
+				switch state[subject] {
+				case unvisited:
+					state[subject] = onPath
+					path = append(path, step{id: subject})
+				case onPath:
+					r.cycle(path, subject)
+				case done:
+					depth[top.id] = max(depth[top.id], depth[subject]+1)
+				}
+				continue
+			}
+
+			state[top.id] = done
+			if depth[top.id] > r.result.MaxDepth {
+				r.result.MaxDepth = depth[top.id]
+				r.result.DeepestEntityId = top.id
+			}
+			path = path[:len(path)-1]
+			if len(path) > 0 {
+				parent := path[len(path)-1].id
+				depth[parent] = max(depth[parent], depth[top.id]+1)
+			}
+		}
+	}
+}
+
+// cycle records the cycle closed by a tuple from the last entity of the path back to an entity on it
+func (r *recursiveRelation) cycle(path []step, entityID string) {
+	r.result.CycleCount++
+	if len(r.result.Cycles) >= MaxCycles {
+		return
+	}
+	start := slices.IndexFunc(path, func(s step) bool { return s.id == entityID })
+	cycle := &base.DataCycle{EntityIds: make([]string, 0, len(path)-start)}
+	for _, s := range path[start:] {
+		cycle.EntityIds = append(cycle.EntityIds, s.id)
+	}
+	r.result.Cycles = append(r.result.Cycles, cycle)
+}
+
+// sortedKeys returns the keys of a map in order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,187 @@
+package analyzer_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/internal/analyzer"
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/database"
+	"github.com/Permify/permify/pkg/dsl/compiler"
+	"github.com/Permify/permify/pkg/dsl/parser"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+func TestAnalyzer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "analyzer suite")
+}
+
+var _ = Describe("analyzer", func() {
+	var db database.Database
+
+	write := func(relationships ...string) {
+		var tuples []*base.Tuple
+		for _, relationship := range relationships {
+			t, err := tuple.Tuple(relationship)
+			Expect(err).ShouldNot(HaveOccurred())
+			tuples = append(tuples, t)
+		}
+		_, err := factories.DataWriterFactory(db).Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection())
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+
+	analyze := func(request *base.DataAnalyzeRequest) *base.DataAnalyzeResponse {
+		ctx := context.Background()
+		an, err := analyzer.NewAnalyzer(ctx, factories.SchemaReaderFactory(db), factories.DataReaderFactory(db), request)
+		Expect(err).ShouldNot(HaveOccurred())
+		response, err := an.Run(ctx)
+		Expect(err).ShouldNot(HaveOccurred())
+		return response
+	}
+
+	BeforeEach(func() {
+		var err error
+		db, err = factories.DatabaseFactory(config.Database{Engine: "memory"})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		sch, err := parser.NewParser(`
+		entity user {}
+
+		entity organization {
+			relation member @user
+		}
+
+		entity group {
+			relation member @user @group#member
+		}
+
+		entity folder {
+			relation parent @folder
+			relation org @organization
+			relation viewer @user
+
+			permission view = viewer or parent.view
+			permission edit = parent.edit or org.member
+		}
+		`).Parse()
+		Expect(err).ShouldNot(HaveOccurred())
+		_, _, err = compiler.NewCompiler(true, sch).Compile()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var definitions []storage.SchemaDefinition
+		for _, st := range sch.Statements {
+			definitions = append(definitions, storage.SchemaDefinition{
+				TenantID:             "t1",
+				Version:              "v1",
+				Name:                 st.GetName(),
+				SerializedDefinition: []byte(st.String()),
+			})
+		}
+		Expect(factories.SchemaWriterFactory(db).WriteSchema(context.Background(), definitions)).Should(Succeed())
+	})
+
+	Context("Analyze", func() {
+		It("Reports cycles, depth and hotspots", func() {
+			write(
+				"folder:1#parent@folder:2",
+				"folder:2#parent@folder:3",
+				"folder:3#parent@folder:4",
+				"folder:5#parent@folder:6",
+				"folder:6#parent@folder:5",
+				"folder:7#parent@folder:7",
+				"folder:1#viewer@user:1",
+				"folder:1#org@organization:1",
+				"organization:1#member@user:1",
+				"organization:1#member@user:2",
+				"organization:1#member@user:3",
+				"organization:2#member@user:1",
+			)
+
+			response := analyze(&base.DataAnalyzeRequest{TenantId: "t1", Metadata: &base.DataAnalyzeRequestMetadata{}, PageSize: 5, Top: 2})
+
+			Expect(response.GetSchemaVersion()).Should(Equal("v1"))
+			Expect(response.GetSnapToken()).ShouldNot(BeEmpty())
+			Expect(response.GetRelationships()).Should(Equal(uint64(12)))
+
+			Expect(response.GetRecursiveRelations()).Should(HaveLen(2))
+			parent := response.GetRecursiveRelations()[0]
+			Expect(parent.GetEntityType()).Should(Equal("folder"))
+			Expect(parent.GetRelation()).Should(Equal("parent"))
+			Expect(parent.GetPermissions()).Should(Equal([]string{"edit", "view"}))
+			Expect(parent.GetTuples()).Should(Equal(uint64(6)))
+			Expect(parent.GetMaxDepth()).Should(Equal(uint32(3)))
+			Expect(parent.GetDeepestEntityId()).Should(Equal("1"))
+			Expect(parent.GetCycleCount()).Should(Equal(uint64(2)))
+			Expect(parent.GetCycles()).Should(HaveLen(2))
+			Expect(parent.GetCycles()[0].GetEntityIds()).Should(Equal([]string{"5", "6"}))
+			Expect(parent.GetCycles()[1].GetEntityIds()).Should(Equal([]string{"7"}))
+
+			Expect(response.GetFanOut()).Should(HaveLen(2))
+			Expect(response.GetFanOut()[0].GetEntity().GetType()).Should(Equal("organization"))
+			Expect(response.GetFanOut()[0].GetEntity().GetId()).Should(Equal("1"))
+			Expect(response.GetFanOut()[0].GetRelation()).Should(Equal("member"))
+			Expect(response.GetFanOut()[0].GetCount()).Should(Equal(uint64(3)))
+			Expect(response.GetFanOut()[1].GetRelation()).Should(Equal("org"))
+
+			Expect(response.GetFanIn()).Should(HaveLen(2))
+			Expect(response.GetFanIn()[0].GetEntity().GetType()).Should(Equal("user"))
+			Expect(response.GetFanIn()[0].GetEntity().GetId()).Should(Equal("1"))
+			Expect(response.GetFanIn()[0].GetCount()).Should(Equal(uint64(3)))
+
+			member := response.GetRecursiveRelations()[1]
+			Expect(member.GetEntityType()).Should(Equal("group"))
+			Expect(member.GetRelation()).Should(Equal("member"))
+			Expect(member.GetTuples()).Should(BeZero())
+		})
+
+		It("Follows userset tuples", func() {
+			write(
+				"group:a#member@group:b#member",
+				"group:b#member@group:a#member",
+				"group:b#member@group:c#member",
+				"group:c#member@user:1",
+			)
+
+			response := analyze(&base.DataAnalyzeRequest{TenantId: "t1", Metadata: &base.DataAnalyzeRequestMetadata{}})
+
+			Expect(response.GetRecursiveRelations()).Should(HaveLen(2))
+			member := response.GetRecursiveRelations()[1]
+			Expect(member.GetEntityType()).Should(Equal("group"))
+			Expect(member.GetRelation()).Should(Equal("member"))
+			Expect(member.GetPermissions()).Should(BeEmpty())
+			Expect(member.GetTuples()).Should(Equal(uint64(3)))
+			Expect(member.GetMaxDepth()).Should(Equal(uint32(2)))
+			Expect(member.GetCycleCount()).Should(Equal(uint64(1)))
+			Expect(member.GetCycles()[0].GetEntityIds()).Should(Equal([]string{"a", "b"}))
+
+			Expect(response.GetFanIn()[0].GetEntity().GetType()).Should(Equal("group"))
+			Expect(response.GetFanIn()[0].GetEntity().GetId()).Should(Equal("a"))
+			Expect(response.GetFanIn()[0].GetRelation()).Should(Equal("member"))
+		})
+
+		It("Measures long chains", func() {
+			var relationships []string
+			for i := 0; i < 5000; i++ {
+				relationships = append(relationships, "folder:"+strconv.Itoa(i)+"#parent@folder:"+strconv.Itoa(i+1))
+			}
+			for i := 0; i < len(relationships); i += 1000 {
+				write(relationships[i : i+1000]...)
+			}
+
+			response := analyze(&base.DataAnalyzeRequest{TenantId: "t1", Metadata: &base.DataAnalyzeRequestMetadata{}})
+
+			parent := response.GetRecursiveRelations()[0]
+			Expect(parent.GetMaxDepth()).Should(Equal(uint32(5000)))
+			Expect(parent.GetDeepestEntityId()).Should(Equal("0"))
+			Expect(parent.GetCycleCount()).Should(BeZero())
+		})
+	})
+})
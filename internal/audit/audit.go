@@ -0,0 +1,271 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/rs/xid"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Permify/permify/internal/authn"
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/pkg/database"
+	PQDatabase "github.com/Permify/permify/pkg/database/postgres"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Sink - Destination of audit records
+type Sink interface {
+	// Write appends a record to the sink.
+	Write(ctx context.Context, record *base.AuditRecord) error
+	// Close flushes and releases the sink.
+	Close(ctx context.Context) error
+}
+
+// Reader - Sink whose records can be queried
+type Reader interface {
+	// List returns the records of a tenant matching the filter, newest first.
+	List(ctx context.Context, tenantID string, filter *base.AuditFilter, pagination database.Pagination) ([]*base.AuditRecord, database.EncodedContinuousToken, error)
+}
+
+// Auditor - Records mutations to the configured sinks
+type Auditor struct {
+	sinks []Sink
+	// reader is the first sink whose records can be queried, nil if there is none.
+	reader Reader
+}
+
+// NewAuditor - Creates an auditor writing to the given sinks
+func NewAuditor(sinks ...Sink) *Auditor {
+	a := &Auditor{sinks: sinks}
+	for _, sink := range sinks {
+		if reader, ok := sink.(Reader); ok {
+			a.reader = reader
+			break
+		}
+	}
+	return a
+}
+
+// New - Creates an auditor writing to the sinks enabled in the configuration
+func New(conf config.Audit, db database.Database) (*Auditor, error) {
+	var sinks []Sink
+
+	if conf.File.Enabled {
+		sink, err := NewFileSink(conf.File.Path, conf.File.MaxSize, conf.File.MaxBackups)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+
+	if conf.Postgres.Enabled {
+		pg, ok := db.(*PQDatabase.Postgres)
+		if !ok {
+			return nil, errors.New("postgres audit sink requires the postgres database engine")
+		}
+		sinks = append(sinks, NewPostgresSink(pg))
+	}
+
+	if conf.Otlp.Enabled {
+		headers := map[string]string{}
+		for _, header := range conf.Otlp.Headers {
+			h := strings.Split(header, ":")
+			if len(h) != 2 {
+				return nil, errors.New("invalid header format; expected 'key:value'")
+			}
+			headers[h[0]] = h[1]
+		}
+		sink, err := NewOTLPSink(conf.Otlp.Endpoint, conf.Otlp.Insecure, conf.Otlp.Urlpath, headers, conf.Otlp.Protocol)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+
+	if len(sinks) == 0 {
+		return nil, errors.New("audit log requires at least one sink")
+	}
+
+	return NewAuditor(sinks...), nil
+}
+
+// Record - Writes a record to every sink. Failing sinks are logged and do not fail the request.
+func (a *Auditor) Record(ctx context.Context, record *base.AuditRecord) {
+	if record.GetId() == "" {
+		record.Id = xid.New().String()
+	}
+	if record.GetCreatedAt() == nil {
+		record.CreatedAt = timestamppb.Now()
+	}
+	for _, sink := range a.sinks {
+		if err := sink.Write(ctx, record); err != nil {
+			slog.ErrorContext(ctx, "failed to write audit record", slog.String("sink", fmt.Sprintf("%T", sink)), slog.String("id", record.GetId()), slog.Any("error", err))
+		}
+	}
+}
+
+// List - Returns the records of a tenant from the queryable sink
+func (a *Auditor) List(ctx context.Context, tenantID string, filter *base.AuditFilter, pagination database.Pagination) ([]*base.AuditRecord, database.EncodedContinuousToken, error) {
+	if a.reader == nil {
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_NOT_IMPLEMENTED.String())
+	}
+	return a.reader.List(ctx, tenantID, filter, pagination)
+}
+
+// Close - Closes every sink
+func (a *Auditor) Close(ctx context.Context) error {
+	var errs []error
+	for _, sink := range a.sinks {
+		errs = append(errs, sink.Close(ctx))
+	}
+	return errors.Join(errs...)
+}
+
+// mutations - Full names of the RPCs that are recorded and how their requests are summarized
+var mutations = map[string]func(req interface{}) string{
+	"/base.v1.Data/Write": func(req interface{}) string {
+		r := req.(*base.DataWriteRequest)
+		return fmt.Sprintf("tuples=%d attributes=%d", len(r.GetTuples()), len(r.GetAttributes()))
+	},
+	"/base.v1.Data/WriteRelationships": func(req interface{}) string {
+		return fmt.Sprintf("tuples=%d", len(req.(*base.RelationshipWriteRequest).GetTuples()))
+	},
+	"/base.v1.Data/Delete": func(req interface{}) string {
+		r := req.(*base.DataDeleteRequest)
+		return fmt.Sprintf("tuple_filter=%s attribute_filter=%s", r.GetTupleFilter().GetEntity().GetType(), r.GetAttributeFilter().GetEntity().GetType())
+	},
+	"/base.v1.Data/DeleteRelationships": func(req interface{}) string {
+		return fmt.Sprintf("tuple_filter=%s", req.(*base.RelationshipDeleteRequest).GetFilter().GetEntity().GetType())
+	},
+	"/base.v1.Data/Import": func(req interface{}) string {
+		return fmt.Sprintf("schema_version=%s", req.(*base.DataImportRequest).GetMetadata().GetSchemaVersion())
+	},
+	"/base.v1.Data/Verify": func(req interface{}) string {
+		r := req.(*base.DataVerifyRequest)
+		return fmt.Sprintf("action=%s quarantine_tenant=%s", r.GetAction(), r.GetQuarantineTenantId())
+	},
+	"/base.v1.Data/RunBundle": func(req interface{}) string {
+		return fmt.Sprintf("bundle=%s", req.(*base.BundleRunRequest).GetName())
+	},
+	"/base.v1.Schema/Write": func(req interface{}) string {
+		return fmt.Sprintf("schema_bytes=%d", len(req.(*base.SchemaWriteRequest).GetSchema()))
+	},
+	"/base.v1.Schema/PartialWrite": func(req interface{}) string {
+		return fmt.Sprintf("entities=%d", len(req.(*base.SchemaPartialWriteRequest).GetPartials()))
+	},
+	"/base.v1.Schema/ShadowWrite": func(req interface{}) string {
+		r := req.(*base.SchemaShadowWriteRequest)
+		return fmt.Sprintf("schema_bytes=%d sample_rate=%v", len(r.GetSchema()), r.GetSampleRate())
+	},
+	"/base.v1.Schema/ShadowPromote": func(interface{}) string {
+		return ""
+	},
+	"/base.v1.Schema/ShadowRollback": func(interface{}) string {
+		return ""
+	},
+	"/base.v1.Schema/Tag": func(req interface{}) string {
+		return fmt.Sprintf("tag=%s", req.(*base.SchemaTagRequest).GetTag())
+	},
+	"/base.v1.Schema/Untag": func(req interface{}) string {
+		return fmt.Sprintf("tag=%s", req.(*base.SchemaUntagRequest).GetTag())
+	},
+	"/base.v1.Schema/Activate": func(req interface{}) string {
+		return fmt.Sprintf("schema_tag=%s", req.(*base.SchemaActivateRequest).GetSchemaTag())
+	},
+	"/base.v1.Bundle/Write": func(req interface{}) string {
+		var names []string
+		for _, bundle := range req.(*base.BundleWriteRequest).GetBundles() {
+			names = append(names, bundle.GetName())
+		}
+		return fmt.Sprintf("bundles=%s", strings.Join(names, ","))
+	},
+	"/base.v1.Bundle/Delete": func(req interface{}) string {
+		return fmt.Sprintf("bundle=%s", req.(*base.BundleDeleteRequest).GetName())
+	},
+	"/base.v1.Tenancy/Create": func(req interface{}) string {
+		return fmt.Sprintf("name=%s", req.(*base.TenantCreateRequest).GetName())
+	},
+	"/base.v1.Tenancy/Delete": func(interface{}) string {
+		return ""
+	},
+}
+
+// IsMutation - Checks whether calls of the given full RPC name are recorded
+func IsMutation(fullMethod string) bool {
+	_, ok := mutations[fullMethod]
+	return ok
+}
+
+// NewRecord - Creates the record of a mutation from its request, response and error
+func NewRecord(ctx context.Context, fullMethod string, req, resp interface{}, err error) *base.AuditRecord {
+	service, method := authn.SplitMethod(fullMethod)
+	record := &base.AuditRecord{
+		Actor:   authn.IdentityFromContext(ctx),
+		Method:  service + "." + method,
+		Outcome: status.Code(err).String(),
+	}
+
+	switch r := req.(type) {
+	case interface{ GetTenantId() string }:
+		record.TenantId = r.GetTenantId()
+	case interface{ GetId() string }:
+		// Tenancy requests identify the tenant by its id.
+		record.TenantId = r.GetId()
+	}
+
+	if summarize, ok := mutations[fullMethod]; ok && req != nil {
+		record.Summary = summarize(req)
+	}
+
+	switch r := req.(type) {
+	case *base.DataWriteRequest:
+		record.TransactionMetadata = r.GetMetadata().GetMetadata()
+	case *base.DataVerifyRequest:
+		record.TransactionMetadata = r.GetMetadata().GetMetadata()
+	case interface {
+		GetMetadata() *base.TransactionMetadata
+	}:
+		record.TransactionMetadata = r.GetMetadata()
+	}
+
+	if err != nil {
+		record.Error = status.Convert(err).Message()
+		// A failed import still reports the transactions it committed before the failure.
+		for _, detail := range status.Convert(err).Details() {
+			if r, ok := detail.(*base.DataImportResponse); ok {
+				record.Summary += importSummary(r)
+			}
+		}
+		return record
+	}
+
+	// Streams report their outcome in the last message they sent.
+	switch r := resp.(type) {
+	case *base.DataImportResponse:
+		record.Summary += importSummary(r)
+	case *base.DataVerifyResponse:
+		summary := r.GetSummary()
+		record.Summary += fmt.Sprintf(" violations=%d removed=%d", summary.GetViolations(), summary.GetRemoved())
+		record.SnapToken = summary.GetSnapToken()
+		record.SchemaVersion = summary.GetSchemaVersion()
+	}
+
+	if r, ok := resp.(interface{ GetSnapToken() string }); ok {
+		record.SnapToken = r.GetSnapToken()
+	}
+	if r, ok := resp.(interface{ GetSchemaVersion() string }); ok {
+		record.SchemaVersion = r.GetSchemaVersion()
+	}
+
+	return record
+}
+
+// importSummary - Summarizes the transactions committed by an import
+func importSummary(r *base.DataImportResponse) string {
+	return fmt.Sprintf(" commits=%d committed_tuples=%d committed_attributes=%d rejected=%d", r.GetCommits(), r.GetCommittedTuples(), r.GetCommittedAttributes(), r.GetRejected())
+}
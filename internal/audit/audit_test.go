@@ -0,0 +1,202 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/internal/authn"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+func TestAudit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "audit suite")
+}
+
+// memorySink - Sink keeping its records in memory
+type memorySink struct {
+	records []*base.AuditRecord
+	err     error
+}
+
+func (s *memorySink) Write(_ context.Context, record *base.AuditRecord) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *memorySink) Close(context.Context) error {
+	return nil
+}
+
+// memoryReader - Queryable sink keeping its records in memory
+type memoryReader struct {
+	memorySink
+}
+
+func (s *memoryReader) List(_ context.Context, tenantID string, _ *base.AuditFilter, _ database.Pagination) ([]*base.AuditRecord, database.EncodedContinuousToken, error) {
+	var records []*base.AuditRecord
+	for _, record := range s.records {
+		if record.GetTenantId() == tenantID {
+			records = append(records, record)
+		}
+	}
+	return records, database.NewNoopContinuousToken().Encode(), nil
+}
+
+var _ = Describe("audit", func() {
+	Context("NewRecord", func() {
+		It("Case 1: records the actor, tenant, summary and snap token of a mutation", func() {
+			ctx := authn.ContextWithIdentity(context.Background(), "billing")
+			record := NewRecord(ctx, "/base.v1.Data/Write", &base.DataWriteRequest{
+				TenantId:   "t1",
+				Tuples:     []*base.Tuple{{}, {}},
+				Attributes: []*base.Attribute{{}},
+			}, &base.DataWriteResponse{SnapToken: "token"}, nil)
+
+			Expect(record).Should(Equal(&base.AuditRecord{
+				TenantId:  "t1",
+				Actor:     "billing",
+				Method:    "Data.Write",
+				Summary:   "tuples=2 attributes=1",
+				SnapToken: "token",
+				Outcome:   "OK",
+			}))
+		})
+
+		It("Case 2: records the schema version and the tenant of tenancy requests", func() {
+			record := NewRecord(context.Background(), "/base.v1.Schema/Write", &base.SchemaWriteRequest{TenantId: "t1", Schema: "entity user {}"}, &base.SchemaWriteResponse{SchemaVersion: "v1"}, nil)
+			Expect(record.GetSchemaVersion()).Should(Equal("v1"))
+			Expect(record.GetSummary()).Should(Equal("schema_bytes=14"))
+
+			record = NewRecord(context.Background(), "/base.v1.Tenancy/Delete", &base.TenantDeleteRequest{Id: "t2"}, &base.TenantDeleteResponse{}, nil)
+			Expect(record.GetTenantId()).Should(Equal("t2"))
+			Expect(record.GetMethod()).Should(Equal("Tenancy.Delete"))
+		})
+
+		It("Case 3: records the outcome of failed mutations", func() {
+			record := NewRecord(context.Background(), "/base.v1.Bundle/Delete", &base.BundleDeleteRequest{TenantId: "t1", Name: "b1"}, nil, status.Error(codes.NotFound, base.ErrorCode_ERROR_CODE_BUNDLE_NOT_FOUND.String()))
+			Expect(record.GetOutcome()).Should(Equal("NotFound"))
+			Expect(record.GetError()).Should(Equal(base.ErrorCode_ERROR_CODE_BUNDLE_NOT_FOUND.String()))
+			Expect(record.GetSummary()).Should(Equal("bundle=b1"))
+		})
+
+		It("Case 4: records the transaction metadata of the request", func() {
+			metadata := &base.TransactionMetadata{Actor: "alice", Reason: "TICKET-1"}
+
+			record := NewRecord(context.Background(), "/base.v1.Data/Write", &base.DataWriteRequest{
+				TenantId: "t1",
+				Metadata: &base.DataWriteRequestMetadata{Metadata: metadata},
+			}, &base.DataWriteResponse{}, nil)
+			Expect(record.GetTransactionMetadata()).Should(Equal(metadata))
+
+			record = NewRecord(context.Background(), "/base.v1.Data/Delete", &base.DataDeleteRequest{TenantId: "t1", Metadata: metadata}, nil, status.Error(codes.FailedPrecondition, base.ErrorCode_ERROR_CODE_FAILED_PRECONDITION.String()))
+			Expect(record.GetTransactionMetadata()).Should(Equal(metadata))
+
+			record = NewRecord(context.Background(), "/base.v1.Schema/Write", &base.SchemaWriteRequest{TenantId: "t1", Metadata: metadata}, &base.SchemaWriteResponse{}, nil)
+			Expect(record.GetTransactionMetadata()).Should(Equal(metadata))
+
+			record = NewRecord(context.Background(), "/base.v1.Bundle/Delete", &base.BundleDeleteRequest{TenantId: "t1"}, &base.BundleDeleteResponse{}, nil)
+			Expect(record.GetTransactionMetadata()).Should(BeNil())
+		})
+
+		It("Case 5: records the outcome of streaming mutations", func() {
+			metadata := &base.TransactionMetadata{Actor: "alice"}
+			record := NewRecord(context.Background(), "/base.v1.Data/Verify", &base.DataVerifyRequest{
+				TenantId:           "t1",
+				Metadata:           &base.DataVerifyRequestMetadata{Metadata: metadata},
+				Action:             base.DataVerifyAction_DATA_VERIFY_ACTION_QUARANTINE,
+				QuarantineTenantId: "q1",
+			}, &base.DataVerifyResponse{Result: &base.DataVerifyResponse_Summary{Summary: &base.DataVerifySummary{
+				Violations:    2,
+				Removed:       2,
+				SchemaVersion: "v1",
+				SnapToken:     "token",
+			}}}, nil)
+			Expect(record.GetSummary()).Should(Equal("action=DATA_VERIFY_ACTION_QUARANTINE quarantine_tenant=q1 violations=2 removed=2"))
+			Expect(record.GetSnapToken()).Should(Equal("token"))
+			Expect(record.GetSchemaVersion()).Should(Equal("v1"))
+			Expect(record.GetTransactionMetadata()).Should(Equal(metadata))
+
+			st, err := status.New(codes.InvalidArgument, base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String()).WithDetails(&base.DataImportResponse{Commits: 1, CommittedTuples: 10, Rejected: 1})
+			Expect(err).ShouldNot(HaveOccurred())
+			record = NewRecord(context.Background(), "/base.v1.Data/Import", &base.DataImportRequest{
+				TenantId: "t1",
+				Metadata: &base.DataImportRequestMetadata{SchemaVersion: "v1"},
+			}, nil, st.Err())
+			Expect(record.GetOutcome()).Should(Equal("InvalidArgument"))
+			Expect(record.GetSummary()).Should(Equal("schema_version=v1 commits=1 committed_tuples=10 committed_attributes=0 rejected=1"))
+		})
+
+		It("Case 6: only mutations are recorded", func() {
+			Expect(IsMutation("/base.v1.Data/Import")).Should(BeTrue())
+			Expect(IsMutation("/base.v1.Data/Verify")).Should(BeTrue())
+			Expect(IsMutation("/base.v1.Data/Write")).Should(BeTrue())
+			Expect(IsMutation("/base.v1.Tenancy/Create")).Should(BeTrue())
+			Expect(IsMutation("/base.v1.Permission/Check")).Should(BeFalse())
+			Expect(IsMutation("/base.v1.Audit/List")).Should(BeFalse())
+		})
+	})
+
+	Context("Auditor", func() {
+		It("Case 1: writes records to every sink and reads them from the queryable one", func() {
+			failing := &memorySink{err: errors.New("unavailable")}
+			reader := &memoryReader{}
+			auditor := NewAuditor(failing, reader)
+
+			auditor.Record(context.Background(), &base.AuditRecord{TenantId: "t1", Method: "Data.Write", Outcome: "OK"})
+			auditor.Record(context.Background(), &base.AuditRecord{TenantId: "t2", Method: "Data.Write", Outcome: "OK"})
+
+			records, _, err := auditor.List(context.Background(), "t1", nil, database.NewPagination())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(records).Should(HaveLen(1))
+			Expect(records[0].GetId()).ShouldNot(BeEmpty())
+			Expect(records[0].GetCreatedAt()).ShouldNot(BeNil())
+		})
+
+		It("Case 2: records cannot be listed without a queryable sink", func() {
+			auditor := NewAuditor(&memorySink{})
+			_, _, err := auditor.List(context.Background(), "t1", nil, database.NewPagination())
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_NOT_IMPLEMENTED.String()))
+		})
+	})
+
+	Context("FileSink", func() {
+		It("Case 1: writes JSON lines", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "audit.jsonl")
+			sink, err := NewFileSink(path, 1, 2)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, tenant := range []string{"t1", "t2"} {
+				Expect(sink.Write(context.Background(), &base.AuditRecord{Id: tenant, TenantId: tenant})).Should(Succeed())
+			}
+			Expect(sink.Close(context.Background())).Should(Succeed())
+
+			file, err := os.Open(path)
+			Expect(err).ShouldNot(HaveOccurred())
+			defer file.Close()
+			var tenants []string
+			scanner := bufio.NewScanner(file)
+			for scanner.Scan() {
+				record := &base.AuditRecord{}
+				Expect(protojson.Unmarshal(scanner.Bytes(), record)).Should(Succeed())
+				tenants = append(tenants, record.GetTenantId())
+			}
+			Expect(tenants).Should(Equal([]string{"t1", "t2"}))
+		})
+	})
+})
@@ -0,0 +1,75 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/agoda-com/opentelemetry-logs-go/logs"
+	sdk "github.com/agoda-com/opentelemetry-logs-go/sdk/logs"
+	"go.opentelemetry.io/otel/attribute"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/telemetry"
+	"github.com/Permify/permify/pkg/telemetry/logexporters"
+)
+
+// OTLPSink - Exports audit records as OTLP log records
+type OTLPSink struct {
+	provider *sdk.LoggerProvider
+	logger   logs.Logger
+}
+
+// NewOTLPSink - Creates a new OTLPSink exporting to the given collector
+func NewOTLPSink(endpoint string, insecure bool, urlpath string, headers map[string]string, protocol string) (*OTLPSink, error) {
+	exporter, err := logexporters.ExporterFactory("otlp", endpoint, insecure, urlpath, headers, protocol)
+	if err != nil {
+		return nil, err
+	}
+	provider := telemetry.NewLog(exporter, "permify")
+	return &OTLPSink{
+		provider: provider,
+		logger:   provider.Logger("permify.audit"),
+	}, nil
+}
+
+// Write - Emits the record with its fields as attributes
+func (s *OTLPSink) Write(_ context.Context, record *base.AuditRecord) error {
+	timestamp := record.GetCreatedAt().AsTime()
+	severityText := "INFO"
+	severityNumber := logs.INFO
+	attributes := []attribute.KeyValue{
+		attribute.String("audit.id", record.GetId()),
+		attribute.String("audit.tenant_id", record.GetTenantId()),
+		attribute.String("audit.actor", record.GetActor()),
+		attribute.String("audit.method", record.GetMethod()),
+		attribute.String("audit.summary", record.GetSummary()),
+		attribute.String("audit.snap_token", record.GetSnapToken()),
+		attribute.String("audit.schema_version", record.GetSchemaVersion()),
+		attribute.String("audit.outcome", record.GetOutcome()),
+		attribute.String("audit.error", record.GetError()),
+	}
+	if metadata := record.GetTransactionMetadata(); metadata != nil {
+		attributes = append(attributes,
+			attribute.String("audit.transaction.actor", metadata.GetActor()),
+			attribute.String("audit.transaction.reason", metadata.GetReason()),
+			attribute.String("audit.transaction.request_id", metadata.GetRequestId()),
+		)
+		for key, value := range metadata.GetLabels() {
+			attributes = append(attributes, attribute.String("audit.transaction.label."+key, value))
+		}
+	}
+	s.logger.Emit(logs.NewLogRecord(logs.LogRecordConfig{
+		Timestamp:         &timestamp,
+		ObservedTimestamp: time.Now(),
+		SeverityText:      &severityText,
+		SeverityNumber:    &severityNumber,
+		BodyAny:           "audit " + record.GetMethod(),
+		Attributes:        &attributes,
+	}))
+	return nil
+}
+
+// Close - Flushes the pending records and shuts the exporter down
+func (s *OTLPSink) Close(ctx context.Context) error {
+	return s.provider.Shutdown(ctx)
+}
@@ -0,0 +1,139 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Permify/permify/internal"
+	"github.com/Permify/permify/internal/storage/postgres/utils"
+	"github.com/Permify/permify/pkg/database"
+	db "github.com/Permify/permify/pkg/database/postgres"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// RecordsTable - Table of the postgres audit sink
+const RecordsTable = "audit_records"
+
+// PostgresSink - Writes audit records to the audit_records table of the database
+type PostgresSink struct {
+	database *db.Postgres
+}
+
+// NewPostgresSink - Creates a new PostgresSink
+func NewPostgresSink(database *db.Postgres) *PostgresSink {
+	return &PostgresSink{
+		database: database,
+	}
+}
+
+// Write - Inserts the record
+func (s *PostgresSink) Write(ctx context.Context, record *base.AuditRecord) error {
+	ctx, span := internal.Tracer.Start(ctx, "audit.postgres.write")
+	defer span.End()
+
+	metadata, err := utils.MarshalMetadata(record.GetTransactionMetadata())
+	if err != nil {
+		return utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INTERNAL)
+	}
+
+	query, args, err := s.database.Builder.Insert(RecordsTable).
+		Columns("id", "tenant_id", "actor", "method", "summary", "snap_token", "schema_version", "outcome", "error", "created_at", "transaction_metadata").
+		Values(record.GetId(), record.GetTenantId(), record.GetActor(), record.GetMethod(), record.GetSummary(), record.GetSnapToken(), record.GetSchemaVersion(), record.GetOutcome(), record.GetError(), record.GetCreatedAt().AsTime(), metadata).
+		ToSql()
+	if err != nil {
+		return utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SQL_BUILDER)
+	}
+
+	if _, err = s.database.WritePool.Exec(ctx, query, args...); err != nil {
+		return utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
+	}
+	return nil
+}
+
+// List - Returns the records of a tenant matching the filter, newest first
+func (s *PostgresSink) List(ctx context.Context, tenantID string, filter *base.AuditFilter, pagination database.Pagination) (records []*base.AuditRecord, ct database.EncodedContinuousToken, err error) {
+	ctx, span := internal.Tracer.Start(ctx, "audit.postgres.list")
+	defer span.End()
+
+	builder := s.database.Builder.
+		Select("id", "tenant_id", "actor", "method", "summary", "snap_token", "schema_version", "outcome", "error", "created_at", "transaction_metadata").
+		From(RecordsTable).
+		Where(squirrel.Eq{"tenant_id": tenantID})
+
+	if len(filter.GetActors()) > 0 {
+		builder = builder.Where(squirrel.Eq{"actor": filter.GetActors()})
+	}
+	if len(filter.GetMethods()) > 0 {
+		builder = builder.Where(squirrel.Eq{"method": filter.GetMethods()})
+	}
+	if filter.GetStartTime() != nil {
+		builder = builder.Where(squirrel.GtOrEq{"created_at": filter.GetStartTime().AsTime()})
+	}
+	if filter.GetEndTime() != nil {
+		builder = builder.Where(squirrel.Lt{"created_at": filter.GetEndTime().AsTime()})
+	}
+
+	if pagination.Token() != "" {
+		var t database.ContinuousToken
+		t, err = utils.EncodedContinuousToken{Value: pagination.Token()}.Decode()
+		if err != nil {
+			return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN)
+		}
+		builder = builder.Where(squirrel.LtOrEq{"id": t.(utils.ContinuousToken).Value})
+	}
+
+	// Record ids are time ordered, so ordering by id lists the newest records first.
+	builder = builder.OrderBy("id DESC").Limit(uint64(pagination.PageSize() + 1))
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SQL_BUILDER)
+	}
+
+	slog.DebugContext(ctx, "executing sql query", slog.Any("query", query), slog.Any("arguments", args))
+
+	var rows pgx.Rows
+	rows, err = s.database.ReadPool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
+	}
+	defer rows.Close()
+
+	var lastID string
+	records = make([]*base.AuditRecord, 0, pagination.PageSize()+1)
+	for rows.Next() {
+		record := &base.AuditRecord{}
+		var createdAt time.Time
+		var metadata []byte
+		err = rows.Scan(&record.Id, &record.TenantId, &record.Actor, &record.Method, &record.Summary, &record.SnapToken, &record.SchemaVersion, &record.Outcome, &record.Error, &createdAt, &metadata)
+		if err != nil {
+			return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
+		}
+		record.CreatedAt = timestamppb.New(createdAt)
+		record.TransactionMetadata, err = utils.UnmarshalMetadata(metadata)
+		if err != nil {
+			return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INTERNAL)
+		}
+		lastID = record.GetId()
+		records = append(records, record)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INTERNAL)
+	}
+
+	if len(records) > int(pagination.PageSize()) {
+		return records[:pagination.PageSize()], utils.NewContinuousToken(lastID).Encode(), nil
+	}
+
+	return records, database.NewNoopContinuousToken().Encode(), nil
+}
+
+// Close - The database is closed by its owner
+func (s *PostgresSink) Close(context.Context) error {
+	return nil
+}
@@ -2,9 +2,106 @@ package authn
 
 import (
 	"context"
+	"strings"
 )
 
 // Authenticator - Interface for oidc authenticator
 type Authenticator interface {
 	Authenticate(ctx context.Context) error
 }
+
+// Authorizer - Interface for authenticators that also resolve what the caller may access
+type Authorizer interface {
+	Authenticator
+	// Grant authenticates the caller and returns its grant. A nil grant allows every tenant and method.
+	Grant(ctx context.Context) (*Grant, error)
+}
+
+// Identifier - Interface for authorizers that also resolve the identity of the caller
+type Identifier interface {
+	Authorizer
+	// Identify authenticates the caller and returns its identity, e.g. the subject of its token, along with its grant.
+	Identify(ctx context.Context) (string, *Grant, error)
+}
+
+// Wildcard matches every tenant or method in a grant.
+const Wildcard = "*"
+
+// Grant - Tenants and RPC methods an authenticated caller is allowed to access
+type Grant struct {
+	// Tenants the caller may access. Empty or "*" allows every tenant.
+	Tenants []string
+	// Methods the caller may call, written as "*", a service name such as "Permission",
+	// or a service and method name such as "Permission.Check". Empty allows every method.
+	Methods []string
+}
+
+// AllowsTenant - Checks whether the grant covers the given tenant
+func (g *Grant) AllowsTenant(tenantID string) bool {
+	if g == nil || len(g.Tenants) == 0 {
+		return true
+	}
+	for _, tenant := range g.Tenants {
+		if tenant == Wildcard || tenant == tenantID {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsAllTenants - Checks whether the grant covers every tenant
+func (g *Grant) AllowsAllTenants() bool {
+	return g.AllowsTenant(Wildcard)
+}
+
+// AllowsMethod - Checks whether the grant covers the given full gRPC method name, e.g. "/base.v1.Permission/Check"
+func (g *Grant) AllowsMethod(fullMethod string) bool {
+	if g == nil || len(g.Methods) == 0 {
+		return true
+	}
+	service, method := SplitMethod(fullMethod)
+	for _, m := range g.Methods {
+		if m == Wildcard || m == service || m == service+"."+method {
+			return true
+		}
+	}
+	return false
+}
+
+// SplitMethod - Splits a full gRPC method name into its short service name and method name,
+// e.g. "/base.v1.Permission/Check" into "Permission" and "Check"
+func SplitMethod(fullMethod string) (service, method string) {
+	service, method, _ = strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if i := strings.LastIndex(service, "."); i >= 0 {
+		service = service[i+1:]
+	}
+	return service, method
+}
+
+// grantKey - Context key of the grant of the caller
+type grantKey struct{}
+
+// ContextWithGrant - Returns a copy of the context carrying the grant of the caller
+func ContextWithGrant(ctx context.Context, grant *Grant) context.Context {
+	return context.WithValue(ctx, grantKey{}, grant)
+}
+
+// GrantFromContext - Returns the grant of the caller, nil if the caller is not restricted
+func GrantFromContext(ctx context.Context) *Grant {
+	grant, _ := ctx.Value(grantKey{}).(*Grant)
+	return grant
+}
+
+// identityKey - Context key of the identity of the caller
+type identityKey struct{}
+
+// ContextWithIdentity - Returns a copy of the context carrying the identity of the caller
+func ContextWithIdentity(ctx context.Context, identity string) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// IdentityFromContext - Returns the identity of the caller, empty if the authenticator does not resolve one
+func IdentityFromContext(ctx context.Context) string {
+	identity, _ := ctx.Value(identityKey{}).(string)
+	return identity
+}
@@ -0,0 +1,308 @@
+package mtls
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/Permify/permify/internal/authn"
+	"github.com/Permify/permify/internal/config"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+const (
+	// identityMetadataKey carries the identity of the client certificate verified by the HTTP gateway.
+	identityMetadataKey = "x-permify-client-identity"
+	// signatureMetadataKey carries the signature of the forwarded identity.
+	signatureMetadataKey = "x-permify-client-identity-signature"
+)
+
+// Identity - Names of a verified client certificate
+type Identity struct {
+	URIs       []string `json:"uris,omitempty"`
+	DNSNames   []string `json:"dns_names,omitempty"`
+	CommonName string   `json:"common_name,omitempty"`
+}
+
+// NewIdentity - Extracts the identity of a client certificate
+func NewIdentity(cert *x509.Certificate) *Identity {
+	identity := &Identity{
+		DNSNames:   cert.DNSNames,
+		CommonName: cert.Subject.CommonName,
+	}
+	for _, uri := range cert.URIs {
+		identity.URIs = append(identity.URIs, uri.String())
+	}
+	return identity
+}
+
+// String - Returns the primary name of the identity: the first SAN URI, else the first SAN DNS name, else the common name
+func (i *Identity) String() string {
+	if len(i.URIs) > 0 {
+		return i.URIs[0]
+	}
+	if len(i.DNSNames) > 0 {
+		return i.DNSNames[0]
+	}
+	return i.CommonName
+}
+
+// Names - Returns every name of the identity in order of precedence
+func (i *Identity) Names() []string {
+	names := make([]string, 0, len(i.URIs)+len(i.DNSNames)+1)
+	names = append(names, i.URIs...)
+	names = append(names, i.DNSNames...)
+	if i.CommonName != "" {
+		names = append(names, i.CommonName)
+	}
+	return names
+}
+
+// Authn - Authenticates callers by the client certificates they present
+type Authn struct {
+	// Path of the PEM bundle of the certificate authorities trusted to issue client certificates.
+	caPath string
+	// Certificate authorities currently in use, swapped when the bundle on disk changes.
+	pool atomic.Pointer[x509.CertPool]
+	// Modification time of the bundle last loaded.
+	modTime time.Time
+	// Guards reloading the bundle.
+	mutex sync.Mutex
+
+	// Configured identities in order of precedence, empty if every identity is allowed.
+	identities []config.MtlsIdentity
+
+	// Secret of this process used to sign the identities forwarded by the HTTP gateway.
+	secret []byte
+}
+
+// NewMtlsAuthn - Creates a client certificate authenticator and reloads its CA bundle until the context is done
+func NewMtlsAuthn(ctx context.Context, conf config.Mtls) (*Authn, error) {
+	if conf.CAPath == "" {
+		return nil, errors.New("mtls authn must have a ca bundle")
+	}
+	for _, identity := range conf.Identities {
+		if identity.Identity == "" {
+			return nil, errors.New("mtls identity must have a name")
+		}
+		if len(identity.Tenants) < 1 {
+			return nil, fmt.Errorf("mtls identity '%s' must have at least one tenant", identity.Identity)
+		}
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+
+	a := &Authn{
+		caPath:     conf.CAPath,
+		identities: conf.Identities,
+		secret:     secret,
+	}
+	if err := a.Reload(); err != nil {
+		return nil, err
+	}
+
+	if conf.ReloadInterval > 0 {
+		go a.watch(ctx, conf.ReloadInterval)
+	}
+
+	return a, nil
+}
+
+// Reload - Loads the CA bundle from disk if it changed since it was last loaded
+func (a *Authn) Reload() error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	info, err := os.Stat(a.caPath)
+	if err != nil {
+		return err
+	}
+	if a.pool.Load() != nil && info.ModTime().Equal(a.modTime) {
+		return nil
+	}
+
+	bundle, err := os.ReadFile(a.caPath)
+	if err != nil {
+		return err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bundle) {
+		return fmt.Errorf("no certificates found in ca bundle '%s'", a.caPath)
+	}
+
+	a.pool.Store(pool)
+	a.modTime = info.ModTime()
+	return nil
+}
+
+// watch - Reloads the CA bundle at every interval until the context is done
+func (a *Authn) watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// Keep the previous bundle if the new one cannot be loaded.
+			if err := a.Reload(); err != nil {
+				slog.Error("failed to reload mtls ca bundle", slog.String("path", a.caPath), slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// TLSConfig - Returns a server TLS configuration verifying client certificates against the current CA bundle.
+// If required is false, connections without a client certificate are accepted and rejected per request instead.
+func (a *Authn) TLSConfig(certPath, keyPath string, required bool) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return nil, err
+	}
+	clientAuth := tls.RequestClientCert
+	if required {
+		clientAuth = tls.RequireAnyClientCert
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   clientAuth,
+		MinVersion:   tls.VersionTLS12,
+		// The certificates are verified here rather than through ClientCAs so that a reloaded bundle
+		// applies to new connections without rebuilding the server.
+		VerifyConnection: a.verifyConnection,
+	}, nil
+}
+
+// verifyConnection - Verifies the client certificate of a connection, if any, against the current CA bundle
+func (a *Authn) verifyConnection(cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 {
+		return nil
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range cs.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := cs.PeerCertificates[0].Verify(x509.VerifyOptions{
+		Roots:         a.pool.Load(),
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	return err
+}
+
+// GatewayMetadata - Forwards the identity of the client certificate verified by the HTTP server to the gRPC server
+func (a *Authn) GatewayMetadata(_ context.Context, r *http.Request) metadata.MD {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return nil
+	}
+	identity, err := json.Marshal(NewIdentity(r.TLS.PeerCertificates[0]))
+	if err != nil {
+		return nil
+	}
+	return metadata.Pairs(identityMetadataKey, string(identity), signatureMetadataKey, a.sign(identity))
+}
+
+// sign - Signs a forwarded identity with the secret of this process
+func (a *Authn) sign(identity []byte) string {
+	mac := hmac.New(sha256.New, a.secret)
+	mac.Write(identity)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// PeerIdentity - Returns the identity of the verified client certificate of the caller, or the identity
+// forwarded by the HTTP gateway of this process
+func (a *Authn) PeerIdentity(ctx context.Context) (*Identity, error) {
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(info.State.PeerCertificates) > 0 {
+			return NewIdentity(info.State.PeerCertificates[0]), nil
+		}
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	identities, signatures := md.Get(identityMetadataKey), md.Get(signatureMetadataKey)
+	if len(identities) != 1 || len(signatures) != 1 {
+		return nil, status.Error(codes.Unauthenticated, base.ErrorCode_ERROR_CODE_UNAUTHENTICATED.String())
+	}
+	if !hmac.Equal([]byte(signatures[0]), []byte(a.sign([]byte(identities[0])))) {
+		return nil, status.Error(codes.Unauthenticated, base.ErrorCode_ERROR_CODE_UNAUTHENTICATED.String())
+	}
+	identity := &Identity{}
+	if err := json.Unmarshal([]byte(identities[0]), identity); err != nil {
+		return nil, status.Error(codes.Unauthenticated, base.ErrorCode_ERROR_CODE_UNAUTHENTICATED.String())
+	}
+	return identity, nil
+}
+
+// Identity - Returns the primary name of the verified identity of the caller
+func (a *Authn) Identity(ctx context.Context) (string, error) {
+	identity, err := a.PeerIdentity(ctx)
+	if err != nil {
+		return "", err
+	}
+	return identity.String(), nil
+}
+
+// Authenticate - Checks whether the caller presented a verified client certificate
+func (a *Authn) Authenticate(ctx context.Context) error {
+	_, err := a.Grant(ctx)
+	return err
+}
+
+// Grant - Authenticates the client certificate of the caller and returns the tenants and methods its identity is bound to
+func (a *Authn) Grant(ctx context.Context) (*authn.Grant, error) {
+	_, grant, err := a.Identify(ctx)
+	return grant, err
+}
+
+// Identify - Authenticates the client certificate of the caller and returns its identity and grant
+func (a *Authn) Identify(ctx context.Context) (string, *authn.Grant, error) {
+	identity, err := a.PeerIdentity(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(a.identities) == 0 {
+		return identity.String(), nil, nil
+	}
+	for _, configured := range a.identities {
+		for _, name := range identity.Names() {
+			if matchIdentity(configured.Identity, name) {
+				return identity.String(), &authn.Grant{
+					Tenants: configured.Tenants,
+					Methods: configured.Methods,
+				}, nil
+			}
+		}
+	}
+	return "", nil, status.Error(codes.Unauthenticated, base.ErrorCode_ERROR_CODE_UNAUTHENTICATED.String())
+}
+
+// matchIdentity - Checks whether a name matches a configured identity, where a trailing "*" matches any suffix
+func matchIdentity(pattern, name string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, authn.Wildcard); ok {
+		return strings.HasPrefix(name, prefix)
+	}
+	return pattern == name
+}
@@ -0,0 +1,283 @@
+package mtls
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/internal/authn"
+	"github.com/Permify/permify/internal/config"
+)
+
+func TestMtlsAuth(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "authentication mtls suite")
+}
+
+// authority - Test certificate authority
+type authority struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// newAuthority - Creates a self-signed test certificate authority
+func newAuthority(name string) *authority {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ShouldNot(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).ShouldNot(HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).ShouldNot(HaveOccurred())
+	return &authority{cert: cert, key: key}
+}
+
+// pem - Encodes the certificate of the authority
+func (a *authority) pem() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: a.cert.Raw})
+}
+
+// issue - Issues a leaf certificate for the given names and usage
+func (a *authority) issue(cn string, uris []string, dnsNames []string, usage x509.ExtKeyUsage) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ShouldNot(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: cn},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	for _, u := range uris {
+		parsed, err := url.Parse(u)
+		Expect(err).ShouldNot(HaveOccurred())
+		template.URIs = append(template.URIs, parsed)
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, a.cert, &key.PublicKey, a.key)
+	Expect(err).ShouldNot(HaveOccurred())
+	leaf, err := x509.ParseCertificate(der)
+	Expect(err).ShouldNot(HaveOccurred())
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+// peerContext - Returns a context of a gRPC call over a connection with the given client certificate
+func peerContext(cert tls.Certificate) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert.Leaf}}},
+	})
+}
+
+var _ = Describe("Authn", func() {
+	var (
+		dir    string
+		caPath string
+		ca     *authority
+	)
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		caPath = filepath.Join(dir, "ca.pem")
+		ca = newAuthority("clients")
+		Expect(os.WriteFile(caPath, ca.pem(), 0o600)).Should(Succeed())
+	})
+
+	Describe("NewMtlsAuthn", func() {
+		It("should require a ca bundle", func() {
+			_, err := NewMtlsAuthn(context.Background(), config.Mtls{})
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("should reject a bundle without certificates", func() {
+			Expect(os.WriteFile(caPath, []byte("not a certificate"), 0o600)).Should(Succeed())
+			_, err := NewMtlsAuthn(context.Background(), config.Mtls{CAPath: caPath})
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("should require identities to have tenants", func() {
+			_, err := NewMtlsAuthn(context.Background(), config.Mtls{
+				CAPath:     caPath,
+				Identities: []config.MtlsIdentity{{Identity: "billing"}},
+			})
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Describe("Grant", func() {
+		It("should allow every identity if none are configured", func() {
+			a, err := NewMtlsAuthn(context.Background(), config.Mtls{CAPath: caPath})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			cert := ca.issue("billing", []string{"spiffe://example.org/billing"}, nil, x509.ExtKeyUsageClientAuth)
+			grant, err := a.Grant(peerContext(cert))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(grant).Should(BeNil())
+
+			identity, err := a.Identity(peerContext(cert))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(identity).Should(Equal("spiffe://example.org/billing"))
+		})
+
+		It("should bind identities to their tenants and methods", func() {
+			a, err := NewMtlsAuthn(context.Background(), config.Mtls{
+				CAPath: caPath,
+				Identities: []config.MtlsIdentity{
+					{Identity: "spiffe://example.org/billing", Tenants: []string{"t1"}, Methods: []string{"Permission"}},
+					{Identity: "spiffe://example.org/admin/*", Tenants: []string{authn.Wildcard}},
+					{Identity: "reporting", Tenants: []string{"t2"}},
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			grant, err := a.Grant(peerContext(ca.issue("billing", []string{"spiffe://example.org/billing"}, nil, x509.ExtKeyUsageClientAuth)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(grant).Should(Equal(&authn.Grant{Tenants: []string{"t1"}, Methods: []string{"Permission"}}))
+
+			grant, err = a.Grant(peerContext(ca.issue("ops", []string{"spiffe://example.org/admin/ops"}, nil, x509.ExtKeyUsageClientAuth)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(grant.AllowsAllTenants()).Should(BeTrue())
+
+			grant, err = a.Grant(peerContext(ca.issue("reporting", nil, []string{"reporting.internal"}, x509.ExtKeyUsageClientAuth)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(grant.Tenants).Should(Equal([]string{"t2"}))
+
+			_, err = a.Grant(peerContext(ca.issue("unknown", []string{"spiffe://example.org/unknown"}, nil, x509.ExtKeyUsageClientAuth)))
+			Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+		})
+
+		It("should reject callers without a client certificate", func() {
+			a, err := NewMtlsAuthn(context.Background(), config.Mtls{CAPath: caPath})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = a.Grant(context.Background())
+			Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+		})
+	})
+
+	Describe("GatewayMetadata", func() {
+		var (
+			a   *Authn
+			req *http.Request
+		)
+
+		BeforeEach(func() {
+			var err error
+			a, err = NewMtlsAuthn(context.Background(), config.Mtls{CAPath: caPath})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			cert := ca.issue("billing", []string{"spiffe://example.org/billing"}, nil, x509.ExtKeyUsageClientAuth)
+			req = &http.Request{TLS: &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert.Leaf}}}
+		})
+
+		It("should forward the verified identity", func() {
+			ctx := metadata.NewIncomingContext(context.Background(), a.GatewayMetadata(context.Background(), req))
+			identity, err := a.Identity(ctx)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(identity).Should(Equal("spiffe://example.org/billing"))
+		})
+
+		It("should reject forged identities", func() {
+			md := a.GatewayMetadata(context.Background(), req)
+			md.Set(identityMetadataKey, `{"uris":["spiffe://example.org/admin"]}`)
+			_, err := a.Identity(metadata.NewIncomingContext(context.Background(), md))
+			Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+
+			md = a.GatewayMetadata(context.Background(), req)
+			md.Append(identityMetadataKey, `{"uris":["spiffe://example.org/admin"]}`)
+			_, err = a.Identity(metadata.NewIncomingContext(context.Background(), md))
+			Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+
+			other, err := NewMtlsAuthn(context.Background(), config.Mtls{CAPath: caPath})
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = other.Identity(metadata.NewIncomingContext(context.Background(), a.GatewayMetadata(context.Background(), req)))
+			Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+		})
+	})
+
+	Describe("TLSConfig", func() {
+		var serverCA *authority
+
+		// handshake - Performs a TLS handshake with the given client certificate
+		handshake := func(a *Authn, client tls.Certificate) error {
+			serverConfig, err := a.TLSConfig(filepath.Join(dir, "server.pem"), filepath.Join(dir, "server.key"), true)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			roots := x509.NewCertPool()
+			roots.AddCert(serverCA.cert)
+			clientConn, serverConn := net.Pipe()
+			defer serverConn.Close()
+
+			errs := make(chan error, 1)
+			go func() {
+				errs <- tls.Server(serverConn, serverConfig).Handshake()
+			}()
+			_ = tls.Client(clientConn, &tls.Config{
+				Certificates: []tls.Certificate{client},
+				RootCAs:      roots,
+				ServerName:   "localhost",
+				MinVersion:   tls.VersionTLS12,
+			}).Handshake()
+			// Unblock the server if it rejects the certificate after the client completed its handshake.
+			clientConn.Close()
+			return <-errs
+		}
+
+		BeforeEach(func() {
+			serverCA = newAuthority("server")
+			server := serverCA.issue("localhost", nil, []string{"localhost"}, x509.ExtKeyUsageServerAuth)
+			key, err := x509.MarshalPKCS8PrivateKey(server.PrivateKey)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(os.WriteFile(filepath.Join(dir, "server.pem"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate[0]}), 0o600)).Should(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, "server.key"), pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: key}), 0o600)).Should(Succeed())
+		})
+
+		It("should verify client certificates against the reloaded bundle", func() {
+			a, err := NewMtlsAuthn(context.Background(), config.Mtls{CAPath: caPath})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(handshake(a, ca.issue("billing", nil, nil, x509.ExtKeyUsageClientAuth))).Should(Succeed())
+			Expect(handshake(a, ca.issue("billing", nil, nil, x509.ExtKeyUsageServerAuth))).ShouldNot(Succeed())
+
+			rotated := newAuthority("rotated")
+			Expect(handshake(a, rotated.issue("billing", nil, nil, x509.ExtKeyUsageClientAuth))).ShouldNot(Succeed())
+
+			Expect(os.WriteFile(caPath, rotated.pem(), 0o600)).Should(Succeed())
+			Expect(os.Chtimes(caPath, time.Now().Add(time.Minute), time.Now().Add(time.Minute))).Should(Succeed())
+			Expect(a.Reload()).Should(Succeed())
+
+			Expect(handshake(a, rotated.issue("billing", nil, nil, x509.ExtKeyUsageClientAuth))).Should(Succeed())
+			Expect(handshake(a, ca.issue("billing", nil, nil, x509.ExtKeyUsageClientAuth))).ShouldNot(Succeed())
+		})
+	})
+})
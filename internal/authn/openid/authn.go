@@ -16,7 +16,10 @@ import (
 	grpcauth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/lestrrat-go/jwx/jwk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/Permify/permify/internal/authn"
 	"github.com/Permify/permify/internal/config"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 )
@@ -41,6 +44,13 @@ type Authn struct {
 
 	backoffFrequency time.Duration
 
+	// Claim holding the tenants the token may access, empty if tokens are not restricted to tenants.
+	tenantClaim string
+	// Claim holding the scopes of the token.
+	scopeClaim string
+	// Services or methods each scope may call, empty if tokens are not restricted to methods.
+	scopes map[string][]string
+
 	// Global backoff state for tracking retry attempts across concurrent requests
 	globalRetryCount int
 	globalFirstSeen  time.Time
@@ -80,6 +90,12 @@ func NewOidcAuthn(ctx context.Context, conf config.Oidc) (*Authn, error) {
 		return nil, errors.New("invalid or missing backoffFrequency")
 	}
 
+	// Scopes are read from the standard scope claim unless configured otherwise.
+	scopeClaim := conf.ScopeClaim
+	if scopeClaim == "" {
+		scopeClaim = "scope"
+	}
+
 	// Initialize the Authn struct with the OIDC configuration details and other relevant settings.
 	oidc := &Authn{
 		IssuerURL:         conf.Issuer,
@@ -91,6 +107,9 @@ func NewOidcAuthn(ctx context.Context, conf config.Oidc) (*Authn, error) {
 		backoffInterval:   backoffInterval,
 		backoffMaxRetries: backoffMaxRetries,
 		backoffFrequency:  backoffFrequency,
+		tenantClaim:       conf.TenantClaim,
+		scopeClaim:        scopeClaim,
+		scopes:            conf.Scopes,
 		globalRetryCount:  0,
 		retriedKeys:       make(map[string]bool),
 		globalFirstSeen:   time.Time{},
@@ -107,11 +126,87 @@ func NewOidcAuthn(ctx context.Context, conf config.Oidc) (*Authn, error) {
 
 // Authenticate validates the JWT token found in the authorization header of the incoming request.
 func (oidc *Authn) Authenticate(ctx context.Context) error {
+	_, err := oidc.validate(ctx)
+	return err
+}
+
+// Grant validates the JWT token of the incoming request and maps its claims to the tenants and methods
+// the caller may access. The tenants are read from the tenant claim and the methods from the scopes
+// of the token, each scope granting the methods configured for it.
+func (oidc *Authn) Grant(ctx context.Context) (*authn.Grant, error) {
+	_, grant, err := oidc.Identify(ctx)
+	return grant, err
+}
+
+// Identify validates the JWT token of the incoming request and returns its subject along with its grant.
+func (oidc *Authn) Identify(ctx context.Context) (string, *authn.Grant, error) {
+	claims, err := oidc.validate(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	subject, _ := claims["sub"].(string)
+	grant, err := oidc.grant(claims)
+	if err != nil {
+		return "", nil, err
+	}
+	return subject, grant, nil
+}
+
+// grant maps the claims of a validated token to the tenants and methods the caller may access.
+func (oidc *Authn) grant(claims jwt.MapClaims) (*authn.Grant, error) {
+	// Without a tenant claim and scope mapping the token is not restricted.
+	if oidc.tenantClaim == "" && len(oidc.scopes) == 0 {
+		return nil, nil
+	}
+
+	grant := &authn.Grant{}
+
+	if oidc.tenantClaim != "" {
+		grant.Tenants = claimValues(claims[oidc.tenantClaim])
+		if len(grant.Tenants) == 0 {
+			slog.Warn("token does not contain the tenant claim", "claim", oidc.tenantClaim)
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_CLAIMS.String())
+		}
+	}
+
+	if len(oidc.scopes) > 0 {
+		for _, scope := range claimValues(claims[oidc.scopeClaim]) {
+			grant.Methods = append(grant.Methods, oidc.scopes[scope]...)
+		}
+		if len(grant.Methods) == 0 {
+			slog.Warn("token does not contain any configured scope", "claim", oidc.scopeClaim)
+			return nil, status.Error(codes.PermissionDenied, base.ErrorCode_ERROR_CODE_METHOD_ACCESS_DENIED.String())
+		}
+	}
+
+	return grant, nil
+}
+
+// claimValues returns the values of a claim that is either a space separated string or a list of strings.
+func claimValues(claim interface{}) []string {
+	switch v := claim.(type) {
+	case string:
+		return strings.Fields(v)
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, value := range v {
+			if s, ok := value.(string); ok && s != "" {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// validate validates the JWT token found in the authorization header of the incoming request and returns its claims.
+func (oidc *Authn) validate(ctx context.Context) (jwt.MapClaims, error) {
 	// Extract the authorization header from the metadata of the incoming gRPC request.
 	authHeader, err := grpcauth.AuthFromMD(ctx, "Bearer")
 	if err != nil { // Check for authentication errors
 		slog.Error("failed to extract authorization header from gRPC request", "error", err)
-		return errors.New(base.ErrorCode_ERROR_CODE_MISSING_BEARER_TOKEN.String())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_MISSING_BEARER_TOKEN.String())
 	}
 	slog.Debug("Successfully extracted authorization header from gRPC request")
 
@@ -129,20 +224,20 @@ func (oidc *Authn) Authenticate(ctx context.Context) error {
 	})
 	if err != nil {
 		slog.Error("token parsing or validation failed", "error", err)
-		return errors.New(base.ErrorCode_ERROR_CODE_INVALID_BEARER_TOKEN.String())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_BEARER_TOKEN.String())
 	}
 
 	// Ensure the token is valid.
 	if !parsedToken.Valid {
 		slog.Warn("parsed token is invalid")
-		return errors.New(base.ErrorCode_ERROR_CODE_INVALID_BEARER_TOKEN.String())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_BEARER_TOKEN.String())
 	}
 
 	// Extract the claims from the token.
 	claims, ok := parsedToken.Claims.(jwt.MapClaims)
 	if !ok {
 		slog.Warn("token claims are in an incorrect format")
-		return errors.New(base.ErrorCode_ERROR_CODE_INVALID_CLAIMS.String())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_CLAIMS.String())
 	}
 
 	slog.Debug("extracted token claims", "claims", claims)
@@ -150,19 +245,19 @@ func (oidc *Authn) Authenticate(ctx context.Context) error {
 	// Verify the issuer of the token matches the expected issuer.
 	if ok := claims.VerifyIssuer(oidc.IssuerURL, true); !ok {
 		slog.Warn("token issuer is invalid", "expected", oidc.IssuerURL, "actual", claims["iss"])
-		return errors.New(base.ErrorCode_ERROR_CODE_INVALID_ISSUER.String())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_ISSUER.String())
 	}
 	// Verify the audience of the token matches the expected audience.
 
 	if ok := claims.VerifyAudience(oidc.Audience, true); !ok {
 		slog.Warn("token audience is invalid", "expected", oidc.Audience, "actual", claims["aud"])
-		return errors.New(base.ErrorCode_ERROR_CODE_INVALID_AUDIENCE.String())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_AUDIENCE.String())
 	}
 
 	slog.Info("token validation succeeded")
 
-	// If all validations pass, return nil indicating the token is valid.
-	return nil
+	// If all validations pass, return the claims of the valid token.
+	return claims, nil
 }
 
 // getKeyWithRetry attempts to retrieve the key for the given keyID with retries using a custom backoff strategy.
@@ -16,6 +16,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/Permify/permify/internal/authn"
 	"github.com/Permify/permify/internal/config"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 )
@@ -678,6 +679,115 @@ var _ = Describe("authn-oidc", func() {
 		})
 	})
 
+	Context("Grant", func() {
+		It("Case 1: maps the tenant claim and scopes to a grant", func() {
+			ctx := context.Background()
+			auth, err := NewOidcAuthn(ctx, config.Oidc{
+				Audience:          audience,
+				Issuer:            issuerURL,
+				RefreshInterval:   5 * time.Minute,
+				BackoffInterval:   12 * time.Second,
+				BackoffMaxRetries: 5,
+				BackoffFrequency:  5 * time.Second,
+				TenantClaim:       "tenants",
+				Scopes: map[string][]string{
+					"permify:read":  {"Permission", "Data.Read"},
+					"permify:write": {"Data.Write"},
+				},
+			})
+			Expect(err).To(BeNil())
+
+			tests := []struct {
+				name    string
+				claims  jwt.MapClaims
+				grant   *authn.Grant
+				wantErr string
+			}{
+				{
+					"Tenants and scopes are mapped",
+					jwt.MapClaims{"tenants": []interface{}{"t1", "t2"}, "scope": "openid permify:read"},
+					&authn.Grant{Tenants: []string{"t1", "t2"}, Methods: []string{"Permission", "Data.Read"}},
+					"",
+				},
+				{
+					"A single tenant is read from a string claim",
+					jwt.MapClaims{"tenants": "t1", "scope": "permify:read permify:write"},
+					&authn.Grant{Tenants: []string{"t1"}, Methods: []string{"Permission", "Data.Read", "Data.Write"}},
+					"",
+				},
+				{
+					"Missing tenant claim, it should fail",
+					jwt.MapClaims{"scope": "permify:read"},
+					nil,
+					base.ErrorCode_ERROR_CODE_INVALID_CLAIMS.String(),
+				},
+				{
+					"No configured scope, it should fail",
+					jwt.MapClaims{"tenants": "t1", "scope": "openid"},
+					nil,
+					base.ErrorCode_ERROR_CODE_METHOD_ACCESS_DENIED.String(),
+				},
+			}
+
+			for _, tt := range tests {
+				now := time.Now()
+				tt.claims["iss"] = issuerURL
+				tt.claims["sub"] = "user"
+				tt.claims["aud"] = []string{audience}
+				tt.claims["exp"] = now.AddDate(1, 0, 0).Unix()
+				tt.claims["iat"] = now.Unix()
+
+				unsignedToken := jwt.NewWithClaims(jwt.SigningMethodRS256, tt.claims)
+				unsignedToken.Header["kid"] = fakeOidcProvider.signingKeyMap[jwt.SigningMethodRS256]
+				idToken, err := fakeOidcProvider.SignIDToken(unsignedToken)
+				Expect(err).To(BeNil())
+
+				niceMd := make(metautils.NiceMD)
+				niceMd.Set("authorization", "Bearer "+idToken)
+				grant, err := auth.Grant(niceMd.ToIncoming(ctx))
+				if tt.wantErr == "" {
+					Expect(err).To(BeNil(), tt.name)
+					Expect(grant).To(Equal(tt.grant), tt.name)
+				} else {
+					Expect(err).To(HaveOccurred(), tt.name)
+					Expect(err.Error()).To(ContainSubstring(tt.wantErr), tt.name)
+				}
+			}
+		})
+
+		It("Case 2: tokens are not restricted without a tenant claim and scopes", func() {
+			ctx := context.Background()
+			auth, err := NewOidcAuthn(ctx, config.Oidc{
+				Audience:          audience,
+				Issuer:            issuerURL,
+				RefreshInterval:   5 * time.Minute,
+				BackoffInterval:   12 * time.Second,
+				BackoffMaxRetries: 5,
+				BackoffFrequency:  5 * time.Second,
+			})
+			Expect(err).To(BeNil())
+
+			now := time.Now()
+			unsignedToken := createUnsignedToken(jwt.RegisteredClaims{
+				Issuer:    issuerURL,
+				Subject:   "user",
+				Audience:  []string{audience},
+				ExpiresAt: &jwt.NumericDate{Time: now.AddDate(1, 0, 0)},
+				IssuedAt:  &jwt.NumericDate{Time: now},
+			}, jwt.SigningMethodRS256)
+			unsignedToken.Header["kid"] = fakeOidcProvider.signingKeyMap[jwt.SigningMethodRS256]
+			idToken, err := fakeOidcProvider.SignIDToken(unsignedToken)
+			Expect(err).To(BeNil())
+
+			niceMd := make(metautils.NiceMD)
+			niceMd.Set("authorization", "Bearer "+idToken)
+			subject, grant, err := auth.Identify(niceMd.ToIncoming(ctx))
+			Expect(err).To(BeNil())
+			Expect(subject).To(Equal("user"))
+			Expect(grant).To(BeNil())
+		})
+	})
+
 	Context("Context Cancellation During Retry", func() {
 		It("should handle context cancellation during retry", func() { // Test cancellation handling
 			// create authenticator with short backoff frequency to trigger retries quickly
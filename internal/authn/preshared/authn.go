@@ -2,6 +2,8 @@ package preshared
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -9,37 +11,87 @@ import (
 	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	"github.com/pkg/errors"
 
+	"github.com/Permify/permify/internal/authn"
 	"github.com/Permify/permify/internal/config"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 )
 
 // KeyAuthn - Authentication Keys Structure
 type KeyAuthn struct {
-	keys map[string]struct{}
+	// credentials of the keys
+	keys map[string]credential
+}
+
+// credential - Identity and grant of a key
+type credential struct {
+	// identity of the key, recorded instead of the key itself
+	id string
+	// grant of the key, nil for keys with access to every tenant and method
+	grant *authn.Grant
 }
 
 // NewKeyAuthn - Create New Authenticated Keys
 func NewKeyAuthn(_ context.Context, cfg config.Preshared) (*KeyAuthn, error) {
-	if len(cfg.Keys) < 1 {
+	if len(cfg.Keys) < 1 && len(cfg.Credentials) < 1 {
 		return nil, errors.New("pre shared key authn must have at least one key")
 	}
-	mapKeys := make(map[string]struct{})
+	mapKeys := make(map[string]credential)
 	for _, k := range cfg.Keys {
-		mapKeys[k] = struct{}{}
+		mapKeys[k] = credential{id: keyID(k)}
+	}
+	for _, c := range cfg.Credentials {
+		if c.Key == "" {
+			return nil, errors.New("pre shared key credential must have a key")
+		}
+		if _, ok := mapKeys[c.Key]; ok {
+			return nil, errors.New("pre shared key is defined more than once")
+		}
+		if len(c.Tenants) < 1 {
+			return nil, errors.New("pre shared key credential must have at least one tenant")
+		}
+		id := c.ID
+		if id == "" {
+			id = keyID(c.Key)
+		}
+		mapKeys[c.Key] = credential{
+			id: id,
+			grant: &authn.Grant{
+				Tenants: c.Tenants,
+				Methods: c.Methods,
+			},
+		}
 	}
 	return &KeyAuthn{
 		keys: mapKeys,
 	}, nil
 }
 
+// keyID - Identifies a key without revealing it
+func keyID(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return "key-" + hex.EncodeToString(sum[:4])
+}
+
 // Authenticate - Checking whether any API request contain keys
 func (a *KeyAuthn) Authenticate(ctx context.Context) error {
+	_, err := a.Grant(ctx)
+	return err
+}
+
+// Grant - Authenticates the key of the request and returns the tenants and methods it is bound to
+func (a *KeyAuthn) Grant(ctx context.Context) (*authn.Grant, error) {
+	_, grant, err := a.Identify(ctx)
+	return grant, err
+}
+
+// Identify - Authenticates the key of the request and returns its identifier and grant
+func (a *KeyAuthn) Identify(ctx context.Context) (string, *authn.Grant, error) {
 	key, err := grpcAuth.AuthFromMD(ctx, "Bearer")
 	if err != nil {
-		return errors.New(base.ErrorCode_ERROR_CODE_MISSING_BEARER_TOKEN.String())
+		return "", nil, errors.New(base.ErrorCode_ERROR_CODE_MISSING_BEARER_TOKEN.String())
 	}
-	if _, found := a.keys[key]; found {
-		return nil
+	if c, found := a.keys[key]; found {
+		return c.id, c.grant, nil
 	}
-	return status.Error(codes.Unauthenticated, base.ErrorCode_ERROR_CODE_INVALID_KEY.String())
+	return "", nil, status.Error(codes.Unauthenticated, base.ErrorCode_ERROR_CODE_INVALID_KEY.String())
 }
@@ -92,4 +92,72 @@ var _ = Describe("KeyAuthn", func() {
 			})
 		})
 	})
+
+	Describe("Grant", func() {
+		BeforeEach(func() {
+			keysConfig = config.Preshared{
+				Keys: []string{"admin"},
+				Credentials: []config.PresharedCredential{
+					{Key: "customer", Tenants: []string{"t1"}, Methods: []string{"Permission", "Data.Write"}},
+				},
+			}
+			authenticator, err = NewKeyAuthn(context.Background(), keysConfig)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should not restrict keys without a credential", func() {
+			md := metadata.New(map[string]string{"authorization": "Bearer admin"})
+			grant, err := authenticator.Grant(metadata.NewIncomingContext(context.Background(), md))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(grant).To(BeNil())
+		})
+
+		It("should return the tenants and methods of a credential", func() {
+			md := metadata.New(map[string]string{"authorization": "Bearer customer"})
+			grant, err := authenticator.Grant(metadata.NewIncomingContext(context.Background(), md))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(grant.Tenants).To(Equal([]string{"t1"}))
+			Expect(grant.Methods).To(Equal([]string{"Permission", "Data.Write"}))
+		})
+
+		It("should identify keys by their credential id or a digest", func() {
+			authenticator, err = NewKeyAuthn(context.Background(), config.Preshared{
+				Keys: []string{"admin"},
+				Credentials: []config.PresharedCredential{
+					{ID: "billing", Key: "customer", Tenants: []string{"t1"}},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			md := metadata.New(map[string]string{"authorization": "Bearer customer"})
+			identity, _, err := authenticator.Identify(metadata.NewIncomingContext(context.Background(), md))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(identity).To(Equal("billing"))
+
+			md = metadata.New(map[string]string{"authorization": "Bearer admin"})
+			identity, _, err = authenticator.Identify(metadata.NewIncomingContext(context.Background(), md))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(identity).To(HavePrefix("key-"))
+			Expect(identity).ToNot(ContainSubstring("admin"))
+		})
+
+		It("should reject unknown keys", func() {
+			md := metadata.New(map[string]string{"authorization": "Bearer unknown"})
+			_, err := authenticator.Grant(metadata.NewIncomingContext(context.Background(), md))
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+
+		It("should reject credentials without tenants or with duplicated keys", func() {
+			_, err := NewKeyAuthn(context.Background(), config.Preshared{
+				Credentials: []config.PresharedCredential{{Key: "customer"}},
+			})
+			Expect(err).To(HaveOccurred())
+
+			_, err = NewKeyAuthn(context.Background(), config.Preshared{
+				Keys:        []string{"customer"},
+				Credentials: []config.PresharedCredential{{Key: "customer", Tenants: []string{"t1"}}},
+			})
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
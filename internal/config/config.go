@@ -18,6 +18,8 @@ type (
 		Log         Log         `mapstructure:"logger"`      // Logging configuration
 		Profiler    Profiler    `mapstructure:"profiler"`    // Profiler configuration
 		Authn       Authn       `mapstructure:"authn"`       // Authentication configuration
+		Audit       Audit       `mapstructure:"audit"`       // Audit log configuration
+		DecisionLog DecisionLog `mapstructure:"decisions"`   // Decision log configuration
 		Tracer      Tracer      `mapstructure:"tracer"`      // Tracing configuration
 		Meter       Meter       `mapstructure:"meter"`       // Metrics configuration
 		Service     Service     `mapstructure:"service"`     // Service configuration
@@ -63,22 +65,49 @@ type (
 		Method    string    `mapstructure:"method"`    // The authentication method to be used
 		Preshared Preshared `mapstructure:"preshared"` // Configuration for preshared key authentication
 		Oidc      Oidc      `mapstructure:"oidc"`      // Configuration for OIDC authentication
+		Mtls      Mtls      `mapstructure:"mtls"`      // Configuration for client certificate authentication
 	}
 
 	// Preshared contains configuration for preshared key authentication.
 	Preshared struct {
-		Keys []string `mapstructure:"keys"` // List of preshared keys
+		Keys        []string              `mapstructure:"keys"`        // List of preshared keys with access to every tenant and method
+		Credentials []PresharedCredential `mapstructure:"credentials"` // List of preshared keys bound to tenants and methods
+	}
+
+	// PresharedCredential contains a preshared key and what it may access.
+	PresharedCredential struct {
+		ID      string   `mapstructure:"id"`      // Identifier of the key recorded in the audit log, defaults to a digest of the key
+		Key     string   `mapstructure:"key"`     // Preshared key
+		Tenants []string `mapstructure:"tenants"` // Tenants the key may access, "*" for every tenant
+		Methods []string `mapstructure:"methods"` // Services or methods the key may call, e.g. "Permission" or "Data.Write"
 	}
 	// OIDC configuration structure
 	// Oidc contains configuration for OIDC authentication.
 	Oidc struct { // OIDC authentication config
-		Issuer            string        `mapstructure:"issuer"`   // OIDC issuer URL
-		Audience          string        `mapstructure:"audience"` // OIDC client ID
-		RefreshInterval   time.Duration `mapstructure:"refresh_interval"`
-		BackoffInterval   time.Duration `mapstructure:"backoff_interval"`
-		BackoffFrequency  time.Duration `mapstructure:"backoff_frequency"`
-		BackoffMaxRetries int           `mapstructure:"backoff_max_retries"`
-		ValidMethods      []string      `mapstructure:"valid_methods"`
+		Issuer            string              `mapstructure:"issuer"`   // OIDC issuer URL
+		Audience          string              `mapstructure:"audience"` // OIDC client ID
+		RefreshInterval   time.Duration       `mapstructure:"refresh_interval"`
+		BackoffInterval   time.Duration       `mapstructure:"backoff_interval"`
+		BackoffFrequency  time.Duration       `mapstructure:"backoff_frequency"`
+		BackoffMaxRetries int                 `mapstructure:"backoff_max_retries"`
+		ValidMethods      []string            `mapstructure:"valid_methods"`
+		TenantClaim       string              `mapstructure:"tenant_claim"` // Claim holding the tenant or tenants the token may access, empty allows every tenant
+		ScopeClaim        string              `mapstructure:"scope_claim"`  // Claim holding the scopes of the token
+		Scopes            map[string][]string `mapstructure:"scopes"`       // Services or methods each scope may call, empty allows every method
+	}
+
+	// Mtls contains configuration for client certificate authentication.
+	Mtls struct {
+		CAPath         string         `mapstructure:"ca"`              // Path to the PEM bundle of the certificate authorities of the clients
+		ReloadInterval time.Duration  `mapstructure:"reload_interval"` // Interval at which the CA bundle is reloaded from disk if it changed
+		Identities     []MtlsIdentity `mapstructure:"identities"`      // Identities bound to tenants and methods, empty allows every identity
+	}
+
+	// MtlsIdentity contains a client certificate identity and what it may access.
+	MtlsIdentity struct {
+		Identity string   `mapstructure:"identity"` // URI, DNS name or common name of the certificate, a trailing "*" matches any suffix
+		Tenants  []string `mapstructure:"tenants"`  // Tenants the identity may access, "*" for every tenant
+		Methods  []string `mapstructure:"methods"`  // Services or methods the identity may call, e.g. "Permission" or "Data.Write"
 	}
 
 	// Profiler contains configuration for the profiler.
@@ -101,6 +130,67 @@ type (
 		ServiceName string   `mapstructure:"service_name"` // Override the service name reported by the exporter (default: "permify")
 	}
 
+	// Audit contains configuration for the audit log of the mutations made through the API.
+	Audit struct {
+		Enabled  bool          `mapstructure:"enabled"`  // Whether mutations are recorded
+		File     AuditFile     `mapstructure:"file"`     // Configuration for the local JSONL file sink
+		Postgres AuditPostgres `mapstructure:"postgres"` // Configuration for the Postgres table sink
+		Otlp     AuditOtlp     `mapstructure:"otlp"`     // Configuration for the OTLP logs sink
+	}
+
+	// AuditFile contains configuration for the audit sink writing JSON lines to a local file.
+	AuditFile struct {
+		Enabled    bool   `mapstructure:"enabled"`     // Whether records are written to the file
+		Path       string `mapstructure:"path"`        // Path of the file
+		MaxSize    int    `mapstructure:"max_size"`    // Size in megabytes at which the file is rotated
+		MaxBackups int    `mapstructure:"max_backups"` // Number of rotated files kept
+	}
+
+	// AuditPostgres contains configuration for the audit sink writing to the audit_records table of the database.
+	AuditPostgres struct {
+		Enabled bool `mapstructure:"enabled"` // Whether records are written to the database, required to query them
+	}
+
+	// AuditOtlp contains configuration for the audit sink exporting records as OTLP logs.
+	AuditOtlp struct {
+		Enabled  bool     `mapstructure:"enabled"`  // Whether records are exported
+		Endpoint string   `mapstructure:"endpoint"` // Endpoint of the collector
+		Insecure bool     `mapstructure:"insecure"` // Connect to the collector using the HTTP scheme, instead of HTTPS.
+		Urlpath  string   `mapstructure:"urlpath"`  // Path for the exporter, if not defined /v1/logs will be used
+		Headers  []string `mapstructure:"headers"`
+		Protocol string   `mapstructure:"protocol"` // Protocol for the exporter, http or grpc
+	}
+
+	// DecisionLog contains configuration for logging the answers of permission checks and lookups.
+	DecisionLog struct {
+		Enabled       bool                `mapstructure:"enabled"`        // Whether decisions are logged
+		SampleRate    float64             `mapstructure:"sample_rate"`    // Fraction of the decisions logged when no rate matches
+		Rates         []DecisionLogRate   `mapstructure:"rates"`          // Sampling rates of tenants and permissions
+		RedactContext bool                `mapstructure:"redact_context"` // Whether the values of the contextual data and attributes are redacted
+		File          DecisionLogFile     `mapstructure:"file"`           // Configuration for the local JSONL file sink
+		Exporter      DecisionLogExporter `mapstructure:"exporter"`       // Configuration for the sink sharing the log exporter
+	}
+
+	// DecisionLogRate contains the sampling rate of the decisions of a tenant, a permission, or a permission of a tenant.
+	DecisionLogRate struct {
+		Tenant     string  `mapstructure:"tenant"`     // Tenant the rate applies to, any tenant if empty
+		Permission string  `mapstructure:"permission"` // Permission the rate applies to, any permission if empty
+		Rate       float64 `mapstructure:"rate"`       // Fraction of the matching decisions logged, between 0 and 1
+	}
+
+	// DecisionLogFile contains configuration for the decision sink writing JSON lines to a local file.
+	DecisionLogFile struct {
+		Enabled    bool   `mapstructure:"enabled"`     // Whether decisions are written to the file
+		Path       string `mapstructure:"path"`        // Path of the file
+		MaxSize    int    `mapstructure:"max_size"`    // Size in megabytes at which the file is rotated
+		MaxBackups int    `mapstructure:"max_backups"` // Number of rotated files kept
+	}
+
+	// DecisionLogExporter contains configuration for the decision sink emitting records through the log exporter of the logger section.
+	DecisionLogExporter struct {
+		Enabled bool `mapstructure:"enabled"` // Whether decisions are emitted through the log exporter
+	}
+
 	// Tracer contains configuration for distributed tracing.
 	Tracer struct {
 		Enabled     bool     `mapstructure:"enabled"`  // Whether tracing collection is enabled
@@ -142,7 +232,22 @@ type (
 
 	// Schema contains configuration for the schema service.
 	Schema struct {
-		Cache Cache `mapstructure:"cache"` // Cache configuration for the schema service
+		Cache  Cache  `mapstructure:"cache"`  // Cache configuration for the schema service
+		Lint   Lint   `mapstructure:"lint"`   // Lint configuration for the schema service
+		Shadow Shadow `mapstructure:"shadow"` // Shadow evaluation configuration for the schema service
+	}
+
+	// Lint contains configuration for the schema linter.
+	Lint struct {
+		Rules map[string]string `mapstructure:"rules"` // Severity overrides keyed by rule, one of error, warning, info or off
+	}
+
+	// Shadow contains configuration for evaluating the checks of the served schema versions against shadow versions.
+	Shadow struct {
+		Enabled         bool          `mapstructure:"enabled"`          // Whether checks are evaluated against shadow versions
+		Concurrency     int           `mapstructure:"concurrency"`      // Maximum number of evaluations in progress, further checks are not evaluated
+		RefreshInterval time.Duration `mapstructure:"refresh_interval"` // How long the shadow version of a tenant is cached
+		Timeout         time.Duration `mapstructure:"timeout"`          // Timeout of an evaluation
 	}
 
 	// Permission contains configuration for the permission service.
@@ -169,7 +274,10 @@ type (
 			URI string `mapstructure:"uri"`
 		} `mapstructure:"writer"`
 		Reader struct {
-			URI string `mapstructure:"uri"`
+			URI                 string        `mapstructure:"uri"`
+			URIs                []string      `mapstructure:"uris"`                  // Additional read replicas, reads are routed among the healthy ones
+			ReplicaWait         time.Duration `mapstructure:"replica_wait"`          // How long a read waits for a replica to replay the transaction of its snap token before it is sent to the writer
+			HealthCheckInterval time.Duration `mapstructure:"health_check_interval"` // Period between the checks of the health and lag of the replicas
 		} `mapstructure:"reader"`
 		AutoMigrate                 bool              `mapstructure:"auto_migrate"`            // Whether to enable automatic migration
 		MaxConnections              int               `mapstructure:"max_connections"`         // Maximum number of connections in the pool (maps to pgxpool MaxConns)
@@ -185,6 +293,8 @@ type (
 		MaxDataPerWrite             int               `mapstructure:"max_data_per_write"`
 		MaxRetries                  int               `mapstructure:"max_retries"`
 		WatchBufferSize             int               `mapstructure:"watch_buffer_size"`
+		IdempotencyKeyRetention     time.Duration     `mapstructure:"idempotency_key_retention"` // How long the idempotency key of a write is remembered
+		SnapshotRefreshInterval     time.Duration     `mapstructure:"snapshot_refresh_interval"` // How long the recent snapshot shared by the reads that minimize latency is used
 		GarbageCollection           GarbageCollection `mapstructure:"garbage_collection"`
 	}
 
@@ -318,6 +428,29 @@ func DefaultConfig() *Config {
 			Protocol:    "http",
 			ServiceName: "permify",
 		},
+		Audit: Audit{
+			Enabled: false,
+			File: AuditFile{
+				Path:       "permify-audit.jsonl",
+				MaxSize:    100,
+				MaxBackups: 10,
+			},
+			Otlp: AuditOtlp{
+				Headers:  []string{},
+				Protocol: "http",
+			},
+		},
+		DecisionLog: DecisionLog{
+			Enabled:       false,
+			SampleRate:    1,
+			Rates:         []DecisionLogRate{},
+			RedactContext: true,
+			File: DecisionLogFile{
+				Path:       "permify-decisions.jsonl",
+				MaxSize:    100,
+				MaxBackups: 10,
+			},
+		},
 		Tracer: Tracer{
 			Enabled:     false,
 			Headers:     []string{},
@@ -343,6 +476,12 @@ func DefaultConfig() *Config {
 					NumberOfCounters: 1_000,
 					MaxCost:          "10MiB",
 				},
+				Shadow: Shadow{
+					Enabled:         false,
+					Concurrency:     10,
+					RefreshInterval: 10 * time.Second,
+					Timeout:         10 * time.Second,
+				},
 			},
 			Permission: Permission{
 				BulkLimit:        100,
@@ -360,9 +499,13 @@ func DefaultConfig() *Config {
 			Oidc: Oidc{
 				RefreshInterval:   time.Minute * 15,
 				ValidMethods:      []string{"RS256", "HS256"},
+				ScopeClaim:        "scope",
 				BackoffMaxRetries: 5,
 				BackoffInterval:   12 * time.Second,
 			},
+			Mtls: Mtls{
+				ReloadInterval: time.Minute,
+			},
 		},
 		Database: Database{ // Database configuration
 			Engine:                      "memory",          // In-memory database
@@ -380,6 +523,8 @@ func DefaultConfig() *Config {
 			MaxDataPerWrite:             1000,              // Max data per write
 			MaxRetries:                  10,                // Max retries
 			WatchBufferSize:             100,               // Watch buffer size
+			IdempotencyKeyRetention:     time.Hour * 24,    // Idempotency key retention
+			SnapshotRefreshInterval:     time.Second * 5,   // Snapshot refresh interval
 			GarbageCollection: GarbageCollection{
 				Enabled: false,
 			},
@@ -0,0 +1,308 @@
+package decision
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/xid"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/Permify/permify/internal/authn"
+	"github.com/Permify/permify/internal/config"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Methods whose decisions are logged
+const (
+	MethodCheck              = "Check"
+	MethodBulkCheck          = "BulkCheck"
+	MethodLookupEntity       = "LookupEntity"
+	MethodLookupEntityStream = "LookupEntityStream"
+	MethodLookupSubject      = "LookupSubject"
+	MethodSubjectPermission  = "SubjectPermission"
+)
+
+// Redacted - Value replacing the redacted values of the contextual data
+const Redacted = "[REDACTED]"
+
+// Record - Decision made by the permission engines. The request and the result are the protojson
+// encodings of the request and response messages of the method, so a record can be replayed. The
+// decisions of the items of a bulk request share the request id, other decisions are requests of their own.
+type Record struct {
+	ID            string          `json:"id"`
+	RequestID     string          `json:"request_id"`
+	Time          time.Time       `json:"time"`
+	TenantID      string          `json:"tenant_id"`
+	Method        string          `json:"method"`
+	Actor         string          `json:"actor,omitempty"`
+	SnapToken     string          `json:"snap_token"`
+	SchemaVersion string          `json:"schema_version"`
+	LatencyMs     float64         `json:"latency_ms"`
+	CheckCount    int32           `json:"check_count"`
+	CacheHit      bool            `json:"cache_hit"`
+	Redacted      bool            `json:"redacted,omitempty"`
+	Request       json.RawMessage `json:"request"`
+	Result        json.RawMessage `json:"result,omitempty"`
+	Error         string          `json:"error,omitempty"`
+}
+
+// Sink - Destination of decision records
+type Sink interface {
+	// Write appends a record to the sink.
+	Write(ctx context.Context, record *Record) error
+	// Close flushes and releases the sink.
+	Close(ctx context.Context) error
+}
+
+// Logger - Logs a sample of the decisions to the configured sinks
+type Logger struct {
+	sinks         []Sink
+	sampleRate    float64
+	rates         []config.DecisionLogRate
+	redactContext bool
+}
+
+// NewLogger - Creates a logger writing the decisions sampled by the configuration to the given sinks
+func NewLogger(conf config.DecisionLog, sinks ...Sink) *Logger {
+	return &Logger{
+		sinks:         sinks,
+		sampleRate:    conf.SampleRate,
+		rates:         conf.Rates,
+		redactContext: conf.RedactContext,
+	}
+}
+
+// New - Creates a logger writing to the sinks enabled in the configuration. The exporter sink
+// emits the records through the log exporter of the logger section.
+func New(conf config.DecisionLog, logConf config.Log) (*Logger, error) {
+	for _, rate := range conf.Rates {
+		if rate.Rate < 0 || rate.Rate > 1 {
+			return nil, fmt.Errorf("decision log rate of tenant '%s' and permission '%s' must be between 0 and 1", rate.Tenant, rate.Permission)
+		}
+	}
+	if conf.SampleRate < 0 || conf.SampleRate > 1 {
+		return nil, errors.New("decision log sample rate must be between 0 and 1")
+	}
+
+	var sinks []Sink
+
+	if conf.File.Enabled {
+		sink, err := NewFileSink(conf.File.Path, conf.File.MaxSize, conf.File.MaxBackups)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+
+	if conf.Exporter.Enabled {
+		if !logConf.Enabled {
+			return nil, errors.New("decision log exporter sink requires the log exporter to be enabled")
+		}
+		sink, err := NewExporterSink(logConf)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+
+	if len(sinks) == 0 {
+		return nil, errors.New("decision log requires at least one sink")
+	}
+
+	return NewLogger(conf, sinks...), nil
+}
+
+// Close - Closes every sink
+func (l *Logger) Close(ctx context.Context) error {
+	var errs []error
+	for _, sink := range l.sinks {
+		errs = append(errs, sink.Close(ctx))
+	}
+	return errors.Join(errs...)
+}
+
+// Tracker - Collects the statistics of a decision while it is evaluated
+type Tracker struct {
+	start     time.Time
+	checks    atomic.Int32
+	cacheHits atomic.Int32
+}
+
+type trackerKey struct{}
+
+// bulkRequest - Request whose items are decided one by one
+type bulkRequest struct {
+	id     string
+	method string
+}
+
+type bulkKey struct{}
+
+// Track - Starts tracking the decision evaluated with the returned context. The tracker is nil if the
+// logger is nil or the context already evaluates a decision, in which case the call is a sub problem
+// of that decision and is not logged on its own.
+func (l *Logger) Track(ctx context.Context) (context.Context, *Tracker) {
+	if l == nil {
+		return ctx, nil
+	}
+	if _, ok := ctx.Value(trackerKey{}).(*Tracker); ok {
+		return ctx, nil
+	}
+	tracker := &Tracker{start: time.Now()}
+	return context.WithValue(ctx, trackerKey{}, tracker), tracker
+}
+
+// Untracked - Returns a context whose checks and lookups are not logged as decisions, for evaluations
+// made by the server itself rather than asked by a client.
+func Untracked(ctx context.Context) context.Context {
+	return context.WithValue(ctx, trackerKey{}, (*Tracker)(nil))
+}
+
+// Bulk - Returns a context whose decisions are logged as the items of one request of the given method,
+// under the same request id.
+func Bulk(ctx context.Context, method string) context.Context {
+	return context.WithValue(ctx, bulkKey{}, &bulkRequest{id: xid.New().String(), method: method})
+}
+
+// CountCheck - Counts a check evaluated by the check engine for the decision of the context, if any
+func CountCheck(ctx context.Context) {
+	if tracker, ok := ctx.Value(trackerKey{}).(*Tracker); ok && tracker != nil {
+		tracker.checks.Add(1)
+	}
+}
+
+// CountCacheHit - Counts a check answered from the cache for the decision of the context, if any
+func CountCacheHit(ctx context.Context) {
+	if tracker, ok := ctx.Value(trackerKey{}).(*Tracker); ok && tracker != nil {
+		tracker.cacheHits.Add(1)
+	}
+}
+
+// Log - Writes the decision of a tracker to every sink if it is sampled. Failing sinks are logged and do not fail the request.
+func (l *Logger) Log(ctx context.Context, tracker *Tracker, method string, request, response proto.Message, err error) {
+	if l == nil || tracker == nil {
+		return
+	}
+
+	tenantID := ""
+	if r, ok := request.(interface{ GetTenantId() string }); ok {
+		tenantID = r.GetTenantId()
+	}
+	permission := ""
+	if r, ok := request.(interface{ GetPermission() string }); ok {
+		permission = r.GetPermission()
+	}
+	if !l.sampled(tenantID, permission) {
+		return
+	}
+
+	record := &Record{
+		ID:         xid.New().String(),
+		Time:       tracker.start.UTC(),
+		TenantID:   tenantID,
+		Method:     method,
+		Actor:      authn.IdentityFromContext(ctx),
+		LatencyMs:  float64(time.Since(tracker.start).Microseconds()) / 1000,
+		CheckCount: tracker.checks.Load(),
+		CacheHit:   tracker.cacheHits.Load() > 0,
+	}
+	record.RequestID = record.ID
+
+	// The items of a bulk request are logged under the request id and method of the request.
+	if bulk, ok := ctx.Value(bulkKey{}).(*bulkRequest); ok {
+		record.RequestID, record.Method = bulk.id, bulk.method
+	}
+	record.SnapToken, record.SchemaVersion = metadata(request)
+
+	if l.redactContext {
+		request, record.Redacted = redact(request)
+	}
+
+	marshaler := protojson.MarshalOptions{UseProtoNames: true}
+	var merr error
+	record.Request, merr = marshaler.Marshal(request)
+	if merr == nil && err == nil && response != nil {
+		record.Result, merr = marshaler.Marshal(response)
+	}
+	if merr != nil {
+		slog.ErrorContext(ctx, "failed to encode decision", slog.String("id", record.ID), slog.Any("error", merr))
+		return
+	}
+	if err != nil {
+		record.Error = status.Convert(err).Message()
+	}
+
+	for _, sink := range l.sinks {
+		if werr := sink.Write(ctx, record); werr != nil {
+			slog.ErrorContext(ctx, "failed to write decision", slog.String("sink", fmt.Sprintf("%T", sink)), slog.String("id", record.ID), slog.Any("error", werr))
+		}
+	}
+}
+
+// sampled - Draws whether a decision of the tenant and permission is logged. The rate of the most
+// specific matching rule applies: tenant and permission, then tenant, then permission, then the default.
+func (l *Logger) sampled(tenantID, permission string) bool {
+	rate, specificity := l.sampleRate, 0
+	for _, r := range l.rates {
+		if (r.Tenant != "" && r.Tenant != tenantID) || (r.Permission != "" && r.Permission != permission) {
+			continue
+		}
+		s := 1
+		if r.Permission != "" {
+			s = 2
+		}
+		if r.Tenant != "" {
+			s += 2
+		}
+		if s > specificity {
+			rate, specificity = r.Rate, s
+		}
+	}
+	if rate >= 1 {
+		return true
+	}
+	return rand.Float64() < rate
+}
+
+// metadata - Returns the snap token and schema version of a request
+func metadata(request proto.Message) (snapToken, schemaVersion string) {
+	switch r := request.(type) {
+	case *base.PermissionCheckRequest:
+		return r.GetMetadata().GetSnapToken(), r.GetMetadata().GetSchemaVersion()
+	case *base.PermissionLookupEntityRequest:
+		return r.GetMetadata().GetSnapToken(), r.GetMetadata().GetSchemaVersion()
+	case *base.PermissionLookupSubjectRequest:
+		return r.GetMetadata().GetSnapToken(), r.GetMetadata().GetSchemaVersion()
+	case *base.PermissionSubjectPermissionRequest:
+		return r.GetMetadata().GetSnapToken(), r.GetMetadata().GetSchemaVersion()
+	}
+	return "", ""
+}
+
+// redact - Returns a copy of the request whose contextual data values and attribute values are redacted.
+// The keys of the data and the entities and names of the attributes are kept.
+func redact(request proto.Message) (proto.Message, bool) {
+	r, ok := request.(interface{ GetContext() *base.Context })
+	if !ok || (len(r.GetContext().GetData().GetFields()) == 0 && len(r.GetContext().GetAttributes()) == 0) {
+		return request, false
+	}
+
+	request = proto.Clone(request)
+	c := request.(interface{ GetContext() *base.Context }).GetContext()
+	for key := range c.GetData().GetFields() {
+		c.Data.Fields[key] = structpb.NewStringValue(Redacted)
+	}
+	for _, attribute := range c.GetAttributes() {
+		attribute.Value = nil
+	}
+	return request, true
+}
@@ -0,0 +1,308 @@
+package decision
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/internal/authn"
+	"github.com/Permify/permify/internal/config"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+func TestDecision(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "decision suite")
+}
+
+// memorySink - Sink keeping its records in memory
+type memorySink struct {
+	records []*Record
+	err     error
+}
+
+func (s *memorySink) Write(_ context.Context, record *Record) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *memorySink) Close(context.Context) error {
+	return nil
+}
+
+// checkRequest - Returns a check request of the given tenant and permission with contextual data
+func checkRequest(tenantID, permission string) *base.PermissionCheckRequest {
+	data, _ := structpb.NewStruct(map[string]interface{}{"ip_address": "10.0.0.1"})
+	value, _ := anypb.New(&base.StringValue{Data: "secret"})
+	return &base.PermissionCheckRequest{
+		TenantId: tenantID,
+		Metadata: &base.PermissionCheckRequestMetadata{
+			SnapToken:     "snap",
+			SchemaVersion: "v1",
+			Depth:         20,
+		},
+		Entity:     &base.Entity{Type: "doc", Id: "1"},
+		Permission: permission,
+		Subject:    &base.Subject{Type: "user", Id: "1"},
+		Context: &base.Context{
+			Attributes: []*base.Attribute{{Entity: &base.Entity{Type: "doc", Id: "1"}, Attribute: "classification", Value: value}},
+			Data:       data,
+		},
+	}
+}
+
+var _ = Describe("decision", func() {
+	allowed := &base.PermissionCheckResponse{Can: base.CheckResult_CHECK_RESULT_ALLOWED}
+
+	Context("Track", func() {
+		It("Case 1: tracks only the outermost decision", func() {
+			logger := NewLogger(config.DecisionLog{SampleRate: 1})
+
+			ctx, tracker := logger.Track(context.Background())
+			Expect(tracker).ShouldNot(BeNil())
+
+			_, nested := logger.Track(ctx)
+			Expect(nested).Should(BeNil())
+
+			CountCheck(ctx)
+			CountCheck(ctx)
+			CountCacheHit(ctx)
+			Expect(tracker.checks.Load()).Should(Equal(int32(2)))
+			Expect(tracker.cacheHits.Load()).Should(Equal(int32(1)))
+		})
+
+		It("Case 2: does not track without a logger", func() {
+			var logger *Logger
+			ctx, tracker := logger.Track(context.Background())
+			Expect(tracker).Should(BeNil())
+
+			// counting and logging without a tracker are no-ops
+			CountCheck(ctx)
+			logger.Log(ctx, tracker, MethodCheck, checkRequest("t1", "view"), allowed, nil)
+		})
+
+		It("Case 3: does not track untracked contexts", func() {
+			logger := NewLogger(config.DecisionLog{SampleRate: 1})
+
+			ctx, tracker := logger.Track(Untracked(context.Background()))
+			Expect(tracker).Should(BeNil())
+
+			CountCheck(ctx)
+			CountCacheHit(ctx)
+		})
+	})
+
+	Context("Log", func() {
+		It("Case 1: records the decision", func() {
+			sink := &memorySink{}
+			logger := NewLogger(config.DecisionLog{SampleRate: 1}, sink)
+
+			ctx := authn.ContextWithIdentity(context.Background(), "service-a")
+			ctx, tracker := logger.Track(ctx)
+			CountCheck(ctx)
+			CountCacheHit(ctx)
+			logger.Log(ctx, tracker, MethodCheck, checkRequest("t1", "view"), allowed, nil)
+
+			Expect(sink.records).Should(HaveLen(1))
+			record := sink.records[0]
+			Expect(record.ID).ShouldNot(BeEmpty())
+			Expect(record.TenantID).Should(Equal("t1"))
+			Expect(record.Method).Should(Equal(MethodCheck))
+			Expect(record.Actor).Should(Equal("service-a"))
+			Expect(record.SnapToken).Should(Equal("snap"))
+			Expect(record.SchemaVersion).Should(Equal("v1"))
+			Expect(record.CheckCount).Should(Equal(int32(1)))
+			Expect(record.CacheHit).Should(BeTrue())
+			Expect(record.Redacted).Should(BeFalse())
+			Expect(record.Error).Should(BeEmpty())
+
+			request := &base.PermissionCheckRequest{}
+			Expect(protojson.Unmarshal(record.Request, request)).Should(Succeed())
+			Expect(request.GetContext().GetData().GetFields()["ip_address"].GetStringValue()).Should(Equal("10.0.0.1"))
+
+			response := &base.PermissionCheckResponse{}
+			Expect(protojson.Unmarshal(record.Result, response)).Should(Succeed())
+			Expect(response.GetCan()).Should(Equal(base.CheckResult_CHECK_RESULT_ALLOWED))
+		})
+
+		It("Case 2: records the error of a failed decision", func() {
+			sink := &memorySink{}
+			logger := NewLogger(config.DecisionLog{SampleRate: 1}, sink)
+
+			ctx, tracker := logger.Track(context.Background())
+			err := status.Error(codes.NotFound, base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String())
+			logger.Log(ctx, tracker, MethodLookupEntity, &base.PermissionLookupEntityRequest{TenantId: "t1"}, nil, err)
+
+			Expect(sink.records).Should(HaveLen(1))
+			Expect(sink.records[0].Method).Should(Equal(MethodLookupEntity))
+			Expect(sink.records[0].Error).Should(Equal(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String()))
+			Expect(sink.records[0].Result).Should(BeEmpty())
+		})
+
+		It("Case 3: redacts the contextual data without changing the request", func() {
+			sink := &memorySink{}
+			logger := NewLogger(config.DecisionLog{SampleRate: 1, RedactContext: true}, sink)
+
+			original := checkRequest("t1", "view")
+			ctx, tracker := logger.Track(context.Background())
+			logger.Log(ctx, tracker, MethodCheck, original, allowed, nil)
+
+			Expect(sink.records).Should(HaveLen(1))
+			Expect(sink.records[0].Redacted).Should(BeTrue())
+
+			request := &base.PermissionCheckRequest{}
+			Expect(protojson.Unmarshal(sink.records[0].Request, request)).Should(Succeed())
+			Expect(request.GetContext().GetData().GetFields()["ip_address"].GetStringValue()).Should(Equal(Redacted))
+			Expect(request.GetContext().GetAttributes()).Should(HaveLen(1))
+			Expect(request.GetContext().GetAttributes()[0].GetAttribute()).Should(Equal("classification"))
+			Expect(request.GetContext().GetAttributes()[0].GetValue()).Should(BeNil())
+
+			Expect(original.GetContext().GetData().GetFields()["ip_address"].GetStringValue()).Should(Equal("10.0.0.1"))
+			Expect(original.GetContext().GetAttributes()[0].GetValue()).ShouldNot(BeNil())
+		})
+
+		It("Case 4: samples by the most specific rate", func() {
+			sink := &memorySink{}
+			logger := NewLogger(config.DecisionLog{
+				SampleRate: 0,
+				Rates: []config.DecisionLogRate{
+					{Tenant: "t1", Rate: 1},
+					{Tenant: "t1", Permission: "edit", Rate: 0},
+					{Permission: "delete", Rate: 1},
+				},
+			}, sink)
+
+			for _, r := range []struct{ tenant, permission string }{
+				{"t1", "view"},
+				{"t1", "edit"},
+				{"t2", "view"},
+				{"t2", "delete"},
+			} {
+				ctx, tracker := logger.Track(context.Background())
+				logger.Log(ctx, tracker, MethodCheck, checkRequest(r.tenant, r.permission), allowed, nil)
+			}
+
+			var logged []string
+			for _, record := range sink.records {
+				request := &base.PermissionCheckRequest{}
+				Expect(protojson.Unmarshal(record.Request, request)).Should(Succeed())
+				logged = append(logged, record.TenantID+"/"+request.GetPermission())
+			}
+			Expect(logged).Should(Equal([]string{"t1/view", "t2/delete"}))
+		})
+
+		It("Case 5: does not fail on a failing sink", func() {
+			failing := &memorySink{err: errors.New("unavailable")}
+			sink := &memorySink{}
+			logger := NewLogger(config.DecisionLog{SampleRate: 1}, failing, sink)
+
+			ctx, tracker := logger.Track(context.Background())
+			logger.Log(ctx, tracker, MethodCheck, checkRequest("t1", "view"), allowed, nil)
+			Expect(sink.records).Should(HaveLen(1))
+		})
+
+		It("Case 6: records the items of a bulk request under one request id", func() {
+			sink := &memorySink{}
+			logger := NewLogger(config.DecisionLog{SampleRate: 1}, sink)
+
+			ctx, tracker := logger.Track(context.Background())
+			logger.Log(ctx, tracker, MethodCheck, checkRequest("t1", "view"), allowed, nil)
+
+			bulk := Bulk(context.Background(), MethodBulkCheck)
+			for _, permission := range []string{"view", "edit"} {
+				ctx, tracker := logger.Track(bulk)
+				logger.Log(ctx, tracker, MethodCheck, checkRequest("t1", permission), allowed, nil)
+			}
+
+			Expect(sink.records).Should(HaveLen(3))
+			Expect(sink.records[0].Method).Should(Equal(MethodCheck))
+			Expect(sink.records[0].RequestID).Should(Equal(sink.records[0].ID))
+
+			Expect(sink.records[1].Method).Should(Equal(MethodBulkCheck))
+			Expect(sink.records[2].Method).Should(Equal(MethodBulkCheck))
+			Expect(sink.records[1].ID).ShouldNot(Equal(sink.records[2].ID))
+			Expect(sink.records[1].RequestID).ShouldNot(Equal(sink.records[1].ID))
+			Expect(sink.records[1].RequestID).Should(Equal(sink.records[2].RequestID))
+		})
+	})
+
+	Context("New", func() {
+		It("Case 1: requires a sink", func() {
+			_, err := New(config.DecisionLog{Enabled: true, SampleRate: 1}, config.Log{})
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("Case 2: requires the log exporter for the exporter sink", func() {
+			_, err := New(config.DecisionLog{Enabled: true, SampleRate: 1, Exporter: config.DecisionLogExporter{Enabled: true}}, config.Log{})
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("Case 3: rejects rates out of range", func() {
+			_, err := New(config.DecisionLog{Enabled: true, SampleRate: 1, Rates: []config.DecisionLogRate{{Tenant: "t1", Rate: 2}}}, config.Log{})
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("FileSink", func() {
+		It("Case 1: writes JSON lines", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "decisions.jsonl")
+			sink, err := NewFileSink(path, 1, 2)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, id := range []string{"d1", "d2"} {
+				Expect(sink.Write(context.Background(), &Record{ID: id, Method: MethodCheck, Request: json.RawMessage(`{}`)})).Should(Succeed())
+			}
+			Expect(sink.Close(context.Background())).Should(Succeed())
+
+			file, err := os.Open(path)
+			Expect(err).ShouldNot(HaveOccurred())
+			defer file.Close()
+			var ids []string
+			scanner := bufio.NewScanner(file)
+			for scanner.Scan() {
+				record := &Record{}
+				Expect(json.Unmarshal(scanner.Bytes(), record)).Should(Succeed())
+				ids = append(ids, record.ID)
+			}
+			Expect(ids).Should(Equal([]string{"d1", "d2"}))
+		})
+	})
+
+	Context("SameResult", func() {
+		It("Case 1: compares lookup results regardless of order", func() {
+			equal, err := SameResult(MethodLookupEntity, json.RawMessage(`{"entity_ids":["1","2"],"continuous_token":"a"}`), json.RawMessage(`{"entity_ids":["2","1"]}`))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(equal).Should(BeTrue())
+
+			equal, err = SameResult(MethodLookupEntity, json.RawMessage(`{"entity_ids":["1","2"]}`), json.RawMessage(`{"entity_ids":["1"]}`))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(equal).Should(BeFalse())
+		})
+
+		It("Case 2: compares check results by their answer", func() {
+			equal, err := SameResult(MethodBulkCheck, json.RawMessage(`{"can":"CHECK_RESULT_ALLOWED","metadata":{"check_count":3}}`), json.RawMessage(`{"can":"CHECK_RESULT_ALLOWED"}`))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(equal).Should(BeTrue())
+
+			equal, err = SameResult(MethodCheck, json.RawMessage(`{"can":"CHECK_RESULT_ALLOWED"}`), json.RawMessage(`{"can":"CHECK_RESULT_DENIED"}`))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(equal).Should(BeFalse())
+		})
+	})
+})
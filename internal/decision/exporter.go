@@ -0,0 +1,61 @@
+package decision
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/pkg/telemetry"
+)
+
+// ExporterSink - Emits decision records through the log exporter configured for the application logs
+type ExporterSink struct {
+	handler slog.Handler
+}
+
+// NewExporterSink - Creates a sink emitting to the exporter of the logger configuration. Records are
+// emitted at info level whatever the level of the application logs.
+func NewExporterSink(conf config.Log) (*ExporterSink, error) {
+	headers := map[string]string{}
+	for _, header := range conf.Headers {
+		h := strings.Split(header, ":")
+		if len(h) != 2 {
+			return nil, errors.New("invalid header format; expected 'key:value'")
+		}
+		headers[h[0]] = h[1]
+	}
+	handler, err := telemetry.HandlerFactory(conf.Exporter, conf.Endpoint, conf.Insecure, conf.Urlpath, headers, conf.Protocol, slog.LevelInfo, conf.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+	return &ExporterSink{handler: handler}, nil
+}
+
+// Write - Emits the record with its fields as attributes
+func (s *ExporterSink) Write(ctx context.Context, record *Record) error {
+	r := slog.NewRecord(record.Time, slog.LevelInfo, "decision "+record.Method, 0)
+	r.AddAttrs(
+		slog.String("decision.id", record.ID),
+		slog.String("decision.request_id", record.RequestID),
+		slog.String("decision.tenant_id", record.TenantID),
+		slog.String("decision.method", record.Method),
+		slog.String("decision.actor", record.Actor),
+		slog.String("decision.snap_token", record.SnapToken),
+		slog.String("decision.schema_version", record.SchemaVersion),
+		slog.Float64("decision.latency_ms", record.LatencyMs),
+		slog.Int64("decision.check_count", int64(record.CheckCount)),
+		slog.Bool("decision.cache_hit", record.CacheHit),
+		slog.Bool("decision.redacted", record.Redacted),
+		slog.String("decision.request", string(record.Request)),
+		slog.String("decision.result", string(record.Result)),
+		slog.String("decision.error", record.Error),
+	)
+	return s.handler.Handle(ctx, r)
+}
+
+// Close - Records are flushed by the exporter in the background, like the application logs
+func (s *ExporterSink) Close(context.Context) error {
+	return nil
+}
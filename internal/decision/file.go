@@ -0,0 +1,38 @@
+package decision
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Permify/permify/pkg/rotate"
+)
+
+// FileSink - Writes decision records as JSON lines to a local file, rotating it by size
+type FileSink struct {
+	file *rotate.File
+}
+
+// NewFileSink - Opens the file at the given path for appending. The file is rotated to path.1, path.2, ...
+// once it reaches maxSize megabytes, keeping maxBackups rotated files.
+func NewFileSink(path string, maxSize, maxBackups int) (*FileSink, error) {
+	file, err := rotate.New(path, maxSize, maxBackups)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{file: file}, nil
+}
+
+// Write - Appends the record as a JSON line
+func (s *FileSink) Write(_ context.Context, record *Record) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	_, err = s.file.Write(append(line, '\n'))
+	return err
+}
+
+// Close - Closes the file
+func (s *FileSink) Close(context.Context) error {
+	return s.file.Close()
+}
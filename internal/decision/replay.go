@@ -0,0 +1,135 @@
+package decision
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Evaluator - Evaluates the requests of replayed decisions, implemented by the invokers
+type Evaluator interface {
+	Check(ctx context.Context, request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error)
+	LookupEntity(ctx context.Context, request *base.PermissionLookupEntityRequest) (*base.PermissionLookupEntityResponse, error)
+	LookupSubject(ctx context.Context, request *base.PermissionLookupSubjectRequest) (*base.PermissionLookupSubjectResponse, error)
+	SubjectPermission(ctx context.Context, request *base.PermissionSubjectPermissionRequest) (*base.PermissionSubjectPermissionResponse, error)
+}
+
+// Replay - Evaluates the request of a record again on the data of its snap token, against the given schema
+// version, or the head version of the tenant if it is empty. The result is encoded like the result of the record.
+func Replay(ctx context.Context, evaluator Evaluator, record *Record, schemaVersion string) (json.RawMessage, error) {
+	var response proto.Message
+	var err error
+
+	switch record.Method {
+	case MethodCheck, MethodBulkCheck:
+		request := &base.PermissionCheckRequest{}
+		if err = protojson.Unmarshal(record.Request, request); err != nil {
+			return nil, err
+		}
+		if request.Metadata == nil {
+			request.Metadata = &base.PermissionCheckRequestMetadata{}
+		}
+		request.Metadata.SchemaVersion = schemaVersion
+		response, err = evaluator.Check(ctx, request)
+	case MethodLookupEntity, MethodLookupEntityStream:
+		request := &base.PermissionLookupEntityRequest{}
+		if err = protojson.Unmarshal(record.Request, request); err != nil {
+			return nil, err
+		}
+		if request.Metadata == nil {
+			request.Metadata = &base.PermissionLookupEntityRequestMetadata{}
+		}
+		request.Metadata.SchemaVersion = schemaVersion
+		response, err = evaluator.LookupEntity(ctx, request)
+	case MethodLookupSubject:
+		request := &base.PermissionLookupSubjectRequest{}
+		if err = protojson.Unmarshal(record.Request, request); err != nil {
+			return nil, err
+		}
+		if request.Metadata == nil {
+			request.Metadata = &base.PermissionLookupSubjectRequestMetadata{}
+		}
+		request.Metadata.SchemaVersion = schemaVersion
+		response, err = evaluator.LookupSubject(ctx, request)
+	case MethodSubjectPermission:
+		request := &base.PermissionSubjectPermissionRequest{}
+		if err = protojson.Unmarshal(record.Request, request); err != nil {
+			return nil, err
+		}
+		if request.Metadata == nil {
+			request.Metadata = &base.PermissionSubjectPermissionRequestMetadata{}
+		}
+		request.Metadata.SchemaVersion = schemaVersion
+		response, err = evaluator.SubjectPermission(ctx, request)
+	default:
+		return nil, fmt.Errorf("decision method '%s' cannot be replayed", record.Method)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return protojson.MarshalOptions{UseProtoNames: true}.Marshal(response)
+}
+
+// SameResult - Compares two results of a method. The ids of lookups are compared regardless of their order,
+// and check counts and continuous tokens are ignored.
+func SameResult(method string, a, b json.RawMessage) (bool, error) {
+	na, err := normalize(method, a)
+	if err != nil {
+		return false, err
+	}
+	nb, err := normalize(method, b)
+	if err != nil {
+		return false, err
+	}
+	return proto.Equal(na, nb), nil
+}
+
+// normalize - Decodes a result of a method, keeping only what decides it
+func normalize(method string, result json.RawMessage) (proto.Message, error) {
+	unmarshal := func(m proto.Message) error {
+		if len(result) == 0 {
+			return nil
+		}
+		return protojson.Unmarshal(result, m)
+	}
+
+	switch method {
+	case MethodCheck, MethodBulkCheck:
+		response := &base.PermissionCheckResponse{}
+		if err := unmarshal(response); err != nil {
+			return nil, err
+		}
+		return &base.PermissionCheckResponse{Can: response.GetCan()}, nil
+	case MethodLookupEntity, MethodLookupEntityStream:
+		response := &base.PermissionLookupEntityResponse{}
+		if err := unmarshal(response); err != nil {
+			return nil, err
+		}
+		ids := slices.Clone(response.GetEntityIds())
+		slices.Sort(ids)
+		return &base.PermissionLookupEntityResponse{EntityIds: ids}, nil
+	case MethodLookupSubject:
+		response := &base.PermissionLookupSubjectResponse{}
+		if err := unmarshal(response); err != nil {
+			return nil, err
+		}
+		ids := slices.Clone(response.GetSubjectIds())
+		slices.Sort(ids)
+		return &base.PermissionLookupSubjectResponse{SubjectIds: ids}, nil
+	case MethodSubjectPermission:
+		response := &base.PermissionSubjectPermissionResponse{}
+		if err := unmarshal(response); err != nil {
+			return nil, err
+		}
+		return response, nil
+	default:
+		return nil, fmt.Errorf("decision method '%s' cannot be replayed", method)
+	}
+}
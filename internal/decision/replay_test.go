@@ -0,0 +1,174 @@
+package decision_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/decision"
+	"github.com/Permify/permify/internal/engines"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/internal/invoke"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/database"
+	"github.com/Permify/permify/pkg/dsl/compiler"
+	"github.com/Permify/permify/pkg/dsl/parser"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// recordingSink - Sink keeping its records in memory
+type recordingSink struct {
+	records []*decision.Record
+}
+
+func (s *recordingSink) Write(_ context.Context, record *decision.Record) error {
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *recordingSink) Close(context.Context) error {
+	return nil
+}
+
+// entityStream - Entity lookup stream dropping the streamed entities
+type entityStream struct {
+	grpc.ServerStream
+}
+
+func (s *entityStream) Send(*base.PermissionLookupEntityStreamResponse) error {
+	return nil
+}
+
+// writeSchema - Writes a schema version of the t1 tenant
+func writeSchema(writer storage.SchemaWriter, version, model string) {
+	sch, err := parser.NewParser(model).Parse()
+	Expect(err).ShouldNot(HaveOccurred())
+	_, _, err = compiler.NewCompiler(true, sch).Compile()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	definitions := make([]storage.SchemaDefinition, 0, len(sch.Statements))
+	for _, st := range sch.Statements {
+		definitions = append(definitions, storage.SchemaDefinition{
+			TenantID:             "t1",
+			Version:              version,
+			Name:                 st.GetName(),
+			SerializedDefinition: []byte(st.String()),
+		})
+	}
+	Expect(writer.WriteSchema(context.Background(), definitions)).Should(Succeed())
+}
+
+var _ = Describe("replay", func() {
+	It("Case 1: reports the decisions changed by a schema version", func() {
+		db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		schemaWriter := factories.SchemaWriterFactory(db)
+		writeSchema(schemaWriter, "v1", `
+		entity user {}
+
+		entity doc {
+			relation owner @user
+			relation viewer @user
+
+			permission view = owner
+		}
+		`)
+
+		schemaReader := factories.SchemaReaderFactory(db)
+		dataReader := factories.DataReaderFactory(db)
+
+		checkEngine := engines.NewCheckEngine(schemaReader, dataReader)
+		invoker := invoke.NewDirectInvoker(
+			schemaReader,
+			dataReader,
+			checkEngine,
+			engines.NewExpandEngine(schemaReader, dataReader),
+			engines.NewLookupEngine(checkEngine, schemaReader, dataReader),
+			engines.NewSubjectPermission(checkEngine, schemaReader),
+		)
+		checkEngine.SetInvoker(invoker)
+
+		sink := &recordingSink{}
+		invoker.SetDecisionLogger(decision.NewLogger(config.DecisionLog{SampleRate: 1}, sink))
+
+		var tuples []*base.Tuple
+		for _, relationship := range []string{"doc:1#owner@user:1", "doc:1#viewer@user:2"} {
+			t, err := tuple.Tuple(relationship)
+			Expect(err).ShouldNot(HaveOccurred())
+			tuples = append(tuples, t)
+		}
+		_, err = factories.DataWriterFactory(db).Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection())
+		Expect(err).ShouldNot(HaveOccurred())
+
+		for _, subject := range []string{"1", "2"} {
+			_, err = invoker.Check(context.Background(), &base.PermissionCheckRequest{
+				TenantId:   "t1",
+				Metadata:   &base.PermissionCheckRequestMetadata{Depth: 20},
+				Entity:     &base.Entity{Type: "doc", Id: "1"},
+				Permission: "view",
+				Subject:    &base.Subject{Type: "user", Id: subject},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+		_, err = invoker.LookupEntity(context.Background(), &base.PermissionLookupEntityRequest{
+			TenantId:   "t1",
+			Metadata:   &base.PermissionLookupEntityRequestMetadata{Depth: 20},
+			EntityType: "doc",
+			Permission: "view",
+			Subject:    &base.Subject{Type: "user", Id: "2"},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		err = invoker.LookupEntityStream(context.Background(), &base.PermissionLookupEntityRequest{
+			TenantId:   "t1",
+			Metadata:   &base.PermissionLookupEntityRequestMetadata{Depth: 20},
+			EntityType: "doc",
+			Permission: "view",
+			Subject:    &base.Subject{Type: "user", Id: "1"},
+		}, &entityStream{})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// only the top level decisions are logged
+		Expect(sink.records).Should(HaveLen(4))
+		Expect(sink.records[0].Method).Should(Equal(decision.MethodCheck))
+		Expect(sink.records[0].SchemaVersion).Should(Equal("v1"))
+		Expect(sink.records[0].CheckCount).Should(BeNumerically(">", 0))
+		Expect(sink.records[2].Method).Should(Equal(decision.MethodLookupEntity))
+		Expect(sink.records[3].Method).Should(Equal(decision.MethodLookupEntityStream))
+		Expect(string(sink.records[3].Result)).Should(MatchJSON(`{"entity_ids":["1"]}`))
+
+		writeSchema(schemaWriter, "v2", `
+		entity user {}
+
+		entity doc {
+			relation owner @user
+			relation viewer @user
+
+			permission view = owner or viewer
+		}
+		`)
+
+		var changed []string
+		for _, record := range sink.records {
+			result, err := decision.Replay(context.Background(), invoker, record, "v2")
+			Expect(err).ShouldNot(HaveOccurred())
+			equal, err := decision.SameResult(record.Method, record.Result, result)
+			Expect(err).ShouldNot(HaveOccurred())
+			if !equal {
+				changed = append(changed, record.ID)
+			}
+		}
+		Expect(changed).Should(Equal([]string{sink.records[1].ID, sink.records[2].ID}))
+
+		// replaying against the recorded version gives the recorded answers
+		for _, record := range sink.records {
+			result, err := decision.Replay(context.Background(), invoker, record, record.SchemaVersion)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(decision.SameResult(record.Method, record.Result, result)).Should(BeTrue())
+		}
+	})
+})
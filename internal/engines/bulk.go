@@ -95,6 +95,9 @@ type BulkChecker struct {
 	// Callback for processing results
 	// callback is invoked for each successful permission check with the entity/subject ID and continuous token
 	callback func(entityID, continuousToken string)
+
+	// filter narrows the collected requests before they are sorted and checked, when it is set
+	filter func(ctx context.Context, requests []BulkCheckerRequest) ([]BulkCheckerRequest, error)
 }
 
 // executionState manages the execution of requests and maintains processing order.
@@ -210,6 +213,29 @@ func (bc *BulkChecker) StopCollectingRequests() {
 	<-bc.collectionDone // Wait for collection to complete
 }
 
+// SetFilter sets a function that narrows the collected requests before they are checked.
+// Requests dropped by the filter are never checked nor reported to the callback.
+func (bc *BulkChecker) SetFilter(filter func(ctx context.Context, requests []BulkCheckerRequest) ([]BulkCheckerRequest, error)) {
+	bc.filter = filter
+}
+
+// filterRequests replaces the collected requests with the ones kept by the filter.
+func (bc *BulkChecker) filterRequests() error {
+	if bc.filter == nil {
+		return nil
+	}
+
+	bc.requestsMu.Lock()
+	defer bc.requestsMu.Unlock()
+
+	requests, err := bc.filter(bc.ctx, bc.requests)
+	if err != nil {
+		return err
+	}
+	bc.requests = requests
+	return nil
+}
+
 // getSortedRequests returns a sorted copy of requests based on the checker type.
 // This method creates a copy of the requests to avoid modifying the original
 // collection and sorts them according to the BulkCheckerType (entity ID or subject ID).
@@ -265,6 +291,11 @@ func (bc *BulkChecker) ExecuteRequests(size uint32) error { // Main execution en
 	// Stop collecting new requests and wait for collection to complete
 	bc.StopCollectingRequests() // Ensure no new requests are added
 
+	// Drop the requests rejected by the filter so that they are never checked
+	if err := bc.filterRequests(); err != nil {
+		return err
+	}
+
 	// Get sorted requests for processing
 	requests := bc.getSortedRequests()
 	if len(requests) == 0 {
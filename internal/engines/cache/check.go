@@ -9,6 +9,7 @@ import (
 	"github.com/cespare/xxhash/v2"
 
 	"github.com/Permify/permify/internal"
+	"github.com/Permify/permify/internal/decision"
 	"github.com/Permify/permify/internal/engines"
 	"github.com/Permify/permify/internal/invoke"
 	"github.com/Permify/permify/internal/storage"
@@ -66,11 +67,13 @@ func (c *CheckEngineWithCache) Check(ctx context.Context, request *base.Permissi
 	if found {
 		// Increase the hit count in the metrics.
 		c.cacheHitHistogram.Record(ctx, 1)
+		decision.CountCacheHit(ctx)
 
 		// If the request doesn't have the exclusion flag set, return the cached result.
 		return &base.PermissionCheckResponse{
 			Can:      res.GetCan(),
 			Metadata: &base.PermissionCheckResponseMetadata{},
+			Warnings: engines.DeprecationWarnings(en, request.GetPermission()),
 		}, nil
 	}
 
@@ -2712,6 +2712,70 @@ var _ = Describe("cache", func() {
 			}
 		})
 	})
+
+	Context("Deprecation Warnings", func() {
+		It("Returns the warnings of a cached check", func() {
+			db, err := factories.DatabaseFactory(
+				config.Database{
+					Engine: "memory",
+				},
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(`
+			entity user {}
+
+			entity doc {
+				relation owner @user
+
+				@deprecated
+				permission edit = owner
+			}`)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+			dataWriter := factories.DataWriterFactory(db)
+
+			engineKeyCache, err := ristretto.New(ristretto.NumberOfCounters(1_000), ristretto.MaxCost("10MiB"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			checkEngine := engines.NewCheckEngine(schemaReader, dataReader)
+			checkEngineWithCache := NewCheckEngineWithCache(checkEngine, schemaReader, engineKeyCache)
+
+			invoker := invoke.NewDirectInvoker(schemaReader, dataReader, checkEngineWithCache, nil, nil, nil)
+			checkEngine.SetInvoker(invoker)
+
+			t, err := tuple.Tuple("doc:1#owner@user:1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(t), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// The first check is answered by the engine, the second one by the cache
+			for range 2 {
+				response, err := invoker.Check(context.Background(), &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Entity:     &base.Entity{Type: "doc", Id: "1"},
+					Subject:    &base.Subject{Type: "user", Id: "1"},
+					Permission: "edit",
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken: token.NewNoopToken().Encode().String(),
+						Depth:     20,
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(response.GetCan()).Should(Equal(base.CheckResult_CHECK_RESULT_ALLOWED))
+				Expect(response.GetWarnings()).Should(Equal([]string{"permission doc#edit is deprecated"}))
+
+				engineKeyCache.Wait()
+			}
+		})
+	})
 })
 
 // newSchema -
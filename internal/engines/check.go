@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/cel-go/cel"
 
+	"github.com/Permify/permify/internal/decision"
 	"github.com/Permify/permify/internal/invoke"
 	"github.com/Permify/permify/internal/schema"
 	"github.com/Permify/permify/internal/storage"
@@ -63,6 +64,9 @@ func (engine *CheckEngine) SetInvoker(invoker invoke.Check) {
 func (engine *CheckEngine) Check(ctx context.Context, request *base.PermissionCheckRequest) (response *base.PermissionCheckResponse, err error) {
 	emptyResp := denied(emptyResponseMetadata())
 
+	// Count the check for the decision log.
+	decision.CountCheck(ctx)
+
 	// Retrieve entity definition
 	var en *base.EntityDefinition
 	en, _, err = engine.schemaReader.ReadEntityDefinition(ctx, request.GetTenantId(), request.GetEntity().GetType(), request.GetMetadata().GetSchemaVersion())
@@ -80,6 +84,7 @@ func (engine *CheckEngine) Check(ctx context.Context, request *base.PermissionCh
 	return &base.PermissionCheckResponse{
 		Can:      res.Can,
 		Metadata: res.Metadata,
+		Warnings: DeprecationWarnings(en, request.GetPermission()),
 	}, nil
 }
 
@@ -207,6 +212,10 @@ func (engine *CheckEngine) checkLeaf(request *base.PermissionCheckRequest, leaf
 	// the Call's permission.
 	case *base.Leaf_Call:
 		return engine.checkCall(request, op.Call)
+	// In case of Count operation, prepare a CheckFunction that counts
+	// the subjects of the relation and compares the result.
+	case *base.Leaf_Count:
+		return engine.checkCount(request, op.Count)
 	// In case of an undefined type, return a CheckFunction that always fails.
 	default:
 		return checkFail(errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_TYPE.String()))
@@ -326,6 +335,24 @@ func (engine *CheckEngine) checkDirectRelation(request *base.PermissionCheckRequ
 	}
 }
 
+// checkCount is a method of CheckEngine that counts the subjects related to the requested
+// entity through the relation of the Count leaf and allows the request when the count
+// satisfies the comparison. The result does not depend on the requested subject.
+func (engine *CheckEngine) checkCount(request *base.PermissionCheckRequest, count *base.Count) CheckFunction {
+	return func(ctx context.Context) (*base.PermissionCheckResponse, error) {
+		c, err := countSubjects(ctx, engine.dataReader, request.GetTenantId(), request.GetEntity(), count.GetRelation(), request.GetMetadata().GetSnapToken(), request.GetContext().GetTuples())
+		if err != nil {
+			return denied(emptyResponseMetadata()), err
+		}
+
+		if compareCount(c, count) {
+			return allowed(emptyResponseMetadata()), nil
+		}
+
+		return denied(emptyResponseMetadata()), nil
+	}
+}
+
 // checkTupleToUserSet is a method of CheckEngine that checks permissions using the
 // TupleToUserSet data structure. It returns a CheckFunction closure that does the check.
 func (engine *CheckEngine) checkTupleToUserSet(
@@ -2589,4 +2589,238 @@ var _ = Describe("check-engine", func() {
 			Expect(resp.GetCan()).To(Equal(base.CheckResult_CHECK_RESULT_ALLOWED))
 		})
 	})
+
+	Context("Count Sample: Check", func() {
+		countSchema := `
+		entity user {}
+
+		entity document {
+			relation approver @user
+			relation owner @user
+			relation blocker @user
+
+			permission publish = count(approver) >= 2 and owner
+			permission draft = count(approver) < 1
+			permission release = owner not count(blocker) > 0
+		}
+		`
+
+		It("Count Sample: Case 1", func() {
+			db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(countSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+			dataWriter := factories.DataWriterFactory(db)
+
+			checkEngine := NewCheckEngine(schemaReader, dataReader)
+			invoker := invoke.NewDirectInvoker(schemaReader, dataReader, checkEngine, nil, nil, nil)
+			checkEngine.SetInvoker(invoker)
+
+			relationships := []string{
+				"document:1#owner@user:1",
+				"document:1#approver@user:2",
+				"document:1#approver@user:3",
+				"document:2#owner@user:1",
+				"document:2#approver@user:2",
+				"document:2#blocker@user:4",
+			}
+
+			var tuples []*base.Tuple
+			for _, relationship := range relationships {
+				t, err := tuple.Tuple(relationship)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, t)
+			}
+
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			checks := []struct {
+				entity     string
+				subject    string
+				permission string
+				result     base.CheckResult
+			}{
+				{entity: "document:1", subject: "user:1", permission: "publish", result: base.CheckResult_CHECK_RESULT_ALLOWED},
+				{entity: "document:1", subject: "user:2", permission: "publish", result: base.CheckResult_CHECK_RESULT_DENIED},
+				{entity: "document:2", subject: "user:1", permission: "publish", result: base.CheckResult_CHECK_RESULT_DENIED},
+				{entity: "document:1", subject: "user:5", permission: "draft", result: base.CheckResult_CHECK_RESULT_DENIED},
+				{entity: "document:3", subject: "user:5", permission: "draft", result: base.CheckResult_CHECK_RESULT_ALLOWED},
+				{entity: "document:1", subject: "user:1", permission: "release", result: base.CheckResult_CHECK_RESULT_ALLOWED},
+				{entity: "document:2", subject: "user:1", permission: "release", result: base.CheckResult_CHECK_RESULT_DENIED},
+			}
+
+			for _, check := range checks {
+				entity, err := tuple.E(check.entity)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				ear, err := tuple.EAR(check.subject)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				response, err := invoker.Check(context.Background(), &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Entity:     entity,
+					Permission: check.permission,
+					Subject:    &base.Subject{Type: ear.GetEntity().GetType(), Id: ear.GetEntity().GetId()},
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "",
+						Depth:         20,
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(response.GetCan()).Should(Equal(check.result))
+			}
+		})
+
+		It("Count Sample: Case 2", func() {
+			db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(countSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+			dataWriter := factories.DataWriterFactory(db)
+
+			checkEngine := NewCheckEngine(schemaReader, dataReader)
+			invoker := invoke.NewDirectInvoker(schemaReader, dataReader, checkEngine, nil, nil, nil)
+			checkEngine.SetInvoker(invoker)
+
+			var tuples []*base.Tuple
+			for _, relationship := range []string{
+				"document:1#owner@user:1",
+				"document:1#approver@user:2",
+			} {
+				t, err := tuple.Tuple(relationship)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, t)
+			}
+
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// An approver already present in the storage is not counted twice.
+			duplicate, err := tuple.Tuple("document:1#approver@user:2")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			response, err := invoker.Check(context.Background(), &base.PermissionCheckRequest{
+				TenantId:   "t1",
+				Entity:     &base.Entity{Type: "document", Id: "1"},
+				Permission: "publish",
+				Subject:    &base.Subject{Type: "user", Id: "1"},
+				Metadata: &base.PermissionCheckRequestMetadata{
+					SnapToken:     token.NewNoopToken().Encode().String(),
+					SchemaVersion: "",
+					Depth:         20,
+				},
+				Context: &base.Context{
+					Tuples: []*base.Tuple{duplicate},
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetCan()).Should(Equal(base.CheckResult_CHECK_RESULT_DENIED))
+
+			approver, err := tuple.Tuple("document:1#approver@user:3")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			response, err = invoker.Check(context.Background(), &base.PermissionCheckRequest{
+				TenantId:   "t1",
+				Entity:     &base.Entity{Type: "document", Id: "1"},
+				Permission: "publish",
+				Subject:    &base.Subject{Type: "user", Id: "1"},
+				Metadata: &base.PermissionCheckRequestMetadata{
+					SnapToken:     token.NewNoopToken().Encode().String(),
+					SchemaVersion: "",
+					Depth:         20,
+				},
+				Context: &base.Context{
+					Tuples: []*base.Tuple{duplicate, approver},
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetCan()).Should(Equal(base.CheckResult_CHECK_RESULT_ALLOWED))
+		})
+
+		It("Count Sample: Case 3", func() {
+			db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(countSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+			dataWriter := factories.DataWriterFactory(db)
+
+			checkEngine := NewCheckEngine(schemaReader, dataReader)
+			invoker := invoke.NewDirectInvoker(schemaReader, dataReader, checkEngine, nil, nil, nil)
+			checkEngine.SetInvoker(invoker)
+
+			var tuples []*base.Tuple
+			for _, relationship := range []string{
+				"document:1#owner@user:1",
+				"document:1#approver@user:2",
+				"document:1#approver@user:*",
+			} {
+				t, err := tuple.Tuple(relationship)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, t)
+			}
+
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			check := func(contextual ...string) base.CheckResult {
+				var ctxTuples []*base.Tuple
+				for _, relationship := range contextual {
+					t, err := tuple.Tuple(relationship)
+					Expect(err).ShouldNot(HaveOccurred())
+					ctxTuples = append(ctxTuples, t)
+				}
+
+				response, err := invoker.Check(context.Background(), &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Entity:     &base.Entity{Type: "document", Id: "1"},
+					Permission: "publish",
+					Subject:    &base.Subject{Type: "user", Id: "1"},
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "",
+						Depth:         20,
+					},
+					Context: &base.Context{
+						Tuples: ctxTuples,
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				return response.GetCan()
+			}
+
+			// A wildcard subject is not counted.
+			Expect(check()).Should(Equal(base.CheckResult_CHECK_RESULT_DENIED))
+
+			// The same subject through a different subject relation is counted once.
+			Expect(check("document:1#approver@user:2#owner")).Should(Equal(base.CheckResult_CHECK_RESULT_DENIED))
+
+			Expect(check("document:1#approver@user:3")).Should(Equal(base.CheckResult_CHECK_RESULT_ALLOWED))
+		})
+	})
 })
@@ -0,0 +1,263 @@
+package engines
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	"github.com/Permify/permify/internal/invoke"
+	"github.com/Permify/permify/internal/schema"
+	tokenutils "github.com/Permify/permify/internal/storage/context/utils"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// _defaultEntitlementsCostLimit is the number of permission checks an entitlements lookup may evaluate if its request sets no limit
+const _defaultEntitlementsCostLimit = 10000
+
+// LookupEntitlements streams the permissions a subject holds on every entity it can reach. The entity types and
+// permissions of the schema are walked in name order, skipping those the linked schema graph cannot reach from the
+// subject. The entities of each permission are found with the entity filter and checked with a bulk checker, the same
+// way LookupEntityStream does, so entitlements are streamed in entity type, permission and entity id order.
+func (engine *LookupEngine) LookupEntitlements(ctx context.Context, request *base.PermissionLookupEntitlementsRequest, server base.Permission_LookupEntitlementsServer) (err error) {
+	size := request.GetPageSize()
+	if size == 0 {
+		size = 1000
+	}
+
+	limit := request.GetCostLimit()
+	if limit == 0 {
+		limit = _defaultEntitlementsCostLimit
+	}
+
+	// Retrieve the schema based on the tenantId and schema version
+	var sc *base.SchemaDefinition
+	sc, err = engine.readSchema(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaVersion())
+	if err != nil {
+		return err
+	}
+
+	cursor, err := decodeEntitlementCursor(request.GetContinuousToken())
+	if err != nil {
+		return err
+	}
+
+	entrances, err := entitlementEntrances(sc, request)
+	if err != nil {
+		return err
+	}
+
+	// The deprecated permissions among the streamed ones are warned about in the header
+	var warnings []string
+	for _, entrance := range entrances {
+		warnings = append(warnings, DeprecationWarnings(sc.GetEntityDefinitions()[entrance.GetType()], entrance.GetValue())...)
+	}
+	err = setWarningHeader(server, warnings)
+	if err != nil {
+		return err
+	}
+
+	checker := &costLimitedCheck{checker: engine.checkEngine, limit: int64(limit)}
+	sent := uint32(0)
+
+	for i, entrance := range entrances {
+		// Skip the permissions streamed by the previous pages
+		if cursor != nil && compareEntrances(entrance, cursor.entrance) < 0 {
+			continue
+		}
+		entityCursor := ""
+		if cursor != nil && compareEntrances(entrance, cursor.entrance) == 0 && cursor.entityID != "" {
+			entityCursor = tokenutils.NewContinuousToken(cursor.entityID).Encode().String()
+		}
+
+		// The entitlements after the last entity of a permission start with the next permission
+		next := ""
+		if i+1 < len(entrances) {
+			next = encodeEntitlementCursor(entrances[i+1], "")
+		}
+
+		var sendErr error
+		callback := func(entityID, ct string) {
+			if sendErr != nil {
+				return
+			}
+			token := next
+			if ct != "" {
+				nextID, err := decodeCursorValue(ct)
+				if err != nil {
+					sendErr = err
+					return
+				}
+				token = encodeEntitlementCursor(entrance, nextID)
+			}
+			sendErr = server.Send(&base.PermissionLookupEntitlementsStreamResponse{
+				EntityType:      entrance.GetType(),
+				Permission:      entrance.GetValue(),
+				EntityId:        entityID,
+				ContinuousToken: token,
+			})
+			sent++
+		}
+
+		err = engine.lookupEntitlement(ctx, sc, request, entrance, entityCursor, checker, callback, size-sent)
+		if sendErr != nil {
+			return sendErr
+		}
+		// Checks refused after the page is complete do not fail it
+		if sent >= size {
+			return nil
+		}
+		if checker.exceeded() {
+			return errors.New(base.ErrorCode_ERROR_CODE_COST_LIMIT_EXCEEDED.String())
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lookupEntitlement checks the entities of one permission, calling the callback for at most size allowed entities
+func (engine *LookupEngine) lookupEntitlement(
+	ctx context.Context,
+	sc *base.SchemaDefinition,
+	request *base.PermissionLookupEntitlementsRequest,
+	entrance *base.Entrance,
+	cursor string,
+	checker invoke.Check,
+	callback func(entityID, ct string),
+	size uint32,
+) error {
+	// Create and start BulkChecker. It performs permission checks concurrently.
+	bulkChecker, err := NewBulkChecker(ctx, checker, BulkCheckerTypeEntity, callback, BulkCheckerConfig{
+		ConcurrencyLimit: engine.concurrencyLimit,
+		BufferSize:       1000,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create bulk checker: %w", err)
+	}
+	defer bulkChecker.Close()
+
+	// Create and start BulkPublisher. It receives entities and passes them to BulkChecker.
+	publisher := NewBulkEntityPublisher(ctx, &base.PermissionLookupEntityRequest{
+		TenantId:   request.GetTenantId(),
+		EntityType: entrance.GetType(),
+		Permission: entrance.GetValue(),
+		Subject:    request.GetSubject(),
+		Context:    request.GetContext(),
+	}, bulkChecker)
+
+	err = NewEntityFilter(engine.dataReader, sc).EntityFilter(ctx, &base.PermissionEntityFilterRequest{
+		TenantId: request.GetTenantId(),
+		Metadata: &base.PermissionEntityFilterRequestMetadata{
+			SnapToken:     request.GetMetadata().GetSnapToken(),
+			SchemaVersion: request.GetMetadata().GetSchemaVersion(),
+			Depth:         request.GetMetadata().GetDepth(),
+		},
+		Entrance: entrance,
+		Subject:  request.GetSubject(),
+		Context:  request.GetContext(),
+		Cursor:   cursor,
+	}, &VisitsMap{}, publisher)
+	if err != nil {
+		return err
+	}
+
+	return bulkChecker.ExecuteRequests(size)
+}
+
+// entitlementEntrances returns the permissions of the schema matching the filters of the request and reachable
+// from its subject, sorted by entity type and permission name
+func entitlementEntrances(sc *base.SchemaDefinition, request *base.PermissionLookupEntitlementsRequest) ([]*base.Entrance, error) {
+	graph := schema.NewLinkedGraph(sc)
+	source := &base.Entrance{
+		Type:  request.GetSubject().GetType(),
+		Value: request.GetSubject().GetRelation(),
+	}
+
+	var entrances []*base.Entrance
+	for entityType, definition := range sc.GetEntityDefinitions() {
+		if len(request.GetEntityTypes()) > 0 && !slices.Contains(request.GetEntityTypes(), entityType) {
+			continue
+		}
+		for permission := range definition.GetPermissions() {
+			if len(request.GetPermissions()) > 0 && !slices.Contains(request.GetPermissions(), permission) {
+				continue
+			}
+			entrance := &base.Entrance{Type: entityType, Value: permission}
+			linked, err := graph.LinkedEntrances(entrance, source)
+			if err != nil {
+				return nil, err
+			}
+			if len(linked) == 0 {
+				continue
+			}
+			entrances = append(entrances, entrance)
+		}
+	}
+
+	slices.SortFunc(entrances, compareEntrances)
+	return entrances, nil
+}
+
+// compareEntrances orders entrances by entity type, then by permission
+func compareEntrances(a, b *base.Entrance) int {
+	if c := strings.Compare(a.GetType(), b.GetType()); c != 0 {
+		return c
+	}
+	return strings.Compare(a.GetValue(), b.GetValue())
+}
+
+// entitlementCursor is the position an entitlements lookup resumes from: the entity id of a permission where
+// its next page starts, or the first entity of the permission if the id is empty
+type entitlementCursor struct {
+	entrance *base.Entrance
+	entityID string
+}
+
+// encodeEntitlementCursor encodes the position of an entity of a permission as a continuous token
+func encodeEntitlementCursor(entrance *base.Entrance, entityID string) string {
+	return tokenutils.NewContinuousToken(entrance.GetType() + "#" + entrance.GetValue() + "#" + entityID).Encode().String()
+}
+
+// decodeEntitlementCursor decodes a continuous token of an entitlements lookup, nil if the token is empty
+func decodeEntitlementCursor(token string) (*entitlementCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	value, err := decodeCursorValue(token)
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())
+	}
+	parts := strings.SplitN(value, "#", 3)
+	if len(parts) != 3 {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())
+	}
+	return &entitlementCursor{
+		entrance: &base.Entrance{Type: parts[0], Value: parts[1]},
+		entityID: parts[2],
+	}, nil
+}
+
+// costLimitedCheck is a check engine failing the checks of a request once it evaluated its limit of checks
+type costLimitedCheck struct {
+	checker invoke.Check
+	limit   int64
+	count   atomic.Int64
+}
+
+// Check evaluates the check if the limit is not reached yet
+func (c *costLimitedCheck) Check(ctx context.Context, request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error) {
+	if c.count.Add(1) > c.limit {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_COST_LIMIT_EXCEEDED.String())
+	}
+	return c.checker.Check(ctx, request)
+}
+
+// exceeded reports whether a check was refused because the limit was reached
+func (c *costLimitedCheck) exceeded() bool {
+	return c.count.Load() > c.limit
+}
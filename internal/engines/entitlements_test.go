@@ -0,0 +1,192 @@
+package engines
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/internal/invoke"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// entitlementsStream collects the responses of an entitlements lookup
+type entitlementsStream struct {
+	grpc.ServerStream
+	responses []*base.PermissionLookupEntitlementsStreamResponse
+}
+
+func (s *entitlementsStream) Send(response *base.PermissionLookupEntitlementsStreamResponse) error {
+	s.responses = append(s.responses, response)
+	return nil
+}
+
+// entitlements returns the entitlements of the responses as entity:id#permission strings
+func (s *entitlementsStream) entitlements() []string {
+	var entitlements []string
+	for _, response := range s.responses {
+		entitlements = append(entitlements, response.GetEntityType()+":"+response.GetEntityId()+"#"+response.GetPermission())
+	}
+	return entitlements
+}
+
+var _ = Describe("lookup-entitlements", func() {
+	driveSchema := `
+		entity user {}
+
+		entity organization {
+			relation admin @user
+		}
+
+		entity folder {
+			relation org @organization
+			relation collaborator @user
+
+			permission read = collaborator
+			permission delete = org.admin
+		}
+
+		entity doc {
+			relation parent @folder
+			relation owner @user
+
+			permission read = owner or parent.collaborator
+			permission delete = owner
+		}
+		`
+
+	var engine *LookupEngine
+	var metadata *base.PermissionLookupEntitlementsRequestMetadata
+
+	BeforeEach(func() {
+		db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		conf, err := newSchema(driveSchema)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(factories.SchemaWriterFactory(db).WriteSchema(context.Background(), conf)).Should(Succeed())
+
+		var tuples []*base.Tuple
+		for _, relationship := range []string{
+			"organization:1#admin@user:1",
+			"folder:1#org@organization:1",
+			"folder:1#collaborator@user:1",
+			"folder:2#collaborator@user:2",
+			"doc:1#parent@folder:1",
+			"doc:2#owner@user:1",
+			"doc:3#parent@folder:2",
+		} {
+			t, err := tuple.Tuple(relationship)
+			Expect(err).ShouldNot(HaveOccurred())
+			tuples = append(tuples, t)
+		}
+		_, err = factories.DataWriterFactory(db).Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection())
+		Expect(err).ShouldNot(HaveOccurred())
+
+		schemaReader := factories.SchemaReaderFactory(db)
+		dataReader := factories.DataReaderFactory(db)
+
+		checkEngine := NewCheckEngine(schemaReader, dataReader)
+		engine = NewLookupEngine(checkEngine, schemaReader, dataReader)
+		invoker := invoke.NewDirectInvoker(schemaReader, dataReader, checkEngine, nil, engine, nil)
+		checkEngine.SetInvoker(invoker)
+
+		metadata = &base.PermissionLookupEntitlementsRequestMetadata{
+			SnapToken:     token.NewNoopToken().Encode().String(),
+			SchemaVersion: conf[0].Version,
+			Depth:         100,
+		}
+	})
+
+	It("Case 1: streams every entitlement of the subject in entity type, permission and entity order", func() {
+		stream := &entitlementsStream{}
+		err := engine.LookupEntitlements(context.Background(), &base.PermissionLookupEntitlementsRequest{
+			TenantId: "t1",
+			Metadata: metadata,
+			Subject:  &base.Subject{Type: "user", Id: "1"},
+		}, stream)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(stream.entitlements()).Should(Equal([]string{
+			"doc:2#delete",
+			"doc:1#read",
+			"doc:2#read",
+			"folder:1#delete",
+			"folder:1#read",
+		}))
+		Expect(stream.responses[len(stream.responses)-1].GetContinuousToken()).Should(BeEmpty())
+	})
+
+	It("Case 2: streams only the entitlements of the filtered entity types and permissions", func() {
+		stream := &entitlementsStream{}
+		err := engine.LookupEntitlements(context.Background(), &base.PermissionLookupEntitlementsRequest{
+			TenantId:    "t1",
+			Metadata:    metadata,
+			Subject:     &base.Subject{Type: "user", Id: "1"},
+			EntityTypes: []string{"doc"},
+			Permissions: []string{"read"},
+		}, stream)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(stream.entitlements()).Should(Equal([]string{"doc:1#read", "doc:2#read"}))
+	})
+
+	It("Case 3: resumes from the continuous token of the last entitlement of a page", func() {
+		var entitlements []string
+		ct := ""
+		for pages := 0; pages < 10; pages++ {
+			stream := &entitlementsStream{}
+			err := engine.LookupEntitlements(context.Background(), &base.PermissionLookupEntitlementsRequest{
+				TenantId:        "t1",
+				Metadata:        metadata,
+				Subject:         &base.Subject{Type: "user", Id: "1"},
+				PageSize:        2,
+				ContinuousToken: ct,
+			}, stream)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(len(stream.responses)).Should(BeNumerically("<=", 2))
+			entitlements = append(entitlements, stream.entitlements()...)
+			if len(stream.responses) == 0 {
+				break
+			}
+			ct = stream.responses[len(stream.responses)-1].GetContinuousToken()
+			if ct == "" {
+				break
+			}
+		}
+		Expect(entitlements).Should(Equal([]string{
+			"doc:2#delete",
+			"doc:1#read",
+			"doc:2#read",
+			"folder:1#delete",
+			"folder:1#read",
+		}))
+	})
+
+	It("Case 4: fails once the request evaluated more checks than its cost limit", func() {
+		stream := &entitlementsStream{}
+		err := engine.LookupEntitlements(context.Background(), &base.PermissionLookupEntitlementsRequest{
+			TenantId:  "t1",
+			Metadata:  metadata,
+			Subject:   &base.Subject{Type: "user", Id: "1"},
+			CostLimit: 2,
+		}, stream)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_COST_LIMIT_EXCEEDED.String()))
+	})
+
+	It("Case 5: rejects continuous tokens of other lookups", func() {
+		err := engine.LookupEntitlements(context.Background(), &base.PermissionLookupEntitlementsRequest{
+			TenantId:        "t1",
+			Metadata:        metadata,
+			Subject:         &base.Subject{Type: "user", Id: "1"},
+			ContinuousToken: "not a token",
+		}, &entitlementsStream{})
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String()))
+	})
+})
@@ -69,6 +69,9 @@ func (engine *EntityFilter) EntityFilter(
 			Value: request.GetSubject().GetRelation(),
 		},
 	) // Retrieve the linked entrances between the entity reference and subject.
+	if err != nil {
+		return err
+	}
 
 	if entrances == nil {
 		return nil
@@ -171,6 +171,10 @@ func (engine *ExpandEngine) expandLeaf(
 	case *base.Leaf_Call:
 		return engine.expandCall(request, op.Call)
 
+	// If the type of the leaf is Count, the method 'expandCount' is called.
+	case *base.Leaf_Count:
+		return engine.expandCount(request, op.Count)
+
 	// If the leaf type is none of the above, an error is returned.
 	default:
 		return expandFail(errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_TYPE.String()))
@@ -557,6 +561,45 @@ func (engine *ExpandEngine) expandCall(
 	}
 }
 
+// expandCount returns an ExpandFunction for the given request and count.
+// The returned function counts the subjects of the counted relation and sends
+// the boolean result of the comparison as a value leaf to the provided channel.
+func (engine *ExpandEngine) expandCount(
+	request *base.PermissionExpandRequest,
+	count *base.Count,
+) ExpandFunction {
+	return func(ctx context.Context, expandChan chan<- ExpandResponse) {
+		c, err := countSubjects(ctx, engine.dataReader, request.GetTenantId(), request.GetEntity(), count.GetRelation(), request.GetMetadata().GetSnapToken(), request.GetContext().GetTuples())
+		if err != nil {
+			expandChan <- expandFailResponse(err)
+			return
+		}
+
+		value, err := anypb.New(&base.BooleanValue{Data: compareCount(c, count)})
+		if err != nil {
+			expandChan <- expandFailResponse(err)
+			return
+		}
+
+		expandChan <- ExpandResponse{
+			Response: &base.PermissionExpandResponse{
+				Tree: &base.Expand{
+					Entity:     request.GetEntity(),
+					Permission: request.GetPermission(),
+					Arguments:  request.GetArguments(),
+					Node: &base.Expand_Leaf{
+						Leaf: &base.ExpandLeaf{
+							Type: &base.ExpandLeaf_Value{
+								Value: value,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+}
+
 // The function 'expandCall' is a method on the ExpandEngine struct.
 // It takes a PermissionExpandRequest and a Call as parameters and returns an ExpandFunction.
 func (engine *ExpandEngine) expandDirectCall(
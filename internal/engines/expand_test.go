@@ -1666,4 +1666,66 @@ var _ = Describe("expand-engine", func() {
 			Expect(response).ShouldNot(BeNil())
 		})
 	})
+
+	Context("Count Sample: Expand", func() {
+		It("Count Sample: Case 1", func() {
+			db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(`
+			entity user {}
+
+			entity document {
+				relation approver @user
+
+				permission draft = count(approver) < 1
+			}
+			`)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+			dataWriter := factories.DataWriterFactory(db)
+
+			expandEngine := NewExpandEngine(schemaReader, dataReader)
+			invoker := invoke.NewDirectInvoker(schemaReader, dataReader, nil, expandEngine, nil, nil)
+
+			t, err := tuple.Tuple("document:1#approver@user:1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(t), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for id, expected := range map[string]bool{"1": false, "2": true} {
+				value, err := anypb.New(&base.BooleanValue{Data: expected})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				response, err := invoker.Expand(context.Background(), &base.PermissionExpandRequest{
+					TenantId:   "t1",
+					Entity:     &base.Entity{Type: "document", Id: id},
+					Permission: "draft",
+					Metadata: &base.PermissionExpandRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "",
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(response.GetTree()).Should(Equal(&base.Expand{
+					Entity:     &base.Entity{Type: "document", Id: id},
+					Permission: "draft",
+					Node: &base.Expand_Leaf{
+						Leaf: &base.ExpandLeaf{
+							Type: &base.ExpandLeaf_Value{
+								Value: value,
+							},
+						},
+					},
+				}))
+			}
+		})
+	})
 })
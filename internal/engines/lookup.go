@@ -2,6 +2,7 @@ package engines
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"sort"
@@ -95,6 +96,15 @@ func (engine *LookupEngine) LookupEntity(ctx context.Context, request *base.Perm
 		return nil, err
 	}
 
+	// Only the entities whose attributes satisfy the predicates are checked
+	if len(request.GetPredicates()) > 0 {
+		predicates, err := newAttributePredicates(ctx, engine.dataReader, sc, request.GetTenantId(), request.GetEntityType(), request.GetMetadata().GetSnapToken(), request.GetContext().GetAttributes(), request.GetPredicates())
+		if err != nil {
+			return nil, err
+		}
+		checker.SetFilter(predicates.filterRequests)
+	}
+
 	// Create a map to keep track of visited entities
 	visits := &VisitsMap{}
 
@@ -129,6 +139,7 @@ func (engine *LookupEngine) LookupEntity(ctx context.Context, request *base.Perm
 	return &base.PermissionLookupEntityResponse{
 		EntityIds:       entityIDs,
 		ContinuousToken: ct,
+		Warnings:        DeprecationWarnings(sc.GetEntityDefinitions()[request.GetEntityType()], request.GetPermission()),
 	}, nil
 }
 
@@ -176,6 +187,21 @@ func (engine *LookupEngine) LookupEntityStream(ctx context.Context, request *bas
 		return err
 	}
 
+	// The warnings are sent in the header, before the first entity is streamed
+	err = setWarningHeader(server, DeprecationWarnings(sc.GetEntityDefinitions()[request.GetEntityType()], request.GetPermission()))
+	if err != nil {
+		return err
+	}
+
+	// Only the entities whose attributes satisfy the predicates are checked
+	if len(request.GetPredicates()) > 0 {
+		predicates, err := newAttributePredicates(ctx, engine.dataReader, sc, request.GetTenantId(), request.GetEntityType(), request.GetMetadata().GetSnapToken(), request.GetContext().GetAttributes(), request.GetPredicates())
+		if err != nil {
+			return err
+		}
+		checker.SetFilter(predicates.filterRequests)
+	}
+
 	visits := &VisitsMap{}
 
 	// Perform an entity filter operation based on the permission request
@@ -217,6 +243,14 @@ func (engine *LookupEngine) LookupSubject(ctx context.Context, request *base.Per
 	var ids []string
 	var ct string
 
+	// Retrieve the schema based on the tenantId and schema version
+	var sc *base.SchemaDefinition
+	sc, err = engine.readSchema(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaVersion())
+	if err != nil {
+		return nil, err
+	}
+	warnings := DeprecationWarnings(sc.GetEntityDefinitions()[request.GetEntity().GetType()], request.GetPermission())
+
 	// Use the schema-based subject filter to get the list of subjects with the requested permission.
 	ids, err = NewSubjectFilter(engine.schemaReader, engine.dataReader, SubjectFilterConcurrencyLimit(engine.concurrencyLimit)).SubjectFilter(ctx, request)
 	if err != nil {
@@ -239,12 +273,33 @@ func (engine *LookupEngine) LookupSubject(ctx context.Context, request *base.Per
 		}
 	}
 
-	// If '<>' was found, query all subjects with exclusions if provided
+	// With predicates, only the subjects whose attributes satisfy them are returned
+	var predicates *attributePredicates
+	if len(request.GetPredicates()) > 0 {
+		predicates, err = newAttributePredicates(ctx, engine.dataReader, sc, request.GetTenantId(), request.GetSubjectReference().GetType(), request.GetMetadata().GetSnapToken(), request.GetContext().GetAttributes(), request.GetPredicates())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if excludedIds != nil || slices.Contains(ids, ALL) {
+		if predicates != nil {
+			response, err = engine.lookupMatchingSubjects(ctx, request, predicates, excludedIds, size)
+			if err != nil {
+				return nil, err
+			}
+			response.Warnings = warnings
+			return response, nil
+		}
+
+		// If '<>' was found, query all subjects with exclusions if provided
 		resp, pct, err := engine.dataReader.QueryUniqueSubjectReferences(
 			ctx,
 			request.GetTenantId(),
-			request.GetSubjectReference(),
+			&base.SubjectFilter{
+				Type:     request.GetSubjectReference().GetType(),
+				Relation: request.GetSubjectReference().GetRelation(),
+			},
 			excludedIds, // Pass the exclusions if any
 			request.GetMetadata().GetSnapToken(),
 			database.NewPagination(database.Size(size), database.Token(request.GetContinuousToken())),
@@ -258,9 +313,21 @@ func (engine *LookupEngine) LookupSubject(ctx context.Context, request *base.Per
 		return &base.PermissionLookupSubjectResponse{
 			SubjectIds:      resp,
 			ContinuousToken: ct,
+			Warnings:        warnings,
 		}, nil
 	}
 
+	if predicates != nil {
+		matching, err := predicates.matching(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		ids = slices.DeleteFunc(ids, func(id string) bool {
+			_, ok := matching[id]
+			return !ok
+		})
+	}
+
 	// Sort the IDs
 	sort.Strings(ids)
 
@@ -281,6 +348,83 @@ func (engine *LookupEngine) LookupSubject(ctx context.Context, request *base.Per
 	return &base.PermissionLookupSubjectResponse{
 		SubjectIds:      ids[:end], // Slice the IDs based on pagination
 		ContinuousToken: ct,        // Return the next continuous token
+		Warnings:        warnings,
+	}, nil
+}
+
+// lookupMatchingSubjects returns a page of every subject satisfying the predicates, except the excluded ones.
+// The storage evaluates the predicates through the subject filter; the subjects whose predicate attributes are
+// overridden by contextual attributes are evaluated beforehand and merged into the page.
+func (engine *LookupEngine) lookupMatchingSubjects(ctx context.Context, request *base.PermissionLookupSubjectRequest, predicates *attributePredicates, excludedIds []string, size uint32) (*base.PermissionLookupSubjectResponse, error) {
+	cursor := ""
+	if request.GetContinuousToken() != "" {
+		t, err := utils.EncodedContinuousToken{Value: request.GetContinuousToken()}.Decode()
+		if err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())
+		}
+		cursor = t.(utils.ContinuousToken).Value
+	}
+
+	excluded := slices.Clone(excludedIds)
+	var overridden []string
+	for id, matches := range predicates.overridden {
+		excluded = append(excluded, id)
+		if matches && id >= cursor && !slices.Contains(excludedIds, id) {
+			overridden = append(overridden, id)
+		}
+	}
+
+	resp, pct, err := engine.dataReader.QueryUniqueSubjectReferences(
+		ctx,
+		request.GetTenantId(),
+		&base.SubjectFilter{
+			Type:       request.GetSubjectReference().GetType(),
+			Relation:   request.GetSubjectReference().GetRelation(),
+			Predicates: request.GetPredicates(),
+		},
+		excluded,
+		request.GetMetadata().GetSnapToken(),
+		database.NewPagination(database.Size(size), database.Token(request.GetContinuousToken())),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(overridden) == 0 {
+		return &base.PermissionLookupSubjectResponse{
+			SubjectIds:      resp,
+			ContinuousToken: pct.String(),
+		}, nil
+	}
+
+	// The overridden subjects satisfying the predicates are returned only if they are subjects of the reference
+	referenced, _, err := engine.dataReader.QueryUniqueSubjectReferences(
+		ctx,
+		request.GetTenantId(),
+		&base.SubjectFilter{
+			Type:     request.GetSubjectReference().GetType(),
+			Relation: request.GetSubjectReference().GetRelation(),
+			Ids:      overridden,
+		},
+		nil,
+		request.GetMetadata().GetSnapToken(),
+		database.NewPagination(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := append(resp, referenced...)
+	sort.Strings(ids)
+
+	ct := pct.String()
+	if len(ids) > int(size) {
+		ct = utils.NewContinuousToken(ids[size]).Encode().String()
+		ids = ids[:size]
+	}
+
+	return &base.PermissionLookupSubjectResponse{
+		SubjectIds:      ids,
+		ContinuousToken: ct,
 	}, nil
 }
 
@@ -6,6 +6,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/Permify/permify/internal/config"
@@ -42,10 +43,22 @@ func (m *mockSchemaReader) HeadVersion(ctx context.Context, tenantID string) (st
 	return "", fmt.Errorf("mock schema reader error")
 }
 
+func (m *mockSchemaReader) TagVersion(ctx context.Context, tenantID, tag string) (string, error) {
+	return "", fmt.Errorf("mock schema reader error")
+}
+
 func (m *mockSchemaReader) ListSchemas(ctx context.Context, tenantID string, pagination database.Pagination) ([]*base.SchemaList, database.EncodedContinuousToken, error) {
 	return nil, nil, fmt.Errorf("mock schema reader error")
 }
 
+func (m *mockSchemaReader) ReadSchemaSource(ctx context.Context, tenantID, version string) (string, error) {
+	return "", fmt.Errorf("mock schema reader error")
+}
+
+func (m *mockSchemaReader) ReadShadow(ctx context.Context, tenantID string) (*base.SchemaShadow, error) {
+	return nil, fmt.Errorf("mock schema reader error")
+}
+
 var _ = Describe("lookup-entity-engine", func() {
 	// DRIVE SAMPLE
 
@@ -6567,6 +6580,86 @@ entity group_perms {
 		})
 	})
 
+	Context("Count Sample: Lookup", func() {
+		It("Count Sample: Case 1", func() {
+			schema := `
+			entity user {}
+
+			entity document {
+				relation approver @user
+				relation owner @user
+
+				permission publish = count(approver) >= 2 and owner
+				permission draft = count(approver) < 1
+			}
+			`
+
+			db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(schema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaWriter := factories.SchemaWriterFactory(db)
+			err = schemaWriter.WriteSchema(context.Background(), conf)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+			dataWriter := factories.DataWriterFactory(db)
+
+			var tuples []*base.Tuple
+			for _, relationship := range []string{
+				"document:1#owner@user:1",
+				"document:1#approver@user:2",
+				"document:1#approver@user:3",
+				"document:2#owner@user:1",
+				"document:2#approver@user:2",
+			} {
+				t, err := tuple.Tuple(relationship)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, t)
+			}
+
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			checkEngine := NewCheckEngine(schemaReader, dataReader)
+			lookupEngine := NewLookupEngine(checkEngine, schemaReader, dataReader)
+			invoker := invoke.NewDirectInvoker(schemaReader, dataReader, checkEngine, nil, lookupEngine, nil)
+			checkEngine.SetInvoker(invoker)
+
+			response, err := invoker.LookupEntity(context.Background(), &base.PermissionLookupEntityRequest{
+				TenantId:   "t1",
+				EntityType: "document",
+				Subject:    &base.Subject{Type: "user", Id: "1"},
+				Permission: "publish",
+				Metadata: &base.PermissionLookupEntityRequestMetadata{
+					SnapToken:     token.NewNoopToken().Encode().String(),
+					SchemaVersion: "",
+					Depth:         20,
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetEntityIds()).Should(Equal([]string{"1"}))
+
+			// A count that grants access on its own can not be looked up.
+			_, err = invoker.LookupEntity(context.Background(), &base.PermissionLookupEntityRequest{
+				TenantId:   "t1",
+				EntityType: "document",
+				Subject:    &base.Subject{Type: "user", Id: "1"},
+				Permission: "draft",
+				Metadata: &base.PermissionLookupEntityRequestMetadata{
+					SnapToken:     token.NewNoopToken().Encode().String(),
+					SchemaVersion: "",
+					Depth:         20,
+				},
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_NOT_SUPPORTED_COUNT.String()))
+		})
+	})
+
 	Context("Entity Filter Cursor", func() {
 		It("decodes empty cursor without error", func() {
 			value, err := decodeCursorValue("")
@@ -6585,4 +6678,268 @@ entity group_perms {
 			Expect(value).To(Equal("abc"))
 		})
 	})
+
+	Context("Attribute Predicates: Lookup", func() {
+		var invoker *invoke.DirectInvoker
+
+		year := func(value int32) *anypb.Any {
+			a, err := anypb.New(&base.IntegerValue{Data: value})
+			Expect(err).ShouldNot(HaveOccurred())
+			return a
+		}
+		text := func(value string) *anypb.Any {
+			a, err := anypb.New(&base.StringValue{Data: value})
+			Expect(err).ShouldNot(HaveOccurred())
+			return a
+		}
+		metadata := func() *base.PermissionLookupEntityRequestMetadata {
+			return &base.PermissionLookupEntityRequestMetadata{
+				SnapToken: token.NewNoopToken().Encode().String(),
+				Depth:     20,
+			}
+		}
+		published := []*base.AttributePredicate{
+			{Attribute: "status", Operator: base.AttributePredicate_OPERATOR_EQUAL, Value: text("published")},
+			{Attribute: "created_year", Operator: base.AttributePredicate_OPERATOR_GREATER_THAN_OR_EQUAL, Value: year(2025)},
+		}
+
+		BeforeEach(func() {
+			schema := `
+			entity user {
+				attribute department string
+			}
+
+			entity organization {
+				relation member @user
+			}
+
+			entity document {
+				relation owner @user
+				relation org @organization
+
+				attribute status string
+				attribute created_year integer
+				attribute is_public boolean
+
+				permission view = owner or org.member
+				permission read = is_public
+			}
+			`
+
+			db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			conf, err := newSchema(schema)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(factories.SchemaWriterFactory(db).WriteSchema(context.Background(), conf)).Should(Succeed())
+
+			var tuples []*base.Tuple
+			for _, relationship := range []string{
+				"document:1#owner@user:1",
+				"document:2#owner@user:1",
+				"document:3#org@organization:1#...",
+				"document:4#owner@user:1",
+				"document:5#owner@user:2",
+				"document:6#org@organization:1#...",
+				"organization:1#member@user:1",
+				"organization:1#member@user:2",
+				"organization:1#member@user:3",
+			} {
+				t, err := tuple.Tuple(relationship)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, t)
+			}
+
+			var attributes []*base.Attribute
+			for _, attr := range []string{
+				"document:1$status|string:published",
+				"document:1$created_year|integer:2025",
+				"document:2$status|string:draft",
+				"document:2$created_year|integer:2025",
+				"document:3$status|string:published",
+				"document:3$created_year|integer:2026",
+				"document:4$status|string:published",
+				"document:4$created_year|integer:2024",
+				"document:5$status|string:published",
+				"document:5$created_year|integer:2025",
+				"document:6$status|string:published",
+				"document:6$created_year|integer:2025",
+				"document:1$is_public|boolean:true",
+				"user:1$department|string:legal",
+				"user:2$department|string:sales",
+				"user:3$department|string:legal",
+				"user:4$department|string:legal",
+			} {
+				a, err := attribute.Attribute(attr)
+				Expect(err).ShouldNot(HaveOccurred())
+				attributes = append(attributes, a)
+			}
+
+			_, err = factories.DataWriterFactory(db).Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection(attributes...))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemaReader := factories.SchemaReaderFactory(db)
+			dataReader := factories.DataReaderFactory(db)
+
+			checkEngine := NewCheckEngine(schemaReader, dataReader)
+			lookupEngine := NewLookupEngine(checkEngine, schemaReader, dataReader)
+			invoker = invoke.NewDirectInvoker(schemaReader, dataReader, checkEngine, nil, lookupEngine, nil)
+			checkEngine.SetInvoker(invoker)
+		})
+
+		It("Case 1: returns the entities satisfying every predicate", func() {
+			response, err := invoker.LookupEntity(context.Background(), &base.PermissionLookupEntityRequest{
+				TenantId:   "t1",
+				EntityType: "document",
+				Subject:    &base.Subject{Type: "user", Id: "1"},
+				Permission: "view",
+				Metadata:   metadata(),
+				Predicates: published,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetEntityIds()).Should(Equal([]string{"1", "3", "6"}))
+		})
+
+		It("Case 2: paginates the entities satisfying the predicates in order", func() {
+			var ids []string
+			ct := ""
+			for {
+				response, err := invoker.LookupEntity(context.Background(), &base.PermissionLookupEntityRequest{
+					TenantId:        "t1",
+					EntityType:      "document",
+					Subject:         &base.Subject{Type: "user", Id: "1"},
+					Permission:      "view",
+					Metadata:        metadata(),
+					Predicates:      published,
+					PageSize:        2,
+					ContinuousToken: ct,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(len(response.GetEntityIds())).Should(BeNumerically("<=", 2))
+				ids = append(ids, response.GetEntityIds()...)
+				ct = response.GetContinuousToken()
+				if ct == "" {
+					break
+				}
+			}
+			Expect(ids).Should(Equal([]string{"1", "3", "6"}))
+		})
+
+		It("Case 3: returns the subjects satisfying the predicates", func() {
+			response, err := invoker.LookupSubject(context.Background(), &base.PermissionLookupSubjectRequest{
+				TenantId:         "t1",
+				Entity:           &base.Entity{Type: "document", Id: "3"},
+				Permission:       "view",
+				SubjectReference: &base.RelationReference{Type: "user"},
+				Metadata:         &base.PermissionLookupSubjectRequestMetadata{SnapToken: token.NewNoopToken().Encode().String(), Depth: 20},
+				Predicates: []*base.AttributePredicate{
+					{Attribute: "department", Operator: base.AttributePredicate_OPERATOR_EQUAL, Value: text("legal")},
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetSubjectIds()).Should(Equal([]string{"1", "3"}))
+		})
+
+		It("Case 4: narrows every subject to the ones satisfying the predicates", func() {
+			var ids []string
+			ct := ""
+			for {
+				response, err := invoker.LookupSubject(context.Background(), &base.PermissionLookupSubjectRequest{
+					TenantId:         "t1",
+					Entity:           &base.Entity{Type: "document", Id: "1"},
+					Permission:       "read",
+					SubjectReference: &base.RelationReference{Type: "user"},
+					Metadata:         &base.PermissionLookupSubjectRequestMetadata{SnapToken: token.NewNoopToken().Encode().String(), Depth: 20},
+					Predicates: []*base.AttributePredicate{
+						{Attribute: "department", Operator: base.AttributePredicate_OPERATOR_EQUAL, Value: text("legal")},
+					},
+					PageSize:        2,
+					ContinuousToken: ct,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				ids = append(ids, response.GetSubjectIds()...)
+				ct = response.GetContinuousToken()
+				if ct == "" {
+					break
+				}
+			}
+			// user:4 satisfies the predicates but is not a subject of any relationship
+			Expect(ids).Should(Equal([]string{"1", "3"}))
+		})
+
+		It("Case 5: rejects predicates on undefined attributes and of another type", func() {
+			_, err := invoker.LookupEntity(context.Background(), &base.PermissionLookupEntityRequest{
+				TenantId:   "t1",
+				EntityType: "document",
+				Subject:    &base.Subject{Type: "user", Id: "1"},
+				Permission: "view",
+				Metadata:   metadata(),
+				Predicates: []*base.AttributePredicate{
+					{Attribute: "title", Operator: base.AttributePredicate_OPERATOR_EQUAL, Value: text("report")},
+				},
+			})
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_ATTRIBUTE_DEFINITION_NOT_FOUND.String()))
+
+			_, err = invoker.LookupEntity(context.Background(), &base.PermissionLookupEntityRequest{
+				TenantId:   "t1",
+				EntityType: "document",
+				Subject:    &base.Subject{Type: "user", Id: "1"},
+				Permission: "view",
+				Metadata:   metadata(),
+				Predicates: []*base.AttributePredicate{
+					{Attribute: "created_year", Operator: base.AttributePredicate_OPERATOR_EQUAL, Value: text("2025")},
+				},
+			})
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_ATTRIBUTE_TYPE_MISMATCH.String()))
+		})
+
+		It("Case 6: evaluates the predicates on contextual attributes over the stored ones", func() {
+			response, err := invoker.LookupEntity(context.Background(), &base.PermissionLookupEntityRequest{
+				TenantId:   "t1",
+				EntityType: "document",
+				Subject:    &base.Subject{Type: "user", Id: "1"},
+				Permission: "view",
+				Metadata:   metadata(),
+				Predicates: published,
+				Context: &base.Context{
+					Attributes: []*base.Attribute{
+						{Entity: &base.Entity{Type: "document", Id: "1"}, Attribute: "status", Value: text("draft")},
+						{Entity: &base.Entity{Type: "document", Id: "2"}, Attribute: "status", Value: text("published")},
+					},
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetEntityIds()).Should(Equal([]string{"2", "3", "6"}))
+
+			var ids []string
+			ct := ""
+			for {
+				response, err := invoker.LookupSubject(context.Background(), &base.PermissionLookupSubjectRequest{
+					TenantId:         "t1",
+					Entity:           &base.Entity{Type: "document", Id: "1"},
+					Permission:       "read",
+					SubjectReference: &base.RelationReference{Type: "user"},
+					Metadata:         &base.PermissionLookupSubjectRequestMetadata{SnapToken: token.NewNoopToken().Encode().String(), Depth: 20},
+					Predicates: []*base.AttributePredicate{
+						{Attribute: "department", Operator: base.AttributePredicate_OPERATOR_EQUAL, Value: text("legal")},
+					},
+					Context: &base.Context{
+						Attributes: []*base.Attribute{
+							{Entity: &base.Entity{Type: "user", Id: "1"}, Attribute: "department", Value: text("sales")},
+							{Entity: &base.Entity{Type: "user", Id: "2"}, Attribute: "department", Value: text("legal")},
+						},
+					},
+					PageSize:        1,
+					ContinuousToken: ct,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				ids = append(ids, response.GetSubjectIds()...)
+				ct = response.GetContinuousToken()
+				if ct == "" {
+					break
+				}
+			}
+			Expect(ids).Should(Equal([]string{"2", "3"}))
+		})
+	})
 })
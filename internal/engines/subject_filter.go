@@ -185,6 +185,9 @@ func (engine *SubjectFilter) subjectFilterLeaf(
 	case *base.Leaf_Call:
 
 		return engine.subjectFilterCall(request, op.Call)
+	// If the type is Count, the result does not depend on the subject, so we prepare a function using subjectFilterCount
+	case *base.Leaf_Count:
+		return engine.subjectFilterCount(request, op.Count)
 	// If the leaf type is not recognized, we return a function that always fails with an error indicating undefined child type.
 	default:
 		return subjectFilterFail(errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_TYPE.String()))
@@ -285,6 +288,27 @@ func (engine *SubjectFilter) subjectFilterDirectAttribute(
 	}
 }
 
+// subjectFilterCount constructs a SubjectFilterFunction for a Count leaf. Since a count
+// does not depend on the subject, either every subject or none of them are returned.
+func (engine *SubjectFilter) subjectFilterCount(
+	request *base.PermissionLookupSubjectRequest,
+	count *base.Count,
+) SubjectFilterFunction {
+	return func(ctx context.Context) ([]string, error) {
+		c, err := countSubjects(ctx, engine.dataReader, request.GetTenantId(), request.GetEntity(), count.GetRelation(), request.GetMetadata().GetSnapToken(), request.GetContext().GetTuples())
+		if err != nil {
+			return subjectFilterEmpty(), err
+		}
+
+		// If the count satisfies the comparison, every subject is allowed.
+		if compareCount(c, count) {
+			return []string{ALL}, nil
+		}
+
+		return subjectFilterEmpty(), nil
+	}
+}
+
 func (engine *SubjectFilter) subjectFilterCall(
 	request *base.PermissionLookupSubjectRequest,
 	call *base.Call,
@@ -1,16 +1,23 @@
 package engines
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/Permify/permify/internal/schema"
+	"github.com/Permify/permify/internal/storage"
+	storageContext "github.com/Permify/permify/internal/storage/context"
 	"github.com/Permify/permify/pkg/attribute"
+	"github.com/Permify/permify/pkg/database"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 	"github.com/Permify/permify/pkg/tuple"
 )
@@ -66,6 +73,25 @@ func joinResponseMetas(meta ...*base.PermissionCheckResponseMetadata) *base.Perm
 	return response
 }
 
+// DeprecationWarnings - a helper function that returns the warnings for the given names that are deprecated in the entity definition.
+func DeprecationWarnings(en *base.EntityDefinition, names ...string) (warnings []string) {
+	for _, name := range names {
+		warning := schema.GetDeprecationWarningByNameInEntityDefinition(en, name)
+		if warning != "" && !slices.Contains(warnings, warning) {
+			warnings = append(warnings, warning)
+		}
+	}
+	return warnings
+}
+
+// setWarningHeader - a helper function that sends the warnings of a streamed lookup in the "warning" header of the stream.
+func setWarningHeader(server grpc.ServerStream, warnings []string) error {
+	if len(warnings) == 0 {
+		return nil
+	}
+	return server.SetHeader(metadata.MD{"warning": warnings})
+}
+
 // SubjectPermissionResponse - a struct that holds a SubjectPermissionResponse and an error for a single subject permission check result.
 type SubjectPermissionResponse struct {
 	permission string
@@ -366,3 +392,225 @@ func IsRelational(en *base.EntityDefinition, permission string) bool {
 
 	return isRelational
 }
+
+// countSubjects counts the distinct subjects, by type and id, related to the given entity through the given
+// relation. Contextual tuples are taken into account; subjects present both in the storage and in the
+// contextual tuples are counted once. Wildcard subjects are not counted.
+func countSubjects(ctx context.Context, dataReader storage.DataReader, tenantID string, entity *base.Entity, relation, snap string, contextual []*base.Tuple) (int, error) {
+	filter := &base.TupleFilter{
+		Entity: &base.EntityFilter{
+			Type: entity.GetType(),
+			Ids:  []string{entity.GetId()},
+		},
+		Relation: relation,
+	}
+
+	cti, err := storageContext.NewContextualTuples(contextual...).QueryRelationships(filter, database.NewCursorPagination())
+	if err != nil {
+		return 0, err
+	}
+
+	// Without matching contextual tuples the storage can count on its own.
+	if !cti.HasNext() {
+		return dataReader.CountSubjects(ctx, tenantID, filter, snap)
+	}
+
+	rit, err := dataReader.QueryRelationships(ctx, tenantID, filter, snap, database.NewCursorPagination())
+	if err != nil {
+		return 0, err
+	}
+
+	subjects := map[string]struct{}{}
+	it := database.NewUniqueTupleIterator(rit, cti)
+	for it.HasNext() {
+		t, ok := it.GetNext()
+		if !ok {
+			break
+		}
+		if tuple.IsWildcard(t.GetSubject()) {
+			continue
+		}
+		subjects[fmt.Sprintf(tuple.ENTITY, t.GetSubject().GetType(), t.GetSubject().GetId())] = struct{}{}
+	}
+
+	return len(subjects), nil
+}
+
+// predicateChunkSize is the number of entity IDs whose predicates are evaluated by a single storage query.
+const predicateChunkSize = 1000
+
+// attributePredicates evaluates attribute predicates on the entities of a type. The predicates are pushed down
+// to the storage through the entity filter, except for the entities that have contextual values for the
+// attributes they name: the contextual values take precedence over the stored ones, so these entities are
+// evaluated in memory once their stored and contextual attributes are merged.
+type attributePredicates struct {
+	dataReader storage.DataReader
+	tenantID   string
+	entityType string
+	snap       string
+	predicates []*base.AttributePredicate
+	// overridden holds the result of the entities that have contextual values for the predicate attributes
+	overridden map[string]bool
+}
+
+// newAttributePredicates validates the predicates against the attribute definitions of the entity type and
+// evaluates them on the entities whose predicate attributes are overridden by contextual attributes.
+func newAttributePredicates(ctx context.Context, dataReader storage.DataReader, sc *base.SchemaDefinition, tenantID, entityType, snap string, contextual []*base.Attribute, predicates []*base.AttributePredicate) (*attributePredicates, error) {
+	definition, ok := sc.GetEntityDefinitions()[entityType]
+	if !ok {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_ENTITY_DEFINITION_NOT_FOUND.String())
+	}
+
+	names := make([]string, 0, len(predicates))
+	for _, predicate := range predicates {
+		ad, ok := definition.GetAttributes()[predicate.GetAttribute()]
+		if !ok {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_ATTRIBUTE_DEFINITION_NOT_FOUND.String())
+		}
+		if err := attribute.ValidatePredicate(predicate, ad.GetType()); err != nil {
+			return nil, err
+		}
+		if !slices.Contains(names, predicate.GetAttribute()) {
+			names = append(names, predicate.GetAttribute())
+		}
+	}
+
+	p := &attributePredicates{
+		dataReader: dataReader,
+		tenantID:   tenantID,
+		entityType: entityType,
+		snap:       snap,
+		predicates: predicates,
+		overridden: make(map[string]bool),
+	}
+
+	// The values of the overridden entities, contextual values are set last so that they take precedence.
+	values := make(map[string]map[string]*anypb.Any)
+	var ids []string
+	for _, attr := range contextual {
+		if attr.GetEntity().GetType() != entityType || !slices.Contains(names, attr.GetAttribute()) {
+			continue
+		}
+		if _, ok := values[attr.GetEntity().GetId()]; !ok {
+			values[attr.GetEntity().GetId()] = make(map[string]*anypb.Any)
+			ids = append(ids, attr.GetEntity().GetId())
+		}
+	}
+	if len(ids) == 0 {
+		return p, nil
+	}
+
+	it, err := dataReader.QueryAttributes(ctx, tenantID, &base.AttributeFilter{
+		Entity:     &base.EntityFilter{Type: entityType, Ids: ids},
+		Attributes: names,
+	}, snap, database.NewCursorPagination())
+	if err != nil {
+		return nil, err
+	}
+	for it.HasNext() {
+		attr := it.GetNext()
+		values[attr.GetEntity().GetId()][attr.GetAttribute()] = attr.GetValue()
+	}
+	for _, attr := range contextual {
+		if v, ok := values[attr.GetEntity().GetId()]; ok && attr.GetEntity().GetType() == entityType && slices.Contains(names, attr.GetAttribute()) {
+			v[attr.GetAttribute()] = attr.GetValue()
+		}
+	}
+
+	for id, v := range values {
+		matches := true
+		for _, predicate := range predicates {
+			value, ok := v[predicate.GetAttribute()]
+			if !ok {
+				matches = false
+				break
+			}
+			if matches, err = attribute.MatchesPredicate(predicate, value); err != nil {
+				return nil, err
+			}
+			if !matches {
+				break
+			}
+		}
+		p.overridden[id] = matches
+	}
+
+	return p, nil
+}
+
+// matching returns the given entity IDs whose attributes satisfy every predicate.
+func (p *attributePredicates) matching(ctx context.Context, ids []string) (map[string]struct{}, error) {
+	matching := make(map[string]struct{})
+
+	var stored []string
+	for _, id := range ids {
+		if matches, ok := p.overridden[id]; ok {
+			if matches {
+				matching[id] = struct{}{}
+			}
+			continue
+		}
+		stored = append(stored, id)
+	}
+
+	for start := 0; start < len(stored); start += predicateChunkSize {
+		chunk := stored[start:min(start+predicateChunkSize, len(stored))]
+
+		// Every matching entity has the attribute of the first predicate, querying it alone yields one row per entity.
+		it, err := p.dataReader.QueryAttributes(ctx, p.tenantID, &base.AttributeFilter{
+			Entity:     &base.EntityFilter{Type: p.entityType, Ids: chunk, Predicates: p.predicates},
+			Attributes: []string{p.predicates[0].GetAttribute()},
+		}, p.snap, database.NewCursorPagination())
+		if err != nil {
+			return nil, err
+		}
+		for it.HasNext() {
+			attr := it.GetNext()
+			matching[attr.GetEntity().GetId()] = struct{}{}
+		}
+	}
+
+	return matching, nil
+}
+
+// filterRequests keeps the bulk checker requests whose entities satisfy the predicates.
+func (p *attributePredicates) filterRequests(ctx context.Context, requests []BulkCheckerRequest) ([]BulkCheckerRequest, error) {
+	ids := make([]string, 0, len(requests))
+	for _, request := range requests {
+		ids = append(ids, request.Request.GetEntity().GetId())
+	}
+
+	matching, err := p.matching(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]BulkCheckerRequest, 0, len(matching))
+	for _, request := range requests {
+		if _, ok := matching[request.Request.GetEntity().GetId()]; ok {
+			filtered = append(filtered, request)
+		}
+	}
+	return filtered, nil
+}
+
+// compareCount reports whether the given count satisfies the comparison of the Count leaf.
+func compareCount(count int, c *base.Count) bool {
+	value := int(c.GetValue())
+	switch c.GetComparison() {
+	case base.Count_COMPARISON_EQUAL:
+		return count == value
+	case base.Count_COMPARISON_NOT_EQUAL:
+		return count != value
+	case base.Count_COMPARISON_GREATER_THAN:
+		return count > value
+	case base.Count_COMPARISON_GREATER_THAN_OR_EQUAL:
+		return count >= value
+	case base.Count_COMPARISON_LESS_THAN:
+		return count < value
+	case base.Count_COMPARISON_LESS_THAN_OR_EQUAL:
+		return count <= value
+	default:
+		return false
+	}
+}
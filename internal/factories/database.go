@@ -29,6 +29,10 @@ import (
 //	- WatchBufferSize: specifies the buffer size for database watch operations, impacting how many changes can be queued
 //	- MaxDataPerWrite: sets the maximum amount of data per write operation to the database
 //	- MaxRetries: defines the maximum number of retries for database operations in case of failure
+//	- IdempotencyKeyRetention: how long the idempotency key of a write is remembered
+//	- Reader: the read replicas the reads are routed among, how long a read waits for one to replay its snapshot and how often their health is checked
+//	- GarbageCollection: its window limits how far back data can be read at a point in time when it is enabled, and
+//	  how long the memory engine keeps the transactions of the writes
 //
 // Returns a database.Database instance if the database connection is successfully created, or an error if the
 // creation fails or the specified database engine is unsupported.
@@ -78,9 +82,29 @@ func DatabaseFactory(conf config.Database) (db database.Database, err error) {
 		if conf.ConnectTimeout > 0 {
 			opts = append(opts, PQDatabase.ConnectTimeout(conf.ConnectTimeout))
 		}
+		if conf.IdempotencyKeyRetention > 0 {
+			opts = append(opts, PQDatabase.IdempotencyKeyRetention(conf.IdempotencyKeyRetention))
+		}
+		if conf.GarbageCollection.Enabled {
+			opts = append(opts, PQDatabase.GarbageCollectionWindow(conf.GarbageCollection.Window))
+		}
+
+		if conf.Reader.ReplicaWait > 0 {
+			opts = append(opts, PQDatabase.ReplicaWait(conf.Reader.ReplicaWait))
+		}
+		if conf.Reader.HealthCheckInterval > 0 {
+			opts = append(opts, PQDatabase.ReplicaHealthCheckInterval(conf.Reader.HealthCheckInterval))
+		}
 
 		if conf.URI == "" {
-			db, err = PQDatabase.NewWithSeparateURIs(conf.Writer.URI, conf.Reader.URI, opts...)
+			// Reads are routed among the reader and the additional replicas, or sent to the writer if there are none
+			var readers []string
+			for _, uri := range append([]string{conf.Reader.URI}, conf.Reader.URIs...) {
+				if uri != "" {
+					readers = append(readers, uri)
+				}
+			}
+			db, err = PQDatabase.NewWithReplicas(conf.Writer.URI, readers, opts...)
 			if err != nil {
 				return nil, err
 			}
@@ -101,7 +125,14 @@ func DatabaseFactory(conf config.Database) (db database.Database, err error) {
 
 		return db, err
 	case database.MEMORY.String():
-		db, err = IMDatabase.New(migrations.Schema)
+		var opts []IMDatabase.Option
+		if conf.IdempotencyKeyRetention > 0 {
+			opts = append(opts, IMDatabase.IdempotencyKeyRetention(conf.IdempotencyKeyRetention))
+		}
+		if conf.GarbageCollection.Window > 0 {
+			opts = append(opts, IMDatabase.TransactionRetention(conf.GarbageCollection.Window))
+		}
+		db, err = IMDatabase.New(migrations.Schema, opts...)
 		if err != nil {
 			return nil, err
 		}
@@ -3,10 +3,13 @@ package factories
 import (
 	"github.com/Permify/permify/internal/storage"
 	MMRepository "github.com/Permify/permify/internal/storage/memory"
+	MMSnapshot "github.com/Permify/permify/internal/storage/memory/snapshot"
 	PQRepository "github.com/Permify/permify/internal/storage/postgres"
+	PQSnapshot "github.com/Permify/permify/internal/storage/postgres/snapshot"
 	"github.com/Permify/permify/pkg/database"
 	MMDatabase "github.com/Permify/permify/pkg/database/memory"
 	PQDatabase "github.com/Permify/permify/pkg/database/postgres"
+	"github.com/Permify/permify/pkg/token"
 )
 
 // DataReaderFactory creates and returns a DataReader based on the database engine type.
@@ -24,6 +27,22 @@ func DataReaderFactory(db database.Database) (repo storage.DataReader) {
 	}
 }
 
+// SnapTokenDecoderFactory creates and returns the decoder of the snap tokens of the database engine type.
+func SnapTokenDecoderFactory(db database.Database) func(value string) (token.SnapToken, error) {
+	switch db.GetEngineType() {
+	case "postgres":
+		// If the database engine is Postgres, decode the tokens of the Postgres snapshots
+		return func(value string) (token.SnapToken, error) {
+			return PQSnapshot.EncodedToken{Value: value}.Decode()
+		}
+	default:
+		// For any other type, decode the tokens of the in-memory snapshots
+		return func(value string) (token.SnapToken, error) {
+			return MMSnapshot.EncodedToken{Value: value}.Decode()
+		}
+	}
+}
+
 // DataWriterFactory creates and returns a DataWriter based on the database engine type.
 func DataWriterFactory(db database.Database) (repo storage.DataWriter) {
 	switch db.GetEngineType() {
@@ -0,0 +1,232 @@
+package importer
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/validation"
+	"github.com/Permify/permify/pkg/attribute"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// MaxRejections - Number of rejected rows listed in the summary of an import, the rest are only counted
+const MaxRejections = 1000
+
+// Importer - Validates tuples and attributes against the schema of a tenant one by one and bulk loads the valid ones.
+// Invalid rows are rejected and listed in the summary instead of failing the import. Cardinality is enforced by the
+// storage when the rows are committed.
+type Importer struct {
+	schemaReader   storage.SchemaReader
+	dataImport     storage.DataImport
+	tenantID       string
+	version        string
+	commitInterval int
+
+	// definitions read so far, a nil definition means the entity type is not in the schema
+	definitions map[string]*base.EntityDefinition
+	// rows loaded since the last commit, and whether anything has been committed yet
+	uncommitted int
+	committed   bool
+	// position of the next row in the stream
+	position uint64
+
+	summary *base.DataImportResponse
+}
+
+// NewImporter - Starts an import into a tenant. The rows are validated against the schema version of the metadata,
+// or the head version when it is empty, and committed every commit interval rows, or once at the end when it is zero.
+func NewImporter(ctx context.Context, schemaReader storage.SchemaReader, dataWriter storage.DataWriter, tenantID string, metadata *base.DataImportRequestMetadata) (*Importer, error) {
+	version := metadata.GetSchemaVersion()
+	if version == "" {
+		v, err := schemaReader.HeadVersion(ctx, tenantID)
+		if err != nil {
+			return nil, err
+		}
+		version = v
+	}
+
+	dataImport, err := dataWriter.Import(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Importer{
+		schemaReader:   schemaReader,
+		dataImport:     dataImport,
+		tenantID:       tenantID,
+		version:        version,
+		commitInterval: int(metadata.GetCommitInterval()),
+		definitions:    map[string]*base.EntityDefinition{},
+		summary:        &base.DataImportResponse{},
+	}, nil
+}
+
+// Load - Validates a chunk of tuples and attributes, loads the valid ones and commits once the commit interval is reached.
+// Returns whether the chunk was committed.
+func (i *Importer) Load(ctx context.Context, tuples []*base.Tuple, attributes []*base.Attribute) (committed bool, err error) {
+	validTuples := make([]*base.Tuple, 0, len(tuples))
+	for _, tup := range tuples {
+		reason, err := i.validateTuple(ctx, tup)
+		if err != nil {
+			return false, err
+		}
+		if reason != "" {
+			i.reject(tuple.ToString(tup), reason)
+			continue
+		}
+		validTuples = append(validTuples, tup)
+		i.position++
+	}
+
+	validAttributes := make([]*base.Attribute, 0, len(attributes))
+	for _, attr := range attributes {
+		reason, err := i.validateAttribute(ctx, attr)
+		if err != nil {
+			return false, err
+		}
+		if reason != "" {
+			i.reject(attributeToString(attr), reason)
+			continue
+		}
+		validAttributes = append(validAttributes, attr)
+		i.position++
+	}
+
+	if err = i.dataImport.Load(ctx, validTuples, validAttributes); err != nil {
+		return false, err
+	}
+
+	i.summary.Tuples += uint64(len(validTuples))
+	i.summary.Attributes += uint64(len(validAttributes))
+	i.uncommitted += len(validTuples) + len(validAttributes)
+
+	if i.commitInterval > 0 && i.uncommitted >= i.commitInterval {
+		return true, i.commit(ctx)
+	}
+
+	return false, nil
+}
+
+// Summary - Returns the summary of the import so far
+func (i *Importer) Summary() *base.DataImportResponse {
+	return i.summary
+}
+
+// Finish - Commits the rows loaded since the last commit and returns the summary of the import
+func (i *Importer) Finish(ctx context.Context) (*base.DataImportResponse, error) {
+	if i.uncommitted > 0 || !i.committed {
+		if err := i.commit(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return i.summary, nil
+}
+
+// Close - Rolls back the rows loaded since the last commit
+func (i *Importer) Close(ctx context.Context) error {
+	return i.dataImport.Close(ctx)
+}
+
+// commit commits the current transaction of the import and keeps its snap token
+func (i *Importer) commit(ctx context.Context) error {
+	snap, err := i.dataImport.Commit(ctx)
+	if err != nil {
+		return err
+	}
+
+	i.summary.SnapToken = snap.String()
+	i.summary.Commits++
+	i.summary.CommittedTuples = i.summary.GetTuples()
+	i.summary.CommittedAttributes = i.summary.GetAttributes()
+	i.uncommitted = 0
+	i.committed = true
+
+	slog.InfoContext(ctx, "import committed",
+		slog.String("tenant_id", i.tenantID),
+		slog.Uint64("tuples", i.summary.GetTuples()),
+		slog.Uint64("attributes", i.summary.GetAttributes()),
+		slog.Uint64("rejected", i.summary.GetRejected()))
+
+	return nil
+}
+
+// reject records a rejected row at the current position
+func (i *Importer) reject(row, reason string) {
+	if len(i.summary.Rejections) < MaxRejections {
+		i.summary.Rejections = append(i.summary.Rejections, &base.DataImportRejection{
+			Position: i.position,
+			Row:      row,
+			Reason:   reason,
+		})
+	}
+	i.summary.Rejected++
+	i.position++
+}
+
+// validateTuple returns the reason a tuple is rejected, or an empty string when it is valid
+func (i *Importer) validateTuple(ctx context.Context, tup *base.Tuple) (string, error) {
+	if v := tup.Validate(); v != nil {
+		return v.Error(), nil
+	}
+	definition, err := i.definition(ctx, tup.GetEntity().GetType())
+	if err != nil {
+		return "", err
+	}
+	if definition == nil {
+		return base.ErrorCode_ERROR_CODE_ENTITY_DEFINITION_NOT_FOUND.String(), nil
+	}
+	if err = validation.ValidateTuple(definition, tup); err != nil {
+		return err.Error(), nil
+	}
+	return "", nil
+}
+
+// validateAttribute returns the reason an attribute is rejected, or an empty string when it is valid
+func (i *Importer) validateAttribute(ctx context.Context, attr *base.Attribute) (string, error) {
+	if v := attr.Validate(); v != nil {
+		return v.Error(), nil
+	}
+	definition, err := i.definition(ctx, attr.GetEntity().GetType())
+	if err != nil {
+		return "", err
+	}
+	if definition == nil {
+		return base.ErrorCode_ERROR_CODE_ENTITY_DEFINITION_NOT_FOUND.String(), nil
+	}
+	if err = validation.ValidateAttribute(definition, attr); err != nil {
+		return err.Error(), nil
+	}
+	return "", nil
+}
+
+// definition reads the definition of an entity type once, it is nil when the entity type is not in the schema
+func (i *Importer) definition(ctx context.Context, entityType string) (*base.EntityDefinition, error) {
+	if definition, ok := i.definitions[entityType]; ok {
+		return definition, nil
+	}
+	definition, _, err := i.schemaReader.ReadEntityDefinition(ctx, i.tenantID, entityType, i.version)
+	if err != nil {
+		if !isDefinitionNotFound(err) {
+			return nil, err
+		}
+		definition = nil
+	}
+	i.definitions[entityType] = definition
+	return definition, nil
+}
+
+// isDefinitionNotFound reports whether reading an entity definition failed because the entity type is not in the schema
+func isDefinitionNotFound(err error) bool {
+	return err.Error() == base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String() ||
+		err.Error() == base.ErrorCode_ERROR_CODE_ENTITY_DEFINITION_NOT_FOUND.String()
+}
+
+// attributeToString converts an attribute to its string form, without the value when it is missing
+func attributeToString(attr *base.Attribute) string {
+	if attr.GetValue() == nil {
+		return attribute.EntityAndAttributeToString(attr.GetEntity(), attr.GetAttribute())
+	}
+	return attribute.ToString(attr)
+}
@@ -0,0 +1,182 @@
+package importer_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/internal/importer"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/database"
+	"github.com/Permify/permify/pkg/dsl/compiler"
+	"github.com/Permify/permify/pkg/dsl/parser"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+func TestImporter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "importer suite")
+}
+
+var _ = Describe("importer", func() {
+	var db database.Database
+
+	tuples := func(relationships ...string) []*base.Tuple {
+		var tuples []*base.Tuple
+		for _, relationship := range relationships {
+			t, err := tuple.Tuple(relationship)
+			Expect(err).ShouldNot(HaveOccurred())
+			tuples = append(tuples, t)
+		}
+		return tuples
+	}
+
+	stored := func() []string {
+		collection, _, err := factories.DataReaderFactory(db).ReadRelationships(context.Background(), "t1", &base.TupleFilter{Entity: &base.EntityFilter{Type: "doc"}}, "", database.NewPagination(database.Size(100)))
+		Expect(err).ShouldNot(HaveOccurred())
+		var relationships []string
+		for _, t := range collection.GetTuples() {
+			relationships = append(relationships, tuple.ToString(t))
+		}
+		return relationships
+	}
+
+	BeforeEach(func() {
+		var err error
+		db, err = factories.DatabaseFactory(config.Database{Engine: "memory"})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		sch, err := parser.NewParser(`
+		entity user {}
+
+		entity doc {
+			relation owner @user
+			attribute public boolean
+		}
+		`).Parse()
+		Expect(err).ShouldNot(HaveOccurred())
+		_, _, err = compiler.NewCompiler(true, sch).Compile()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var definitions []storage.SchemaDefinition
+		for _, st := range sch.Statements {
+			definitions = append(definitions, storage.SchemaDefinition{
+				TenantID:             "t1",
+				Version:              "v1",
+				Name:                 st.GetName(),
+				SerializedDefinition: []byte(st.String()),
+			})
+		}
+		Expect(factories.SchemaWriterFactory(db).WriteSchema(context.Background(), definitions)).Should(Succeed())
+	})
+
+	Context("Import", func() {
+		It("Loads valid rows and rejects invalid ones", func() {
+			ctx := context.Background()
+			imp, err := importer.NewImporter(ctx, factories.SchemaReaderFactory(db), factories.DataWriterFactory(db), "t1", &base.DataImportRequestMetadata{})
+			Expect(err).ShouldNot(HaveOccurred())
+			defer imp.Close(ctx)
+
+			value, err := anypb.New(&base.BooleanValue{Data: true})
+			Expect(err).ShouldNot(HaveOccurred())
+			wrong, err := anypb.New(&base.StringValue{Data: "yes"})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			committed, err := imp.Load(ctx, tuples("doc:1#owner@user:1", "doc:1#editor@user:2", "folder:1#owner@user:1"), []*base.Attribute{
+				{Entity: &base.Entity{Type: "doc", Id: "1"}, Attribute: "public", Value: value},
+				{Entity: &base.Entity{Type: "doc", Id: "2"}, Attribute: "public", Value: wrong},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(committed).Should(BeFalse())
+
+			_, err = imp.Load(ctx, tuples("doc:2#owner@user:2"), nil)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			summary, err := imp.Finish(ctx)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(summary.GetSnapToken()).ShouldNot(BeEmpty())
+			Expect(summary.GetTuples()).Should(Equal(uint64(2)))
+			Expect(summary.GetAttributes()).Should(Equal(uint64(1)))
+			Expect(summary.GetRejected()).Should(Equal(uint64(3)))
+
+			var positions []uint64
+			for _, rejection := range summary.GetRejections() {
+				positions = append(positions, rejection.GetPosition())
+			}
+			Expect(positions).Should(Equal([]uint64{1, 2, 4}))
+			Expect(summary.GetRejections()[1].GetReason()).Should(Equal(base.ErrorCode_ERROR_CODE_ENTITY_DEFINITION_NOT_FOUND.String()))
+
+			Expect(stored()).Should(ConsistOf("doc:1#owner@user:1", "doc:2#owner@user:2"))
+		})
+
+		It("Commits every commit interval rows", func() {
+			ctx := context.Background()
+			imp, err := importer.NewImporter(ctx, factories.SchemaReaderFactory(db), factories.DataWriterFactory(db), "t1", &base.DataImportRequestMetadata{CommitInterval: 2})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			committed, err := imp.Load(ctx, tuples("doc:1#owner@user:1"), nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(committed).Should(BeFalse())
+
+			committed, err = imp.Load(ctx, tuples("doc:2#owner@user:1"), nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(committed).Should(BeTrue())
+			Expect(stored()).Should(HaveLen(2))
+
+			_, err = imp.Load(ctx, tuples("doc:3#owner@user:1"), nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(imp.Summary().GetCommits()).Should(Equal(uint64(1)))
+			Expect(imp.Summary().GetCommittedTuples()).Should(Equal(uint64(2)))
+			Expect(imp.Summary().GetTuples()).Should(Equal(uint64(3)))
+
+			// closing without finishing rolls back the rows loaded since the last commit
+			Expect(imp.Close(ctx)).Should(Succeed())
+			Expect(stored()).Should(HaveLen(2))
+		})
+	})
+
+	Context("Reader", func() {
+		It("Reads NDJSON rows in chunks", func() {
+			reader, err := importer.NewReader(importer.FormatNDJSON, strings.NewReader(`{"tuple": "doc:1#owner@user:1"}
+
+{"tuple": {"entity": {"type": "doc", "id": "2"}, "relation": "owner", "subject": {"type": "user", "id": "2"}}}
+{"attribute": "doc:1$public|boolean:true"}
+`), 2)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			chunk, err := reader.Next()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(chunk.GetTuples()).Should(HaveLen(2))
+			Expect(tuple.ToString(chunk.GetTuples()[1])).Should(Equal("doc:2#owner@user:2"))
+
+			chunk, err = reader.Next()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(chunk.GetAttributes()).Should(HaveLen(1))
+
+			_, err = reader.Next()
+			Expect(err).Should(Equal(io.EOF))
+		})
+
+		It("Reports the line of a malformed row", func() {
+			reader, err := importer.NewReader(importer.FormatNDJSON, strings.NewReader("{\"tuple\": \"doc:1#owner@user:1\"}\n{\"relation\": \"owner\"}\n"), 10)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = reader.Next()
+			Expect(err).Should(MatchError(ContainSubstring("line 2")))
+		})
+
+		It("Rejects unknown formats", func() {
+			_, err := importer.NewReader("csv", strings.NewReader(""), 10)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,146 @@
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/Permify/permify/pkg/attribute"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// Input formats of an import
+const (
+	FormatNDJSON = "ndjson"
+	FormatProto  = "proto"
+)
+
+// Reader - Reads the rows of an import in chunks
+type Reader interface {
+	// Next returns the next chunk of rows, or io.EOF when there are no rows left
+	Next() (*base.DataImportRequest, error)
+}
+
+// NewReader - Creates a reader of the given format. NDJSON lines are grouped in chunks of chunkSize rows, proto input
+// is a stream of size delimited DataImportRequest messages, each one being a chunk.
+func NewReader(format string, r io.Reader, chunkSize int) (Reader, error) {
+	switch format {
+	case FormatNDJSON:
+		if chunkSize <= 0 {
+			return nil, errors.New("chunk size must be positive")
+		}
+		return &ndjsonReader{reader: bufio.NewReader(r), chunkSize: chunkSize}, nil
+	case FormatProto:
+		return &protoReader{reader: bufio.NewReader(r)}, nil
+	default:
+		return nil, fmt.Errorf("unknown import format %q, expected %s or %s", format, FormatNDJSON, FormatProto)
+	}
+}
+
+// ndjsonRow is a line of NDJSON input. Either field holds the JSON form of the row or its string notation,
+// such as "document:1#owner@user:1" or "document:1$public|boolean:true".
+type ndjsonRow struct {
+	Tuple     json.RawMessage `json:"tuple"`
+	Attribute json.RawMessage `json:"attribute"`
+}
+
+// ndjsonReader reads one tuple or attribute per line
+type ndjsonReader struct {
+	reader    *bufio.Reader
+	chunkSize int
+	line      int
+}
+
+// Next reads up to chunk size rows, skipping empty lines
+func (r *ndjsonReader) Next() (*base.DataImportRequest, error) {
+	chunk := &base.DataImportRequest{}
+	for len(chunk.GetTuples())+len(chunk.GetAttributes()) < r.chunkSize {
+		line, err := r.reader.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		if len(line) > 0 {
+			r.line++
+			if perr := r.parse(bytes.TrimSpace(line), chunk); perr != nil {
+				return nil, fmt.Errorf("line %d: %w", r.line, perr)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			if len(chunk.GetTuples())+len(chunk.GetAttributes()) == 0 {
+				return nil, io.EOF
+			}
+			break
+		}
+	}
+	return chunk, nil
+}
+
+// parse adds the row of a line to the chunk
+func (r *ndjsonReader) parse(line []byte, chunk *base.DataImportRequest) error {
+	if len(line) == 0 {
+		return nil
+	}
+
+	var row ndjsonRow
+	if err := json.Unmarshal(line, &row); err != nil {
+		return err
+	}
+
+	switch {
+	case len(row.Tuple) > 0 && len(row.Attribute) == 0:
+		t := &base.Tuple{}
+		if row.Tuple[0] == '"' {
+			var notation string
+			if err := json.Unmarshal(row.Tuple, &notation); err != nil {
+				return err
+			}
+			var err error
+			if t, err = tuple.Tuple(notation); err != nil {
+				return err
+			}
+		} else if err := protojson.Unmarshal(row.Tuple, t); err != nil {
+			return err
+		}
+		chunk.Tuples = append(chunk.Tuples, t)
+	case len(row.Attribute) > 0 && len(row.Tuple) == 0:
+		a := &base.Attribute{}
+		if row.Attribute[0] == '"' {
+			var notation string
+			if err := json.Unmarshal(row.Attribute, &notation); err != nil {
+				return err
+			}
+			var err error
+			if a, err = attribute.Attribute(notation); err != nil {
+				return err
+			}
+		} else if err := protojson.Unmarshal(row.Attribute, a); err != nil {
+			return err
+		}
+		chunk.Attributes = append(chunk.Attributes, a)
+	default:
+		return errors.New("expected exactly one of tuple or attribute")
+	}
+
+	return nil
+}
+
+// protoReader reads size delimited DataImportRequest messages
+type protoReader struct {
+	reader *bufio.Reader
+}
+
+// Next reads the next message
+func (r *protoReader) Next() (*base.DataImportRequest, error) {
+	chunk := &base.DataImportRequest{}
+	if err := protodelim.UnmarshalFrom(r.reader, chunk); err != nil {
+		return nil, err
+	}
+	return chunk, nil
+}
@@ -0,0 +1,170 @@
+package invoke
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Permify/permify/internal/storage"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+)
+
+// DefaultSnapshotRefreshInterval is how long the recent snapshot of a tenant is shared by the requests
+// that minimize latency before it is refreshed.
+const DefaultSnapshotRefreshInterval = 5 * time.Second
+
+// maxRecentSnapshots is the number of tenants whose recent snapshot is kept at once.
+const maxRecentSnapshots = 10000
+
+// SnapTokenDecoder decodes the snap tokens of a storage engine.
+type SnapTokenDecoder func(value string) (token.SnapToken, error)
+
+// Snapshots selects the snapshot reads are answered at, given their consistency.
+type Snapshots struct {
+	// dataReader reads the head and point in time snapshots
+	dataReader storage.DataReader
+	// decode decodes the snap tokens of the storage engine, nil if they can not be compared
+	decode SnapTokenDecoder
+	// interval is how long a recent snapshot is shared before it is refreshed
+	interval time.Duration
+
+	mu     sync.Mutex
+	recent map[string]recentSnapshot
+}
+
+// recentSnapshot is the snapshot of a tenant shared by the requests that minimize latency.
+type recentSnapshot struct {
+	token     token.SnapToken
+	refreshed time.Time
+}
+
+// NewSnapshots creates a snapshot selector. Without a decoder, reads at least as fresh as a snap token are
+// answered at the head snapshot. A non positive interval refreshes the recent snapshot on every read.
+func NewSnapshots(dataReader storage.DataReader, decode SnapTokenDecoder, interval time.Duration) *Snapshots {
+	return &Snapshots{
+		dataReader: dataReader,
+		decode:     decode,
+		interval:   interval,
+		recent:     make(map[string]recentSnapshot),
+	}
+}
+
+// Select returns the encoded snap token a read of the tenant is answered at. A read that sets a consistency is
+// answered as it requires. Otherwise it is answered at its snap token if it sets one, at its at time if it sets
+// one and at the head snapshot of the tenant if it sets neither.
+func (s *Snapshots) Select(ctx context.Context, tenantID string, consistency *base.Consistency, snapToken string, at *timestamppb.Timestamp) (string, error) {
+	switch requirement := consistency.GetRequirement().(type) {
+	case *base.Consistency_MinimizeLatency:
+		st, err := s.recentSnapshot(ctx, tenantID)
+		if err != nil {
+			return "", err
+		}
+		return st.Encode().String(), nil
+	case *base.Consistency_AtLeastAsFresh:
+		return s.atLeastAsFresh(ctx, tenantID, requirement.AtLeastAsFresh)
+	case *base.Consistency_AtExactSnapshot:
+		if s.decode != nil {
+			if _, err := s.decode(requirement.AtExactSnapshot); err != nil {
+				return "", errors.New(base.ErrorCode_ERROR_CODE_INVALID_SNAP_TOKEN.String())
+			}
+		}
+		return requirement.AtExactSnapshot, nil
+	case *base.Consistency_FullyConsistent:
+		st, err := s.dataReader.ConsistentHeadSnapshot(ctx, tenantID)
+		if err != nil {
+			return "", err
+		}
+		return st.Encode().String(), nil
+	}
+
+	if snapToken != "" {
+		return snapToken, nil
+	}
+	if at != nil {
+		st, err := s.dataReader.SnapshotAt(ctx, tenantID, at.AsTime())
+		if err != nil {
+			return "", err
+		}
+		return st.Encode().String(), nil
+	}
+	return s.head(ctx, tenantID)
+}
+
+// atLeastAsFresh - Returns the recent snapshot of the tenant if it is at least as fresh as the snap token,
+// the snap token otherwise.
+func (s *Snapshots) atLeastAsFresh(ctx context.Context, tenantID, snapToken string) (string, error) {
+	if s.decode == nil {
+		return s.head(ctx, tenantID)
+	}
+	requested, err := s.decode(snapToken)
+	if err != nil {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_INVALID_SNAP_TOKEN.String())
+	}
+	recent, err := s.recentSnapshot(ctx, tenantID)
+	if err != nil {
+		return "", err
+	}
+	if recent.Lt(requested) {
+		return snapToken, nil
+	}
+	return recent.Encode().String(), nil
+}
+
+// recentSnapshot - Returns the recent snapshot of the tenant, reading the head snapshot once it is older than
+// the refresh interval.
+func (s *Snapshots) recentSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	s.mu.Lock()
+	recent, ok := s.recent[tenantID]
+	s.mu.Unlock()
+	if ok && time.Since(recent.refreshed) < s.interval {
+		return recent.token, nil
+	}
+
+	st, err := s.dataReader.HeadSnapshot(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.store(tenantID, st)
+
+	return st, nil
+}
+
+// store - Keeps the recent snapshot of the tenant. Once the snapshots of too many tenants are kept, the ones past
+// the refresh interval are evicted, and an arbitrary one if none is.
+func (s *Snapshots) store(tenantID string, st token.SnapToken) {
+	if s.interval <= 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.recent[tenantID]; !ok && len(s.recent) >= maxRecentSnapshots {
+		for id, recent := range s.recent {
+			if time.Since(recent.refreshed) >= s.interval {
+				delete(s.recent, id)
+			}
+		}
+		if len(s.recent) >= maxRecentSnapshots {
+			for id := range s.recent {
+				delete(s.recent, id)
+				break
+			}
+		}
+	}
+	s.recent[tenantID] = recentSnapshot{token: st, refreshed: time.Now()}
+}
+
+// head - Returns the encoded head snapshot of the tenant.
+func (s *Snapshots) head(ctx context.Context, tenantID string) (string, error) {
+	st, err := s.dataReader.HeadSnapshot(ctx, tenantID)
+	if err != nil {
+		return "", err
+	}
+	return st.Encode().String(), nil
+}
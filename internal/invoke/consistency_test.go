@@ -0,0 +1,161 @@
+package invoke
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/memory"
+	"github.com/Permify/permify/internal/storage/memory/migrations"
+	"github.com/Permify/permify/internal/storage/memory/snapshot"
+	MMDatabase "github.com/Permify/permify/pkg/database/memory"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+)
+
+// primaryReader counts the head snapshots read from the primary storage.
+type primaryReader struct {
+	storage.DataReader
+	reads int
+}
+
+func (r *primaryReader) ConsistentHeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	r.reads++
+	return r.DataReader.ConsistentHeadSnapshot(ctx, tenantID)
+}
+
+var _ = Describe("Snapshots", func() {
+	var dataReader storage.DataReader
+
+	decode := func(value string) (token.SnapToken, error) {
+		return snapshot.EncodedToken{Value: value}.Decode()
+	}
+
+	encode := func(t time.Time) string {
+		return snapshot.NewToken(t).Encode().String()
+	}
+
+	minimizeLatency := &base.Consistency{Requirement: &base.Consistency_MinimizeLatency{MinimizeLatency: true}}
+	fullyConsistent := &base.Consistency{Requirement: &base.Consistency_FullyConsistent{FullyConsistent: true}}
+
+	BeforeEach(func() {
+		db, err := MMDatabase.New(migrations.Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+		dataReader = memory.NewDataReader(db)
+	})
+
+	Context("Select", func() {
+		It("should share the recent snapshot between the reads that minimize latency", func() {
+			snapshots := NewSnapshots(dataReader, decode, time.Hour)
+
+			first, err := snapshots.Select(context.Background(), "t1", minimizeLatency, "", nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			second, err := snapshots.Select(context.Background(), "t1", minimizeLatency, "", nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(second).Should(Equal(first))
+
+			other, err := snapshots.Select(context.Background(), "t2", minimizeLatency, "", nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(other).ShouldNot(Equal(first))
+		})
+
+		It("should refresh the recent snapshot once it is older than the interval", func() {
+			snapshots := NewSnapshots(dataReader, decode, 0)
+
+			first, err := snapshots.Select(context.Background(), "t1", minimizeLatency, "", nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			second, err := snapshots.Select(context.Background(), "t1", minimizeLatency, "", nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(second).ShouldNot(Equal(first))
+		})
+
+		It("should answer the reads that are fully consistent at the head snapshot", func() {
+			snapshots := NewSnapshots(dataReader, decode, time.Hour)
+
+			recent, err := snapshots.Select(context.Background(), "t1", minimizeLatency, "", nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			head, err := snapshots.Select(context.Background(), "t1", fullyConsistent, recent, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(head).ShouldNot(Equal(recent))
+		})
+
+		It("should read the head snapshot of the reads that are fully consistent from the primary storage", func() {
+			reader := &primaryReader{DataReader: dataReader}
+			snapshots := NewSnapshots(reader, decode, time.Hour)
+
+			_, err := snapshots.Select(context.Background(), "t1", nil, "", nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(reader.reads).Should(Equal(0))
+
+			_, err = snapshots.Select(context.Background(), "t1", fullyConsistent, "", nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(reader.reads).Should(Equal(1))
+		})
+
+		It("should bound the number of tenants whose recent snapshot is kept", func() {
+			snapshots := NewSnapshots(dataReader, decode, time.Hour)
+
+			for i := 0; i <= maxRecentSnapshots; i++ {
+				_, err := snapshots.Select(context.Background(), fmt.Sprintf("t%d", i), minimizeLatency, "", nil)
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+			Expect(len(snapshots.recent)).Should(Equal(maxRecentSnapshots))
+			Expect(snapshots.recent).Should(HaveKey(fmt.Sprintf("t%d", maxRecentSnapshots)))
+		})
+
+		It("should answer the reads at least as fresh as a snap token at the fresher snapshot", func() {
+			snapshots := NewSnapshots(dataReader, decode, time.Hour)
+
+			recent, err := snapshots.Select(context.Background(), "t1", minimizeLatency, "", nil)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			older := encode(time.Now().Add(-time.Hour))
+			selected, err := snapshots.Select(context.Background(), "t1", &base.Consistency{Requirement: &base.Consistency_AtLeastAsFresh{AtLeastAsFresh: older}}, "", nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(selected).Should(Equal(recent))
+
+			newer := encode(time.Now().Add(time.Hour))
+			selected, err = snapshots.Select(context.Background(), "t1", &base.Consistency{Requirement: &base.Consistency_AtLeastAsFresh{AtLeastAsFresh: newer}}, "", nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(selected).Should(Equal(newer))
+		})
+
+		It("should answer the reads at an exact snapshot at its snap token", func() {
+			snapshots := NewSnapshots(dataReader, decode, time.Hour)
+
+			exact := encode(time.Now().Add(-time.Minute))
+			selected, err := snapshots.Select(context.Background(), "t1", &base.Consistency{Requirement: &base.Consistency_AtExactSnapshot{AtExactSnapshot: exact}}, "", nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(selected).Should(Equal(exact))
+		})
+
+		It("should reject snap tokens that can not be decoded", func() {
+			snapshots := NewSnapshots(dataReader, decode, time.Hour)
+
+			_, err := snapshots.Select(context.Background(), "t1", &base.Consistency{Requirement: &base.Consistency_AtLeastAsFresh{AtLeastAsFresh: "not a token"}}, "", nil)
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_INVALID_SNAP_TOKEN.String()))
+
+			_, err = snapshots.Select(context.Background(), "t1", &base.Consistency{Requirement: &base.Consistency_AtExactSnapshot{AtExactSnapshot: "not a token"}}, "", nil)
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_INVALID_SNAP_TOKEN.String()))
+		})
+
+		It("should answer the reads without a consistency at their snap token or the head snapshot", func() {
+			snapshots := NewSnapshots(dataReader, decode, time.Hour)
+
+			pinned := encode(time.Now().Add(-time.Minute))
+			selected, err := snapshots.Select(context.Background(), "t1", nil, pinned, nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(selected).Should(Equal(pinned))
+
+			first, err := snapshots.Select(context.Background(), "t1", nil, "", nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			second, err := snapshots.Select(context.Background(), "t1", nil, "", nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(second).ShouldNot(Equal(first))
+		})
+	})
+})
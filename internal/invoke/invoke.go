@@ -2,18 +2,21 @@ package invoke
 
 import (
 	"context"
+	"sync"
 	"sync/atomic"
 
 	"go.opentelemetry.io/otel/attribute"
 	otelCodes "go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/Permify/permify/internal"
+	"github.com/Permify/permify/internal/decision"
+	"github.com/Permify/permify/internal/shadow"
 	"github.com/Permify/permify/internal/storage"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 	"github.com/Permify/permify/pkg/telemetry"
-	"github.com/Permify/permify/pkg/token"
 	"github.com/Permify/permify/pkg/tuple"
 )
 
@@ -43,6 +46,7 @@ type Expand interface {
 type Lookup interface {
 	LookupEntity(ctx context.Context, request *base.PermissionLookupEntityRequest) (response *base.PermissionLookupEntityResponse, err error)
 	LookupEntityStream(ctx context.Context, request *base.PermissionLookupEntityRequest, server base.Permission_LookupEntityStreamServer) (err error)
+	LookupEntitlements(ctx context.Context, request *base.PermissionLookupEntitlementsRequest, server base.Permission_LookupEntitlementsServer) (err error)
 	LookupSubject(ctx context.Context, request *base.PermissionLookupSubjectRequest) (response *base.PermissionLookupSubjectResponse, err error)
 }
 
@@ -66,6 +70,12 @@ type DirectInvoker struct {
 	lo Lookup
 	// LookupSubject
 	sp SubjectPermission
+	// decisions logs the answers of checks and lookups, nil if decision logging is disabled
+	decisions *decision.Logger
+	// shadows evaluates checks against the shadow schema versions, nil if shadow evaluation is disabled
+	shadows *shadow.Evaluator
+	// snapshots selects the snapshot requests are answered at
+	snapshots *Snapshots
 
 	checkHistogram             metric.Int64Histogram
 	lookupEntityHistogram      metric.Int64Histogram
@@ -91,6 +101,7 @@ func NewDirectInvoker(
 		ec:             ec,
 		lo:             lo,
 		sp:             sp,
+		snapshots:      NewSnapshots(dataReader, nil, DefaultSnapshotRefreshInterval),
 		checkHistogram: telemetry.NewHistogram(internal.Meter, "check", "amount", "Number of checks"),
 
 		lookupEntityHistogram:      telemetry.NewHistogram(internal.Meter, "lookup_entity", "amount", "Number of lookup entity"),
@@ -99,6 +110,21 @@ func NewDirectInvoker(
 	}
 }
 
+// SetDecisionLogger sets the logger of the decisions made through the invoker.
+func (invoker *DirectInvoker) SetDecisionLogger(decisions *decision.Logger) {
+	invoker.decisions = decisions
+}
+
+// SetShadowEvaluator sets the evaluator of the checks against the shadow schema versions.
+func (invoker *DirectInvoker) SetShadowEvaluator(shadows *shadow.Evaluator) {
+	invoker.shadows = shadows
+}
+
+// SetSnapshots sets the selector of the snapshot requests are answered at.
+func (invoker *DirectInvoker) SetSnapshots(snapshots *Snapshots) {
+	invoker.snapshots = snapshots
+}
+
 // Check is a method that implements the Check interface.
 // It calls the Run method of the CheckEngine with the provided context and PermissionCheckRequest,
 // and returns a PermissionCheckResponse and an error if any.
@@ -118,6 +144,12 @@ func (invoker *DirectInvoker) Check(ctx context.Context, request *base.Permissio
 			)),
 	)
 
+	// Track the decision, unless the call is a sub problem of a decision already tracked.
+	ctx, tracker := invoker.decisions.Track(ctx)
+	defer func() {
+		invoker.decisions.Log(ctx, tracker, decision.MethodCheck, request, response, err)
+	}()
+
 	// Validate the depth of the request.
 	err = checkDepth(request)
 	if err != nil {
@@ -132,10 +164,9 @@ func (invoker *DirectInvoker) Check(ctx context.Context, request *base.Permissio
 		}, err
 	}
 
-	// Set the SnapToken if it's not provided in the request.
-	if request.GetMetadata().GetSnapToken() == "" {
-		var st token.SnapToken
-		st, err = invoker.dataReader.HeadSnapshot(ctx, request.GetTenantId())
+	// Set the SnapToken if it's not provided in the request or the request sets a consistency.
+	if request.GetMetadata().GetSnapToken() == "" || request.GetMetadata().GetConsistency() != nil {
+		request.Metadata.SnapToken, err = invoker.snapshot(ctx, request.GetTenantId(), request.GetMetadata().GetConsistency(), request.GetMetadata().GetAtTime())
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(otelCodes.Error, err.Error())
@@ -147,12 +178,16 @@ func (invoker *DirectInvoker) Check(ctx context.Context, request *base.Permissio
 				},
 			}, err
 		}
-		request.Metadata.SnapToken = st.Encode().String()
+		// Sub problems are answered at the resolved snapshot
+		request.Metadata.Consistency = nil
 	}
 
 	// Set the SchemaVersion if it's not provided in the request.
+	// Only checks answered with the served version are evaluated against the shadow version, sub problems
+	// and checks of a pinned or tagged version are not.
+	served := request.GetMetadata().GetSchemaVersion() == "" && request.GetMetadata().GetSchemaTag() == ""
 	if request.GetMetadata().GetSchemaVersion() == "" {
-		request.Metadata.SchemaVersion, err = invoker.schemaReader.HeadVersion(ctx, request.GetTenantId())
+		request.Metadata.SchemaVersion, err = invoker.schemaVersion(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaTag())
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(otelCodes.Error, err.Error())
@@ -187,6 +222,10 @@ func (invoker *DirectInvoker) Check(ctx context.Context, request *base.Permissio
 	// increaseCheckCount increments the CheckCount value in the response metadata by 1.
 	atomic.AddInt32(&response.GetMetadata().CheckCount, +1)
 
+	if served {
+		invoker.shadows.Observe(ctx, request, response)
+	}
+
 	span.SetAttributes(attribute.KeyValue{Key: "can", Value: attribute.StringValue(response.GetCan().String())})
 	return response, err
 }
@@ -202,19 +241,19 @@ func (invoker *DirectInvoker) Expand(ctx context.Context, request *base.Permissi
 	))
 	defer span.End()
 
-	if request.GetMetadata().GetSnapToken() == "" {
-		var st token.SnapToken
-		st, err = invoker.dataReader.HeadSnapshot(ctx, request.GetTenantId())
+	if request.GetMetadata().GetSnapToken() == "" || request.GetMetadata().GetConsistency() != nil {
+		request.Metadata.SnapToken, err = invoker.snapshot(ctx, request.GetTenantId(), request.GetMetadata().GetConsistency(), request.GetMetadata().GetAtTime())
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(otelCodes.Error, err.Error())
 			return response, err
 		}
-		request.Metadata.SnapToken = st.Encode().String()
+		// Sub problems are answered at the resolved snapshot
+		request.Metadata.Consistency = nil
 	}
 
 	if request.GetMetadata().GetSchemaVersion() == "" {
-		request.Metadata.SchemaVersion, err = invoker.schemaReader.HeadVersion(ctx, request.GetTenantId())
+		request.Metadata.SchemaVersion, err = invoker.schemaVersion(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaTag())
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(otelCodes.Error, err.Error())
@@ -237,21 +276,27 @@ func (invoker *DirectInvoker) LookupEntity(ctx context.Context, request *base.Pe
 	))
 	defer span.End()
 
-	// Set SnapToken if not provided
-	if request.GetMetadata().GetSnapToken() == "" { // Check if the request has a SnapToken.
-		var st token.SnapToken
-		st, err = invoker.dataReader.HeadSnapshot(ctx, request.GetTenantId()) // Retrieve the head snapshot from the relationship reader.
+	// Track the decision, unless the call is a sub problem of a decision already tracked.
+	ctx, tracker := invoker.decisions.Track(ctx)
+	defer func() {
+		invoker.decisions.Log(ctx, tracker, decision.MethodLookupEntity, request, response, err)
+	}()
+
+	// Set SnapToken if not provided, or if a consistency is set
+	if request.GetMetadata().GetSnapToken() == "" || request.GetMetadata().GetConsistency() != nil { // Check if the request has a SnapToken or sets a consistency.
+		request.Metadata.SnapToken, err = invoker.snapshot(ctx, request.GetTenantId(), request.GetMetadata().GetConsistency(), request.GetMetadata().GetAtTime()) // Resolve the snapshot the consistency of the request requires.
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(otelCodes.Error, err.Error())
 			return response, err
 		}
-		request.Metadata.SnapToken = st.Encode().String() // Set the SnapToken in the request metadata.
+		// Sub problems are answered at the resolved snapshot
+		request.Metadata.Consistency = nil
 	}
 
 	// Set SchemaVersion if not provided
 	if request.GetMetadata().GetSchemaVersion() == "" { // Check if the request has a SchemaVersion.
-		request.Metadata.SchemaVersion, err = invoker.schemaReader.HeadVersion(ctx, request.GetTenantId()) // Retrieve the head schema version from the schema reader.
+		request.Metadata.SchemaVersion, err = invoker.schemaVersion(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaTag()) // Retrieve the schema version of the tag, or the head schema version.
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(otelCodes.Error, err.Error())
@@ -278,21 +323,32 @@ func (invoker *DirectInvoker) LookupEntityStream(ctx context.Context, request *b
 	))
 	defer span.End()
 
-	// Set SnapToken if not provided
-	if request.GetMetadata().GetSnapToken() == "" { // Check if the request has a SnapToken.
-		var st token.SnapToken
-		st, err = invoker.dataReader.HeadSnapshot(ctx, request.GetTenantId()) // Retrieve the head snapshot from the relationship reader.
+	// Track the decision, unless the call is a sub problem of a decision already tracked.
+	// The streamed entities are collected as the result of the decision.
+	ctx, tracker := invoker.decisions.Track(ctx)
+	if tracker != nil {
+		stream := &decisionStream{Permission_LookupEntityStreamServer: server}
+		server = stream
+		defer func() {
+			invoker.decisions.Log(ctx, tracker, decision.MethodLookupEntityStream, request, stream.response(), err)
+		}()
+	}
+
+	// Set SnapToken if not provided, or if a consistency is set
+	if request.GetMetadata().GetSnapToken() == "" || request.GetMetadata().GetConsistency() != nil { // Check if the request has a SnapToken or sets a consistency.
+		request.Metadata.SnapToken, err = invoker.snapshot(ctx, request.GetTenantId(), request.GetMetadata().GetConsistency(), request.GetMetadata().GetAtTime()) // Resolve the snapshot the consistency of the request requires.
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(otelCodes.Error, err.Error())
 			return err
 		}
-		request.Metadata.SnapToken = st.Encode().String() // Set the SnapToken in the request metadata.
+		// Sub problems are answered at the resolved snapshot
+		request.Metadata.Consistency = nil
 	}
 
 	// Set SchemaVersion if not provided
 	if request.GetMetadata().GetSchemaVersion() == "" { // Check if the request has a SchemaVersion.
-		request.Metadata.SchemaVersion, err = invoker.schemaReader.HeadVersion(ctx, request.GetTenantId()) // Retrieve the head schema version from the schema reader.
+		request.Metadata.SchemaVersion, err = invoker.schemaVersion(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaTag()) // Retrieve the schema version of the tag, or the head schema version.
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(otelCodes.Error, err.Error())
@@ -300,11 +356,79 @@ func (invoker *DirectInvoker) LookupEntityStream(ctx context.Context, request *b
 		}
 	}
 
-	resp := invoker.lo.LookupEntityStream(ctx, request, server)
+	err = invoker.lo.LookupEntityStream(ctx, request, server)
 
 	invoker.lookupEntityHistogram.Record(ctx, 1)
 
-	return resp
+	return err
+}
+
+// decisionStream collects the entities sent through a lookup entity stream, so the streamed decision can be logged.
+type decisionStream struct {
+	base.Permission_LookupEntityStreamServer
+
+	mu        sync.Mutex
+	entityIDs []string
+}
+
+// Send sends the response through the stream and collects its entity.
+func (s *decisionStream) Send(response *base.PermissionLookupEntityStreamResponse) error {
+	err := s.Permission_LookupEntityStreamServer.Send(response)
+	if err == nil {
+		s.mu.Lock()
+		s.entityIDs = append(s.entityIDs, response.GetEntityId())
+		s.mu.Unlock()
+	}
+	return err
+}
+
+// response returns the collected entities as the response of a lookup entity.
+func (s *decisionStream) response() *base.PermissionLookupEntityResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &base.PermissionLookupEntityResponse{EntityIds: s.entityIDs}
+}
+
+// LookupEntitlements is a method of the DirectInvoker structure. It streams the permissions a subject holds
+// on every entity it can reach, answered at one snap token and schema version.
+func (invoker *DirectInvoker) LookupEntitlements(ctx context.Context, request *base.PermissionLookupEntitlementsRequest, server base.Permission_LookupEntitlementsServer) (err error) {
+	ctx, span := internal.Tracer.Start(ctx, "lookup-entitlements", trace.WithAttributes(
+		attribute.KeyValue{Key: "tenant_id", Value: attribute.StringValue(request.GetTenantId())},
+		attribute.KeyValue{Key: "subject", Value: attribute.StringValue(tuple.SubjectToString(request.GetSubject()))},
+	))
+	defer span.End()
+
+	// Set SnapToken if not provided, or if a consistency is set
+	if request.GetMetadata().GetSnapToken() == "" || request.GetMetadata().GetConsistency() != nil {
+		request.Metadata.SnapToken, err = invoker.snapshot(ctx, request.GetTenantId(), request.GetMetadata().GetConsistency(), nil)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return err
+		}
+		// Sub problems are answered at the resolved snapshot
+		request.Metadata.Consistency = nil
+	}
+
+	// Set SchemaVersion if not provided
+	if request.GetMetadata().GetSchemaVersion() == "" {
+		request.Metadata.SchemaVersion, err = invoker.schemaVersion(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaTag())
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return err
+		}
+	}
+
+	err = invoker.lo.LookupEntitlements(ctx, request, server)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+	}
+
+	invoker.lookupEntityHistogram.Record(ctx, 1)
+
+	return err
 }
 
 // LookupSubject is a method of the DirectInvoker structure. It handles the task of looking up subjects
@@ -318,26 +442,30 @@ func (invoker *DirectInvoker) LookupSubject(ctx context.Context, request *base.P
 	))
 	defer span.End()
 
-	// Check if the request has a SnapToken. If not, a SnapToken is set.
-	if request.GetMetadata().GetSnapToken() == "" {
-		// Create an instance of SnapToken
-		var st token.SnapToken
-		// Retrieve the head snapshot from the relationship reader
-		st, err = invoker.dataReader.HeadSnapshot(ctx, request.GetTenantId())
+	// Track the decision, unless the call is a sub problem of a decision already tracked.
+	ctx, tracker := invoker.decisions.Track(ctx)
+	defer func() {
+		invoker.decisions.Log(ctx, tracker, decision.MethodLookupSubject, request, response, err)
+	}()
+
+	// Check if the request has a SnapToken. If not, or if it sets a consistency, a SnapToken is set.
+	if request.GetMetadata().GetSnapToken() == "" || request.GetMetadata().GetConsistency() != nil {
+		// Resolve the snapshot the consistency of the request requires
+		request.Metadata.SnapToken, err = invoker.snapshot(ctx, request.GetTenantId(), request.GetMetadata().GetConsistency(), request.GetMetadata().GetAtTime())
 		// If there's an error retrieving the snapshot, return the response and the error
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(otelCodes.Error, err.Error())
 			return response, err
 		}
-		// Set the SnapToken in the request metadata
-		request.Metadata.SnapToken = st.Encode().String()
+		// Sub problems are answered at the resolved snapshot
+		request.Metadata.Consistency = nil
 	}
 
 	// Similar to SnapToken, check if the request has a SchemaVersion. If not, a SchemaVersion is set.
 	if request.GetMetadata().GetSchemaVersion() == "" {
-		// Retrieve the head schema version from the schema reader
-		request.Metadata.SchemaVersion, err = invoker.schemaReader.HeadVersion(ctx, request.GetTenantId())
+		// Retrieve the schema version of the tag, or the head schema version
+		request.Metadata.SchemaVersion, err = invoker.schemaVersion(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaTag())
 		// If there's an error retrieving the schema version, return the response and the error
 		if err != nil {
 			span.RecordError(err)
@@ -365,26 +493,30 @@ func (invoker *DirectInvoker) SubjectPermission(ctx context.Context, request *ba
 	))
 	defer span.End()
 
-	// Check if the request has a SnapToken. If not, a SnapToken is set.
-	if request.GetMetadata().GetSnapToken() == "" {
-		// Create an instance of SnapToken
-		var st token.SnapToken
-		// Retrieve the head snapshot from the relationship reader
-		st, err = invoker.dataReader.HeadSnapshot(ctx, request.GetTenantId())
+	// Track the decision, unless the call is a sub problem of a decision already tracked.
+	ctx, tracker := invoker.decisions.Track(ctx)
+	defer func() {
+		invoker.decisions.Log(ctx, tracker, decision.MethodSubjectPermission, request, response, err)
+	}()
+
+	// Check if the request has a SnapToken. If not, or if it sets a consistency, a SnapToken is set.
+	if request.GetMetadata().GetSnapToken() == "" || request.GetMetadata().GetConsistency() != nil {
+		// Resolve the snapshot the consistency of the request requires
+		request.Metadata.SnapToken, err = invoker.snapshot(ctx, request.GetTenantId(), request.GetMetadata().GetConsistency(), nil)
 		// If there's an error retrieving the snapshot, return the response and the error
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(otelCodes.Error, err.Error())
 			return response, err
 		}
-		// Set the SnapToken in the request metadata
-		request.Metadata.SnapToken = st.Encode().String()
+		// Sub problems are answered at the resolved snapshot
+		request.Metadata.Consistency = nil
 	}
 
 	// Similar to SnapToken, check if the request has a SchemaVersion. If not, a SchemaVersion is set.
 	if request.GetMetadata().GetSchemaVersion() == "" {
-		// Retrieve the head schema version from the schema reader
-		request.Metadata.SchemaVersion, err = invoker.schemaReader.HeadVersion(ctx, request.GetTenantId())
+		// Retrieve the schema version of the tag, or the head schema version
+		request.Metadata.SchemaVersion, err = invoker.schemaVersion(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaTag())
 		// If there's an error retrieving the schema version, return the response and the error
 		if err != nil {
 			span.RecordError(err)
@@ -400,3 +532,18 @@ func (invoker *DirectInvoker) SubjectPermission(ctx context.Context, request *ba
 	// and return its response and error
 	return resp, err
 }
+
+// snapshot - Resolves the snapshot of a request that sets a consistency or does not set a snap token,
+// see Snapshots.Select.
+func (invoker *DirectInvoker) snapshot(ctx context.Context, tenantID string, consistency *base.Consistency, at *timestamppb.Timestamp) (string, error) {
+	return invoker.snapshots.Select(ctx, tenantID, consistency, "", at)
+}
+
+// schemaVersion - Resolves the schema version of a request that does not set one: the version of its
+// tag if it selects one, the head version of the tenant otherwise.
+func (invoker *DirectInvoker) schemaVersion(ctx context.Context, tenantID, tag string) (string, error) {
+	if tag != "" {
+		return invoker.schemaReader.TagVersion(ctx, tenantID, tag)
+	}
+	return invoker.schemaReader.HeadVersion(ctx, tenantID)
+}
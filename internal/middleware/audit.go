@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc"
+
+	"github.com/Permify/permify/internal/audit"
+)
+
+// UnaryAuditInterceptor - Middleware that records every mutation, along with its caller and outcome, to the audit log
+func UnaryAuditInterceptor(auditor *audit.Auditor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !audit.IsMutation(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		resp, err := handler(ctx, req)
+		auditor.Record(ctx, audit.NewRecord(ctx, info.FullMethod, req, resp, err))
+		return resp, err
+	}
+}
+
+// StreamAuditInterceptor - Middleware that records every streaming mutation to the audit log, identified by the
+// first message received on the stream and concluded by the last message sent on it
+func StreamAuditInterceptor(auditor *audit.Auditor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !audit.IsMutation(info.FullMethod) {
+			return handler(srv, stream)
+		}
+		wrapped := &auditServerStream{ServerStream: stream}
+		err := handler(srv, wrapped)
+		auditor.Record(stream.Context(), audit.NewRecord(stream.Context(), info.FullMethod, wrapped.req, wrapped.resp, err))
+		return err
+	}
+}
+
+// auditServerStream - Server stream that keeps the first message received and the last message sent
+type auditServerStream struct {
+	grpc.ServerStream
+	req  interface{}
+	resp interface{}
+}
+
+// RecvMsg - Receives a message and keeps it if it is the first one
+func (s *auditServerStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	// Messages rejected by later interceptors were still received and name the tenant of the attempt.
+	if s.req == nil && !errors.Is(err, io.EOF) {
+		s.req = m
+	}
+	return err
+}
+
+// SendMsg - Sends a message and keeps it as the last one
+func (s *auditServerStream) SendMsg(m interface{}) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+	s.resp = m
+	return nil
+}
@@ -0,0 +1,125 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Permify/permify/internal/audit"
+	"github.com/Permify/permify/internal/authn"
+	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+type recordingSink struct {
+	records []*v1.AuditRecord
+}
+
+func (s *recordingSink) Write(_ context.Context, record *v1.AuditRecord) error {
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *recordingSink) Close(context.Context) error {
+	return nil
+}
+
+func TestUnaryAuditInterceptor(t *testing.T) {
+	sink := &recordingSink{}
+	interceptor := UnaryAuditInterceptor(audit.NewAuditor(sink))
+	ctx := authn.ContextWithIdentity(context.Background(), "billing")
+
+	_, err := interceptor(ctx, &v1.PermissionCheckRequest{TenantId: "t1"}, &grpc.UnaryServerInfo{FullMethod: "/base.v1.Permission/Check"}, func(context.Context, interface{}) (interface{}, error) {
+		return &v1.PermissionCheckResponse{}, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(sink.records) != 0 {
+		t.Fatalf("expected reads not to be recorded, got %v", sink.records)
+	}
+
+	_, err = interceptor(ctx, &v1.DataWriteRequest{TenantId: "t1"}, &grpc.UnaryServerInfo{FullMethod: "/base.v1.Data/Write"}, func(context.Context, interface{}) (interface{}, error) {
+		return &v1.DataWriteResponse{SnapToken: "token"}, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(sink.records) != 1 {
+		t.Fatalf("expected one record, got %v", sink.records)
+	}
+	record := sink.records[0]
+	if record.GetActor() != "billing" || record.GetTenantId() != "t1" || record.GetMethod() != "Data.Write" || record.GetSnapToken() != "token" || record.GetOutcome() != "OK" {
+		t.Fatalf("unexpected record: %v", record)
+	}
+}
+
+type importServerStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	messages []*v1.DataImportRequest
+	sent     []interface{}
+}
+
+func (s *importServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *importServerStream) RecvMsg(m interface{}) error {
+	if len(s.messages) == 0 {
+		return io.EOF
+	}
+	proto.Merge(m.(*v1.DataImportRequest), s.messages[0])
+	s.messages = s.messages[1:]
+	return nil
+}
+
+func (s *importServerStream) SendMsg(m interface{}) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func TestStreamAuditInterceptor(t *testing.T) {
+	sink := &recordingSink{}
+	interceptor := StreamAuditInterceptor(audit.NewAuditor(sink))
+	ctx := authn.ContextWithIdentity(context.Background(), "billing")
+
+	handler := func(_ interface{}, stream grpc.ServerStream) error {
+		for {
+			if err := stream.RecvMsg(&v1.DataImportRequest{}); err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				return err
+			}
+		}
+		return stream.SendMsg(&v1.DataImportResponse{SnapToken: "token", Commits: 2, CommittedTuples: 3})
+	}
+
+	stream := &importServerStream{ctx: ctx, messages: []*v1.DataImportRequest{{TenantId: "t1"}, {TenantId: "t1"}}}
+	if err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/base.v1.Watch/Watch"}, handler); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(sink.records) != 0 {
+		t.Fatalf("expected reads not to be recorded, got %v", sink.records)
+	}
+
+	stream = &importServerStream{ctx: ctx, messages: []*v1.DataImportRequest{{TenantId: "t1"}, {TenantId: "t1"}}}
+	if err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/base.v1.Data/Import"}, handler); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(sink.records) != 1 {
+		t.Fatalf("expected one record, got %v", sink.records)
+	}
+	record := sink.records[0]
+	if record.GetActor() != "billing" || record.GetTenantId() != "t1" || record.GetMethod() != "Data.Import" || record.GetSnapToken() != "token" || record.GetOutcome() != "OK" {
+		t.Fatalf("unexpected record: %v", record)
+	}
+	if !strings.Contains(record.GetSummary(), "commits=2 committed_tuples=3") {
+		t.Fatalf("expected the committed transactions in the summary, got %q", record.GetSummary())
+	}
+}
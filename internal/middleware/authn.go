@@ -11,6 +11,22 @@ import (
 // AuthFunc - Middleware that responsible for key authentication
 func AuthFunc(authenticator authn.Authenticator) grpcAuth.AuthFunc {
 	return func(ctx context.Context) (context.Context, error) {
+		// Authenticators that resolve an identity make it available to the handlers and the audit log.
+		if identifier, ok := authenticator.(authn.Identifier); ok {
+			identity, grant, err := identifier.Identify(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return authn.ContextWithGrant(authn.ContextWithIdentity(ctx, identity), grant), nil
+		}
+		// Authenticators that resolve a grant pass it on to the authorization interceptors.
+		if authorizer, ok := authenticator.(authn.Authorizer); ok {
+			grant, err := authorizer.Grant(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return authn.ContextWithGrant(ctx, grant), nil
+		}
 		err := authenticator.Authenticate(ctx)
 		if err != nil {
 			return nil, err
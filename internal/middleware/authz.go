@@ -0,0 +1,119 @@
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Permify/permify/internal/authn"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// tenantRequest - Requests that are scoped to a tenant
+type tenantRequest interface {
+	GetTenantId() string
+}
+
+// quarantineRequest - Requests that may write into a second tenant, the quarantine tenant of a data verification
+type quarantineRequest interface {
+	GetQuarantineTenantId() string
+}
+
+// tenancyRequest - Tenancy requests, which carry the tenant in their id field
+type tenancyRequest interface {
+	GetId() string
+}
+
+// UnaryAuthzInterceptor - Middleware that checks the method and the tenant of every request against the grant of the caller
+func UnaryAuthzInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		grant := authn.GrantFromContext(ctx)
+		if err := authorizeMethod(grant, info.FullMethod); err != nil {
+			return nil, err
+		}
+		if err := authorizeRequest(grant, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthzInterceptor - Middleware that checks the method of every stream and the tenant of every message received on it
+func StreamAuthzInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		grant := authn.GrantFromContext(stream.Context())
+		if err := authorizeMethod(grant, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, &authzServerStream{ServerStream: stream, grant: grant, method: info.FullMethod})
+	}
+}
+
+// authzServerStream - Server stream that authorizes every received message
+type authzServerStream struct {
+	grpc.ServerStream
+	grant  *authn.Grant
+	method string
+}
+
+// RecvMsg - Receives a message and checks its tenant against the grant of the caller
+func (s *authzServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return authorizeRequest(s.grant, s.method, m)
+}
+
+// authorizeMethod - Checks whether the grant covers the method
+func authorizeMethod(grant *authn.Grant, fullMethod string) error {
+	if !grant.AllowsMethod(fullMethod) {
+		return status.Error(codes.PermissionDenied, base.ErrorCode_ERROR_CODE_METHOD_ACCESS_DENIED.String())
+	}
+	return nil
+}
+
+// authorizeRequest - Checks whether the grant covers the tenant of the request. Requests that are
+// not scoped to a tenant are allowed, except listing tenants, which requires access to every tenant.
+func authorizeRequest(grant *authn.Grant, fullMethod string, req interface{}) error {
+	if err := authorizeQuarantine(grant, req); err != nil {
+		return err
+	}
+
+	if grant.AllowsAllTenants() {
+		return nil
+	}
+
+	var tenantID string
+	if r, ok := req.(tenantRequest); ok {
+		tenantID = r.GetTenantId()
+	} else {
+		if service, _ := authn.SplitMethod(fullMethod); service != "Tenancy" {
+			return nil
+		}
+		if r, ok := req.(tenancyRequest); ok {
+			tenantID = r.GetId()
+		}
+	}
+
+	if tenantID == "" || !grant.AllowsTenant(tenantID) {
+		return status.Error(codes.PermissionDenied, base.ErrorCode_ERROR_CODE_TENANT_ACCESS_DENIED.String())
+	}
+	return nil
+}
+
+// authorizeQuarantine - Checks whether the grant covers writing into the quarantine tenant of the request, if it names one
+func authorizeQuarantine(grant *authn.Grant, req interface{}) error {
+	r, ok := req.(quarantineRequest)
+	if !ok || r.GetQuarantineTenantId() == "" {
+		return nil
+	}
+	if !grant.AllowsTenant(r.GetQuarantineTenantId()) {
+		return status.Error(codes.PermissionDenied, base.ErrorCode_ERROR_CODE_TENANT_ACCESS_DENIED.String())
+	}
+	if !grant.AllowsMethod(base.Data_Write_FullMethodName) {
+		return status.Error(codes.PermissionDenied, base.ErrorCode_ERROR_CODE_METHOD_ACCESS_DENIED.String())
+	}
+	return nil
+}
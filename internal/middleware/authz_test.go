@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Permify/permify/internal/authn"
+	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+func TestUnaryAuthzInterceptor(t *testing.T) {
+	grant := &authn.Grant{Tenants: []string{"t1"}, Methods: []string{"Permission", "Data.Write", "Tenancy"}}
+
+	tests := []struct {
+		name   string
+		grant  *authn.Grant
+		method string
+		req    interface{}
+		code   codes.Code
+	}{
+		{"allowed tenant and service", grant, "/base.v1.Permission/Check", &v1.PermissionCheckRequest{TenantId: "t1"}, codes.OK},
+		{"allowed tenant and method", grant, "/base.v1.Data/Write", &v1.DataWriteRequest{TenantId: "t1"}, codes.OK},
+		{"other tenant", grant, "/base.v1.Permission/Check", &v1.PermissionCheckRequest{TenantId: "t2"}, codes.PermissionDenied},
+		{"other method", grant, "/base.v1.Data/Delete", &v1.DataDeleteRequest{TenantId: "t1"}, codes.PermissionDenied},
+		{"deleting its own tenant", grant, "/base.v1.Tenancy/Delete", &v1.TenantDeleteRequest{Id: "t1"}, codes.OK},
+		{"deleting another tenant", grant, "/base.v1.Tenancy/Delete", &v1.TenantDeleteRequest{Id: "t2"}, codes.PermissionDenied},
+		{"listing tenants", grant, "/base.v1.Tenancy/List", &v1.TenantListRequest{}, codes.PermissionDenied},
+		{"unrestricted caller", nil, "/base.v1.Tenancy/Delete", &v1.TenantDeleteRequest{Id: "t2"}, codes.OK},
+		{"every tenant", &authn.Grant{Tenants: []string{authn.Wildcard}}, "/base.v1.Tenancy/List", &v1.TenantListRequest{}, codes.OK},
+		{"quarantining into its own tenants", &authn.Grant{Tenants: []string{"t1", "q1"}, Methods: []string{"Data.Verify", "Data.Write"}}, "/base.v1.Data/Verify", &v1.DataVerifyRequest{TenantId: "t1", QuarantineTenantId: "q1"}, codes.OK},
+		{"quarantining into another tenant", &authn.Grant{Tenants: []string{"t1"}, Methods: []string{"Data.Verify", "Data.Write"}}, "/base.v1.Data/Verify", &v1.DataVerifyRequest{TenantId: "t1", QuarantineTenantId: "t2"}, codes.PermissionDenied},
+		{"quarantining without write", &authn.Grant{Tenants: []string{"t1", "q1"}, Methods: []string{"Data.Verify"}}, "/base.v1.Data/Verify", &v1.DataVerifyRequest{TenantId: "t1", QuarantineTenantId: "q1"}, codes.PermissionDenied},
+		{"quarantining without write on every tenant", &authn.Grant{Tenants: []string{authn.Wildcard}, Methods: []string{"Data.Verify"}}, "/base.v1.Data/Verify", &v1.DataVerifyRequest{TenantId: "t1", QuarantineTenantId: "q1"}, codes.PermissionDenied},
+	}
+
+	interceptor := UnaryAuthzInterceptor()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := authn.ContextWithGrant(context.Background(), tt.grant)
+			_, err := interceptor(ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(context.Context, interface{}) (interface{}, error) {
+				return nil, nil
+			})
+			if status.Code(err) != tt.code {
+				t.Fatalf("expected %v, got %v", tt.code, status.Code(err))
+			}
+		})
+	}
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	messages []*v1.PermissionLookupEntityRequest
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeServerStream) RecvMsg(m interface{}) error {
+	req := m.(*v1.PermissionLookupEntityRequest)
+	req.TenantId = s.messages[0].GetTenantId()
+	s.messages = s.messages[1:]
+	return nil
+}
+
+func TestStreamAuthzInterceptor(t *testing.T) {
+	ctx := authn.ContextWithGrant(context.Background(), &authn.Grant{Tenants: []string{"t1"}, Methods: []string{"Permission.LookupEntityStream"}})
+	interceptor := StreamAuthzInterceptor()
+
+	recv := func(_ interface{}, stream grpc.ServerStream) error {
+		return stream.RecvMsg(&v1.PermissionLookupEntityRequest{})
+	}
+
+	stream := &fakeServerStream{ctx: ctx, messages: []*v1.PermissionLookupEntityRequest{{TenantId: "t1"}}}
+	if err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/base.v1.Permission/LookupEntityStream"}, recv); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	stream = &fakeServerStream{ctx: ctx, messages: []*v1.PermissionLookupEntityRequest{{TenantId: "t2"}}}
+	err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/base.v1.Permission/LookupEntityStream"}, recv)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected permission denied for another tenant, got %v", err)
+	}
+
+	stream = &fakeServerStream{ctx: ctx, messages: []*v1.PermissionLookupEntityRequest{{TenantId: "t1"}}}
+	err = interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/base.v1.Watch/Watch"}, recv)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected permission denied for another method, got %v", err)
+	}
+}
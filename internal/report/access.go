@@ -0,0 +1,352 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/Permify/permify/internal/invoke"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/context/utils"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// Page sizes of the reads enumerating the entities and subjects of a review
+const (
+	entityPageSize  = 100
+	subjectPageSize = 1000
+)
+
+// AccessReviewer - Enumerates who holds which permission on which entity for access reviews
+type AccessReviewer struct {
+	schemaReader storage.SchemaReader
+	dataReader   storage.DataReader
+	invoker      invoke.Invoker
+}
+
+// NewAccessReviewer - Creates an access reviewer reading the entities with the readers and evaluating their permissions with the invoker
+func NewAccessReviewer(schemaReader storage.SchemaReader, dataReader storage.DataReader, invoker invoke.Invoker) *AccessReviewer {
+	return &AccessReviewer{
+		schemaReader: schemaReader,
+		dataReader:   dataReader,
+		invoker:      invoker,
+	}
+}
+
+// Review - Calls emit with every permission held by a subject on an entity of the entity types of the request. Entities
+// are found through their relation tuples and attributes, and their subjects are looked up one permission and subject
+// type at a time, so entries are emitted as they are found rather than collected. The snap token and schema version of
+// the request are set to the head ones of the tenant if they are empty, so every entry is read at the same ones.
+func (r *AccessReviewer) Review(ctx context.Context, request *base.AuditAccessReviewRequest, emit func(entry *base.AccessReviewEntry) error) (err error) {
+	if request.GetMetadata().GetSnapToken() == "" {
+		st, err := r.dataReader.HeadSnapshot(ctx, request.GetTenantId())
+		if err != nil {
+			return err
+		}
+		request.Metadata.SnapToken = st.Encode().String()
+	}
+	if request.GetMetadata().GetSchemaVersion() == "" {
+		request.Metadata.SchemaVersion, err = r.schemaReader.HeadVersion(ctx, request.GetTenantId())
+		if err != nil {
+			return err
+		}
+	}
+
+	sc, err := r.schemaReader.ReadSchema(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaVersion())
+	if err != nil {
+		return err
+	}
+
+	subjectTypes := request.GetSubjectTypes()
+	if len(subjectTypes) == 0 {
+		subjectTypes = directSubjectTypes(sc)
+	}
+
+	for _, entityType := range request.GetEntityTypes() {
+		definition, ok := sc.GetEntityDefinitions()[entityType]
+		if !ok {
+			return errors.New(base.ErrorCode_ERROR_CODE_ENTITY_DEFINITION_NOT_FOUND.String())
+		}
+
+		var permissions []string
+		for permission := range definition.GetPermissions() {
+			if len(request.GetPermissions()) == 0 || slices.Contains(request.GetPermissions(), permission) {
+				permissions = append(permissions, permission)
+			}
+		}
+		if len(permissions) == 0 {
+			continue
+		}
+		slices.Sort(permissions)
+
+		err = r.entities(ctx, request, entityType, func(entity *base.Entity) error {
+			for _, permission := range permissions {
+				if err := r.reviewPermission(ctx, request, entity, permission, subjectTypes, emit); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reviewPermission - Emits the subjects of the subject types holding a permission on an entity. The permission is
+// expanded once, to explain how each subject holds it.
+func (r *AccessReviewer) reviewPermission(ctx context.Context, request *base.AuditAccessReviewRequest, entity *base.Entity, permission string, subjectTypes []string, emit func(entry *base.AccessReviewEntry) error) error {
+	var tree *base.Expand
+	for _, subjectType := range subjectTypes {
+		err := r.subjects(ctx, request, entity, permission, subjectType, func(id string) error {
+			if tree == nil {
+				response, err := r.invoker.Expand(ctx, &base.PermissionExpandRequest{
+					TenantId: request.GetTenantId(),
+					Metadata: &base.PermissionExpandRequestMetadata{
+						SchemaVersion: request.GetMetadata().GetSchemaVersion(),
+						SnapToken:     request.GetMetadata().GetSnapToken(),
+					},
+					Entity:     entity,
+					Permission: permission,
+				})
+				if err != nil {
+					return err
+				}
+				tree = response.GetTree()
+			}
+
+			subject := &base.Subject{Type: subjectType, Id: id}
+			access, path := Explain(tree, subject)
+			return emit(&base.AccessReviewEntry{
+				EntityType: entity.GetType(),
+				EntityId:   entity.GetId(),
+				Permission: permission,
+				Subject:    subject,
+				Access:     access,
+				Path:       path,
+			})
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// entities - Calls fn with every entity of a type that has relation tuples or attributes, once each and in the order
+// of their ids. The ids of the tuples and of the attributes are read as two sorted streams and merged.
+func (r *AccessReviewer) entities(ctx context.Context, request *base.AuditAccessReviewRequest, entityType string, fn func(entity *base.Entity) error) error {
+	relations := &entityStream{limit: entityPageSize, read: func(cursor string, limit uint32) ([]string, error) {
+		it, err := r.dataReader.QueryRelationships(ctx, request.GetTenantId(), &base.TupleFilter{
+			Entity: &base.EntityFilter{Type: entityType},
+		}, request.GetMetadata().GetSnapToken(), database.NewCursorPagination(database.Cursor(cursor), database.Sort("entity_id"), database.Limit(limit)))
+		if err != nil {
+			return nil, err
+		}
+		var ids []string
+		for it.HasNext() {
+			ids = append(ids, it.GetNext().GetEntity().GetId())
+		}
+		return ids, nil
+	}}
+	attributes := &entityStream{limit: entityPageSize, read: func(cursor string, limit uint32) ([]string, error) {
+		it, err := r.dataReader.QueryAttributes(ctx, request.GetTenantId(), &base.AttributeFilter{
+			Entity: &base.EntityFilter{Type: entityType},
+		}, request.GetMetadata().GetSnapToken(), database.NewCursorPagination(database.Cursor(cursor), database.Sort("entity_id"), database.Limit(limit)))
+		if err != nil {
+			return nil, err
+		}
+		var ids []string
+		for it.HasNext() {
+			ids = append(ids, it.GetNext().GetEntity().GetId())
+		}
+		return ids, nil
+	}}
+
+	a, aok, err := relations.next()
+	if err != nil {
+		return err
+	}
+	b, bok, err := attributes.next()
+	if err != nil {
+		return err
+	}
+	for aok || bok {
+		id := a
+		if !aok || (bok && b < a) {
+			id = b
+		}
+		if err = fn(&base.Entity{Type: entityType, Id: id}); err != nil {
+			return err
+		}
+		if aok && a == id {
+			if a, aok, err = relations.next(); err != nil {
+				return err
+			}
+		}
+		if bok && b == id {
+			if b, bok, err = attributes.next(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// entityStream - Streams the distinct entity ids of pages read in the order of the ids. The cursor of a page is the
+// last id read and is inclusive, so the ids repeated from the previous page are skipped.
+type entityStream struct {
+	read  func(cursor string, limit uint32) ([]string, error)
+	limit uint32
+
+	ids     []string
+	last    string
+	started bool
+	done    bool
+}
+
+// next - Returns the next entity id, false once every page is read
+func (s *entityStream) next() (string, bool, error) {
+	for {
+		for len(s.ids) > 0 {
+			id := s.ids[0]
+			s.ids = s.ids[1:]
+			if s.started && id <= s.last {
+				continue
+			}
+			s.started, s.last = true, id
+			return id, true, nil
+		}
+		if s.done {
+			return "", false, nil
+		}
+
+		cursor := ""
+		if s.started {
+			cursor = utils.NewContinuousToken(s.last).Encode().String()
+		}
+		ids, err := s.read(cursor, s.limit)
+		if err != nil {
+			return "", false, err
+		}
+		s.done = len(ids) < int(s.limit)
+
+		// A full page of the last entity holds no new id, so more is read at once.
+		if !s.done && s.started && ids[len(ids)-1] == s.last {
+			s.limit *= 2
+			continue
+		}
+		s.ids = ids
+	}
+}
+
+// subjects - Calls fn with the ids of the subjects of a type holding a permission on an entity, page by page in the
+// order of their ids
+func (r *AccessReviewer) subjects(ctx context.Context, request *base.AuditAccessReviewRequest, entity *base.Entity, permission, subjectType string, fn func(id string) error) error {
+	ct := ""
+	for {
+		response, err := r.invoker.LookupSubject(ctx, &base.PermissionLookupSubjectRequest{
+			TenantId: request.GetTenantId(),
+			Metadata: &base.PermissionLookupSubjectRequestMetadata{
+				SchemaVersion: request.GetMetadata().GetSchemaVersion(),
+				SnapToken:     request.GetMetadata().GetSnapToken(),
+				Depth:         request.GetMetadata().GetDepth(),
+			},
+			Entity:           entity,
+			Permission:       permission,
+			SubjectReference: &base.RelationReference{Type: subjectType},
+			PageSize:         subjectPageSize,
+			ContinuousToken:  ct,
+		})
+		if err != nil {
+			return err
+		}
+		for _, id := range response.GetSubjectIds() {
+			if err = fn(id); err != nil {
+				return err
+			}
+		}
+		if ct = response.GetContinuousToken(); ct == "" || len(response.GetSubjectIds()) < subjectPageSize {
+			return nil
+		}
+	}
+}
+
+// directSubjectTypes - Returns the sorted entity types the relations of a schema reference as subjects without a relation
+func directSubjectTypes(sc *base.SchemaDefinition) []string {
+	var types []string
+	for _, definition := range sc.GetEntityDefinitions() {
+		for _, relation := range definition.GetRelations() {
+			for _, reference := range relation.GetRelationReferences() {
+				if reference.GetRelation() == "" && !slices.Contains(types, reference.GetType()) {
+					types = append(types, reference.GetType())
+				}
+			}
+		}
+	}
+	slices.Sort(types)
+	return types
+}
+
+// Explain - Returns how a subject holds the permission of an expansion tree. The path lists the relations leading from
+// the entity of the tree to the relation holding the subject, one per entity; the fewest entities are preferred. The
+// access is conditional, and the path empty, if no relation holds the subject, as when attributes or rules grant the
+// permission. Excluded branches of the tree are not searched.
+func Explain(tree *base.Expand, subject *base.Subject) (base.AccessReviewEntry_Access, []string) {
+	chain := find(tree, subject)
+	if chain == nil {
+		return base.AccessReviewEntry_ACCESS_CONDITIONAL, nil
+	}
+
+	path := compress(chain)
+	if len(path) == 1 {
+		return base.AccessReviewEntry_ACCESS_DIRECT, path
+	}
+	return base.AccessReviewEntry_ACCESS_INHERITED, path
+}
+
+// find - Returns the nodes leading from a node to a leaf holding the subject, crossing the fewest entities, nil if none does
+func find(node *base.Expand, subject *base.Subject) []*base.Expand {
+	if leaf := node.GetLeaf(); leaf != nil {
+		for _, s := range leaf.GetSubjects().GetSubjects() {
+			if s.GetType() == subject.GetType() && s.GetId() == subject.GetId() && tuple.NormalizeRelation(s.GetRelation()) == "" {
+				return []*base.Expand{node}
+			}
+		}
+		return nil
+	}
+
+	children := node.GetExpand().GetChildren()
+	if node.GetExpand().GetOperation() == base.ExpandTreeNode_OPERATION_EXCLUSION && len(children) > 0 {
+		children = children[:1]
+	}
+
+	var best []*base.Expand
+	for _, child := range children {
+		chain := find(child, subject)
+		if chain != nil && (best == nil || len(compress(chain)) < len(compress(best))) {
+			best = chain
+		}
+	}
+	if best == nil {
+		return nil
+	}
+	return append([]*base.Expand{node}, best...)
+}
+
+// compress - Returns the last relation of every run of nodes of the same entity in a chain
+func compress(chain []*base.Expand) []string {
+	var path []string
+	for i, node := range chain {
+		if i+1 < len(chain) && tuple.EntityToString(node.GetEntity()) == tuple.EntityToString(chain[i+1].GetEntity()) {
+			continue
+		}
+		path = append(path, tuple.EntityToString(node.GetEntity())+"#"+node.GetPermission())
+	}
+	return path
+}
@@ -0,0 +1,243 @@
+package report_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/engines"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/internal/invoke"
+	"github.com/Permify/permify/internal/report"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/attribute"
+	"github.com/Permify/permify/pkg/database"
+	"github.com/Permify/permify/pkg/dsl/compiler"
+	"github.com/Permify/permify/pkg/dsl/parser"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+func TestReport(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "report suite")
+}
+
+var _ = Describe("report", func() {
+	var reviewer *report.AccessReviewer
+	var dataWriter storage.DataWriter
+
+	review := func(request *base.AuditAccessReviewRequest) []report.Row {
+		var rows []report.Row
+		Expect(reviewer.Review(context.Background(), request, func(entry *base.AccessReviewEntry) error {
+			rows = append(rows, report.NewRow(entry))
+			return nil
+		})).Should(Succeed())
+		return rows
+	}
+
+	BeforeEach(func() {
+		db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		sch, err := parser.NewParser(`
+		entity user {}
+
+		entity organization {
+			relation admin @user
+		}
+
+		entity doc {
+			relation parent @organization
+			relation owner @user
+			relation banned @user
+
+			attribute public boolean
+
+			permission view = (owner or parent.admin) not banned
+		}
+		`).Parse()
+		Expect(err).ShouldNot(HaveOccurred())
+		_, _, err = compiler.NewCompiler(true, sch).Compile()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var definitions []storage.SchemaDefinition
+		for _, st := range sch.Statements {
+			definitions = append(definitions, storage.SchemaDefinition{
+				TenantID:             "t1",
+				Version:              "v1",
+				Name:                 st.GetName(),
+				SerializedDefinition: []byte(st.String()),
+			})
+		}
+		Expect(factories.SchemaWriterFactory(db).WriteSchema(context.Background(), definitions)).Should(Succeed())
+
+		var tuples []*base.Tuple
+		for _, relationship := range []string{
+			"doc:1#owner@user:1",
+			"doc:1#parent@organization:1#...",
+			"organization:1#admin@user:2",
+			"organization:1#admin@user:3",
+			"doc:1#banned@user:3",
+			"doc:2#owner@user:3",
+		} {
+			t, err := tuple.Tuple(relationship)
+			Expect(err).ShouldNot(HaveOccurred())
+			tuples = append(tuples, t)
+		}
+		dataWriter = factories.DataWriterFactory(db)
+		_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection())
+		Expect(err).ShouldNot(HaveOccurred())
+
+		schemaReader := factories.SchemaReaderFactory(db)
+		dataReader := factories.DataReaderFactory(db)
+
+		checkEngine := engines.NewCheckEngine(schemaReader, dataReader)
+		invoker := invoke.NewDirectInvoker(
+			schemaReader,
+			dataReader,
+			checkEngine,
+			engines.NewExpandEngine(schemaReader, dataReader),
+			engines.NewLookupEngine(checkEngine, schemaReader, dataReader),
+			engines.NewSubjectPermission(checkEngine, schemaReader),
+		)
+		checkEngine.SetInvoker(invoker)
+
+		reviewer = report.NewAccessReviewer(schemaReader, dataReader, invoker)
+	})
+
+	Context("Access Review", func() {
+		It("Case 1: lists the direct and inherited holders of a permission with the relations granting it", func() {
+			request := &base.AuditAccessReviewRequest{
+				TenantId:     "t1",
+				Metadata:     &base.AuditAccessReviewRequestMetadata{Depth: 20},
+				EntityTypes:  []string{"doc"},
+				SubjectTypes: []string{"user"},
+			}
+			rows := review(request)
+
+			Expect(rows).Should(ConsistOf(
+				report.Row{EntityType: "doc", EntityID: "1", Permission: "view", SubjectType: "user", SubjectID: "1", Access: "direct", Path: []string{"doc:1#owner"}},
+				report.Row{EntityType: "doc", EntityID: "1", Permission: "view", SubjectType: "user", SubjectID: "2", Access: "inherited", Path: []string{"doc:1#parent", "organization:1#admin"}},
+				report.Row{EntityType: "doc", EntityID: "2", Permission: "view", SubjectType: "user", SubjectID: "3", Access: "direct", Path: []string{"doc:2#owner"}},
+			))
+			Expect(request.GetMetadata().GetSnapToken()).ShouldNot(BeEmpty())
+			Expect(request.GetMetadata().GetSchemaVersion()).Should(Equal("v1"))
+		})
+
+		It("Case 2: reviews only the requested permissions", func() {
+			rows := review(&base.AuditAccessReviewRequest{
+				TenantId:    "t1",
+				Metadata:    &base.AuditAccessReviewRequestMetadata{Depth: 20},
+				EntityTypes: []string{"doc"},
+				Permissions: []string{"edit"},
+			})
+
+			Expect(rows).Should(BeEmpty())
+		})
+
+		It("Case 3: fails on unknown entity types", func() {
+			err := reviewer.Review(context.Background(), &base.AuditAccessReviewRequest{
+				TenantId:    "t1",
+				Metadata:    &base.AuditAccessReviewRequestMetadata{Depth: 20},
+				EntityTypes: []string{"folder"},
+			}, func(*base.AccessReviewEntry) error { return nil })
+
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_ENTITY_DEFINITION_NOT_FOUND.String()))
+		})
+
+		It("Case 4: reviews entities with more tuples than a page and with attributes once", func() {
+			var tuples []*base.Tuple
+			for i := 0; i < 250; i++ {
+				t, err := tuple.Tuple(fmt.Sprintf("doc:3#owner@user:u%03d", i))
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, t)
+			}
+			public, err := attribute.Attribute("doc:3$public|boolean:true")
+			Expect(err).ShouldNot(HaveOccurred())
+			attributeOnly, err := attribute.Attribute("doc:4$public|boolean:true")
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = dataWriter.Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection(public, attributeOnly))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			rows := review(&base.AuditAccessReviewRequest{
+				TenantId:     "t1",
+				Metadata:     &base.AuditAccessReviewRequestMetadata{Depth: 20},
+				EntityTypes:  []string{"doc"},
+				SubjectTypes: []string{"user"},
+			})
+
+			subjects := map[string]int{}
+			for _, row := range rows {
+				if row.EntityID == "3" {
+					subjects[row.SubjectID]++
+				}
+			}
+			Expect(subjects).Should(HaveLen(250))
+			for _, count := range subjects {
+				Expect(count).Should(Equal(1))
+			}
+			Expect(rows).Should(HaveLen(253))
+		})
+	})
+
+	Context("Explain", func() {
+		It("Case 1: reports subjects not found in the expansion as conditional", func() {
+			access, path := report.Explain(&base.Expand{
+				Entity:     &base.Entity{Type: "doc", Id: "1"},
+				Permission: "view",
+				Node:       &base.Expand_Leaf{Leaf: &base.ExpandLeaf{Type: &base.ExpandLeaf_Subjects{Subjects: &base.Subjects{}}}},
+			}, &base.Subject{Type: "user", Id: "1"})
+
+			Expect(access).Should(Equal(base.AccessReviewEntry_ACCESS_CONDITIONAL))
+			Expect(path).Should(BeNil())
+		})
+	})
+
+	Context("Writer", func() {
+		row := report.Row{EntityType: "doc", EntityID: "1", Permission: "view", SubjectType: "user", SubjectID: "2", Access: "inherited", Path: []string{"doc:1#parent", "organization:1#admin"}}
+
+		It("Case 1: writes csv with a header", func() {
+			var buf bytes.Buffer
+			w, err := report.NewWriter(report.FormatCSV, &buf)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(w.Write(row)).Should(Succeed())
+			Expect(w.Close()).Should(Succeed())
+
+			lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+			Expect(lines).Should(HaveLen(2))
+			Expect(lines[1]).Should(Equal("doc,1,view,user,2,inherited,doc:1#parent > organization:1#admin"))
+		})
+
+		It("Case 2: writes json lines", func() {
+			var buf bytes.Buffer
+			w, err := report.NewWriter(report.FormatJSONLines, &buf)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(w.Write(row)).Should(Succeed())
+			Expect(w.Close()).Should(Succeed())
+
+			Expect(buf.String()).Should(ContainSubstring(`"path":["doc:1#parent","organization:1#admin"]`))
+		})
+
+		It("Case 3: writes parquet", func() {
+			var buf bytes.Buffer
+			w, err := report.NewWriter(report.FormatParquet, &buf)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(w.Write(row)).Should(Succeed())
+			Expect(w.Close()).Should(Succeed())
+
+			Expect(buf.Bytes()[:4]).Should(Equal([]byte("PAR1")))
+		})
+
+		It("Case 4: rejects unknown formats", func() {
+			_, err := report.NewWriter("xml", &bytes.Buffer{})
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
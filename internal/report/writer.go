@@ -0,0 +1,118 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/parquet-go/parquet-go"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Formats of the access review files
+const (
+	FormatCSV       = "csv"
+	FormatJSONLines = "jsonl"
+	FormatParquet   = "parquet"
+)
+
+// parquetGroupRows - Number of rows of a parquet row group, which is held in memory until it is full
+const parquetGroupRows = 10000
+
+// Row - Entry of an access review as written to files
+type Row struct {
+	EntityType  string   `json:"entity_type" parquet:"entity_type"`
+	EntityID    string   `json:"entity_id" parquet:"entity_id"`
+	Permission  string   `json:"permission" parquet:"permission"`
+	SubjectType string   `json:"subject_type" parquet:"subject_type"`
+	SubjectID   string   `json:"subject_id" parquet:"subject_id"`
+	Access      string   `json:"access" parquet:"access"`
+	Path        []string `json:"path" parquet:"path,list"`
+}
+
+// NewRow - Creates the row of an entry, its access is written in lower case without its prefix, e.g. "direct"
+func NewRow(entry *base.AccessReviewEntry) Row {
+	return Row{
+		EntityType:  entry.GetEntityType(),
+		EntityID:    entry.GetEntityId(),
+		Permission:  entry.GetPermission(),
+		SubjectType: entry.GetSubject().GetType(),
+		SubjectID:   entry.GetSubject().GetId(),
+		Access:      strings.ToLower(strings.TrimPrefix(entry.GetAccess().String(), "ACCESS_")),
+		Path:        entry.GetPath(),
+	}
+}
+
+// Writer - Writes the rows of an access review as they are found
+type Writer interface {
+	// Write appends a row.
+	Write(row Row) error
+	// Close flushes the rows written, it does not close the underlying writer.
+	Close() error
+}
+
+// NewWriter - Creates a writer of a format
+func NewWriter(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w)
+	case FormatJSONLines:
+		return &jsonLinesWriter{encoder: json.NewEncoder(w)}, nil
+	case FormatParquet:
+		return &parquetWriter{writer: parquet.NewGenericWriter[Row](w, parquet.MaxRowsPerRowGroup(parquetGroupRows))}, nil
+	default:
+		return nil, fmt.Errorf("unknown access review format '%s', must be one of %s, %s or %s", format, FormatCSV, FormatJSONLines, FormatParquet)
+	}
+}
+
+// csvWriter - Writes rows as CSV records after a header, the relations of a path are separated by " > "
+type csvWriter struct {
+	writer *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"entity_type", "entity_id", "permission", "subject_type", "subject_id", "access", "path"}); err != nil {
+		return nil, err
+	}
+	return &csvWriter{writer: writer}, nil
+}
+
+func (c *csvWriter) Write(row Row) error {
+	return c.writer.Write([]string{row.EntityType, row.EntityID, row.Permission, row.SubjectType, row.SubjectID, row.Access, strings.Join(row.Path, " > ")})
+}
+
+func (c *csvWriter) Close() error {
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+// jsonLinesWriter - Writes rows as JSON objects, one per line
+type jsonLinesWriter struct {
+	encoder *json.Encoder
+}
+
+func (j *jsonLinesWriter) Write(row Row) error {
+	return j.encoder.Encode(row)
+}
+
+func (j *jsonLinesWriter) Close() error {
+	return nil
+}
+
+// parquetWriter - Writes rows to a parquet file, flushing a row group every parquetGroupRows rows
+type parquetWriter struct {
+	writer *parquet.GenericWriter[Row]
+}
+
+func (p *parquetWriter) Write(row Row) error {
+	_, err := p.writer.Write([]Row{row})
+	return err
+}
+
+func (p *parquetWriter) Close() error {
+	return p.writer.Close()
+}
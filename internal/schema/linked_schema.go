@@ -372,6 +372,9 @@ func (g *LinkedSchemaGraph) findEntranceLeaf(target, source *base.Entrance, leaf
 			}
 		}
 		return entrances, nil
+	case *base.Leaf_Count:
+		// A count does not depend on the subject, so it can not provide entrances on its own.
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_NOT_SUPPORTED_COUNT.String())
 	default:
 		return nil, ErrUndefinedLeafType
 	}
@@ -394,7 +397,12 @@ func (g *LinkedSchemaGraph) findEntranceLeaf(target, source *base.Entrance, leaf
 //     source relation does not exist in the schema graph
 func (g *LinkedSchemaGraph) findEntranceRewrite(target, source *base.Entrance, rewrite *base.Rewrite, visited map[string]struct{}) (results []*LinkedEntrance, err error) {
 	var res []*LinkedEntrance
-	for _, child := range rewrite.GetChildren() {
+	for i, child := range rewrite.GetChildren() {
+		// Count leaves are skipped when the other children narrow down the candidates,
+		// the count is then evaluated while checking the candidates.
+		if child.GetLeaf().GetCount() != nil && isNarrowedCount(rewrite, i) {
+			continue
+		}
 		switch child.GetType().(type) {
 		case *base.Child_Rewrite:
 			results, err = g.findEntranceRewrite(target, source, child.GetRewrite(), visited)
@@ -414,6 +422,24 @@ func (g *LinkedSchemaGraph) findEntranceRewrite(target, source *base.Entrance, r
 	return res, nil
 }
 
+// isNarrowedCount reports whether the i-th child of the rewrite is restricted by its siblings,
+// either as an excluded child of an exclusion or next to a non count child of an intersection.
+func isNarrowedCount(rewrite *base.Rewrite, i int) bool {
+	switch rewrite.GetRewriteOperation() {
+	case base.Rewrite_OPERATION_EXCLUSION:
+		return i > 0
+	case base.Rewrite_OPERATION_INTERSECTION:
+		for _, child := range rewrite.GetChildren() {
+			if child.GetLeaf().GetCount() == nil {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
 // GetInverseRelation finds which relation connects the given entity types.
 // Returns the relation name that connects sourceEntityType to targetEntityType.
 func (g *LinkedSchemaGraph) GetInverseRelation(sourceEntityType, targetEntityType string) (string, error) {
@@ -583,7 +609,7 @@ func (g *LinkedSchemaGraph) collectSelfCycleRelations(entityType, permission str
 				return
 			}
 		}
-	case *base.Leaf_ComputedUserSet, *base.Leaf_ComputedAttribute, *base.Leaf_Call:
+	case *base.Leaf_ComputedUserSet, *base.Leaf_ComputedAttribute, *base.Leaf_Call, *base.Leaf_Count:
 		return
 	}
 }
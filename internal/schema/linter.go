@@ -0,0 +1,416 @@
+package schema
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Permify/permify/pkg/dsl/ast"
+	"github.com/Permify/permify/pkg/dsl/compiler"
+	"github.com/Permify/permify/pkg/dsl/parser"
+	"github.com/Permify/permify/pkg/dsl/token"
+	"github.com/Permify/permify/pkg/dsl/utils"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// Lint rule identifiers reported in SchemaLintFinding.Rule.
+const (
+	// LintRuleUnusedRelation reports relations that are not referenced by any permission or relation type.
+	LintRuleUnusedRelation = "unused-relation"
+	// LintRuleUnusedAttribute reports attributes that are not referenced by any permission.
+	LintRuleUnusedAttribute = "unused-attribute"
+	// LintRuleUnusedRule reports rules that are never called.
+	LintRuleUnusedRule = "unused-rule"
+	// LintRuleUngrantablePermission reports permissions that can never be granted to any subject.
+	LintRuleUngrantablePermission = "ungrantable-permission"
+	// LintRuleRecursionWithoutBaseCase reports permissions that only refer back to themselves.
+	LintRuleRecursionWithoutBaseCase = "recursion-without-base-case"
+	// LintRuleEmptyExclusionBase reports exclusions whose left side can never be granted.
+	LintRuleEmptyExclusionBase = "empty-exclusion-base"
+)
+
+// Lint severity names accepted in the lint configuration.
+const (
+	LintSeverityError   = "error"
+	LintSeverityWarning = "warning"
+	LintSeverityInfo    = "info"
+	LintSeverityOff     = "off"
+)
+
+// defaultLintSeverities holds the severity of every lint rule when it is not overridden.
+var defaultLintSeverities = map[string]base.SchemaLintFinding_Severity{
+	LintRuleUnusedRelation:           base.SchemaLintFinding_SEVERITY_WARNING,
+	LintRuleUnusedAttribute:          base.SchemaLintFinding_SEVERITY_WARNING,
+	LintRuleUnusedRule:               base.SchemaLintFinding_SEVERITY_WARNING,
+	LintRuleUngrantablePermission:    base.SchemaLintFinding_SEVERITY_ERROR,
+	LintRuleRecursionWithoutBaseCase: base.SchemaLintFinding_SEVERITY_ERROR,
+	LintRuleEmptyExclusionBase:       base.SchemaLintFinding_SEVERITY_WARNING,
+}
+
+// Linter statically analyzes a schema and reports findings such as unused relations
+// or permissions that can never be granted.
+type Linter struct {
+	// severities of the rules, rules mapped to SEVERITY_UNSPECIFIED are turned off
+	severities map[string]base.SchemaLintFinding_Severity
+}
+
+// NewLinter creates a new Linter. The given rules override the default severity of a rule,
+// keyed by rule identifier with one of "error", "warning", "info" or "off" as value.
+func NewLinter(rules map[string]string) (*Linter, error) {
+	severities := make(map[string]base.SchemaLintFinding_Severity, len(defaultLintSeverities))
+	for rule, severity := range defaultLintSeverities {
+		severities[rule] = severity
+	}
+
+	for rule, value := range rules {
+		if _, ok := defaultLintSeverities[rule]; !ok {
+			return nil, fmt.Errorf("unknown lint rule: %s", rule)
+		}
+		switch value {
+		case LintSeverityError:
+			severities[rule] = base.SchemaLintFinding_SEVERITY_ERROR
+		case LintSeverityWarning:
+			severities[rule] = base.SchemaLintFinding_SEVERITY_WARNING
+		case LintSeverityInfo:
+			severities[rule] = base.SchemaLintFinding_SEVERITY_INFO
+		case LintSeverityOff:
+			severities[rule] = base.SchemaLintFinding_SEVERITY_UNSPECIFIED
+		default:
+			return nil, fmt.Errorf("unknown lint severity %q for rule %s", value, rule)
+		}
+	}
+
+	return &Linter{
+		severities: severities,
+	}, nil
+}
+
+// Lint parses and compiles the given schema and returns its findings ordered by position.
+// An error is returned if the schema can not be parsed or compiled.
+func (l *Linter) Lint(schema string) ([]*base.SchemaLintFinding, error) {
+	sch, err := parser.NewParser(schema).Parse()
+	if err != nil {
+		return nil, err
+	}
+
+	entities, rules, err := compiler.NewCompiler(true, sch).Compile()
+	if err != nil {
+		return nil, err
+	}
+
+	a := &lintAnalysis{
+		linter:     l,
+		schema:     NewSchemaFromEntityAndRuleDefinitions(entities, rules),
+		positions:  lintPositions(sch),
+		used:       map[string]struct{}{},
+		grantable:  map[string]bool{},
+		dependency: map[string][]string{},
+	}
+
+	if err := a.run(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(a.findings, func(i, j int) bool {
+		if a.findings[i].GetLine() != a.findings[j].GetLine() {
+			return a.findings[i].GetLine() < a.findings[j].GetLine()
+		}
+		if a.findings[i].GetColumn() != a.findings[j].GetColumn() {
+			return a.findings[i].GetColumn() < a.findings[j].GetColumn()
+		}
+		return a.findings[i].GetRule() < a.findings[j].GetRule()
+	})
+
+	return a.findings, nil
+}
+
+// lintAnalysis holds the state of a single Lint call.
+type lintAnalysis struct {
+	linter *Linter
+	schema *base.SchemaDefinition
+
+	// positions of the names of entities, rules and their members keyed by utils.Key(entity, name) or rule name
+	positions map[string]token.PositionInfo
+	// references to relations, attributes and rules keyed like positions
+	used map[string]struct{}
+	// grantable state of every permission keyed by utils.Key(entity, permission)
+	grantable map[string]bool
+	// permissions directly referenced by every permission keyed by utils.Key(entity, permission)
+	dependency map[string][]string
+
+	findings []*base.SchemaLintFinding
+}
+
+// run collects the references and grantability of the schema and reports the findings.
+func (a *lintAnalysis) run() error {
+	for _, entity := range a.schema.GetEntityDefinitions() {
+		for _, relation := range entity.GetRelations() {
+			for _, reference := range relation.GetRelationReferences() {
+				if reference.GetRelation() != "" {
+					a.used[utils.Key(reference.GetType(), reference.GetRelation())] = struct{}{}
+				}
+			}
+		}
+	}
+
+	// the walker reaches every leaf of the permissions once, following computed user sets and tuple to user sets
+	// into the permissions they refer to
+	walker := NewVisitingWalker(a.schema, a.collect)
+	for _, entity := range a.schema.GetEntityDefinitions() {
+		for name := range entity.GetPermissions() {
+			a.grantable[utils.Key(entity.GetName(), name)] = false
+			if err := walker.Walk(entity.GetName(), name); err != nil {
+				return err
+			}
+		}
+	}
+
+	// grantability is the least fixed point of the permission expressions, starting with every
+	// permission ungrantable, so recursive permissions without a base case are never granted
+	for changed := true; changed; {
+		changed = false
+		for _, entity := range a.schema.GetEntityDefinitions() {
+			for name, permission := range entity.GetPermissions() {
+				key := utils.Key(entity.GetName(), name)
+				if !a.grantable[key] && a.isGrantable(entity.GetName(), permission.GetChild()) {
+					a.grantable[key] = true
+					changed = true
+				}
+			}
+		}
+	}
+
+	graph := NewLinkedGraph(a.schema)
+	for _, entity := range a.schema.GetEntityDefinitions() {
+		for name := range entity.GetRelations() {
+			if _, ok := a.used[utils.Key(entity.GetName(), name)]; !ok {
+				a.report(LintRuleUnusedRelation, utils.Key(entity.GetName(), name),
+					fmt.Sprintf("relation %s#%s is not referenced by any permission", entity.GetName(), name))
+			}
+		}
+		for name := range entity.GetAttributes() {
+			if _, ok := a.used[utils.Key(entity.GetName(), name)]; !ok {
+				a.report(LintRuleUnusedAttribute, utils.Key(entity.GetName(), name),
+					fmt.Sprintf("attribute %s#%s is not referenced by any permission", entity.GetName(), name))
+			}
+		}
+		for name, permission := range entity.GetPermissions() {
+			key := utils.Key(entity.GetName(), name)
+			// the graph names the relations a permission walks back to itself, such as parent in view = parent.view
+			if relations := graph.SelfCycleRelationsForPermission(entity.GetName(), name); !a.grantable[key] && len(relations) > 0 {
+				a.report(LintRuleRecursionWithoutBaseCase, key,
+					fmt.Sprintf("permission %s refers to itself through %s without a base case and can never be granted", key, strings.Join(relations, ", ")))
+				continue
+			}
+			if !a.grantable[key] && a.isRecursive(key) {
+				a.report(LintRuleRecursionWithoutBaseCase, key,
+					fmt.Sprintf("permission %s refers to itself without a base case and can never be granted", key))
+				continue
+			}
+			// an exclusion with an empty base already explains why the permission is never granted
+			excluded := a.checkExclusions(entity.GetName(), key, permission.GetChild())
+			if !a.grantable[key] && !excluded {
+				a.report(LintRuleUngrantablePermission, key,
+					fmt.Sprintf("permission %s can never be granted", key))
+			}
+		}
+	}
+
+	for name := range a.schema.GetRuleDefinitions() {
+		if _, ok := a.used[name]; !ok {
+			a.report(LintRuleUnusedRule, name, fmt.Sprintf("rule %s is never called", name))
+		}
+	}
+
+	return nil
+}
+
+// collect records the relations, attributes, rules and permissions referenced by a leaf of the permission with the
+// given key.
+func (a *lintAnalysis) collect(entityType, key string, leaf *base.Leaf) error {
+	switch t := leaf.GetType().(type) {
+	case *base.Leaf_ComputedUserSet:
+		a.reference(key, entityType, t.ComputedUserSet.GetRelation())
+	case *base.Leaf_TupleToUserSet:
+		tupleSet := t.TupleToUserSet.GetTupleSet().GetRelation()
+		a.used[utils.Key(entityType, tupleSet)] = struct{}{}
+		relation, err := GetRelationByNameInEntityDefinition(a.schema.GetEntityDefinitions()[entityType], tupleSet)
+		if err != nil {
+			return err
+		}
+		for _, reference := range relation.GetRelationReferences() {
+			a.reference(key, reference.GetType(), t.TupleToUserSet.GetComputed().GetRelation())
+		}
+	case *base.Leaf_ComputedAttribute:
+		a.used[utils.Key(entityType, t.ComputedAttribute.GetName())] = struct{}{}
+	case *base.Leaf_Call:
+		a.used[t.Call.GetRuleName()] = struct{}{}
+		for _, argument := range t.Call.GetArguments() {
+			if attribute := argument.GetComputedAttribute(); attribute != nil {
+				a.used[utils.Key(entityType, attribute.GetName())] = struct{}{}
+			}
+		}
+	case *base.Leaf_Count:
+		a.used[utils.Key(entityType, t.Count.GetRelation())] = struct{}{}
+	default:
+		return ErrUndefinedLeafType
+	}
+	return nil
+}
+
+// reference marks the given member of an entity as used by the permission with the given key.
+func (a *lintAnalysis) reference(key, entityType, name string) {
+	reference := utils.Key(entityType, name)
+	a.used[reference] = struct{}{}
+	if entity, ok := a.schema.GetEntityDefinitions()[entityType]; ok {
+		if entity.GetReferences()[name] == base.EntityDefinition_REFERENCE_PERMISSION {
+			a.dependency[key] = append(a.dependency[key], reference)
+		}
+	}
+}
+
+// isGrantable reports whether the child can be granted to some subject, given the current
+// grantable state of the permissions. Relations, attributes, rule calls and counts are
+// assumed grantable since they depend on the stored data.
+func (a *lintAnalysis) isGrantable(entityType string, child *base.Child) bool {
+	if rewrite := child.GetRewrite(); rewrite != nil {
+		children := rewrite.GetChildren()
+		switch rewrite.GetRewriteOperation() {
+		case base.Rewrite_OPERATION_UNION:
+			for _, c := range children {
+				if a.isGrantable(entityType, c) {
+					return true
+				}
+			}
+			return false
+		case base.Rewrite_OPERATION_INTERSECTION:
+			for _, c := range children {
+				if !a.isGrantable(entityType, c) {
+					return false
+				}
+			}
+			return true
+		case base.Rewrite_OPERATION_EXCLUSION:
+			return len(children) > 0 && a.isGrantable(entityType, children[0])
+		default:
+			return false
+		}
+	}
+
+	switch t := child.GetLeaf().GetType().(type) {
+	case *base.Leaf_ComputedUserSet:
+		return a.isReferenceGrantable(entityType, t.ComputedUserSet.GetRelation())
+	case *base.Leaf_TupleToUserSet:
+		entity := a.schema.GetEntityDefinitions()[entityType]
+		for _, reference := range entity.GetRelations()[t.TupleToUserSet.GetTupleSet().GetRelation()].GetRelationReferences() {
+			if a.isReferenceGrantable(reference.GetType(), t.TupleToUserSet.GetComputed().GetRelation()) {
+				return true
+			}
+		}
+		return false
+	default:
+		return true
+	}
+}
+
+// isReferenceGrantable reports whether the given member of an entity can be granted.
+func (a *lintAnalysis) isReferenceGrantable(entityType, name string) bool {
+	entity, ok := a.schema.GetEntityDefinitions()[entityType]
+	if !ok {
+		return false
+	}
+	switch entity.GetReferences()[name] {
+	case base.EntityDefinition_REFERENCE_PERMISSION:
+		return a.grantable[utils.Key(entityType, name)]
+	case base.EntityDefinition_REFERENCE_RELATION, base.EntityDefinition_REFERENCE_ATTRIBUTE:
+		return true
+	default:
+		return false
+	}
+}
+
+// checkExclusions reports the exclusions of a permission whose base can never be granted,
+// and returns whether any was found.
+func (a *lintAnalysis) checkExclusions(entityType, key string, child *base.Child) bool {
+	rewrite := child.GetRewrite()
+	if rewrite == nil {
+		return false
+	}
+
+	found := false
+	for _, c := range rewrite.GetChildren() {
+		if a.checkExclusions(entityType, key, c) {
+			found = true
+		}
+	}
+
+	if rewrite.GetRewriteOperation() == base.Rewrite_OPERATION_EXCLUSION && len(rewrite.GetChildren()) > 0 &&
+		!a.isGrantable(entityType, rewrite.GetChildren()[0]) {
+		a.report(LintRuleEmptyExclusionBase, key,
+			fmt.Sprintf("permission %s excludes from an expression that can never be granted", key))
+		found = true
+	}
+	return found
+}
+
+// isRecursive reports whether the permission with the given key depends on itself.
+func (a *lintAnalysis) isRecursive(key string) bool {
+	visited := map[string]struct{}{}
+	stack := append([]string{}, a.dependency[key]...)
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if current == key {
+			return true
+		}
+		if _, ok := visited[current]; ok {
+			continue
+		}
+		visited[current] = struct{}{}
+		stack = append(stack, a.dependency[current]...)
+	}
+	return false
+}
+
+// report adds a finding for the given rule unless the rule is turned off.
+func (a *lintAnalysis) report(rule, key, message string) {
+	severity := a.linter.severities[rule]
+	if severity == base.SchemaLintFinding_SEVERITY_UNSPECIFIED {
+		return
+	}
+	position := a.positions[key]
+	a.findings = append(a.findings, &base.SchemaLintFinding{
+		Rule:     rule,
+		Severity: severity,
+		Message:  message,
+		Line:     int32(position.LinePosition),
+		Column:   int32(position.ColumnPosition),
+	})
+}
+
+// lintPositions returns the positions of the names of the statements in the schema.
+func lintPositions(sch *ast.Schema) map[string]token.PositionInfo {
+	positions := map[string]token.PositionInfo{}
+	for _, statement := range sch.Statements {
+		switch st := statement.(type) {
+		case *ast.EntityStatement:
+			positions[st.Name.Literal] = st.Name.PositionInfo
+			members := append(append(append([]ast.Statement{}, st.RelationStatements...), st.AttributeStatements...), st.PermissionStatements...)
+			for _, member := range members {
+				var name token.Token
+				switch m := member.(type) {
+				case *ast.RelationStatement:
+					name = m.Name
+				case *ast.AttributeStatement:
+					name = m.Name
+				case *ast.PermissionStatement:
+					name = m.Name
+				}
+				positions[utils.Key(st.Name.Literal, name.Literal)] = name.PositionInfo
+			}
+		case *ast.RuleStatement:
+			positions[st.Name.Literal] = st.Name.PositionInfo
+		}
+	}
+	return positions
+}
@@ -0,0 +1,218 @@
+package schema
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+var _ = Describe("linter", func() {
+	Context("Lint", func() {
+		It("Case 1: clean schema has no findings", func() {
+			l, err := NewLinter(nil)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			findings, err := l.Lint(`
+entity user {}
+
+entity organization {
+    relation member @user
+}
+
+entity document {
+    relation org @organization
+    relation owner @user
+
+    attribute is_public boolean
+
+    permission view = owner or org.member or is_public
+}`)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(findings).Should(BeEmpty())
+		})
+
+		It("Case 2: unused relations, attributes and rules", func() {
+			l, err := NewLinter(nil)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			findings, err := l.Lint(`entity user {}
+
+entity document {
+    relation owner @user
+    relation editor @user
+
+    attribute is_public boolean
+    attribute level integer
+
+    permission view = owner or is_public
+}
+
+rule is_high(level integer) {
+    level > 3
+}`)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(findings).Should(Equal([]*base.SchemaLintFinding{
+				{
+					Rule:     LintRuleUnusedRelation,
+					Severity: base.SchemaLintFinding_SEVERITY_WARNING,
+					Message:  "relation document#editor is not referenced by any permission",
+					Line:     5,
+					Column:   15,
+				},
+				{
+					Rule:     LintRuleUnusedAttribute,
+					Severity: base.SchemaLintFinding_SEVERITY_WARNING,
+					Message:  "attribute document#level is not referenced by any permission",
+					Line:     8,
+					Column:   16,
+				},
+				{
+					Rule:     LintRuleUnusedRule,
+					Severity: base.SchemaLintFinding_SEVERITY_WARNING,
+					Message:  "rule is_high is never called",
+					Line:     13,
+					Column:   7,
+				},
+			}))
+		})
+
+		It("Case 3: relations referenced by relation types, tuple to user sets and counts are used", func() {
+			l, err := NewLinter(nil)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			findings, err := l.Lint(`
+entity user {}
+
+entity team {
+    relation member @user
+}
+
+entity document {
+    relation parent @team
+    relation viewer @team#member
+    relation approver @user
+
+    attribute level integer
+
+    permission view = viewer or parent.member
+    permission publish = count(approver) >= 2 and check_level(level)
+}
+
+rule check_level(level integer) {
+    level > 3
+}`)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(findings).Should(BeEmpty())
+		})
+
+		It("Case 4: recursion without base case", func() {
+			l, err := NewLinter(nil)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			findings, err := l.Lint(`entity user {}
+
+entity folder {
+    relation parent @folder
+    relation owner @user
+
+    permission view = parent.view
+    permission edit = owner or parent.edit
+}`)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(findings).Should(Equal([]*base.SchemaLintFinding{
+				{
+					Rule:     LintRuleRecursionWithoutBaseCase,
+					Severity: base.SchemaLintFinding_SEVERITY_ERROR,
+					Message:  "permission folder#view refers to itself through parent without a base case and can never be granted",
+					Line:     7,
+					Column:   17,
+				},
+			}))
+		})
+
+		It("Case 5: ungrantable permissions and empty exclusion bases", func() {
+			l, err := NewLinter(nil)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			findings, err := l.Lint(`entity user {}
+
+entity document {
+    relation owner @user
+    relation blocked @user
+
+    permission loop = loop and owner
+    permission edit = loop or owner
+    permission view = loop not blocked
+    permission share = owner and loop
+}`)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(findings).Should(Equal([]*base.SchemaLintFinding{
+				{
+					Rule:     LintRuleRecursionWithoutBaseCase,
+					Severity: base.SchemaLintFinding_SEVERITY_ERROR,
+					Message:  "permission document#loop refers to itself without a base case and can never be granted",
+					Line:     7,
+					Column:   17,
+				},
+				{
+					Rule:     LintRuleEmptyExclusionBase,
+					Severity: base.SchemaLintFinding_SEVERITY_WARNING,
+					Message:  "permission document#view excludes from an expression that can never be granted",
+					Line:     9,
+					Column:   17,
+				},
+				{
+					Rule:     LintRuleUngrantablePermission,
+					Severity: base.SchemaLintFinding_SEVERITY_ERROR,
+					Message:  "permission document#share can never be granted",
+					Line:     10,
+					Column:   17,
+				},
+			}))
+		})
+
+		It("Case 6: severity overrides", func() {
+			l, err := NewLinter(map[string]string{
+				LintRuleUnusedRelation:  LintSeverityOff,
+				LintRuleUnusedAttribute: LintSeverityInfo,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			findings, err := l.Lint(`entity user {}
+
+entity document {
+    relation editor @user
+    attribute level integer
+}`)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(findings).Should(Equal([]*base.SchemaLintFinding{
+				{
+					Rule:     LintRuleUnusedAttribute,
+					Severity: base.SchemaLintFinding_SEVERITY_INFO,
+					Message:  "attribute document#level is not referenced by any permission",
+					Line:     5,
+					Column:   16,
+				},
+			}))
+		})
+
+		It("Case 7: invalid configuration", func() {
+			_, err := NewLinter(map[string]string{"unknown-rule": LintSeverityError})
+			Expect(err).Should(HaveOccurred())
+
+			_, err = NewLinter(map[string]string{LintRuleUnusedRule: "fatal"})
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("Case 8: invalid schema", func() {
+			l, err := NewLinter(nil)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = l.Lint(`entity document {
+    permission view = owner
+}`)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
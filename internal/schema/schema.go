@@ -2,6 +2,7 @@ package schema
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/Permify/permify/pkg/dsl/compiler"
@@ -163,6 +164,66 @@ func GetAttributeByNameInEntityDefinition(entityDefinition *base.EntityDefinitio
 	return nil, errors.New(base.ErrorCode_ERROR_CODE_ATTRIBUTE_DEFINITION_NOT_FOUND.String())
 }
 
+// GetAnnotationsByNameInEntityDefinition retrieves the annotations of a relation, attribute or permission
+// in an `EntityDefinition` by its name. It returns nil if the name is not defined or has no annotations.
+func GetAnnotationsByNameInEntityDefinition(entityDefinition *base.EntityDefinition, name string) *base.Annotations {
+	// Determine the kind of the reference and look up its definition accordingly
+	switch entityDefinition.GetReferences()[name] {
+	case base.EntityDefinition_REFERENCE_RELATION:
+		return entityDefinition.GetRelations()[name].GetAnnotations()
+	case base.EntityDefinition_REFERENCE_ATTRIBUTE:
+		return entityDefinition.GetAttributes()[name].GetAnnotations()
+	case base.EntityDefinition_REFERENCE_PERMISSION:
+		return entityDefinition.GetPermissions()[name].GetAnnotations()
+	default:
+		return nil
+	}
+}
+
+// GetDeprecationWarningByNameInEntityDefinition returns the warning for a relation, attribute or permission
+// in an `EntityDefinition` that is annotated as deprecated. It returns an empty string otherwise.
+func GetDeprecationWarningByNameInEntityDefinition(entityDefinition *base.EntityDefinition, name string) string {
+	// Skip the names that are not annotated as deprecated
+	if !GetAnnotationsByNameInEntityDefinition(entityDefinition, name).GetDeprecated() {
+		return ""
+	}
+
+	// Name the kind of the reference in the warning
+	kind := "permission"
+	switch entityDefinition.GetReferences()[name] {
+	case base.EntityDefinition_REFERENCE_RELATION:
+		kind = "relation"
+	case base.EntityDefinition_REFERENCE_ATTRIBUTE:
+		kind = "attribute"
+	}
+
+	return fmt.Sprintf("%s %s#%s is deprecated", kind, entityDefinition.GetName(), name)
+}
+
+// IsRelationCountedInEntityDefinition checks if a relation of an `EntityDefinition` is counted by a count aggregation
+// in one of the permissions of the entity.
+func IsRelationCountedInEntityDefinition(entityDefinition *base.EntityDefinition, name string) bool {
+	for _, permission := range entityDefinition.GetPermissions() {
+		if isRelationCountedInChild(permission.GetChild(), name) {
+			return true
+		}
+	}
+	return false
+}
+
+// isRelationCountedInChild checks if a child or one of its descendants counts the named relation.
+func isRelationCountedInChild(child *base.Child, name string) bool {
+	if count := child.GetLeaf().GetCount(); count != nil {
+		return count.GetRelation() == name
+	}
+	for _, c := range child.GetRewrite().GetChildren() {
+		if isRelationCountedInChild(c, name) {
+			return true
+		}
+	}
+	return false
+}
+
 // IsDirectlyRelated checks if a source `RelationReference` is directly related to a target `RelationDefinition`.
 // It returns true if the source and target have the same type and relation, false otherwise.
 func IsDirectlyRelated(target *base.RelationDefinition, source *base.Entrance) bool {
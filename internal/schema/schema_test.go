@@ -837,4 +837,46 @@ rule check_region(region string, regions string[]) {
 			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_ATTRIBUTE_DEFINITION_NOT_FOUND.String()))
 		})
 	})
+
+	Context("GetAnnotationsByNameInEntityDefinition", func() {
+		It("Case 1", func() {
+			definition := &base.EntityDefinition{
+				Name: "document",
+				Relations: map[string]*base.RelationDefinition{
+					"viewer": {
+						Name:        "viewer",
+						Annotations: &base.Annotations{Deprecated: true},
+					},
+				},
+				Attributes: map[string]*base.AttributeDefinition{
+					"public": {
+						Name:        "public",
+						Type:        base.AttributeType_ATTRIBUTE_TYPE_BOOLEAN,
+						Annotations: &base.Annotations{Owner: "team-docs"},
+					},
+				},
+				Permissions: map[string]*base.PermissionDefinition{
+					"view": {
+						Name:        "view",
+						Annotations: &base.Annotations{Description: "who can view the document"},
+					},
+					"edit": {
+						Name: "edit",
+					},
+				},
+				References: map[string]base.EntityDefinition_Reference{
+					"viewer": base.EntityDefinition_REFERENCE_RELATION,
+					"public": base.EntityDefinition_REFERENCE_ATTRIBUTE,
+					"view":   base.EntityDefinition_REFERENCE_PERMISSION,
+					"edit":   base.EntityDefinition_REFERENCE_PERMISSION,
+				},
+			}
+
+			Expect(GetAnnotationsByNameInEntityDefinition(definition, "viewer").GetDeprecated()).Should(BeTrue())
+			Expect(GetAnnotationsByNameInEntityDefinition(definition, "public").GetOwner()).Should(Equal("team-docs"))
+			Expect(GetAnnotationsByNameInEntityDefinition(definition, "view").GetDescription()).Should(Equal("who can view the document"))
+			Expect(GetAnnotationsByNameInEntityDefinition(definition, "edit")).Should(BeNil())
+			Expect(GetAnnotationsByNameInEntityDefinition(definition, "owner")).Should(BeNil())
+		})
+	})
 })
@@ -13,8 +13,17 @@ type Walker struct {
 
 	// map used to track visited nodes and avoid infinite recursion
 	visited map[string]struct{}
+
+	// visitor is called for every leaf reached, nil when the walker only validates the schema
+	visitor LeafVisitor
+	// permission is the key of the permission whose leaves are being walked
+	permission string
 }
 
+// LeafVisitor is called by a Walker for every leaf it reaches, with the entity type and the key of the permission
+// the leaf belongs to
+type LeafVisitor func(entityType, permission string, leaf *base.Leaf) error
+
 // NewWalker is a constructor for the Walker struct
 func NewWalker(schema *base.SchemaDefinition) *Walker {
 	return &Walker{
@@ -23,6 +32,16 @@ func NewWalker(schema *base.SchemaDefinition) *Walker {
 	}
 }
 
+// NewVisitingWalker is a constructor for a Walker that calls the visitor for every leaf it reaches. Unlike a Walker
+// created with NewWalker, it walks attributes, calls and counts instead of returning ErrUnimplemented for them.
+func NewVisitingWalker(schema *base.SchemaDefinition, visitor LeafVisitor) *Walker {
+	return &Walker{
+		schema:  schema,
+		visited: make(map[string]struct{}),
+		visitor: visitor,
+	}
+}
+
 // Walk traverses the schema based on entity type and permission
 func (w *Walker) Walk(
 	entityType string,
@@ -56,6 +75,11 @@ func (w *Walker) Walk(
 			// Error is returned if permission is not found
 			return errors.New(base.ErrorCode_ERROR_CODE_PERMISSION_NOT_FOUND.String())
 		}
+		// Track the permission whose leaves are walked, restoring the previous one once it is walked
+		previous := w.permission
+		w.permission = key
+		defer func() { w.permission = previous }()
+
 		// Check if the permission has a child element
 		child := permission.GetChild()
 		// If the child has a rewrite rule, walk the rewrite rule
@@ -68,7 +92,11 @@ func (w *Walker) Walk(
 		// If the reference type is a relation, nothing to do, return nil
 		return nil
 	case base.EntityDefinition_REFERENCE_ATTRIBUTE:
-		// If the reference type is an attribute, not implemented, return error
+		// If the reference type is an attribute, there is nothing to walk for a visiting walker
+		if w.visitor != nil {
+			return nil
+		}
+		// Otherwise, not implemented, return error
 		return ErrUnimplemented
 	default:
 		// For any other reference type, not implemented, return error
@@ -115,6 +143,13 @@ func (w *Walker) WalkLeaf(
 	entityType string,
 	leaf *base.Leaf,
 ) error {
+	// Let the visitor see the leaf before it is followed
+	if w.visitor != nil {
+		if err := w.visitor(entityType, w.permission, leaf); err != nil {
+			return err
+		}
+	}
+
 	// Switch on the type of the leaf
 	switch t := leaf.GetType().(type) {
 	case *base.Leaf_TupleToUserSet:
@@ -136,9 +171,14 @@ func (w *Walker) WalkLeaf(
 			return errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE.String())
 		}
 
-		// Walk each relation reference
+		// Walk each relation reference, a visiting walker follows every referenced entity type
 		for _, rel := range relations.GetRelationReferences() {
-			return w.WalkComputedUserSet(rel.GetType(), computedUserSet)
+			if w.visitor == nil {
+				return w.WalkComputedUserSet(rel.GetType(), computedUserSet)
+			}
+			if err := w.WalkComputedUserSet(rel.GetType(), computedUserSet); err != nil {
+				return err
+			}
 		}
 
 		// If no errors occur, return nil
@@ -149,11 +189,24 @@ func (w *Walker) WalkLeaf(
 		return w.WalkComputedUserSet(entityType, t.ComputedUserSet)
 	case *base.Leaf_ComputedAttribute:
 		// Handle case where the leaf is a computed attribute
-		// This is currently unimplemented, so return an error
+		// There is nothing to follow for a visiting walker, otherwise it is unimplemented, so return an error
+		if w.visitor != nil {
+			return nil
+		}
 		return ErrUnimplemented
 	case *base.Leaf_Call:
 		// Handle case where the leaf is a call
-		// This is currently unimplemented, so return an error
+		// There is nothing to follow for a visiting walker, otherwise it is unimplemented, so return an error
+		if w.visitor != nil {
+			return nil
+		}
+		return ErrUnimplemented
+	case *base.Leaf_Count:
+		// Handle case where the leaf is a count
+		// There is nothing to follow for a visiting walker, otherwise it is unimplemented, so return an error
+		if w.visitor != nil {
+			return nil
+		}
 		return ErrUnimplemented
 	default:
 		// Handle any other type of leaf
@@ -183,6 +183,56 @@ var _ = Describe("walker", func() {
 
 			Expect(err).Should(Equal(ErrUnimplemented))
 		})
+
+		It("Case 5", func() {
+			sch, err := parser.NewParser(`
+			entity user {}
+
+			entity team {
+				relation lead @user
+				attribute active boolean
+
+				permission manage = lead and active
+			}
+
+			entity organization {
+				relation admin @user
+
+				permission manage = admin
+			}
+
+			entity project {
+				relation owner @team @organization
+
+				permission edit = owner.manage
+				permission view = edit
+			}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := compiler.NewCompiler(true, sch)
+			e, r, err := c.Compile()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var visited []string
+			w := NewVisitingWalker(NewSchemaFromEntityAndRuleDefinitions(e, r), func(entityType, permission string, leaf *base.Leaf) error {
+				visited = append(visited, permission+"@"+entityType)
+				return nil
+			})
+
+			err = w.Walk("project", "view")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(visited).Should(Equal([]string{
+				"project#view@project",
+				"project#edit@project",
+				"team#manage@team",
+				"team#manage@team",
+				"organization#manage@organization",
+			}))
+		})
 	})
 
 	Context("Error Handling", func() {
@@ -0,0 +1,81 @@
+package servers
+
+import (
+	"context"
+	"log/slog"
+
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Permify/permify/internal"
+	"github.com/Permify/permify/internal/audit"
+	"github.com/Permify/permify/internal/report"
+	"github.com/Permify/permify/pkg/database"
+	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// AuditServer - Structure for Audit Server
+type AuditServer struct {
+	v1.UnimplementedAuditServer
+
+	auditor  *audit.Auditor
+	reviewer *report.AccessReviewer
+}
+
+// NewAuditServer - Creates new Audit Server, auditor is nil if the audit log is disabled
+func NewAuditServer(auditor *audit.Auditor, reviewer *report.AccessReviewer) *AuditServer {
+	return &AuditServer{
+		auditor:  auditor,
+		reviewer: reviewer,
+	}
+}
+
+// List - List the audit records of a tenant
+func (a *AuditServer) List(ctx context.Context, request *v1.AuditListRequest) (*v1.AuditListResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "audit.list")
+	defer span.End()
+
+	if a.auditor == nil {
+		return nil, status.Error(codes.Unimplemented, v1.ErrorCode_ERROR_CODE_NOT_IMPLEMENTED.String())
+	}
+
+	records, ct, err := a.auditor.List(ctx, request.GetTenantId(), request.GetFilter(), database.NewPagination(database.Size(request.GetPageSize()), database.Token(request.GetContinuousToken())))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	return &v1.AuditListResponse{
+		Records:         records,
+		ContinuousToken: ct.String(),
+	}, nil
+}
+
+// AccessReview - Streams who holds which permission on the entities of the given types
+func (a *AuditServer) AccessReview(request *v1.AuditAccessReviewRequest, server v1.Audit_AccessReviewServer) error {
+	ctx, span := internal.Tracer.Start(server.Context(), "audit.access-review")
+	defer span.End()
+
+	v := request.Validate()
+	if v != nil {
+		return status.Error(GetStatus(v), v.Error())
+	}
+
+	err := a.reviewer.Review(ctx, request, func(entry *v1.AccessReviewEntry) error {
+		return server.Send(&v1.AuditAccessReviewResponse{
+			Entry:     entry,
+			SnapToken: request.GetMetadata().GetSnapToken(),
+		})
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return status.Error(GetStatus(err), err.Error())
+	}
+
+	return nil
+}
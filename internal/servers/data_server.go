@@ -2,16 +2,26 @@ package servers
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
 	"log/slog"
 
 	otelCodes "go.opentelemetry.io/otel/codes"
 	api "go.opentelemetry.io/otel/metric"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/Permify/permify/internal"
+	"github.com/Permify/permify/internal/analyzer"
+	"github.com/Permify/permify/internal/importer"
+	"github.com/Permify/permify/internal/invoke"
 	"github.com/Permify/permify/internal/storage"
 	"github.com/Permify/permify/internal/validation"
+	"github.com/Permify/permify/internal/verifier"
 	"github.com/Permify/permify/pkg/attribute"
+	pkgbundle "github.com/Permify/permify/pkg/bundle"
 	"github.com/Permify/permify/pkg/database"
 	v1 "github.com/Permify/permify/pkg/pb/base/v1"
 	"github.com/Permify/permify/pkg/telemetry"
@@ -26,6 +36,7 @@ type DataServer struct {
 	dr                           storage.DataReader
 	br                           storage.BundleReader
 	dw                           storage.DataWriter
+	snapshots                    *invoke.Snapshots
 	writeDataHistogram           api.Int64Histogram
 	deleteDataHistogram          api.Int64Histogram
 	readAttributesHistogram      api.Int64Histogram
@@ -33,6 +44,10 @@ type DataServer struct {
 	writeRelationshipsHistogram  api.Int64Histogram
 	deleteRelationshipsHistogram api.Int64Histogram
 	runBundleHistogram           api.Int64Histogram
+	importDataHistogram          api.Int64Histogram
+	readHistoryHistogram         api.Int64Histogram
+	verifyDataHistogram          api.Int64Histogram
+	analyzeDataHistogram         api.Int64Histogram
 }
 
 // NewDataServer - Creates new Data Server
@@ -41,12 +56,14 @@ func NewDataServer(
 	dw storage.DataWriter,
 	br storage.BundleReader,
 	sr storage.SchemaReader,
+	snapshots *invoke.Snapshots,
 ) *DataServer {
 	return &DataServer{
 		dr:                           dr,
 		dw:                           dw,
 		br:                           br,
 		sr:                           sr,
+		snapshots:                    snapshots,
 		writeDataHistogram:           telemetry.NewHistogram(internal.Meter, "write_data", "amount", "Number of writing data"),
 		deleteDataHistogram:          telemetry.NewHistogram(internal.Meter, "delete_data", "amount", "Number of deleting data"),
 		readAttributesHistogram:      telemetry.NewHistogram(internal.Meter, "read_attributes", "amount", "Number of reading attributes"),
@@ -54,6 +71,10 @@ func NewDataServer(
 		writeRelationshipsHistogram:  telemetry.NewHistogram(internal.Meter, "write_relationships", "amount", "Number of writing relationships"),
 		deleteRelationshipsHistogram: telemetry.NewHistogram(internal.Meter, "delete_relationships", "amount", "Number of deleting relationships"),
 		runBundleHistogram:           telemetry.NewHistogram(internal.Meter, "run_bundle", "amount", "Number of running bunble"),
+		importDataHistogram:          telemetry.NewHistogram(internal.Meter, "import_data", "amount", "Number of importing data"),
+		readHistoryHistogram:         telemetry.NewHistogram(internal.Meter, "read_history", "amount", "Number of reading history"),
+		verifyDataHistogram:          telemetry.NewHistogram(internal.Meter, "verify_data", "amount", "Number of verifying data"),
+		analyzeDataHistogram:         telemetry.NewHistogram(internal.Meter, "analyze_data", "amount", "Number of analyzing data"),
 	}
 }
 
@@ -72,13 +93,9 @@ func (r *DataServer) ReadRelationships(ctx context.Context, request *v1.Relation
 		return nil, status.Error(GetStatus(v), v.Error()) // Return validation error
 	}
 
-	snap := request.GetMetadata().GetSnapToken()
-	if snap == "" {
-		st, err := r.dr.HeadSnapshot(ctx, request.GetTenantId())
-		if err != nil {
-			return nil, status.Error(GetStatus(err), err.Error()) // Return snapshot error
-		}
-		snap = st.Encode().String()
+	snap, err := r.snapshots.Select(ctx, request.GetTenantId(), request.GetMetadata().GetConsistency(), request.GetMetadata().GetSnapToken(), request.GetMetadata().GetAtTime())
+	if err != nil {
+		return nil, status.Error(GetStatus(err), err.Error()) // Return snapshot error
 	}
 
 	collection, ct, err := r.dr.ReadRelationships(
@@ -121,13 +138,9 @@ func (r *DataServer) ReadAttributes(ctx context.Context, request *v1.AttributeRe
 		return nil, status.Error(GetStatus(v), v.Error()) // Return validation error
 	}
 
-	snap := request.GetMetadata().GetSnapToken()
-	if snap == "" {
-		st, err := r.dr.HeadSnapshot(ctx, request.GetTenantId())
-		if err != nil {
-			return nil, status.Error(GetStatus(err), err.Error()) // Return snapshot error
-		}
-		snap = st.Encode().String()
+	snap, err := r.snapshots.Select(ctx, request.GetTenantId(), request.GetMetadata().GetConsistency(), request.GetMetadata().GetSnapToken(), nil)
+	if err != nil {
+		return nil, status.Error(GetStatus(err), err.Error()) // Return snapshot error
 	}
 
 	collection, ct, err := r.dr.ReadAttributes(
@@ -155,6 +168,49 @@ func (r *DataServer) ReadAttributes(ctx context.Context, request *v1.AttributeRe
 	}, nil
 }
 
+// ReadHistory - Lists the versions of the stored tuples or attributes matching a filter
+func (r *DataServer) ReadHistory(ctx context.Context, request *v1.DataReadHistoryRequest) (*v1.DataReadHistoryResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "data.read.history")
+	defer span.End()
+
+	size := request.GetPageSize()
+	if size == 0 {
+		size = 50
+	}
+
+	v := request.Validate()
+	if v != nil {
+		return nil, status.Error(GetStatus(v), v.Error()) // Return validation error
+	}
+
+	pagination := database.NewPagination(
+		database.Size(size),
+		database.Token(request.GetContinuousToken()),
+	)
+
+	var records []*v1.DataHistoryRecord
+	var ct database.EncodedContinuousToken
+	var err error
+	if request.GetTupleFilter() != nil {
+		records, ct, err = r.dr.ReadRelationshipHistory(ctx, request.GetTenantId(), request.GetTupleFilter(), pagination)
+	} else {
+		records, ct, err = r.dr.ReadAttributeHistory(ctx, request.GetTenantId(), request.GetAttributeFilter(), pagination)
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	r.readHistoryHistogram.Record(ctx, 1)
+
+	return &v1.DataReadHistoryResponse{
+		Records:         records,
+		ContinuousToken: ct.String(),
+	}, nil
+}
+
 // Write - Write relationships and attributes to writeDB
 func (r *DataServer) Write(ctx context.Context, request *v1.DataWriteRequest) (*v1.DataWriteResponse, error) {
 	ctx, span := internal.Tracer.Start(ctx, "data.write")
@@ -180,6 +236,10 @@ func (r *DataServer) Write(ctx context.Context, request *v1.DataWriteRequest) (*
 
 	relationshipsMap := map[string]struct{}{}
 
+	definitions := map[string]*v1.EntityDefinition{}
+
+	var warnings []string
+
 	for _, tup := range request.GetTuples() {
 		key := tuple.ToString(tup)
 
@@ -203,9 +263,19 @@ func (r *DataServer) Write(ctx context.Context, request *v1.DataWriteRequest) (*
 			return nil, status.Error(GetStatus(err), err.Error()) // Return tuple validation error
 		}
 
+		warnings = appendDeprecationWarning(warnings, definition, tup.GetRelation())
+
+		definitions[definition.GetName()] = definition
 		relationships = append(relationships, tup)
 	}
 
+	err := validation.ValidateCardinality(definitions, relationships)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error()) // Return cardinality validation error
+	}
+
 	attrs := make([]*v1.Attribute, 0, len(request.GetAttributes()))
 
 	attributesMap := map[string]struct{}{}
@@ -233,10 +303,21 @@ func (r *DataServer) Write(ctx context.Context, request *v1.DataWriteRequest) (*
 			return nil, status.Error(GetStatus(err), err.Error()) // Return attribute validation error
 		}
 
+		warnings = appendDeprecationWarning(warnings, definition, attr.GetAttribute())
+
 		attrs = append(attrs, attr)
 	}
 
-	snap, err := r.dw.Write(ctx, request.GetTenantId(), database.NewTupleCollection(relationships...), database.NewAttributeCollection(attrs...))
+	hash, err := requestHash(request, request.GetMetadata().GetIdempotencyKey())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	snap, err := r.dw.Write(ctx, request.GetTenantId(), database.NewTupleCollection(relationships...), database.NewAttributeCollection(attrs...),
+		storage.Preconditions(request.GetPreconditions()...), storage.IdempotencyKey(request.GetMetadata().GetIdempotencyKey(), hash),
+		storage.TransactionMetadata(request.GetMetadata().GetMetadata()), storage.SchemaVersion(version))
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
@@ -246,8 +327,11 @@ func (r *DataServer) Write(ctx context.Context, request *v1.DataWriteRequest) (*
 
 	r.writeDataHistogram.Record(ctx, 1)
 
+	logWarnings(ctx, request.GetTenantId(), warnings)
+
 	return &v1.DataWriteResponse{
 		SnapToken: snap.String(),
+		Warnings:  warnings,
 	}, nil
 }
 
@@ -276,6 +360,10 @@ func (r *DataServer) WriteRelationships(ctx context.Context, request *v1.Relatio
 
 	relationshipsMap := map[string]struct{}{}
 
+	definitions := map[string]*v1.EntityDefinition{}
+
+	var warnings []string
+
 	for _, tup := range request.GetTuples() {
 		key := tuple.ToString(tup)
 
@@ -299,10 +387,20 @@ func (r *DataServer) WriteRelationships(ctx context.Context, request *v1.Relatio
 			return nil, status.Error(GetStatus(err), err.Error()) // Return tuple validation error
 		}
 
+		warnings = appendDeprecationWarning(warnings, definition, tup.GetRelation())
+
+		definitions[definition.GetName()] = definition
 		relationships = append(relationships, tup)
 	}
 
-	snap, err := r.dw.Write(ctx, request.GetTenantId(), database.NewTupleCollection(relationships...), database.NewAttributeCollection())
+	err := validation.ValidateCardinality(definitions, relationships)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error()) // Return cardinality validation error
+	}
+
+	snap, err := r.dw.Write(ctx, request.GetTenantId(), database.NewTupleCollection(relationships...), database.NewAttributeCollection(), storage.SchemaVersion(version))
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
@@ -312,8 +410,11 @@ func (r *DataServer) WriteRelationships(ctx context.Context, request *v1.Relatio
 
 	r.writeRelationshipsHistogram.Record(ctx, 1)
 
+	logWarnings(ctx, request.GetTenantId(), warnings)
+
 	return &v1.RelationshipWriteResponse{
 		SnapToken: snap.String(),
+		Warnings:  warnings,
 	}, nil
 }
 
@@ -332,7 +433,8 @@ func (r *DataServer) Delete(ctx context.Context, request *v1.DataDeleteRequest)
 		return nil, status.Error(GetStatus(v), err.Error())
 	}
 
-	snap, err := r.dw.Delete(ctx, request.GetTenantId(), request.GetTupleFilter(), request.GetAttributeFilter())
+	snap, err := r.dw.Delete(ctx, request.GetTenantId(), request.GetTupleFilter(), request.GetAttributeFilter(),
+		storage.Preconditions(request.GetPreconditions()...), storage.TransactionMetadata(request.GetMetadata()))
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
@@ -400,7 +502,16 @@ func (r *DataServer) RunBundle(ctx context.Context, request *v1.BundleRunRequest
 		return nil, status.Error(GetStatus(err), err.Error()) // Return bundle argument validation error
 	}
 
-	snap, err := r.dw.RunBundle(ctx, request.GetTenantId(), request.GetArguments(), bundle)
+	hash, err := requestHash(request, request.GetIdempotencyKey())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	snap, err := r.dw.RunBundle(ctx, request.GetTenantId(), request.GetArguments(), bundle,
+		storage.Preconditions(request.GetPreconditions()...), storage.IdempotencyKey(request.GetIdempotencyKey(), hash),
+		storage.TransactionMetadata(request.GetMetadata()))
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
@@ -410,7 +521,218 @@ func (r *DataServer) RunBundle(ctx context.Context, request *v1.BundleRunRequest
 
 	r.runBundleHistogram.Record(ctx, 1)
 
+	warnings := r.bundleWarnings(ctx, request.GetTenantId(), request.GetArguments(), bundle)
+	logWarnings(ctx, request.GetTenantId(), warnings)
+
 	return &v1.BundleRunResponse{
 		SnapToken: snap.String(),
+		Warnings:  warnings,
 	}, nil
 }
+
+// bundleWarnings - Returns warnings for the deprecated relations and attributes written by the operations of a bundle
+func (r *DataServer) bundleWarnings(ctx context.Context, tenantID string, arguments map[string]string, bundle *v1.DataBundle) []string {
+	// the bundle has already run, so a failure to read the schema only skips the warnings
+	version, err := r.sr.HeadVersion(ctx, tenantID)
+	if err != nil {
+		return nil
+	}
+
+	var warnings []string
+	definitions := make(map[string]*v1.EntityDefinition)
+	warn := func(entityType, name string) {
+		definition, ok := definitions[entityType]
+		if !ok {
+			definition, _, err = r.sr.ReadEntityDefinition(ctx, tenantID, entityType, version)
+			if err != nil {
+				definition = nil
+			}
+			definitions[entityType] = definition
+		}
+		if definition != nil {
+			warnings = appendDeprecationWarning(warnings, definition, name)
+		}
+	}
+
+	for _, operation := range bundle.GetOperations() {
+		tb, ab, err := pkgbundle.Operation(arguments, operation)
+		if err != nil {
+			return warnings
+		}
+		for _, tup := range tb.Write.GetTuples() {
+			warn(tup.GetEntity().GetType(), tup.GetRelation())
+		}
+		for _, attr := range ab.Write.GetAttributes() {
+			warn(attr.GetEntity().GetType(), attr.GetAttribute())
+		}
+	}
+
+	return warnings
+}
+
+// Import - Bulk loads the tuples and attributes streamed by the client. Rows are validated one by one and the invalid
+// ones are rejected in the response instead of failing the import. The import is rolled back to its last commit when
+// the stream fails.
+func (r *DataServer) Import(server v1.Data_ImportServer) error {
+	ctx, span := internal.Tracer.Start(server.Context(), "data.import")
+	defer span.End()
+
+	var imp *importer.Importer
+	var tenantID string
+
+	for {
+		request, err := server.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return err
+		}
+
+		v := request.Validate()
+		if v != nil {
+			return status.Error(GetStatus(v), v.Error()) // Return validation error
+		}
+
+		if imp == nil {
+			tenantID = request.GetTenantId()
+			imp, err = importer.NewImporter(ctx, r.sr, r.dw, tenantID, request.GetMetadata())
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(otelCodes.Error, err.Error())
+				return status.Error(GetStatus(err), err.Error())
+			}
+			defer func() {
+				_ = imp.Close(ctx)
+			}()
+		} else if request.GetTenantId() != tenantID {
+			err = errors.New(v1.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+			return importError(imp, err) // Every chunk must target the tenant of the first one
+		}
+
+		if _, err = imp.Load(ctx, request.GetTuples(), request.GetAttributes()); err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			slog.ErrorContext(ctx, err.Error())
+			return importError(imp, err)
+		}
+	}
+
+	if imp == nil {
+		err := errors.New(v1.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+		return status.Error(GetStatus(err), err.Error()) // An import needs at least one chunk naming its tenant
+	}
+
+	response, err := imp.Finish(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return importError(imp, err)
+	}
+
+	r.importDataHistogram.Record(ctx, 1)
+
+	return server.SendAndClose(response)
+}
+
+// importError returns the status of a failed import, carrying the summary of the transactions committed before the
+// failure as a detail. The rejected rows are left out to keep the trailers small.
+func importError(imp *importer.Importer, err error) error {
+	st := status.New(GetStatus(err), err.Error())
+	summary := proto.Clone(imp.Summary()).(*v1.DataImportResponse)
+	summary.Rejections = nil
+	if detailed, derr := st.WithDetails(summary); derr == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// requestHash hashes a request carrying an idempotency key, so that the key cannot be reused for another request.
+// The key itself is left out of the hash; requests without a key are not hashed.
+func requestHash(request proto.Message, idempotencyKey string) (string, error) {
+	if idempotencyKey == "" {
+		return "", nil
+	}
+
+	clone := proto.Clone(request)
+	switch r := clone.(type) {
+	case *v1.DataWriteRequest:
+		r.GetMetadata().IdempotencyKey = ""
+	case *v1.BundleRunRequest:
+		r.IdempotencyKey = ""
+	}
+
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(clone)
+	if err != nil {
+		return "", errors.New(v1.ErrorCode_ERROR_CODE_INTERNAL.String())
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Verify - Validates the stored tuples and attributes of a tenant against a schema version, streaming the violations
+// as they are found and a summary at the end
+func (r *DataServer) Verify(request *v1.DataVerifyRequest, server v1.Data_VerifyServer) error {
+	ctx, span := internal.Tracer.Start(server.Context(), "data.verify")
+	defer span.End()
+
+	v := request.Validate()
+	if v != nil {
+		return status.Error(GetStatus(v), v.Error()) // Return validation error
+	}
+
+	ver, err := verifier.NewVerifier(ctx, r.sr, r.dr, r.dw, request)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return status.Error(GetStatus(err), err.Error())
+	}
+
+	summary, err := ver.Run(ctx, func(violation *v1.DataViolation) error {
+		return server.Send(&v1.DataVerifyResponse{Result: &v1.DataVerifyResponse_Violation{Violation: violation}})
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return status.Error(GetStatus(err), err.Error())
+	}
+
+	r.verifyDataHistogram.Record(ctx, 1)
+
+	return server.Send(&v1.DataVerifyResponse{Result: &v1.DataVerifyResponse_Summary{Summary: summary}})
+}
+
+// Analyze - Reports the cycles and longest chains of the recursive relations of the schema in the stored tuples of a
+// tenant, and the entities and subjects with the most tuples
+func (r *DataServer) Analyze(ctx context.Context, request *v1.DataAnalyzeRequest) (*v1.DataAnalyzeResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "data.analyze")
+	defer span.End()
+
+	v := request.Validate()
+	if v != nil {
+		return nil, status.Error(GetStatus(v), v.Error()) // Return validation error
+	}
+
+	an, err := analyzer.NewAnalyzer(ctx, r.sr, r.dr, request)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	response, err := an.Run(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	r.analyzeDataHistogram.Record(ctx, 1)
+
+	return response, nil
+}
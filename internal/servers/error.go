@@ -31,6 +31,24 @@ func GetStatus(err error) codes.Code {
 		return codes.Canceled
 	case base.ErrorCode_ERROR_CODE_NOT_IMPLEMENTED:
 		return codes.Unimplemented
+	case base.ErrorCode_ERROR_CODE_TENANT_ACCESS_DENIED, base.ErrorCode_ERROR_CODE_METHOD_ACCESS_DENIED:
+		// The caller is authenticated but its credential does not cover the tenant or RPC.
+		return codes.PermissionDenied
+	case base.ErrorCode_ERROR_CODE_SCHEMA_SHADOW_OUTDATED:
+		// The shadow version can be promoted again once it is written over the current served version.
+		return codes.FailedPrecondition
+	case base.ErrorCode_ERROR_CODE_SCHEMA_VERSION_IN_SHADOW:
+		// The shadow version can be served once it is promoted.
+		return codes.FailedPrecondition
+	case base.ErrorCode_ERROR_CODE_FAILED_PRECONDITION:
+		// The write may succeed again once the data it is conditioned on changes.
+		return codes.FailedPrecondition
+	case base.ErrorCode_ERROR_CODE_HISTORY_UNAVAILABLE:
+		// The data of the requested time has been garbage collected.
+		return codes.OutOfRange
+	case base.ErrorCode_ERROR_CODE_COST_LIMIT_EXCEEDED:
+		// The request may succeed again with a higher cost limit or by resuming from its last continuous token.
+		return codes.ResourceExhausted
 	case base.ErrorCode_ERROR_CODE_SERIALIZATION:
 		// Serialization failures (e.g. optimistic-lock conflicts) are transient
 		// and should be signalled as Aborted so callers can safely retry.
@@ -27,6 +27,46 @@ func TestGetStatus(t *testing.T) {
 			err:      errors.New(base.ErrorCode_ERROR_CODE_NOT_IMPLEMENTED.String()),
 			expected: codes.Unimplemented,
 		},
+		{
+			name:     "ERROR_CODE_TENANT_ACCESS_DENIED maps to codes.PermissionDenied",
+			err:      errors.New(base.ErrorCode_ERROR_CODE_TENANT_ACCESS_DENIED.String()),
+			expected: codes.PermissionDenied,
+		},
+		{
+			name:     "ERROR_CODE_METHOD_ACCESS_DENIED maps to codes.PermissionDenied",
+			err:      errors.New(base.ErrorCode_ERROR_CODE_METHOD_ACCESS_DENIED.String()),
+			expected: codes.PermissionDenied,
+		},
+		{
+			name:     "ERROR_CODE_SCHEMA_SHADOW_OUTDATED maps to codes.FailedPrecondition",
+			err:      errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_SHADOW_OUTDATED.String()),
+			expected: codes.FailedPrecondition,
+		},
+		{
+			name:     "ERROR_CODE_SCHEMA_VERSION_IN_SHADOW maps to codes.FailedPrecondition",
+			err:      errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_VERSION_IN_SHADOW.String()),
+			expected: codes.FailedPrecondition,
+		},
+		{
+			name:     "ERROR_CODE_FAILED_PRECONDITION maps to codes.FailedPrecondition",
+			err:      errors.New(base.ErrorCode_ERROR_CODE_FAILED_PRECONDITION.String()),
+			expected: codes.FailedPrecondition,
+		},
+		{
+			name:     "ERROR_CODE_HISTORY_UNAVAILABLE maps to codes.OutOfRange",
+			err:      errors.New(base.ErrorCode_ERROR_CODE_HISTORY_UNAVAILABLE.String()),
+			expected: codes.OutOfRange,
+		},
+		{
+			name:     "ERROR_CODE_COST_LIMIT_EXCEEDED maps to codes.ResourceExhausted",
+			err:      errors.New(base.ErrorCode_ERROR_CODE_COST_LIMIT_EXCEEDED.String()),
+			expected: codes.ResourceExhausted,
+		},
+		{
+			name:     "ERROR_CODE_SCHEMA_TAG_NOT_FOUND maps to codes.NotFound",
+			err:      errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_TAG_NOT_FOUND.String()),
+			expected: codes.NotFound,
+		},
 		{
 			name:     "ERROR_CODE_SERIALIZATION maps to codes.Aborted",
 			err:      errors.New(base.ErrorCode_ERROR_CODE_SERIALIZATION.String()),
@@ -38,6 +78,11 @@ func TestGetStatus(t *testing.T) {
 			err:      errors.New(base.ErrorCode_ERROR_CODE_UNAUTHENTICATED.String()),
 			expected: codes.Unauthenticated,
 		},
+		{
+			name:     "ERROR_CODE_INVALID_SNAP_TOKEN maps to codes.InvalidArgument",
+			err:      errors.New(base.ErrorCode_ERROR_CODE_INVALID_SNAP_TOKEN.String()),
+			expected: codes.InvalidArgument,
+		},
 		{
 			name:     "validation error maps to codes.InvalidArgument",
 			err:      errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String()),
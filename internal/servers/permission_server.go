@@ -10,7 +10,10 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/Permify/permify/internal"
+	"github.com/Permify/permify/internal/decision"
 	"github.com/Permify/permify/internal/invoke"
+	"github.com/Permify/permify/internal/simulation"
+	"github.com/Permify/permify/internal/storage"
 	v1 "github.com/Permify/permify/pkg/pb/base/v1"
 )
 
@@ -18,13 +21,17 @@ import (
 type PermissionServer struct {
 	v1.UnimplementedPermissionServer
 
-	invoker invoke.Invoker
+	invoker   invoke.Invoker
+	sr        storage.SchemaReader
+	simulator *simulation.Simulator
 }
 
 // NewPermissionServer - Creates new Permission Server
-func NewPermissionServer(i invoke.Invoker) *PermissionServer {
+func NewPermissionServer(i invoke.Invoker, sr storage.SchemaReader, dr storage.DataReader) *PermissionServer {
 	return &PermissionServer{
-		invoker: i,
+		invoker:   i,
+		sr:        sr,
+		simulator: simulation.NewSimulator(sr, dr),
 	}
 }
 
@@ -46,6 +53,8 @@ func (r *PermissionServer) Check(ctx context.Context, request *v1.PermissionChec
 		return nil, status.Error(GetStatus(err), err.Error())
 	}
 
+	logWarnings(ctx, request.GetTenantId(), response.GetWarnings())
+
 	return response, nil
 }
 
@@ -84,6 +93,9 @@ func (r *PermissionServer) BulkCheck(ctx context.Context, request *v1.Permission
 		return nil, err
 	}
 
+	// The checks of the items are logged as the decisions of one bulk check request.
+	ctx = decision.Bulk(ctx, decision.MethodBulkCheck)
+
 	// The buffer size is equal to the number of references in the entity.
 	type resultItem struct {
 		index    int
@@ -143,9 +155,12 @@ func (r *PermissionServer) BulkCheck(ctx context.Context, request *v1.Permission
 				return
 			}
 
+			logWarnings(ctx, request.GetTenantId(), response.GetWarnings())
+
 			resultChannel <- resultItem{index: index, response: &v1.PermissionCheckResponse{
 				Can:      response.GetCan(),
 				Metadata: response.GetMetadata(),
+				Warnings: response.GetWarnings(),
 			}}
 		}(i, checkRequestItem)
 	}
@@ -219,6 +234,8 @@ func (r *PermissionServer) LookupEntity(ctx context.Context, request *v1.Permiss
 		return nil, status.Error(GetStatus(err), err.Error())
 	}
 
+	logWarnings(ctx, request.GetTenantId(), response.GetWarnings())
+
 	return response, nil
 }
 
@@ -243,6 +260,27 @@ func (r *PermissionServer) LookupEntityStream(request *v1.PermissionLookupEntity
 	return nil
 }
 
+// LookupEntitlements -
+func (r *PermissionServer) LookupEntitlements(request *v1.PermissionLookupEntitlementsRequest, server v1.Permission_LookupEntitlementsServer) error {
+	ctx, span := internal.Tracer.Start(server.Context(), "permissions.lookup-entitlements")
+	defer span.End()
+
+	v := request.Validate()
+	if v != nil {
+		return status.Error(GetStatus(v), v.Error()) // Return validation error
+	}
+
+	err := r.invoker.LookupEntitlements(ctx, request, server)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return status.Error(GetStatus(err), err.Error())
+	}
+
+	return nil
+}
+
 // LookupSubject -
 func (r *PermissionServer) LookupSubject(ctx context.Context, request *v1.PermissionLookupSubjectRequest) (*v1.PermissionLookupSubjectResponse, error) {
 	ctx, span := internal.Tracer.Start(ctx, "permissions.lookup-subject")
@@ -261,6 +299,8 @@ func (r *PermissionServer) LookupSubject(ctx context.Context, request *v1.Permis
 		return nil, status.Error(GetStatus(err), err.Error())
 	}
 
+	logWarnings(ctx, request.GetTenantId(), response.GetWarnings())
+
 	return response, nil
 }
 
@@ -284,3 +324,44 @@ func (r *PermissionServer) SubjectPermission(ctx context.Context, request *v1.Pe
 
 	return response, nil
 }
+
+// Simulate - Answers a permission request as if hypothetical changes were made to the data of the tenant
+func (r *PermissionServer) Simulate(ctx context.Context, request *v1.PermissionSimulateRequest) (*v1.PermissionSimulateResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "permissions.simulate")
+	defer span.End()
+
+	v := request.Validate()
+	if v != nil {
+		return nil, status.Error(GetStatus(v), v.Error()) // Return validation error
+	}
+
+	// The simulated request belongs to the tenant of the simulation.
+	var nested interface{ Validate() error }
+	switch req := request.GetRequest().(type) {
+	case *v1.PermissionSimulateRequest_Check:
+		req.Check.TenantId = request.GetTenantId()
+		nested = req.Check
+	case *v1.PermissionSimulateRequest_LookupEntity:
+		req.LookupEntity.TenantId = request.GetTenantId()
+		nested = req.LookupEntity
+	case *v1.PermissionSimulateRequest_LookupSubject:
+		req.LookupSubject.TenantId = request.GetTenantId()
+		nested = req.LookupSubject
+	case *v1.PermissionSimulateRequest_SubjectPermission:
+		req.SubjectPermission.TenantId = request.GetTenantId()
+		nested = req.SubjectPermission
+	}
+	if v = nested.Validate(); v != nil {
+		return nil, status.Error(GetStatus(v), v.Error()) // Return validation error
+	}
+
+	response, err := r.simulator.Simulate(ctx, request)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	return response, nil
+}
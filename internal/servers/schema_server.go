@@ -4,14 +4,17 @@ import (
 	"context"
 	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/rs/xid"
 	api "go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	otelCodes "go.opentelemetry.io/otel/codes"
 
 	"github.com/Permify/permify/internal"
+	"github.com/Permify/permify/internal/schema"
 	"github.com/Permify/permify/internal/storage"
 	"github.com/Permify/permify/pkg/database" // Database utilities
 	"github.com/Permify/permify/pkg/dsl/compiler"
@@ -26,16 +29,18 @@ type SchemaServer struct {
 
 	sw                   storage.SchemaWriter
 	sr                   storage.SchemaReader
+	linter               *schema.Linter
 	writeSchemaHistogram api.Int64Histogram
 	readSchemaHistogram  api.Int64Histogram
 	listSchemaHistogram  api.Int64Histogram
 }
 
 // NewSchemaServer - Creates new Schema Server
-func NewSchemaServer(sw storage.SchemaWriter, sr storage.SchemaReader) *SchemaServer {
+func NewSchemaServer(sw storage.SchemaWriter, sr storage.SchemaReader, linter *schema.Linter) *SchemaServer {
 	return &SchemaServer{
 		sw:                   sw,
 		sr:                   sr,
+		linter:               linter,
 		writeSchemaHistogram: telemetry.NewHistogram(internal.Meter, "write_schema", "amount", "Number of writing schema in"),
 		readSchemaHistogram:  telemetry.NewHistogram(internal.Meter, "read_schema", "amount", "Number of reading schema"),
 		listSchemaHistogram:  telemetry.NewHistogram(internal.Meter, "list_schema", "amount", "Number of listing schema"),
@@ -70,6 +75,8 @@ func (r *SchemaServer) Write(ctx context.Context, request *v1.SchemaWriteRequest
 			Version:              version,
 			Name:                 st.GetName(),
 			SerializedDefinition: []byte(st.String()),
+			Metadata:             request.GetMetadata(),
+			Source:               request.GetSchema(),
 		})
 	}
 
@@ -206,7 +213,7 @@ func (r *SchemaServer) Read(ctx context.Context, request *v1.SchemaReadRequest)
 
 	version := request.GetMetadata().GetSchemaVersion()
 	if version == "" {
-		ver, err := r.sr.HeadVersion(ctx, request.GetTenantId())
+		ver, err := r.schemaVersion(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaTag())
 		if err != nil {
 			return nil, status.Error(GetStatus(err), err.Error()) // Return version error
 		}
@@ -256,3 +263,238 @@ func (r *SchemaServer) List(ctx context.Context, request *v1.SchemaListRequest)
 		ContinuousToken: ct.String(),
 	}, nil
 }
+
+// Lint analyzes a schema and returns its findings. If the request has no schema, the head schema of the tenant is analyzed.
+func (r *SchemaServer) Lint(ctx context.Context, request *v1.SchemaLintRequest) (*v1.SchemaLintResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "schemas.lint")
+	defer span.End()
+
+	sch := request.GetSchema()
+	if sch == "" {
+		version, err := r.sr.HeadVersion(ctx, request.GetTenantId())
+		if err != nil {
+			return nil, status.Error(GetStatus(err), err.Error()) // Return version error
+		}
+
+		// The findings point into the schema the version was written from, versions written without a source,
+		// such as by a partial write, are linted from their definitions.
+		sch, err = r.sr.ReadSchemaSource(ctx, request.GetTenantId(), version)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			slog.ErrorContext(ctx, err.Error())
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
+		if sch == "" {
+			definitions, err := r.sr.ReadSchemaString(ctx, request.GetTenantId(), version)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(otelCodes.Error, err.Error())
+				slog.ErrorContext(ctx, err.Error())
+				return nil, status.Error(GetStatus(err), err.Error())
+			}
+			sch = strings.Join(definitions, "\n")
+		}
+	}
+
+	findings, err := r.linter.Lint(sch)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, status.Error(codes.InvalidArgument, err.Error()) // Return parse or compile error
+	}
+
+	return &v1.SchemaLintResponse{
+		Findings: findings,
+	}, nil
+}
+
+// ShadowWrite writes a candidate schema as the shadow version of the tenant. The checks answered with the
+// version served at the time are evaluated against the shadow version until it is promoted or rolled back.
+func (r *SchemaServer) ShadowWrite(ctx context.Context, request *v1.SchemaShadowWriteRequest) (*v1.SchemaShadowWriteResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "schemas.shadow.write")
+	defer span.End()
+
+	sch, err := parser.NewParser(request.GetSchema()).Parse()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error()) // Return parse error
+	}
+
+	_, _, err = compiler.NewCompiler(true, sch).Compile()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error()) // Return compile error
+	}
+
+	// The shadow version is compared against the version served now.
+	served, err := r.sr.HeadVersion(ctx, request.GetTenantId())
+	if err != nil {
+		return nil, status.Error(GetStatus(err), err.Error()) // Return version error
+	}
+
+	sampleRate := request.GetSampleRate()
+	if sampleRate == 0 {
+		sampleRate = 1
+	}
+
+	shadow := storage.SchemaShadow{
+		TenantID:      request.GetTenantId(),
+		Version:       xid.New().String(),
+		ServedVersion: served,
+		SampleRate:    sampleRate,
+		CreatedAt:     time.Now(),
+	}
+
+	cnf := make([]storage.SchemaDefinition, 0, len(sch.Statements))
+	for _, st := range sch.Statements {
+		cnf = append(cnf, storage.SchemaDefinition{
+			TenantID:             request.GetTenantId(),
+			Version:              shadow.Version,
+			Name:                 st.GetName(),
+			SerializedDefinition: []byte(st.String()),
+			Source:               request.GetSchema(),
+		})
+	}
+
+	err = r.sw.WriteShadow(ctx, shadow, cnf)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		slog.ErrorContext(ctx, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	return &v1.SchemaShadowWriteResponse{
+		Shadow: shadow.ToSchemaShadow(),
+	}, nil
+}
+
+// ShadowRead reads the shadow version of the tenant.
+func (r *SchemaServer) ShadowRead(ctx context.Context, request *v1.SchemaShadowReadRequest) (*v1.SchemaShadowReadResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "schemas.shadow.read")
+	defer span.End()
+
+	shadow, err := r.sr.ReadShadow(ctx, request.GetTenantId())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	return &v1.SchemaShadowReadResponse{
+		Shadow: shadow,
+	}, nil
+}
+
+// ShadowPromote serves the shadow version of the tenant.
+func (r *SchemaServer) ShadowPromote(ctx context.Context, request *v1.SchemaShadowPromoteRequest) (*v1.SchemaShadowPromoteResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "schemas.shadow.promote")
+	defer span.End()
+
+	shadow, err := r.sw.PromoteShadow(ctx, request.GetTenantId())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	return &v1.SchemaShadowPromoteResponse{
+		SchemaVersion:   shadow.GetVersion(),
+		PreviousVersion: shadow.GetServedVersion(),
+	}, nil
+}
+
+// ShadowRollback discards the shadow version of the tenant, the served version is left as is.
+func (r *SchemaServer) ShadowRollback(ctx context.Context, request *v1.SchemaShadowRollbackRequest) (*v1.SchemaShadowRollbackResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "schemas.shadow.rollback")
+	defer span.End()
+
+	shadow, err := r.sw.DeleteShadow(ctx, request.GetTenantId())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	return &v1.SchemaShadowRollbackResponse{
+		SchemaVersion: shadow.GetVersion(),
+	}, nil
+}
+
+// Tag points a tag of the tenant to one of its versions.
+func (r *SchemaServer) Tag(ctx context.Context, request *v1.SchemaTagRequest) (*v1.SchemaTagResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "schemas.tag")
+	defer span.End()
+
+	previous, err := r.sw.TagSchema(ctx, request.GetTenantId(), request.GetTag(), request.GetSchemaVersion())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	return &v1.SchemaTagResponse{
+		SchemaVersion:   request.GetSchemaVersion(),
+		PreviousVersion: previous,
+	}, nil
+}
+
+// Untag deletes a tag of the tenant, the version it pointed to is left as is.
+func (r *SchemaServer) Untag(ctx context.Context, request *v1.SchemaUntagRequest) (*v1.SchemaUntagResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "schemas.untag")
+	defer span.End()
+
+	version, err := r.sw.UntagSchema(ctx, request.GetTenantId(), request.GetTag())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	return &v1.SchemaUntagResponse{
+		SchemaVersion: version,
+	}, nil
+}
+
+// Activate serves a version of the tenant, given directly or by tag, to the requests that do not select one.
+// Activating a previous version rolls the tenant back to it without writing it again.
+func (r *SchemaServer) Activate(ctx context.Context, request *v1.SchemaActivateRequest) (*v1.SchemaActivateResponse, error) {
+	ctx, span := internal.Tracer.Start(ctx, "schemas.activate")
+	defer span.End()
+
+	version := request.GetSchemaVersion()
+	if version == "" {
+		if request.GetSchemaTag() == "" {
+			return nil, status.Error(codes.InvalidArgument, "either schema_version or schema_tag is required")
+		}
+		ver, err := r.sr.TagVersion(ctx, request.GetTenantId(), request.GetSchemaTag())
+		if err != nil {
+			return nil, status.Error(GetStatus(err), err.Error()) // Return version error
+		}
+		version = ver
+	}
+
+	previous, err := r.sw.ActivateSchema(ctx, request.GetTenantId(), version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+
+	return &v1.SchemaActivateResponse{
+		SchemaVersion:   version,
+		PreviousVersion: previous,
+	}, nil
+}
+
+// schemaVersion resolves the version of a request that does not set one: the version of its tag
+// if it selects one, the head version of the tenant otherwise.
+func (r *SchemaServer) schemaVersion(ctx context.Context, tenantID, tag string) (string, error) {
+	if tag != "" {
+		return r.sr.TagVersion(ctx, tenantID, tag)
+	}
+	return r.sr.HeadVersion(ctx, tenantID)
+}
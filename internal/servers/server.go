@@ -2,6 +2,7 @@ package servers
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -30,11 +31,15 @@ import (
 
 	health "google.golang.org/grpc/health/grpc_health_v1" // gRPC health check
 
+	"github.com/Permify/permify/internal/audit"
+	"github.com/Permify/permify/internal/authn/mtls"
 	oidc "github.com/Permify/permify/internal/authn/openid"
 	"github.com/Permify/permify/internal/authn/preshared"
 	"github.com/Permify/permify/internal/config"
 	"github.com/Permify/permify/internal/invoke"
 	"github.com/Permify/permify/internal/middleware"
+	"github.com/Permify/permify/internal/report"
+	"github.com/Permify/permify/internal/schema"
 	"github.com/Permify/permify/internal/storage"
 	grpcV1 "github.com/Permify/permify/pkg/pb/base/v1"
 )
@@ -63,6 +68,9 @@ type Container struct {
 	TW storage.TenantWriter
 
 	W storage.Watcher
+
+	// Snapshots selects the snapshot the data reads are answered at, a default selector is used if nil
+	Snapshots *invoke.Snapshots
 }
 
 // NewContainer is a constructor for the Container struct.
@@ -104,14 +112,36 @@ func (s *Container) Run(
 	dst *config.Distributed,
 	authentication *config.Authn,
 	profiler *config.Profiler,
+	lint *config.Lint,
+	auditor *audit.Auditor,
 	localInvoker invoke.Invoker,
 ) error {
 	var err error
 
+	// Create the schema linter with the configured rule severities.
+	linter, err := schema.NewLinter(lint.Rules)
+	if err != nil {
+		return err
+	}
+
 	limiter := middleware.NewRateLimiter(srv.RateLimit) // for example 1000 req/sec
 
+	// Client certificate authenticator, set if the "mtls" authentication method is used.
+	var mtlsAuthn *mtls.Authn
+
 	lopts := []logging.Option{
 		logging.WithLogOnEvents(logging.StartCall, logging.FinishCall),
+		// Log the verified client certificate identity of the caller.
+		logging.WithFieldsFromContext(func(ctx context.Context) logging.Fields {
+			if mtlsAuthn == nil {
+				return nil
+			}
+			identity, err := mtlsAuthn.Identity(ctx)
+			if err != nil {
+				return nil
+			}
+			return logging.Fields{"peer.identity", identity}
+		}),
 	}
 
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
@@ -128,7 +158,7 @@ func (s *Container) Run(
 		logging.StreamServerInterceptor(InterceptorLogger(logger), lopts...),
 	}
 
-	// Configure authentication based on the provided method ("preshared" or "oidc").
+	// Configure authentication based on the provided method ("preshared", "oidc" or "mtls").
 	// Add the appropriate interceptors to the unary and streaming interceptors.
 	if authentication != nil && authentication.Enabled {
 		switch authentication.Method {
@@ -148,11 +178,37 @@ func (s *Container) Run(
 			} // OIDC authenticator created
 			unaryInterceptors = append(unaryInterceptors, grpcAuth.UnaryServerInterceptor(middleware.AuthFunc(authenticator)))
 			streamingInterceptors = append(streamingInterceptors, grpcAuth.StreamServerInterceptor(middleware.AuthFunc(authenticator)))
+		case "mtls": // Client certificate authentication
+			if !srv.GRPC.TLSConfig.Enabled {
+				return errors.New("mtls authentication requires grpc tls to be enabled")
+			}
+			if srv.HTTP.Enabled && !srv.HTTP.TLSConfig.Enabled {
+				return errors.New("mtls authentication requires http tls to be enabled")
+			}
+			mtlsAuthn, err = mtls.NewMtlsAuthn(ctx, authentication.Mtls)
+			if err != nil {
+				return err
+			}
+			unaryInterceptors = append(unaryInterceptors, grpcAuth.UnaryServerInterceptor(middleware.AuthFunc(mtlsAuthn)))
+			streamingInterceptors = append(streamingInterceptors, grpcAuth.StreamServerInterceptor(middleware.AuthFunc(mtlsAuthn)))
 		default: // Unknown authentication method
 			return fmt.Errorf("unknown authentication method: '%s'", authentication.Method) // Return error
 		}
 	}
 
+	// Record mutations after authentication, so that their actor is known, and before authorization,
+	// so that denied attempts are recorded as well.
+	if auditor != nil {
+		unaryInterceptors = append(unaryInterceptors, middleware.UnaryAuditInterceptor(auditor))
+		streamingInterceptors = append(streamingInterceptors, middleware.StreamAuditInterceptor(auditor))
+	}
+
+	if authentication != nil && authentication.Enabled {
+		// Restrict authenticated callers to the tenants and methods of their credentials.
+		unaryInterceptors = append(unaryInterceptors, middleware.UnaryAuthzInterceptor())
+		streamingInterceptors = append(streamingInterceptors, middleware.StreamAuthzInterceptor())
+	}
+
 	opts := []grpc.ServerOption{
 		grpc.ChainUnaryInterceptor(unaryInterceptors...),
 		grpc.ChainStreamInterceptor(streamingInterceptors...),
@@ -161,9 +217,20 @@ func (s *Container) Run(
 
 	if srv.GRPC.TLSConfig.Enabled {
 		var c credentials.TransportCredentials
-		c, err = credentials.NewServerTLSFromFile(srv.GRPC.TLSConfig.CertPath, srv.GRPC.TLSConfig.KeyPath)
-		if err != nil {
-			return err
+		if mtlsAuthn != nil {
+			// Client certificates are optional on the transport so that the HTTP gateway can connect,
+			// requests without one are rejected by the authenticator.
+			var tlsConfig *tls.Config
+			tlsConfig, err = mtlsAuthn.TLSConfig(srv.GRPC.TLSConfig.CertPath, srv.GRPC.TLSConfig.KeyPath, false)
+			if err != nil {
+				return err
+			}
+			c = credentials.NewTLS(tlsConfig)
+		} else {
+			c, err = credentials.NewServerTLSFromFile(srv.GRPC.TLSConfig.CertPath, srv.GRPC.TLSConfig.KeyPath)
+			if err != nil {
+				return err
+			}
 		}
 		opts = append(opts, grpc.Creds(c))
 	}
@@ -172,12 +239,13 @@ func (s *Container) Run(
 	grpcServer := grpc.NewServer(opts...)
 
 	// Register various gRPC services to the server.
-	grpcV1.RegisterPermissionServer(grpcServer, NewPermissionServer(s.Invoker))
-	grpcV1.RegisterSchemaServer(grpcServer, NewSchemaServer(s.SW, s.SR))
-	grpcV1.RegisterDataServer(grpcServer, NewDataServer(s.DR, s.DW, s.BR, s.SR))
+	grpcV1.RegisterPermissionServer(grpcServer, NewPermissionServer(s.Invoker, s.SR, s.DR))
+	grpcV1.RegisterSchemaServer(grpcServer, NewSchemaServer(s.SW, s.SR, linter))
+	grpcV1.RegisterDataServer(grpcServer, NewDataServer(s.DR, s.DW, s.BR, s.SR, s.snapshots()))
 	grpcV1.RegisterBundleServer(grpcServer, NewBundleServer(s.BR, s.BW))
 	grpcV1.RegisterTenancyServer(grpcServer, NewTenancyServer(s.TR, s.TW))
 	grpcV1.RegisterWatchServer(grpcServer, NewWatchServer(s.W, s.DR))
+	grpcV1.RegisterAuditServer(grpcServer, NewAuditServer(auditor, report.NewAccessReviewer(s.SR, s.DR, s.Invoker)))
 
 	// Register health check and reflection services for gRPC.
 	health.RegisterHealthServer(grpcServer, NewHealthServer()) // Register health server
@@ -185,7 +253,7 @@ func (s *Container) Run(
 
 	// Create another gRPC server, presumably for invoking permissions.
 	invokeServer := grpc.NewServer(opts...)
-	grpcV1.RegisterPermissionServer(invokeServer, NewPermissionServer(localInvoker))
+	grpcV1.RegisterPermissionServer(invokeServer, NewPermissionServer(localInvoker, s.SR, s.DR))
 
 	// Register health check and reflection services for the invokeServer.
 	health.RegisterHealthServer(invokeServer, NewHealthServer()) // Register health server for invoker
@@ -312,6 +380,11 @@ func (s *Container) Run(
 			}), // Middleware registered
 		}
 
+		if mtlsAuthn != nil {
+			// Forward the client certificate identity verified by the HTTP server to the gRPC server.
+			muxOpts = append(muxOpts, runtime.WithMetadata(mtlsAuthn.GatewayMetadata))
+		}
+
 		mux := runtime.NewServeMux(muxOpts...)
 
 		if err = grpcV1.RegisterPermissionHandler(ctx, mux, conn); err != nil {
@@ -329,6 +402,9 @@ func (s *Container) Run(
 		if err = grpcV1.RegisterTenancyHandler(ctx, mux, conn); err != nil {
 			return err
 		}
+		if err = grpcV1.RegisterAuditHandler(ctx, mux, conn); err != nil {
+			return err
+		}
 
 		corsHandler := cors.New(cors.Options{ // CORS configuration
 			AllowCredentials: true,                        // Allow credentials
@@ -346,12 +422,23 @@ func (s *Container) Run(
 			Handler:           corsHandler,    // CORS handler
 			ReadHeaderTimeout: 5 * time.Second,
 		}
+		if mtlsAuthn != nil {
+			httpServer.TLSConfig, err = mtlsAuthn.TLSConfig(srv.HTTP.TLSConfig.CertPath, srv.HTTP.TLSConfig.KeyPath, true)
+			if err != nil {
+				return err
+			}
+		}
 
 		// Start the HTTP server with TLS if enabled, otherwise without TLS.
 		go func() {
 			var err error
 			if srv.HTTP.TLSConfig.Enabled {
-				err = httpServer.ListenAndServeTLS(srv.HTTP.TLSConfig.CertPath, srv.HTTP.TLSConfig.KeyPath)
+				if httpServer.TLSConfig != nil {
+					// The certificate is already loaded into the TLS configuration.
+					err = httpServer.ListenAndServeTLS("", "")
+				} else {
+					err = httpServer.ListenAndServeTLS(srv.HTTP.TLSConfig.CertPath, srv.HTTP.TLSConfig.KeyPath)
+				}
 			} else {
 				err = httpServer.ListenAndServe()
 			}
@@ -403,3 +490,11 @@ func httpNameFormatter(_ string, req *http.Request) string {
 	}
 	return req.Method + " " + path
 }
+
+// snapshots - Returns the snapshot selector of the data reads, a selector without a snap token decoder if none is set.
+func (s *Container) snapshots() *invoke.Snapshots {
+	if s.Snapshots != nil {
+		return s.Snapshots
+	}
+	return invoke.NewSnapshots(s.DR, nil, invoke.DefaultSnapshotRefreshInterval)
+}
@@ -13,12 +13,21 @@ import (
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	health "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"github.com/Permify/permify/internal/audit"
+	"github.com/Permify/permify/internal/engines"
+	"github.com/Permify/permify/internal/invoke"
+	"github.com/Permify/permify/internal/schema"
 	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/memory"
+	"github.com/Permify/permify/internal/storage/memory/migrations"
 	"github.com/Permify/permify/pkg/database"
+	memoryDatabase "github.com/Permify/permify/pkg/database/memory"
 	v1 "github.com/Permify/permify/pkg/pb/base/v1"
 )
 
@@ -150,6 +159,10 @@ func (f *fakePermissionInvoker) LookupEntityStream(_ context.Context, request *v
 	return f.err
 }
 
+func (f *fakePermissionInvoker) LookupEntitlements(_ context.Context, _ *v1.PermissionLookupEntitlementsRequest, _ v1.Permission_LookupEntitlementsServer) error {
+	return f.err
+}
+
 func (f *fakePermissionInvoker) LookupSubject(_ context.Context, request *v1.PermissionLookupSubjectRequest) (*v1.PermissionLookupSubjectResponse, error) {
 	f.lookupSubjectReq = request
 	if f.err != nil {
@@ -334,7 +347,7 @@ func TestInterceptorLogger(t *testing.T) {
 
 func TestPermissionServerPassesThroughInvoker(t *testing.T) {
 	invoker := &fakePermissionInvoker{}
-	server := NewPermissionServer(invoker)
+	server := NewPermissionServer(invoker, storage.NewNoopSchemaReader(), storage.NewNoopRelationshipReader())
 	if server == nil {
 		t.Fatal("expected permission server")
 	}
@@ -388,7 +401,7 @@ func TestPermissionServerPassesThroughInvoker(t *testing.T) {
 
 func TestPermissionServerValidationAndInvokerErrors(t *testing.T) {
 	invoker := &fakePermissionInvoker{}
-	server := NewPermissionServer(invoker)
+	server := NewPermissionServer(invoker, storage.NewNoopSchemaReader(), storage.NewNoopRelationshipReader())
 
 	_, err := server.Check(context.Background(), &v1.PermissionCheckRequest{})
 	if err == nil {
@@ -540,3 +553,320 @@ func TestBundleServerValidationAndStorageErrors(t *testing.T) {
 		t.Fatalf("expected not found status, got %v", status.Code(err))
 	}
 }
+
+// lookupEntityStream collects the header and the entities of an entity lookup stream
+type lookupEntityStream struct {
+	grpc.ServerStream
+	header    metadata.MD
+	entityIDs []string
+}
+
+func (s *lookupEntityStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *lookupEntityStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *lookupEntityStream) Send(response *v1.PermissionLookupEntityStreamResponse) error {
+	s.entityIDs = append(s.entityIDs, response.GetEntityId())
+	return nil
+}
+
+func TestDeprecationWarnings(t *testing.T) {
+	db, err := memoryDatabase.New(migrations.Schema)
+	if err != nil {
+		t.Fatalf("unexpected database error: %v", err)
+	}
+
+	linter, err := schema.NewLinter(nil)
+	if err != nil {
+		t.Fatalf("unexpected linter error: %v", err)
+	}
+
+	sr := memory.NewSchemaReader(db)
+	schemaServer := NewSchemaServer(memory.NewSchemaWriter(db), sr, linter)
+
+	written, err := schemaServer.Write(context.Background(), &v1.SchemaWriteRequest{
+		TenantId: "t1",
+		Schema: `
+		entity user {}
+
+		@description("A shared document")
+		@owner("team-docs")
+		entity document {
+			relation owner @user
+
+			@deprecated
+			relation viewer @user
+
+			@description("Who can view the document")
+			@deprecated
+			permission view = owner or viewer
+		}`,
+	})
+	if err != nil {
+		t.Fatalf("unexpected schema write error: %v", err)
+	}
+
+	read, err := schemaServer.Read(context.Background(), &v1.SchemaReadRequest{TenantId: "t1"})
+	if err != nil {
+		t.Fatalf("unexpected schema read error: %v", err)
+	}
+	document := read.GetSchema().GetEntityDefinitions()["document"]
+	if document.GetAnnotations().GetDescription() != "A shared document" || document.GetAnnotations().GetOwner() != "team-docs" {
+		t.Fatalf("expected entity annotations to be returned, got %v", document.GetAnnotations())
+	}
+	if !document.GetPermissions()["view"].GetAnnotations().GetDeprecated() || document.GetRelations()["owner"].GetAnnotations() != nil {
+		t.Fatalf("expected permission annotations to be returned")
+	}
+
+	dataServer := NewDataServer(memory.NewDataReader(db), memory.NewDataWriter(db), memory.NewBundleReader(db), sr, invoke.NewSnapshots(memory.NewDataReader(db), nil, invoke.DefaultSnapshotRefreshInterval))
+	resp, err := dataServer.WriteRelationships(context.Background(), &v1.RelationshipWriteRequest{
+		TenantId: "t1",
+		Metadata: &v1.RelationshipWriteRequestMetadata{SchemaVersion: written.GetSchemaVersion()},
+		Tuples: []*v1.Tuple{
+			{Entity: &v1.Entity{Type: "document", Id: "1"}, Relation: "owner", Subject: &v1.Subject{Type: "user", Id: "1"}},
+			{Entity: &v1.Entity{Type: "document", Id: "1"}, Relation: "viewer", Subject: &v1.Subject{Type: "user", Id: "2"}},
+			{Entity: &v1.Entity{Type: "document", Id: "2"}, Relation: "viewer", Subject: &v1.Subject{Type: "user", Id: "2"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected write error: %v", err)
+	}
+	if !reflect.DeepEqual(resp.GetWarnings(), []string{"relation document#viewer is deprecated"}) {
+		t.Fatalf("unexpected write warnings: %v", resp.GetWarnings())
+	}
+
+	dr := memory.NewDataReader(db)
+	checkEngine := engines.NewCheckEngine(sr, dr)
+	lookupEngine := engines.NewLookupEngine(checkEngine, sr, dr)
+	invoker := invoke.NewDirectInvoker(sr, dr, checkEngine, nil, lookupEngine, nil)
+	checkEngine.SetInvoker(invoker)
+
+	permissionServer := NewPermissionServer(invoker, sr, dr)
+	checkResp, err := permissionServer.Check(context.Background(), &v1.PermissionCheckRequest{
+		TenantId:   "t1",
+		Metadata:   &v1.PermissionCheckRequestMetadata{SchemaVersion: written.GetSchemaVersion(), Depth: 20},
+		Entity:     &v1.Entity{Type: "document", Id: "1"},
+		Permission: "view",
+		Subject:    &v1.Subject{Type: "user", Id: "2"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected check error: %v", err)
+	}
+	if checkResp.GetCan() != v1.CheckResult_CHECK_RESULT_ALLOWED ||
+		!reflect.DeepEqual(checkResp.GetWarnings(), []string{"permission document#view is deprecated"}) {
+		t.Fatalf("unexpected check response: %v", checkResp)
+	}
+
+	bulkResp, err := permissionServer.BulkCheck(context.Background(), &v1.PermissionBulkCheckRequest{
+		TenantId: "t1",
+		Metadata: &v1.PermissionCheckRequestMetadata{SchemaVersion: written.GetSchemaVersion(), Depth: 20},
+		Items: []*v1.PermissionBulkCheckRequestItem{
+			{Entity: &v1.Entity{Type: "document", Id: "1"}, Permission: "view", Subject: &v1.Subject{Type: "user", Id: "2"}},
+			{Entity: &v1.Entity{Type: "document", Id: "1"}, Permission: "owner", Subject: &v1.Subject{Type: "user", Id: "1"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected bulk check error: %v", err)
+	}
+	if !reflect.DeepEqual(bulkResp.GetResults()[0].GetWarnings(), []string{"permission document#view is deprecated"}) ||
+		len(bulkResp.GetResults()[1].GetWarnings()) != 0 {
+		t.Fatalf("unexpected bulk check warnings: %v", bulkResp.GetResults())
+	}
+
+	entityReq := &v1.PermissionLookupEntityRequest{
+		TenantId:   "t1",
+		Metadata:   &v1.PermissionLookupEntityRequestMetadata{SchemaVersion: written.GetSchemaVersion(), Depth: 20},
+		EntityType: "document",
+		Permission: "view",
+		Subject:    &v1.Subject{Type: "user", Id: "2"},
+	}
+	entityResp, err := permissionServer.LookupEntity(context.Background(), entityReq)
+	if err != nil {
+		t.Fatalf("unexpected lookup entity error: %v", err)
+	}
+	if !reflect.DeepEqual(entityResp.GetEntityIds(), []string{"1", "2"}) ||
+		!reflect.DeepEqual(entityResp.GetWarnings(), []string{"permission document#view is deprecated"}) {
+		t.Fatalf("unexpected lookup entity response: %v", entityResp)
+	}
+
+	stream := &lookupEntityStream{}
+	err = permissionServer.LookupEntityStream(entityReq, stream)
+	if err != nil {
+		t.Fatalf("unexpected lookup entity stream error: %v", err)
+	}
+	if !reflect.DeepEqual(stream.header.Get("warning"), []string{"permission document#view is deprecated"}) || len(stream.entityIDs) != 2 {
+		t.Fatalf("unexpected lookup entity stream: %v %v", stream.header, stream.entityIDs)
+	}
+
+	subjectResp, err := permissionServer.LookupSubject(context.Background(), &v1.PermissionLookupSubjectRequest{
+		TenantId:         "t1",
+		Metadata:         &v1.PermissionLookupSubjectRequestMetadata{SchemaVersion: written.GetSchemaVersion(), Depth: 20},
+		Entity:           &v1.Entity{Type: "document", Id: "1"},
+		Permission:       "view",
+		SubjectReference: &v1.RelationReference{Type: "user"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected lookup subject error: %v", err)
+	}
+	if !reflect.DeepEqual(subjectResp.GetSubjectIds(), []string{"1", "2"}) ||
+		!reflect.DeepEqual(subjectResp.GetWarnings(), []string{"permission document#view is deprecated"}) {
+		t.Fatalf("unexpected lookup subject response: %v", subjectResp)
+	}
+
+	_, err = memory.NewBundleWriter(db).Write(context.Background(), []storage.Bundle{{
+		Name:     "share",
+		TenantID: "t1",
+		DataBundle: &v1.DataBundle{
+			Name:      "share",
+			Arguments: []string{"documentID", "userID"},
+			Operations: []*v1.Operation{{
+				RelationshipsWrite: []string{
+					"document:{{.documentID}}#owner@user:{{.userID}}",
+					"document:{{.documentID}}#viewer@user:{{.userID}}",
+				},
+			}},
+		},
+	}})
+	if err != nil {
+		t.Fatalf("unexpected bundle write error: %v", err)
+	}
+	bundleResp, err := dataServer.RunBundle(context.Background(), &v1.BundleRunRequest{
+		TenantId:  "t1",
+		Name:      "share",
+		Arguments: map[string]string{"documentID": "3", "userID": "3"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected run bundle error: %v", err)
+	}
+	if !reflect.DeepEqual(bundleResp.GetWarnings(), []string{"relation document#viewer is deprecated"}) {
+		t.Fatalf("unexpected run bundle warnings: %v", bundleResp.GetWarnings())
+	}
+}
+
+func TestSchemaLint(t *testing.T) {
+	db, err := memoryDatabase.New(migrations.Schema)
+	if err != nil {
+		t.Fatalf("unexpected database error: %v", err)
+	}
+
+	linter, err := schema.NewLinter(map[string]string{schema.LintRuleUnusedRelation: schema.LintSeverityInfo})
+	if err != nil {
+		t.Fatalf("unexpected linter error: %v", err)
+	}
+
+	schemaServer := NewSchemaServer(memory.NewSchemaWriter(db), memory.NewSchemaReader(db), linter)
+
+	resp, err := schemaServer.Lint(context.Background(), &v1.SchemaLintRequest{
+		TenantId: "t1",
+		Schema: `entity user {}
+entity folder {
+    relation parent @folder
+    relation owner @user
+    permission view = parent.view
+}`,
+	})
+	if err != nil {
+		t.Fatalf("unexpected lint error: %v", err)
+	}
+	findings := resp.GetFindings()
+	if len(findings) != 2 ||
+		findings[0].GetRule() != schema.LintRuleUnusedRelation || findings[0].GetSeverity() != v1.SchemaLintFinding_SEVERITY_INFO ||
+		findings[1].GetRule() != schema.LintRuleRecursionWithoutBaseCase || findings[1].GetSeverity() != v1.SchemaLintFinding_SEVERITY_ERROR {
+		t.Fatalf("unexpected findings: %v", findings)
+	}
+
+	// without a schema in the request the head schema of the tenant is analyzed
+	_, err = schemaServer.Write(context.Background(), &v1.SchemaWriteRequest{
+		TenantId: "t1",
+		Schema: `// documents and their editors
+entity user {}
+
+entity document {
+    relation owner @user
+    relation   editor   @user
+    permission view = owner
+}`,
+	})
+	if err != nil {
+		t.Fatalf("unexpected schema write error: %v", err)
+	}
+
+	resp, err = schemaServer.Lint(context.Background(), &v1.SchemaLintRequest{TenantId: "t1"})
+	if err != nil {
+		t.Fatalf("unexpected lint error: %v", err)
+	}
+	if len(resp.GetFindings()) != 1 || resp.GetFindings()[0].GetMessage() != "relation document#editor is not referenced by any permission" {
+		t.Fatalf("unexpected findings: %v", resp.GetFindings())
+	}
+	// the position is the one of the written schema, not of its serialized definitions
+	if resp.GetFindings()[0].GetLine() != 6 || resp.GetFindings()[0].GetColumn() != 17 {
+		t.Fatalf("unexpected position: %d:%d", resp.GetFindings()[0].GetLine(), resp.GetFindings()[0].GetColumn())
+	}
+
+	_, err = schemaServer.Lint(context.Background(), &v1.SchemaLintRequest{
+		TenantId: "t1",
+		Schema:   "entity document { permission view = owner }",
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument status, got %v", status.Code(err))
+	}
+}
+
+func TestAuditServer(t *testing.T) {
+	_, err := NewAuditServer(nil, nil).List(context.Background(), &v1.AuditListRequest{TenantId: "t1"})
+	if status.Code(err) != codes.Unimplemented {
+		t.Fatalf("expected unimplemented without an audit log, got %v", err)
+	}
+
+	_, err = NewAuditServer(audit.NewAuditor(), nil).List(context.Background(), &v1.AuditListRequest{TenantId: "t1"})
+	if status.Code(err) != codes.Unimplemented {
+		t.Fatalf("expected unimplemented without a queryable sink, got %v", err)
+	}
+}
+
+func TestRequestHash(t *testing.T) {
+	request := func(key, relationship string) *v1.DataWriteRequest {
+		return &v1.DataWriteRequest{
+			TenantId: "t1",
+			Metadata: &v1.DataWriteRequestMetadata{IdempotencyKey: key},
+			Tuples: []*v1.Tuple{{
+				Entity:   &v1.Entity{Type: "document", Id: "1"},
+				Relation: relationship,
+				Subject:  &v1.Subject{Type: "user", Id: "1"},
+			}},
+		}
+	}
+
+	original, err := requestHash(request("k1", "viewer"), "k1")
+	if err != nil {
+		t.Fatalf("requestHash returned error: %v", err)
+	}
+	if original == "" {
+		t.Fatal("expected a hash for a request with an idempotency key")
+	}
+
+	retry, err := requestHash(request("k2", "viewer"), "k2")
+	if err != nil {
+		t.Fatalf("requestHash returned error: %v", err)
+	}
+	if retry != original {
+		t.Fatal("expected the idempotency key to be left out of the hash")
+	}
+
+	other, err := requestHash(request("k1", "owner"), "k1")
+	if err != nil {
+		t.Fatalf("requestHash returned error: %v", err)
+	}
+	if other == original {
+		t.Fatal("expected another request to have another hash")
+	}
+
+	if hash, _ := requestHash(request("", "viewer"), ""); hash != "" {
+		t.Fatalf("expected no hash without an idempotency key, got %q", hash)
+	}
+}
@@ -0,0 +1,26 @@
+package servers
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	"github.com/Permify/permify/internal/schema"
+	v1 "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// appendDeprecationWarning - Appends a warning if the relation, attribute or permission is deprecated unless it is already present
+func appendDeprecationWarning(warnings []string, definition *v1.EntityDefinition, name string) []string {
+	warning := schema.GetDeprecationWarningByNameInEntityDefinition(definition, name)
+	if warning == "" || slices.Contains(warnings, warning) {
+		return warnings
+	}
+	return append(warnings, warning)
+}
+
+// logWarnings - Logs the warnings returned to the client
+func logWarnings(ctx context.Context, tenantID string, warnings []string) {
+	for _, warning := range warnings {
+		slog.WarnContext(ctx, warning, slog.String("tenant_id", tenantID))
+	}
+}
@@ -0,0 +1,299 @@
+package shadow
+
+import (
+	"context"
+	"log/slog"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Permify/permify/internal"
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/decision"
+	"github.com/Permify/permify/internal/storage"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/telemetry"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// Outcomes of an evaluation
+const (
+	OutcomeSame      = "same"
+	OutcomeDifferent = "different"
+	OutcomeError     = "error"
+	OutcomeDropped   = "dropped"
+)
+
+// maxExplained - Maximum number of tuples reported for each version of a difference
+const maxExplained = 20
+
+// Checker - Evaluates checks and expansions, implemented by the invokers
+type Checker interface {
+	Check(ctx context.Context, request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error)
+	Expand(ctx context.Context, request *base.PermissionExpandRequest) (*base.PermissionExpandResponse, error)
+}
+
+// Difference - Check whose answer differs between the served version and the shadow version of its tenant.
+// The tuples are those the permission expands to under one version only.
+type Difference struct {
+	Request       *base.PermissionCheckRequest
+	ServedVersion string
+	ShadowVersion string
+	Served        base.CheckResult
+	Shadow        base.CheckResult
+	ServedOnly    []string
+	ShadowOnly    []string
+}
+
+// Reporter - Reports a difference
+type Reporter func(ctx context.Context, difference *Difference)
+
+// Evaluator - Evaluates a sample of the checks answered with the served schema version of a tenant
+// against its shadow version in the background, and reports the answers that differ.
+type Evaluator struct {
+	reader  storage.SchemaReader
+	checker Checker
+
+	refreshInterval time.Duration
+	timeout         time.Duration
+	slots           chan struct{}
+	wg              sync.WaitGroup
+
+	mu      sync.Mutex
+	shadows map[string]cachedShadow
+
+	// report is called with every difference
+	report Reporter
+
+	evaluationCounter metric.Int64Counter
+}
+
+// cachedShadow - Shadow version of a tenant, nil if the tenant has none
+type cachedShadow struct {
+	shadow  *base.SchemaShadow
+	expires time.Time
+}
+
+// NewEvaluator - Creates an evaluator evaluating checks with the checker against the shadow versions read from the reader
+func NewEvaluator(reader storage.SchemaReader, checker Checker, conf config.Shadow) *Evaluator {
+	concurrency := conf.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &Evaluator{
+		reader:            reader,
+		checker:           checker,
+		refreshInterval:   conf.RefreshInterval,
+		timeout:           conf.Timeout,
+		slots:             make(chan struct{}, concurrency),
+		shadows:           make(map[string]cachedShadow),
+		report:            LogDifference,
+		evaluationCounter: telemetry.NewCounter(internal.Meter, "shadow_evaluations", "Number of checks evaluated against shadow schema versions"),
+	}
+}
+
+// Observe - Schedules the evaluation of a check answered with the served version against the shadow version of its
+// tenant, if the tenant has one that was written over that served version and the check is sampled. Checks arriving
+// while every evaluation slot is taken are dropped rather than delaying the served answers.
+func (e *Evaluator) Observe(ctx context.Context, request *base.PermissionCheckRequest, response *base.PermissionCheckResponse) {
+	if e == nil {
+		return
+	}
+
+	shadow := e.shadow(ctx, request.GetTenantId())
+	if shadow == nil || shadow.GetServedVersion() != request.GetMetadata().GetSchemaVersion() {
+		return
+	}
+	if shadow.GetSampleRate() < 1 && rand.Float64() >= shadow.GetSampleRate() {
+		return
+	}
+
+	select {
+	case e.slots <- struct{}{}:
+	default:
+		e.count(ctx, request.GetTenantId(), OutcomeDropped)
+		return
+	}
+
+	// The evaluation outlives the request, it keeps its trace but not its deadline, and it is not a decision of its own.
+	ectx := decision.Untracked(trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(ctx)))
+	request = request.CloneVT()
+	served := response.GetCan()
+
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		defer func() { <-e.slots }()
+
+		ectx, cancel := context.WithTimeout(ectx, e.timeout)
+		defer cancel()
+		e.evaluate(ectx, shadow, request, served)
+	}()
+}
+
+// SetReporter - Sets the reporter of the differences, which logs them by default
+func (e *Evaluator) SetReporter(report Reporter) {
+	e.report = report
+}
+
+// Wait - Waits for the evaluations in progress
+func (e *Evaluator) Wait() {
+	if e == nil {
+		return
+	}
+	e.wg.Wait()
+}
+
+// evaluate - Evaluates a check against the shadow version and reports the difference, if any
+func (e *Evaluator) evaluate(ctx context.Context, shadow *base.SchemaShadow, request *base.PermissionCheckRequest, served base.CheckResult) {
+	ctx, span := internal.Tracer.Start(ctx, "shadow.evaluate", trace.WithAttributes(
+		attribute.String("tenant_id", request.GetTenantId()),
+		attribute.String("shadow_version", shadow.GetVersion()),
+	))
+	defer span.End()
+
+	shadowRequest := request.CloneVT()
+	shadowRequest.Metadata.SchemaVersion = shadow.GetVersion()
+
+	response, err := e.checker.Check(ctx, shadowRequest)
+	if err != nil {
+		e.count(ctx, request.GetTenantId(), OutcomeError)
+		slog.DebugContext(ctx, "failed to evaluate check against the shadow schema version", slog.String("tenant_id", request.GetTenantId()), slog.String("shadow_version", shadow.GetVersion()), slog.Any("error", err))
+		return
+	}
+	if response.GetCan() == served {
+		e.count(ctx, request.GetTenantId(), OutcomeSame)
+		return
+	}
+	e.count(ctx, request.GetTenantId(), OutcomeDifferent)
+
+	difference := &Difference{
+		Request:       request,
+		ServedVersion: shadow.GetServedVersion(),
+		ShadowVersion: shadow.GetVersion(),
+		Served:        served,
+		Shadow:        response.GetCan(),
+	}
+	difference.ServedOnly, difference.ShadowOnly, err = e.explain(ctx, request, shadow)
+	if err != nil {
+		slog.DebugContext(ctx, "failed to explain shadow schema version difference", slog.Any("error", err))
+	}
+
+	e.report(ctx, difference)
+}
+
+// LogDifference - Logs a difference as a warning
+func LogDifference(ctx context.Context, difference *Difference) {
+	slog.WarnContext(ctx, "shadow schema version answers check differently",
+		slog.String("tenant_id", difference.Request.GetTenantId()),
+		slog.String("entity", tuple.EntityToString(difference.Request.GetEntity())),
+		slog.String("permission", difference.Request.GetPermission()),
+		slog.String("subject", tuple.SubjectToString(difference.Request.GetSubject())),
+		slog.String("snap_token", difference.Request.GetMetadata().GetSnapToken()),
+		slog.String("served_version", difference.ServedVersion),
+		slog.String("shadow_version", difference.ShadowVersion),
+		slog.String("served", difference.Served.String()),
+		slog.String("shadow", difference.Shadow.String()),
+		slog.Any("served_only_tuples", difference.ServedOnly),
+		slog.Any("shadow_only_tuples", difference.ShadowOnly),
+	)
+}
+
+// explain - Returns the tuples relevant to the subject that the permission expands to under only the served
+// version and under only the shadow version. Relevant tuples are those of the subject and of subject sets.
+func (e *Evaluator) explain(ctx context.Context, request *base.PermissionCheckRequest, shadow *base.SchemaShadow) (servedOnly, shadowOnly []string, err error) {
+	served, err := e.expand(ctx, request, shadow.GetServedVersion())
+	if err != nil {
+		return nil, nil, err
+	}
+	shadowed, err := e.expand(ctx, request, shadow.GetVersion())
+	if err != nil {
+		return nil, nil, err
+	}
+	return only(served, shadowed), only(shadowed, served), nil
+}
+
+// expand - Returns the relevant tuples of the expansion of the permission of a check under a version
+func (e *Evaluator) expand(ctx context.Context, request *base.PermissionCheckRequest, version string) (map[string]struct{}, error) {
+	response, err := e.checker.Expand(ctx, &base.PermissionExpandRequest{
+		TenantId: request.GetTenantId(),
+		Metadata: &base.PermissionExpandRequestMetadata{
+			SchemaVersion: version,
+			SnapToken:     request.GetMetadata().GetSnapToken(),
+		},
+		Entity:     request.GetEntity(),
+		Permission: request.GetPermission(),
+		Context:    request.GetContext(),
+		Arguments:  request.GetArguments(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tuples := make(map[string]struct{})
+	var walk func(node *base.Expand)
+	walk = func(node *base.Expand) {
+		for _, child := range node.GetExpand().GetChildren() {
+			walk(child)
+		}
+		for _, subject := range node.GetLeaf().GetSubjects().GetSubjects() {
+			if !tuple.AreSubjectsEqual(subject, request.GetSubject()) && tuple.IsDirectSubject(subject) {
+				continue
+			}
+			tuples[tuple.ToString(&base.Tuple{Entity: node.GetEntity(), Relation: node.GetPermission(), Subject: subject})] = struct{}{}
+		}
+	}
+	walk(response.GetTree())
+	return tuples, nil
+}
+
+// only - Returns the sorted tuples of a that are not in b, at most maxExplained of them
+func only(a, b map[string]struct{}) []string {
+	var tuples []string
+	for t := range a {
+		if _, ok := b[t]; !ok {
+			tuples = append(tuples, t)
+		}
+	}
+	slices.Sort(tuples)
+	if len(tuples) > maxExplained {
+		tuples = tuples[:maxExplained]
+	}
+	return tuples
+}
+
+// shadow - Returns the shadow version of a tenant, nil if it has none. The shadow versions are cached for the refresh interval.
+func (e *Evaluator) shadow(ctx context.Context, tenantID string) *base.SchemaShadow {
+	e.mu.Lock()
+	cached, ok := e.shadows[tenantID]
+	e.mu.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.shadow
+	}
+
+	shadow, err := e.reader.ReadShadow(ctx, tenantID)
+	if err != nil {
+		shadow = nil
+		if err.Error() != base.ErrorCode_ERROR_CODE_SCHEMA_SHADOW_NOT_FOUND.String() {
+			slog.DebugContext(ctx, "failed to read the shadow schema version", slog.String("tenant_id", tenantID), slog.Any("error", err))
+		}
+	}
+
+	e.mu.Lock()
+	e.shadows[tenantID] = cachedShadow{shadow: shadow, expires: time.Now().Add(e.refreshInterval)}
+	e.mu.Unlock()
+	return shadow
+}
+
+// count - Counts an evaluation of a tenant
+func (e *Evaluator) count(ctx context.Context, tenantID, outcome string) {
+	e.evaluationCounter.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("tenant_id", tenantID),
+		attribute.String("outcome", outcome),
+	))
+}
@@ -0,0 +1,155 @@
+package shadow_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/engines"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/internal/invoke"
+	"github.com/Permify/permify/internal/shadow"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/database"
+	"github.com/Permify/permify/pkg/dsl/compiler"
+	"github.com/Permify/permify/pkg/dsl/parser"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+func TestShadow(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "shadow suite")
+}
+
+// definitions - Compiles a schema into the definitions of a version of the t1 tenant
+func definitions(version, model string) []storage.SchemaDefinition {
+	sch, err := parser.NewParser(model).Parse()
+	Expect(err).ShouldNot(HaveOccurred())
+	_, _, err = compiler.NewCompiler(true, sch).Compile()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	defs := make([]storage.SchemaDefinition, 0, len(sch.Statements))
+	for _, st := range sch.Statements {
+		defs = append(defs, storage.SchemaDefinition{
+			TenantID:             "t1",
+			Version:              version,
+			Name:                 st.GetName(),
+			SerializedDefinition: []byte(st.String()),
+		})
+	}
+	return defs
+}
+
+var _ = Describe("shadow", func() {
+	var invoker *invoke.DirectInvoker
+	var evaluator *shadow.Evaluator
+
+	var mu sync.Mutex
+	var differences []*shadow.Difference
+
+	check := func(subject, version string) {
+		_, err := invoker.Check(context.Background(), &base.PermissionCheckRequest{
+			TenantId:   "t1",
+			Metadata:   &base.PermissionCheckRequestMetadata{Depth: 20, SchemaVersion: version},
+			Entity:     &base.Entity{Type: "doc", Id: "1"},
+			Permission: "view",
+			Subject:    &base.Subject{Type: "user", Id: subject},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		evaluator.Wait()
+	}
+
+	BeforeEach(func() {
+		db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		schemaWriter := factories.SchemaWriterFactory(db)
+		Expect(schemaWriter.WriteSchema(context.Background(), definitions("v1", `
+		entity user {}
+
+		entity doc {
+			relation owner @user
+			relation viewer @user
+
+			permission view = owner
+		}
+		`))).Should(Succeed())
+		Expect(schemaWriter.WriteShadow(context.Background(), storage.SchemaShadow{
+			TenantID:      "t1",
+			Version:       "v2",
+			ServedVersion: "v1",
+			SampleRate:    1,
+		}, definitions("v2", `
+		entity user {}
+
+		entity doc {
+			relation owner @user
+			relation viewer @user
+
+			permission view = owner or viewer
+		}
+		`))).Should(Succeed())
+
+		var tuples []*base.Tuple
+		for _, relationship := range []string{"doc:1#owner@user:1", "doc:1#viewer@user:2"} {
+			t, err := tuple.Tuple(relationship)
+			Expect(err).ShouldNot(HaveOccurred())
+			tuples = append(tuples, t)
+		}
+		_, err = factories.DataWriterFactory(db).Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection())
+		Expect(err).ShouldNot(HaveOccurred())
+
+		schemaReader := factories.SchemaReaderFactory(db)
+		dataReader := factories.DataReaderFactory(db)
+
+		checkEngine := engines.NewCheckEngine(schemaReader, dataReader)
+		invoker = invoke.NewDirectInvoker(
+			schemaReader,
+			dataReader,
+			checkEngine,
+			engines.NewExpandEngine(schemaReader, dataReader),
+			engines.NewLookupEngine(checkEngine, schemaReader, dataReader),
+			engines.NewSubjectPermission(checkEngine, schemaReader),
+		)
+		checkEngine.SetInvoker(invoker)
+
+		differences = nil
+		evaluator = shadow.NewEvaluator(schemaReader, invoker, config.Shadow{Concurrency: 1, RefreshInterval: time.Minute, Timeout: time.Minute})
+		evaluator.SetReporter(func(_ context.Context, difference *shadow.Difference) {
+			mu.Lock()
+			defer mu.Unlock()
+			differences = append(differences, difference)
+		})
+		invoker.SetShadowEvaluator(evaluator)
+	})
+
+	It("Case 1: reports the checks answered differently by the shadow version with the tuples explaining them", func() {
+		check("2", "")
+
+		Expect(differences).Should(HaveLen(1))
+		Expect(differences[0].ServedVersion).Should(Equal("v1"))
+		Expect(differences[0].ShadowVersion).Should(Equal("v2"))
+		Expect(differences[0].Served).Should(Equal(base.CheckResult_CHECK_RESULT_DENIED))
+		Expect(differences[0].Shadow).Should(Equal(base.CheckResult_CHECK_RESULT_ALLOWED))
+		Expect(differences[0].ServedOnly).Should(BeEmpty())
+		Expect(differences[0].ShadowOnly).Should(Equal([]string{"doc:1#viewer@user:2"}))
+	})
+
+	It("Case 2: does not report the checks answered the same", func() {
+		check("1", "")
+
+		Expect(differences).Should(BeEmpty())
+	})
+
+	It("Case 3: does not evaluate checks of a pinned version", func() {
+		check("2", "v1")
+
+		Expect(differences).Should(BeEmpty())
+	})
+})
@@ -0,0 +1,304 @@
+package simulation
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Permify/permify/internal/engines"
+	"github.com/Permify/permify/internal/invoke"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/proxies/overlay"
+	"github.com/Permify/permify/internal/validation"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// lookupPageSize - Page size of the lookups collecting every id of a simulated lookup
+const lookupPageSize = 1000
+
+// Simulator - Answers permission requests as if hypothetical changes were made to the data of their tenant
+type Simulator struct {
+	schemaReader storage.SchemaReader
+	dataReader   storage.DataReader
+}
+
+// NewSimulator - Creates a simulator answering requests with the schemas and data of the readers
+func NewSimulator(schemaReader storage.SchemaReader, dataReader storage.DataReader) *Simulator {
+	return &Simulator{
+		schemaReader: schemaReader,
+		dataReader:   dataReader,
+	}
+}
+
+// Simulate - Answers the request of a simulation on the stored data and on the stored data with the changes
+// overlaid, and returns what the changes grant and revoke. Both answers are made at the same snap token and
+// schema version. The simulated request is expected to be validated and to belong to the simulated tenant, the
+// written tuples and attributes are validated against the schema version like the writes of the data API.
+func (s *Simulator) Simulate(ctx context.Context, request *base.PermissionSimulateRequest) (*base.PermissionSimulateResponse, error) {
+	before := s.invoker(s.dataReader)
+	after := s.invoker(overlay.NewDataReader(s.dataReader, request.GetChanges()))
+
+	var gained, lost []string
+	var snap, version string
+	var err error
+
+	switch r := request.GetRequest().(type) {
+	case *base.PermissionSimulateRequest_Check:
+		check := r.Check.CloneVT()
+		snap, version, err = s.resolve(ctx, check.GetTenantId(), check.GetMetadata().GetSnapToken(), check.GetMetadata().GetSchemaVersion(), check.GetMetadata().GetSchemaTag())
+		if err != nil {
+			return nil, err
+		}
+		check.Metadata.SnapToken, check.Metadata.SchemaVersion, check.Metadata.SchemaTag = snap, version, ""
+		if err = s.validate(ctx, check.GetTenantId(), version, request.GetChanges()); err != nil {
+			return nil, err
+		}
+
+		var b, a []string
+		if b, err = allowed(ctx, before, check); err != nil {
+			return nil, err
+		}
+		if a, err = allowed(ctx, after, check); err != nil {
+			return nil, err
+		}
+		gained, lost = difference(b, a)
+	case *base.PermissionSimulateRequest_LookupEntity:
+		lookup := r.LookupEntity.CloneVT()
+		snap, version, err = s.resolve(ctx, lookup.GetTenantId(), lookup.GetMetadata().GetSnapToken(), lookup.GetMetadata().GetSchemaVersion(), lookup.GetMetadata().GetSchemaTag())
+		if err != nil {
+			return nil, err
+		}
+		lookup.Metadata.SnapToken, lookup.Metadata.SchemaVersion, lookup.Metadata.SchemaTag = snap, version, ""
+		if err = s.validate(ctx, lookup.GetTenantId(), version, request.GetChanges()); err != nil {
+			return nil, err
+		}
+
+		var b, a []string
+		if b, err = lookupEntity(ctx, before, lookup); err != nil {
+			return nil, err
+		}
+		if a, err = lookupEntity(ctx, after, lookup); err != nil {
+			return nil, err
+		}
+		gained, lost = difference(b, a)
+	case *base.PermissionSimulateRequest_LookupSubject:
+		lookup := r.LookupSubject.CloneVT()
+		snap, version, err = s.resolve(ctx, lookup.GetTenantId(), lookup.GetMetadata().GetSnapToken(), lookup.GetMetadata().GetSchemaVersion(), lookup.GetMetadata().GetSchemaTag())
+		if err != nil {
+			return nil, err
+		}
+		lookup.Metadata.SnapToken, lookup.Metadata.SchemaVersion, lookup.Metadata.SchemaTag = snap, version, ""
+		if err = s.validate(ctx, lookup.GetTenantId(), version, request.GetChanges()); err != nil {
+			return nil, err
+		}
+
+		var b, a []string
+		if b, err = lookupSubject(ctx, before, lookup); err != nil {
+			return nil, err
+		}
+		if a, err = lookupSubject(ctx, after, lookup); err != nil {
+			return nil, err
+		}
+		gained, lost = difference(b, a)
+	case *base.PermissionSimulateRequest_SubjectPermission:
+		sp := r.SubjectPermission.CloneVT()
+		snap, version, err = s.resolve(ctx, sp.GetTenantId(), sp.GetMetadata().GetSnapToken(), sp.GetMetadata().GetSchemaVersion(), sp.GetMetadata().GetSchemaTag())
+		if err != nil {
+			return nil, err
+		}
+		sp.Metadata.SnapToken, sp.Metadata.SchemaVersion, sp.Metadata.SchemaTag = snap, version, ""
+		if err = s.validate(ctx, sp.GetTenantId(), version, request.GetChanges()); err != nil {
+			return nil, err
+		}
+
+		var b, a []string
+		if b, err = subjectPermission(ctx, before, sp); err != nil {
+			return nil, err
+		}
+		if a, err = subjectPermission(ctx, after, sp); err != nil {
+			return nil, err
+		}
+		gained, lost = difference(b, a)
+	}
+
+	return &base.PermissionSimulateResponse{
+		Gained:        gained,
+		Lost:          lost,
+		SnapToken:     snap,
+		SchemaVersion: version,
+	}, nil
+}
+
+// invoker - Creates an invoker answering requests on the data of the reader. It neither caches nor logs decisions,
+// so simulated answers never leak into the answers of real requests.
+func (s *Simulator) invoker(dataReader storage.DataReader) *invoke.DirectInvoker {
+	checkEngine := engines.NewCheckEngine(s.schemaReader, dataReader)
+	invoker := invoke.NewDirectInvoker(
+		s.schemaReader,
+		dataReader,
+		checkEngine,
+		engines.NewExpandEngine(s.schemaReader, dataReader),
+		engines.NewLookupEngine(checkEngine, s.schemaReader, dataReader),
+		engines.NewSubjectPermission(checkEngine, s.schemaReader),
+	)
+	checkEngine.SetInvoker(invoker)
+	return invoker
+}
+
+// validate - Validates the written tuples and attributes of the changes against the entity definitions of a schema version
+func (s *Simulator) validate(ctx context.Context, tenantID, version string, changes *base.SimulationChanges) error {
+	definitions := map[string]*base.EntityDefinition{}
+	definition := func(name string) (*base.EntityDefinition, error) {
+		if d, ok := definitions[name]; ok {
+			return d, nil
+		}
+		d, _, err := s.schemaReader.ReadEntityDefinition(ctx, tenantID, name, version)
+		if err != nil {
+			return nil, err
+		}
+		definitions[name] = d
+		return d, nil
+	}
+
+	for _, t := range changes.GetWriteTuples() {
+		d, err := definition(t.GetEntity().GetType())
+		if err != nil {
+			return err
+		}
+		if err = validation.ValidateTuple(d, t); err != nil {
+			return err
+		}
+	}
+	if err := validation.ValidateCardinality(definitions, changes.GetWriteTuples()); err != nil {
+		return err
+	}
+
+	for _, a := range changes.GetWriteAttributes() {
+		d, err := definition(a.GetEntity().GetType())
+		if err != nil {
+			return err
+		}
+		if err = validation.ValidateAttribute(d, a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolve - Returns the snap token and schema version of a request, the head ones of the tenant if they are not set
+func (s *Simulator) resolve(ctx context.Context, tenantID, snap, version, tag string) (string, string, error) {
+	if snap == "" {
+		st, err := s.dataReader.HeadSnapshot(ctx, tenantID)
+		if err != nil {
+			return "", "", err
+		}
+		snap = st.Encode().String()
+	}
+
+	if version == "" {
+		var err error
+		if tag != "" {
+			version, err = s.schemaReader.TagVersion(ctx, tenantID, tag)
+		} else {
+			version, err = s.schemaReader.HeadVersion(ctx, tenantID)
+		}
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	return snap, version, nil
+}
+
+// allowed - Returns the permission of a check if it is allowed
+func allowed(ctx context.Context, invoker invoke.Invoker, request *base.PermissionCheckRequest) ([]string, error) {
+	response, err := invoker.Check(ctx, request.CloneVT())
+	if err != nil {
+		return nil, err
+	}
+	if response.GetCan() == base.CheckResult_CHECK_RESULT_ALLOWED {
+		return []string{request.GetPermission()}, nil
+	}
+	return nil, nil
+}
+
+// lookupEntity - Returns every entity id of a lookup, page by page
+func lookupEntity(ctx context.Context, invoker invoke.Invoker, request *base.PermissionLookupEntityRequest) ([]string, error) {
+	request = request.CloneVT()
+	request.PageSize = lookupPageSize
+	request.ContinuousToken = ""
+
+	var ids []string
+	for {
+		response, err := invoker.LookupEntity(ctx, request.CloneVT())
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, response.GetEntityIds()...)
+		if response.GetContinuousToken() == "" || len(response.GetEntityIds()) < lookupPageSize {
+			return ids, nil
+		}
+		request.ContinuousToken = response.GetContinuousToken()
+	}
+}
+
+// lookupSubject - Returns every subject id of a lookup, page by page
+func lookupSubject(ctx context.Context, invoker invoke.Invoker, request *base.PermissionLookupSubjectRequest) ([]string, error) {
+	request = request.CloneVT()
+	request.PageSize = lookupPageSize
+	request.ContinuousToken = ""
+
+	var ids []string
+	for {
+		response, err := invoker.LookupSubject(ctx, request.CloneVT())
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, response.GetSubjectIds()...)
+		if response.GetContinuousToken() == "" || len(response.GetSubjectIds()) < lookupPageSize {
+			return ids, nil
+		}
+		request.ContinuousToken = response.GetContinuousToken()
+	}
+}
+
+// subjectPermission - Returns the allowed permissions of a subject permission request
+func subjectPermission(ctx context.Context, invoker invoke.Invoker, request *base.PermissionSubjectPermissionRequest) ([]string, error) {
+	response, err := invoker.SubjectPermission(ctx, request.CloneVT())
+	if err != nil {
+		return nil, err
+	}
+
+	var permissions []string
+	for permission, result := range response.GetResults() {
+		if result == base.CheckResult_CHECK_RESULT_ALLOWED {
+			permissions = append(permissions, permission)
+		}
+	}
+	return permissions, nil
+}
+
+// difference - Returns the sorted items of after that are not in before, and of before that are not in after
+func difference(before, after []string) (gained, lost []string) {
+	b := make(map[string]struct{}, len(before))
+	for _, item := range before {
+		b[item] = struct{}{}
+	}
+	a := make(map[string]struct{}, len(after))
+	for _, item := range after {
+		a[item] = struct{}{}
+	}
+
+	for item := range a {
+		if _, ok := b[item]; !ok {
+			gained = append(gained, item)
+		}
+	}
+	for item := range b {
+		if _, ok := a[item]; !ok {
+			lost = append(lost, item)
+		}
+	}
+	slices.Sort(gained)
+	slices.Sort(lost)
+	return gained, lost
+}
@@ -0,0 +1,223 @@
+package simulation_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/internal/simulation"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/attribute"
+	"github.com/Permify/permify/pkg/database"
+	"github.com/Permify/permify/pkg/dsl/compiler"
+	"github.com/Permify/permify/pkg/dsl/parser"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+func TestSimulation(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "simulation suite")
+}
+
+const model = `
+entity user {}
+
+entity group {
+	relation member @user
+}
+
+entity doc {
+	relation viewer @user @group#member
+
+	attribute public boolean
+
+	permission view = viewer or public
+	permission edit = viewer not public
+}
+`
+
+// tuples - Parses relation tuples
+func tuples(relationships ...string) []*base.Tuple {
+	ts := make([]*base.Tuple, 0, len(relationships))
+	for _, relationship := range relationships {
+		t, err := tuple.Tuple(relationship)
+		Expect(err).ShouldNot(HaveOccurred())
+		ts = append(ts, t)
+	}
+	return ts
+}
+
+// attributes - Parses attributes
+func attributes(attrs ...string) []*base.Attribute {
+	as := make([]*base.Attribute, 0, len(attrs))
+	for _, attr := range attrs {
+		a, err := attribute.Attribute(attr)
+		Expect(err).ShouldNot(HaveOccurred())
+		as = append(as, a)
+	}
+	return as
+}
+
+var _ = Describe("simulation", func() {
+	var simulator *simulation.Simulator
+
+	BeforeEach(func() {
+		db, err := factories.DatabaseFactory(config.Database{Engine: "memory"})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		sch, err := parser.NewParser(model).Parse()
+		Expect(err).ShouldNot(HaveOccurred())
+		_, _, err = compiler.NewCompiler(true, sch).Compile()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		defs := make([]storage.SchemaDefinition, 0, len(sch.Statements))
+		for _, st := range sch.Statements {
+			defs = append(defs, storage.SchemaDefinition{
+				TenantID:             "simulation",
+				Version:              "v1",
+				Name:                 st.GetName(),
+				SerializedDefinition: []byte(st.String()),
+			})
+		}
+		Expect(factories.SchemaWriterFactory(db).WriteSchema(context.Background(), defs)).Should(Succeed())
+
+		_, err = factories.DataWriterFactory(db).Write(context.Background(), "simulation", database.NewTupleCollection(tuples(
+			"group:x#member@user:alice",
+			"doc:1#viewer@group:x#member",
+			"doc:2#viewer@group:x#member",
+			"doc:3#viewer@user:alice",
+		)...), database.NewAttributeCollection(attributes(
+			"doc:3$public|boolean:false",
+		)...))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		simulator = simulation.NewSimulator(factories.SchemaReaderFactory(db), factories.DataReaderFactory(db))
+	})
+
+	It("Case 1: lists the entities lost by a deleted tuple", func() {
+		request := &base.PermissionSimulateRequest{
+			TenantId: "simulation",
+			Changes: &base.SimulationChanges{
+				DeleteTuples: tuples("group:x#member@user:alice"),
+			},
+			Request: &base.PermissionSimulateRequest_LookupEntity{LookupEntity: &base.PermissionLookupEntityRequest{
+				TenantId:   "simulation",
+				Metadata:   &base.PermissionLookupEntityRequestMetadata{Depth: 20},
+				EntityType: "doc",
+				Permission: "view",
+				Subject:    &base.Subject{Type: "user", Id: "alice"},
+			}},
+		}
+
+		response, err := simulator.Simulate(context.Background(), request)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(response.GetGained()).Should(BeEmpty())
+		Expect(response.GetLost()).Should(Equal([]string{"1", "2"}))
+		Expect(response.GetSchemaVersion()).Should(Equal("v1"))
+		Expect(response.GetSnapToken()).ShouldNot(BeEmpty())
+	})
+
+	It("Case 2: lists the subjects gained and lost by written and deleted tuples", func() {
+		request := &base.PermissionSimulateRequest{
+			TenantId: "simulation",
+			Changes: &base.SimulationChanges{
+				WriteTuples:  tuples("group:x#member@user:bob"),
+				DeleteTuples: tuples("group:x#member@user:alice"),
+			},
+			Request: &base.PermissionSimulateRequest_LookupSubject{LookupSubject: &base.PermissionLookupSubjectRequest{
+				TenantId:         "simulation",
+				Metadata:         &base.PermissionLookupSubjectRequestMetadata{Depth: 20},
+				Entity:           &base.Entity{Type: "doc", Id: "1"},
+				Permission:       "view",
+				SubjectReference: &base.RelationReference{Type: "user"},
+			}},
+		}
+
+		response, err := simulator.Simulate(context.Background(), request)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(response.GetGained()).Should(Equal([]string{"bob"}))
+		Expect(response.GetLost()).Should(Equal([]string{"alice"}))
+	})
+
+	It("Case 3: answers checks with written attributes replacing the stored ones", func() {
+		check := func(permission string) *base.PermissionSimulateRequest {
+			return &base.PermissionSimulateRequest{
+				TenantId: "simulation",
+				Changes: &base.SimulationChanges{
+					WriteAttributes: attributes("doc:3$public|boolean:true"),
+				},
+				Request: &base.PermissionSimulateRequest_Check{Check: &base.PermissionCheckRequest{
+					TenantId:   "simulation",
+					Metadata:   &base.PermissionCheckRequestMetadata{Depth: 20},
+					Entity:     &base.Entity{Type: "doc", Id: "3"},
+					Permission: permission,
+					Subject:    &base.Subject{Type: "user", Id: "alice"},
+				}},
+			}
+		}
+
+		response, err := simulator.Simulate(context.Background(), check("view"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(response.GetGained()).Should(BeEmpty())
+		Expect(response.GetLost()).Should(BeEmpty())
+
+		response, err = simulator.Simulate(context.Background(), check("edit"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(response.GetGained()).Should(BeEmpty())
+		Expect(response.GetLost()).Should(Equal([]string{"edit"}))
+	})
+
+	It("Case 4: lists the permissions and relations lost by deleted tuples and attributes", func() {
+		request := &base.PermissionSimulateRequest{
+			TenantId: "simulation",
+			Changes: &base.SimulationChanges{
+				WriteTuples:      tuples("doc:3#viewer@group:x#member"),
+				DeleteTuples:     tuples("doc:3#viewer@user:alice", "group:x#member@user:alice"),
+				DeleteAttributes: attributes("doc:3$public|boolean:false"),
+			},
+			Request: &base.PermissionSimulateRequest_SubjectPermission{SubjectPermission: &base.PermissionSubjectPermissionRequest{
+				TenantId: "simulation",
+				Metadata: &base.PermissionSubjectPermissionRequestMetadata{Depth: 20},
+				Entity:   &base.Entity{Type: "doc", Id: "3"},
+				Subject:  &base.Subject{Type: "user", Id: "alice"},
+			}},
+		}
+
+		response, err := simulator.Simulate(context.Background(), request)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(response.GetGained()).Should(BeEmpty())
+		Expect(response.GetLost()).Should(Equal([]string{"edit", "view", "viewer"}))
+	})
+
+	It("Case 5: rejects written tuples and attributes the schema does not allow", func() {
+		simulate := func(changes *base.SimulationChanges) error {
+			_, err := simulator.Simulate(context.Background(), &base.PermissionSimulateRequest{
+				TenantId: "simulation",
+				Changes:  changes,
+				Request: &base.PermissionSimulateRequest_Check{Check: &base.PermissionCheckRequest{
+					TenantId:   "simulation",
+					Metadata:   &base.PermissionCheckRequestMetadata{Depth: 20},
+					Entity:     &base.Entity{Type: "doc", Id: "1"},
+					Permission: "view",
+					Subject:    &base.Subject{Type: "user", Id: "alice"},
+				}},
+			})
+			return err
+		}
+
+		err := simulate(&base.SimulationChanges{WriteTuples: tuples("doc:1#owner@user:bob")})
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_RELATION_DEFINITION_NOT_FOUND.String()))
+
+		err = simulate(&base.SimulationChanges{WriteTuples: tuples("doc:1#viewer@group:x")})
+		Expect(err).Should(HaveOccurred())
+
+		err = simulate(&base.SimulationChanges{WriteAttributes: attributes("doc:1$public|string:yes")})
+		Expect(err).Should(HaveOccurred())
+	})
+})
@@ -5,6 +5,10 @@ const (
 	RelationTuplesTable    = "relation_tuples"
 	AttributesTable        = "attributes"
 	SchemaDefinitionsTable = "schema_definitions"
+	SchemaShadowsTable     = "schema_shadows"
+	SchemaTagsTable        = "schema_tags"
 	TenantsTable           = "tenants"
 	BundlesTable           = "bundles"
+	IdempotencyKeysTable   = "idempotency_keys"
+	TransactionsTable      = "transactions"
 )
@@ -1,24 +1,29 @@
 package memory
 
 import (
+	"cmp"
 	"context"
 	"errors"
+	"fmt"
 	"slices" // Slice operations
 	"sort"
 	"strconv"
 	"time"
 
 	"github.com/hashicorp/go-memdb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/Permify/permify/internal/storage/memory/constants"
 
 	"github.com/Permify/permify/internal/storage"
 	"github.com/Permify/permify/internal/storage/memory/snapshot"
 	"github.com/Permify/permify/internal/storage/memory/utils"
+	"github.com/Permify/permify/pkg/attribute"
 	"github.com/Permify/permify/pkg/database"
 	db "github.com/Permify/permify/pkg/database/memory"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 	"github.com/Permify/permify/pkg/token"
+	"github.com/Permify/permify/pkg/tuple"
 )
 
 // DataReader -
@@ -67,6 +72,14 @@ func (r *DataReader) QueryRelationships(_ context.Context, tenantID string, filt
 		if !ok {
 			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
 		}
+		var matched bool
+		matched, err = tupleMatchesPredicates(txn, tenantID, t, filter)
+		if err != nil {
+			return nil, err
+		}
+		if !matched {
+			continue
+		}
 		tup = append(tup, t)
 	}
 
@@ -112,6 +125,102 @@ func (r *DataReader) QueryRelationships(_ context.Context, tenantID string, filt
 	return database.NewTupleCollection(tuples...).CreateTupleIterator(), nil
 }
 
+// CountSubjects counts the distinct subjects, by type and id, of the relationships in the database that match the
+// provided filter. Wildcard subjects are not counted.
+func (r *DataReader) CountSubjects(_ context.Context, tenantID string, filter *base.TupleFilter, _ string) (count int, err error) {
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+
+	// Get the index and arguments based on the filter.
+	index, args := utils.GetRelationTuplesIndexNameAndArgsByFilters(tenantID, filter)
+
+	// Get the result iterator based on the index and arguments.
+	var result memdb.ResultIterator
+	result, err = txn.Get(constants.RelationTuplesTable, index, args...)
+	if err != nil {
+		return 0, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	// Collect the subjects of the tuples that pass the filter.
+	subjects := map[string]struct{}{}
+	fit := memdb.NewFilterIterator(result, utils.FilterRelationTuplesQuery(tenantID, filter))
+	for obj := fit.Next(); obj != nil; obj = fit.Next() {
+		t, ok := obj.(storage.RelationTuple)
+		if !ok {
+			return 0, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		var matched bool
+		matched, err = tupleMatchesPredicates(txn, tenantID, t, filter)
+		if err != nil {
+			return 0, err
+		}
+		if matched && t.SubjectID != tuple.WILDCARD {
+			subjects[fmt.Sprintf(tuple.ENTITY, t.SubjectType, t.SubjectID)] = struct{}{}
+		}
+	}
+
+	return len(subjects), nil
+}
+
+// QueryRelationshipHotspots - Counts the relation tuples of a tenant by their entity and relation, or by their
+// subject, and returns the groups with the most tuples, most first
+func (r *DataReader) QueryRelationshipHotspots(_ context.Context, tenantID string, group storage.HotspotGroup, _ string, limit uint32) ([]*base.DataHotspot, error) {
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+
+	filter := &base.TupleFilter{}
+	index, args := utils.GetRelationTuplesIndexNameAndArgsByFilters(tenantID, filter)
+	result, err := txn.Get(constants.RelationTuplesTable, index, args...)
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	type key struct {
+		entityType string
+		entityID   string
+		relation   string
+	}
+	counts := map[key]uint64{}
+	fit := memdb.NewFilterIterator(result, utils.FilterRelationTuplesQuery(tenantID, filter))
+	for obj := fit.Next(); obj != nil; obj = fit.Next() {
+		t, ok := obj.(storage.RelationTuple)
+		if !ok {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		if group == storage.FanIn {
+			counts[key{entityType: t.SubjectType, entityID: t.SubjectID, relation: t.SubjectRelation}]++
+		} else {
+			counts[key{entityType: t.EntityType, entityID: t.EntityID, relation: t.Relation}]++
+		}
+	}
+
+	keys := make([]key, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, func(a, b key) int {
+		return cmp.Or(
+			cmp.Compare(counts[b], counts[a]),
+			cmp.Compare(a.entityType, b.entityType),
+			cmp.Compare(a.entityID, b.entityID),
+			cmp.Compare(a.relation, b.relation),
+		)
+	})
+	if limit > 0 && len(keys) > int(limit) {
+		keys = keys[:limit]
+	}
+
+	hotspots := make([]*base.DataHotspot, 0, len(keys))
+	for _, k := range keys {
+		hotspots = append(hotspots, &base.DataHotspot{
+			Entity:   &base.Entity{Type: k.entityType, Id: k.entityID},
+			Relation: k.relation,
+			Count:    counts[k],
+		})
+	}
+	return hotspots, nil
+}
+
 // ReadRelationships reads relationships from the database taking into account the pagination.
 func (r *DataReader) ReadRelationships(_ context.Context, tenantID string, filter *base.TupleFilter, _ string, pagination database.Pagination) (collection *database.TupleCollection, ct database.EncodedContinuousToken, err error) {
 	txn := r.database.DB.Txn(false)
@@ -147,6 +256,14 @@ func (r *DataReader) ReadRelationships(_ context.Context, tenantID string, filte
 		if !ok {
 			return nil, database.NewNoopContinuousToken().Encode(), errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
 		}
+		var matched bool
+		matched, err = tupleMatchesPredicates(txn, tenantID, t, filter)
+		if err != nil {
+			return nil, database.NewNoopContinuousToken().Encode(), err
+		}
+		if !matched {
+			continue
+		}
 		tup = append(tup, t)
 	}
 
@@ -185,13 +302,19 @@ func (r *DataReader) QuerySingleAttribute(_ context.Context, tenantID string, fi
 
 	// Filter the result iterator and get the first attribute.
 	fit := memdb.NewFilterIterator(result, utils.FilterAttributesQuery(tenantID, filter))
-	obj := fit.Next()
-	if obj != nil {
+	for obj := fit.Next(); obj != nil; obj = fit.Next() {
 		t, ok := obj.(storage.Attribute)
 		if !ok {
 			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
 		}
-		return t.ToAttribute(), nil
+		var matched bool
+		matched, err = matchesPredicates(txn, tenantID, t.EntityType, t.EntityID, filter.GetEntity().GetPredicates())
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			return t.ToAttribute(), nil
+		}
 	}
 
 	return nil, nil
@@ -231,6 +354,14 @@ func (r *DataReader) QueryAttributes(_ context.Context, tenantID string, filter
 		if !ok {
 			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
 		}
+		var matched bool
+		matched, err = matchesPredicates(txn, tenantID, t.EntityType, t.EntityID, filter.GetEntity().GetPredicates())
+		if err != nil {
+			return nil, err
+		}
+		if !matched {
+			continue
+		}
 		attr = append(attr, t)
 	}
 
@@ -306,6 +437,14 @@ func (r *DataReader) ReadAttributes(_ context.Context, tenantID string, filter *
 		if !ok {
 			return nil, database.NewNoopContinuousToken().Encode(), errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
 		}
+		var matched bool
+		matched, err = matchesPredicates(txn, tenantID, a.EntityType, a.EntityID, filter.GetEntity().GetPredicates())
+		if err != nil {
+			return nil, database.NewNoopContinuousToken().Encode(), err
+		}
+		if !matched {
+			continue
+		}
 		attr = append(attr, a)
 	}
 
@@ -328,7 +467,7 @@ func (r *DataReader) ReadAttributes(_ context.Context, tenantID string, filter *
 }
 
 // QueryUniqueSubjectReferences is a function that searches for unique subject references in a given database.
-func (r *DataReader) QueryUniqueSubjectReferences(_ context.Context, tenantID string, subjectReference *base.RelationReference, excluded []string, _ string, pagination database.Pagination) (ids []string, _ database.EncodedContinuousToken, err error) {
+func (r *DataReader) QueryUniqueSubjectReferences(_ context.Context, tenantID string, filter *base.SubjectFilter, excluded []string, _ string, pagination database.Pagination) (ids []string, _ database.EncodedContinuousToken, err error) {
 	txn := r.database.DB.Txn(false)
 	defer txn.Abort()
 
@@ -351,18 +490,21 @@ func (r *DataReader) QueryUniqueSubjectReferences(_ context.Context, tenantID st
 	var subjectIDs []string
 
 	// Filter the result iterator and add the tuples to the collection.
-	fit := memdb.NewFilterIterator(result, utils.FilterRelationTuplesQuery(tenantID, &base.TupleFilter{
-		Subject: &base.SubjectFilter{
-			Type:     subjectReference.GetType(),
-			Relation: subjectReference.GetRelation(),
-		},
-	}))
+	tupleFilter := &base.TupleFilter{Subject: filter}
+	fit := memdb.NewFilterIterator(result, utils.FilterRelationTuplesQuery(tenantID, tupleFilter))
 	for obj := fit.Next(); obj != nil; obj = fit.Next() {
 		t, ok := obj.(storage.RelationTuple)
 		if !ok {
 			return nil, database.NewNoopContinuousToken().Encode(), errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
 		}
-		subjectIDs = append(subjectIDs, t.SubjectID)
+		var matched bool
+		matched, err = tupleMatchesPredicates(txn, tenantID, t, tupleFilter)
+		if err != nil {
+			return nil, database.NewNoopContinuousToken().Encode(), err
+		}
+		if matched {
+			subjectIDs = append(subjectIDs, t.SubjectID)
+		}
 	}
 
 	// Sort the tuples and append them to the collection.
@@ -400,3 +542,147 @@ func (r *DataReader) QueryUniqueSubjectReferences(_ context.Context, tenantID st
 func (r *DataReader) HeadSnapshot(_ context.Context, _ string) (token.SnapToken, error) {
 	return snapshot.NewToken(time.Now()), nil
 }
+
+// ConsistentHeadSnapshot - Reads the latest version of the snapshot from the repository, the memory engine has no replicas.
+func (r *DataReader) ConsistentHeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	return r.HeadSnapshot(ctx, tenantID)
+}
+
+// SnapshotAt - Reads the version of the snapshot at a point in time from the repository. The memory engine keeps
+// no history, so only times after the last write of the tenant can be read.
+func (r *DataReader) SnapshotAt(_ context.Context, tenantID string, at time.Time) (token.SnapToken, error) {
+	if uint64(at.UnixNano()) < r.database.GetHead(tenantID) {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_HISTORY_UNAVAILABLE.String())
+	}
+	return snapshot.NewToken(time.Now()), nil
+}
+
+// ReadRelationshipHistory - Reads the versions of relation tuples from the repository. The memory engine keeps
+// no expired versions, so only the stored tuples are listed, as active versions with the transaction they were
+// written in while it is retained.
+func (r *DataReader) ReadRelationshipHistory(ctx context.Context, tenantID string, filter *base.TupleFilter, pagination database.Pagination) ([]*base.DataHistoryRecord, database.EncodedContinuousToken, error) {
+	collection, ct, err := r.ReadRelationships(ctx, tenantID, filter, "", pagination)
+	if err != nil {
+		return nil, ct, err
+	}
+	created, err := r.createdIn(tenantID)
+	if err != nil {
+		return nil, nil, err
+	}
+	records := make([]*base.DataHistoryRecord, 0, len(collection.GetTuples()))
+	for _, t := range collection.GetTuples() {
+		record := &base.DataHistoryRecord{Item: &base.DataHistoryRecord_Tuple{Tuple: t}}
+		setCreated(record, created[tuple.ToString(t)])
+		records = append(records, record)
+	}
+	return records, ct, nil
+}
+
+// ReadAttributeHistory - Reads the versions of attributes from the repository. The memory engine keeps
+// no expired versions, so only the stored attributes are listed, as active versions with the transaction they
+// were written in while it is retained.
+func (r *DataReader) ReadAttributeHistory(ctx context.Context, tenantID string, filter *base.AttributeFilter, pagination database.Pagination) ([]*base.DataHistoryRecord, database.EncodedContinuousToken, error) {
+	collection, ct, err := r.ReadAttributes(ctx, tenantID, filter, "", pagination)
+	if err != nil {
+		return nil, ct, err
+	}
+	created, err := r.createdIn(tenantID)
+	if err != nil {
+		return nil, nil, err
+	}
+	records := make([]*base.DataHistoryRecord, 0, len(collection.GetAttributes()))
+	for _, a := range collection.GetAttributes() {
+		record := &base.DataHistoryRecord{Item: &base.DataHistoryRecord_Attribute{Attribute: a}}
+		setCreated(record, created[attribute.EntityAndAttributeToString(a.GetEntity(), a.GetAttribute())])
+		records = append(records, record)
+	}
+	return records, ct, nil
+}
+
+// createdIn - Maps the tuples and the attributes created in the retained transactions of a tenant to the last
+// transaction that created them
+func (r *DataReader) createdIn(tenantID string) (map[string]storage.Transaction, error) {
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+
+	it, err := txn.LowerBound(constants.TransactionsTable, "id", tenantID, uint64(0))
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	created := map[string]storage.Transaction{}
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		tx, ok := obj.(storage.Transaction)
+		if !ok || tx.TenantID != tenantID {
+			break
+		}
+		for _, change := range tx.Changes.GetDataChanges() {
+			if change.GetOperation() != base.DataChange_OPERATION_CREATE {
+				continue
+			}
+			if t := change.GetTuple(); t != nil {
+				created[tuple.ToString(t)] = tx
+			}
+			if a := change.GetAttribute(); a != nil {
+				created[attribute.EntityAndAttributeToString(a.GetEntity(), a.GetAttribute())] = tx
+			}
+		}
+	}
+	return created, nil
+}
+
+// setCreated - Sets the transaction a version was written in on its record, if it is retained
+func setCreated(record *base.DataHistoryRecord, tx storage.Transaction) {
+	if tx.ID == 0 {
+		return
+	}
+	record.CreatedTxId = tx.ID
+	record.CreatedAt = timestamppb.New(tx.CreatedAt)
+	record.CreatedMetadata = tx.Changes.GetMetadata()
+}
+
+// tupleMatchesPredicates - Checks if the entity and the subject of a tuple satisfy the predicates of their filters
+func tupleMatchesPredicates(txn *memdb.Txn, tenantID string, t storage.RelationTuple, filter *base.TupleFilter) (bool, error) {
+	matched, err := matchesPredicates(txn, tenantID, t.EntityType, t.EntityID, filter.GetEntity().GetPredicates())
+	if err != nil || !matched {
+		return false, err
+	}
+	return matchesPredicates(txn, tenantID, t.SubjectType, t.SubjectID, filter.GetSubject().GetPredicates())
+}
+
+// matchesPredicates - Checks if the attributes of an entity satisfy every predicate
+func matchesPredicates(txn *memdb.Txn, tenantID, entityType, entityID string, predicates []*base.AttributePredicate) (bool, error) {
+	for _, predicate := range predicates {
+		// Predicates that cannot be evaluated are an error even for entities without the attribute.
+		if _, err := attribute.MatchesPredicate(predicate, predicate.GetValue()); err != nil {
+			return false, err
+		}
+
+		filter := &base.AttributeFilter{
+			Entity:     &base.EntityFilter{Type: entityType, Ids: []string{entityID}},
+			Attributes: []string{predicate.GetAttribute()},
+		}
+		index, args := utils.GetAttributesIndexNameAndArgsByFilters(tenantID, filter)
+		result, err := txn.Get(constants.AttributesTable, index, args...)
+		if err != nil {
+			return false, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+
+		matched := false
+		fit := memdb.NewFilterIterator(result, utils.FilterAttributesQuery(tenantID, filter))
+		for obj := fit.Next(); obj != nil && !matched; obj = fit.Next() {
+			a, ok := obj.(storage.Attribute)
+			if !ok {
+				return false, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+			}
+			matched, err = attribute.MatchesPredicate(predicate, a.Value)
+			if err != nil {
+				return false, err
+			}
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -3,10 +3,13 @@ package memory
 import (
 	"context"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/anypb"
 
+	"github.com/Permify/permify/internal/storage"
 	"github.com/Permify/permify/internal/storage/memory/migrations"
 	"github.com/Permify/permify/pkg/attribute"
 	"github.com/Permify/permify/pkg/database"
@@ -78,6 +81,136 @@ var _ = Describe("DataReader", func() {
 		})
 	})
 
+	Context("Count Subjects", func() {
+		It("should write relationships and count their subjects correctly", func() {
+			ctx := context.Background()
+
+			tup1, err := tuple.Tuple("document:document-1#approver@user:user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tup2, err := tuple.Tuple("document:document-1#approver@user:user-2")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tup3, err := tuple.Tuple("document:document-1#owner@user:user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tup4, err := tuple.Tuple("document:document-2#approver@user:user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = dataWriter.Write(ctx, "t1", database.NewTupleCollection(tup1, tup2, tup3, tup4), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			count, err := dataReader.CountSubjects(ctx, "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "document",
+					Ids:  []string{"document-1"},
+				},
+				Relation: "approver",
+			}, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(count).Should(Equal(2))
+
+			_, err = dataWriter.Delete(ctx, "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "document",
+					Ids:  []string{"document-1"},
+				},
+				Relation: "approver",
+				Subject: &base.SubjectFilter{
+					Type: "user",
+					Ids:  []string{"user-2"},
+				},
+			}, &base.AttributeFilter{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			count, err = dataReader.CountSubjects(ctx, "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "document",
+					Ids:  []string{"document-1"},
+				},
+				Relation: "approver",
+			}, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(count).Should(Equal(1))
+
+			count, err = dataReader.CountSubjects(ctx, "t2", &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "document",
+				},
+			}, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(count).Should(Equal(0))
+		})
+
+		It("should count a subject once and leave wildcards out", func() {
+			ctx := context.Background()
+
+			collection := database.NewTupleCollection()
+			for _, t := range []string{
+				"document:document-1#approver@group:group-1#member",
+				"document:document-1#approver@group:group-1#admin",
+				"document:document-1#approver@user:user-1",
+				"document:document-1#approver@user:*",
+			} {
+				tup, err := tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				collection.Add(tup)
+			}
+
+			_, err := dataWriter.Write(ctx, "t1", collection, database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			count, err := dataReader.CountSubjects(ctx, "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "document",
+					Ids:  []string{"document-1"},
+				},
+				Relation: "approver",
+			}, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(count).Should(Equal(2))
+		})
+	})
+
+	Context("Query Relationship Hotspots", func() {
+		It("should group and count relationships by entity and by subject", func() {
+			ctx := context.Background()
+
+			tup1, err := tuple.Tuple("document:document-1#approver@user:user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tup2, err := tuple.Tuple("document:document-1#approver@user:user-2")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tup3, err := tuple.Tuple("document:document-2#approver@organization:organization-1#member")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tup4, err := tuple.Tuple("document:document-3#owner@organization:organization-1#member")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			token1, err := dataWriter.Write(ctx, "t1", database.NewTupleCollection(tup1, tup2, tup3, tup4), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fanOut, err := dataReader.QueryRelationshipHotspots(ctx, "t1", storage.FanOut, token1.String(), 0)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(fanOut).Should(HaveLen(3))
+			Expect(fanOut[0].GetEntity()).Should(Equal(&base.Entity{Type: "document", Id: "document-1"}))
+			Expect(fanOut[0].GetRelation()).Should(Equal("approver"))
+			Expect(fanOut[0].GetCount()).Should(Equal(uint64(2)))
+
+			fanIn, err := dataReader.QueryRelationshipHotspots(ctx, "t1", storage.FanIn, token1.String(), 1)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(fanIn).Should(HaveLen(1))
+			Expect(fanIn[0].GetEntity()).Should(Equal(&base.Entity{Type: "organization", Id: "organization-1"}))
+			Expect(fanIn[0].GetRelation()).Should(Equal("member"))
+			Expect(fanIn[0].GetCount()).Should(Equal(uint64(2)))
+
+			empty, err := dataReader.QueryRelationshipHotspots(ctx, "t2", storage.FanOut, token1.String(), 0)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(empty).Should(BeEmpty())
+		})
+	})
+
 	Context("Read Relationships", func() {
 		It("should write relationships and read relationships correctly", func() {
 			ctx := context.Background()
@@ -379,7 +512,7 @@ var _ = Describe("DataReader", func() {
 			token1, err := dataWriter.Write(ctx, "t1", tuples1, attributes1)
 			Expect(err).ShouldNot(HaveOccurred())
 
-			refs1, _, err := dataReader.QueryUniqueSubjectReferences(ctx, "t1", &base.RelationReference{
+			refs1, _, err := dataReader.QueryUniqueSubjectReferences(ctx, "t1", &base.SubjectFilter{
 				Type:     "user",
 				Relation: "",
 			}, []string{}, token1.String(), database.NewPagination())
@@ -388,7 +521,7 @@ var _ = Describe("DataReader", func() {
 
 			Expect(isSameArray(refs1, []string{"user-1", "user-2", "user-3", "user-5"})).Should(BeTrue())
 
-			refs4, ct4, err := dataReader.QueryUniqueSubjectReferences(ctx, "t1", &base.RelationReference{
+			refs4, ct4, err := dataReader.QueryUniqueSubjectReferences(ctx, "t1", &base.SubjectFilter{
 				Type:     "organization",
 				Relation: "member",
 			}, []string{}, token1.String(), database.NewPagination())
@@ -536,6 +669,81 @@ var _ = Describe("DataReader", func() {
 			Expect(it2.HasNext()).Should(BeTrue())
 		})
 
+		It("should filter attributes by predicates in QueryAttributes and ReadAttributes", func() {
+			ctx := context.Background()
+
+			var attributes []*base.Attribute
+			for _, a := range []string{
+				"organization:org-a$public|boolean:true",
+				"organization:org-a$balance|integer:3000",
+				"organization:org-b$public|boolean:true",
+				"organization:org-b$balance|integer:1000",
+				"organization:org-c$public|boolean:false",
+				"organization:org-c$balance|integer:5000",
+			} {
+				attr, err := attribute.Attribute(a)
+				Expect(err).ShouldNot(HaveOccurred())
+				attributes = append(attributes, attr)
+			}
+
+			_, err := dataWriter.Write(ctx, "t1", database.NewTupleCollection(), database.NewAttributeCollection(attributes...))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			public, err := anypb.New(&base.BooleanValue{Data: true})
+			Expect(err).ShouldNot(HaveOccurred())
+			balance, err := anypb.New(&base.IntegerValue{Data: 2000})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			predicates := []*base.AttributePredicate{
+				{Attribute: "public", Operator: base.AttributePredicate_OPERATOR_EQUAL, Value: public},
+				{Attribute: "balance", Operator: base.AttributePredicate_OPERATOR_GREATER_THAN, Value: balance},
+			}
+			filter := &base.AttributeFilter{
+				Entity:     &base.EntityFilter{Type: "organization", Predicates: predicates},
+				Attributes: []string{"public"},
+			}
+
+			it, err := dataReader.QueryAttributes(ctx, "t1", filter, "", database.NewCursorPagination(database.Sort("entity_id")))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(it.HasNext()).Should(BeTrue())
+			Expect(it.GetNext().GetEntity().GetId()).Should(Equal("org-a"))
+			Expect(it.HasNext()).Should(BeFalse())
+
+			collection, _, err := dataReader.ReadAttributes(ctx, "t1", filter, "", database.NewPagination(database.Size(10)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(collection.GetAttributes()).Should(HaveLen(1))
+			Expect(collection.GetAttributes()[0].GetEntity().GetId()).Should(Equal("org-a"))
+
+			var tuples []*base.Tuple
+			for _, t := range []string{
+				"repository:1#owner@organization:org-a",
+				"repository:2#owner@organization:org-b",
+				"repository:3#owner@organization:org-c",
+			} {
+				tup, err := tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, tup)
+			}
+
+			_, err = dataWriter.Write(ctx, "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			refs, _, err := dataReader.QueryUniqueSubjectReferences(ctx, "t1", &base.SubjectFilter{
+				Type:       "organization",
+				Predicates: predicates,
+			}, []string{}, "", database.NewPagination())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(refs).Should(Equal([]string{"org-a"}))
+
+			_, err = dataReader.QueryAttributes(ctx, "t1", &base.AttributeFilter{
+				Entity: &base.EntityFilter{Type: "organization", Predicates: []*base.AttributePredicate{
+					{Attribute: "public", Operator: base.AttributePredicate_OPERATOR_UNSPECIFIED, Value: public},
+				}},
+			}, "", database.NewCursorPagination())
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String()))
+		})
+
 		It("should handle limit in QueryAttributes", func() {
 			ctx := context.Background()
 
@@ -588,7 +796,7 @@ var _ = Describe("DataReader", func() {
 			Expect(err).ShouldNot(HaveOccurred())
 
 			// Test with excluded subjects
-			refs, _, err := dataReader.QueryUniqueSubjectReferences(ctx, "t1", &base.RelationReference{
+			refs, _, err := dataReader.QueryUniqueSubjectReferences(ctx, "t1", &base.SubjectFilter{
 				Type:     "user",
 				Relation: "",
 			}, []string{"user-2"}, "", database.NewPagination())
@@ -612,7 +820,7 @@ var _ = Describe("DataReader", func() {
 			Expect(err).ShouldNot(HaveOccurred())
 
 			// Test with page size
-			refs, ct, err := dataReader.QueryUniqueSubjectReferences(ctx, "t1", &base.RelationReference{
+			refs, ct, err := dataReader.QueryUniqueSubjectReferences(ctx, "t1", &base.SubjectFilter{
 				Type:     "user",
 				Relation: "",
 			}, []string{}, "", database.NewPagination(database.Size(3)))
@@ -629,6 +837,56 @@ var _ = Describe("DataReader", func() {
 			Expect(snapshot).ShouldNot(BeNil())
 		})
 
+		It("should only handle SnapshotAt after the last write", func() {
+			ctx := context.Background()
+
+			before := time.Now()
+
+			tup, err := tuple.Tuple("organization:org-1#admin@user:user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = dataWriter.Write(ctx, "t1", database.NewTupleCollection(tup), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = dataReader.SnapshotAt(ctx, "t1", before)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_HISTORY_UNAVAILABLE.String()))
+
+			snapshot, err := dataReader.SnapshotAt(ctx, "t1", time.Now())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(snapshot).ShouldNot(BeNil())
+		})
+
+		It("should list the stored tuples as active versions with their transactions in ReadRelationshipHistory", func() {
+			ctx := context.Background()
+
+			tup1, err := tuple.Tuple("organization:org-1#admin@user:user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+			tup2, err := tuple.Tuple("organization:org-1#admin@user:user-2")
+			Expect(err).ShouldNot(HaveOccurred())
+			metadata := &base.TransactionMetadata{Actor: "alice", Reason: "JIRA-1", Labels: map[string]string{"source": "test"}}
+			_, err = dataWriter.Write(ctx, "t1", database.NewTupleCollection(tup1, tup2), database.NewAttributeCollection(), storage.TransactionMetadata(metadata))
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = dataWriter.Delete(ctx, "t1", &base.TupleFilter{
+				Entity:   &base.EntityFilter{Type: "organization", Ids: []string{"org-1"}},
+				Relation: "admin",
+				Subject:  &base.SubjectFilter{Type: "user", Ids: []string{"user-2"}},
+			}, &base.AttributeFilter{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			records, _, err := dataReader.ReadRelationshipHistory(ctx, "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{Type: "organization"},
+			}, database.NewPagination(database.Size(10)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(records).Should(HaveLen(1))
+			Expect(tuple.ToString(records[0].GetTuple())).Should(Equal("organization:org-1#admin@user:user-1"))
+			Expect(records[0].GetExpiredTxId()).Should(Equal(uint64(0)))
+			Expect(records[0].GetCreatedTxId()).ShouldNot(Equal(uint64(0)))
+			Expect(records[0].GetCreatedAt()).ShouldNot(BeNil())
+			Expect(records[0].GetCreatedMetadata().GetActor()).Should(Equal("alice"))
+			Expect(records[0].GetCreatedMetadata().GetReason()).Should(Equal("JIRA-1"))
+			Expect(records[0].GetCreatedMetadata().GetLabels()).Should(HaveKeyWithValue("source", "test"))
+		})
+
 		It("should handle invalid token in ReadAttributes", func() {
 			ctx := context.Background()
 
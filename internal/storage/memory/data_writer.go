@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-memdb"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/Permify/permify/internal/schema"
 	"github.com/Permify/permify/internal/storage"
 	"github.com/Permify/permify/internal/storage/memory/constants" // Memory storage constants
 	"github.com/Permify/permify/internal/storage/memory/snapshot"
@@ -20,6 +22,8 @@ import (
 	"github.com/Permify/permify/pkg/tuple"
 )
 
+// DataWriter - Structure for the memory data writer. Every write records its changes and its transaction
+// metadata as a transaction of the tenant, kept for watch and history within the transaction retention.
 type DataWriter struct {
 	database *db.Memory
 }
@@ -32,8 +36,20 @@ func NewDataWriter(database *db.Memory) *DataWriter {
 }
 
 // WriteRelationships - Write a Relation to repository
-func (w *DataWriter) Write(_ context.Context, tenantID string, tupleCollection *database.TupleCollection, attributesCollection *database.AttributeCollection) (token.EncodedSnapToken, error) {
+func (w *DataWriter) Write(_ context.Context, tenantID string, tupleCollection *database.TupleCollection, attributesCollection *database.AttributeCollection, opts ...storage.WriteOption) (token.EncodedSnapToken, error) {
 	var err error
+	options := storage.NewWriteOptions(opts...)
+
+	txn := w.begin()
+	defer txn.Abort()
+
+	if tkn, ok, err := w.readIdempotencyKey(txn, tenantID, options); err != nil || ok {
+		return tkn, err
+	}
+
+	if err = w.checkPreconditions(txn, tenantID, options.GetPreconditions()); err != nil {
+		return nil, err
+	}
 
 	tupleIterator := tupleCollection.CreateTupleIterator()
 	attributeIterator := attributesCollection.CreateAttributeIterator()
@@ -41,8 +57,9 @@ func (w *DataWriter) Write(_ context.Context, tenantID string, tupleCollection *
 		return token.NewNoopToken().Encode(), nil
 	}
 
-	txn := w.database.DB.Txn(true)
-	defer txn.Abort()
+	if err = w.enforceCardinality(txn, tenantID, options.GetSchemaVersion(), tupleCollection, database.NewTupleCollection()); err != nil {
+		return nil, err
+	}
 
 	for tupleIterator.HasNext() {
 		bt := tupleIterator.GetNext()
@@ -78,16 +95,24 @@ func (w *DataWriter) Write(_ context.Context, tenantID string, tupleCollection *
 		}
 	}
 
-	txn.Commit()
-	return snapshot.NewToken(time.Now()).Encode(), nil
+	return w.commit(txn, tenantID, options)
 }
 
 // Delete - Delete relationship from repository
-func (w *DataWriter) Delete(_ context.Context, tenantID string, tupleFilter *base.TupleFilter, attributeFilter *base.AttributeFilter) (token.EncodedSnapToken, error) {
+func (w *DataWriter) Delete(_ context.Context, tenantID string, tupleFilter *base.TupleFilter, attributeFilter *base.AttributeFilter, opts ...storage.WriteOption) (token.EncodedSnapToken, error) {
 	var err error
-	txn := w.database.DB.Txn(true)
+	options := storage.NewWriteOptions(opts...)
+	txn := w.begin()
 	defer txn.Abort()
 
+	if tkn, ok, err := w.readIdempotencyKey(txn, tenantID, options); err != nil || ok {
+		return tkn, err
+	}
+
+	if err = w.checkPreconditions(txn, tenantID, options.GetPreconditions()); err != nil {
+		return nil, err
+	}
+
 	if !validation.IsTupleFilterEmpty(tupleFilter) {
 		tIndex, tArgs := utils.GetRelationTuplesIndexNameAndArgsByFilters(tenantID, tupleFilter)
 		var tit memdb.ResultIterator
@@ -130,8 +155,7 @@ func (w *DataWriter) Delete(_ context.Context, tenantID string, tupleFilter *bas
 		}
 	}
 
-	txn.Commit()
-	return snapshot.NewToken(time.Now()).Encode(), nil
+	return w.commit(txn, tenantID, options)
 }
 
 // RunBundle executes a bundle of operations in the context of a given tenant.
@@ -141,25 +165,136 @@ func (w *DataWriter) RunBundle(
 	tenantID string,
 	arguments map[string]string,
 	b *base.DataBundle,
+	opts ...storage.WriteOption,
 ) (token.EncodedSnapToken, error) {
-	txn := w.database.DB.Txn(true)
+	options := storage.NewWriteOptions(opts...)
+	txn := w.begin()
 	defer txn.Abort()
 
+	if tkn, ok, err := w.readIdempotencyKey(txn, tenantID, options); err != nil || ok {
+		return tkn, err
+	}
+
+	if err := w.checkPreconditions(txn, tenantID, options.GetPreconditions()); err != nil {
+		return nil, err
+	}
+
+	tbs := make([]database.TupleBundle, 0, len(b.GetOperations()))
+	abs := make([]database.AttributeBundle, 0, len(b.GetOperations()))
+	writes := database.NewTupleCollection()
+	deletes := database.NewTupleCollection()
+
 	for _, op := range b.GetOperations() {
 		tb, ab, err := bundle.Operation(arguments, op)
 		if err != nil {
 			return nil, err
 		}
 
-		err = w.runOperation(ctx, txn, tenantID, tb, ab)
+		for _, t := range tb.Write.GetTuples() {
+			writes.Add(t)
+		}
+		for _, t := range tb.Delete.GetTuples() {
+			deletes.Add(t)
+		}
+		tbs = append(tbs, tb)
+		abs = append(abs, ab)
+	}
+
+	// Cardinality is checked against the bundle as a whole, so an operation may
+	// delete the current subject of a single relation and write a new one.
+	if err := w.enforceCardinality(txn, tenantID, options.GetSchemaVersion(), writes, deletes); err != nil {
+		return nil, err
+	}
+
+	for i := range tbs {
+		err := w.runOperation(ctx, txn, tenantID, tbs[i], abs[i])
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	txn.Commit()
+	return w.commit(txn, tenantID, options)
+}
+
+// Remove deletes exactly the given tuples and attributes of a tenant, moving them to the quarantine tenant when one is given
+func (w *DataWriter) Remove(
+	_ context.Context,
+	tenantID string,
+	tupleCollection *database.TupleCollection,
+	attributeCollection *database.AttributeCollection,
+	quarantineTenantID string,
+	opts ...storage.WriteOption,
+) (token.EncodedSnapToken, error) {
+	options := storage.NewWriteOptions(opts...)
+	txn := w.begin()
+	defer txn.Abort()
+
+	if tkn, ok, err := w.readIdempotencyKey(txn, tenantID, options); err != nil || ok {
+		return tkn, err
+	}
 
-	return snapshot.NewToken(time.Now()).Encode(), nil
+	if err := w.checkPreconditions(txn, tenantID, options.GetPreconditions()); err != nil {
+		return nil, err
+	}
+
+	for _, t := range tupleCollection.GetTuples() {
+		if err := deleteTuple(txn, tenantID, t); err != nil {
+			return nil, err
+		}
+	}
+	for _, a := range attributeCollection.GetAttributes() {
+		if err := deleteAttribute(txn, tenantID, a); err != nil {
+			return nil, err
+		}
+	}
+
+	if quarantineTenantID == "" {
+		return w.commit(txn, tenantID, options)
+	}
+
+	for _, t := range tupleCollection.GetTuples() {
+		exists, err := tupleExists(txn, quarantineTenantID, t)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			continue
+		}
+		srelation := t.GetSubject().GetRelation()
+		if srelation == tuple.ELLIPSIS {
+			srelation = ""
+		}
+		if err = txn.Insert(constants.RelationTuplesTable, storage.RelationTuple{
+			ID:              w.database.RelationTupleID(),
+			TenantID:        quarantineTenantID,
+			EntityType:      t.GetEntity().GetType(),
+			EntityID:        t.GetEntity().GetId(),
+			Relation:        t.GetRelation(),
+			SubjectType:     t.GetSubject().GetType(),
+			SubjectID:       t.GetSubject().GetId(),
+			SubjectRelation: srelation,
+		}); err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+	}
+	for _, a := range attributeCollection.GetAttributes() {
+		// A quarantined attribute replaces the one quarantined earlier for the same entity
+		if err := deleteAttribute(txn, quarantineTenantID, a); err != nil {
+			return nil, err
+		}
+		if err := txn.Insert(constants.AttributesTable, storage.Attribute{
+			ID:         w.database.AttributeID(),
+			TenantID:   quarantineTenantID,
+			EntityType: a.GetEntity().GetType(),
+			EntityID:   a.GetEntity().GetId(),
+			Attribute:  a.GetAttribute(),
+			Value:      a.GetValue(),
+		}); err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+	}
+
+	return w.commit(txn, tenantID, options, quarantineTenantID)
 }
 
 // runOperation processes and executes database operations defined in TupleBundle and AttributeBundle within a given transaction.
@@ -281,3 +416,457 @@ func (w *DataWriter) runOperation(
 
 	return nil
 }
+
+// enforceCardinality checks the written tuples against the single cardinality relations of the given schema version,
+// the head version when it is empty. Subjects already assigned to the same entity and relation either reject the write
+// or, when the relation is declared with the replace modifier, are deleted within the transaction. Deleted tuples are
+// not counted.
+func (w *DataWriter) enforceCardinality(txn *memdb.Txn, tenantID, version string, writes, deletes *database.TupleCollection) error {
+	if len(writes.GetTuples()) == 0 {
+		return nil
+	}
+
+	if version == "" {
+		var ok bool
+		mu.Lock()
+		version, ok = headVersion[tenantID]
+		mu.Unlock()
+		if !ok {
+			return nil
+		}
+	}
+
+	definitions := map[string]*base.EntityDefinition{}
+	for _, t := range writes.GetTuples() {
+		name := t.GetEntity().GetType()
+		if _, ok := definitions[name]; ok {
+			continue
+		}
+
+		raw, err := txn.First(constants.SchemaDefinitionsTable, "id", tenantID, name, version)
+		if err != nil {
+			return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+		if raw == nil {
+			continue
+		}
+
+		sch, err := schema.NewSchemaFromStringDefinitions(false, raw.(storage.SchemaDefinition).Serialized())
+		if err != nil {
+			return err
+		}
+
+		definition, err := schema.GetEntityByName(sch, name)
+		if err != nil {
+			continue
+		}
+		definitions[name] = definition
+	}
+
+	deleted := map[string]struct{}{}
+	for _, t := range deletes.GetTuples() {
+		deleted[tuple.ToString(t)] = struct{}{}
+	}
+
+	tuples := make([]*base.Tuple, 0, len(writes.GetTuples()))
+	for _, t := range writes.GetTuples() {
+		if _, ok := deleted[tuple.ToString(t)]; !ok {
+			tuples = append(tuples, t)
+		}
+	}
+
+	if err := validation.ValidateCardinality(definitions, tuples); err != nil {
+		return err
+	}
+
+	var replaced []storage.RelationTuple
+	checked := map[string]struct{}{}
+
+	for _, t := range tuples {
+		definition, ok := definitions[t.GetEntity().GetType()]
+		if !ok {
+			continue
+		}
+
+		rel, err := schema.GetRelationByNameInEntityDefinition(definition, t.GetRelation())
+		if err != nil || rel.GetCardinality() != base.RelationDefinition_CARDINALITY_SINGLE {
+			continue
+		}
+
+		key := tuple.EntityAndRelationToString(t.GetEntity(), t.GetRelation())
+		if _, ok := checked[key]; ok {
+			continue
+		}
+		checked[key] = struct{}{}
+
+		it, err := txn.Get(constants.RelationTuplesTable, "entity-index", tenantID, t.GetEntity().GetType(), t.GetEntity().GetId(), t.GetRelation())
+		if err != nil {
+			return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+
+		for obj := it.Next(); obj != nil; obj = it.Next() {
+			existing, ok := obj.(storage.RelationTuple)
+			if !ok {
+				return errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+			}
+
+			current := existing.ToTuple()
+			if tuple.AreSubjectsEqual(current.GetSubject(), t.GetSubject()) {
+				continue
+			}
+
+			if _, ok := deleted[tuple.ToString(current)]; ok {
+				continue
+			}
+
+			if rel.GetOnConflict() != base.RelationDefinition_ON_CONFLICT_REPLACE {
+				return errors.New(base.ErrorCode_ERROR_CODE_CARDINALITY_VIOLATION.String())
+			}
+
+			replaced = append(replaced, existing)
+		}
+	}
+
+	for _, t := range replaced {
+		if err := txn.Delete(constants.RelationTuplesTable, t); err != nil {
+			return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+	}
+
+	return nil
+}
+
+// DataImport - Bulk load of data, staged in memory and written on commit
+type DataImport struct {
+	writer     *DataWriter
+	tenantID   string
+	tuples     *database.TupleCollection
+	attributes *database.AttributeCollection
+}
+
+// Import - Start a bulk load of data
+func (w *DataWriter) Import(_ context.Context, tenantID string) (storage.DataImport, error) {
+	return &DataImport{
+		writer:     w,
+		tenantID:   tenantID,
+		tuples:     database.NewTupleCollection(),
+		attributes: database.NewAttributeCollection(),
+	}, nil
+}
+
+// Load - Stage tuples and attributes until the next commit
+func (i *DataImport) Load(_ context.Context, tuples []*base.Tuple, attributes []*base.Attribute) error {
+	for _, t := range tuples {
+		i.tuples.Add(t)
+	}
+	for _, a := range attributes {
+		i.attributes.Add(a)
+	}
+	return nil
+}
+
+// Commit - Write the staged tuples and attributes
+func (i *DataImport) Commit(ctx context.Context) (token.EncodedSnapToken, error) {
+	tuples, attributes := i.tuples, i.attributes
+	i.tuples, i.attributes = database.NewTupleCollection(), database.NewAttributeCollection()
+	return i.writer.Write(ctx, i.tenantID, tuples, attributes)
+}
+
+// Close - Discard the staged tuples and attributes
+func (i *DataImport) Close(_ context.Context) error {
+	i.tuples, i.attributes = database.NewTupleCollection(), database.NewAttributeCollection()
+	return nil
+}
+
+// begin - Start a write transaction that tracks its changes, so that commit can record them
+func (w *DataWriter) begin() *memdb.Txn {
+	txn := w.database.DB.Txn(true)
+	txn.TrackChanges()
+	return txn
+}
+
+// commit - Commit a write transaction, recording its snap token under its idempotency key if it has one,
+// its changes as a transaction of the tenants they were made in, and its snapshot as the head snapshot
+// of the tenant and of the other tenants the transaction wrote into
+func (w *DataWriter) commit(txn *memdb.Txn, tenantID string, options storage.WriteOptions, others ...string) (token.EncodedSnapToken, error) {
+	now := time.Now()
+	snap := snapshot.NewToken(now)
+	encoded := snap.Encode()
+	if err := w.recordTransactions(txn, tenantID, snap.(snapshot.Token).Value, encoded.String(), options.GetTransactionMetadata(), now); err != nil {
+		return nil, err
+	}
+	if options.GetIdempotencyKey() != "" {
+		if err := w.deleteExpiredIdempotencyKeys(txn, tenantID, now); err != nil {
+			return nil, err
+		}
+		if err := txn.Insert(constants.IdempotencyKeysTable, storage.IdempotentWrite{
+			TenantID:    tenantID,
+			Key:         options.GetIdempotencyKey(),
+			RequestHash: options.GetRequestHash(),
+			SnapToken:   encoded.String(),
+			CreatedAt:   now,
+		}); err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+	}
+	w.database.SetHead(snap.(snapshot.Token).Value, append([]string{tenantID}, others...)...)
+	txn.Commit()
+	return encoded, nil
+}
+
+// recordTransactions - Record the tuple and attribute changes of a write transaction per tenant, always including
+// the tenant of the write, after deleting the transactions of those tenants that are past the retention
+func (w *DataWriter) recordTransactions(txn *memdb.Txn, tenantID string, id uint64, snapToken string, metadata *base.TransactionMetadata, now time.Time) error {
+	changes := map[string]*base.DataChanges{
+		tenantID: {SnapToken: snapToken, Metadata: metadata},
+	}
+	add := func(tenant string, change *base.DataChange) {
+		if _, ok := changes[tenant]; !ok {
+			changes[tenant] = &base.DataChanges{SnapToken: snapToken, Metadata: metadata}
+		}
+		changes[tenant].DataChanges = append(changes[tenant].DataChanges, change)
+	}
+
+	for _, change := range txn.Changes() {
+		switch change.Table {
+		case constants.RelationTuplesTable:
+			before, _ := change.Before.(storage.RelationTuple)
+			after, _ := change.After.(storage.RelationTuple)
+			if change.Updated() && proto.Equal(before.ToTuple(), after.ToTuple()) {
+				continue
+			}
+			if !change.Created() {
+				add(before.TenantID, &base.DataChange{
+					Operation: base.DataChange_OPERATION_DELETE,
+					Type:      &base.DataChange_Tuple{Tuple: before.ToTuple()},
+				})
+			}
+			if !change.Deleted() {
+				add(after.TenantID, &base.DataChange{
+					Operation: base.DataChange_OPERATION_CREATE,
+					Type:      &base.DataChange_Tuple{Tuple: after.ToTuple()},
+				})
+			}
+		case constants.AttributesTable:
+			before, _ := change.Before.(storage.Attribute)
+			after, _ := change.After.(storage.Attribute)
+			if change.Updated() && proto.Equal(before.ToAttribute(), after.ToAttribute()) {
+				continue
+			}
+			if !change.Created() {
+				add(before.TenantID, &base.DataChange{
+					Operation: base.DataChange_OPERATION_DELETE,
+					Type:      &base.DataChange_Attribute{Attribute: before.ToAttribute()},
+				})
+			}
+			if !change.Deleted() {
+				add(after.TenantID, &base.DataChange{
+					Operation: base.DataChange_OPERATION_CREATE,
+					Type:      &base.DataChange_Attribute{Attribute: after.ToAttribute()},
+				})
+			}
+		}
+	}
+
+	for tenant, c := range changes {
+		if err := w.deleteExpiredTransactions(txn, tenant, now); err != nil {
+			return err
+		}
+		if err := txn.Insert(constants.TransactionsTable, storage.Transaction{
+			TenantID:  tenant,
+			ID:        id,
+			Changes:   c,
+			CreatedAt: now,
+		}); err != nil {
+			return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+	}
+	return nil
+}
+
+// deleteExpiredTransactions - Delete the transactions of a tenant that are past the retention, oldest first
+func (w *DataWriter) deleteExpiredTransactions(txn *memdb.Txn, tenantID string, now time.Time) error {
+	it, err := txn.LowerBound(constants.TransactionsTable, "id", tenantID, uint64(0))
+	if err != nil {
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	var expired []storage.Transaction
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		tx, ok := obj.(storage.Transaction)
+		if !ok || tx.TenantID != tenantID || now.Sub(tx.CreatedAt) < w.database.GetTransactionRetention() {
+			break
+		}
+		expired = append(expired, tx)
+	}
+	for _, tx := range expired {
+		if err = txn.Delete(constants.TransactionsTable, tx); err != nil {
+			return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+	}
+	return nil
+}
+
+// readIdempotencyKey - Get the snap token recorded under the idempotency key of a write within the retention window, if any.
+// A key recorded for another request is rejected
+func (w *DataWriter) readIdempotencyKey(txn *memdb.Txn, tenantID string, options storage.WriteOptions) (token.EncodedSnapToken, bool, error) {
+	if options.GetIdempotencyKey() == "" {
+		return nil, false, nil
+	}
+	raw, err := txn.First(constants.IdempotencyKeysTable, "id", tenantID, options.GetIdempotencyKey())
+	if err != nil || raw == nil {
+		return nil, false, nil
+	}
+	key, ok := raw.(storage.IdempotentWrite)
+	if !ok || time.Since(key.CreatedAt) >= w.database.GetIdempotencyKeyRetention() {
+		return nil, false, nil
+	}
+	if key.RequestHash != options.GetRequestHash() {
+		return nil, false, errors.New(base.ErrorCode_ERROR_CODE_IDEMPOTENCY_KEY_REUSED.String())
+	}
+	return snapshot.EncodedToken{Value: key.SnapToken}, true, nil
+}
+
+// deleteExpiredIdempotencyKeys - Delete the idempotency keys of a tenant that are past the retention window
+func (w *DataWriter) deleteExpiredIdempotencyKeys(txn *memdb.Txn, tenantID string, now time.Time) error {
+	it, err := txn.Get(constants.IdempotencyKeysTable, "tenant_id", tenantID)
+	if err != nil {
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	var expired []storage.IdempotentWrite
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		if key, ok := obj.(storage.IdempotentWrite); ok && now.Sub(key.CreatedAt) >= w.database.GetIdempotencyKeyRetention() {
+			expired = append(expired, key)
+		}
+	}
+	for _, key := range expired {
+		if err = txn.Delete(constants.IdempotencyKeysTable, key); err != nil {
+			return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+	}
+	return nil
+}
+
+// checkPreconditions - Evaluate the preconditions of a write in its transaction
+func (w *DataWriter) checkPreconditions(txn *memdb.Txn, tenantID string, preconditions []*base.Precondition) error {
+	for _, precondition := range preconditions {
+		var holds bool
+		var err error
+		switch p := precondition.GetType().(type) {
+		case *base.Precondition_TupleExists:
+			holds, err = tupleExists(txn, tenantID, p.TupleExists)
+		case *base.Precondition_TupleNotExists:
+			holds, err = tupleExists(txn, tenantID, p.TupleNotExists)
+			holds = !holds
+		case *base.Precondition_FilterMatchesNone:
+			holds, err = tuplesMatch(txn, tenantID, p.FilterMatchesNone, func(storage.RelationTuple) bool { return true })
+			holds = !holds
+		case *base.Precondition_HeadSnapToken:
+			var decoded token.SnapToken
+			decoded, err = snapshot.EncodedToken{Value: p.HeadSnapToken}.Decode()
+			since, ok := decoded.(snapshot.Token)
+			if err != nil || !ok {
+				return errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+			}
+			holds = w.database.GetHead(tenantID) <= since.Value
+		default:
+			return errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+		}
+		if err != nil {
+			return err
+		}
+		if !holds {
+			return errors.New(base.ErrorCode_ERROR_CODE_FAILED_PRECONDITION.String())
+		}
+	}
+	return nil
+}
+
+// tupleExists - Check if a tuple is stored
+func tupleExists(txn *memdb.Txn, tenantID string, t *base.Tuple) (bool, error) {
+	srelation := t.GetSubject().GetRelation()
+	if srelation == tuple.ELLIPSIS {
+		srelation = ""
+	}
+	filter := &base.TupleFilter{
+		Entity:   &base.EntityFilter{Type: t.GetEntity().GetType(), Ids: []string{t.GetEntity().GetId()}},
+		Relation: t.GetRelation(),
+		Subject:  &base.SubjectFilter{Type: t.GetSubject().GetType(), Ids: []string{t.GetSubject().GetId()}},
+	}
+	return tuplesMatch(txn, tenantID, filter, func(rt storage.RelationTuple) bool {
+		return rt.SubjectRelation == srelation
+	})
+}
+
+// deleteTuple - Delete a stored tuple, matching all of its fields including an empty subject relation
+func deleteTuple(txn *memdb.Txn, tenantID string, t *base.Tuple) error {
+	srelation := t.GetSubject().GetRelation()
+	if srelation == tuple.ELLIPSIS {
+		srelation = ""
+	}
+	filter := &base.TupleFilter{
+		Entity:   &base.EntityFilter{Type: t.GetEntity().GetType(), Ids: []string{t.GetEntity().GetId()}},
+		Relation: t.GetRelation(),
+		Subject:  &base.SubjectFilter{Type: t.GetSubject().GetType(), Ids: []string{t.GetSubject().GetId()}},
+	}
+	index, args := utils.GetRelationTuplesIndexNameAndArgsByFilters(tenantID, filter)
+	it, err := txn.Get(constants.RelationTuplesTable, index, args...)
+	if err != nil {
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	var matches []storage.RelationTuple
+	fit := memdb.NewFilterIterator(it, utils.FilterRelationTuplesQuery(tenantID, filter))
+	for obj := fit.Next(); obj != nil; obj = fit.Next() {
+		if rt, ok := obj.(storage.RelationTuple); ok && rt.SubjectRelation == srelation {
+			matches = append(matches, rt)
+		}
+	}
+	for _, rt := range matches {
+		if err = txn.Delete(constants.RelationTuplesTable, rt); err != nil {
+			return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+	}
+	return nil
+}
+
+// deleteAttribute - Delete the stored attribute of the same entity and name as an attribute
+func deleteAttribute(txn *memdb.Txn, tenantID string, a *base.Attribute) error {
+	filter := &base.AttributeFilter{
+		Entity:     &base.EntityFilter{Type: a.GetEntity().GetType(), Ids: []string{a.GetEntity().GetId()}},
+		Attributes: []string{a.GetAttribute()},
+	}
+	index, args := utils.GetAttributesIndexNameAndArgsByFilters(tenantID, filter)
+	it, err := txn.Get(constants.AttributesTable, index, args...)
+	if err != nil {
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	var matches []storage.Attribute
+	fit := memdb.NewFilterIterator(it, utils.FilterAttributesQuery(tenantID, filter))
+	for obj := fit.Next(); obj != nil; obj = fit.Next() {
+		if at, ok := obj.(storage.Attribute); ok {
+			matches = append(matches, at)
+		}
+	}
+	for _, at := range matches {
+		if err = txn.Delete(constants.AttributesTable, at); err != nil {
+			return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+	}
+	return nil
+}
+
+// tuplesMatch - Check if a stored tuple matches the filter and the match function
+func tuplesMatch(txn *memdb.Txn, tenantID string, filter *base.TupleFilter, match func(storage.RelationTuple) bool) (bool, error) {
+	index, args := utils.GetRelationTuplesIndexNameAndArgsByFilters(tenantID, filter)
+	it, err := txn.Get(constants.RelationTuplesTable, index, args...)
+	if err != nil {
+		return false, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	fit := memdb.NewFilterIterator(it, utils.FilterRelationTuplesQuery(tenantID, filter))
+	for obj := fit.Next(); obj != nil; obj = fit.Next() {
+		t, ok := obj.(storage.RelationTuple)
+		if ok && match(t) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
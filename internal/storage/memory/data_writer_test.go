@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/memory/constants"
 	"github.com/Permify/permify/internal/storage/memory/migrations"
 	"github.com/Permify/permify/pkg/attribute"
 	"github.com/Permify/permify/pkg/database"
@@ -393,4 +394,327 @@ var _ = Describe("DataWriter", func() {
 			Expect(attr).ShouldNot(BeNil())
 		})
 	})
+
+	Context("Cardinality", func() {
+		var schemaWriter *SchemaWriter
+
+		BeforeEach(func() {
+			schemaWriter = NewSchemaWriter(db)
+
+			err := schemaWriter.WriteSchema(context.Background(), []storage.SchemaDefinition{
+				{TenantID: "cardinality", Name: "user", SerializedDefinition: []byte("entity user {}"), Version: "v1"},
+				{TenantID: "cardinality", Name: "folder", SerializedDefinition: []byte("entity folder {}"), Version: "v1"},
+				{TenantID: "cardinality", Name: "document", SerializedDefinition: []byte("entity document { relation parent @folder single relation owner @user single replace relation viewer @user }"), Version: "v1"},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		subjectsOf := func(ctx context.Context, documentID, relation string) []string {
+			it, err := dataReader.QueryRelationships(ctx, "cardinality", &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "document",
+					Ids:  []string{documentID},
+				},
+				Relation: relation,
+			}, "", database.NewCursorPagination())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var subjects []string
+			for it.HasNext() {
+				subjects = append(subjects, tuple.SubjectToString(it.GetNext().GetSubject()))
+			}
+			return subjects
+		}
+
+		write := func(ctx context.Context, tuples ...string) error {
+			collection := database.NewTupleCollection()
+			for _, t := range tuples {
+				tup, err := tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				collection.Add(tup)
+			}
+			_, err := dataWriter.Write(ctx, "cardinality", collection, database.NewAttributeCollection())
+			return err
+		}
+
+		It("should reject a second subject on a single relation", func() {
+			ctx := context.Background()
+
+			Expect(write(ctx, "document:1#parent@folder:1")).ShouldNot(HaveOccurred())
+			Expect(write(ctx, "document:1#parent@folder:1")).ShouldNot(HaveOccurred())
+
+			err := write(ctx, "document:1#parent@folder:2")
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_CARDINALITY_VIOLATION.String()))
+
+			err = write(ctx, "document:2#parent@folder:1", "document:2#parent@folder:2")
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_CARDINALITY_VIOLATION.String()))
+
+			Expect(subjectsOf(ctx, "1", "parent")).Should(Equal([]string{"folder:1"}))
+			Expect(subjectsOf(ctx, "2", "parent")).Should(BeEmpty())
+
+			Expect(write(ctx, "document:1#viewer@user:1", "document:1#viewer@user:2")).ShouldNot(HaveOccurred())
+			Expect(subjectsOf(ctx, "1", "viewer")).Should(ConsistOf("user:1", "user:2"))
+		})
+
+		It("should enforce single relations against the schema version of the write", func() {
+			ctx := context.Background()
+
+			err := schemaWriter.WriteSchema(ctx, []storage.SchemaDefinition{
+				{TenantID: "cardinality", Name: "user", SerializedDefinition: []byte("entity user {}"), Version: "v2"},
+				{TenantID: "cardinality", Name: "folder", SerializedDefinition: []byte("entity folder {}"), Version: "v2"},
+				{TenantID: "cardinality", Name: "document", SerializedDefinition: []byte("entity document { relation parent @folder relation owner @user single replace relation viewer @user }"), Version: "v2"},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			collection := database.NewTupleCollection()
+			for _, t := range []string{"document:1#parent@folder:1", "document:1#parent@folder:2"} {
+				tup, err := tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				collection.Add(tup)
+			}
+
+			_, err = dataWriter.Write(ctx, "cardinality", collection, database.NewAttributeCollection(), storage.SchemaVersion("v1"))
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_CARDINALITY_VIOLATION.String()))
+			Expect(subjectsOf(ctx, "1", "parent")).Should(BeEmpty())
+
+			_, err = dataWriter.Write(ctx, "cardinality", collection, database.NewAttributeCollection(), storage.SchemaVersion("v2"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(subjectsOf(ctx, "1", "parent")).Should(ConsistOf("folder:1", "folder:2"))
+		})
+
+		It("should replace the subject of a single relation declared with replace", func() {
+			ctx := context.Background()
+
+			Expect(write(ctx, "document:1#owner@user:1")).ShouldNot(HaveOccurred())
+			Expect(write(ctx, "document:1#owner@user:2")).ShouldNot(HaveOccurred())
+
+			Expect(subjectsOf(ctx, "1", "owner")).Should(Equal([]string{"user:2"}))
+		})
+
+		It("should allow a bundle to move a single relation to a new subject", func() {
+			ctx := context.Background()
+
+			Expect(write(ctx, "document:1#parent@folder:1")).ShouldNot(HaveOccurred())
+
+			_, err := dataWriter.RunBundle(ctx, "cardinality", map[string]string{}, &base.DataBundle{
+				Name: "move_document",
+				Operations: []*base.Operation{
+					{
+						RelationshipsDelete: []string{"document:1#parent@folder:1"},
+						RelationshipsWrite:  []string{"document:1#parent@folder:2"},
+					},
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(subjectsOf(ctx, "1", "parent")).Should(Equal([]string{"folder:2"}))
+
+			_, err = dataWriter.RunBundle(ctx, "cardinality", map[string]string{}, &base.DataBundle{
+				Name: "move_document",
+				Operations: []*base.Operation{
+					{
+						RelationshipsWrite: []string{"document:1#parent@folder:3"},
+					},
+				},
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_CARDINALITY_VIOLATION.String()))
+		})
+	})
+
+	Context("Preconditions", func() {
+		tuplesOf := func(tuples ...string) *database.TupleCollection {
+			collection := database.NewTupleCollection()
+			for _, t := range tuples {
+				tup, err := tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				collection.Add(tup)
+			}
+			return collection
+		}
+
+		failedPrecondition := func(err error) {
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_FAILED_PRECONDITION.String()))
+		}
+
+		It("should write only if the tuple conditions hold", func() {
+			ctx := context.Background()
+
+			owner, err := tuple.Tuple("document:1#owner@user:1")
+			Expect(err).ShouldNot(HaveOccurred())
+			noOwner := &base.Precondition{Type: &base.Precondition_FilterMatchesNone{FilterMatchesNone: &base.TupleFilter{
+				Entity:   &base.EntityFilter{Type: "document", Ids: []string{"1"}},
+				Relation: "owner",
+			}}}
+
+			_, err = dataWriter.Write(ctx, "t1", tuplesOf("document:1#owner@user:1"), database.NewAttributeCollection(), storage.Preconditions(noOwner))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = dataWriter.Write(ctx, "t1", tuplesOf("document:1#owner@user:2"), database.NewAttributeCollection(), storage.Preconditions(noOwner))
+			failedPrecondition(err)
+
+			_, err = dataWriter.Write(ctx, "t1", tuplesOf("document:1#viewer@user:2"), database.NewAttributeCollection(),
+				storage.Preconditions(&base.Precondition{Type: &base.Precondition_TupleExists{TupleExists: owner}}))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = dataWriter.Delete(ctx, "t1", &base.TupleFilter{
+				Entity:   &base.EntityFilter{Type: "document", Ids: []string{"1"}},
+				Relation: "viewer",
+			}, &base.AttributeFilter{}, storage.Preconditions(&base.Precondition{Type: &base.Precondition_TupleNotExists{TupleNotExists: owner}}))
+			failedPrecondition(err)
+
+			it, err := dataReader.QueryRelationships(ctx, "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{Type: "document", Ids: []string{"1"}},
+			}, "", database.NewCursorPagination())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var stored []string
+			for it.HasNext() {
+				stored = append(stored, tuple.ToString(it.GetNext()))
+			}
+			Expect(stored).Should(ConsistOf("document:1#owner@user:1", "document:1#viewer@user:2"))
+		})
+
+		It("should apply a bundle only if nothing was written since the snap token", func() {
+			ctx := context.Background()
+
+			token1, err := dataWriter.Write(ctx, "t1", tuplesOf("document:1#owner@user:1"), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			unchanged := &base.Precondition{Type: &base.Precondition_HeadSnapToken{HeadSnapToken: token1.String()}}
+			bundle := &base.DataBundle{
+				Name:       "share",
+				Operations: []*base.Operation{{RelationshipsWrite: []string{"document:1#viewer@user:2"}}},
+			}
+
+			_, err = dataWriter.RunBundle(ctx, "t1", map[string]string{}, bundle, storage.Preconditions(unchanged))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// the bundle itself moved the head past the snap token
+			_, err = dataWriter.RunBundle(ctx, "t1", map[string]string{}, bundle, storage.Preconditions(unchanged))
+			failedPrecondition(err)
+
+			_, err = dataWriter.Write(ctx, "t1", tuplesOf("document:1#viewer@user:3"), database.NewAttributeCollection(),
+				storage.Preconditions(&base.Precondition{Type: &base.Precondition_HeadSnapToken{HeadSnapToken: "not a token"}}))
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String()))
+		})
+
+		It("should keep the head snapshots of every database apart", func() {
+			ctx := context.Background()
+
+			token1, err := dataWriter.Write(ctx, "t1", tuplesOf("document:1#owner@user:1"), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			other, err := memory.New(migrations.Schema)
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = NewDataWriter(other).Write(ctx, "t1", tuplesOf("document:1#owner@user:2"), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// the write to the other database does not move the head of this one
+			unchanged := &base.Precondition{Type: &base.Precondition_HeadSnapToken{HeadSnapToken: token1.String()}}
+			_, err = dataWriter.Write(ctx, "t1", tuplesOf("document:1#viewer@user:3"), database.NewAttributeCollection(), storage.Preconditions(unchanged))
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+	})
+
+	Context("Idempotency Keys", func() {
+		It("should return the snap token of the original write on retry", func() {
+			ctx := context.Background()
+
+			tup, err := tuple.Tuple("document:1#owner@user:1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			token1, err := dataWriter.Write(ctx, "t1", database.NewTupleCollection(tup), database.NewAttributeCollection(), storage.IdempotencyKey("k1", "h1"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = dataWriter.Delete(ctx, "t1", &base.TupleFilter{Entity: &base.EntityFilter{Type: "document", Ids: []string{"1"}}}, &base.AttributeFilter{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// the retry is not applied again, so the deleted tuple stays deleted
+			token2, err := dataWriter.Write(ctx, "t1", database.NewTupleCollection(tup), database.NewAttributeCollection(), storage.IdempotencyKey("k1", "h1"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(token2.String()).Should(Equal(token1.String()))
+
+			it, err := dataReader.QueryRelationships(ctx, "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{Type: "document", Ids: []string{"1"}},
+			}, "", database.NewCursorPagination())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(it.HasNext()).Should(BeFalse())
+
+			// keys are scoped to the tenant
+			token3, err := dataWriter.Write(ctx, "t2", database.NewTupleCollection(tup), database.NewAttributeCollection(), storage.IdempotencyKey("k1", "h1"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(token3.String()).ShouldNot(Equal(token1.String()))
+
+			// a key cannot be reused for another request
+			_, err = dataWriter.Write(ctx, "t1", database.NewTupleCollection(tup), database.NewAttributeCollection(), storage.IdempotencyKey("k1", "h2"))
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_IDEMPOTENCY_KEY_REUSED.String()))
+		})
+
+		It("should apply the write again once the key is past its retention", func() {
+			ctx := context.Background()
+
+			expiring, err := memory.New(migrations.Schema, memory.IdempotencyKeyRetention(0))
+			Expect(err).ShouldNot(HaveOccurred())
+			writer := NewDataWriter(expiring)
+
+			bundle := &base.DataBundle{
+				Name:       "share",
+				Operations: []*base.Operation{{RelationshipsWrite: []string{"document:1#viewer@user:2"}}},
+			}
+
+			token1, err := writer.RunBundle(ctx, "t1", map[string]string{}, bundle, storage.IdempotencyKey("k1", "h1"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			token2, err := writer.RunBundle(ctx, "t1", map[string]string{}, bundle, storage.IdempotencyKey("k1", "h1"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(token2.String()).ShouldNot(Equal(token1.String()))
+
+			// expired keys are collected on the next idempotent write of the tenant
+			_, err = writer.RunBundle(ctx, "t1", map[string]string{}, bundle, storage.IdempotencyKey("k2", "h2"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			it, err := expiring.DB.Txn(false).Get(constants.IdempotencyKeysTable, "tenant_id", "t1")
+			Expect(err).ShouldNot(HaveOccurred())
+			var keys []string
+			for obj := it.Next(); obj != nil; obj = it.Next() {
+				keys = append(keys, obj.(storage.IdempotentWrite).Key)
+			}
+			Expect(keys).Should(Equal([]string{"k2"}))
+		})
+	})
+
+	Context("Remove", func() {
+		It("should remove exactly the given tuples and move them to the quarantine tenant", func() {
+			ctx := context.Background()
+
+			var tuples []*base.Tuple
+			for _, s := range []string{"document:1#viewer@group:1", "document:1#viewer@group:1#member", "document:2#viewer@user:1"} {
+				tup, err := tuple.Tuple(s)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, tup)
+			}
+			_, err := dataWriter.Write(ctx, "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = dataWriter.Remove(ctx, "t1", database.NewTupleCollection(tuples[0]), database.NewAttributeCollection(), "quarantine")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			read := func(tenantID string) []string {
+				it, err := dataReader.QueryRelationships(ctx, tenantID, &base.TupleFilter{Entity: &base.EntityFilter{Type: "document"}}, "", database.NewCursorPagination())
+				Expect(err).ShouldNot(HaveOccurred())
+				var relationships []string
+				for it.HasNext() {
+					relationships = append(relationships, tuple.ToString(it.GetNext()))
+				}
+				return relationships
+			}
+			Expect(read("t1")).Should(ConsistOf("document:1#viewer@group:1#member", "document:2#viewer@user:1"))
+			Expect(read("quarantine")).Should(ConsistOf("document:1#viewer@group:1"))
+		})
+	})
 })
@@ -177,5 +177,90 @@ var Schema = &memdb.DBSchema{
 				},
 			},
 		},
+		constants.SchemaShadowsTable: {
+			Name: constants.SchemaShadowsTable,
+			Indexes: map[string]*memdb.IndexSchema{
+				"id": {
+					Name:    "id",
+					Unique:  true,
+					Indexer: &memdb.StringFieldIndex{Field: "TenantID"},
+				},
+				"tenant_id": {
+					Name:    "tenant_id",
+					Unique:  true,
+					Indexer: &memdb.StringFieldIndex{Field: "TenantID"},
+				},
+			},
+		},
+		constants.IdempotencyKeysTable: {
+			Name: constants.IdempotencyKeysTable,
+			Indexes: map[string]*memdb.IndexSchema{
+				"id": {
+					Name:   "id",
+					Unique: true,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "TenantID"},
+							&memdb.StringFieldIndex{Field: "Key"},
+						},
+					},
+				},
+				"tenant_id": {
+					Name:    "tenant_id",
+					Unique:  false,
+					Indexer: &memdb.StringFieldIndex{Field: "TenantID"},
+				},
+			},
+		},
+		constants.TransactionsTable: {
+			Name: constants.TransactionsTable,
+			Indexes: map[string]*memdb.IndexSchema{
+				"id": {
+					Name:   "id",
+					Unique: true,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "TenantID"},
+							&memdb.UintFieldIndex{Field: "ID"},
+						},
+					},
+				},
+				"tenant_id": {
+					Name:    "tenant_id",
+					Unique:  false,
+					Indexer: &memdb.StringFieldIndex{Field: "TenantID"},
+				},
+			},
+		},
+		constants.SchemaTagsTable: {
+			Name: constants.SchemaTagsTable,
+			Indexes: map[string]*memdb.IndexSchema{
+				"id": {
+					Name:   "id",
+					Unique: true,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "TenantID"},
+							&memdb.StringFieldIndex{Field: "Tag"},
+						},
+					},
+				},
+				"version": {
+					Name:   "version",
+					Unique: false,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "TenantID"},
+							&memdb.StringFieldIndex{Field: "Version"},
+						},
+					},
+				},
+				"tenant_id": {
+					Name:    "tenant_id",
+					Unique:  false,
+					Indexer: &memdb.StringFieldIndex{Field: "TenantID"},
+				},
+			},
+		},
 	},
 }
@@ -3,6 +3,7 @@ package memory
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"github.com/hashicorp/go-memdb"
 	"github.com/rs/xid"
@@ -68,6 +69,20 @@ func (r *SchemaReader) ReadSchemaString(_ context.Context, tenantID, version str
 	return definitions, nil
 }
 
+// ReadSchemaSource - Reads the schema a version was written from
+func (r *SchemaReader) ReadSchemaSource(_ context.Context, tenantID, version string) (string, error) {
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+	raw, err := txn.First(constants.SchemaDefinitionsTable, "version", tenantID, version)
+	if err != nil {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if raw == nil {
+		return "", nil
+	}
+	return raw.(storage.SchemaDefinition).Source, nil
+}
+
 // ReadEntityDefinition - Reads a Entity Definition from repository
 func (r *SchemaReader) ReadEntityDefinition(_ context.Context, tenantID, entityName, version string) (definition *base.EntityDefinition, v string, err error) {
 	txn := r.database.DB.Txn(false)
@@ -135,11 +150,31 @@ func (r *SchemaReader) HeadVersion(_ context.Context, tenantID string) (string,
 	return version, nil
 }
 
+// TagVersion - Reads the version a tag points to from the repository.
+func (r *SchemaReader) TagVersion(_ context.Context, tenantID, tag string) (string, error) {
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+
+	raw, err := txn.First(constants.SchemaTagsTable, "id", tenantID, tag)
+	if err != nil {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if raw == nil {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_TAG_NOT_FOUND.String())
+	}
+
+	return raw.(storage.SchemaTag).Version, nil
+}
+
 // ListSchemas - List all Schemas
 func (r *SchemaReader) ListSchemas(_ context.Context, tenantID string, pagination database.Pagination) (schemas []*base.SchemaList, ct database.EncodedContinuousToken, err error) {
 	txn := r.database.DB.Txn(false)
 	defer txn.Abort()
 
+	mu.Lock()
+	head := headVersion[tenantID]
+	mu.Unlock()
+
 	var result memdb.ResultIterator
 	result, err = txn.Get(constants.SchemaDefinitionsTable, "tenant", tenantID)
 	if err != nil {
@@ -184,7 +219,11 @@ func (r *SchemaReader) ListSchemas(_ context.Context, tenantID string, paginatio
 				return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_INTERNAL.String())
 			}
 			createdAt := id.Time().String()
-			schemas = append(schemas, &base.SchemaList{Version: s.Version, CreatedAt: createdAt})
+			tags, err := r.tags(txn, tenantID, s.Version)
+			if err != nil {
+				return nil, nil, err
+			}
+			schemas = append(schemas, &base.SchemaList{Version: s.Version, CreatedAt: createdAt, Tags: tags, Active: s.Version == head, Metadata: s.Metadata})
 		}
 		if len(schemas) > int(pagination.PageSize()) {
 			return schemas[:pagination.PageSize()], utils.NewContinuousToken(s.Version).Encode(), nil
@@ -193,3 +232,35 @@ func (r *SchemaReader) ListSchemas(_ context.Context, tenantID string, paginatio
 
 	return schemas, database.NewNoopContinuousToken().Encode(), err
 }
+
+// tags - Returns the sorted tags pointing to a version
+func (r *SchemaReader) tags(txn *memdb.Txn, tenantID, version string) ([]string, error) {
+	it, err := txn.Get(constants.SchemaTagsTable, "version", tenantID, version)
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	var tags []string
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		tags = append(tags, obj.(storage.SchemaTag).Tag)
+	}
+	slices.Sort(tags)
+
+	return tags, nil
+}
+
+// ReadShadow - Reads the shadow version of the schema from the repository.
+func (r *SchemaReader) ReadShadow(_ context.Context, tenantID string) (*base.SchemaShadow, error) {
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+
+	raw, err := txn.First(constants.SchemaShadowsTable, "id", tenantID)
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if raw == nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_SHADOW_NOT_FOUND.String())
+	}
+
+	return raw.(storage.SchemaShadow).ToSchemaShadow(), nil
+}
@@ -127,6 +127,38 @@ var _ = Describe("SchemaReader", func() {
 		})
 	})
 
+	Context("Read Schema Source", func() {
+		It("should read the source a version was written from", func() {
+			ctx := context.Background()
+
+			version := xid.New().String()
+			source := "// users\nentity user {}\n\nentity organization {\n    relation admin @user\n}"
+
+			schema := []storage.SchemaDefinition{
+				{TenantID: "t1", Name: "user", SerializedDefinition: []byte("entity user {}"), Version: version, Source: source},
+				{TenantID: "t1", Name: "organization", SerializedDefinition: []byte("entity organization { relation admin @user}"), Version: version, Source: source},
+			}
+
+			err := schemaWriter.WriteSchema(ctx, schema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			src, err := schemaReader.ReadSchemaSource(ctx, "t1", version)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(src).Should(Equal(source))
+
+			// a version written without a source has none
+			other := xid.New().String()
+			err = schemaWriter.WriteSchema(ctx, []storage.SchemaDefinition{
+				{TenantID: "t1", Name: "user", SerializedDefinition: []byte("entity user {}"), Version: other},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			src, err = schemaReader.ReadSchemaSource(ctx, "t1", other)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(src).Should(BeEmpty())
+		})
+	})
+
 	Context("Read Entity Definition", func() {
 		It("should write and then read the entity definition for a tenant", func() {
 			ctx := context.Background()
@@ -239,6 +271,22 @@ var _ = Describe("SchemaReader", func() {
 			Expect(len(col2)).Should(Equal(2))
 			Expect(ct2.String()).Should(Equal(""))
 		})
+
+		It("should list the metadata the versions were written with", func() {
+			ctx := context.Background()
+
+			version := xid.New().String()
+			metadata := &base.TransactionMetadata{Actor: "alice", Reason: "TICKET-1"}
+			err := schemaWriter.WriteSchema(ctx, []storage.SchemaDefinition{
+				{TenantID: "t1", Name: "user", SerializedDefinition: []byte("entity user {}"), Version: version, Metadata: metadata},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemas, _, err := schemaReader.ListSchemas(ctx, "t1", database.NewPagination(database.Size(10)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(schemas).Should(HaveLen(1))
+			Expect(schemas[0].GetMetadata()).Should(Equal(metadata))
+		})
 	})
 
 	Context("Error handling and edge cases", func() {
@@ -56,3 +56,172 @@ func (w *SchemaWriter) WriteSchema(_ context.Context, definitions []storage.Sche
 
 	return nil
 }
+
+// WriteShadow - Write the shadow version of the schema to repository, replacing the previous shadow version
+func (w *SchemaWriter) WriteShadow(_ context.Context, shadow storage.SchemaShadow, definitions []storage.SchemaDefinition) error {
+	txn := w.database.DB.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(constants.SchemaShadowsTable, "id", shadow.TenantID)
+	if err != nil {
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if raw != nil {
+		if _, err = txn.DeleteAll(constants.SchemaDefinitionsTable, "version", shadow.TenantID, raw.(storage.SchemaShadow).Version); err != nil {
+			return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+	}
+
+	for _, definition := range definitions {
+		if err = txn.Insert(constants.SchemaDefinitionsTable, definition); err != nil {
+			return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+	}
+	if err = txn.Insert(constants.SchemaShadowsTable, shadow); err != nil {
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	txn.Commit()
+
+	// The head version is left as is, the shadow version is not served until it is promoted.
+	return nil
+}
+
+// PromoteShadow - Makes the shadow version of the schema the head version
+func (w *SchemaWriter) PromoteShadow(_ context.Context, tenantID string) (*base.SchemaShadow, error) {
+	txn := w.database.DB.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(constants.SchemaShadowsTable, "id", tenantID)
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if raw == nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_SHADOW_NOT_FOUND.String())
+	}
+	shadow := raw.(storage.SchemaShadow)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if headVersion[tenantID] != shadow.ServedVersion {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_SHADOW_OUTDATED.String())
+	}
+	if _, err = txn.DeleteAll(constants.SchemaShadowsTable, "id", tenantID); err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	txn.Commit()
+
+	headVersion[tenantID] = shadow.Version
+
+	return shadow.ToSchemaShadow(), nil
+}
+
+// DeleteShadow - Deletes the shadow version of the schema and its definitions
+func (w *SchemaWriter) DeleteShadow(_ context.Context, tenantID string) (*base.SchemaShadow, error) {
+	txn := w.database.DB.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(constants.SchemaShadowsTable, "id", tenantID)
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if raw == nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_SHADOW_NOT_FOUND.String())
+	}
+	shadow := raw.(storage.SchemaShadow)
+
+	if _, err = txn.DeleteAll(constants.SchemaDefinitionsTable, "version", tenantID, shadow.Version); err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if _, err = txn.DeleteAll(constants.SchemaTagsTable, "version", tenantID, shadow.Version); err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if _, err = txn.DeleteAll(constants.SchemaShadowsTable, "id", tenantID); err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	txn.Commit()
+
+	return shadow.ToSchemaShadow(), nil
+}
+
+// TagSchema - Points a tag to a version of the schema
+func (w *SchemaWriter) TagSchema(_ context.Context, tenantID, tag, version string) (string, error) {
+	txn := w.database.DB.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(constants.SchemaDefinitionsTable, "version", tenantID, version)
+	if err != nil {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if raw == nil {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String())
+	}
+
+	var previous string
+	raw, err = txn.First(constants.SchemaTagsTable, "id", tenantID, tag)
+	if err != nil {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if raw != nil {
+		previous = raw.(storage.SchemaTag).Version
+	}
+
+	if err = txn.Insert(constants.SchemaTagsTable, storage.SchemaTag{TenantID: tenantID, Tag: tag, Version: version}); err != nil {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	txn.Commit()
+
+	return previous, nil
+}
+
+// UntagSchema - Deletes a tag of the schema
+func (w *SchemaWriter) UntagSchema(_ context.Context, tenantID, tag string) (string, error) {
+	txn := w.database.DB.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(constants.SchemaTagsTable, "id", tenantID, tag)
+	if err != nil {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if raw == nil {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_TAG_NOT_FOUND.String())
+	}
+
+	if err = txn.Delete(constants.SchemaTagsTable, raw); err != nil {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	txn.Commit()
+
+	return raw.(storage.SchemaTag).Version, nil
+}
+
+// ActivateSchema - Makes a version of the schema the head version
+func (w *SchemaWriter) ActivateSchema(_ context.Context, tenantID, version string) (string, error) {
+	txn := w.database.DB.Txn(false)
+	defer txn.Abort()
+
+	raw, err := txn.First(constants.SchemaDefinitionsTable, "version", tenantID, version)
+	if err != nil {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if raw == nil {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String())
+	}
+
+	// The shadow version is served by promoting it, which checks it was compared against the served version.
+	raw, err = txn.First(constants.SchemaShadowsTable, "id", tenantID)
+	if err != nil {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if raw != nil && raw.(storage.SchemaShadow).Version == version {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_VERSION_IN_SHADOW.String())
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	previous := headVersion[tenantID]
+	headVersion[tenantID] = version
+
+	return previous, nil
+}
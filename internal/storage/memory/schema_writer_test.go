@@ -10,6 +10,7 @@ import (
 
 	"github.com/Permify/permify/internal/storage"
 	"github.com/Permify/permify/internal/storage/memory/migrations"
+	"github.com/Permify/permify/pkg/database"
 	"github.com/Permify/permify/pkg/database/memory"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 )
@@ -86,4 +87,203 @@ var _ = Describe("SchemaWriter", func() {
 			Expect(sch.References["organization"]).Should(Equal(base.SchemaDefinition_REFERENCE_ENTITY))
 		})
 	})
+
+	Context("Shadow", func() {
+		writeShadow := func(tenantID, served string) string {
+			version := xid.New().String()
+			err := schemaWriter.WriteShadow(context.Background(), storage.SchemaShadow{
+				TenantID:      tenantID,
+				Version:       version,
+				ServedVersion: served,
+				SampleRate:    1,
+			}, []storage.SchemaDefinition{
+				{TenantID: tenantID, Name: "user", SerializedDefinition: []byte("entity user {}"), Version: version},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			return version
+		}
+
+		writeServed := func(tenantID string) string {
+			version := xid.New().String()
+			err := schemaWriter.WriteSchema(context.Background(), []storage.SchemaDefinition{
+				{TenantID: tenantID, Name: "user", SerializedDefinition: []byte("entity user {}"), Version: version},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			return version
+		}
+
+		It("should not serve the shadow version until it is promoted", func() {
+			ctx := context.Background()
+
+			served := writeServed("shadow-1")
+			shadow := writeShadow("shadow-1", served)
+
+			head, err := schemaReader.HeadVersion(ctx, "shadow-1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(head).Should(Equal(served))
+
+			sh, err := schemaReader.ReadShadow(ctx, "shadow-1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(sh.GetVersion()).Should(Equal(shadow))
+			Expect(sh.GetServedVersion()).Should(Equal(served))
+
+			promoted, err := schemaWriter.PromoteShadow(ctx, "shadow-1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(promoted.GetVersion()).Should(Equal(shadow))
+
+			head, err = schemaReader.HeadVersion(ctx, "shadow-1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(head).Should(Equal(shadow))
+
+			_, err = schemaReader.ReadShadow(ctx, "shadow-1")
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_SCHEMA_SHADOW_NOT_FOUND.String()))
+		})
+
+		It("should not promote a shadow version written over a version no longer served", func() {
+			ctx := context.Background()
+
+			writeShadow("shadow-2", writeServed("shadow-2"))
+			served := writeServed("shadow-2")
+
+			_, err := schemaWriter.PromoteShadow(ctx, "shadow-2")
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_SCHEMA_SHADOW_OUTDATED.String()))
+
+			head, err := schemaReader.HeadVersion(ctx, "shadow-2")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(head).Should(Equal(served))
+		})
+
+		It("should replace and delete the shadow version with its definitions", func() {
+			ctx := context.Background()
+
+			served := writeServed("shadow-3")
+			first := writeShadow("shadow-3", served)
+			second := writeShadow("shadow-3", served)
+
+			definitions, err := schemaReader.ReadSchemaString(ctx, "shadow-3", first)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(definitions).Should(BeEmpty())
+
+			deleted, err := schemaWriter.DeleteShadow(ctx, "shadow-3")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(deleted.GetVersion()).Should(Equal(second))
+
+			definitions, err = schemaReader.ReadSchemaString(ctx, "shadow-3", second)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(definitions).Should(BeEmpty())
+
+			_, err = schemaWriter.DeleteShadow(ctx, "shadow-3")
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_SCHEMA_SHADOW_NOT_FOUND.String()))
+		})
+	})
+
+	Context("Tags and activation", func() {
+		write := func(tenantID string) string {
+			version := xid.New().String()
+			err := schemaWriter.WriteSchema(context.Background(), []storage.SchemaDefinition{
+				{TenantID: tenantID, Name: "user", SerializedDefinition: []byte("entity user {}"), Version: version},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			return version
+		}
+
+		It("should move, resolve and delete tags", func() {
+			ctx := context.Background()
+
+			first := write("tag-1")
+			second := write("tag-1")
+
+			previous, err := schemaWriter.TagSchema(ctx, "tag-1", "prod", first)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(previous).Should(BeEmpty())
+
+			previous, err = schemaWriter.TagSchema(ctx, "tag-1", "prod", second)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(previous).Should(Equal(first))
+
+			version, err := schemaReader.TagVersion(ctx, "tag-1", "prod")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(version).Should(Equal(second))
+
+			_, err = schemaWriter.TagSchema(ctx, "tag-1", "canary", xid.New().String())
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String()))
+
+			version, err = schemaWriter.UntagSchema(ctx, "tag-1", "prod")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(version).Should(Equal(second))
+
+			_, err = schemaReader.TagVersion(ctx, "tag-1", "prod")
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_SCHEMA_TAG_NOT_FOUND.String()))
+
+			_, err = schemaWriter.UntagSchema(ctx, "tag-1", "prod")
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_SCHEMA_TAG_NOT_FOUND.String()))
+		})
+
+		It("should serve the activated version until another version is written", func() {
+			ctx := context.Background()
+
+			first := write("tag-2")
+			second := write("tag-2")
+
+			previous, err := schemaWriter.ActivateSchema(ctx, "tag-2", first)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(previous).Should(Equal(second))
+
+			head, err := schemaReader.HeadVersion(ctx, "tag-2")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(head).Should(Equal(first))
+
+			third := write("tag-2")
+
+			head, err = schemaReader.HeadVersion(ctx, "tag-2")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(head).Should(Equal(third))
+
+			_, err = schemaWriter.ActivateSchema(ctx, "tag-2", xid.New().String())
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String()))
+		})
+
+		It("should list the tags and the active version", func() {
+			ctx := context.Background()
+
+			first := write("tag-3")
+			second := write("tag-3")
+
+			_, err := schemaWriter.TagSchema(ctx, "tag-3", "prod", first)
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = schemaWriter.TagSchema(ctx, "tag-3", "canary", second)
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = schemaWriter.TagSchema(ctx, "tag-3", "stable", first)
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = schemaWriter.ActivateSchema(ctx, "tag-3", first)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			schemas, _, err := schemaReader.ListSchemas(ctx, "tag-3", database.NewPagination(database.Size(10), database.Token("")))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(schemas).Should(HaveLen(2))
+
+			listed := make(map[string]*base.SchemaList)
+			for _, sch := range schemas {
+				listed[sch.GetVersion()] = sch
+			}
+			Expect(listed[first].GetTags()).Should(Equal([]string{"prod", "stable"}))
+			Expect(listed[first].GetActive()).Should(BeTrue())
+			Expect(listed[second].GetTags()).Should(Equal([]string{"canary"}))
+			Expect(listed[second].GetActive()).Should(BeFalse())
+		})
+
+		It("should not activate the shadow version", func() {
+			ctx := context.Background()
+
+			served := write("tag-4")
+			shadow := xid.New().String()
+			err := schemaWriter.WriteShadow(ctx, storage.SchemaShadow{TenantID: "tag-4", Version: shadow, ServedVersion: served}, []storage.SchemaDefinition{
+				{TenantID: "tag-4", Name: "user", SerializedDefinition: []byte("entity user {}"), Version: shadow},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = schemaWriter.ActivateSchema(ctx, "tag-4", shadow)
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_SCHEMA_VERSION_IN_SHADOW.String()))
+		})
+	})
 })
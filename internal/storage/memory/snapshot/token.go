@@ -3,8 +3,10 @@ package snapshot
 import (
 	"encoding/base64"
 	"encoding/binary"
+	"errors"
 	"time"
 
+	base "github.com/Permify/permify/pkg/pb/base/v1"
 	"github.com/Permify/permify/pkg/token"
 )
 
@@ -59,6 +61,9 @@ func (t EncodedToken) Decode() (token.SnapToken, error) {
 	if err != nil {
 		return nil, err
 	}
+	if len(b) != 8 {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+	}
 	return Token{
 		Value: binary.LittleEndian.Uint64(b),
 	}, nil
@@ -54,8 +54,12 @@ func (w *TenantWriter) DeleteTenant(_ context.Context, tenantID string) (err err
 	tables := []string{
 		constants.AttributesTable,
 		constants.BundlesTable,
+		constants.IdempotencyKeysTable,
 		constants.RelationTuplesTable,
 		constants.SchemaDefinitionsTable,
+		constants.SchemaShadowsTable,
+		constants.SchemaTagsTable,
+		constants.TransactionsTable,
 	}
 
 	// Iterate through each table and delete records associated with the tenant
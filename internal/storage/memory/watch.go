@@ -2,12 +2,21 @@ package memory
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 
+	"github.com/hashicorp/go-memdb"
+
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/memory/constants"
+	"github.com/Permify/permify/internal/storage/memory/snapshot"
 	db "github.com/Permify/permify/pkg/database/memory"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 )
 
+// watchBufferSize - Number of changes that can be queued for a watcher
+const watchBufferSize = 100
+
 // Watch - Watches for changes in the repository.
 type Watch struct {
 	database *db.Memory
@@ -20,16 +29,70 @@ func NewWatcher(database *db.Memory) *Watch {
 	}
 }
 
-// Watch - Watches for changes in the repository.
-func (r *Watch) Watch(_ context.Context, _, _ string) (<-chan *base.DataChanges, <-chan error) {
-	tupleChanges := make(chan *base.DataChanges)
-	errs := make(chan error)
+// Watch - Watches for changes in the repository. The transactions of the tenant committed after the snapshot
+// are sent in order, then the watch waits for the next ones until the context is done.
+func (r *Watch) Watch(ctx context.Context, tenantID, snap string) (<-chan *base.DataChanges, <-chan error) {
+	changes := make(chan *base.DataChanges, watchBufferSize)
+	errs := make(chan error, 1)
+
+	st, err := snapshot.EncodedToken{Value: snap}.Decode()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to decode snapshot", slog.Any("error", err))
+		errs <- err
+		return changes, errs
+	}
+
+	go func() {
+		defer close(changes)
+		defer close(errs)
+
+		cr := st.(snapshot.Token).Value
+
+		for {
+			txn := r.database.DB.Txn(false)
+
+			// The lower bound iterator cannot be watched, so the transactions of the tenant are watched as a whole.
+			all, err := txn.Get(constants.TransactionsTable, "tenant_id", tenantID)
+			if err != nil {
+				txn.Abort()
+				errs <- errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+				return
+			}
+			ws := memdb.NewWatchSet()
+			ws.Add(all.WatchCh())
+
+			it, err := txn.LowerBound(constants.TransactionsTable, "id", tenantID, cr+1)
+			if err != nil {
+				txn.Abort()
+				errs <- errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+				return
+			}
+
+			for obj := it.Next(); obj != nil; obj = it.Next() {
+				tx, ok := obj.(storage.Transaction)
+				if !ok || tx.TenantID != tenantID {
+					break
+				}
+
+				select {
+				case <-ctx.Done():
+					txn.Abort()
+					errs <- errors.New(base.ErrorCode_ERROR_CODE_CANCELLED.String())
+					return
+				case changes <- tx.Changes:
+				}
 
-	slog.Info(base.ErrorCode_ERROR_CODE_NOT_IMPLEMENTED.String())
+				cr = tx.ID
+			}
+			txn.Abort()
 
-	// Close the channels immediately
-	close(tupleChanges)
-	close(errs)
+			// Wait for a write into the transactions of the tenant after the last one sent.
+			if err := ws.WatchCtx(ctx); err != nil {
+				errs <- errors.New(base.ErrorCode_ERROR_CODE_CANCELLED.String())
+				return
+			}
+		}
+	}()
 
-	return tupleChanges, errs
+	return changes, errs
 }
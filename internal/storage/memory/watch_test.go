@@ -2,20 +2,25 @@ package memory
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/Permify/permify/internal/storage"
 	"github.com/Permify/permify/internal/storage/memory/migrations"
+	"github.com/Permify/permify/internal/storage/memory/snapshot"
+	"github.com/Permify/permify/pkg/database"
 	"github.com/Permify/permify/pkg/database/memory"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
 )
 
 var _ = Describe("Watch", func() {
 	var db *memory.Memory
 
 	var watcher *Watch
+	var dataWriter *DataWriter
 
 	BeforeEach(func() {
 		database, err := memory.New(migrations.Schema)
@@ -23,6 +28,7 @@ var _ = Describe("Watch", func() {
 		db = database
 
 		watcher = NewWatcher(db)
+		dataWriter = NewDataWriter(db)
 	})
 
 	AfterEach(func() {
@@ -31,15 +37,76 @@ var _ = Describe("Watch", func() {
 	})
 
 	Context("Watch", func() {
-		It("watch", func() {
-			_, errs := watcher.Watch(context.Background(), "t1", "")
-			select {
-			case err := <-errs:
-				// Handle and assert the error
-				Expect(err).ShouldNot(HaveOccurred())
-			case <-time.After(time.Second * 10):
-				fmt.Println("test timed out")
+		It("should send the changes written after the snapshot with their metadata", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			tup1, err := tuple.Tuple("organization:org-1#admin@user:user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = dataWriter.Write(ctx, "t1", database.NewTupleCollection(tup1), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			snap := snapshot.NewToken(time.Now()).Encode().String()
+			changes, errs := watcher.Watch(ctx, "t1", snap)
+
+			tup2, err := tuple.Tuple("organization:org-1#member@user:user-2")
+			Expect(err).ShouldNot(HaveOccurred())
+			metadata := &base.TransactionMetadata{Actor: "alice", Reason: "JIRA-1", Labels: map[string]string{"source": "test"}}
+			written, err := dataWriter.Write(ctx, "t1", database.NewTupleCollection(tup2), database.NewAttributeCollection(), storage.TransactionMetadata(metadata))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = dataWriter.Delete(ctx, "t1", &base.TupleFilter{
+				Entity:   &base.EntityFilter{Type: "organization", Ids: []string{"org-1"}},
+				Relation: "admin",
+			}, &base.AttributeFilter{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var received []*base.DataChanges
+			for len(received) < 2 {
+				select {
+				case c := <-changes:
+					received = append(received, c)
+				case err := <-errs:
+					Fail(err.Error())
+				case <-time.After(time.Second * 10):
+					Fail("watch timed out")
+				}
 			}
+
+			Expect(received[0].GetSnapToken()).Should(Equal(written.String()))
+			Expect(received[0].GetMetadata().GetActor()).Should(Equal("alice"))
+			Expect(received[0].GetMetadata().GetReason()).Should(Equal("JIRA-1"))
+			Expect(received[0].GetMetadata().GetLabels()).Should(HaveKeyWithValue("source", "test"))
+			Expect(received[0].GetDataChanges()).Should(HaveLen(1))
+			Expect(received[0].GetDataChanges()[0].GetOperation()).Should(Equal(base.DataChange_OPERATION_CREATE))
+			Expect(tuple.ToString(received[0].GetDataChanges()[0].GetTuple())).Should(Equal("organization:org-1#member@user:user-2"))
+
+			Expect(received[1].GetMetadata()).Should(BeNil())
+			Expect(received[1].GetDataChanges()).Should(HaveLen(1))
+			Expect(received[1].GetDataChanges()[0].GetOperation()).Should(Equal(base.DataChange_OPERATION_DELETE))
+			Expect(tuple.ToString(received[1].GetDataChanges()[0].GetTuple())).Should(Equal("organization:org-1#admin@user:user-1"))
+
+			cancel()
+			Eventually(errs, time.Second*10).Should(Receive(MatchError(base.ErrorCode_ERROR_CODE_CANCELLED.String())))
+		})
+
+		It("should not send the changes of other tenants", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			changes, _ := watcher.Watch(ctx, "t1", snapshot.NewToken(time.Now()).Encode().String())
+
+			tup, err := tuple.Tuple("organization:org-1#admin@user:user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = dataWriter.Write(ctx, "t2", database.NewTupleCollection(tup), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Consistently(changes, time.Millisecond*200).ShouldNot(Receive())
+		})
+
+		It("should reject an invalid snapshot", func() {
+			_, errs := watcher.Watch(context.Background(), "t1", "")
+			Expect(<-errs).Should(HaveOccurred())
 		})
 	})
 })
@@ -64,6 +64,11 @@ type SchemaDefinition struct {
 	Name                 string
 	SerializedDefinition []byte
 	Version              string
+	// Metadata describes who wrote the version and why, nil when it was written without metadata
+	Metadata *base.TransactionMetadata
+	// Source is the schema the version was written from, the same for every definition of the version. It is
+	// empty when the version was not written from a source, such as a version written by a partial write.
+	Source string
 }
 
 // Serialized - get schema serialized definition
@@ -71,6 +76,59 @@ func (e SchemaDefinition) Serialized() string {
 	return string(e.SerializedDefinition)
 }
 
+// SchemaShadow - Structure for the shadow version of the schema of a tenant
+type SchemaShadow struct {
+	TenantID      string
+	Version       string
+	ServedVersion string
+	SampleRate    float64
+	CreatedAt     time.Time
+}
+
+// ToSchemaShadow - Convert database schema shadow to base schema shadow
+func (s SchemaShadow) ToSchemaShadow() *base.SchemaShadow {
+	return &base.SchemaShadow{
+		Version:       s.Version,
+		ServedVersion: s.ServedVersion,
+		SampleRate:    s.SampleRate,
+		CreatedAt:     s.CreatedAt.String(),
+	}
+}
+
+// SchemaTag - Structure for a tag pointing to a version of the schema of a tenant
+type SchemaTag struct {
+	TenantID string
+	Tag      string
+	Version  string
+}
+
+// IdempotentWrite - Structure for the snap token of a write recorded under its idempotency key
+type IdempotentWrite struct {
+	TenantID    string
+	Key         string
+	RequestHash string
+	SnapToken   string
+	CreatedAt   time.Time
+}
+
+// HotspotGroup - Part of the relation tuples hotspots are grouped by
+type HotspotGroup int
+
+const (
+	// FanOut groups the relation tuples by their entity and relation
+	FanOut HotspotGroup = iota
+	// FanIn groups the relation tuples by their subject, with its relation
+	FanIn
+)
+
+// Transaction - Structure for the changes a write committed to a tenant, with the metadata it was written with
+type Transaction struct {
+	TenantID  string
+	ID        uint64
+	Changes   *base.DataChanges
+	CreatedAt time.Time
+}
+
 // Tenant - Structure for tenant
 type Tenant struct {
 	ID        string
@@ -0,0 +1,82 @@
+package storage
+
+import (
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// WriteOption - Option type of the writes of a DataWriter
+type WriteOption func(*WriteOptions)
+
+// Preconditions - Conditions on the stored data that must hold for the write to be applied
+func Preconditions(preconditions ...*base.Precondition) WriteOption {
+	return func(o *WriteOptions) {
+		o.preconditions = append(o.preconditions, preconditions...)
+	}
+}
+
+// IdempotencyKey - Key making retries of the write safe, a retry with the same key returns the snap token of the original write.
+// The hash identifies the request of the write, reusing the key for another request is rejected
+func IdempotencyKey(key, requestHash string) WriteOption {
+	return func(o *WriteOptions) {
+		o.idempotencyKey = key
+		o.requestHash = requestHash
+	}
+}
+
+// TransactionMetadata - Metadata describing who made the write and why, persisted alongside its transaction
+func TransactionMetadata(metadata *base.TransactionMetadata) WriteOption {
+	return func(o *WriteOptions) {
+		o.transactionMetadata = metadata
+	}
+}
+
+// SchemaVersion - Version of the schema the write was validated against, the cardinality of its single relations is
+// enforced against the same version
+func SchemaVersion(version string) WriteOption {
+	return func(o *WriteOptions) {
+		o.schemaVersion = version
+	}
+}
+
+// WriteOptions - Options of a write
+type WriteOptions struct {
+	preconditions       []*base.Precondition
+	idempotencyKey      string
+	requestHash         string
+	transactionMetadata *base.TransactionMetadata
+	schemaVersion       string
+}
+
+// NewWriteOptions - Creates the options of a write
+func NewWriteOptions(opts ...WriteOption) WriteOptions {
+	o := WriteOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// GetPreconditions - Gets the preconditions of the write
+func (o WriteOptions) GetPreconditions() []*base.Precondition {
+	return o.preconditions
+}
+
+// GetIdempotencyKey - Gets the idempotency key of the write, empty when the write is not idempotent
+func (o WriteOptions) GetIdempotencyKey() string {
+	return o.idempotencyKey
+}
+
+// GetRequestHash - Gets the hash of the request of the write recorded with its idempotency key
+func (o WriteOptions) GetRequestHash() string {
+	return o.requestHash
+}
+
+// GetTransactionMetadata - Gets the metadata of the transaction of the write, nil when there is none
+func (o WriteOptions) GetTransactionMetadata() *base.TransactionMetadata {
+	return o.transactionMetadata
+}
+
+// GetSchemaVersion - Gets the version of the schema the write is enforced against, empty for the head version
+func (o WriteOptions) GetSchemaVersion() string {
+	return o.schemaVersion
+}
@@ -4,7 +4,12 @@ const (
 	RelationTuplesTable   = "relation_tuples"
 	AttributesTable       = "attributes"
 	SchemaDefinitionTable = "schema_definitions"
+	SchemaShadowsTable    = "schema_shadows"
+	SchemaTagsTable       = "schema_tags"
+	SchemaHeadsTable      = "schema_heads"
+	SchemaSourcesTable    = "schema_sources"
 	TransactionsTable     = "transactions"
 	TenantsTable          = "tenants"
 	BundlesTable          = "bundles"
+	IdempotencyKeysTable  = "idempotency_keys"
 )
@@ -0,0 +1,200 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Permify/permify/internal"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/postgres/utils"
+	"github.com/Permify/permify/pkg/database"
+	db "github.com/Permify/permify/pkg/database/postgres"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+// historyVersion holds the transactions a version of a tuple or an attribute was written and expired in, with their metadata.
+// The times are nil once the garbage collector deleted the transaction, the expiration while the version is active.
+type historyVersion struct {
+	createdTxID     db.XID8
+	createdAt       *time.Time
+	createdMetadata []byte
+	expiredTxID     db.XID8
+	expiredAt       *time.Time
+	expiredMetadata []byte
+}
+
+// record builds the history record of the version.
+func (v historyVersion) record(r *base.DataHistoryRecord) (*base.DataHistoryRecord, error) {
+	var err error
+	r.CreatedTxId = v.createdTxID.Uint
+	if v.createdAt != nil {
+		r.CreatedAt = timestamppb.New(*v.createdAt)
+	}
+	if r.CreatedMetadata, err = utils.UnmarshalMetadata(v.createdMetadata); err != nil {
+		return nil, err
+	}
+	if v.expiredTxID.Uint != utils.ActiveRecordTxnID {
+		r.ExpiredTxId = v.expiredTxID.Uint
+		if v.expiredAt != nil {
+			r.ExpiredAt = timestamppb.New(*v.expiredAt)
+		}
+		if r.ExpiredMetadata, err = utils.UnmarshalMetadata(v.expiredMetadata); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// ReadRelationshipHistory reads every version of the relation tuples matching the filter, in the order they were written.
+func (r *DataReader) ReadRelationshipHistory(ctx context.Context, tenantID string, filter *base.TupleFilter, pagination database.Pagination) (records []*base.DataHistoryRecord, ct database.EncodedContinuousToken, err error) {
+	ctx, span := internal.Tracer.Start(ctx, "data-reader.read-relationship-history")
+	defer span.End()
+
+	slog.DebugContext(ctx, "reading relationship history for tenant_id", slog.String("tenant_id", tenantID))
+
+	builder := r.historyBuilder(RelationTuplesTable, tenantID, "v.entity_type, v.entity_id, v.relation, v.subject_type, v.subject_id, v.subject_relation")
+	builder = utils.TuplesFilterQueryForSelectBuilder(builder, filter)
+	builder, err = historyPage(builder, pagination)
+	if err != nil {
+		return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SQL_BUILDER)
+	}
+
+	rows, err := r.database.ReadPool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
+	}
+	defer rows.Close()
+
+	var lastID uint64
+	records = make([]*base.DataHistoryRecord, 0, pagination.PageSize()+1)
+	for rows.Next() {
+		rt := storage.RelationTuple{}
+		var v historyVersion
+		err = rows.Scan(&rt.ID, &rt.EntityType, &rt.EntityID, &rt.Relation, &rt.SubjectType, &rt.SubjectID, &rt.SubjectRelation, &v.createdTxID, &v.createdAt, &v.createdMetadata, &v.expiredTxID, &v.expiredAt, &v.expiredMetadata)
+		if err != nil {
+			return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
+		}
+		lastID = rt.ID
+		record, err := v.record(&base.DataHistoryRecord{Item: &base.DataHistoryRecord_Tuple{Tuple: rt.ToTuple()}})
+		if err != nil {
+			return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INTERNAL)
+		}
+		records = append(records, record)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
+	}
+
+	if pagination.PageSize() != 0 && len(records) > int(pagination.PageSize()) {
+		return records[:pagination.PageSize()], utils.NewContinuousToken(strconv.FormatUint(lastID, 10)).Encode(), nil
+	}
+	return records, database.NewNoopContinuousToken().Encode(), nil
+}
+
+// ReadAttributeHistory reads every version of the attributes matching the filter, in the order they were written.
+func (r *DataReader) ReadAttributeHistory(ctx context.Context, tenantID string, filter *base.AttributeFilter, pagination database.Pagination) (records []*base.DataHistoryRecord, ct database.EncodedContinuousToken, err error) {
+	ctx, span := internal.Tracer.Start(ctx, "data-reader.read-attribute-history")
+	defer span.End()
+
+	slog.DebugContext(ctx, "reading attribute history for tenant_id", slog.String("tenant_id", tenantID))
+
+	builder := r.historyBuilder(AttributesTable, tenantID, "v.entity_type, v.entity_id, v.attribute, v.value")
+	builder = utils.AttributesFilterQueryForSelectBuilder(builder, filter)
+	builder, err = historyPage(builder, pagination)
+	if err != nil {
+		return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SQL_BUILDER)
+	}
+
+	rows, err := r.database.ReadPool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
+	}
+	defer rows.Close()
+
+	var lastID uint64
+	records = make([]*base.DataHistoryRecord, 0, pagination.PageSize()+1)
+	for rows.Next() {
+		at := storage.Attribute{}
+		var v historyVersion
+		var valueStr string
+		err = rows.Scan(&at.ID, &at.EntityType, &at.EntityID, &at.Attribute, &valueStr, &v.createdTxID, &v.createdAt, &v.createdMetadata, &v.expiredTxID, &v.expiredAt, &v.expiredMetadata)
+		if err != nil {
+			return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
+		}
+		lastID = at.ID
+
+		at.Value = &anypb.Any{}
+		if err = protojson.Unmarshal([]byte(valueStr), at.Value); err != nil {
+			return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INTERNAL)
+		}
+		record, err := v.record(&base.DataHistoryRecord{Item: &base.DataHistoryRecord_Attribute{Attribute: at.ToAttribute()}})
+		if err != nil {
+			return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INTERNAL)
+		}
+		records = append(records, record)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
+	}
+
+	if pagination.PageSize() != 0 && len(records) > int(pagination.PageSize()) {
+		return records[:pagination.PageSize()], utils.NewContinuousToken(strconv.FormatUint(lastID, 10)).Encode(), nil
+	}
+	return records, database.NewNoopContinuousToken().Encode(), nil
+}
+
+// historyBuilder selects the versions of a table with the transactions they were written and expired in. Versions that
+// expired before the garbage collection window are left out, since the garbage collector may have deleted them already.
+func (r *DataReader) historyBuilder(table, tenantID, columns string) squirrel.SelectBuilder {
+	builder := r.database.Builder.Select("v.id, " + columns + ", v.created_tx_id, c.timestamp, c.metadata, v.expired_tx_id, e.timestamp, e.metadata").
+		From(table + " AS v").
+		LeftJoin(TransactionsTable + " AS c ON c.id = v.created_tx_id").
+		LeftJoin(TransactionsTable + " AS e ON e.id = v.expired_tx_id").
+		Where(squirrel.Eq{"v.tenant_id": tenantID})
+
+	if window := r.database.GetGarbageCollectionWindow(); window > 0 {
+		builder = builder.Where(squirrel.Or{
+			squirrel.Eq{"v.expired_tx_id": utils.ActiveRecordTxnID},
+			squirrel.GtOrEq{"e.timestamp": time.Now().UTC().Add(-window)},
+		})
+	}
+	return builder
+}
+
+// historyPage applies the continuous token and the page size of the pagination to a history query.
+func historyPage(builder squirrel.SelectBuilder, pagination database.Pagination) (squirrel.SelectBuilder, error) {
+	if pagination.Token() != "" {
+		t, err := utils.EncodedContinuousToken{Value: pagination.Token()}.Decode()
+		if err != nil {
+			return builder, err
+		}
+		v, err := strconv.ParseUint(t.(utils.ContinuousToken).Value, 10, 64)
+		if err != nil {
+			return builder, err
+		}
+		builder = builder.Where(squirrel.GtOrEq{"v.id": v})
+	}
+
+	builder = builder.OrderBy("v.id")
+	if pagination.PageSize() != 0 {
+		builder = builder.Limit(uint64(pagination.PageSize() + 1))
+	}
+	return builder, nil
+}
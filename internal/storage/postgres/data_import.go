@@ -0,0 +1,258 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/Permify/permify/internal"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/storage/postgres/snapshot"
+	"github.com/Permify/permify/internal/storage/postgres/utils"
+	db "github.com/Permify/permify/pkg/database/postgres"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+const (
+	// importTuplesTable and importAttributesTable are the staging tables rows are copied into
+	// before they are merged, they only live as long as the transaction that created them.
+	importTuplesTable     = "import_relation_tuples"
+	importAttributesTable = "import_attributes"
+
+	createImportTablesQuery = `CREATE TEMPORARY TABLE import_relation_tuples (entity_type VARCHAR NOT NULL, entity_id VARCHAR NOT NULL, relation VARCHAR NOT NULL, subject_type VARCHAR NOT NULL, subject_id VARCHAR NOT NULL, subject_relation VARCHAR NOT NULL) ON COMMIT DROP;
+CREATE TEMPORARY TABLE import_attributes (ord BIGSERIAL, entity_type VARCHAR NOT NULL, entity_id VARCHAR NOT NULL, attribute VARCHAR NOT NULL, value JSONB NOT NULL) ON COMMIT DROP`
+
+	// mergeImportTuplesQuery inserts the staged tuples, skipping the ones that are already active.
+	mergeImportTuplesQuery = `INSERT INTO relation_tuples (entity_type, entity_id, relation, subject_type, subject_id, subject_relation, created_tx_id, tenant_id)
+SELECT DISTINCT entity_type, entity_id, relation, subject_type, subject_id, subject_relation, $1::xid8, $2 FROM import_relation_tuples
+ON CONFLICT ON CONSTRAINT uq_relation_tuple_not_expired DO NOTHING`
+
+	// importEntityTypesQuery lists the entity types of the staged tuples.
+	importEntityTypesQuery = `SELECT DISTINCT entity_type FROM import_relation_tuples`
+
+	// importAssignsManyQuery reports whether the staged tuples assign more than one subject to an entity through a relation.
+	importAssignsManyQuery = `SELECT EXISTS (SELECT 1 FROM import_relation_tuples WHERE entity_type = $1 AND relation = $2
+GROUP BY entity_id HAVING COUNT(DISTINCT (subject_type, subject_id, subject_relation)) > 1)`
+
+	// importConflictsQuery reports whether a staged tuple assigns another subject than the active one to an entity through a relation.
+	importConflictsQuery = `SELECT EXISTS (SELECT 1 FROM relation_tuples AS r JOIN import_relation_tuples AS i
+ON r.entity_type = i.entity_type AND r.entity_id = i.entity_id AND r.relation = i.relation
+WHERE r.tenant_id = $1 AND r.expired_tx_id = $2::xid8 AND i.entity_type = $3 AND i.relation = $4
+AND (r.subject_type, r.subject_id, r.subject_relation) <> (i.subject_type, i.subject_id, i.subject_relation))`
+
+	// expireImportReplacedQuery expires the active tuples whose subject a staged tuple replaces through a relation.
+	expireImportReplacedQuery = `UPDATE relation_tuples AS r SET expired_tx_id = $1::xid8 FROM import_relation_tuples AS i
+WHERE r.tenant_id = $2 AND r.expired_tx_id = $3::xid8 AND i.entity_type = $4 AND i.relation = $5
+AND r.entity_type = i.entity_type AND r.entity_id = i.entity_id AND r.relation = i.relation
+AND (r.subject_type, r.subject_id, r.subject_relation) <> (i.subject_type, i.subject_id, i.subject_relation)`
+
+	// expireImportAttributesQuery expires the active attributes that the staged attributes replace.
+	expireImportAttributesQuery = `UPDATE attributes AS a SET expired_tx_id = $1::xid8 FROM import_attributes AS i
+WHERE a.tenant_id = $2 AND a.expired_tx_id = $3::xid8 AND a.entity_type = i.entity_type AND a.entity_id = i.entity_id AND a.attribute = i.attribute`
+
+	// mergeImportAttributesQuery inserts the staged attributes, the last one staged wins when an attribute is given more than once.
+	mergeImportAttributesQuery = `INSERT INTO attributes (entity_type, entity_id, attribute, value, created_tx_id, tenant_id)
+SELECT DISTINCT ON (entity_type, entity_id, attribute) entity_type, entity_id, attribute, value, $1::xid8, $2 FROM import_attributes
+ORDER BY entity_type, entity_id, attribute, ord DESC`
+)
+
+// DataImport - Bulk loads data into a tenant. Rows are copied with COPY into staging tables,
+// which are merged into relation_tuples and attributes when the transaction is committed.
+type DataImport struct {
+	database  *db.Postgres
+	txOptions pgx.TxOptions
+	tenantID  string
+
+	// current transaction, nil until rows are loaded after the last commit
+	tx            pgx.Tx
+	xid           db.XID8
+	snapshotValue string
+}
+
+// Import starts a bulk load of data for a tenant.
+func (w *DataWriter) Import(_ context.Context, tenantID string) (storage.DataImport, error) {
+	return &DataImport{
+		database:  w.database,
+		txOptions: w.txOptions,
+		tenantID:  tenantID,
+	}, nil
+}
+
+// Load copies tuples and attributes into the staging tables of the current transaction.
+func (i *DataImport) Load(ctx context.Context, tuples []*base.Tuple, attributes []*base.Attribute) (err error) {
+	ctx, span := internal.Tracer.Start(ctx, "data-import.load")
+	defer span.End()
+
+	if err = i.begin(ctx); err != nil {
+		return utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_DATASTORE)
+	}
+
+	if len(tuples) > 0 {
+		rows := make([][]any, 0, len(tuples))
+		for _, t := range tuples {
+			srelation := t.GetSubject().GetRelation()
+			if srelation == tuple.ELLIPSIS {
+				srelation = ""
+			}
+			rows = append(rows, []any{t.GetEntity().GetType(), t.GetEntity().GetId(), t.GetRelation(), t.GetSubject().GetType(), t.GetSubject().GetId(), srelation})
+		}
+		if _, err = i.tx.CopyFrom(ctx, pgx.Identifier{importTuplesTable}, []string{"entity_type", "entity_id", "relation", "subject_type", "subject_id", "subject_relation"}, pgx.CopyFromRows(rows)); err != nil {
+			return utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_DATASTORE)
+		}
+	}
+
+	if len(attributes) > 0 {
+		rows := make([][]any, 0, len(attributes))
+		for _, a := range attributes {
+			jsonBytes, err := protojson.Marshal(a.GetValue())
+			if err != nil {
+				return utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INTERNAL)
+			}
+			rows = append(rows, []any{a.GetEntity().GetType(), a.GetEntity().GetId(), a.GetAttribute(), string(jsonBytes)})
+		}
+		if _, err = i.tx.CopyFrom(ctx, pgx.Identifier{importAttributesTable}, []string{"entity_type", "entity_id", "attribute", "value"}, pgx.CopyFromRows(rows)); err != nil {
+			return utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_DATASTORE)
+		}
+	}
+
+	return nil
+}
+
+// Commit merges the staged rows and commits the current transaction.
+func (i *DataImport) Commit(ctx context.Context) (token.EncodedSnapToken, error) {
+	ctx, span := internal.Tracer.Start(ctx, "data-import.commit")
+	defer span.End()
+
+	// a commit without loaded rows still records a transaction, so the caller always gets a token
+	if err := i.begin(ctx); err != nil {
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_DATASTORE)
+	}
+
+	tx := i.tx
+	i.tx = nil
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if err := i.enforceCardinality(ctx, tx); err != nil {
+		if isReportedAsIs(err) {
+			return nil, err
+		}
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_DATASTORE)
+	}
+	if _, err := tx.Exec(ctx, mergeImportTuplesQuery, i.xid, i.tenantID); err != nil {
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_DATASTORE)
+	}
+	if _, err := tx.Exec(ctx, expireImportAttributesQuery, i.xid, i.tenantID, utils.ActiveRecordTxnID); err != nil {
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_DATASTORE)
+	}
+	if _, err := tx.Exec(ctx, mergeImportAttributesQuery, i.xid, i.tenantID); err != nil {
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_DATASTORE)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_DATASTORE)
+	}
+
+	slog.DebugContext(ctx, "import transaction committed", slog.String("tenant_id", i.tenantID), slog.Any("xid", i.xid))
+
+	return snapshot.NewToken(i.xid, i.snapshotValue).Encode(), nil
+}
+
+// enforceCardinality checks the staged tuples against the single cardinality relations of the head schema, as Write
+// does. Staged tuples assigning several subjects to an entity reject the commit, and so do active tuples of another
+// subject unless the relation is declared with the replace modifier, in which case they are expired.
+func (i *DataImport) enforceCardinality(ctx context.Context, tx pgx.Tx) error {
+	rows, err := tx.Query(ctx, importEntityTypesQuery)
+	if err != nil {
+		return err
+	}
+	names, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	definitions, err := readEntityDefinitions(ctx, tx, i.database.Builder, i.tenantID, "", names)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		definition, ok := definitions[name]
+		if !ok {
+			continue
+		}
+		for _, relation := range definition.GetRelations() {
+			if relation.GetCardinality() != base.RelationDefinition_CARDINALITY_SINGLE {
+				continue
+			}
+
+			var violated bool
+			if err = tx.QueryRow(ctx, importAssignsManyQuery, name, relation.GetName()).Scan(&violated); err != nil {
+				return err
+			}
+			if violated {
+				return errors.New(base.ErrorCode_ERROR_CODE_CARDINALITY_VIOLATION.String())
+			}
+
+			if relation.GetOnConflict() == base.RelationDefinition_ON_CONFLICT_REPLACE {
+				if _, err = tx.Exec(ctx, expireImportReplacedQuery, i.xid, i.tenantID, utils.ActiveRecordTxnID, name, relation.GetName()); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err = tx.QueryRow(ctx, importConflictsQuery, i.tenantID, utils.ActiveRecordTxnID, name, relation.GetName()).Scan(&violated); err != nil {
+				return err
+			}
+			if violated {
+				return errors.New(base.ErrorCode_ERROR_CODE_CARDINALITY_VIOLATION.String())
+			}
+		}
+	}
+	return nil
+}
+
+// Close rolls back the rows loaded since the last commit.
+func (i *DataImport) Close(ctx context.Context) error {
+	if i.tx == nil {
+		return nil
+	}
+	tx := i.tx
+	i.tx = nil
+	return tx.Rollback(ctx)
+}
+
+// begin starts the transaction of the import and its staging tables, unless one is already running.
+func (i *DataImport) begin(ctx context.Context) (err error) {
+	if i.tx != nil {
+		return nil
+	}
+
+	var tx pgx.Tx
+	tx, err = i.database.WritePool.BeginTx(ctx, i.txOptions)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.QueryRow(ctx, utils.TransactionTemplate, i.tenantID, nil).Scan(&i.xid, &i.snapshotValue); err != nil {
+		_ = tx.Rollback(ctx)
+		return err
+	}
+
+	if _, err = tx.Exec(ctx, createImportTablesQuery); err != nil {
+		_ = tx.Rollback(ctx)
+		return err
+	}
+
+	i.tx = tx
+	return nil
+}
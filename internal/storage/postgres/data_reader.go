@@ -5,8 +5,11 @@ import (
 	"errors"
 	"log/slog" // Structured logging
 	"strconv"
+	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/Masterminds/squirrel"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -20,10 +23,12 @@ import (
 	db "github.com/Permify/permify/pkg/database/postgres"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 	"github.com/Permify/permify/pkg/token"
+	"github.com/Permify/permify/pkg/tuple"
 )
 
 // DataReader is a struct which holds a reference to the database, transaction options and a logger.
-// It is responsible for reading data from the database.
+// It is responsible for reading data from the database. Reads at a snapshot are sent to a read replica that
+// replayed the transaction of the snapshot, or to the writer if none did in time.
 type DataReader struct {
 	database  *db.Postgres  // database is an instance of the PostgreSQL database
 	txOptions pgx.TxOptions // txOptions specifies the isolation level for database transaction and sets it as read only
@@ -58,6 +63,10 @@ func (r *DataReader) QueryRelationships(ctx context.Context, tenantID string, fi
 	builder := r.database.Builder.Select("entity_type, entity_id, relation, subject_type, subject_id, subject_relation").From(RelationTuplesTable).Where(squirrel.Eq{"tenant_id": tenantID})
 	builder = utils.TuplesFilterQueryForSelectBuilder(builder, filter)
 	builder = utils.SnapshotQuery(builder, st.(snapshot.Token).Value.Uint, st.(snapshot.Token).Snapshot)
+	builder, err = predicatesQuery(builder, RelationTuplesTable, filter.GetEntity(), filter.GetSubject(), st.(snapshot.Token))
+	if err != nil {
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT)
+	}
 
 	if pagination.Cursor() != "" {
 		var t database.ContinuousToken
@@ -89,7 +98,7 @@ func (r *DataReader) QueryRelationships(ctx context.Context, tenantID string, fi
 	// Execute query
 	// Execute the SQL query and retrieve the result rows.
 	var rows pgx.Rows
-	rows, err = r.database.ReadPool.Query(ctx, query, args...)
+	rows, err = r.database.ReadPoolAt(ctx, st.(snapshot.Token).Value.Uint).Query(ctx, query, args...)
 	if err != nil {
 		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
 	}
@@ -114,6 +123,124 @@ func (r *DataReader) QueryRelationships(ctx context.Context, tenantID string, fi
 	return collection.CreateTupleIterator(), nil
 }
 
+// CountSubjects counts the distinct subjects, by type and id, of the relation tuples in the storage that match the
+// given filter. Wildcard subjects are not counted.
+func (r *DataReader) CountSubjects(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (count int, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "data-reader.count-subjects")
+	defer span.End()
+
+	slog.DebugContext(ctx, "counting subjects for tenant_id", slog.String("tenant_id", tenantID))
+
+	// Decode the snapshot value.
+	var st token.SnapToken
+	st, err = snapshot.EncodedToken{Value: snap}.Decode()
+	if err != nil {
+		return 0, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INTERNAL)
+	}
+
+	// Build the count query based on the provided filter and snapshot value.
+	builder := r.database.Builder.Select("COUNT(DISTINCT (subject_type, subject_id))").From(RelationTuplesTable).
+		Where(squirrel.Eq{"tenant_id": tenantID}).
+		Where(squirrel.NotEq{"subject_id": tuple.WILDCARD})
+	builder = utils.TuplesFilterQueryForSelectBuilder(builder, filter)
+	builder = utils.SnapshotQuery(builder, st.(snapshot.Token).Value.Uint, st.(snapshot.Token).Snapshot)
+	builder, err = predicatesQuery(builder, RelationTuplesTable, filter.GetEntity(), filter.GetSubject(), st.(snapshot.Token))
+	if err != nil {
+		return 0, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT)
+	}
+
+	// Generate the SQL query and arguments.
+	var query string
+	var args []interface{}
+	query, args, err = builder.ToSql()
+	if err != nil {
+		return 0, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SQL_BUILDER)
+	}
+
+	slog.DebugContext(ctx, "generated sql query", slog.String("query", query), "with args", slog.Any("arguments", args))
+
+	// Execute the SQL query and scan the resulting count.
+	err = r.database.ReadPoolAt(ctx, st.(snapshot.Token).Value.Uint).QueryRow(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return 0, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
+	}
+
+	slog.DebugContext(ctx, "successfully counted subjects", slog.Int("count", count))
+
+	return count, nil
+}
+
+// QueryRelationshipHotspots counts the relation tuples in the storage grouped by their entity and relation, or by
+// their subject, and returns the groups with the most tuples, most first.
+func (r *DataReader) QueryRelationshipHotspots(ctx context.Context, tenantID string, group storage.HotspotGroup, snap string, limit uint32) (hotspots []*base.DataHotspot, err error) {
+	// Start a new trace span and end it when the function exits.
+	ctx, span := internal.Tracer.Start(ctx, "data-reader.query-relationship-hotspots")
+	defer span.End()
+
+	slog.DebugContext(ctx, "querying relationship hotspots for tenant_id", slog.String("tenant_id", tenantID))
+
+	// Decode the snapshot value.
+	var st token.SnapToken
+	st, err = snapshot.EncodedToken{Value: snap}.Decode()
+	if err != nil {
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INTERNAL)
+	}
+
+	// Group the tuples by their entity and relation, or by their subject.
+	columns := "entity_type, entity_id, relation"
+	if group == storage.FanIn {
+		columns = "subject_type, subject_id, subject_relation"
+	}
+
+	builder := r.database.Builder.Select(columns, "COUNT(*) AS count").From(RelationTuplesTable).Where(squirrel.Eq{"tenant_id": tenantID})
+	builder = utils.SnapshotQuery(builder, st.(snapshot.Token).Value.Uint, st.(snapshot.Token).Snapshot)
+	builder = builder.GroupBy(columns).OrderBy("count DESC", columns)
+	if limit > 0 {
+		builder = builder.Limit(uint64(limit))
+	}
+
+	// Generate the SQL query and arguments.
+	var query string
+	var args []interface{}
+	query, args, err = builder.ToSql()
+	if err != nil {
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SQL_BUILDER)
+	}
+
+	slog.DebugContext(ctx, "generated sql query", slog.String("query", query), "with args", slog.Any("arguments", args))
+
+	// Execute the SQL query and scan the groups.
+	var rows pgx.Rows
+	rows, err = r.database.ReadPoolAt(ctx, st.(snapshot.Token).Value.Uint).Query(ctx, query, args...)
+	if err != nil {
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
+	}
+	defer rows.Close()
+
+	hotspots = []*base.DataHotspot{}
+	for rows.Next() {
+		var entityType, entityID, relation string
+		var count int64
+		err = rows.Scan(&entityType, &entityID, &relation, &count)
+		if err != nil {
+			return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
+		}
+		hotspots = append(hotspots, &base.DataHotspot{
+			Entity:   &base.Entity{Type: entityType, Id: entityID},
+			Relation: relation,
+			Count:    uint64(count),
+		})
+	}
+	if err = rows.Err(); err != nil {
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
+	}
+
+	slog.DebugContext(ctx, "successfully queried relationship hotspots", slog.Int("hotspots", len(hotspots)))
+
+	return hotspots, nil
+}
+
 // ReadRelationships reads relation tuples from the storage based on the given filter and pagination.
 func (r *DataReader) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (collection *database.TupleCollection, ct database.EncodedContinuousToken, err error) {
 	// Start a new trace span and end it when the function exits.
@@ -133,6 +260,10 @@ func (r *DataReader) ReadRelationships(ctx context.Context, tenantID string, fil
 	builder := r.database.Builder.Select("id, entity_type, entity_id, relation, subject_type, subject_id, subject_relation").From(RelationTuplesTable).Where(squirrel.Eq{"tenant_id": tenantID})
 	builder = utils.TuplesFilterQueryForSelectBuilder(builder, filter)
 	builder = utils.SnapshotQuery(builder, st.(snapshot.Token).Value.Uint, st.(snapshot.Token).Snapshot)
+	builder, err = predicatesQuery(builder, RelationTuplesTable, filter.GetEntity(), filter.GetSubject(), st.(snapshot.Token))
+	if err != nil {
+		return nil, database.NewNoopContinuousToken().Encode(), utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT)
+	}
 
 	// Apply the pagination token and limit to the query.
 	if pagination.Token() != "" {
@@ -167,7 +298,7 @@ func (r *DataReader) ReadRelationships(ctx context.Context, tenantID string, fil
 	// Execute query
 	// Execute the query and retrieve the rows.
 	var rows pgx.Rows
-	rows, err = r.database.ReadPool.Query(ctx, query, args...)
+	rows, err = r.database.ReadPoolAt(ctx, st.(snapshot.Token).Value.Uint).Query(ctx, query, args...)
 	if err != nil {
 		return nil, database.NewNoopContinuousToken().Encode(), utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
 	}
@@ -220,6 +351,10 @@ func (r *DataReader) QuerySingleAttribute(ctx context.Context, tenantID string,
 	builder := r.database.Builder.Select("entity_type, entity_id, attribute, value").From(AttributesTable).Where(squirrel.Eq{"tenant_id": tenantID})
 	builder = utils.AttributesFilterQueryForSelectBuilder(builder, filter)
 	builder = utils.SnapshotQuery(builder, st.(snapshot.Token).Value.Uint, st.(snapshot.Token).Snapshot)
+	builder, err = predicatesQuery(builder, AttributesTable, filter.GetEntity(), nil, st.(snapshot.Token))
+	if err != nil {
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT)
+	}
 
 	// Generate the SQL query and arguments.
 	var query string
@@ -230,7 +365,7 @@ func (r *DataReader) QuerySingleAttribute(ctx context.Context, tenantID string,
 
 	slog.DebugContext(ctx, "generated sql query", slog.String("query", query), "with args", slog.Any("arguments", args))
 	// Execute query
-	row := r.database.ReadPool.QueryRow(ctx, query, args...)
+	row := r.database.ReadPoolAt(ctx, st.(snapshot.Token).Value.Uint).QueryRow(ctx, query, args...)
 
 	rt := storage.Attribute{}
 
@@ -279,6 +414,10 @@ func (r *DataReader) QueryAttributes(ctx context.Context, tenantID string, filte
 	builder := r.database.Builder.Select("entity_type, entity_id, attribute, value").From(AttributesTable).Where(squirrel.Eq{"tenant_id": tenantID})
 	builder = utils.AttributesFilterQueryForSelectBuilder(builder, filter)
 	builder = utils.SnapshotQuery(builder, st.(snapshot.Token).Value.Uint, st.(snapshot.Token).Snapshot)
+	builder, err = predicatesQuery(builder, AttributesTable, filter.GetEntity(), nil, st.(snapshot.Token))
+	if err != nil {
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT)
+	}
 
 	if pagination.Cursor() != "" {
 		var t database.ContinuousToken
@@ -309,7 +448,7 @@ func (r *DataReader) QueryAttributes(ctx context.Context, tenantID string, filte
 	slog.DebugContext(ctx, "generated sql query", slog.String("query", query), "with args", slog.Any("arguments", args))
 	// Execute the SQL query and retrieve the result rows.
 	var rows pgx.Rows
-	rows, err = r.database.ReadPool.Query(ctx, query, args...)
+	rows, err = r.database.ReadPoolAt(ctx, st.(snapshot.Token).Value.Uint).Query(ctx, query, args...)
 	if err != nil {
 		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
 	}
@@ -367,6 +506,10 @@ func (r *DataReader) ReadAttributes(ctx context.Context, tenantID string, filter
 	builder := r.database.Builder.Select("id, entity_type, entity_id, attribute, value").From(AttributesTable).Where(squirrel.Eq{"tenant_id": tenantID})
 	builder = utils.AttributesFilterQueryForSelectBuilder(builder, filter)
 	builder = utils.SnapshotQuery(builder, st.(snapshot.Token).Value.Uint, st.(snapshot.Token).Snapshot)
+	builder, err = predicatesQuery(builder, AttributesTable, filter.GetEntity(), nil, st.(snapshot.Token))
+	if err != nil {
+		return nil, database.NewNoopContinuousToken().Encode(), utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT)
+	}
 
 	// Apply the pagination token and limit to the query.
 	if pagination.Token() != "" {
@@ -401,7 +544,7 @@ func (r *DataReader) ReadAttributes(ctx context.Context, tenantID string, filter
 	// Execute query
 	// Execute the query and retrieve the rows.
 	var rows pgx.Rows
-	rows, err = r.database.ReadPool.Query(ctx, query, args...)
+	rows, err = r.database.ReadPoolAt(ctx, st.(snapshot.Token).Value.Uint).Query(ctx, query, args...)
 	if err != nil {
 		return nil, database.NewNoopContinuousToken().Encode(), utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
 	}
@@ -449,7 +592,7 @@ func (r *DataReader) ReadAttributes(ctx context.Context, tenantID string, filter
 }
 
 // QueryUniqueSubjectReferences reads unique subject references from the storage based on the given filter and pagination.
-func (r *DataReader) QueryUniqueSubjectReferences(ctx context.Context, tenantID string, subjectReference *base.RelationReference, excluded []string, snap string, pagination database.Pagination) (ids []string, ct database.EncodedContinuousToken, err error) {
+func (r *DataReader) QueryUniqueSubjectReferences(ctx context.Context, tenantID string, filter *base.SubjectFilter, excluded []string, snap string, pagination database.Pagination) (ids []string, ct database.EncodedContinuousToken, err error) {
 	// Start a new trace span and end it when the function exits.
 	ctx, span := internal.Tracer.Start(ctx, "data-reader.query-unique-subject-reference")
 	defer span.End()
@@ -471,15 +614,14 @@ func (r *DataReader) QueryUniqueSubjectReferences(ctx context.Context, tenantID
 		GroupBy("subject_id")
 
 	// Apply subject filter
-	builder = utils.TuplesFilterQueryForSelectBuilder(builder, &base.TupleFilter{
-		Subject: &base.SubjectFilter{
-			Type:     subjectReference.GetType(),
-			Relation: subjectReference.GetRelation(),
-		},
-	})
+	builder = utils.TuplesFilterQueryForSelectBuilder(builder, &base.TupleFilter{Subject: filter})
 
 	// Apply snapshot filter
 	builder = utils.SnapshotQuery(builder, st.(snapshot.Token).Value.Uint, st.(snapshot.Token).Snapshot)
+	builder, err = predicatesQuery(builder, RelationTuplesTable, nil, filter, st.(snapshot.Token))
+	if err != nil {
+		return nil, database.NewNoopContinuousToken().Encode(), utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT)
+	}
 
 	// Apply exclusion if the list is not empty
 	if len(excluded) > 0 {
@@ -514,7 +656,7 @@ func (r *DataReader) QueryUniqueSubjectReferences(ctx context.Context, tenantID
 	// Execute query
 	// Execute the query and retrieve the rows.
 	var rows pgx.Rows
-	rows, err = r.database.ReadPool.Query(ctx, query, args...)
+	rows, err = r.database.ReadPoolAt(ctx, st.(snapshot.Token).Value.Uint).Query(ctx, query, args...)
 	if err != nil {
 		return nil, database.NewNoopContinuousToken().Encode(), utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
 	}
@@ -556,6 +698,23 @@ func (r *DataReader) HeadSnapshot(ctx context.Context, tenantID string) (token.S
 	defer span.End()
 	// Log snapshot operation
 	slog.DebugContext(ctx, "getting head snapshot for tenant_id", slog.String("tenant_id", tenantID))
+
+	return r.headSnapshot(ctx, span, r.database.ReadPoolAt(ctx, 0), tenantID)
+}
+
+// ConsistentHeadSnapshot retrieves the latest snapshot token associated with the tenant from the writer, a replica
+// may not have replayed the last transactions yet.
+func (r *DataReader) ConsistentHeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	ctx, span := internal.Tracer.Start(ctx, "data-reader.consistent-head-snapshot")
+	defer span.End()
+
+	slog.DebugContext(ctx, "getting consistent head snapshot for tenant_id", slog.String("tenant_id", tenantID))
+
+	return r.headSnapshot(ctx, span, r.database.WritePool, tenantID)
+}
+
+// headSnapshot reads the highest transaction ID and snapshot of the tenant from the given pool.
+func (r *DataReader) headSnapshot(ctx context.Context, span trace.Span, pool *pgxpool.Pool, tenantID string) (token.SnapToken, error) {
 	// Declare transaction ID and snapshot variables
 	var xid db.XID8
 	var snapshotValue string
@@ -571,7 +730,7 @@ func (r *DataReader) HeadSnapshot(ctx context.Context, tenantID string) (token.S
 	// CREATE INDEX CONCURRENTLY idx_transactions_tenant_id_id ON transactions(tenant_id, id DESC);
 
 	// Execute the query and retrieve the highest transaction ID and snapshot.
-	err = r.database.ReadPool.QueryRow(ctx, query, args...).Scan(&xid, &snapshotValue)
+	err = pool.QueryRow(ctx, query, args...).Scan(&xid, &snapshotValue)
 	if err != nil {
 		// If no rows are found, return a snapshot token with a value of 0.
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -581,7 +740,52 @@ func (r *DataReader) HeadSnapshot(ctx context.Context, tenantID string) (token.S
 	}
 
 	slog.DebugContext(ctx, "successfully retrieved latest snapshot token")
-	// Return snapshot token
 	// Return the latest snapshot token associated with the tenant.
 	return snapshot.NewToken(xid, snapshotValue), nil
 }
+
+// SnapshotAt retrieves the snapshot token of the last transaction of the tenant committed at or before a point in time.
+func (r *DataReader) SnapshotAt(ctx context.Context, tenantID string, at time.Time) (token.SnapToken, error) {
+	ctx, span := internal.Tracer.Start(ctx, "data-reader.snapshot-at")
+	defer span.End()
+
+	slog.DebugContext(ctx, "getting snapshot at time for tenant_id", slog.String("tenant_id", tenantID), slog.Time("at", at))
+
+	// The garbage collector only keeps the data needed to read within its window
+	if window := r.database.GetGarbageCollectionWindow(); window > 0 && at.Before(time.Now().Add(-window)) {
+		return nil, utils.HandleError(ctx, span, errors.New("time is older than the garbage collection window"), base.ErrorCode_ERROR_CODE_HISTORY_UNAVAILABLE)
+	}
+
+	var xid db.XID8
+	var snapshotValue string
+
+	// transactions stores its timestamps in UTC without a time zone
+	builder := r.database.Builder.Select("id", "snapshot").From(TransactionsTable).
+		Where(squirrel.Eq{"tenant_id": tenantID}).
+		Where(squirrel.LtOrEq{"timestamp": at.UTC()}).
+		OrderBy("id DESC").Limit(1)
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SQL_BUILDER)
+	}
+
+	err = r.database.ReadPoolAt(ctx, 0).QueryRow(ctx, query, args...).Scan(&xid, &snapshotValue)
+	if err != nil {
+		// Nothing was written to the tenant yet at that time
+		if errors.Is(err, pgx.ErrNoRows) {
+			return snapshot.NewToken(db.XID8{Uint: 0}, ""), nil
+		}
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
+	}
+
+	return snapshot.NewToken(xid, snapshotValue), nil
+}
+
+// predicatesQuery - Restricts a select on a table to the rows whose entity and subject satisfy the predicates of their filters
+func predicatesQuery(builder squirrel.SelectBuilder, table string, entity *base.EntityFilter, subject *base.SubjectFilter, st snapshot.Token) (squirrel.SelectBuilder, error) {
+	builder, err := utils.PredicatesQueryForSelectBuilder(builder, AttributesTable, table, "entity", entity.GetPredicates(), st.Value.Uint, st.Snapshot)
+	if err != nil {
+		return builder, err
+	}
+	return utils.PredicatesQueryForSelectBuilder(builder, AttributesTable, table, "subject", subject.GetPredicates(), st.Value.Uint, st.Snapshot)
+}
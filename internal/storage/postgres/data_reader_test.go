@@ -8,8 +8,10 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/Permify/permify/internal/storage"
 	"github.com/Permify/permify/pkg/attribute"
 	"github.com/Permify/permify/pkg/database"
+	PQDatabase "github.com/Permify/permify/pkg/database/postgres"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 	"github.com/Permify/permify/pkg/testinstance"
 	"github.com/Permify/permify/pkg/token"
@@ -86,6 +88,125 @@ var _ = Describe("DataReader", func() {
 		})
 	})
 
+	Context("Snapshot At", func() {
+		It("should retrieve the snapshot of the last write at or before a point in time", func() {
+			ctx := context.Background()
+
+			tup1, err := tuple.Tuple("organization:organization-1#admin@user:user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tup2, err := tuple.Tuple("organization:organization-1#admin@user:user-2")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			token1, err := dataWriter.Write(ctx, "t1", database.NewTupleCollection(tup1), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			time.Sleep(time.Millisecond * 10)
+			at := time.Now()
+			time.Sleep(time.Millisecond * 10)
+
+			_, err = dataWriter.Write(ctx, "t1", database.NewTupleCollection(tup2), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			snap, err := dataReader.SnapshotAt(ctx, "t1", at)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(snap.Encode()).Should(Equal(token1))
+
+			col, _, err := dataReader.ReadRelationships(ctx, "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{Type: "organization"},
+			}, snap.Encode().String(), database.NewPagination(database.Size(10)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(len(col.GetTuples())).Should(Equal(1))
+		})
+
+		It("should refuse a point in time older than the garbage collection window", func() {
+			ctx := context.Background()
+
+			PQDatabase.GarbageCollectionWindow(time.Hour)(db.Postgres)
+
+			_, err := dataReader.SnapshotAt(ctx, "t1", time.Now().Add(-2*time.Hour))
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_HISTORY_UNAVAILABLE.String()))
+
+			_, err = dataReader.SnapshotAt(ctx, "t1", time.Now().Add(-time.Minute))
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+	})
+
+	Context("History", func() {
+		It("should list the written and deleted versions of relationships", func() {
+			ctx := context.Background()
+
+			tup1, err := tuple.Tuple("organization:organization-1#admin@user:user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tup2, err := tuple.Tuple("organization:organization-1#admin@user:user-2")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = dataWriter.Write(ctx, "t1", database.NewTupleCollection(tup1, tup2), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = dataWriter.Delete(ctx, "t1", &base.TupleFilter{
+				Entity:   &base.EntityFilter{Type: "organization", Ids: []string{"organization-1"}},
+				Relation: "admin",
+				Subject:  &base.SubjectFilter{Type: "user", Ids: []string{"user-1"}},
+			}, &base.AttributeFilter{}, storage.TransactionMetadata(&base.TransactionMetadata{Actor: "alice", Reason: "TICKET-1"}))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			records, ct, err := dataReader.ReadRelationshipHistory(ctx, "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{Type: "organization"},
+			}, database.NewPagination(database.Size(1)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(records).Should(HaveLen(1))
+			Expect(tuple.ToString(records[0].GetTuple())).Should(Equal("organization:organization-1#admin@user:user-1"))
+			Expect(records[0].GetCreatedTxId()).ShouldNot(Equal(uint64(0)))
+			Expect(records[0].GetCreatedAt()).ShouldNot(BeNil())
+			Expect(records[0].GetExpiredTxId()).Should(BeNumerically(">", records[0].GetCreatedTxId()))
+			Expect(records[0].GetExpiredAt()).ShouldNot(BeNil())
+			Expect(records[0].GetCreatedMetadata()).Should(BeNil())
+			Expect(records[0].GetExpiredMetadata().GetActor()).Should(Equal("alice"))
+			Expect(records[0].GetExpiredMetadata().GetReason()).Should(Equal("TICKET-1"))
+			Expect(ct.String()).ShouldNot(BeEmpty())
+
+			records, ct, err = dataReader.ReadRelationshipHistory(ctx, "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{Type: "organization"},
+			}, database.NewPagination(database.Size(1), database.Token(ct.String())))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(records).Should(HaveLen(1))
+			Expect(tuple.ToString(records[0].GetTuple())).Should(Equal("organization:organization-1#admin@user:user-2"))
+			Expect(records[0].GetExpiredTxId()).Should(Equal(uint64(0)))
+			Expect(records[0].GetExpiredAt()).Should(BeNil())
+			Expect(ct.String()).Should(BeEmpty())
+		})
+
+		It("should list the replaced values of attributes", func() {
+			ctx := context.Background()
+
+			attr1, err := attribute.Attribute("organization:organization-1$public|boolean:true")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			attr2, err := attribute.Attribute("organization:organization-1$public|boolean:false")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = dataWriter.Write(ctx, "t1", database.NewTupleCollection(), database.NewAttributeCollection(attr1))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = dataWriter.Write(ctx, "t1", database.NewTupleCollection(), database.NewAttributeCollection(attr2))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			records, _, err := dataReader.ReadAttributeHistory(ctx, "t1", &base.AttributeFilter{
+				Entity:     &base.EntityFilter{Type: "organization", Ids: []string{"organization-1"}},
+				Attributes: []string{"public"},
+			}, database.NewPagination(database.Size(10)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(records).Should(HaveLen(2))
+			Expect(attribute.ToString(records[0].GetAttribute())).Should(Equal(attribute.ToString(attr1)))
+			Expect(records[0].GetExpiredTxId()).ShouldNot(Equal(uint64(0)))
+			Expect(attribute.ToString(records[1].GetAttribute())).Should(Equal(attribute.ToString(attr2)))
+			Expect(records[1].GetExpiredTxId()).Should(Equal(uint64(0)))
+		})
+	})
+
 	Context("Query Relationships", func() {
 		It("should write relationships and query relationships correctly", func() {
 			ctx := context.Background()
@@ -142,6 +263,126 @@ var _ = Describe("DataReader", func() {
 		})
 	})
 
+	Context("Count Subjects", func() {
+		It("should write relationships and count their subjects correctly", func() {
+			ctx := context.Background()
+
+			tup1, err := tuple.Tuple("document:document-1#approver@user:user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tup2, err := tuple.Tuple("document:document-1#approver@user:user-2")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tup3, err := tuple.Tuple("document:document-1#owner@user:user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			token1, err := dataWriter.Write(ctx, "t1", database.NewTupleCollection(tup1, tup2, tup3), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			filter := &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "document",
+					Ids:  []string{"document-1"},
+				},
+				Relation: "approver",
+			}
+
+			count, err := dataReader.CountSubjects(ctx, "t1", filter, token1.String())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(count).Should(Equal(2))
+
+			token2, err := dataWriter.Delete(ctx, "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "document",
+					Ids:  []string{"document-1"},
+				},
+				Relation: "approver",
+				Subject: &base.SubjectFilter{
+					Type: "user",
+					Ids:  []string{"user-2"},
+				},
+			}, &base.AttributeFilter{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			count, err = dataReader.CountSubjects(ctx, "t1", filter, token2.String())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(count).Should(Equal(1))
+
+			// The count at the earlier snapshot is not affected by the deletion.
+			count, err = dataReader.CountSubjects(ctx, "t1", filter, token1.String())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(count).Should(Equal(2))
+		})
+
+		It("should count a subject once and leave wildcards out", func() {
+			ctx := context.Background()
+
+			collection := database.NewTupleCollection()
+			for _, t := range []string{
+				"document:document-1#approver@group:group-1#member",
+				"document:document-1#approver@group:group-1#admin",
+				"document:document-1#approver@user:user-1",
+				"document:document-1#approver@user:*",
+			} {
+				tup, err := tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				collection.Add(tup)
+			}
+
+			token, err := dataWriter.Write(ctx, "t1", collection, database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			count, err := dataReader.CountSubjects(ctx, "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "document",
+					Ids:  []string{"document-1"},
+				},
+				Relation: "approver",
+			}, token.String())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(count).Should(Equal(2))
+		})
+	})
+
+	Context("Query Relationship Hotspots", func() {
+		It("should group and count relationships by entity and by subject", func() {
+			ctx := context.Background()
+
+			tup1, err := tuple.Tuple("document:document-1#approver@user:user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tup2, err := tuple.Tuple("document:document-1#approver@user:user-2")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tup3, err := tuple.Tuple("document:document-2#approver@organization:organization-1#member")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tup4, err := tuple.Tuple("document:document-3#owner@organization:organization-1#member")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			token1, err := dataWriter.Write(ctx, "t1", database.NewTupleCollection(tup1, tup2, tup3, tup4), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fanOut, err := dataReader.QueryRelationshipHotspots(ctx, "t1", storage.FanOut, token1.String(), 0)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(fanOut).Should(HaveLen(3))
+			Expect(fanOut[0].GetEntity()).Should(Equal(&base.Entity{Type: "document", Id: "document-1"}))
+			Expect(fanOut[0].GetRelation()).Should(Equal("approver"))
+			Expect(fanOut[0].GetCount()).Should(Equal(uint64(2)))
+
+			fanIn, err := dataReader.QueryRelationshipHotspots(ctx, "t1", storage.FanIn, token1.String(), 1)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(fanIn).Should(HaveLen(1))
+			Expect(fanIn[0].GetEntity()).Should(Equal(&base.Entity{Type: "organization", Id: "organization-1"}))
+			Expect(fanIn[0].GetRelation()).Should(Equal("member"))
+			Expect(fanIn[0].GetCount()).Should(Equal(uint64(2)))
+
+			empty, err := dataReader.QueryRelationshipHotspots(ctx, "t2", storage.FanOut, token1.String(), 0)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(empty).Should(BeEmpty())
+		})
+	})
+
 	Context("Read Relationships", func() {
 		It("should write relationships and read relationships correctly", func() {
 			ctx := context.Background()
@@ -455,14 +696,14 @@ var _ = Describe("DataReader", func() {
 			token1, err := dataWriter.Write(ctx, "t1", tuples1, attributes1)
 			Expect(err).ShouldNot(HaveOccurred())
 
-			refs1, ct1, err := dataReader.QueryUniqueSubjectReferences(ctx, "t1", &base.RelationReference{
+			refs1, ct1, err := dataReader.QueryUniqueSubjectReferences(ctx, "t1", &base.SubjectFilter{
 				Type:     "user",
 				Relation: "",
 			}, []string{}, token1.String(), database.NewPagination(database.Size(2), database.Token("")))
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(len(refs1)).Should(Equal(2))
 
-			refs2, ct2, err := dataReader.QueryUniqueSubjectReferences(ctx, "t1", &base.RelationReference{
+			refs2, ct2, err := dataReader.QueryUniqueSubjectReferences(ctx, "t1", &base.SubjectFilter{
 				Type:     "user",
 				Relation: "",
 			}, []string{}, token1.String(), database.NewPagination(database.Size(2), database.Token(ct1.String())))
@@ -470,7 +711,7 @@ var _ = Describe("DataReader", func() {
 			Expect(len(refs2)).Should(Equal(2))
 			Expect(ct2.String()).Should(Equal(""))
 
-			refs3, ct3, err := dataReader.QueryUniqueSubjectReferences(ctx, "t1", &base.RelationReference{
+			refs3, ct3, err := dataReader.QueryUniqueSubjectReferences(ctx, "t1", &base.SubjectFilter{
 				Type:     "user",
 				Relation: "",
 			}, []string{}, token1.String(), database.NewPagination(database.Size(20), database.Token("")))
@@ -480,7 +721,7 @@ var _ = Describe("DataReader", func() {
 
 			Expect(isSameArray(refs3, []string{"user-1", "user-2", "user-3", "user-5"})).Should(BeTrue())
 
-			refs4, ct4, err := dataReader.QueryUniqueSubjectReferences(ctx, "t1", &base.RelationReference{
+			refs4, ct4, err := dataReader.QueryUniqueSubjectReferences(ctx, "t1", &base.SubjectFilter{
 				Type:     "organization",
 				Relation: "member",
 			}, []string{}, token1.String(), database.NewPagination(database.Size(20), database.Token("")))
@@ -908,7 +1149,7 @@ var _ = Describe("DataReader", func() {
 				// Test with invalid continuous token
 				pagination := database.NewPagination(database.Token("invalid_token"))
 
-				_, _, err := dataReader.QueryUniqueSubjectReferences(ctx, "t1", &base.RelationReference{Type: "user"}, []string{}, "0", pagination)
+				_, _, err := dataReader.QueryUniqueSubjectReferences(ctx, "t1", &base.SubjectFilter{Type: "user"}, []string{}, "0", pagination)
 				Expect(err).Should(HaveOccurred())
 				// The error could be either INTERNAL (snapshot decode) or INVALID_CONTINUOUS_TOKEN depending on which fails first
 				Expect(err.Error()).Should(Or(
@@ -927,7 +1168,7 @@ var _ = Describe("DataReader", func() {
 
 				readerWithClosedDB := NewDataReader(closedDB)
 
-				_, _, err = readerWithClosedDB.QueryUniqueSubjectReferences(ctx, "t1", &base.RelationReference{Type: "user"}, []string{}, "0", database.Pagination{})
+				_, _, err = readerWithClosedDB.QueryUniqueSubjectReferences(ctx, "t1", &base.SubjectFilter{Type: "user"}, []string{}, "0", database.Pagination{})
 				Expect(err).Should(HaveOccurred())
 				// The error could be either INTERNAL (snapshot decode) or SQL_BUILDER depending on when the connection fails
 				Expect(err.Error()).Should(Or(
@@ -946,7 +1187,7 @@ var _ = Describe("DataReader", func() {
 
 				readerWithClosedDB := NewDataReader(closedDB)
 
-				_, _, err = readerWithClosedDB.QueryUniqueSubjectReferences(ctx, "t1", &base.RelationReference{Type: "user"}, []string{}, "0", database.Pagination{})
+				_, _, err = readerWithClosedDB.QueryUniqueSubjectReferences(ctx, "t1", &base.SubjectFilter{Type: "user"}, []string{}, "0", database.Pagination{})
 				Expect(err).Should(HaveOccurred())
 				// The error could be INTERNAL, SQL_BUILDER, or EXECUTION depending on when the connection fails
 				Expect(err.Error()).Should(Or(
@@ -966,7 +1207,7 @@ var _ = Describe("DataReader", func() {
 
 				readerWithClosedDB := NewDataReader(closedDB)
 
-				_, _, err = readerWithClosedDB.QueryUniqueSubjectReferences(ctx, "t1", &base.RelationReference{Type: "user"}, []string{}, "0", database.Pagination{})
+				_, _, err = readerWithClosedDB.QueryUniqueSubjectReferences(ctx, "t1", &base.SubjectFilter{Type: "user"}, []string{}, "0", database.Pagination{})
 				Expect(err).Should(HaveOccurred())
 				// The error could be various types depending on when the connection fails
 				Expect(err.Error()).Should(Or(
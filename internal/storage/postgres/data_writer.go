@@ -12,6 +12,8 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/Permify/permify/internal"
+	"github.com/Permify/permify/internal/schema"
+	"github.com/Permify/permify/internal/storage"
 	"github.com/Permify/permify/internal/storage/postgres/snapshot"
 	"github.com/Permify/permify/internal/storage/postgres/utils"
 	"github.com/Permify/permify/internal/validation"
@@ -44,6 +46,7 @@ func (w *DataWriter) Write(
 	tenantID string,
 	tupleCollection *database.TupleCollection,
 	attributeCollection *database.AttributeCollection,
+	opts ...storage.WriteOption,
 ) (token token.EncodedSnapToken, err error) {
 	// Start a new tracing span for this operation.
 	ctx, span := internal.Tracer.Start(ctx, "data-writer.write")
@@ -58,9 +61,10 @@ func (w *DataWriter) Write(
 	}
 
 	// Retry loop for handling transient errors like serialization issues.
+	options := storage.NewWriteOptions(opts...)
 	for i := 0; i <= w.database.GetMaxRetries(); i++ {
 		// Attempt to write the data to the database.
-		tkn, err := w.write(ctx, tenantID, tupleCollection, attributeCollection)
+		tkn, err := w.write(ctx, tenantID, tupleCollection, attributeCollection, options)
 		if err != nil {
 			// Check if the error is due to serialization, and if so, retry.
 			if utils.IsSerializationRelatedError(err) || pgconn.SafeToRetry(err) {
@@ -68,6 +72,10 @@ func (w *DataWriter) Write(
 				utils.WaitWithBackoff(ctx, tenantID, i)
 				continue // Retry the operation.
 			}
+			// Cardinality violations and failed preconditions are reported to the caller as they are.
+			if isReportedAsIs(err) {
+				return nil, err
+			}
 			// If the error is not serialization-related, handle it and return.
 			return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_DATASTORE)
 		}
@@ -89,6 +97,7 @@ func (w *DataWriter) Delete(
 	tenantID string,
 	tupleFilter *base.TupleFilter,
 	attributeFilter *base.AttributeFilter,
+	opts ...storage.WriteOption,
 ) (token.EncodedSnapToken, error) {
 	// Start a new tracing span for this delete operation.
 	ctx, span := internal.Tracer.Start(ctx, "data-writer.delete")
@@ -98,9 +107,10 @@ func (w *DataWriter) Delete(
 	slog.DebugContext(ctx, "deleting data for tenant_id", slog.String("tenant_id", tenantID), "max retries", slog.Any("max_retries", w.database.GetMaxRetries()))
 	// Retry loop with backoff
 	// Retry loop for handling transient errors like serialization issues.
+	options := storage.NewWriteOptions(opts...)
 	for i := 0; i <= w.database.GetMaxRetries(); i++ {
 		// Attempt to delete the data from the database.
-		tkn, err := w.delete(ctx, tenantID, tupleFilter, attributeFilter)
+		tkn, err := w.delete(ctx, tenantID, tupleFilter, attributeFilter, options)
 		if err != nil {
 			// Check if the error is due to serialization, and if so, retry.
 			if utils.IsSerializationRelatedError(err) || pgconn.SafeToRetry(err) {
@@ -108,6 +118,10 @@ func (w *DataWriter) Delete(
 				utils.WaitWithBackoff(ctx, tenantID, i)
 				continue // Retry the operation.
 			}
+			// Failed preconditions are reported to the caller as they are.
+			if isReportedAsIs(err) {
+				return nil, err
+			}
 			// If the error is not serialization-related, handle it and return.
 			return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_DATASTORE)
 		}
@@ -129,6 +143,7 @@ func (w *DataWriter) RunBundle(
 	tenantID string,
 	arguments map[string]string,
 	b *base.DataBundle,
+	opts ...storage.WriteOption,
 ) (token.EncodedSnapToken, error) {
 	// Start a new tracing span for this operation.
 	ctx, span := internal.Tracer.Start(ctx, "data-writer.run-bundle")
@@ -138,9 +153,10 @@ func (w *DataWriter) RunBundle(
 	slog.DebugContext(ctx, "running bundle for tenant_id", slog.String("tenant_id", tenantID), "max retries", slog.Any("max_retries", w.database.GetMaxRetries()))
 	// Retry loop with backoff
 	// Retry loop for handling transient errors like serialization issues.
+	options := storage.NewWriteOptions(opts...)
 	for i := 0; i <= w.database.GetMaxRetries(); i++ {
 		// Attempt to run the bundle operation.
-		tkn, err := w.runBundle(ctx, tenantID, arguments, b)
+		tkn, err := w.runBundle(ctx, tenantID, arguments, b, options)
 		if err != nil {
 			// Check if the error is due to serialization, and if so, retry.
 			if utils.IsSerializationRelatedError(err) || pgconn.SafeToRetry(err) {
@@ -148,6 +164,10 @@ func (w *DataWriter) RunBundle(
 				utils.WaitWithBackoff(ctx, tenantID, i)
 				continue // Retry the operation.
 			}
+			// Cardinality violations and failed preconditions are reported to the caller as they are.
+			if isReportedAsIs(err) {
+				return nil, err
+			}
 			// If the error is not serialization-related, handle it and return.
 			return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_DATASTORE)
 		}
@@ -162,6 +182,63 @@ func (w *DataWriter) RunBundle(
 	return nil, errors.New(base.ErrorCode_ERROR_CODE_ERROR_MAX_RETRIES.String())
 }
 
+// Remove method deletes exactly the given tuples and attributes of a tenant, moving them to the quarantine tenant when one is given.
+// It returns an EncodedSnapToken upon successful removal or an error if the removal fails.
+func (w *DataWriter) Remove(
+	ctx context.Context,
+	tenantID string,
+	tupleCollection *database.TupleCollection,
+	attributeCollection *database.AttributeCollection,
+	quarantineTenantID string,
+	opts ...storage.WriteOption,
+) (token.EncodedSnapToken, error) {
+	// Start a new tracing span for this operation.
+	ctx, span := internal.Tracer.Start(ctx, "data-writer.remove")
+	defer span.End() // Ensure that the span is ended when the function returns.
+
+	// Log the start of a data removal operation.
+	slog.DebugContext(ctx, "removing data for tenant_id", slog.String("tenant_id", tenantID), slog.String("quarantine_tenant_id", quarantineTenantID), "max retries", slog.Any("max_retries", w.database.GetMaxRetries()))
+	// Check if the total number of tuples and attributes exceeds the maximum allowed per write.
+	if len(tupleCollection.GetTuples())+len(attributeCollection.GetAttributes()) > w.database.GetMaxDataPerWrite() {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_MAX_DATA_PER_WRITE_EXCEEDED.String())
+	}
+
+	// Retry loop for handling transient errors like serialization issues.
+	options := storage.NewWriteOptions(opts...)
+	for i := 0; i <= w.database.GetMaxRetries(); i++ {
+		// Attempt to remove the data.
+		tkn, err := w.remove(ctx, tenantID, tupleCollection, attributeCollection, quarantineTenantID, options)
+		if err != nil {
+			// Check if the error is due to serialization, and if so, retry.
+			if utils.IsSerializationRelatedError(err) || pgconn.SafeToRetry(err) {
+				slog.WarnContext(ctx, "serialization error occurred", slog.String("tenant_id", tenantID), slog.Int("retry", i))
+				utils.WaitWithBackoff(ctx, tenantID, i)
+				continue // Retry the operation.
+			}
+			// Failed preconditions are reported to the caller as they are.
+			if isReportedAsIs(err) {
+				return nil, err
+			}
+			// If the error is not serialization-related, handle it and return.
+			return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_DATASTORE)
+		}
+		// The quarantine tenant records a transaction of its own once the removal is committed, so that its head snapshot
+		// includes the quarantined data. The removal is not retried if this fails.
+		if quarantineTenantID != "" {
+			if err = w.recordTransaction(ctx, quarantineTenantID, options); err != nil {
+				return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_DATASTORE)
+			}
+		}
+		// If the removal is successful, return the token.
+		return tkn, nil
+	}
+
+	// Log an error if the operation failed after reaching the maximum number of retries.
+	slog.ErrorContext(ctx, "max retries reached", slog.Any("error", errors.New(base.ErrorCode_ERROR_CODE_ERROR_MAX_RETRIES.String())))
+	// Return an error indicating that the maximum number of retries has been reached.
+	return nil, errors.New(base.ErrorCode_ERROR_CODE_ERROR_MAX_RETRIES.String())
+}
+
 // write handles the database writing of tuple and attribute collections for a given tenant.
 // It returns an EncodedSnapToken upon successful write or an error if the write fails.
 func (w *DataWriter) write(
@@ -169,6 +246,7 @@ func (w *DataWriter) write(
 	tenantID string,
 	tupleCollection *database.TupleCollection,
 	attributeCollection *database.AttributeCollection,
+	options storage.WriteOptions,
 ) (token token.EncodedSnapToken, err error) {
 	var tx pgx.Tx
 	tx, err = w.database.WritePool.BeginTx(ctx, w.txOptions)
@@ -179,21 +257,37 @@ func (w *DataWriter) write(
 	defer func() {
 		_ = tx.Rollback(ctx)
 	}()
-	// Get transaction ID and snapshot
+	// A retry of an idempotent write returns the snap token of the original one
+	if tkn, ok, err := w.readIdempotencyKey(ctx, tx, tenantID, options); err != nil || ok {
+		return tkn, err
+	}
+	// Get transaction ID and snapshot, recording the metadata of the write alongside the transaction
+	metadata, err := utils.MarshalMetadata(options.GetTransactionMetadata())
+	if err != nil {
+		return nil, err
+	}
 	var xid db.XID8
 	var snapshotValue string
-	err = tx.QueryRow(ctx, utils.TransactionTemplate, tenantID).Scan(&xid, &snapshotValue)
+	err = tx.QueryRow(ctx, utils.TransactionTemplate, tenantID, metadata).Scan(&xid, &snapshotValue)
 	if err != nil {
 		return nil, err
 	}
 
 	slog.DebugContext(ctx, "retrieved transaction", slog.Any("xid", xid), "for tenant", slog.Any("tenant_id", tenantID))
 
+	if err = w.checkPreconditions(ctx, tx, xid, tenantID, options.GetPreconditions()); err != nil {
+		return nil, err
+	}
+
 	slog.DebugContext(ctx, "processing tuples and executing insert query")
 
 	batch := &pgx.Batch{}
 
 	if len(tupleCollection.GetTuples()) > 0 {
+		err = w.batchEnforceCardinality(ctx, tx, batch, xid, tenantID, options.GetSchemaVersion(), tupleCollection, database.NewTupleCollection())
+		if err != nil {
+			return nil, err
+		}
 		err = w.batchInsertRelationships(batch, xid, tenantID, tupleCollection)
 		if err != nil {
 			return nil, err
@@ -228,13 +322,18 @@ func (w *DataWriter) write(
 		return nil, err
 	}
 
+	token = snapshot.NewToken(xid, snapshotValue).Encode()
+	if err = w.writeIdempotencyKey(ctx, tx, tenantID, options, token); err != nil {
+		return nil, err
+	}
+
 	if err = tx.Commit(ctx); err != nil {
 		return nil, err
 	}
 	// Log success
 	slog.DebugContext(ctx, "data successfully written to the database")
 	// Return snapshot token
-	return snapshot.NewToken(xid, snapshotValue).Encode(), nil
+	return token, nil
 }
 
 // delete handles the deletion of tuples and attributes from the database based on provided filters.
@@ -244,6 +343,7 @@ func (w *DataWriter) delete(
 	tenantID string,
 	tupleFilter *base.TupleFilter,
 	attributeFilter *base.AttributeFilter,
+	options storage.WriteOptions,
 ) (token token.EncodedSnapToken, err error) {
 	var tx pgx.Tx
 	tx, err = w.database.WritePool.BeginTx(ctx, w.txOptions)
@@ -254,16 +354,28 @@ func (w *DataWriter) delete(
 	defer func() {
 		_ = tx.Rollback(ctx)
 	}()
-	// Get transaction ID and snapshot
+	// A retry of an idempotent write returns the snap token of the original one
+	if tkn, ok, err := w.readIdempotencyKey(ctx, tx, tenantID, options); err != nil || ok {
+		return tkn, err
+	}
+	// Get transaction ID and snapshot, recording the metadata of the write alongside the transaction
+	metadata, err := utils.MarshalMetadata(options.GetTransactionMetadata())
+	if err != nil {
+		return nil, err
+	}
 	var xid db.XID8
 	var snapshotValue string
-	err = tx.QueryRow(ctx, utils.TransactionTemplate, tenantID).Scan(&xid, &snapshotValue)
+	err = tx.QueryRow(ctx, utils.TransactionTemplate, tenantID, metadata).Scan(&xid, &snapshotValue)
 	if err != nil {
 		return nil, err
 	}
 
 	slog.DebugContext(ctx, "retrieved transaction", slog.Any("xid", xid), "for tenant", slog.Any("tenant_id", tenantID))
 
+	if err = w.checkPreconditions(ctx, tx, xid, tenantID, options.GetPreconditions()); err != nil {
+		return nil, err
+	}
+
 	slog.DebugContext(ctx, "processing tuple and executing update query")
 	// Process tuple filter
 	if !validation.IsTupleFilterEmpty(tupleFilter) {
@@ -304,13 +416,18 @@ func (w *DataWriter) delete(
 		}
 	}
 
+	token = snapshot.NewToken(xid, snapshotValue).Encode()
+	if err = w.writeIdempotencyKey(ctx, tx, tenantID, options, token); err != nil {
+		return nil, err
+	}
+
 	if err = tx.Commit(ctx); err != nil {
 		return nil, err
 	}
 
 	slog.DebugContext(ctx, "data successfully deleted from the database")
 	// Return snapshot token
-	return snapshot.NewToken(xid, snapshotValue).Encode(), nil
+	return token, nil
 } // End Delete
 // Helper functions for RunBundle
 // RunBundle helper function
@@ -322,6 +439,7 @@ func (w *DataWriter) runBundle(
 	tenantID string,
 	arguments map[string]string,
 	b *base.DataBundle,
+	options storage.WriteOptions,
 ) (token token.EncodedSnapToken, err error) {
 	var tx pgx.Tx
 	tx, err = w.database.WritePool.BeginTx(ctx, w.txOptions)
@@ -332,25 +450,62 @@ func (w *DataWriter) runBundle(
 	defer func() {
 		_ = tx.Rollback(ctx)
 	}()
-	// Get transaction ID and snapshot
+	// A retry of an idempotent write returns the snap token of the original one
+	if tkn, ok, err := w.readIdempotencyKey(ctx, tx, tenantID, options); err != nil || ok {
+		return tkn, err
+	}
+	// Get transaction ID and snapshot, recording the metadata of the write alongside the transaction
+	metadata, err := utils.MarshalMetadata(options.GetTransactionMetadata())
+	if err != nil {
+		return nil, err
+	}
 	var xid db.XID8
 	var snapshotValue string
-	err = tx.QueryRow(ctx, utils.TransactionTemplate, tenantID).Scan(&xid, &snapshotValue)
+	err = tx.QueryRow(ctx, utils.TransactionTemplate, tenantID, metadata).Scan(&xid, &snapshotValue)
 	if err != nil {
 		return nil, err
 	}
 
 	slog.DebugContext(ctx, "retrieved transaction", slog.Any("xid", xid), "for tenant", slog.Any("tenant_id", tenantID))
+
+	if err = w.checkPreconditions(ctx, tx, xid, tenantID, options.GetPreconditions()); err != nil {
+		return nil, err
+	}
 	// Create batch for operations
 	batch := &pgx.Batch{}
 
+	tbs := make([]database.TupleBundle, 0, len(b.GetOperations()))
+	abs := make([]database.AttributeBundle, 0, len(b.GetOperations()))
+	writes := database.NewTupleCollection()
+	deletes := database.NewTupleCollection()
+
 	for _, op := range b.GetOperations() {
 		tb, ab, err := bundle.Operation(arguments, op)
 		if err != nil {
 			return nil, err
 		}
 
-		err = w.runOperation(batch, xid, tenantID, tb, ab)
+		for _, t := range tb.Write.GetTuples() {
+			writes.Add(t)
+		}
+		for _, t := range tb.Delete.GetTuples() {
+			deletes.Add(t)
+		}
+		tbs = append(tbs, tb)
+		abs = append(abs, ab)
+	}
+
+	// Cardinality is checked against the bundle as a whole, so an operation may
+	// delete the current subject of a single relation and write a new one.
+	if len(writes.GetTuples()) > 0 {
+		err = w.batchEnforceCardinality(ctx, tx, batch, xid, tenantID, options.GetSchemaVersion(), writes, deletes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range tbs {
+		err = w.runOperation(batch, xid, tenantID, tbs[i], abs[i])
 		if err != nil {
 			return nil, err
 		}
@@ -373,11 +528,109 @@ func (w *DataWriter) runBundle(
 		return nil, err
 	}
 
+	token = snapshot.NewToken(xid, snapshotValue).Encode()
+	if err = w.writeIdempotencyKey(ctx, tx, tenantID, options, token); err != nil {
+		return nil, err
+	}
+
 	if err = tx.Commit(ctx); err != nil {
 		return nil, err
 	}
 	// Return snapshot token
-	return snapshot.NewToken(xid, snapshotValue).Encode(), nil
+	return token, nil
+}
+
+// remove expires the given tuples and attributes of a tenant by exact match and, when a quarantine tenant is given, inserts
+// them into it in the same transaction. The transaction is recorded for the tenant only.
+func (w *DataWriter) remove(
+	ctx context.Context,
+	tenantID string,
+	tupleCollection *database.TupleCollection,
+	attributeCollection *database.AttributeCollection,
+	quarantineTenantID string,
+	options storage.WriteOptions,
+) (token token.EncodedSnapToken, err error) {
+	var tx pgx.Tx
+	tx, err = w.database.WritePool.BeginTx(ctx, w.txOptions)
+	if err != nil {
+		return nil, err
+	}
+	// Defer rollback
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+	// A retry of an idempotent write returns the snap token of the original one
+	if tkn, ok, err := w.readIdempotencyKey(ctx, tx, tenantID, options); err != nil || ok {
+		return tkn, err
+	}
+	// Get transaction ID and snapshot, recording the metadata of the write alongside the transaction
+	metadata, err := utils.MarshalMetadata(options.GetTransactionMetadata())
+	if err != nil {
+		return nil, err
+	}
+	var xid db.XID8
+	var snapshotValue string
+	err = tx.QueryRow(ctx, utils.TransactionTemplate, tenantID, metadata).Scan(&xid, &snapshotValue)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = w.checkPreconditions(ctx, tx, xid, tenantID, options.GetPreconditions()); err != nil {
+		return nil, err
+	}
+
+	batch := &pgx.Batch{}
+	if err = w.batchUpdateRelationships(batch, xid, tenantID, buildDeleteClausesForRelationships(tupleCollection)); err != nil {
+		return nil, err
+	}
+	if err = w.batchUpdateAttributes(batch, xid, tenantID, buildDeleteClausesForAttributes(attributeCollection)); err != nil {
+		return nil, err
+	}
+
+	if quarantineTenantID != "" {
+		if err = w.batchInsertRelationships(batch, xid, quarantineTenantID, tupleCollection); err != nil {
+			return nil, err
+		}
+		// A quarantined attribute replaces the one quarantined earlier for the same entity
+		if err = w.batchUpdateAttributes(batch, xid, quarantineTenantID, buildDeleteClausesForAttributes(attributeCollection)); err != nil {
+			return nil, err
+		}
+		if err = w.batchInsertAttributes(batch, xid, quarantineTenantID, attributeCollection); err != nil {
+			return nil, err
+		}
+	}
+
+	batchResult := tx.SendBatch(ctx, batch)
+	for i := 0; i < batch.Len(); i++ {
+		if _, err = batchResult.Exec(); err != nil {
+			_ = batchResult.Close()
+			return nil, err
+		}
+	}
+	if err = batchResult.Close(); err != nil {
+		return nil, err
+	}
+
+	token = snapshot.NewToken(xid, snapshotValue).Encode()
+	if err = w.writeIdempotencyKey(ctx, tx, tenantID, options, token); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	// Return snapshot token
+	return token, nil
+}
+
+// recordTransaction records a transaction of a tenant that writes no data, with the metadata of the options
+func (w *DataWriter) recordTransaction(ctx context.Context, tenantID string, options storage.WriteOptions) error {
+	metadata, err := utils.MarshalMetadata(options.GetTransactionMetadata())
+	if err != nil {
+		return err
+	}
+	_, err = w.database.WritePool.Exec(ctx, utils.TransactionTemplate, tenantID, metadata)
+	return err
 }
 
 // runOperation processes and executes database operations defined in TupleBundle and AttributeBundle within a given transaction.
@@ -428,6 +681,161 @@ func (w *DataWriter) runOperation(
 	return nil
 }
 
+// checkPreconditions evaluates the preconditions of a write in its transaction and fails with
+// ERROR_CODE_FAILED_PRECONDITION when one of them does not hold.
+func (w *DataWriter) checkPreconditions(ctx context.Context, tx pgx.Tx, xid db.XID8, tenantID string, preconditions []*base.Precondition) error {
+	for _, precondition := range preconditions {
+		var holds bool
+		var err error
+		switch p := precondition.GetType().(type) {
+		case *base.Precondition_TupleExists:
+			holds, err = tuplesExist(ctx, tx, w.activeTuples(tenantID).Where(tupleEq(p.TupleExists)))
+		case *base.Precondition_TupleNotExists:
+			holds, err = tuplesExist(ctx, tx, w.activeTuples(tenantID).Where(tupleEq(p.TupleNotExists)))
+			holds = !holds
+		case *base.Precondition_FilterMatchesNone:
+			holds, err = tuplesExist(ctx, tx, utils.TuplesFilterQueryForSelectBuilder(w.activeTuples(tenantID), p.FilterMatchesNone))
+			holds = !holds
+		case *base.Precondition_HeadSnapToken:
+			holds, err = w.unchangedSince(ctx, tx, xid, tenantID, p.HeadSnapToken)
+		default:
+			return errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+		}
+		if err != nil {
+			return err
+		}
+		if !holds {
+			return errors.New(base.ErrorCode_ERROR_CODE_FAILED_PRECONDITION.String())
+		}
+	}
+	return nil
+}
+
+// activeTuples selects the active tuples of a tenant
+func (w *DataWriter) activeTuples(tenantID string) squirrel.SelectBuilder {
+	return w.database.Builder.Select("1").From(RelationTuplesTable).Where(squirrel.Eq{"tenant_id": tenantID}).
+		Where(squirrel.Expr("expired_tx_id = ?::xid8", utils.ActiveRecordTxnID)).Limit(1)
+}
+
+// tuplesExist reports whether the select of tuples matches any row
+func tuplesExist(ctx context.Context, tx pgx.Tx, builder squirrel.SelectBuilder) (bool, error) {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	var exists bool
+	if err = tx.QueryRow(ctx, "SELECT EXISTS ("+query+")", args...).Scan(&exists); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+// unchangedSince reports whether every transaction of the tenant other than the current one is visible in the snapshot of
+// the snap token. The transaction of the token itself is left out, since its own snapshot does not see it.
+func (w *DataWriter) unchangedSince(ctx context.Context, tx pgx.Tx, xid db.XID8, tenantID, snapToken string) (bool, error) {
+	decoded, err := snapshot.EncodedToken{Value: snapToken}.Decode()
+	if err != nil {
+		return false, errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+	}
+	since, ok := decoded.(snapshot.Token)
+	if !ok {
+		return false, errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+	}
+
+	// Tokens of the legacy format carry no snapshot, the one recorded with their transaction is used instead
+	visible := squirrel.Expr("pg_visible_in_snapshot(id, ?::pg_snapshot)", since.Snapshot)
+	if since.Snapshot == "" {
+		visible = squirrel.Expr("pg_visible_in_snapshot(id, (select snapshot from transactions where id = ?::xid8))", since.Value)
+	}
+
+	query, args, err := w.database.Builder.Select("1").From(TransactionsTable).
+		Where(squirrel.Eq{"tenant_id": tenantID}).
+		Where(squirrel.NotEq{"id": []db.XID8{xid, since.Value}}).
+		Where(squirrel.Expr("NOT ?", visible)).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	var changed bool
+	if err = tx.QueryRow(ctx, "SELECT EXISTS ("+query+")", args...).Scan(&changed); err != nil {
+		return false, err
+	}
+	return !changed, nil
+}
+
+// readIdempotencyKey returns the snap token recorded for the idempotency key of a write within the retention window, if any.
+// A key recorded for another request is rejected.
+func (w *DataWriter) readIdempotencyKey(ctx context.Context, tx pgx.Tx, tenantID string, options storage.WriteOptions) (token.EncodedSnapToken, bool, error) {
+	key := options.GetIdempotencyKey()
+	if key == "" {
+		return nil, false, nil
+	}
+
+	query, args, err := w.database.Builder.Select("snap_token", "request_hash").From(IdempotencyKeysTable).
+		Where(squirrel.Eq{"tenant_id": tenantID, "key": key}).
+		Where(squirrel.Expr("created_at > NOW() - make_interval(secs => ?)", w.database.GetIdempotencyKeyRetention().Seconds())).
+		ToSql()
+	if err != nil {
+		return nil, false, err
+	}
+
+	var snap, requestHash string
+	if err = tx.QueryRow(ctx, query, args...).Scan(&snap, &requestHash); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	if requestHash != options.GetRequestHash() {
+		slog.WarnContext(ctx, "idempotency key reused for another request", slog.String("tenant_id", tenantID), slog.String("key", key))
+		return nil, false, errors.New(base.ErrorCode_ERROR_CODE_IDEMPOTENCY_KEY_REUSED.String())
+	}
+
+	slog.DebugContext(ctx, "idempotency key already used, returning the snap token of the original write", slog.String("tenant_id", tenantID), slog.String("key", key))
+	return snapshot.EncodedToken{Value: snap}, true, nil
+}
+
+// writeIdempotencyKey records the snap token and the request hash of a write for its idempotency key, replacing an expired record of the key
+func (w *DataWriter) writeIdempotencyKey(ctx context.Context, tx pgx.Tx, tenantID string, options storage.WriteOptions, snap token.EncodedSnapToken) error {
+	if options.GetIdempotencyKey() == "" {
+		return nil
+	}
+
+	_, err := tx.Exec(ctx, "INSERT INTO "+IdempotencyKeysTable+" (tenant_id, key, request_hash, snap_token) VALUES ($1, $2, $3, $4) ON CONFLICT (tenant_id, key) DO UPDATE SET request_hash = EXCLUDED.request_hash, snap_token = EXCLUDED.snap_token, created_at = NOW()",
+		tenantID, options.GetIdempotencyKey(), options.GetRequestHash(), snap.String())
+	return err
+}
+
+// tupleEq builds the condition matching exactly a tuple
+func tupleEq(t *base.Tuple) squirrel.Eq {
+	srelation := t.GetSubject().GetRelation()
+	if srelation == tuple.ELLIPSIS {
+		srelation = ""
+	}
+	return squirrel.Eq{
+		"entity_type":      t.GetEntity().GetType(),
+		"entity_id":        t.GetEntity().GetId(),
+		"relation":         t.GetRelation(),
+		"subject_type":     t.GetSubject().GetType(),
+		"subject_id":       t.GetSubject().GetId(),
+		"subject_relation": srelation,
+	}
+}
+
+// isReportedAsIs reports whether an error of a write is returned to the caller as it is, rather than as a datastore error
+func isReportedAsIs(err error) bool {
+	switch err.Error() {
+	case base.ErrorCode_ERROR_CODE_CARDINALITY_VIOLATION.String(),
+		base.ErrorCode_ERROR_CODE_FAILED_PRECONDITION.String(),
+		base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String():
+		return true
+	}
+	return false
+}
+
 // Batch operations helper functions
 func (w *DataWriter) batchInsertRelationships(batch *pgx.Batch, xid db.XID8, tenantID string, tupleCollection *database.TupleCollection) error {
 	titer := tupleCollection.CreateTupleIterator()
@@ -461,6 +869,199 @@ func (w *DataWriter) batchUpdateRelationships(batch *pgx.Batch, xid db.XID8, ten
 	return nil
 }
 
+// batchEnforceCardinality checks the written tuples against the single cardinality relations of the given schema version,
+// the head version when it is empty. Subjects already assigned to the same entity and relation either reject the write
+// or, when the relation is declared with the replace modifier, are expired in the same transaction. Deleted tuples are
+// not counted.
+func (w *DataWriter) batchEnforceCardinality(
+	ctx context.Context,
+	tx pgx.Tx,
+	batch *pgx.Batch,
+	xid db.XID8,
+	tenantID string,
+	version string,
+	writes *database.TupleCollection,
+	deletes *database.TupleCollection,
+) error {
+	definitions, err := w.readEntityDefinitions(ctx, tx, tenantID, version, writes)
+	if err != nil {
+		return err
+	}
+
+	if len(definitions) == 0 {
+		return nil
+	}
+
+	deleted := map[string]struct{}{}
+	for _, t := range deletes.GetTuples() {
+		deleted[tuple.ToString(t)] = struct{}{}
+	}
+
+	tuples := make([]*base.Tuple, 0, len(writes.GetTuples()))
+	for _, t := range writes.GetTuples() {
+		if _, ok := deleted[tuple.ToString(t)]; !ok {
+			tuples = append(tuples, t)
+		}
+	}
+
+	err = validation.ValidateCardinality(definitions, tuples)
+	if err != nil {
+		return err
+	}
+
+	replaced := database.NewTupleCollection()
+	checked := map[string]struct{}{}
+
+	for _, t := range tuples {
+		definition, ok := definitions[t.GetEntity().GetType()]
+		if !ok {
+			continue
+		}
+
+		rel, err := schema.GetRelationByNameInEntityDefinition(definition, t.GetRelation())
+		if err != nil || rel.GetCardinality() != base.RelationDefinition_CARDINALITY_SINGLE {
+			continue
+		}
+
+		key := tuple.EntityAndRelationToString(t.GetEntity(), t.GetRelation())
+		if _, ok := checked[key]; ok {
+			continue
+		}
+		checked[key] = struct{}{}
+
+		query, args, err := w.database.Builder.
+			Select("subject_type, subject_id, subject_relation").
+			From(RelationTuplesTable).
+			Where(squirrel.Eq{
+				"tenant_id":     tenantID,
+				"entity_type":   t.GetEntity().GetType(),
+				"entity_id":     t.GetEntity().GetId(),
+				"relation":      t.GetRelation(),
+				"expired_tx_id": utils.ActiveRecordTxnID,
+			}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		rows, err := tx.Query(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+
+		var existing []*base.Subject
+		for rows.Next() {
+			subject := &base.Subject{}
+			if err = rows.Scan(&subject.Type, &subject.Id, &subject.Relation); err != nil {
+				rows.Close()
+				return err
+			}
+			existing = append(existing, subject)
+		}
+		rows.Close()
+		if err = rows.Err(); err != nil {
+			return err
+		}
+
+		for _, subject := range existing {
+			if tuple.AreSubjectsEqual(subject, t.GetSubject()) {
+				continue
+			}
+
+			current := &base.Tuple{Entity: t.GetEntity(), Relation: t.GetRelation(), Subject: subject}
+			if _, ok := deleted[tuple.ToString(current)]; ok {
+				continue
+			}
+
+			if rel.GetOnConflict() != base.RelationDefinition_ON_CONFLICT_REPLACE {
+				return errors.New(base.ErrorCode_ERROR_CODE_CARDINALITY_VIOLATION.String())
+			}
+
+			replaced.Add(current)
+		}
+	}
+
+	if len(replaced.GetTuples()) == 0 {
+		return nil
+	}
+
+	return w.batchUpdateRelationships(batch, xid, tenantID, buildDeleteClausesForRelationships(replaced))
+}
+
+// readEntityDefinitions reads the definitions of the entity types of the given tuples in a schema version, the head
+// version when it is empty, within the write transaction. Entity types that are not defined in the schema are left out
+// of the result.
+func (w *DataWriter) readEntityDefinitions(
+	ctx context.Context,
+	tx pgx.Tx,
+	tenantID string,
+	version string,
+	tupleCollection *database.TupleCollection,
+) (map[string]*base.EntityDefinition, error) {
+	names := make([]string, 0)
+	seen := map[string]struct{}{}
+	for _, t := range tupleCollection.GetTuples() {
+		if _, ok := seen[t.GetEntity().GetType()]; !ok {
+			seen[t.GetEntity().GetType()] = struct{}{}
+			names = append(names, t.GetEntity().GetType())
+		}
+	}
+	return readEntityDefinitions(ctx, tx, w.database.Builder, tenantID, version, names)
+}
+
+// readEntityDefinitions reads the definitions of the named entity types in a schema version, the head version when
+// it is empty, within a transaction. Names that are not entity types of the schema are left out of the result.
+func readEntityDefinitions(
+	ctx context.Context,
+	tx pgx.Tx,
+	builder squirrel.StatementBuilderType,
+	tenantID string,
+	version string,
+	names []string,
+) (map[string]*base.EntityDefinition, error) {
+	var versionCondition squirrel.Sqlizer = squirrel.Eq{"version": version}
+	if version == "" {
+		versionCondition = squirrel.Expr("version = (?)", headVersionBuilder(builder.PlaceholderFormat(squirrel.Question), tenantID))
+	}
+	query, args, err := builder.
+		Select("name, serialized_definition, version").
+		From(SchemaDefinitionTable).
+		Where(squirrel.Eq{"tenant_id": tenantID, "name": names}).
+		Where(versionCondition).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	definitions := map[string]*base.EntityDefinition{}
+	for rows.Next() {
+		sd := storage.SchemaDefinition{}
+		if err = rows.Scan(&sd.Name, &sd.SerializedDefinition, &sd.Version); err != nil {
+			return nil, err
+		}
+
+		sch, err := schema.NewSchemaFromStringDefinitions(false, sd.Serialized())
+		if err != nil {
+			return nil, err
+		}
+
+		definition, err := schema.GetEntityByName(sch, sd.Name)
+		if err != nil {
+			// The name belongs to a rule definition.
+			continue
+		}
+		definitions[sd.Name] = definition
+	}
+
+	return definitions, rows.Err()
+}
+
 // Build delete clauses for relationships
 func buildDeleteClausesForRelationships(tupleCollection *database.TupleCollection) []squirrel.Eq {
 	deleteClauses := make([]squirrel.Eq, 0)
@@ -423,6 +423,167 @@ var _ = Describe("DataWriter", func() {
 		})
 	})
 
+	Context("Preconditions", func() {
+		It("should evaluate the preconditions in the transaction of the write", func() {
+			ctx := context.Background()
+
+			tup1, err := tuple.Tuple("organization:organization-1#admin@user:user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tup2, err := tuple.Tuple("organization:organization-1#admin@user:user-2")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			noAdmin := &base.Precondition{Type: &base.Precondition_FilterMatchesNone{FilterMatchesNone: &base.TupleFilter{
+				Entity:   &base.EntityFilter{Type: "organization", Ids: []string{"organization-1"}},
+				Relation: "admin",
+			}}}
+
+			token1, err := dataWriter.Write(ctx, "t1", database.NewTupleCollection(tup1), database.NewAttributeCollection(), storage.Preconditions(noAdmin))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = dataWriter.Write(ctx, "t1", database.NewTupleCollection(tup2), database.NewAttributeCollection(), storage.Preconditions(noAdmin))
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_FAILED_PRECONDITION.String()))
+
+			_, err = dataWriter.Write(ctx, "t1", database.NewTupleCollection(tup2), database.NewAttributeCollection(),
+				storage.Preconditions(
+					&base.Precondition{Type: &base.Precondition_TupleExists{TupleExists: tup1}},
+					&base.Precondition{Type: &base.Precondition_HeadSnapToken{HeadSnapToken: token1.String()}}))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// the last write moved the head past the first snap token
+			_, err = dataWriter.Delete(ctx, "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{Type: "organization", Ids: []string{"organization-1"}},
+			}, &base.AttributeFilter{}, storage.Preconditions(&base.Precondition{Type: &base.Precondition_HeadSnapToken{HeadSnapToken: token1.String()}}))
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_FAILED_PRECONDITION.String()))
+
+			head, err := dataReader.HeadSnapshot(ctx, "t1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			col1, _, err := dataReader.ReadRelationships(ctx, "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{Type: "organization"},
+			}, head.Encode().String(), database.NewPagination(database.Size(10)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(len(col1.GetTuples())).Should(Equal(2))
+		})
+	})
+
+	Context("Idempotency Keys", func() {
+		It("should return the snap token of the original write on retry", func() {
+			ctx := context.Background()
+
+			tup1, err := tuple.Tuple("organization:organization-1#admin@user:user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			token1, err := dataWriter.Write(ctx, "t1", database.NewTupleCollection(tup1), database.NewAttributeCollection(), storage.IdempotencyKey("k1", "h1"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = dataWriter.Delete(ctx, "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{Type: "organization", Ids: []string{"organization-1"}},
+			}, &base.AttributeFilter{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// the retry is not applied again, so the deleted tuple stays deleted
+			token2, err := dataWriter.Write(ctx, "t1", database.NewTupleCollection(tup1), database.NewAttributeCollection(), storage.IdempotencyKey("k1", "h1"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(token2.String()).Should(Equal(token1.String()))
+
+			head, err := dataReader.HeadSnapshot(ctx, "t1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			col1, _, err := dataReader.ReadRelationships(ctx, "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{Type: "organization"},
+			}, head.Encode().String(), database.NewPagination(database.Size(10)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(len(col1.GetTuples())).Should(Equal(0))
+
+			// a key cannot be reused for another request
+			_, err = dataWriter.Write(ctx, "t1", database.NewTupleCollection(tup1), database.NewAttributeCollection(), storage.IdempotencyKey("k1", "h2"))
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_IDEMPOTENCY_KEY_REUSED.String()))
+		})
+	})
+
+	Context("Import", func() {
+		It("should copy tuples and attributes past the max data per write and replace existing attributes", func() {
+			ctx := context.Background()
+
+			attr1, err := attribute.Attribute("organization:organization-1$public|boolean:true")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = dataWriter.Write(ctx, "t1", database.NewTupleCollection(), database.NewAttributeCollection(attr1))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tuples := make([]*base.Tuple, 0, db.Postgres.GetMaxDataPerWrite()+1)
+			for i := 0; i <= db.Postgres.GetMaxDataPerWrite(); i++ {
+				tup, err := tuple.Tuple(fmt.Sprintf("organization:organization-%d#admin@user:user-1", i))
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, tup)
+			}
+
+			attr2, err := attribute.Attribute("organization:organization-1$public|boolean:false")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			imp, err := dataWriter.Import(ctx, "t1")
+			Expect(err).ShouldNot(HaveOccurred())
+			defer imp.Close(ctx)
+
+			// the first tuple is loaded twice, it must only be stored once
+			Expect(imp.Load(ctx, tuples, nil)).Should(Succeed())
+			Expect(imp.Load(ctx, tuples[:1], []*base.Attribute{attr2})).Should(Succeed())
+
+			token1, err := imp.Commit(ctx)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(token1.String()).ShouldNot(Equal(""))
+
+			col1, _, err := dataReader.ReadRelationships(ctx, "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "organization",
+				},
+			}, token1.String(), database.NewPagination(database.Size(uint32(len(tuples)+10))))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(len(col1.GetTuples())).Should(Equal(len(tuples)))
+
+			attrRes1, err := dataReader.QuerySingleAttribute(ctx, "t1", &base.AttributeFilter{
+				Entity: &base.EntityFilter{
+					Type: "organization",
+					Ids:  []string{"organization-1"},
+				},
+				Attributes: []string{"public"},
+			}, token1.String())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var msg1 base.BooleanValue
+			err = attrRes1.GetValue().UnmarshalTo(&msg1)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(msg1.GetData()).Should(Equal(false))
+		})
+
+		It("should roll back the rows loaded since the last commit when closed", func() {
+			ctx := context.Background()
+
+			tup1, err := tuple.Tuple("organization:organization-1#admin@user:user-1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			imp, err := dataWriter.Import(ctx, "t1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(imp.Load(ctx, []*base.Tuple{tup1}, nil)).Should(Succeed())
+			Expect(imp.Close(ctx)).Should(Succeed())
+
+			token1, err := dataReader.HeadSnapshot(ctx, "t1")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			col1, _, err := dataReader.ReadRelationships(ctx, "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "organization",
+				},
+			}, token1.Encode().String(), database.NewPagination(database.Size(10)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(col1.GetTuples()).Should(BeEmpty())
+		})
+	})
+
 	Context("RunBundle", func() {
 		It("should run the bundle successfully and return an encoded snapshot token", func() {
 			ctx := context.Background()
@@ -1113,4 +1274,196 @@ var _ = Describe("DataWriter", func() {
 			})
 		})
 	})
+
+	Context("Cardinality", func() {
+		var schemaWriter *SchemaWriter
+
+		BeforeEach(func() {
+			schemaWriter = NewSchemaWriter(db.Postgres)
+
+			err := schemaWriter.WriteSchema(context.Background(), []storage.SchemaDefinition{
+				{TenantID: "t1", Name: "user", SerializedDefinition: []byte("entity user {}"), Version: "cardinality"},
+				{TenantID: "t1", Name: "folder", SerializedDefinition: []byte("entity folder {}"), Version: "cardinality"},
+				{TenantID: "t1", Name: "document", SerializedDefinition: []byte("entity document { relation parent @folder single relation owner @user single replace relation viewer @user }"), Version: "cardinality"},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		subjectsOf := func(ctx context.Context, documentID, relation string) []string {
+			it, err := dataReader.QueryRelationships(ctx, "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "document",
+					Ids:  []string{documentID},
+				},
+				Relation: relation,
+			}, "", database.NewCursorPagination())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var subjects []string
+			for it.HasNext() {
+				subjects = append(subjects, tuple.SubjectToString(it.GetNext().GetSubject()))
+			}
+			return subjects
+		}
+
+		write := func(ctx context.Context, tuples ...string) error {
+			collection := database.NewTupleCollection()
+			for _, t := range tuples {
+				tup, err := tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				collection.Add(tup)
+			}
+			_, err := dataWriter.Write(ctx, "t1", collection, database.NewAttributeCollection())
+			return err
+		}
+
+		It("should reject a second subject on a single relation", func() {
+			ctx := context.Background()
+
+			Expect(write(ctx, "document:1#parent@folder:1")).ShouldNot(HaveOccurred())
+			Expect(write(ctx, "document:1#parent@folder:1")).ShouldNot(HaveOccurred())
+
+			err := write(ctx, "document:1#parent@folder:2")
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_CARDINALITY_VIOLATION.String()))
+
+			Expect(subjectsOf(ctx, "1", "parent")).Should(Equal([]string{"folder:1"}))
+
+			Expect(write(ctx, "document:1#viewer@user:1", "document:1#viewer@user:2")).ShouldNot(HaveOccurred())
+			Expect(subjectsOf(ctx, "1", "viewer")).Should(ConsistOf("user:1", "user:2"))
+		})
+
+		It("should enforce single relations against the schema version of the write", func() {
+			ctx := context.Background()
+
+			err := schemaWriter.WriteSchema(ctx, []storage.SchemaDefinition{
+				{TenantID: "t1", Name: "user", SerializedDefinition: []byte("entity user {}"), Version: "cardinality-v2"},
+				{TenantID: "t1", Name: "folder", SerializedDefinition: []byte("entity folder {}"), Version: "cardinality-v2"},
+				{TenantID: "t1", Name: "document", SerializedDefinition: []byte("entity document { relation parent @folder relation owner @user single replace relation viewer @user }"), Version: "cardinality-v2"},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			collection := database.NewTupleCollection()
+			for _, t := range []string{"document:1#parent@folder:1", "document:1#parent@folder:2"} {
+				tup, err := tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				collection.Add(tup)
+			}
+
+			_, err = dataWriter.Write(ctx, "t1", collection, database.NewAttributeCollection(), storage.SchemaVersion("cardinality"))
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_CARDINALITY_VIOLATION.String()))
+			Expect(subjectsOf(ctx, "1", "parent")).Should(BeEmpty())
+
+			_, err = dataWriter.Write(ctx, "t1", collection, database.NewAttributeCollection(), storage.SchemaVersion("cardinality-v2"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(subjectsOf(ctx, "1", "parent")).Should(ConsistOf("folder:1", "folder:2"))
+		})
+
+		It("should replace the subject of a single relation declared with replace", func() {
+			ctx := context.Background()
+
+			Expect(write(ctx, "document:1#owner@user:1")).ShouldNot(HaveOccurred())
+			Expect(write(ctx, "document:1#owner@user:2")).ShouldNot(HaveOccurred())
+
+			Expect(subjectsOf(ctx, "1", "owner")).Should(Equal([]string{"user:2"}))
+		})
+
+		It("should enforce single relations when an import is committed", func() {
+			ctx := context.Background()
+
+			Expect(write(ctx, "document:1#parent@folder:1", "document:1#owner@user:1")).ShouldNot(HaveOccurred())
+
+			load := func(tuples ...string) error {
+				imp, err := dataWriter.Import(ctx, "t1")
+				Expect(err).ShouldNot(HaveOccurred())
+				defer imp.Close(ctx)
+
+				var loaded []*base.Tuple
+				for _, t := range tuples {
+					tup, err := tuple.Tuple(t)
+					Expect(err).ShouldNot(HaveOccurred())
+					loaded = append(loaded, tup)
+				}
+				Expect(imp.Load(ctx, loaded, nil)).Should(Succeed())
+				_, err = imp.Commit(ctx)
+				return err
+			}
+
+			err := load("document:1#parent@folder:2")
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_CARDINALITY_VIOLATION.String()))
+
+			err = load("document:2#parent@folder:1", "document:2#parent@folder:2")
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_CARDINALITY_VIOLATION.String()))
+
+			Expect(load("document:1#owner@user:2", "document:1#parent@folder:1")).Should(Succeed())
+			Expect(subjectsOf(ctx, "1", "owner")).Should(Equal([]string{"user:2"}))
+			Expect(subjectsOf(ctx, "1", "parent")).Should(Equal([]string{"folder:1"}))
+			Expect(subjectsOf(ctx, "2", "parent")).Should(BeEmpty())
+		})
+
+		It("should allow a bundle to move a single relation to a new subject", func() {
+			ctx := context.Background()
+
+			Expect(write(ctx, "document:1#parent@folder:1")).ShouldNot(HaveOccurred())
+
+			_, err := dataWriter.RunBundle(ctx, "t1", map[string]string{}, &base.DataBundle{
+				Name: "move_document",
+				Operations: []*base.Operation{
+					{
+						RelationshipsDelete: []string{"document:1#parent@folder:1"},
+						RelationshipsWrite:  []string{"document:1#parent@folder:2"},
+					},
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(subjectsOf(ctx, "1", "parent")).Should(Equal([]string{"folder:2"}))
+		})
+	})
+
+	Context("Remove", func() {
+		It("should remove exactly the given tuples and move them to the quarantine tenant", func() {
+			ctx := context.Background()
+
+			var tuples []*base.Tuple
+			for _, s := range []string{"document:1#viewer@group:1", "document:1#viewer@group:1#member", "document:2#viewer@user:1"} {
+				tup, err := tuple.Tuple(s)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, tup)
+			}
+			_, err := dataWriter.Write(ctx, "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection())
+			Expect(err).ShouldNot(HaveOccurred())
+
+			token, err := dataWriter.Remove(ctx, "t1", database.NewTupleCollection(tuples[0]), database.NewAttributeCollection(), "quarantine")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			read := func(tenantID string) []string {
+				it, err := dataReader.QueryRelationships(ctx, tenantID, &base.TupleFilter{Entity: &base.EntityFilter{Type: "document"}}, "", database.NewCursorPagination())
+				Expect(err).ShouldNot(HaveOccurred())
+				var relationships []string
+				for it.HasNext() {
+					relationships = append(relationships, tuple.ToString(it.GetNext()))
+				}
+				return relationships
+			}
+			Expect(read("t1")).Should(ConsistOf("document:1#viewer@group:1#member", "document:2#viewer@user:1"))
+			Expect(read("quarantine")).Should(ConsistOf("document:1#viewer@group:1"))
+
+			// the head snapshot of the quarantine tenant includes the quarantined tuples
+			head, err := dataReader.HeadSnapshot(ctx, "quarantine")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(head.Encode().String()).ShouldNot(Equal(token.String()))
+			it, err := dataReader.QueryRelationships(ctx, "quarantine", &base.TupleFilter{Entity: &base.EntityFilter{Type: "document"}}, head.Encode().String(), database.NewCursorPagination())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(it.HasNext()).Should(BeTrue())
+
+			// the transaction of the removal is recorded once
+			var count int
+			err = db.Postgres.WritePool.QueryRow(ctx, "SELECT count(*) FROM transactions WHERE id = (SELECT id FROM transactions WHERE tenant_id = 't1' ORDER BY id DESC LIMIT 1)").Scan(&count)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(count).Should(Equal(1))
+		})
+	})
 })
@@ -80,6 +80,11 @@ func (gc *GC) Run() error {
 	// Calculate the cutoff timestamp based on the window duration.
 	cutoffTime := dbNow.Add(-gc.window)
 
+	// Delete the idempotency keys of all tenants that are past their retention
+	if err := gc.deleteExpiredIdempotencyKeys(ctx); err != nil {
+		slog.Error("Failed to delete expired idempotency keys", slog.Any("error", err))
+	}
+
 	// Get all tenants for tenant-specific garbage collection
 	tenants, err := gc.getAllTenants(ctx)
 	if err != nil {
@@ -205,6 +210,19 @@ func (gc *GC) deleteRecordsForTenant(ctx context.Context, table, tenantID string
 	return err
 }
 
+// deleteExpiredIdempotencyKeys deletes the idempotency keys recorded before the retention of the database.
+func (gc *GC) deleteExpiredIdempotencyKeys(ctx context.Context) error {
+	query, args, err := gc.database.Builder.Delete(postgres.IdempotencyKeysTable).
+		Where(squirrel.Expr("created_at < NOW() - make_interval(secs => ?)", gc.database.GetIdempotencyKeyRetention().Seconds())).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = gc.database.WritePool.Exec(ctx, query, args...)
+	return err
+}
+
 // deleteTransactionsForTenant deletes transactions for a specific tenant older than the provided lastTransactionID.
 func (gc *GC) deleteTransactionsForTenant(ctx context.Context, tenantID string, lastTransactionID uint64) error {
 	// Convert the provided lastTransactionID into a string format suitable for SQL queries.
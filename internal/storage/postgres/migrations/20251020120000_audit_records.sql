@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS audit_records
+(
+    id             VARCHAR   NOT NULL,
+    tenant_id      VARCHAR   NOT NULL,
+    actor          VARCHAR   NOT NULL DEFAULT '',
+    method         VARCHAR   NOT NULL,
+    summary        TEXT      NOT NULL DEFAULT '',
+    snap_token     VARCHAR   NOT NULL DEFAULT '',
+    schema_version VARCHAR   NOT NULL DEFAULT '',
+    outcome        VARCHAR   NOT NULL,
+    error          TEXT      NOT NULL DEFAULT '',
+    created_at     TIMESTAMP NOT NULL,
+    CONSTRAINT pk_audit_record PRIMARY KEY (tenant_id, id)
+);
+
+-- The audit trail is append-only.
+CREATE RULE audit_records_no_update AS ON UPDATE TO audit_records DO INSTEAD NOTHING;
+CREATE RULE audit_records_no_delete AS ON DELETE TO audit_records DO INSTEAD NOTHING;
+
+-- +goose Down
+DROP TABLE IF EXISTS audit_records;
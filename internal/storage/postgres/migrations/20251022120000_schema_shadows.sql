@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS schema_shadows
+(
+    tenant_id      VARCHAR          NOT NULL,
+    version        VARCHAR          NOT NULL,
+    served_version VARCHAR          NOT NULL,
+    sample_rate    DOUBLE PRECISION NOT NULL DEFAULT 1,
+    created_at     TIMESTAMP        NOT NULL DEFAULT now(),
+    CONSTRAINT pk_schema_shadow PRIMARY KEY (tenant_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS schema_shadows;
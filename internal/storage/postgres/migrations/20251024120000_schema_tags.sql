@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS schema_tags
+(
+    tenant_id  VARCHAR   NOT NULL,
+    tag        VARCHAR   NOT NULL,
+    version    VARCHAR   NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    CONSTRAINT pk_schema_tag PRIMARY KEY (tenant_id, tag)
+);
+
+CREATE INDEX IF NOT EXISTS idx_schema_tags_version ON schema_tags (tenant_id, version);
+
+CREATE TABLE IF NOT EXISTS schema_heads
+(
+    tenant_id    VARCHAR   NOT NULL,
+    version      VARCHAR   NOT NULL,
+    activated_at TIMESTAMP NOT NULL DEFAULT now(),
+    CONSTRAINT pk_schema_head PRIMARY KEY (tenant_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS schema_heads;
+DROP TABLE IF EXISTS schema_tags;
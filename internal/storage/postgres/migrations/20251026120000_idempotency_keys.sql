@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS idempotency_keys
+(
+    tenant_id  VARCHAR   NOT NULL,
+    key        VARCHAR   NOT NULL,
+    snap_token VARCHAR   NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    CONSTRAINT pk_idempotency_key PRIMARY KEY (tenant_id, key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_created_at ON idempotency_keys (created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS idempotency_keys;
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS metadata JSONB;
+ALTER TABLE schema_definitions ADD COLUMN IF NOT EXISTS metadata JSONB;
+ALTER TABLE audit_records ADD COLUMN IF NOT EXISTS transaction_metadata JSONB;
+
+-- +goose Down
+ALTER TABLE audit_records DROP COLUMN IF EXISTS transaction_metadata;
+ALTER TABLE schema_definitions DROP COLUMN IF EXISTS metadata;
+ALTER TABLE transactions DROP COLUMN IF EXISTS metadata;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS request_hash VARCHAR NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS request_hash;
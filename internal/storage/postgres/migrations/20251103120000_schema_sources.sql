@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS schema_sources
+(
+    tenant_id VARCHAR NOT NULL,
+    version   VARCHAR NOT NULL,
+    source    TEXT    NOT NULL,
+    CONSTRAINT pk_schema_source PRIMARY KEY (tenant_id, version)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS schema_sources;
@@ -117,6 +117,34 @@ func (r *SchemaReader) ReadSchemaString(ctx context.Context, tenantID, version s
 	return definitions, err
 }
 
+// ReadSchemaSource returns the schema a version was written from, empty when it was not written from a source.
+func (r *SchemaReader) ReadSchemaSource(ctx context.Context, tenantID, version string) (source string, err error) {
+	ctx, span := internal.Tracer.Start(ctx, "schema-reader.read-schema-source")
+	defer span.End()
+	slog.DebugContext(ctx, "reading schema source", slog.Any("tenant_id", tenantID), slog.Any("version", version))
+	builder := r.database.Builder.Select("source").From(SchemaSourcesTable).Where(squirrel.Eq{"version": version, "tenant_id": tenantID}).Limit(1)
+
+	var query string
+	var args []interface{}
+
+	query, args, err = builder.ToSql()
+	if err != nil {
+		return "", utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SQL_BUILDER)
+	}
+
+	slog.DebugContext(ctx, "executing sql query", slog.Any("query", query), slog.Any("arguments", args))
+	row := r.database.ReadPool.QueryRow(ctx, query, args...) // Execute query
+	if err = row.Scan(&source); err != nil {
+		// versions written by a partial write, or before sources were kept, have no source
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
+	}
+
+	return source, nil
+}
+
 // ReadEntityDefinition - Reads entity config from the repository.
 func (r *SchemaReader) ReadEntityDefinition(ctx context.Context, tenantID, name, version string) (definition *base.EntityDefinition, v string, err error) {
 	ctx, span := internal.Tracer.Start(ctx, "schema-reader.read-entity-definition")
@@ -190,31 +218,70 @@ func (r *SchemaReader) ReadRuleDefinition(ctx context.Context, tenantID, name, v
 	return definition, def.Version, err // Return result
 }
 
-// HeadVersion - Finds the latest version of the schema.
+// HeadVersion returns the active version of the schema of a tenant
 func (r *SchemaReader) HeadVersion(ctx context.Context, tenantID string) (version string, err error) {
 	ctx, span := internal.Tracer.Start(ctx, "schema-reader.head-version")
 	defer span.End() // close span
-	slog.DebugContext(ctx, "finding the latest version fo the schema for", slog.String("tenant_id", tenantID))
+	slog.DebugContext(ctx, "finding the head version fo the schema for", slog.String("tenant_id", tenantID))
 	var query string
 	var args []interface{}
-	query, args, err = r.database.Builder.
-		Select("version").From(SchemaDefinitionTable).Where(squirrel.Eq{"tenant_id": tenantID}).OrderBy("version DESC").Limit(1).
-		ToSql()
+	query, args, err = headVersionBuilder(r.database.Builder, tenantID).ToSql()
 	if err != nil {
 		return "", utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SQL_BUILDER)
 	}
 	slog.DebugContext(ctx, "executing sql query", slog.Any("query", query), slog.Any("arguments", args))
+	var head *string
 	row := r.database.ReadPool.QueryRow(ctx, query, args...) // Execute query
-	err = row.Scan(&version)
+	err = row.Scan(&head)
+	if err != nil {
+		return "", utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
+	}
+	if head == nil {
+		return "", utils.HandleError(ctx, span, errors.New("no schema version"), base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND)
+	}
+
+	slog.DebugContext(ctx, "successfully found the head schema version", slog.Any("version", *head))
+	return *head, nil // Return version
+}
+
+// headVersionBuilder builds the query of the head version of a tenant: the activated version if any, otherwise
+// the latest written version apart from the shadow version, which is not served until it is promoted. The
+// query returns NULL if the tenant has no version.
+func headVersionBuilder(builder squirrel.StatementBuilderType, tenantID string) squirrel.SelectBuilder {
+	// the subqueries keep their placeholders for the outer query to number them
+	inner := builder.PlaceholderFormat(squirrel.Question)
+	active := inner.Select("version").From(SchemaHeadsTable).Where(squirrel.Eq{"tenant_id": tenantID})
+	latest := inner.Select("version").From(SchemaDefinitionTable).Where(squirrel.Eq{"tenant_id": tenantID}).
+		Where(squirrel.Expr("version NOT IN (SELECT version FROM "+SchemaShadowsTable+" WHERE tenant_id = ?)", tenantID)).
+		OrderBy("version DESC").Limit(1)
+	return builder.Select().Column(squirrel.Expr("COALESCE((?), (?))", active, latest))
+}
+
+// TagVersion returns the version of the schema a tag of a tenant points to
+func (r *SchemaReader) TagVersion(ctx context.Context, tenantID, tag string) (version string, err error) {
+	ctx, span := internal.Tracer.Start(ctx, "schema-reader.tag-version")
+	defer span.End()
+	slog.DebugContext(ctx, "finding the version of a schema tag", slog.String("tenant_id", tenantID), slog.String("tag", tag))
+
+	var query string
+	var args []interface{}
+	query, args, err = r.database.Builder.
+		Select("version").From(SchemaTagsTable).Where(squirrel.Eq{"tenant_id": tenantID, "tag": tag}).
+		ToSql()
 	if err != nil {
+		return "", utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SQL_BUILDER)
+	}
+
+	slog.DebugContext(ctx, "executing sql query", slog.Any("query", query), slog.Any("arguments", args))
+	if err = r.database.ReadPool.QueryRow(ctx, query, args...).Scan(&version); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return "", utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND)
+			return "", utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCHEMA_TAG_NOT_FOUND)
 		}
 		return "", utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
 	}
 
-	slog.DebugContext(ctx, "successfully found the latest schema version", slog.Any("version", version))
-	return version, nil // Return version
+	slog.DebugContext(ctx, "successfully found the version of the schema tag", slog.String("version", version))
+	return version, nil
 }
 
 // ListSchemas - List all Schemas
@@ -224,7 +291,8 @@ func (r *SchemaReader) ListSchemas(ctx context.Context, tenantID string, paginat
 
 	slog.DebugContext(ctx, "listing schemas with pagination", slog.Any("pagination", pagination))
 
-	builder := r.database.Builder.Select("DISTINCT version").From(SchemaDefinitionTable).Where(squirrel.Eq{"tenant_id": tenantID})
+	// the definitions of a version are written together, with the same metadata
+	builder := r.database.Builder.Select("DISTINCT version, metadata").From(SchemaDefinitionTable).Where(squirrel.Eq{"tenant_id": tenantID})
 	if pagination.Token() != "" {
 		var t database.ContinuousToken
 		t, err = utils.EncodedContinuousToken{Value: pagination.Token()}.Decode()
@@ -256,10 +324,15 @@ func (r *SchemaReader) ListSchemas(ctx context.Context, tenantID string, paginat
 	schemas = make([]*base.SchemaList, 0, pagination.PageSize()+1)
 	for rows.Next() {
 		sch := &base.SchemaList{}
-		err = rows.Scan(&sch.Version)
+		var metadata []byte
+		err = rows.Scan(&sch.Version, &metadata)
 		if err != nil {
 			return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
 		}
+		sch.Metadata, err = utils.UnmarshalMetadata(metadata)
+		if err != nil {
+			return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INTERNAL)
+		}
 		id, err := xid.FromString(sch.Version)
 		if err != nil {
 			return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
@@ -272,6 +345,12 @@ func (r *SchemaReader) ListSchemas(ctx context.Context, tenantID string, paginat
 		return nil, nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INTERNAL)
 	}
 
+	if len(schemas) > 0 {
+		if err = r.annotate(ctx, tenantID, schemas); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	slog.DebugContext(ctx, "successfully listed schemas", slog.Any("number_of_schemas", len(schemas)))
 
 	if len(schemas) > int(pagination.PageSize()) {
@@ -279,3 +358,81 @@ func (r *SchemaReader) ListSchemas(ctx context.Context, tenantID string, paginat
 	}
 	return schemas, database.NewNoopContinuousToken().Encode(), nil
 }
+
+// annotate sets the tags of listed schema versions and marks the head version as active
+func (r *SchemaReader) annotate(ctx context.Context, tenantID string, schemas []*base.SchemaList) error {
+	ctx, span := internal.Tracer.Start(ctx, "schema-reader.annotate")
+	defer span.End()
+
+	head, err := r.HeadVersion(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+
+	versions := make([]string, 0, len(schemas))
+	for _, sch := range schemas {
+		versions = append(versions, sch.Version)
+	}
+
+	query, args, err := r.database.Builder.
+		Select("version, tag").From(SchemaTagsTable).Where(squirrel.Eq{"tenant_id": tenantID, "version": versions}).
+		OrderBy("tag").
+		ToSql()
+	if err != nil {
+		return utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SQL_BUILDER)
+	}
+
+	slog.DebugContext(ctx, "executing sql query", slog.Any("query", query), slog.Any("arguments", args))
+	rows, err := r.database.ReadPool.Query(ctx, query, args...)
+	if err != nil {
+		return utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
+	}
+	defer rows.Close()
+
+	tags := make(map[string][]string)
+	for rows.Next() {
+		var version, tag string
+		if err = rows.Scan(&version, &tag); err != nil {
+			return utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
+		}
+		tags[version] = append(tags[version], tag)
+	}
+	if err = rows.Err(); err != nil {
+		return utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INTERNAL)
+	}
+
+	for _, sch := range schemas {
+		sch.Tags = tags[sch.Version]
+		sch.Active = sch.Version == head
+	}
+	return nil
+}
+
+// ReadShadow - Reads the shadow version of the schema
+func (r *SchemaReader) ReadShadow(ctx context.Context, tenantID string) (shadow *base.SchemaShadow, err error) {
+	ctx, span := internal.Tracer.Start(ctx, "schema-reader.read-shadow")
+	defer span.End()
+	slog.DebugContext(ctx, "reading the shadow version of the schema", slog.String("tenant_id", tenantID))
+
+	var query string
+	var args []interface{}
+	query, args, err = r.database.Builder.
+		Select("version, served_version, sample_rate, created_at").From(SchemaShadowsTable).Where(squirrel.Eq{"tenant_id": tenantID}).
+		ToSql()
+	if err != nil {
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SQL_BUILDER)
+	}
+
+	slog.DebugContext(ctx, "executing sql query", slog.Any("query", query), slog.Any("arguments", args))
+	sh := storage.SchemaShadow{TenantID: tenantID}
+	row := r.database.ReadPool.QueryRow(ctx, query, args...)
+	if err = row.Scan(&sh.Version, &sh.ServedVersion, &sh.SampleRate, &sh.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCHEMA_SHADOW_NOT_FOUND)
+		}
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
+	}
+
+	slog.DebugContext(ctx, "successfully read the shadow version of the schema", slog.String("version", sh.Version))
+	return sh.ToSchemaShadow(), nil
+}
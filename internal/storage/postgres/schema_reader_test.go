@@ -129,6 +129,38 @@ var _ = Describe("SchemaReader", func() {
 		})
 	})
 
+	Context("Read Schema Source", func() {
+		It("should read the source a version was written from", func() {
+			ctx := context.Background()
+
+			version := xid.New().String()
+			source := "// users\nentity user {}\n\nentity organization {\n    relation admin @user\n}"
+
+			schema := []storage.SchemaDefinition{
+				{TenantID: "t1", Name: "user", SerializedDefinition: []byte("entity user {}"), Version: version, Source: source},
+				{TenantID: "t1", Name: "organization", SerializedDefinition: []byte("entity organization { relation admin @user}"), Version: version, Source: source},
+			}
+
+			err := schemaWriter.WriteSchema(ctx, schema)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			src, err := schemaReader.ReadSchemaSource(ctx, "t1", version)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(src).Should(Equal(source))
+
+			// a version written without a source has none
+			other := xid.New().String()
+			err = schemaWriter.WriteSchema(ctx, []storage.SchemaDefinition{
+				{TenantID: "t1", Name: "user", SerializedDefinition: []byte("entity user {}"), Version: other},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			src, err = schemaReader.ReadSchemaSource(ctx, "t1", other)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(src).Should(BeEmpty())
+		})
+	})
+
 	Context("Read Entity Definition", func() {
 		It("should write and then read the entity definition for a tenant", func() {
 			ctx := context.Background()
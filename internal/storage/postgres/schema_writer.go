@@ -2,8 +2,10 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"log/slog" // structured logging
 
+	"github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
 
 	"github.com/Permify/permify/internal"
@@ -28,29 +30,398 @@ func NewSchemaWriter(database *db.Postgres) *SchemaWriter {
 	}
 }
 
-// WriteSchema writes a schema to the database
+// WriteSchema writes a schema to the database and makes it the head version of its tenant
 func (w *SchemaWriter) WriteSchema(ctx context.Context, schemas []storage.SchemaDefinition) (err error) {
 	ctx, span := internal.Tracer.Start(ctx, "schema-writer.write-schema")
 	defer span.End() // end tracing span
 	slog.DebugContext(ctx, "writing schemas to the database", slog.Any("number_of_schemas", len(schemas)))
-	insertBuilder := w.database.Builder.Insert(SchemaDefinitionTable).Columns("name, serialized_definition, version, tenant_id") // create insert builder
+	insertBuilder := w.database.Builder.Insert(SchemaDefinitionTable).Columns("name, serialized_definition, version, tenant_id, metadata") // create insert builder
+	tenants := make(map[string]struct{})
+	for _, schema := range schemas {
+		metadata, err := utils.MarshalMetadata(schema.Metadata)
+		if err != nil {
+			return utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_INTERNAL)
+		}
+		insertBuilder = insertBuilder.Values(schema.Name, schema.SerializedDefinition, schema.Version, schema.TenantID, metadata)
+		tenants[schema.TenantID] = struct{}{}
+	}
+
+	tx, err := w.database.WritePool.BeginTx(ctx, w.txOptions)
+	if err != nil {
+		return utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
+	}
+	defer tx.Rollback(ctx)
+
+	if err = w.exec(ctx, tx, insertBuilder); err != nil {
+		return err
+	}
+	if err = w.writeSources(ctx, tx, schemas); err != nil {
+		return err
+	}
+	// the written version replaces the version activated before, if any
+	for tenantID := range tenants {
+		if err = w.exec(ctx, tx, w.database.Builder.Delete(SchemaHeadsTable).Where(squirrel.Eq{"tenant_id": tenantID})); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
+	}
+
+	slog.DebugContext(ctx, "successfully wrote schemas to the database", slog.Any("number_of_schemas", len(schemas)))
+	return nil // success
+}
+
+// WriteShadow writes the definitions of the shadow version of a tenant and replaces its previous shadow version
+func (w *SchemaWriter) WriteShadow(ctx context.Context, shadow storage.SchemaShadow, schemas []storage.SchemaDefinition) (err error) {
+	ctx, span := internal.Tracer.Start(ctx, "schema-writer.write-shadow")
+	defer span.End()
+	slog.DebugContext(ctx, "writing the shadow version of the schema", slog.String("tenant_id", shadow.TenantID), slog.String("version", shadow.Version))
+
+	tx, err := w.database.WritePool.BeginTx(ctx, w.txOptions)
+	if err != nil {
+		return utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
+	}
+	defer tx.Rollback(ctx)
+
+	previous, err := w.lockShadow(ctx, tx, shadow.TenantID)
+	if err != nil && err.Error() != base.ErrorCode_ERROR_CODE_SCHEMA_SHADOW_NOT_FOUND.String() {
+		return err
+	}
+	if previous != nil {
+		if err = w.deleteDefinitions(ctx, tx, shadow.TenantID, previous.Version); err != nil {
+			return err
+		}
+	}
+
+	insertBuilder := w.database.Builder.Insert(SchemaDefinitionTable).Columns("name, serialized_definition, version, tenant_id")
 	for _, schema := range schemas {
 		insertBuilder = insertBuilder.Values(schema.Name, schema.SerializedDefinition, schema.Version, schema.TenantID)
 	}
+	if err = w.exec(ctx, tx, insertBuilder); err != nil {
+		return err
+	}
+	if err = w.writeSources(ctx, tx, schemas); err != nil {
+		return err
+	}
+
+	upsertBuilder := w.database.Builder.Insert(SchemaShadowsTable).
+		Columns("tenant_id, version, served_version, sample_rate, created_at").
+		Values(shadow.TenantID, shadow.Version, shadow.ServedVersion, shadow.SampleRate, shadow.CreatedAt).
+		Suffix("ON CONFLICT (tenant_id) DO UPDATE SET version = EXCLUDED.version, served_version = EXCLUDED.served_version, sample_rate = EXCLUDED.sample_rate, created_at = EXCLUDED.created_at")
+	if err = w.exec(ctx, tx, upsertBuilder); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
+	}
+
+	slog.DebugContext(ctx, "successfully wrote the shadow version of the schema", slog.String("version", shadow.Version))
+	return nil
+}
+
+// PromoteShadow makes the shadow version of a tenant its head version
+func (w *SchemaWriter) PromoteShadow(ctx context.Context, tenantID string) (shadow *base.SchemaShadow, err error) {
+	ctx, span := internal.Tracer.Start(ctx, "schema-writer.promote-shadow")
+	defer span.End()
+	slog.DebugContext(ctx, "promoting the shadow version of the schema", slog.String("tenant_id", tenantID))
+
+	tx, err := w.database.WritePool.BeginTx(ctx, w.txOptions)
+	if err != nil {
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
+	}
+	defer tx.Rollback(ctx)
+
+	shadow, err = w.lockShadow(ctx, tx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The decisions of the shadow version were compared against the served version, promoting it over
+	// a version written since then would serve a version that was never compared.
+	head, err := w.headVersion(ctx, tx, tenantID)
+	if err != nil && err.Error() != base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String() {
+		return nil, err
+	}
+	if head != shadow.GetServedVersion() {
+		return nil, utils.HandleError(ctx, span, errors.New("schema version written or activated since the shadow version"), base.ErrorCode_ERROR_CODE_SCHEMA_SHADOW_OUTDATED)
+	}
+
+	if err = w.exec(ctx, tx, w.database.Builder.Delete(SchemaShadowsTable).Where(squirrel.Eq{"tenant_id": tenantID})); err != nil {
+		return nil, err
+	}
+	// the shadow version is the latest version, it is the head version once the activated version, if any, is cleared
+	if err = w.exec(ctx, tx, w.database.Builder.Delete(SchemaHeadsTable).Where(squirrel.Eq{"tenant_id": tenantID})); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
+	}
+
+	slog.DebugContext(ctx, "successfully promoted the shadow version of the schema", slog.String("version", shadow.GetVersion()))
+	return shadow, nil
+}
+
+// DeleteShadow removes the shadow version of a tenant and its definitions
+func (w *SchemaWriter) DeleteShadow(ctx context.Context, tenantID string) (shadow *base.SchemaShadow, err error) {
+	ctx, span := internal.Tracer.Start(ctx, "schema-writer.delete-shadow")
+	defer span.End()
+	slog.DebugContext(ctx, "deleting the shadow version of the schema", slog.String("tenant_id", tenantID))
+
+	tx, err := w.database.WritePool.BeginTx(ctx, w.txOptions)
+	if err != nil {
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
+	}
+	defer tx.Rollback(ctx)
+
+	shadow, err = w.lockShadow(ctx, tx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if err = w.deleteDefinitions(ctx, tx, tenantID, shadow.GetVersion()); err != nil {
+		return nil, err
+	}
+	if err = w.exec(ctx, tx, w.database.Builder.Delete(SchemaTagsTable).Where(squirrel.Eq{"tenant_id": tenantID, "version": shadow.GetVersion()})); err != nil {
+		return nil, err
+	}
+	if err = w.exec(ctx, tx, w.database.Builder.Delete(SchemaShadowsTable).Where(squirrel.Eq{"tenant_id": tenantID})); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
+	}
+
+	slog.DebugContext(ctx, "successfully deleted the shadow version of the schema", slog.String("version", shadow.GetVersion()))
+	return shadow, nil
+}
+
+// TagSchema points a tag of a tenant to a version and returns the version it pointed to before, if any
+func (w *SchemaWriter) TagSchema(ctx context.Context, tenantID, tag, version string) (previous string, err error) {
+	ctx, span := internal.Tracer.Start(ctx, "schema-writer.tag-schema")
+	defer span.End()
+	slog.DebugContext(ctx, "tagging a version of the schema", slog.String("tenant_id", tenantID), slog.String("tag", tag), slog.String("version", version))
+
+	tx, err := w.database.WritePool.BeginTx(ctx, w.txOptions)
+	if err != nil {
+		return "", utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
+	}
+	defer tx.Rollback(ctx)
+
+	if err = w.versionExists(ctx, tx, tenantID, version); err != nil {
+		return "", err
+	}
+
+	query, args, err := w.database.Builder.
+		Select("version").From(SchemaTagsTable).Where(squirrel.Eq{"tenant_id": tenantID, "tag": tag}).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return "", utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SQL_BUILDER)
+	}
+	if err = tx.QueryRow(ctx, query, args...).Scan(&previous); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return "", utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
+	}
+
+	upsertBuilder := w.database.Builder.Insert(SchemaTagsTable).
+		Columns("tenant_id, tag, version").
+		Values(tenantID, tag, version).
+		Suffix("ON CONFLICT (tenant_id, tag) DO UPDATE SET version = EXCLUDED.version, created_at = now()")
+	if err = w.exec(ctx, tx, upsertBuilder); err != nil {
+		return "", err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return "", utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
+	}
+
+	slog.DebugContext(ctx, "successfully tagged a version of the schema", slog.String("tag", tag), slog.String("previous_version", previous))
+	return previous, nil
+}
+
+// UntagSchema deletes a tag of a tenant and returns the version it pointed to
+func (w *SchemaWriter) UntagSchema(ctx context.Context, tenantID, tag string) (version string, err error) {
+	ctx, span := internal.Tracer.Start(ctx, "schema-writer.untag-schema")
+	defer span.End()
+	slog.DebugContext(ctx, "untagging a version of the schema", slog.String("tenant_id", tenantID), slog.String("tag", tag))
+
+	query, args, err := w.database.Builder.
+		Delete(SchemaTagsTable).Where(squirrel.Eq{"tenant_id": tenantID, "tag": tag}).
+		Suffix("RETURNING version").
+		ToSql()
+	if err != nil {
+		return "", utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SQL_BUILDER)
+	}
+
+	slog.DebugContext(ctx, "executing sql query", slog.Any("query", query), slog.Any("arguments", args))
+	if err = w.database.WritePool.QueryRow(ctx, query, args...).Scan(&version); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCHEMA_TAG_NOT_FOUND)
+		}
+		return "", utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
+	}
+
+	slog.DebugContext(ctx, "successfully untagged a version of the schema", slog.String("version", version))
+	return version, nil
+}
+
+// ActivateSchema makes a version of a tenant its head version and returns the previous head version
+func (w *SchemaWriter) ActivateSchema(ctx context.Context, tenantID, version string) (previous string, err error) {
+	ctx, span := internal.Tracer.Start(ctx, "schema-writer.activate-schema")
+	defer span.End()
+	slog.DebugContext(ctx, "activating a version of the schema", slog.String("tenant_id", tenantID), slog.String("version", version))
+
+	tx, err := w.database.WritePool.BeginTx(ctx, w.txOptions)
+	if err != nil {
+		return "", utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
+	}
+	defer tx.Rollback(ctx)
 
-	var query string
-	var args []interface{}
+	if err = w.versionExists(ctx, tx, tenantID, version); err != nil {
+		return "", err
+	}
+
+	// The shadow version is served by promoting it, which checks it was compared against the served version.
+	shadow, err := w.lockShadow(ctx, tx, tenantID)
+	if err != nil && err.Error() != base.ErrorCode_ERROR_CODE_SCHEMA_SHADOW_NOT_FOUND.String() {
+		return "", err
+	}
+	if shadow.GetVersion() == version {
+		return "", utils.HandleError(ctx, span, errors.New("schema version is the shadow version"), base.ErrorCode_ERROR_CODE_SCHEMA_VERSION_IN_SHADOW)
+	}
 
-	query, args, err = insertBuilder.ToSql()
+	previous, err = w.headVersion(ctx, tx, tenantID)
+	if err != nil {
+		return "", err
+	}
+
+	upsertBuilder := w.database.Builder.Insert(SchemaHeadsTable).
+		Columns("tenant_id, version").
+		Values(tenantID, version).
+		Suffix("ON CONFLICT (tenant_id) DO UPDATE SET version = EXCLUDED.version, activated_at = now()")
+	if err = w.exec(ctx, tx, upsertBuilder); err != nil {
+		return "", err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return "", utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
+	}
+
+	slog.DebugContext(ctx, "successfully activated a version of the schema", slog.String("version", version), slog.String("previous_version", previous))
+	return previous, nil
+}
+
+// headVersion reads the head version of a tenant within the transaction
+func (w *SchemaWriter) headVersion(ctx context.Context, tx pgx.Tx, tenantID string) (string, error) {
+	ctx, span := internal.Tracer.Start(ctx, "schema-writer.head-version")
+	defer span.End()
+
+	query, args, err := headVersionBuilder(w.database.Builder, tenantID).ToSql()
+	if err != nil {
+		return "", utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SQL_BUILDER)
+	}
+
+	var version *string
+	if err = tx.QueryRow(ctx, query, args...).Scan(&version); err != nil {
+		return "", utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
+	}
+	if version == nil {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String())
+	}
+	return *version, nil
+}
+
+// versionExists fails with a not found error if a tenant has no definition of the version
+func (w *SchemaWriter) versionExists(ctx context.Context, tx pgx.Tx, tenantID, version string) error {
+	ctx, span := internal.Tracer.Start(ctx, "schema-writer.version-exists")
+	defer span.End()
+
+	query, args, err := w.database.Builder.
+		Select("1").From(SchemaDefinitionTable).Where(squirrel.Eq{"tenant_id": tenantID, "version": version}).Limit(1).
+		ToSql()
 	if err != nil {
 		return utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SQL_BUILDER)
 	}
-	slog.DebugContext(ctx, "executing sql insert query", slog.Any("query", query), slog.Any("arguments", args))
-	_, err = w.database.WritePool.Exec(ctx, query, args...) // execute insert
+
+	var exists int
+	if err = tx.QueryRow(ctx, query, args...).Scan(&exists); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String())
+		}
+		return utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
+	}
+	return nil
+}
+
+// lockShadow reads the shadow version of a tenant and locks it until the end of the transaction
+func (w *SchemaWriter) lockShadow(ctx context.Context, tx pgx.Tx, tenantID string) (*base.SchemaShadow, error) {
+	ctx, span := internal.Tracer.Start(ctx, "schema-writer.lock-shadow")
+	defer span.End()
+
+	query, args, err := w.database.Builder.
+		Select("version, served_version, sample_rate, created_at").From(SchemaShadowsTable).Where(squirrel.Eq{"tenant_id": tenantID}).
+		Suffix("FOR UPDATE").
+		ToSql()
 	if err != nil {
-		return utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SQL_BUILDER)
 	}
 
-	slog.DebugContext(ctx, "successfully wrote schemas to the database", slog.Any("number_of_schemas", len(schemas)))
-	return nil // success
+	sh := storage.SchemaShadow{TenantID: tenantID}
+	if err = tx.QueryRow(ctx, query, args...).Scan(&sh.Version, &sh.ServedVersion, &sh.SampleRate, &sh.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_SHADOW_NOT_FOUND.String())
+		}
+		return nil, utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SCAN)
+	}
+	return sh.ToSchemaShadow(), nil
+}
+
+// deleteDefinitions deletes the definitions of a version of the schema of a tenant
+func (w *SchemaWriter) deleteDefinitions(ctx context.Context, tx pgx.Tx, tenantID, version string) error {
+	if err := w.exec(ctx, tx, w.database.Builder.Delete(SchemaSourcesTable).Where(squirrel.Eq{"tenant_id": tenantID, "version": version})); err != nil {
+		return err
+	}
+	return w.exec(ctx, tx, w.database.Builder.Delete(SchemaDefinitionTable).Where(squirrel.Eq{"tenant_id": tenantID, "version": version}))
+}
+
+// writeSources writes the source of every version of the definitions once, the versions written without a source
+// are skipped
+func (w *SchemaWriter) writeSources(ctx context.Context, tx pgx.Tx, schemas []storage.SchemaDefinition) error {
+	type versionKey struct {
+		tenantID string
+		version  string
+	}
+	written := make(map[versionKey]struct{})
+	insertBuilder := w.database.Builder.Insert(SchemaSourcesTable).Columns("tenant_id, version, source")
+	for _, schema := range schemas {
+		key := versionKey{tenantID: schema.TenantID, version: schema.Version}
+		if _, ok := written[key]; ok || schema.Source == "" {
+			continue
+		}
+		written[key] = struct{}{}
+		insertBuilder = insertBuilder.Values(schema.TenantID, schema.Version, schema.Source)
+	}
+	if len(written) == 0 {
+		return nil
+	}
+	return w.exec(ctx, tx, insertBuilder)
+}
+
+// exec executes the statement built by the builder within the transaction
+func (w *SchemaWriter) exec(ctx context.Context, tx pgx.Tx, builder squirrel.Sqlizer) error {
+	ctx, span := internal.Tracer.Start(ctx, "schema-writer.exec")
+	defer span.End()
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_SQL_BUILDER)
+	}
+	slog.DebugContext(ctx, "executing sql query", slog.Any("query", query), slog.Any("arguments", args))
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return utils.HandleError(ctx, span, err, base.ErrorCode_ERROR_CODE_EXECUTION)
+	}
+	return nil
 }
@@ -85,7 +85,7 @@ func (w *TenantWriter) DeleteTenant(ctx context.Context, tenantID string) (err e
 	}
 
 	// Prepare batch operations for deleting tenant-related records from multiple tables
-	tables := []string{BundlesTable, RelationTuplesTable, AttributesTable, SchemaDefinitionTable, TransactionsTable}
+	tables := []string{BundlesTable, RelationTuplesTable, AttributesTable, SchemaDefinitionTable, SchemaShadowsTable, SchemaTagsTable, SchemaHeadsTable, SchemaSourcesTable, IdempotencyKeysTable, TransactionsTable}
 	batch := &pgx.Batch{}
 	for _, table := range tables {
 		query := fmt.Sprintf(utils.DeleteAllByTenantTemplate, table)
@@ -15,6 +15,7 @@ import (
 	"go.opentelemetry.io/otel/codes"
 
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/Masterminds/squirrel"
 
@@ -22,7 +23,7 @@ import (
 )
 
 const (
-	TransactionTemplate       = `INSERT INTO transactions (tenant_id) VALUES ($1) RETURNING id, snapshot`
+	TransactionTemplate       = `INSERT INTO transactions (tenant_id, metadata) VALUES ($1, $2) RETURNING id, snapshot`
 	InsertTenantTemplate      = `INSERT INTO tenants (id, name) VALUES ($1, $2) RETURNING created_at`
 	DeleteTenantTemplate      = `DELETE FROM tenants WHERE id = $1 RETURNING name, created_at`
 	DeleteAllByTenantTemplate = `DELETE FROM %s WHERE tenant_id = $1`
@@ -143,6 +144,32 @@ func HandleError(ctx context.Context, span trace.Span, err error, errorCode base
 	return errors.New(errorCode.String())
 }
 
+// MarshalMetadata converts transaction metadata to the value of a JSONB column, nil when there is no metadata so
+// that the column is left NULL.
+func MarshalMetadata(metadata *base.TransactionMetadata) (interface{}, error) {
+	if metadata == nil {
+		return nil, nil
+	}
+	value, err := protojson.Marshal(metadata)
+	if err != nil {
+		return nil, err
+	}
+	return string(value), nil
+}
+
+// UnmarshalMetadata converts the value of a JSONB metadata column back to transaction metadata, nil when the
+// column is NULL.
+func UnmarshalMetadata(value []byte) (*base.TransactionMetadata, error) {
+	if value == nil {
+		return nil, nil
+	}
+	metadata := &base.TransactionMetadata{}
+	if err := protojson.Unmarshal(value, metadata); err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
 // IsContextRelatedError checks if the error is due to context cancellation, deadline exceedance, or closed connection
 func IsContextRelatedError(ctx context.Context, err error) bool {
 	if errors.Is(ctx.Err(), context.Canceled) || errors.Is(ctx.Err(), context.DeadlineExceeded) {
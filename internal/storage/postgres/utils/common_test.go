@@ -193,6 +193,33 @@ var _ = Describe("Common", func() {
 		})
 	})
 
+	Context("Transaction Metadata", func() {
+		It("should leave the column NULL without metadata", func() {
+			value, err := utils.MarshalMetadata(nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(value).Should(BeNil())
+
+			metadata, err := utils.UnmarshalMetadata(nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(metadata).Should(BeNil())
+		})
+
+		It("should convert metadata to JSON and back", func() {
+			value, err := utils.MarshalMetadata(&base.TransactionMetadata{
+				Actor:  "alice",
+				Reason: "TICKET-1",
+				Labels: map[string]string{"source": "sync"},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			metadata, err := utils.UnmarshalMetadata([]byte(value.(string)))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(metadata.GetActor()).Should(Equal("alice"))
+			Expect(metadata.GetReason()).Should(Equal("TICKET-1"))
+			Expect(metadata.GetLabels()).Should(Equal(map[string]string{"source": "sync"}))
+		})
+	})
+
 	Context("Error Detection Functions", func() {
 		It("should detect context-related errors", func() {
 			// Test context cancellation
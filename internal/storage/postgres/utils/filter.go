@@ -1,11 +1,25 @@
 package utils
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
 	"github.com/Masterminds/squirrel"
 
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 )
 
+// predicateOperators - SQL comparison operators of the attribute predicate operators
+var predicateOperators = map[base.AttributePredicate_Operator]string{
+	base.AttributePredicate_OPERATOR_EQUAL:                 "=",
+	base.AttributePredicate_OPERATOR_NOT_EQUAL:             "<>",
+	base.AttributePredicate_OPERATOR_LESS_THAN:             "<",
+	base.AttributePredicate_OPERATOR_LESS_THAN_OR_EQUAL:    "<=",
+	base.AttributePredicate_OPERATOR_GREATER_THAN:          ">",
+	base.AttributePredicate_OPERATOR_GREATER_THAN_OR_EQUAL: ">=",
+}
+
 // TuplesFilterQueryForSelectBuilder -
 func TuplesFilterQueryForSelectBuilder(sl squirrel.SelectBuilder, filter *base.TupleFilter) squirrel.SelectBuilder {
 	eq := squirrel.Eq{}
@@ -96,6 +110,70 @@ func AttributesFilterQueryForSelectBuilder(sl squirrel.SelectBuilder, filter *ba
 	return sl.Where(eq)
 }
 
+// PredicatesQueryForSelectBuilder restricts a select on a table to the rows whose entity or subject, as named by the
+// reference ("entity" or "subject"), has attributes satisfying every predicate as visible in the snapshot. Each
+// predicate is an EXISTS subquery on the attributes table. Values are read from their JSON encoding, where zero values
+// are omitted, and strings are compared bytewise.
+func PredicatesQueryForSelectBuilder(sl squirrel.SelectBuilder, attributesTable, table, reference string, predicates []*base.AttributePredicate, value uint64, snapshotValue string) (squirrel.SelectBuilder, error) {
+	for _, predicate := range predicates {
+		condition, err := attributePredicateCondition(predicate)
+		if err != nil {
+			return sl, err
+		}
+
+		exists := squirrel.Select("1").From(attributesTable + " AS p").
+			Where(fmt.Sprintf("p.tenant_id = %[1]s.tenant_id AND p.entity_type = %[1]s.%[2]s_type AND p.entity_id = %[1]s.%[2]s_id", table, reference)).
+			Where(squirrel.Eq{"p.attribute": predicate.GetAttribute()}).
+			Where(condition)
+		exists = SnapshotQuery(exists, value, snapshotValue)
+
+		sl = sl.Where(squirrel.Expr("EXISTS (?)", exists))
+	}
+	return sl, nil
+}
+
+// attributePredicateCondition - Returns the condition on the value column of the p alias satisfying a predicate
+func attributePredicateCondition(predicate *base.AttributePredicate) (squirrel.Sqlizer, error) {
+	target, err := predicate.GetValue().UnmarshalNew()
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+	}
+
+	var data any
+	var column string
+	switch t := target.(type) {
+	case *base.BooleanValue:
+		data, column = t.GetData(), "COALESCE((p.value->>'data')::boolean, false)"
+	case *base.StringValue:
+		data, column = t.GetData(), `COALESCE(p.value->>'data', '') COLLATE "C"`
+	case *base.IntegerValue:
+		data, column = t.GetData(), "COALESCE((p.value->>'data')::numeric, 0)"
+	case *base.DoubleValue:
+		data, column = t.GetData(), "COALESCE((p.value->>'data')::numeric, 0)"
+	default:
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+	}
+
+	if predicate.GetOperator() == base.AttributePredicate_OPERATOR_CONTAINS {
+		element, err := json.Marshal([]any{data})
+		if err != nil {
+			return nil, err
+		}
+		return squirrel.Expr("COALESCE(p.value->'data', '[]'::jsonb) @> ?::jsonb", string(element)), nil
+	}
+
+	operator, ok := predicateOperators[predicate.GetOperator()]
+	if !ok {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+	}
+	switch data.(type) {
+	case int32, float64:
+		return squirrel.Expr(column+" "+operator+" ?::numeric", data), nil
+	default:
+		return squirrel.Expr(column+" "+operator+" ?", data), nil
+	}
+}
+
 // TuplesFilterQueryForUpdateBuilder -
 func TuplesFilterQueryForUpdateBuilder(sl squirrel.UpdateBuilder, filter *base.TupleFilter) squirrel.UpdateBuilder {
 	eq := squirrel.Eq{}
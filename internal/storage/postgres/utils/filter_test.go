@@ -1,10 +1,13 @@
 package utils_test
 
 import (
+	"errors"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	"github.com/Masterminds/squirrel"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/Permify/permify/internal/storage/postgres/utils"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
@@ -729,4 +732,65 @@ var _ = Describe("Filter", func() {
 			})
 		})
 	})
+
+	Context("PredicatesQueryForSelectBuilder", func() {
+		It("adds an exists subquery per predicate", func() {
+			status, err := anypb.New(&base.StringValue{Data: "published"})
+			Expect(err).ShouldNot(HaveOccurred())
+			year, err := anypb.New(&base.IntegerValue{Data: 2025})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			sl := squirrel.Select("entity_id").From("attributes")
+			sl, err = utils.PredicatesQueryForSelectBuilder(sl, "attributes", "attributes", "entity", []*base.AttributePredicate{
+				{Attribute: "status", Operator: base.AttributePredicate_OPERATOR_EQUAL, Value: status},
+				{Attribute: "created_year", Operator: base.AttributePredicate_OPERATOR_GREATER_THAN_OR_EQUAL, Value: year},
+			}, 1, "snapshot")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			sql, args, err := sl.ToSql()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(sql).Should(ContainSubstring(`EXISTS (SELECT 1 FROM attributes AS p WHERE p.tenant_id = attributes.tenant_id AND p.entity_type = attributes.entity_type AND p.entity_id = attributes.entity_id AND p.attribute = ? AND COALESCE(p.value->>'data', '') COLLATE "C" = ?`))
+			Expect(sql).Should(ContainSubstring("COALESCE((p.value->>'data')::numeric, 0) >= ?::numeric"))
+			Expect(args).Should(ContainElements("status", "published", "created_year", int32(2025)))
+		})
+
+		It("matches array elements with containment", func() {
+			tag, err := anypb.New(&base.StringValue{Data: "legal"})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			sl := squirrel.Select("entity_id").From("attributes")
+			sl, err = utils.PredicatesQueryForSelectBuilder(sl, "attributes", "attributes", "entity", []*base.AttributePredicate{
+				{Attribute: "tags", Operator: base.AttributePredicate_OPERATOR_CONTAINS, Value: tag},
+			}, 1, "snapshot")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			sql, args, err := sl.ToSql()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(sql).Should(ContainSubstring("COALESCE(p.value->'data', '[]'::jsonb) @> ?::jsonb"))
+			Expect(args).Should(ContainElement(`["legal"]`))
+		})
+
+		It("correlates the subquery with the subject of tuples", func() {
+			public, err := anypb.New(&base.BooleanValue{Data: true})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			sl := squirrel.Select("subject_id").From("relation_tuples")
+			sl, err = utils.PredicatesQueryForSelectBuilder(sl, "attributes", "relation_tuples", "subject", []*base.AttributePredicate{
+				{Attribute: "is_public", Operator: base.AttributePredicate_OPERATOR_EQUAL, Value: public},
+			}, 1, "snapshot")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			sql, _, err := sl.ToSql()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(sql).Should(ContainSubstring("p.entity_type = relation_tuples.subject_type AND p.entity_id = relation_tuples.subject_id"))
+		})
+
+		It("fails on predicates that cannot be evaluated", func() {
+			sl := squirrel.Select("entity_id").From("attributes")
+			_, err := utils.PredicatesQueryForSelectBuilder(sl, "attributes", "attributes", "entity", []*base.AttributePredicate{
+				{Attribute: "status", Operator: base.AttributePredicate_OPERATOR_EQUAL, Value: &anypb.Any{TypeUrl: "unknown"}},
+			}, 1, "snapshot")
+			Expect(err).Should(Equal(errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())))
+		})
+	})
 })
@@ -18,6 +18,7 @@ import (
 
 	"github.com/Permify/permify/internal/storage"
 	"github.com/Permify/permify/internal/storage/postgres/snapshot"
+	"github.com/Permify/permify/internal/storage/postgres/utils"
 	db "github.com/Permify/permify/pkg/database/postgres"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
 )
@@ -280,6 +281,12 @@ func (w *Watch) getChanges(ctx context.Context, value db.XID8, tenantID string)
 	// Set the snapshot token for the changes.
 	changes.SnapToken = snapshot.NewToken(value, "").Encode().String()
 
+	// Attach the metadata the transaction was written with, if any.
+	changes.Metadata, err = w.getMetadata(ctx, value, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Iterate through the result rows.
 	for trows.Next() {
 		var expiredXID db.XID8
@@ -350,3 +357,27 @@ func (w *Watch) getChanges(ctx context.Context, value db.XID8, tenantID string)
 	// Return the changes and no error.
 	return changes, nil
 }
+
+// getMetadata retrieves the metadata a transaction was written with, nil if it was written without metadata.
+func (w *Watch) getMetadata(ctx context.Context, value db.XID8, tenantID string) (*base.TransactionMetadata, error) {
+	query, args, err := w.database.Builder.Select("metadata").
+		From(TransactionsTable).
+		Where(squirrel.Eq{"tenant_id": tenantID, "id": value}).
+		ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, "error while building sql query for transaction metadata", slog.Any("error", err))
+		return nil, err
+	}
+
+	var metadata []byte
+	err = w.database.ReadPool.QueryRow(ctx, query, args...).Scan(&metadata)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		slog.ErrorContext(ctx, "failed to execute sql query for transaction metadata", slog.Any("error", err))
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+
+	return utils.UnmarshalMetadata(metadata)
+}
@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/Permify/permify/internal/storage"
 	"github.com/Permify/permify/pkg/attribute"
 	"github.com/Permify/permify/pkg/database"
 	PQDatabase "github.com/Permify/permify/pkg/database/postgres"
@@ -92,7 +93,7 @@ var _ = Describe("Watch", func() {
 				}...)
 
 				time.Sleep(time.Second)
-				token2, err := dataWriter.Write(ctx, "t1", tuples1, attributes1)
+				token2, err := dataWriter.Write(ctx, "t1", tuples1, attributes1, storage.TransactionMetadata(&base.TransactionMetadata{Actor: "alice", Reason: "TICKET-1"}))
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(token2.String()).ShouldNot(Equal(""))
 			}()
@@ -101,6 +102,8 @@ var _ = Describe("Watch", func() {
 			case change := <-changes:
 				// Test the received change
 				Expect(change.DataChanges).ShouldNot(BeNil())
+				Expect(change.GetMetadata().GetActor()).Should(Equal("alice"))
+				Expect(change.GetMetadata().GetReason()).Should(Equal("TICKET-1"))
 				// Additional assertions about the structure and content of 'change'
 			case err := <-errs:
 				// Handle and assert the error
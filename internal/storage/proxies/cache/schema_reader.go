@@ -82,11 +82,17 @@ func (r *SchemaReader) ReadRuleDefinition(ctx context.Context, tenantID, ruleNam
 	return def, "", err
 }
 
-// HeadVersion - Finds the latest version of the schema.
+// HeadVersion - Finds the active version of the schema.
 func (r *SchemaReader) HeadVersion(ctx context.Context, tenantID string) (version string, err error) {
 	return r.delegate.HeadVersion(ctx, tenantID)
 }
 
+// TagVersion - Finds the version a tag points to. Tags are moved between versions, so unlike the definitions
+// of a version, they are not cached.
+func (r *SchemaReader) TagVersion(ctx context.Context, tenantID, tag string) (version string, err error) {
+	return r.delegate.TagVersion(ctx, tenantID, tag)
+}
+
 // ListSchemas - List all Schemas
 func (r *SchemaReader) ListSchemas(ctx context.Context, tenantID string, pagination database.Pagination) (schemas []*base.SchemaList, ct database.EncodedContinuousToken, err error) {
 	schemas, ct, err = r.delegate.ListSchemas(ctx, tenantID, pagination)
@@ -95,3 +101,13 @@ func (r *SchemaReader) ListSchemas(ctx context.Context, tenantID string, paginat
 	}
 	return schemas, ct, nil
 }
+
+// ReadSchemaSource - Reads the schema a version was written from
+func (r *SchemaReader) ReadSchemaSource(ctx context.Context, tenantID, version string) (string, error) {
+	return r.delegate.ReadSchemaSource(ctx, tenantID, version)
+}
+
+// ReadShadow - Reads the shadow version of the schema
+func (r *SchemaReader) ReadShadow(ctx context.Context, tenantID string) (*base.SchemaShadow, error) {
+	return r.delegate.ReadShadow(ctx, tenantID)
+}
@@ -2,6 +2,7 @@ package circuitbreaker
 
 import (
 	"context"
+	"time"
 
 	"github.com/sony/gobreaker"
 
@@ -54,6 +55,28 @@ func (r *DataReader) ReadRelationships(ctx context.Context, tenantID string, fil
 	return resp.Collection, resp.ContinuousToken, nil
 }
 
+// CountSubjects - Counts the distinct subjects of relation tuples in the repository
+func (r *DataReader) CountSubjects(ctx context.Context, tenantID string, filter *base.TupleFilter, token string) (int, error) {
+	response, err := r.cb.Execute(func() (interface{}, error) {
+		return r.delegate.CountSubjects(ctx, tenantID, filter, token)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return response.(int), nil
+}
+
+// QueryRelationshipHotspots - Counts relation tuples in the repository by their entity and relation, or by their subject
+func (r *DataReader) QueryRelationshipHotspots(ctx context.Context, tenantID string, group storage.HotspotGroup, token string, limit uint32) ([]*base.DataHotspot, error) {
+	response, err := r.cb.Execute(func() (interface{}, error) {
+		return r.delegate.QueryRelationshipHotspots(ctx, tenantID, group, token, limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.([]*base.DataHotspot), nil
+}
+
 // QuerySingleAttribute - Reads a single attribute from the repository.
 func (r *DataReader) QuerySingleAttribute(ctx context.Context, tenantID string, filter *base.AttributeFilter, token string) (*base.Attribute, error) {
 	response, err := r.cb.Execute(func() (interface{}, error) {
@@ -98,7 +121,7 @@ func (r *DataReader) ReadAttributes(ctx context.Context, tenantID string, filter
 }
 
 // QueryUniqueSubjectReferences - Reads unique subject references from the repository with different options.
-func (r *DataReader) QueryUniqueSubjectReferences(ctx context.Context, tenantID string, subjectReference *base.RelationReference, excluded []string, token string, pagination database.Pagination) (ids []string, ct database.EncodedContinuousToken, err error) {
+func (r *DataReader) QueryUniqueSubjectReferences(ctx context.Context, tenantID string, filter *base.SubjectFilter, excluded []string, token string, pagination database.Pagination) (ids []string, ct database.EncodedContinuousToken, err error) {
 	type circuitBreakerResponse struct {
 		IDs             []string
 		ContinuousToken database.EncodedContinuousToken
@@ -107,7 +130,7 @@ func (r *DataReader) QueryUniqueSubjectReferences(ctx context.Context, tenantID
 	response, err := r.cb.Execute(func() (interface{}, error) {
 		var err error
 		var resp circuitBreakerResponse
-		resp.IDs, resp.ContinuousToken, err = r.delegate.QueryUniqueSubjectReferences(ctx, tenantID, subjectReference, excluded, token, pagination)
+		resp.IDs, resp.ContinuousToken, err = r.delegate.QueryUniqueSubjectReferences(ctx, tenantID, filter, excluded, token, pagination)
 		return resp, err
 	})
 	if err != nil {
@@ -128,3 +151,67 @@ func (r *DataReader) HeadSnapshot(ctx context.Context, tenantID string) (token.S
 	}
 	return response.(token.SnapToken), nil
 }
+
+// ConsistentHeadSnapshot - Reads the latest version of the snapshot from the primary of the repository.
+func (r *DataReader) ConsistentHeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	response, err := r.cb.Execute(func() (interface{}, error) {
+		return r.delegate.ConsistentHeadSnapshot(ctx, tenantID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.(token.SnapToken), nil
+}
+
+// SnapshotAt - Reads the version of the snapshot at a point in time from the repository.
+func (r *DataReader) SnapshotAt(ctx context.Context, tenantID string, at time.Time) (token.SnapToken, error) {
+	response, err := r.cb.Execute(func() (interface{}, error) {
+		return r.delegate.SnapshotAt(ctx, tenantID, at)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.(token.SnapToken), nil
+}
+
+// ReadRelationshipHistory - Reads the versions of relation tuples from the repository.
+func (r *DataReader) ReadRelationshipHistory(ctx context.Context, tenantID string, filter *base.TupleFilter, pagination database.Pagination) ([]*base.DataHistoryRecord, database.EncodedContinuousToken, error) {
+	type circuitBreakerResponse struct {
+		Records         []*base.DataHistoryRecord
+		ContinuousToken database.EncodedContinuousToken
+	}
+
+	response, err := r.cb.Execute(func() (interface{}, error) {
+		var err error
+		var resp circuitBreakerResponse
+		resp.Records, resp.ContinuousToken, err = r.delegate.ReadRelationshipHistory(ctx, tenantID, filter, pagination)
+		return resp, err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := response.(circuitBreakerResponse)
+	return resp.Records, resp.ContinuousToken, nil
+}
+
+// ReadAttributeHistory - Reads the versions of attributes from the repository.
+func (r *DataReader) ReadAttributeHistory(ctx context.Context, tenantID string, filter *base.AttributeFilter, pagination database.Pagination) ([]*base.DataHistoryRecord, database.EncodedContinuousToken, error) {
+	type circuitBreakerResponse struct {
+		Records         []*base.DataHistoryRecord
+		ContinuousToken database.EncodedContinuousToken
+	}
+
+	response, err := r.cb.Execute(func() (interface{}, error) {
+		var err error
+		var resp circuitBreakerResponse
+		resp.Records, resp.ContinuousToken, err = r.delegate.ReadAttributeHistory(ctx, tenantID, filter, pagination)
+		return resp, err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := response.(circuitBreakerResponse)
+	return resp.Records, resp.ContinuousToken, nil
+}
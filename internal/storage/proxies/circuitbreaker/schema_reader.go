@@ -2,6 +2,7 @@ package circuitbreaker
 
 import (
 	"context"
+	"errors"
 
 	"github.com/sony/gobreaker"
 
@@ -85,7 +86,7 @@ func (r *SchemaReader) ReadRuleDefinition(ctx context.Context, tenantID, ruleNam
 	return resp.Definition, resp.Version, nil
 }
 
-// HeadVersion - Finds the latest version of the schema.
+// HeadVersion - Finds the active version of the schema.
 func (r *SchemaReader) HeadVersion(ctx context.Context, tenantID string) (version string, err error) {
 	response, err := r.cb.Execute(func() (interface{}, error) {
 		return r.delegate.HeadVersion(ctx, tenantID)
@@ -96,6 +97,25 @@ func (r *SchemaReader) HeadVersion(ctx context.Context, tenantID string) (versio
 	return response.(string), nil
 }
 
+// TagVersion - Finds the version a tag points to.
+func (r *SchemaReader) TagVersion(ctx context.Context, tenantID, tag string) (version string, err error) {
+	response, err := r.cb.Execute(func() (interface{}, error) {
+		version, err := r.delegate.TagVersion(ctx, tenantID, tag)
+		// An unknown tag is a mistake of the request, not a failure of the storage.
+		if err != nil && err.Error() == base.ErrorCode_ERROR_CODE_SCHEMA_TAG_NOT_FOUND.String() {
+			return "", nil
+		}
+		return version, err
+	})
+	if err != nil {
+		return "", err
+	}
+	if response.(string) == "" {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_TAG_NOT_FOUND.String())
+	}
+	return response.(string), nil
+}
+
 // ListSchemas - List all Schemas
 func (r *SchemaReader) ListSchemas(ctx context.Context, tenantID string, pagination database.Pagination) (schemas []*base.SchemaList, ct database.EncodedContinuousToken, err error) {
 	type circuitBreakerResponse struct {
@@ -116,3 +136,33 @@ func (r *SchemaReader) ListSchemas(ctx context.Context, tenantID string, paginat
 	resp := response.(circuitBreakerResponse)
 	return resp.Schemas, resp.Ct, nil
 }
+
+// ReadSchemaSource - Reads the schema a version was written from
+func (r *SchemaReader) ReadSchemaSource(ctx context.Context, tenantID, version string) (string, error) {
+	response, err := r.cb.Execute(func() (interface{}, error) {
+		return r.delegate.ReadSchemaSource(ctx, tenantID, version)
+	})
+	if err != nil {
+		return "", err
+	}
+	return response.(string), nil
+}
+
+// ReadShadow - Reads the shadow version of the schema
+func (r *SchemaReader) ReadShadow(ctx context.Context, tenantID string) (*base.SchemaShadow, error) {
+	response, err := r.cb.Execute(func() (interface{}, error) {
+		shadow, err := r.delegate.ReadShadow(ctx, tenantID)
+		// Most tenants have no shadow version, which is not a failure of the storage.
+		if err != nil && err.Error() == base.ErrorCode_ERROR_CODE_SCHEMA_SHADOW_NOT_FOUND.String() {
+			return nil, nil
+		}
+		return shadow, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if response == nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_SHADOW_NOT_FOUND.String())
+	}
+	return response.(*base.SchemaShadow), nil
+}
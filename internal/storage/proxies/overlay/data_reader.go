@@ -0,0 +1,550 @@
+package overlay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/Permify/permify/internal/storage"
+	storageContext "github.com/Permify/permify/internal/storage/context"
+	"github.com/Permify/permify/internal/storage/context/utils"
+	"github.com/Permify/permify/pkg/attribute"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/token"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// readPageSize - Page size of the reads collecting the stored data of the delegate
+const readPageSize = 100
+
+// DataReader - Overlays hypothetical changes on the data of a data reader. Deleted tuples and attributes are
+// read as if they were not stored and written ones as if they were. Deletions are applied before writes, and
+// written attributes replace the stored values of the same attributes.
+type DataReader struct {
+	delegate storage.DataReader
+
+	tuples     []*base.Tuple
+	attributes []*base.Attribute
+
+	// excludedTuples are the keys of the deleted tuples
+	excludedTuples map[string]struct{}
+	// excludedAttributes are the keys of the deleted and written attributes
+	excludedAttributes map[string]struct{}
+}
+
+// NewDataReader - Creates a data reader overlaying the changes on the data of the delegate
+func NewDataReader(delegate storage.DataReader, changes *base.SimulationChanges) *DataReader {
+	r := &DataReader{
+		delegate:           delegate,
+		tuples:             changes.GetWriteTuples(),
+		attributes:         changes.GetWriteAttributes(),
+		excludedTuples:     make(map[string]struct{}, len(changes.GetDeleteTuples())),
+		excludedAttributes: make(map[string]struct{}, len(changes.GetDeleteAttributes())+len(changes.GetWriteAttributes())),
+	}
+	for _, t := range changes.GetDeleteTuples() {
+		r.excludedTuples[tuple.ToString(t)] = struct{}{}
+	}
+	for _, a := range slices.Concat(changes.GetDeleteAttributes(), changes.GetWriteAttributes()) {
+		r.excludedAttributes[attribute.EntityAndAttributeToString(a.GetEntity(), a.GetAttribute())] = struct{}{}
+	}
+	return r
+}
+
+// QueryRelationships - Reads the stored relation tuples that are not deleted, and the written ones
+func (r *DataReader) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.CursorPagination) (*database.TupleIterator, error) {
+	predicates := slices.Concat(filter.GetEntity().GetPredicates(), filter.GetSubject().GetPredicates())
+	if err := validatePredicates(predicates); err != nil {
+		return nil, err
+	}
+
+	// Deleted tuples are dropped after the delegate applies the limit, so as many more are read. Predicates
+	// are evaluated on the overlaid attributes, so every stored tuple after the cursor is read when there are any.
+	limit := uint32(0)
+	if pagination.Limit() > 0 && len(predicates) == 0 {
+		limit = pagination.Limit() + uint32(len(r.excludedTuples))
+	}
+	unfiltered := withoutTuplePredicates(filter)
+	stored, err := r.delegate.QueryRelationships(ctx, tenantID, unfiltered, snap, database.NewCursorPagination(
+		database.Cursor(pagination.Cursor()),
+		database.Sort(pagination.Sort()),
+		database.Limit(limit),
+	))
+	if err != nil {
+		return nil, err
+	}
+	// the contextual tuples are sorted in place, the written tuples are shared by concurrent queries
+	written, err := storageContext.NewContextualTuples(slices.Clone(r.tuples)...).QueryRelationships(unfiltered, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	tuples, err := r.keepTuples(ctx, tenantID, snap, filter, stored, true)
+	if err != nil {
+		return nil, err
+	}
+	writtenTuples, err := r.keepTuples(ctx, tenantID, snap, filter, written, false)
+	if err != nil {
+		return nil, err
+	}
+	tuples = mergeTuples(tuples, writtenTuples)
+	switch pagination.Sort() {
+	case "entity_id":
+		sort.SliceStable(tuples, func(i, j int) bool { return tuples[i].GetEntity().GetId() < tuples[j].GetEntity().GetId() })
+	case "subject_id":
+		sort.SliceStable(tuples, func(i, j int) bool { return tuples[i].GetSubject().GetId() < tuples[j].GetSubject().GetId() })
+	}
+	if pagination.Limit() > 0 && len(tuples) > int(pagination.Limit()) {
+		tuples = tuples[:pagination.Limit()]
+	}
+
+	return database.NewTupleIterator(tuples...), nil
+}
+
+// ReadRelationships - Reads a page of the stored relation tuples that are not deleted followed by the written ones.
+// The pages are cut from the overlaid tuples, so their continuous tokens are offsets into them.
+func (r *DataReader) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (*database.TupleCollection, database.EncodedContinuousToken, error) {
+	offset, err := decodeOffset(pagination.Token())
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = validatePredicates(slices.Concat(filter.GetEntity().GetPredicates(), filter.GetSubject().GetPredicates())); err != nil {
+		return nil, nil, err
+	}
+
+	// The stored tuples are read until the page and the first tuple of the next one are collected.
+	unfiltered := withoutTuplePredicates(filter)
+	need := offset + int(pagination.PageSize()) + 1
+	var tuples []*base.Tuple
+	token := ""
+	for {
+		stored, ct, err := r.delegate.ReadRelationships(ctx, tenantID, unfiltered, snap, database.NewPagination(database.Size(readPageSize), database.Token(token)))
+		if err != nil {
+			return nil, nil, err
+		}
+		kept, err := r.keepTuples(ctx, tenantID, snap, filter, stored.CreateTupleIterator(), true)
+		if err != nil {
+			return nil, nil, err
+		}
+		tuples = append(tuples, kept...)
+
+		token = ct.String()
+		if token == "" {
+			break
+		}
+		if pagination.PageSize() > 0 && len(tuples) >= need {
+			items, next := page(tuples, offset, int(pagination.PageSize()))
+			return database.NewTupleCollection(items...), next, nil
+		}
+	}
+
+	written, err := storageContext.NewContextualTuples(slices.Clone(r.tuples)...).QueryRelationships(unfiltered, database.NewCursorPagination())
+	if err != nil {
+		return nil, nil, err
+	}
+	writtenTuples, err := r.keepTuples(ctx, tenantID, snap, filter, written, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	items, next := page(mergeTuples(tuples, writtenTuples), offset, int(pagination.PageSize()))
+	return database.NewTupleCollection(items...), next, nil
+}
+
+// CountSubjects - Counts the distinct subjects of the relation tuples read by QueryRelationships, wildcards are not counted
+func (r *DataReader) CountSubjects(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (int, error) {
+	it, err := r.QueryRelationships(ctx, tenantID, filter, snap, database.NewCursorPagination())
+	if err != nil {
+		return 0, err
+	}
+
+	subjects := map[string]struct{}{}
+	for it.HasNext() {
+		subject := it.GetNext().GetSubject()
+		if tuple.IsWildcard(subject) {
+			continue
+		}
+		subjects[fmt.Sprintf(tuple.ENTITY, subject.GetType(), subject.GetId())] = struct{}{}
+	}
+	return len(subjects), nil
+}
+
+// QueryRelationshipHotspots - Counts the stored relation tuples of the delegate, the changes are not counted
+func (r *DataReader) QueryRelationshipHotspots(ctx context.Context, tenantID string, group storage.HotspotGroup, snap string, limit uint32) ([]*base.DataHotspot, error) {
+	return r.delegate.QueryRelationshipHotspots(ctx, tenantID, group, snap, limit)
+}
+
+// QuerySingleAttribute - Reads the written attribute matching the filter, or the stored one if it is neither deleted nor written
+func (r *DataReader) QuerySingleAttribute(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string) (*base.Attribute, error) {
+	predicates := filter.GetEntity().GetPredicates()
+	if err := validatePredicates(predicates); err != nil {
+		return nil, err
+	}
+	unfiltered := withoutAttributePredicates(filter)
+
+	attr, err := storageContext.NewContextualAttributes(r.attributes...).QuerySingleAttribute(unfiltered)
+	if err != nil {
+		return nil, err
+	}
+	if attr == nil {
+		attr, err = r.delegate.QuerySingleAttribute(ctx, tenantID, unfiltered, snap)
+		if err != nil || attr == nil {
+			return attr, err
+		}
+		if _, ok := r.excludedAttributes[attribute.EntityAndAttributeToString(attr.GetEntity(), attr.GetAttribute())]; ok {
+			return nil, nil
+		}
+	}
+
+	matched, err := r.matchesPredicates(ctx, tenantID, snap, attr.GetEntity(), predicates)
+	if err != nil || !matched {
+		return nil, err
+	}
+	return attr, nil
+}
+
+// QueryAttributes - Reads the stored attributes that are neither deleted nor written, and the written ones
+func (r *DataReader) QueryAttributes(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string, pagination database.CursorPagination) (*database.AttributeIterator, error) {
+	predicates := filter.GetEntity().GetPredicates()
+	if err := validatePredicates(predicates); err != nil {
+		return nil, err
+	}
+
+	// Deleted and written attributes are dropped after the delegate applies the limit, so as many more are read.
+	// Predicates are evaluated on the overlaid attributes, so every stored attribute after the cursor is read when
+	// there are any.
+	limit := uint32(0)
+	if pagination.Limit() > 0 && len(predicates) == 0 {
+		limit = pagination.Limit() + uint32(len(r.excludedAttributes))
+	}
+	unfiltered := withoutAttributePredicates(filter)
+	stored, err := r.delegate.QueryAttributes(ctx, tenantID, unfiltered, snap, database.NewCursorPagination(
+		database.Cursor(pagination.Cursor()),
+		database.Sort(pagination.Sort()),
+		database.Limit(limit),
+	))
+	if err != nil {
+		return nil, err
+	}
+	written, err := storageContext.NewContextualAttributes(slices.Clone(r.attributes)...).QueryAttributes(unfiltered, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := r.keepAttributes(ctx, tenantID, snap, filter, stored, true)
+	if err != nil {
+		return nil, err
+	}
+	writtenAttributes, err := r.keepAttributes(ctx, tenantID, snap, filter, written, false)
+	if err != nil {
+		return nil, err
+	}
+	attributes = append(attributes, writtenAttributes...)
+	if pagination.Sort() == "entity_id" {
+		sort.SliceStable(attributes, func(i, j int) bool { return attributes[i].GetEntity().GetId() < attributes[j].GetEntity().GetId() })
+	}
+	if pagination.Limit() > 0 && len(attributes) > int(pagination.Limit()) {
+		attributes = attributes[:pagination.Limit()]
+	}
+
+	return database.NewAttributeIterator(attributes...), nil
+}
+
+// ReadAttributes - Reads a page of the stored attributes that are neither deleted nor written followed by the
+// written ones. The pages are cut from the overlaid attributes, so their continuous tokens are offsets into them.
+func (r *DataReader) ReadAttributes(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string, pagination database.Pagination) (*database.AttributeCollection, database.EncodedContinuousToken, error) {
+	offset, err := decodeOffset(pagination.Token())
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = validatePredicates(filter.GetEntity().GetPredicates()); err != nil {
+		return nil, nil, err
+	}
+
+	// The stored attributes are read until the page and the first attribute of the next one are collected.
+	unfiltered := withoutAttributePredicates(filter)
+	need := offset + int(pagination.PageSize()) + 1
+	var attributes []*base.Attribute
+	token := ""
+	for {
+		stored, ct, err := r.delegate.ReadAttributes(ctx, tenantID, unfiltered, snap, database.NewPagination(database.Size(readPageSize), database.Token(token)))
+		if err != nil {
+			return nil, nil, err
+		}
+		kept, err := r.keepAttributes(ctx, tenantID, snap, filter, stored.CreateAttributeIterator(), true)
+		if err != nil {
+			return nil, nil, err
+		}
+		attributes = append(attributes, kept...)
+
+		token = ct.String()
+		if token == "" {
+			break
+		}
+		if pagination.PageSize() > 0 && len(attributes) >= need {
+			items, next := page(attributes, offset, int(pagination.PageSize()))
+			return database.NewAttributeCollection(items...), next, nil
+		}
+	}
+
+	written, err := storageContext.NewContextualAttributes(slices.Clone(r.attributes)...).QueryAttributes(unfiltered, database.NewCursorPagination())
+	if err != nil {
+		return nil, nil, err
+	}
+	writtenAttributes, err := r.keepAttributes(ctx, tenantID, snap, filter, written, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	items, next := page(append(attributes, writtenAttributes...), offset, int(pagination.PageSize()))
+	return database.NewAttributeCollection(items...), next, nil
+}
+
+// QueryUniqueSubjectReferences - Reads the sorted subject references of the stored and the written tuples matching
+// the filter, with its predicates evaluated on the overlaid attributes. Subjects of deleted tuples are kept, as other
+// stored tuples may still reference them. Like the continuous tokens of the engines, the token is the first subject
+// of the next page.
+func (r *DataReader) QueryUniqueSubjectReferences(ctx context.Context, tenantID string, filter *base.SubjectFilter, excluded []string, snap string, pagination database.Pagination) ([]string, database.EncodedContinuousToken, error) {
+	var lowerBound string
+	if pagination.Token() != "" {
+		t, err := utils.EncodedContinuousToken{Value: pagination.Token()}.Decode()
+		if err != nil {
+			return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())
+		}
+		lowerBound = t.(utils.ContinuousToken).Value
+	}
+	if err := validatePredicates(filter.GetPredicates()); err != nil {
+		return nil, nil, err
+	}
+
+	unfiltered := filter.CloneVT()
+	unfiltered.Predicates = nil
+
+	references := map[string]struct{}{}
+	token := ""
+	for {
+		ids, ct, err := r.delegate.QueryUniqueSubjectReferences(ctx, tenantID, unfiltered, excluded, snap, database.NewPagination(database.Size(readPageSize), database.Token(token)))
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, id := range ids {
+			references[id] = struct{}{}
+		}
+		token = ct.String()
+		if token == "" {
+			break
+		}
+	}
+	for _, t := range r.tuples {
+		subject := t.GetSubject()
+		if subject.GetType() != filter.GetType() || subject.GetRelation() != filter.GetRelation() {
+			continue
+		}
+		if len(filter.GetIds()) > 0 && !slices.Contains(filter.GetIds(), subject.GetId()) {
+			continue
+		}
+		if slices.Contains(excluded, subject.GetId()) {
+			continue
+		}
+		references[subject.GetId()] = struct{}{}
+	}
+
+	sorted := make([]string, 0, len(references))
+	for id := range references {
+		if id >= lowerBound {
+			sorted = append(sorted, id)
+		}
+	}
+	sort.Strings(sorted)
+
+	var ids []string
+	for _, id := range sorted {
+		matched, err := r.matchesPredicates(ctx, tenantID, snap, &base.Entity{Type: filter.GetType(), Id: id}, filter.GetPredicates())
+		if err != nil {
+			return nil, nil, err
+		}
+		if !matched {
+			continue
+		}
+		if pagination.PageSize() > 0 && len(ids) == int(pagination.PageSize()) {
+			return ids, utils.NewContinuousToken(id).Encode(), nil
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, database.NewNoopContinuousToken().Encode(), nil
+}
+
+// HeadSnapshot - Reads the latest snapshot of the delegate
+func (r *DataReader) HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	return r.delegate.HeadSnapshot(ctx, tenantID)
+}
+
+// ConsistentHeadSnapshot - Reads the latest snapshot of the delegate from its primary
+func (r *DataReader) ConsistentHeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	return r.delegate.ConsistentHeadSnapshot(ctx, tenantID)
+}
+
+// SnapshotAt - Reads the snapshot of the delegate at a point in time
+func (r *DataReader) SnapshotAt(ctx context.Context, tenantID string, at time.Time) (token.SnapToken, error) {
+	return r.delegate.SnapshotAt(ctx, tenantID, at)
+}
+
+// ReadRelationshipHistory - Reads the relationship history of the delegate, the overlay has no history
+func (r *DataReader) ReadRelationshipHistory(ctx context.Context, tenantID string, filter *base.TupleFilter, pagination database.Pagination) ([]*base.DataHistoryRecord, database.EncodedContinuousToken, error) {
+	return r.delegate.ReadRelationshipHistory(ctx, tenantID, filter, pagination)
+}
+
+// ReadAttributeHistory - Reads the attribute history of the delegate, the overlay has no history
+func (r *DataReader) ReadAttributeHistory(ctx context.Context, tenantID string, filter *base.AttributeFilter, pagination database.Pagination) ([]*base.DataHistoryRecord, database.EncodedContinuousToken, error) {
+	return r.delegate.ReadAttributeHistory(ctx, tenantID, filter, pagination)
+}
+
+// keepTuples - Returns the tuples matching the predicates of the filter on the overlaid attributes, dropping the
+// deleted ones when they are stored
+func (r *DataReader) keepTuples(ctx context.Context, tenantID, snap string, filter *base.TupleFilter, it *database.TupleIterator, stored bool) ([]*base.Tuple, error) {
+	var tuples []*base.Tuple
+	for it.HasNext() {
+		t := it.GetNext()
+		if _, ok := r.excludedTuples[tuple.ToString(t)]; ok && stored {
+			continue
+		}
+		matched, err := r.matchesPredicates(ctx, tenantID, snap, t.GetEntity(), filter.GetEntity().GetPredicates())
+		if err != nil {
+			return nil, err
+		}
+		if !matched {
+			continue
+		}
+		subject := &base.Entity{Type: t.GetSubject().GetType(), Id: t.GetSubject().GetId()}
+		matched, err = r.matchesPredicates(ctx, tenantID, snap, subject, filter.GetSubject().GetPredicates())
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			tuples = append(tuples, t)
+		}
+	}
+	return tuples, nil
+}
+
+// keepAttributes - Returns the attributes whose entities match the predicates of the filter on the overlaid
+// attributes, dropping the deleted and written ones when they are stored
+func (r *DataReader) keepAttributes(ctx context.Context, tenantID, snap string, filter *base.AttributeFilter, it *database.AttributeIterator, stored bool) ([]*base.Attribute, error) {
+	var attributes []*base.Attribute
+	for it.HasNext() {
+		a := it.GetNext()
+		if _, ok := r.excludedAttributes[attribute.EntityAndAttributeToString(a.GetEntity(), a.GetAttribute())]; ok && stored {
+			continue
+		}
+		matched, err := r.matchesPredicates(ctx, tenantID, snap, a.GetEntity(), filter.GetEntity().GetPredicates())
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			attributes = append(attributes, a)
+		}
+	}
+	return attributes, nil
+}
+
+// matchesPredicates - Checks if the overlaid attributes of an entity satisfy every predicate
+func (r *DataReader) matchesPredicates(ctx context.Context, tenantID, snap string, entity *base.Entity, predicates []*base.AttributePredicate) (bool, error) {
+	for _, predicate := range predicates {
+		a, err := r.QuerySingleAttribute(ctx, tenantID, &base.AttributeFilter{
+			Entity:     &base.EntityFilter{Type: entity.GetType(), Ids: []string{entity.GetId()}},
+			Attributes: []string{predicate.GetAttribute()},
+		}, snap)
+		if err != nil {
+			return false, err
+		}
+		if a == nil {
+			return false, nil
+		}
+		matched, err := attribute.MatchesPredicate(predicate, a.GetValue())
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// mergeTuples - Returns the stored tuples followed by the written tuples, without duplicates
+func mergeTuples(stored, written []*base.Tuple) []*base.Tuple {
+	it := database.NewUniqueTupleIterator(database.NewTupleIterator(stored...), database.NewTupleIterator(written...))
+	var tuples []*base.Tuple
+	for it.HasNext() {
+		if t, ok := it.GetNext(); ok {
+			tuples = append(tuples, t)
+		}
+	}
+	return tuples
+}
+
+// validatePredicates - Rejects the predicates that cannot be evaluated, even when no data is read for them
+func validatePredicates(predicates []*base.AttributePredicate) error {
+	for _, predicate := range predicates {
+		if _, err := attribute.MatchesPredicate(predicate, predicate.GetValue()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// withoutTuplePredicates - Returns the filter without the predicates of its entity and subject, which the overlay
+// evaluates itself
+func withoutTuplePredicates(filter *base.TupleFilter) *base.TupleFilter {
+	if len(filter.GetEntity().GetPredicates()) == 0 && len(filter.GetSubject().GetPredicates()) == 0 {
+		return filter
+	}
+	unfiltered := filter.CloneVT()
+	if unfiltered.Entity != nil {
+		unfiltered.Entity.Predicates = nil
+	}
+	if unfiltered.Subject != nil {
+		unfiltered.Subject.Predicates = nil
+	}
+	return unfiltered
+}
+
+// withoutAttributePredicates - Returns the filter without the predicates of its entity, which the overlay evaluates itself
+func withoutAttributePredicates(filter *base.AttributeFilter) *base.AttributeFilter {
+	if len(filter.GetEntity().GetPredicates()) == 0 {
+		return filter
+	}
+	unfiltered := filter.CloneVT()
+	unfiltered.Entity.Predicates = nil
+	return unfiltered
+}
+
+// decodeOffset - Decodes the offset a continuous token of the overlay points to, zero for the first page
+func decodeOffset(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	t, err := utils.EncodedContinuousToken{Value: token}.Decode()
+	if err != nil {
+		return 0, errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())
+	}
+	offset, err := strconv.Atoi(t.(utils.ContinuousToken).Value)
+	if err != nil || offset < 0 {
+		return 0, errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())
+	}
+	return offset, nil
+}
+
+// page - Returns the items of the page at the offset, and the token of the next page if there are more items.
+// A page size of zero returns every item after the offset.
+func page[T any](items []T, offset, size int) ([]T, database.EncodedContinuousToken) {
+	if offset >= len(items) {
+		return nil, database.NewNoopContinuousToken().Encode()
+	}
+	if size == 0 || offset+size >= len(items) {
+		return items[offset:], database.NewNoopContinuousToken().Encode()
+	}
+	return items[offset : offset+size], utils.NewContinuousToken(strconv.Itoa(offset + size)).Encode()
+}
@@ -0,0 +1,135 @@
+package overlay
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	MMRepository "github.com/Permify/permify/internal/storage/memory"
+	"github.com/Permify/permify/internal/storage/memory/migrations"
+	"github.com/Permify/permify/pkg/attribute"
+	"github.com/Permify/permify/pkg/database"
+	"github.com/Permify/permify/pkg/database/memory"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+var _ = Describe("DataReader", func() {
+	var db *memory.Memory
+	var delegate *MMRepository.DataReader
+
+	tuples := func(relationships ...string) []*base.Tuple {
+		ts := make([]*base.Tuple, 0, len(relationships))
+		for _, relationship := range relationships {
+			t, err := tuple.Tuple(relationship)
+			Expect(err).ShouldNot(HaveOccurred())
+			ts = append(ts, t)
+		}
+		return ts
+	}
+
+	attributes := func(attrs ...string) []*base.Attribute {
+		as := make([]*base.Attribute, 0, len(attrs))
+		for _, attr := range attrs {
+			a, err := attribute.Attribute(attr)
+			Expect(err).ShouldNot(HaveOccurred())
+			as = append(as, a)
+		}
+		return as
+	}
+
+	BeforeEach(func() {
+		var err error
+		db, err = memory.New(migrations.Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = MMRepository.NewDataWriter(db).Write(context.Background(), "t1", database.NewTupleCollection(tuples(
+			"doc:1#viewer@user:a",
+			"doc:2#viewer@user:b",
+			"doc:3#viewer@user:c",
+			"doc:4#viewer@user:d",
+		)...), database.NewAttributeCollection(attributes(
+			"user:a$active|boolean:true",
+			"user:b$active|boolean:false",
+		)...))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		delegate = MMRepository.NewDataReader(db)
+	})
+
+	AfterEach(func() {
+		Expect(db.Close()).Should(Succeed())
+	})
+
+	It("pages the overlaid tuples, with the deleted ones dropped before the page is cut", func() {
+		reader := NewDataReader(delegate, &base.SimulationChanges{
+			DeleteTuples: tuples("doc:1#viewer@user:a", "doc:2#viewer@user:b"),
+			WriteTuples:  tuples("doc:5#viewer@user:e"),
+		})
+		filter := &base.TupleFilter{Entity: &base.EntityFilter{Type: "doc"}}
+
+		var pages [][]string
+		token := ""
+		for {
+			collection, ct, err := reader.ReadRelationships(context.Background(), "t1", filter, "", database.NewPagination(database.Size(2), database.Token(token)))
+			Expect(err).ShouldNot(HaveOccurred())
+			var page []string
+			for _, t := range collection.GetTuples() {
+				page = append(page, tuple.ToString(t))
+			}
+			pages = append(pages, page)
+			token = ct.String()
+			if token == "" {
+				break
+			}
+		}
+
+		Expect(pages).Should(Equal([][]string{
+			{"doc:3#viewer@user:c", "doc:4#viewer@user:d"},
+			{"doc:5#viewer@user:e"},
+		}))
+	})
+
+	It("limits the overlaid tuples after dropping the deleted ones", func() {
+		reader := NewDataReader(delegate, &base.SimulationChanges{
+			DeleteTuples: tuples("doc:1#viewer@user:a"),
+		})
+
+		it, err := reader.QueryRelationships(context.Background(), "t1", &base.TupleFilter{Entity: &base.EntityFilter{Type: "doc"}}, "", database.NewCursorPagination(database.Sort("entity_id"), database.Limit(2)))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var ids []string
+		for it.HasNext() {
+			ids = append(ids, it.GetNext().GetEntity().GetId())
+		}
+		Expect(ids).Should(Equal([]string{"2", "3"}))
+	})
+
+	It("matches the written subject references by ids and by predicates on the overlaid attributes", func() {
+		reader := NewDataReader(delegate, &base.SimulationChanges{
+			WriteTuples:     tuples("doc:5#viewer@user:e", "doc:6#viewer@user:f"),
+			WriteAttributes: attributes("user:b$active|boolean:true", "user:e$active|boolean:true", "user:f$active|boolean:false"),
+		})
+
+		value, err := anypb.New(&base.BooleanValue{Data: true})
+		Expect(err).ShouldNot(HaveOccurred())
+		predicate := &base.AttributePredicate{Attribute: "active", Operator: base.AttributePredicate_OPERATOR_EQUAL, Value: value}
+
+		ids, ct, err := reader.QueryUniqueSubjectReferences(context.Background(), "t1", &base.SubjectFilter{
+			Type:       "user",
+			Predicates: []*base.AttributePredicate{predicate},
+		}, nil, "", database.NewPagination(database.Size(10)))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ct.String()).Should(BeEmpty())
+		Expect(ids).Should(Equal([]string{"a", "b", "e"}))
+
+		ids, _, err = reader.QueryUniqueSubjectReferences(context.Background(), "t1", &base.SubjectFilter{
+			Type: "user",
+			Ids:  []string{"a", "f"},
+		}, nil, "", database.NewPagination(database.Size(10)))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ids).Should(Equal([]string{"a", "f"}))
+	})
+})
@@ -0,0 +1,13 @@
+package overlay
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestOverlay(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "overlay-suite")
+}
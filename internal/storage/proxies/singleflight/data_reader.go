@@ -2,6 +2,7 @@ package singleflight
 
 import (
 	"context"
+	"time"
 
 	"resenje.org/singleflight"
 
@@ -32,6 +33,16 @@ func (r *DataReader) ReadRelationships(ctx context.Context, tenantID string, fil
 	return r.delegate.ReadRelationships(ctx, tenantID, filter, token, pagination)
 }
 
+// CountSubjects - Counts the distinct subjects of relation tuples in the repository
+func (r *DataReader) CountSubjects(ctx context.Context, tenantID string, filter *base.TupleFilter, token string) (int, error) {
+	return r.delegate.CountSubjects(ctx, tenantID, filter, token)
+}
+
+// QueryRelationshipHotspots - Counts relation tuples in the repository by their entity and relation, or by their subject
+func (r *DataReader) QueryRelationshipHotspots(ctx context.Context, tenantID string, group storage.HotspotGroup, token string, limit uint32) ([]*base.DataHotspot, error) {
+	return r.delegate.QueryRelationshipHotspots(ctx, tenantID, group, token, limit)
+}
+
 // QuerySingleAttribute - Reads a single attribute from the repository.
 func (r *DataReader) QuerySingleAttribute(ctx context.Context, tenantID string, filter *base.AttributeFilter, token string) (*base.Attribute, error) {
 	return r.delegate.QuerySingleAttribute(ctx, tenantID, filter, token)
@@ -48,8 +59,8 @@ func (r *DataReader) ReadAttributes(ctx context.Context, tenantID string, filter
 }
 
 // QueryUniqueSubjectReferences - Reads unique subject references from the repository with different options.
-func (r *DataReader) QueryUniqueSubjectReferences(ctx context.Context, tenantID string, subjectReference *base.RelationReference, excluded []string, token string, pagination database.Pagination) (ids []string, ct database.EncodedContinuousToken, err error) {
-	return r.delegate.QueryUniqueSubjectReferences(ctx, tenantID, subjectReference, excluded, token, pagination)
+func (r *DataReader) QueryUniqueSubjectReferences(ctx context.Context, tenantID string, filter *base.SubjectFilter, excluded []string, token string, pagination database.Pagination) (ids []string, ct database.EncodedContinuousToken, err error) {
+	return r.delegate.QueryUniqueSubjectReferences(ctx, tenantID, filter, excluded, token, pagination)
 }
 
 // HeadSnapshot - Reads the latest version of the snapshot from the repository.
@@ -59,3 +70,24 @@ func (r *DataReader) HeadSnapshot(ctx context.Context, tenantID string) (token.S
 	})
 	return rev, err
 }
+
+// ConsistentHeadSnapshot - Reads the latest version of the snapshot from the primary of the repository. The reads are
+// not shared, a read started before the call may miss the writes committed just before it.
+func (r *DataReader) ConsistentHeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	return r.delegate.ConsistentHeadSnapshot(ctx, tenantID)
+}
+
+// SnapshotAt - Reads the version of the snapshot at a point in time from the repository.
+func (r *DataReader) SnapshotAt(ctx context.Context, tenantID string, at time.Time) (token.SnapToken, error) {
+	return r.delegate.SnapshotAt(ctx, tenantID, at)
+}
+
+// ReadRelationshipHistory - Reads the versions of relation tuples from the repository.
+func (r *DataReader) ReadRelationshipHistory(ctx context.Context, tenantID string, filter *base.TupleFilter, pagination database.Pagination) ([]*base.DataHistoryRecord, database.EncodedContinuousToken, error) {
+	return r.delegate.ReadRelationshipHistory(ctx, tenantID, filter, pagination)
+}
+
+// ReadAttributeHistory - Reads the versions of attributes from the repository.
+func (r *DataReader) ReadAttributeHistory(ctx context.Context, tenantID string, filter *base.AttributeFilter, pagination database.Pagination) ([]*base.DataHistoryRecord, database.EncodedContinuousToken, error) {
+	return r.delegate.ReadAttributeHistory(ctx, tenantID, filter, pagination)
+}
@@ -168,11 +168,11 @@ var _ = Describe("Singleflight DataReader", func() {
 			delegate := storage.NewNoopRelationshipReader()
 			reader := NewDataReader(delegate)
 
-			subjectRef := &base.RelationReference{
+			subjectFilter := &base.SubjectFilter{
 				Type:     "user",
 				Relation: "member",
 			}
-			ids, ct, err := reader.QueryUniqueSubjectReferences(ctx, "tenant1", subjectRef, []string{}, "token", database.Pagination{})
+			ids, ct, err := reader.QueryUniqueSubjectReferences(ctx, "tenant1", subjectFilter, []string{}, "token", database.Pagination{})
 
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(ids).ShouldNot(BeNil())
@@ -41,7 +41,7 @@ func (r *SchemaReader) ReadRuleDefinition(ctx context.Context, tenantID, ruleNam
 	return r.delegate.ReadRuleDefinition(ctx, tenantID, ruleName, version)
 }
 
-// HeadVersion - Finds the latest version of the schema.
+// HeadVersion - Finds the active version of the schema.
 func (r *SchemaReader) HeadVersion(ctx context.Context, tenantID string) (version string, err error) {
 	rev, _, err := r.group.Do(ctx, tenantID, func(ctx context.Context) (string, error) { // tenantID ensures proper tenant isolation in deduplication
 		return r.delegate.HeadVersion(ctx, tenantID)
@@ -49,7 +49,26 @@ func (r *SchemaReader) HeadVersion(ctx context.Context, tenantID string) (versio
 	return rev, err
 }
 
+// TagVersion - Finds the version a tag points to.
+func (r *SchemaReader) TagVersion(ctx context.Context, tenantID, tag string) (version string, err error) {
+	// '#' is allowed in neither tenant ids nor tags, so the keys of tags do not collide with the keys of tenants
+	rev, _, err := r.group.Do(ctx, tenantID+"#"+tag, func(ctx context.Context) (string, error) {
+		return r.delegate.TagVersion(ctx, tenantID, tag)
+	})
+	return rev, err
+}
+
 // ListSchemas - List all Schemas
 func (r *SchemaReader) ListSchemas(ctx context.Context, tenantID string, pagination database.Pagination) (schemas []*base.SchemaList, ct database.EncodedContinuousToken, err error) {
 	return r.delegate.ListSchemas(ctx, tenantID, pagination)
 }
+
+// ReadSchemaSource - Reads the schema a version was written from
+func (r *SchemaReader) ReadSchemaSource(ctx context.Context, tenantID, version string) (string, error) {
+	return r.delegate.ReadSchemaSource(ctx, tenantID, version)
+}
+
+// ReadShadow - Reads the shadow version of the schema
+func (r *SchemaReader) ReadShadow(ctx context.Context, tenantID string) (*base.SchemaShadow, error) {
+	return r.delegate.ReadShadow(ctx, tenantID)
+}
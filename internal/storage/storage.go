@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"time"
 
 	"github.com/Permify/permify/pkg/database"
 	base "github.com/Permify/permify/pkg/pb/base/v1"
@@ -18,6 +19,14 @@ type DataReader interface {
 	// It returns a collection of tuples, a continuous token indicating the position in the data set, and any error encountered.
 	ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (collection *database.TupleCollection, ct database.EncodedContinuousToken, err error)
 
+	// CountSubjects counts the distinct subjects, by type and id, of the relation tuples in the storage that match
+	// the given filter. Wildcard subjects are not counted. It returns the number of subjects and any error encountered.
+	CountSubjects(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (count int, err error)
+
+	// QueryRelationshipHotspots counts the relation tuples in the storage grouped by their entity and relation, or by
+	// their subject. It returns the groups with the most tuples, most first and at most limit of them, and any error encountered.
+	QueryRelationshipHotspots(ctx context.Context, tenantID string, group HotspotGroup, snap string, limit uint32) (hotspots []*base.DataHotspot, err error)
+
 	// QuerySingleAttribute retrieves a single attribute from the storage based on the given filter.
 	// It returns the retrieved attribute and any error encountered.
 	QuerySingleAttribute(ctx context.Context, tenantID string, filter *base.AttributeFilter, snap string) (attribute *base.Attribute, err error)
@@ -32,11 +41,29 @@ type DataReader interface {
 
 	// QueryUniqueSubjectReferences reads unique subject references from the storage based on the given filter and pagination.
 	// It returns a slice of subject reference IDs, a continuous token indicating the position in the data set, and any error encountered.
-	QueryUniqueSubjectReferences(ctx context.Context, tenantID string, subjectReference *base.RelationReference, excluded []string, snap string, pagination database.Pagination) (ids []string, ct database.EncodedContinuousToken, err error)
+	QueryUniqueSubjectReferences(ctx context.Context, tenantID string, filter *base.SubjectFilter, excluded []string, snap string, pagination database.Pagination) (ids []string, ct database.EncodedContinuousToken, err error)
 
 	// HeadSnapshot reads the latest version of the snapshot from the storage for a specific tenant.
 	// It returns the snapshot token representing the version of the snapshot and any error encountered.
 	HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error)
+
+	// ConsistentHeadSnapshot reads the latest version of the snapshot of a specific tenant from the primary storage,
+	// so that it includes every write committed before the call even when the reads are served by replicas.
+	ConsistentHeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error)
+
+	// SnapshotAt reads the version of the snapshot of a specific tenant at a point in time, the last one committed at or before it.
+	// It fails with ERROR_CODE_HISTORY_UNAVAILABLE when the data of that time may have been garbage collected.
+	SnapshotAt(ctx context.Context, tenantID string, at time.Time) (token.SnapToken, error)
+
+	// ReadRelationshipHistory reads every version of the relation tuples matching the given filter, in the order they were written,
+	// leaving out the versions that expired before the garbage collection window.
+	// It returns the versions, a continuous token indicating the position in the data set, and any error encountered.
+	ReadRelationshipHistory(ctx context.Context, tenantID string, filter *base.TupleFilter, pagination database.Pagination) (records []*base.DataHistoryRecord, ct database.EncodedContinuousToken, err error)
+
+	// ReadAttributeHistory reads every version of the attributes matching the given filter, in the order they were written,
+	// leaving out the versions that expired before the garbage collection window.
+	// It returns the versions, a continuous token indicating the position in the data set, and any error encountered.
+	ReadAttributeHistory(ctx context.Context, tenantID string, filter *base.AttributeFilter, pagination database.Pagination) (records []*base.DataHistoryRecord, ct database.EncodedContinuousToken, err error)
 }
 
 type NoopDataReader struct{}
@@ -53,6 +80,14 @@ func (f *NoopDataReader) ReadRelationships(_ context.Context, _ string, _ *base.
 	return database.NewTupleCollection(), database.NewNoopContinuousToken().Encode(), nil
 }
 
+func (f *NoopDataReader) CountSubjects(_ context.Context, _ string, _ *base.TupleFilter, _ string) (int, error) {
+	return 0, nil
+}
+
+func (f *NoopDataReader) QueryRelationshipHotspots(_ context.Context, _ string, _ HotspotGroup, _ string, _ uint32) ([]*base.DataHotspot, error) {
+	return []*base.DataHotspot{}, nil
+}
+
 func (f *NoopDataReader) QuerySingleAttribute(_ context.Context, _ string, _ *base.AttributeFilter, _ string) (*base.Attribute, error) {
 	return &base.Attribute{}, nil
 }
@@ -65,7 +100,7 @@ func (f *NoopDataReader) ReadAttributes(_ context.Context, _ string, _ *base.Att
 	return database.NewAttributeCollection(), database.NewNoopContinuousToken().Encode(), nil
 }
 
-func (f *NoopDataReader) QueryUniqueSubjectReferences(_ context.Context, _ string, _ *base.RelationReference, _ []string, _ string, _ database.Pagination) ([]string, database.EncodedContinuousToken, error) {
+func (f *NoopDataReader) QueryUniqueSubjectReferences(_ context.Context, _ string, _ *base.SubjectFilter, _ []string, _ string, _ database.Pagination) ([]string, database.EncodedContinuousToken, error) {
 	return []string{}, database.NewNoopContinuousToken().Encode(), nil
 }
 
@@ -73,18 +108,68 @@ func (f *NoopDataReader) HeadSnapshot(_ context.Context, _ string) (token.SnapTo
 	return token.NewNoopToken(), nil
 }
 
+func (f *NoopDataReader) ConsistentHeadSnapshot(_ context.Context, _ string) (token.SnapToken, error) {
+	return token.NewNoopToken(), nil
+}
+
+func (f *NoopDataReader) SnapshotAt(_ context.Context, _ string, _ time.Time) (token.SnapToken, error) {
+	return token.NewNoopToken(), nil
+}
+
+func (f *NoopDataReader) ReadRelationshipHistory(_ context.Context, _ string, _ *base.TupleFilter, _ database.Pagination) ([]*base.DataHistoryRecord, database.EncodedContinuousToken, error) {
+	return []*base.DataHistoryRecord{}, database.NewNoopContinuousToken().Encode(), nil
+}
+
+func (f *NoopDataReader) ReadAttributeHistory(_ context.Context, _ string, _ *base.AttributeFilter, _ database.Pagination) ([]*base.DataHistoryRecord, database.EncodedContinuousToken, error) {
+	return []*base.DataHistoryRecord{}, database.NewNoopContinuousToken().Encode(), nil
+}
+
 type DataWriter interface {
 	// Write inserts a new TupleCollection and AttributeCollection into the database for a specified tenant.
+	// Preconditions of the options are evaluated in the transaction of the write, which fails with ERROR_CODE_FAILED_PRECONDITION when one does not hold,
+	// and a retry with the idempotency key of the options returns the snap token of the original write without applying it again.
+	// The transaction metadata of the options is persisted alongside the transaction of the write.
 	// Returns an encoded snapshot token representing the state of the database after the write operation and any error encountered.
-	Write(ctx context.Context, tenantID string, tupleCollection *database.TupleCollection, attributesCollection *database.AttributeCollection) (token token.EncodedSnapToken, err error)
+	Write(ctx context.Context, tenantID string, tupleCollection *database.TupleCollection, attributesCollection *database.AttributeCollection, opts ...WriteOption) (token token.EncodedSnapToken, err error)
 
 	// Delete removes data from the database based on the provided tuple and attribute filters for a specified tenant.
+	// Preconditions of the options are evaluated in the transaction of the delete, which fails with ERROR_CODE_FAILED_PRECONDITION when one does not hold,
+	// and a retry with the idempotency key of the options returns the snap token of the original delete without applying it again.
+	// The transaction metadata of the options is persisted alongside the transaction of the delete.
 	// Returns an encoded snapshot token representing the state of the database after the delete operation and any error encountered.
-	Delete(ctx context.Context, tenantID string, tupleFilter *base.TupleFilter, attributeFilter *base.AttributeFilter) (token token.EncodedSnapToken, err error)
+	Delete(ctx context.Context, tenantID string, tupleFilter *base.TupleFilter, attributeFilter *base.AttributeFilter, opts ...WriteOption) (token token.EncodedSnapToken, err error)
 
 	// RunBundle executes a specified data bundle for a given tenant.
+	// Preconditions of the options are evaluated in the transaction of the bundle, which fails with ERROR_CODE_FAILED_PRECONDITION when one does not hold,
+	// and a retry with the idempotency key of the options returns the snap token of the original run without applying it again.
+	// The transaction metadata of the options is persisted alongside the transaction of the bundle.
 	// Returns an encoded snapshot token representing the state of the database after running the bundle and any error encountered.
-	RunBundle(ctx context.Context, tenantID string, arguments map[string]string, bundle *base.DataBundle) (token token.EncodedSnapToken, err error)
+	RunBundle(ctx context.Context, tenantID string, arguments map[string]string, bundle *base.DataBundle, opts ...WriteOption) (token token.EncodedSnapToken, err error)
+
+	// Remove deletes exactly the given tuples and attributes of a specified tenant and, when a quarantine tenant is given,
+	// writes them into the quarantine tenant in the same transaction. Tuples are matched on all of their fields, including
+	// an empty subject relation, and attributes on their entity and name. Cardinality is not enforced on the quarantine tenant.
+	// Preconditions, the idempotency key and the transaction metadata of the options apply as they do to Write.
+	// Returns an encoded snapshot token representing the state of the database after the removal and any error encountered.
+	Remove(ctx context.Context, tenantID string, tupleCollection *database.TupleCollection, attributeCollection *database.AttributeCollection, quarantineTenantID string, opts ...WriteOption) (token token.EncodedSnapToken, err error)
+
+	// Import starts a bulk load of data for a specified tenant. The loaded data is not bound by the per write limit
+	// and is committed in as many transactions as the caller commits.
+	Import(ctx context.Context, tenantID string) (importer DataImport, err error)
+}
+
+// DataImport - Bulk load of data into a tenant, committed in one or more transactions.
+type DataImport interface {
+	// Load stages tuples and attributes in the current transaction of the import, starting one when needed.
+	// The rows are expected to be validated by the caller.
+	Load(ctx context.Context, tuples []*base.Tuple, attributes []*base.Attribute) (err error)
+
+	// Commit commits the current transaction of the import.
+	// Returns an encoded snapshot token representing the state of the database after the commit and any error encountered.
+	Commit(ctx context.Context) (token token.EncodedSnapToken, err error)
+
+	// Close rolls back the data loaded since the last commit and releases the import.
+	Close(ctx context.Context) (err error)
 }
 
 type NoopDataWriter struct{}
@@ -93,18 +178,40 @@ func NewNoopDataWriter() DataWriter {
 	return &NoopDataWriter{}
 }
 
-func (n *NoopDataWriter) Write(_ context.Context, _ string, _ *database.TupleCollection, _ *database.AttributeCollection) (token.EncodedSnapToken, error) {
+func (n *NoopDataWriter) Write(_ context.Context, _ string, _ *database.TupleCollection, _ *database.AttributeCollection, _ ...WriteOption) (token.EncodedSnapToken, error) {
 	return token.NewNoopToken().Encode(), nil
 }
 
-func (n *NoopDataWriter) Delete(_ context.Context, _ string, _ *base.TupleFilter, _ *base.AttributeFilter) (token.EncodedSnapToken, error) {
+func (n *NoopDataWriter) Delete(_ context.Context, _ string, _ *base.TupleFilter, _ *base.AttributeFilter, _ ...WriteOption) (token.EncodedSnapToken, error) {
 	return token.NewNoopToken().Encode(), nil
 }
 
-func (n *NoopDataWriter) RunBundle(_ context.Context, _ string, _ map[string]string, _ *base.DataBundle) (token.EncodedSnapToken, error) {
+func (n *NoopDataWriter) RunBundle(_ context.Context, _ string, _ map[string]string, _ *base.DataBundle, _ ...WriteOption) (token.EncodedSnapToken, error) {
 	return nil, nil
 }
 
+func (n *NoopDataWriter) Remove(_ context.Context, _ string, _ *database.TupleCollection, _ *database.AttributeCollection, _ string, _ ...WriteOption) (token.EncodedSnapToken, error) {
+	return token.NewNoopToken().Encode(), nil
+}
+
+func (n *NoopDataWriter) Import(_ context.Context, _ string) (DataImport, error) {
+	return &NoopDataImport{}, nil
+}
+
+type NoopDataImport struct{}
+
+func (n *NoopDataImport) Load(_ context.Context, _ []*base.Tuple, _ []*base.Attribute) error {
+	return nil
+}
+
+func (n *NoopDataImport) Commit(_ context.Context) (token.EncodedSnapToken, error) {
+	return token.NewNoopToken().Encode(), nil
+}
+
+func (n *NoopDataImport) Close(_ context.Context) error {
+	return nil
+}
+
 // SchemaReader - Reads schema definitions from the storage.
 type SchemaReader interface {
 	// ReadSchema returns the schema definition for a specific tenant and version as a structured object.
@@ -115,10 +222,17 @@ type SchemaReader interface {
 	ReadEntityDefinition(ctx context.Context, tenantID, entityName, version string) (definition *base.EntityDefinition, v string, err error)
 	// ReadRuleDefinition reads rule config from the storage.
 	ReadRuleDefinition(ctx context.Context, tenantID, ruleName, version string) (definition *base.RuleDefinition, v string, err error)
-	// HeadVersion reads the latest version of the schema from the storage.
+	// HeadVersion reads the active version of the schema from the storage, the latest written version unless
+	// another version was activated since.
 	HeadVersion(ctx context.Context, tenantID string) (version string, err error)
+	// TagVersion reads the version of the schema a tag points to from the storage.
+	TagVersion(ctx context.Context, tenantID, tag string) (version string, err error)
 	// ListSchemas lists all schemas from the storage
 	ListSchemas(ctx context.Context, tenantID string, pagination database.Pagination) (schemas []*base.SchemaList, ct database.EncodedContinuousToken, err error)
+	// ReadShadow reads the shadow version of the schema from the storage.
+	ReadShadow(ctx context.Context, tenantID string) (shadow *base.SchemaShadow, err error)
+	// ReadSchemaSource returns the schema a version was written from, empty when it was not written from a source.
+	ReadSchemaSource(ctx context.Context, tenantID, version string) (source string, err error)
 }
 
 type NoopSchemaReader struct{}
@@ -147,14 +261,41 @@ func (n *NoopSchemaReader) HeadVersion(_ context.Context, _ string) (string, err
 	return "", nil
 }
 
+func (n *NoopSchemaReader) TagVersion(_ context.Context, _, _ string) (string, error) {
+	return "", nil
+}
+
 func (n *NoopSchemaReader) ListSchemas(_ context.Context, _ string, _ database.Pagination) (tenants []*base.SchemaList, ct database.EncodedContinuousToken, err error) {
 	return nil, nil, nil
 }
 
+func (n *NoopSchemaReader) ReadSchemaSource(_ context.Context, _, _ string) (string, error) {
+	return "", nil
+}
+
+func (n *NoopSchemaReader) ReadShadow(_ context.Context, _ string) (*base.SchemaShadow, error) {
+	return &base.SchemaShadow{}, nil
+}
+
 // SchemaWriter - Writes schema definitions to the storage.
 type SchemaWriter interface {
 	// WriteSchema writes schema to the storage.
 	WriteSchema(ctx context.Context, definitions []SchemaDefinition) (err error)
+	// WriteShadow writes the definitions of the shadow version of a tenant, replacing its previous shadow version.
+	// The shadow version is excluded from the head version until it is promoted.
+	WriteShadow(ctx context.Context, shadow SchemaShadow, definitions []SchemaDefinition) (err error)
+	// PromoteShadow makes the shadow version of a tenant its head version. It fails if the head version is no
+	// longer the version served when the shadow was written.
+	PromoteShadow(ctx context.Context, tenantID string) (shadow *base.SchemaShadow, err error)
+	// DeleteShadow removes the shadow version of a tenant, its definitions and its tags.
+	DeleteShadow(ctx context.Context, tenantID string) (shadow *base.SchemaShadow, err error)
+	// TagSchema points a tag of a tenant to a version, returning the version it pointed to before, if any.
+	TagSchema(ctx context.Context, tenantID, tag, version string) (previous string, err error)
+	// UntagSchema deletes a tag of a tenant, returning the version it pointed to.
+	UntagSchema(ctx context.Context, tenantID, tag string) (version string, err error)
+	// ActivateSchema makes a version of a tenant its head version until another version is written, promoted
+	// or activated, returning the previous head version.
+	ActivateSchema(ctx context.Context, tenantID, version string) (previous string, err error)
 }
 
 type NoopSchemaWriter struct{}
@@ -167,6 +308,30 @@ func (n *NoopSchemaWriter) WriteSchema(_ context.Context, _ []SchemaDefinition)
 	return nil
 }
 
+func (n *NoopSchemaWriter) WriteShadow(_ context.Context, _ SchemaShadow, _ []SchemaDefinition) error {
+	return nil
+}
+
+func (n *NoopSchemaWriter) PromoteShadow(_ context.Context, _ string) (*base.SchemaShadow, error) {
+	return &base.SchemaShadow{}, nil
+}
+
+func (n *NoopSchemaWriter) DeleteShadow(_ context.Context, _ string) (*base.SchemaShadow, error) {
+	return &base.SchemaShadow{}, nil
+}
+
+func (n *NoopSchemaWriter) TagSchema(_ context.Context, _, _, _ string) (string, error) {
+	return "", nil
+}
+
+func (n *NoopSchemaWriter) UntagSchema(_ context.Context, _, _ string) (string, error) {
+	return "", nil
+}
+
+func (n *NoopSchemaWriter) ActivateSchema(_ context.Context, _, _ string) (string, error) {
+	return "", nil
+}
+
 // BundleReader - Reads data bundles from storage.
 type BundleReader interface {
 	// Read retrieves a data bundle based on tenant ID and name.
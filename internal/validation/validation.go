@@ -43,15 +43,56 @@ func ValidateTuple(definition *base.EntityDefinition, tup *base.Tuple) (err erro
 		return err
 	}
 
+	// A wildcard does not stand for a number of subjects, so it can not be assigned to a counted relation
+	if tuple.IsWildcard(tup.GetSubject()) && schema.IsRelationCountedInEntityDefinition(definition, tup.GetRelation()) {
+		return errors.New(base.ErrorCode_ERROR_CODE_NOT_SUPPORTED_COUNT.String())
+	}
+
 	// If no errors were encountered, return nil
 	return nil
 }
 
+// ValidateCardinality checks that the provided tuples do not assign more than one subject
+// to the same entity through a relation declared with the single cardinality modifier.
+// Definitions are keyed by entity type; tuples of entity types without a definition are skipped.
+func ValidateCardinality(definitions map[string]*base.EntityDefinition, tuples []*base.Tuple) error {
+	subjects := map[string]*base.Subject{}
+
+	for _, tup := range tuples {
+		definition, ok := definitions[tup.GetEntity().GetType()]
+		if !ok {
+			continue
+		}
+
+		rel, err := schema.GetRelationByNameInEntityDefinition(definition, tup.GetRelation())
+		if err != nil || rel.GetCardinality() != base.RelationDefinition_CARDINALITY_SINGLE {
+			continue
+		}
+
+		key := tuple.EntityAndRelationToString(tup.GetEntity(), tup.GetRelation())
+		if subject, ok := subjects[key]; ok {
+			if !tuple.AreSubjectsEqual(subject, tup.GetSubject()) {
+				return errors.New(base.ErrorCode_ERROR_CODE_CARDINALITY_VIOLATION.String())
+			}
+			continue
+		}
+
+		subjects[key] = tup.GetSubject()
+	}
+
+	return nil
+}
+
 // ValidateTupleFilter checks if the provided filter conforms to the entity definition
 func ValidateTupleFilter(tupleFilter *base.TupleFilter) (err error) {
 	if IsTupleFilterEmpty(tupleFilter) {
 		return errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
 	}
+
+	// Deletes are not restricted by attribute values, predicates would widen them silently.
+	if hasPredicates(tupleFilter, nil) {
+		return errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+	}
 	return nil
 }
 
@@ -65,10 +106,22 @@ func ValidateFilters(tupleFilter *base.TupleFilter, attributeFilter *base.Attrib
 		return errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
 	}
 
+	// Deletes are not restricted by attribute values, predicates would widen them silently.
+	if hasPredicates(tupleFilter, attributeFilter) {
+		return errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+	}
+
 	// If at least one of the filters is not empty, then the validation is successful, and no error is returned.
 	return nil
 }
 
+// hasPredicates checks if any of the entity and subject filters of the given filters has predicates.
+func hasPredicates(tupleFilter *base.TupleFilter, attributeFilter *base.AttributeFilter) bool {
+	return len(tupleFilter.GetEntity().GetPredicates()) > 0 ||
+		len(tupleFilter.GetSubject().GetPredicates()) > 0 ||
+		len(attributeFilter.GetEntity().GetPredicates()) > 0
+}
+
 // ValidateAttribute checks whether a given attribute request (reqAttribute) aligns with
 // the attribute definition in a given entity definition. It verifies if the attribute exists
 // in the entity definition and if the attribute type matches the type specified in the request.
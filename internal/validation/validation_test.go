@@ -500,6 +500,30 @@ var _ = Describe("validation", func() {
 					Attributes: []string{},
 				})
 			Expect(err).ShouldNot(HaveOccurred())
+
+			err = ValidateFilters(
+				&base.TupleFilter{}, &base.AttributeFilter{
+					Entity: &base.EntityFilter{
+						Type: "organization",
+						Predicates: []*base.AttributePredicate{
+							{Attribute: "is_public", Operator: base.AttributePredicate_OPERATOR_EQUAL},
+						},
+					},
+				})
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String()))
+
+			err = ValidateTupleFilter(&base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "organization",
+				},
+				Subject: &base.SubjectFilter{
+					Type: "user",
+					Predicates: []*base.AttributePredicate{
+						{Attribute: "is_active", Operator: base.AttributePredicate_OPERATOR_EQUAL},
+					},
+				},
+			})
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String()))
 		})
 
 		It("Case 8", func() {
@@ -682,5 +706,144 @@ var _ = Describe("validation", func() {
 			})
 			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_MISSING_ARGUMENT.String()))
 		})
+
+		It("Case 12", func() {
+			definitions := map[string]*base.EntityDefinition{
+				"document": {
+					Name: "document",
+					Relations: map[string]*base.RelationDefinition{
+						"parent": {
+							Name: "parent",
+							RelationReferences: []*base.RelationReference{
+								{
+									Type: "folder",
+								},
+							},
+							Cardinality: base.RelationDefinition_CARDINALITY_SINGLE,
+						},
+						"viewer": {
+							Name: "viewer",
+							RelationReferences: []*base.RelationReference{
+								{
+									Type: "user",
+								},
+							},
+						},
+					},
+				},
+			}
+
+			parent := func(documentID, folderID string) *base.Tuple {
+				return &base.Tuple{
+					Entity:   &base.Entity{Type: "document", Id: documentID},
+					Relation: "parent",
+					Subject:  &base.Subject{Type: "folder", Id: folderID},
+				}
+			}
+
+			viewer := func(documentID, userID string) *base.Tuple {
+				return &base.Tuple{
+					Entity:   &base.Entity{Type: "document", Id: documentID},
+					Relation: "viewer",
+					Subject:  &base.Subject{Type: "user", Id: userID},
+				}
+			}
+
+			err := ValidateCardinality(definitions, []*base.Tuple{
+				parent("1", "1"),
+				parent("2", "1"),
+				parent("1", "1"),
+				viewer("1", "1"),
+				viewer("1", "2"),
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = ValidateCardinality(definitions, []*base.Tuple{
+				parent("1", "1"),
+				parent("1", "2"),
+			})
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_CARDINALITY_VIOLATION.String()))
+		})
+
+		It("Case 13", func() {
+			definition := &base.EntityDefinition{
+				Name: "document",
+				Relations: map[string]*base.RelationDefinition{
+					"approver": {
+						Name: "approver",
+						RelationReferences: []*base.RelationReference{
+							{
+								Type: "user",
+							},
+						},
+					},
+					"viewer": {
+						Name: "viewer",
+						RelationReferences: []*base.RelationReference{
+							{
+								Type: "user",
+							},
+						},
+					},
+				},
+				Permissions: map[string]*base.PermissionDefinition{
+					"publish": {
+						Name: "publish",
+						Child: &base.Child{
+							Type: &base.Child_Rewrite{
+								Rewrite: &base.Rewrite{
+									RewriteOperation: base.Rewrite_OPERATION_INTERSECTION,
+									Children: []*base.Child{
+										{
+											Type: &base.Child_Leaf{
+												Leaf: &base.Leaf{
+													Type: &base.Leaf_Count{
+														Count: &base.Count{
+															Relation:   "approver",
+															Comparison: base.Count_COMPARISON_GREATER_THAN_OR_EQUAL,
+															Value:      2,
+														},
+													},
+												},
+											},
+										},
+										{
+											Type: &base.Child_Leaf{
+												Leaf: &base.Leaf{
+													Type: &base.Leaf_ComputedUserSet{
+														ComputedUserSet: &base.ComputedUserSet{
+															Relation: "viewer",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				References: map[string]base.EntityDefinition_Reference{
+					"approver": base.EntityDefinition_REFERENCE_RELATION,
+					"viewer":   base.EntityDefinition_REFERENCE_RELATION,
+					"publish":  base.EntityDefinition_REFERENCE_PERMISSION,
+				},
+			}
+
+			tup := func(relation, userID string) *base.Tuple {
+				return &base.Tuple{
+					Entity:   &base.Entity{Type: "document", Id: "1"},
+					Relation: relation,
+					Subject:  &base.Subject{Type: "user", Id: userID},
+				}
+			}
+
+			Expect(ValidateTuple(definition, tup("approver", "1"))).ShouldNot(HaveOccurred())
+			Expect(ValidateTuple(definition, tup("viewer", "*"))).ShouldNot(HaveOccurred())
+
+			err := ValidateTuple(definition, tup("approver", "*"))
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_NOT_SUPPORTED_COUNT.String()))
+		})
 	})
 })
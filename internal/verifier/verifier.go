@@ -0,0 +1,298 @@
+package verifier
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/Permify/permify/internal/schema"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/validation"
+	"github.com/Permify/permify/pkg/attribute"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+// DefaultPageSize - Number of tuples or attributes read and validated in a batch when the request sets none
+const DefaultPageSize = 100
+
+// Verifier - Scans the tuples and attributes of a tenant in batches at a single snapshot and validates them against a
+// schema version. Violations are reported as they are found and, depending on the action, deleted or moved to a
+// quarantine tenant once their batch is validated, each batch in a single transaction. The oldest tuple of an entity
+// through a single relation, the first one the storage returns at the snapshot, is valid, the tuples assigning the
+// entity another subject through the relation violate its cardinality.
+type Verifier struct {
+	schemaReader storage.SchemaReader
+	dataReader   storage.DataReader
+	dataWriter   storage.DataWriter
+
+	tenantID     string
+	quarantineID string
+	action       base.DataVerifyAction
+	pageSize     uint32
+	snap         string
+	options      []storage.WriteOption
+
+	// definitions read so far, a nil definition means the entity type is not in the schema
+	definitions map[string]*base.EntityDefinition
+	// singles holds the valid subject of the entities and single relations of the batch being validated
+	singles map[string]*base.Subject
+
+	summary *base.DataVerifySummary
+}
+
+// NewVerifier - Starts a verification of the data of a tenant. The data is read at the snap token of the metadata and
+// validated against its schema version, or at the head snapshot and version when they are empty.
+func NewVerifier(ctx context.Context, schemaReader storage.SchemaReader, dataReader storage.DataReader, dataWriter storage.DataWriter, request *base.DataVerifyRequest) (*Verifier, error) {
+	if request.GetAction() == base.DataVerifyAction_DATA_VERIFY_ACTION_QUARANTINE &&
+		(request.GetQuarantineTenantId() == "" || request.GetQuarantineTenantId() == request.GetTenantId()) {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+	}
+
+	version := request.GetMetadata().GetSchemaVersion()
+	if version == "" {
+		v, err := schemaReader.HeadVersion(ctx, request.GetTenantId())
+		if err != nil {
+			return nil, err
+		}
+		version = v
+	}
+
+	snap := request.GetMetadata().GetSnapToken()
+	if snap == "" {
+		st, err := dataReader.HeadSnapshot(ctx, request.GetTenantId())
+		if err != nil {
+			return nil, err
+		}
+		snap = st.Encode().String()
+	}
+
+	pageSize := request.GetPageSize()
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+
+	var options []storage.WriteOption
+	if md := request.GetMetadata().GetMetadata(); md != nil {
+		options = append(options, storage.TransactionMetadata(md))
+	}
+
+	return &Verifier{
+		schemaReader: schemaReader,
+		dataReader:   dataReader,
+		dataWriter:   dataWriter,
+		tenantID:     request.GetTenantId(),
+		quarantineID: request.GetQuarantineTenantId(),
+		action:       request.GetAction(),
+		pageSize:     pageSize,
+		snap:         snap,
+		options:      options,
+		definitions:  map[string]*base.EntityDefinition{},
+		singles:      map[string]*base.Subject{},
+		summary: &base.DataVerifySummary{
+			SchemaVersion: version,
+			SnapToken:     snap,
+		},
+	}, nil
+}
+
+// Run - Verifies the tuples and then the attributes of the tenant, calling report for every violation, and returns
+// the summary of the verification
+func (v *Verifier) Run(ctx context.Context, report func(*base.DataViolation) error) (*base.DataVerifySummary, error) {
+	ct := ""
+	for {
+		collection, next, err := v.dataReader.ReadRelationships(ctx, v.tenantID, &base.TupleFilter{}, v.snap, database.NewPagination(database.Size(v.pageSize), database.Token(ct)))
+		if err != nil {
+			return nil, err
+		}
+
+		var violations []*base.Tuple
+		clear(v.singles)
+		for _, tup := range collection.GetTuples() {
+			v.summary.Relationships++
+			reason, err := v.validateTuple(ctx, tup)
+			if err != nil {
+				return nil, err
+			}
+			if reason == "" {
+				continue
+			}
+			v.summary.Violations++
+			violations = append(violations, tup)
+			if err = report(&base.DataViolation{Item: &base.DataViolation_Tuple{Tuple: tup}, Reason: reason}); err != nil {
+				return nil, err
+			}
+		}
+
+		if err = v.remove(ctx, violations, nil); err != nil {
+			return nil, err
+		}
+
+		ct = next.String()
+		if ct == "" {
+			break
+		}
+	}
+
+	ct = ""
+	for {
+		collection, next, err := v.dataReader.ReadAttributes(ctx, v.tenantID, &base.AttributeFilter{}, v.snap, database.NewPagination(database.Size(v.pageSize), database.Token(ct)))
+		if err != nil {
+			return nil, err
+		}
+
+		var violations []*base.Attribute
+		for _, attr := range collection.GetAttributes() {
+			v.summary.Attributes++
+			reason, err := v.validateAttribute(ctx, attr)
+			if err != nil {
+				return nil, err
+			}
+			if reason == "" {
+				continue
+			}
+			v.summary.Violations++
+			violations = append(violations, attr)
+			if err = report(&base.DataViolation{Item: &base.DataViolation_Attribute{Attribute: attr}, Reason: reason}); err != nil {
+				return nil, err
+			}
+		}
+
+		if err = v.remove(ctx, nil, violations); err != nil {
+			return nil, err
+		}
+
+		ct = next.String()
+		if ct == "" {
+			break
+		}
+	}
+
+	slog.InfoContext(ctx, "data verified",
+		slog.String("tenant_id", v.tenantID),
+		slog.String("schema_version", v.summary.GetSchemaVersion()),
+		slog.Uint64("relationships", v.summary.GetRelationships()),
+		slog.Uint64("attributes", v.summary.GetAttributes()),
+		slog.Uint64("violations", v.summary.GetViolations()),
+		slog.Uint64("removed", v.summary.GetRemoved()))
+
+	return v.summary, nil
+}
+
+// remove deletes the violations of a batch by exact match, moving them to the quarantine tenant in the same transaction
+// when quarantining
+func (v *Verifier) remove(ctx context.Context, tuples []*base.Tuple, attributes []*base.Attribute) error {
+	if len(tuples)+len(attributes) == 0 || v.action == base.DataVerifyAction_DATA_VERIFY_ACTION_REPORT {
+		return nil
+	}
+
+	quarantineID := ""
+	if v.action == base.DataVerifyAction_DATA_VERIFY_ACTION_QUARANTINE {
+		quarantineID = v.quarantineID
+	}
+
+	snap, err := v.dataWriter.Remove(ctx, v.tenantID, database.NewTupleCollection(tuples...), database.NewAttributeCollection(attributes...), quarantineID, v.options...)
+	if err != nil {
+		return err
+	}
+	v.summary.SnapToken = snap.String()
+	v.summary.Removed += uint64(len(tuples) + len(attributes))
+	return nil
+}
+
+// validateTuple returns the reason a tuple violates the schema, or an empty string when it is valid
+func (v *Verifier) validateTuple(ctx context.Context, tup *base.Tuple) (string, error) {
+	if err := tup.Validate(); err != nil {
+		return err.Error(), nil
+	}
+	definition, err := v.definition(ctx, tup.GetEntity().GetType())
+	if err != nil {
+		return "", err
+	}
+	if definition == nil {
+		return base.ErrorCode_ERROR_CODE_ENTITY_DEFINITION_NOT_FOUND.String(), nil
+	}
+	if err = validation.ValidateTuple(definition, tup); err != nil {
+		return err.Error(), nil
+	}
+
+	relation, err := schema.GetRelationByNameInEntityDefinition(definition, tup.GetRelation())
+	if err != nil || relation.GetCardinality() != base.RelationDefinition_CARDINALITY_SINGLE {
+		return "", nil
+	}
+	subject, err := v.single(ctx, tup)
+	if err != nil {
+		return "", err
+	}
+	if !tuple.AreSubjectsEqual(subject, tup.GetSubject()) {
+		return base.ErrorCode_ERROR_CODE_CARDINALITY_VIOLATION.String(), nil
+	}
+	return "", nil
+}
+
+// single returns the valid subject of the entity and single relation of a tuple, the subject of the oldest tuple of
+// the entity through the relation at the snapshot. It is read once per batch.
+func (v *Verifier) single(ctx context.Context, tup *base.Tuple) (*base.Subject, error) {
+	key := tuple.EntityAndRelationToString(tup.GetEntity(), tup.GetRelation())
+	if subject, ok := v.singles[key]; ok {
+		return subject, nil
+	}
+	collection, _, err := v.dataReader.ReadRelationships(ctx, v.tenantID, &base.TupleFilter{
+		Entity:   &base.EntityFilter{Type: tup.GetEntity().GetType(), Ids: []string{tup.GetEntity().GetId()}},
+		Relation: tup.GetRelation(),
+	}, v.snap, database.NewPagination(database.Size(1)))
+	if err != nil {
+		return nil, err
+	}
+	subject := tup.GetSubject()
+	if tuples := collection.GetTuples(); len(tuples) > 0 {
+		subject = tuples[0].GetSubject()
+	}
+	v.singles[key] = subject
+	return subject, nil
+}
+
+// validateAttribute returns the reason an attribute violates the schema, or an empty string when it is valid
+func (v *Verifier) validateAttribute(ctx context.Context, attr *base.Attribute) (string, error) {
+	if err := attr.Validate(); err != nil {
+		return err.Error(), nil
+	}
+	definition, err := v.definition(ctx, attr.GetEntity().GetType())
+	if err != nil {
+		return "", err
+	}
+	if definition == nil {
+		return base.ErrorCode_ERROR_CODE_ENTITY_DEFINITION_NOT_FOUND.String(), nil
+	}
+	attributeDefinition, err := schema.GetAttributeByNameInEntityDefinition(definition, attr.GetAttribute())
+	if err != nil {
+		return err.Error(), nil
+	}
+	if err = attribute.ValidateValue(attr.GetValue(), attributeDefinition.GetType()); err != nil {
+		return base.ErrorCode_ERROR_CODE_ATTRIBUTE_TYPE_MISMATCH.String(), nil
+	}
+	return "", nil
+}
+
+// definition reads the definition of an entity type once, it is nil when the entity type is not in the schema
+func (v *Verifier) definition(ctx context.Context, entityType string) (*base.EntityDefinition, error) {
+	if definition, ok := v.definitions[entityType]; ok {
+		return definition, nil
+	}
+	definition, _, err := v.schemaReader.ReadEntityDefinition(ctx, v.tenantID, entityType, v.summary.GetSchemaVersion())
+	if err != nil {
+		if !isDefinitionNotFound(err) {
+			return nil, err
+		}
+		definition = nil
+	}
+	v.definitions[entityType] = definition
+	return definition, nil
+}
+
+// isDefinitionNotFound reports whether reading an entity definition failed because the entity type is not in the schema
+func isDefinitionNotFound(err error) bool {
+	return err.Error() == base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String() ||
+		err.Error() == base.ErrorCode_ERROR_CODE_ENTITY_DEFINITION_NOT_FOUND.String()
+}
@@ -0,0 +1,204 @@
+package verifier_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/internal/verifier"
+	"github.com/Permify/permify/pkg/attribute"
+	"github.com/Permify/permify/pkg/database"
+	"github.com/Permify/permify/pkg/dsl/compiler"
+	"github.com/Permify/permify/pkg/dsl/parser"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+func TestVerifier(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "verifier suite")
+}
+
+var _ = Describe("verifier", func() {
+	var db database.Database
+
+	storedTuples := func(tenantID string) []string {
+		collection, _, err := factories.DataReaderFactory(db).ReadRelationships(context.Background(), tenantID, &base.TupleFilter{}, "", database.NewPagination(database.Size(100)))
+		Expect(err).ShouldNot(HaveOccurred())
+		var relationships []string
+		for _, t := range collection.GetTuples() {
+			relationships = append(relationships, tuple.ToString(t))
+		}
+		return relationships
+	}
+
+	storedAttributes := func(tenantID string) []string {
+		collection, _, err := factories.DataReaderFactory(db).ReadAttributes(context.Background(), tenantID, &base.AttributeFilter{}, "", database.NewPagination(database.Size(100)))
+		Expect(err).ShouldNot(HaveOccurred())
+		var attributes []string
+		for _, a := range collection.GetAttributes() {
+			attributes = append(attributes, attribute.EntityAndAttributeToString(a.GetEntity(), a.GetAttribute()))
+		}
+		return attributes
+	}
+
+	verify := func(request *base.DataVerifyRequest) ([]*base.DataViolation, *base.DataVerifySummary) {
+		ctx := context.Background()
+		ver, err := verifier.NewVerifier(ctx, factories.SchemaReaderFactory(db), factories.DataReaderFactory(db), factories.DataWriterFactory(db), request)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var violations []*base.DataViolation
+		summary, err := ver.Run(ctx, func(violation *base.DataViolation) error {
+			violations = append(violations, violation)
+			return nil
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		return violations, summary
+	}
+
+	BeforeEach(func() {
+		var err error
+		db, err = factories.DatabaseFactory(config.Database{Engine: "memory"})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// The storage does not validate against the schema, which lets the data violate it. The tuples are built
+		// by hand since ids with an @ have no string form.
+		tup := func(entityType, entityID, relation, subjectType, subjectID, subjectRelation string) *base.Tuple {
+			return &base.Tuple{
+				Entity:   &base.Entity{Type: entityType, Id: entityID},
+				Relation: relation,
+				Subject:  &base.Subject{Type: subjectType, Id: subjectID, Relation: subjectRelation},
+			}
+		}
+		tuples := []*base.Tuple{
+			tup("doc", "1", "owner", "user", "1", ""),
+			tup("doc", "1", "editor", "user", "2", ""),
+			tup("folder", "1", "owner", "user", "1", ""),
+			tup("doc", "2", "owner", "user", "a@b.c", ""),
+			tup("doc", "2", "editor", "user", "a@b.c", ""),
+			tup("doc", "3", "viewer", "group", "1", "member"),
+			tup("doc", "3", "viewer", "group", "1", ""),
+			tup("doc", "1", "owner", "user", "3", ""),
+		}
+
+		public, err := anypb.New(&base.BooleanValue{Data: true})
+		Expect(err).ShouldNot(HaveOccurred())
+		wrong, err := anypb.New(&base.StringValue{Data: "yes"})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = factories.DataWriterFactory(db).Write(context.Background(), "t1", database.NewTupleCollection(tuples...), database.NewAttributeCollection(
+			&base.Attribute{Entity: &base.Entity{Type: "doc", Id: "1"}, Attribute: "public", Value: public},
+			&base.Attribute{Entity: &base.Entity{Type: "doc", Id: "2"}, Attribute: "public", Value: wrong},
+			&base.Attribute{Entity: &base.Entity{Type: "doc", Id: "3"}, Attribute: "archived", Value: public},
+		))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// The schema is written after the data, since the writes enforce the cardinality of its single relations.
+		sch, err := parser.NewParser(`
+		entity user {}
+
+		entity group {
+			relation member @user
+		}
+
+		entity doc {
+			relation owner @user single
+			relation viewer @group#member
+			attribute public boolean
+		}
+		`).Parse()
+		Expect(err).ShouldNot(HaveOccurred())
+		_, _, err = compiler.NewCompiler(true, sch).Compile()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var definitions []storage.SchemaDefinition
+		for _, st := range sch.Statements {
+			definitions = append(definitions, storage.SchemaDefinition{
+				TenantID:             "t1",
+				Version:              "v1",
+				Name:                 st.GetName(),
+				SerializedDefinition: []byte(st.String()),
+			})
+		}
+		Expect(factories.SchemaWriterFactory(db).WriteSchema(context.Background(), definitions)).Should(Succeed())
+	})
+
+	Context("Verify", func() {
+		It("Reports violations without removing them", func() {
+			violations, summary := verify(&base.DataVerifyRequest{TenantId: "t1", Metadata: &base.DataVerifyRequestMetadata{}, PageSize: 2})
+
+			Expect(summary.GetSchemaVersion()).Should(Equal("v1"))
+			Expect(summary.GetRelationships()).Should(Equal(uint64(8)))
+			Expect(summary.GetAttributes()).Should(Equal(uint64(3)))
+			Expect(summary.GetViolations()).Should(Equal(uint64(7)))
+			Expect(summary.GetRemoved()).Should(BeZero())
+			Expect(violations).Should(HaveLen(7))
+
+			Expect(tuple.ToString(violations[0].GetTuple())).Should(Equal("doc:1#editor@user:2"))
+			Expect(violations[1].GetReason()).Should(Equal(base.ErrorCode_ERROR_CODE_ENTITY_DEFINITION_NOT_FOUND.String()))
+			Expect(tuple.ToString(violations[3].GetTuple())).Should(Equal("doc:3#viewer@group:1"))
+			Expect(tuple.ToString(violations[4].GetTuple())).Should(Equal("doc:1#owner@user:3"))
+			Expect(violations[4].GetReason()).Should(Equal(base.ErrorCode_ERROR_CODE_CARDINALITY_VIOLATION.String()))
+			Expect(violations[5].GetAttribute().GetEntity().GetId()).Should(Equal("2"))
+			Expect(violations[5].GetReason()).Should(Equal(base.ErrorCode_ERROR_CODE_ATTRIBUTE_TYPE_MISMATCH.String()))
+			Expect(violations[6].GetAttribute().GetAttribute()).Should(Equal("archived"))
+			Expect(violations[6].GetReason()).Should(Equal(base.ErrorCode_ERROR_CODE_ATTRIBUTE_DEFINITION_NOT_FOUND.String()))
+
+			Expect(storedTuples("t1")).Should(HaveLen(8))
+			Expect(storedAttributes("t1")).Should(HaveLen(3))
+		})
+
+		It("Keeps the oldest subject of a single relation across batches", func() {
+			violations, _ := verify(&base.DataVerifyRequest{TenantId: "t1", Metadata: &base.DataVerifyRequestMetadata{}, PageSize: 1})
+
+			var cardinality []string
+			for _, violation := range violations {
+				if violation.GetReason() == base.ErrorCode_ERROR_CODE_CARDINALITY_VIOLATION.String() {
+					cardinality = append(cardinality, tuple.ToString(violation.GetTuple()))
+				}
+			}
+			Expect(cardinality).Should(Equal([]string{"doc:1#owner@user:3"}))
+		})
+
+		It("Deletes violations", func() {
+			_, summary := verify(&base.DataVerifyRequest{TenantId: "t1", Metadata: &base.DataVerifyRequestMetadata{}, PageSize: 2, Action: base.DataVerifyAction_DATA_VERIFY_ACTION_DELETE})
+
+			Expect(summary.GetViolations()).Should(Equal(uint64(7)))
+			Expect(summary.GetRemoved()).Should(Equal(uint64(7)))
+			Expect(summary.GetSnapToken()).ShouldNot(BeEmpty())
+
+			// the valid tuple differing from a violation only by its subject relation is kept
+			Expect(storedTuples("t1")).Should(ConsistOf("doc:1#owner@user:1", "doc:2#owner@user:a@b.c", "doc:3#viewer@group:1#member"))
+			Expect(storedAttributes("t1")).Should(ConsistOf("doc:1$public"))
+
+			_, summary = verify(&base.DataVerifyRequest{TenantId: "t1", Metadata: &base.DataVerifyRequestMetadata{}})
+			Expect(summary.GetViolations()).Should(BeZero())
+		})
+
+		It("Moves violations to the quarantine tenant", func() {
+			_, summary := verify(&base.DataVerifyRequest{TenantId: "t1", Metadata: &base.DataVerifyRequestMetadata{}, Action: base.DataVerifyAction_DATA_VERIFY_ACTION_QUARANTINE, QuarantineTenantId: "quarantine"})
+
+			Expect(summary.GetRemoved()).Should(Equal(uint64(7)))
+			Expect(storedTuples("t1")).Should(HaveLen(3))
+			Expect(storedTuples("quarantine")).Should(ConsistOf("doc:1#editor@user:2", "folder:1#owner@user:1", "doc:2#editor@user:a@b.c", "doc:3#viewer@group:1", "doc:1#owner@user:3"))
+			Expect(storedAttributes("quarantine")).Should(ConsistOf("doc:2$public", "doc:3$archived"))
+		})
+
+		It("Requires a distinct quarantine tenant", func() {
+			_, err := verifier.NewVerifier(context.Background(), factories.SchemaReaderFactory(db), factories.DataReaderFactory(db), factories.DataWriterFactory(db), &base.DataVerifyRequest{
+				TenantId:           "t1",
+				Metadata:           &base.DataVerifyRequestMetadata{},
+				Action:             base.DataVerifyAction_DATA_VERIFY_ACTION_QUARANTINE,
+				QuarantineTenantId: "t1",
+			})
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String()))
+		})
+	})
+})
@@ -1,8 +1,10 @@
 package attribute
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -342,3 +344,115 @@ func ValidateValue(any *anypb.Any, attributeType base.AttributeType) error {
 	// If the value was successfully unmarshalled and is of the expected type, return nil to indicate success.
 	return nil
 }
+
+// ValidatePredicate checks that a predicate applies to an attribute of the given type. Scalar attributes are
+// compared with a value of their own type, with any operator but OPERATOR_CONTAINS, except boolean ones which
+// are only compared for equality. Array attributes are only matched with OPERATOR_CONTAINS and a value of the
+// type of their elements.
+func ValidatePredicate(predicate *base.AttributePredicate, attributeType base.AttributeType) error {
+	var valueType base.AttributeType
+	switch attributeType {
+	case base.AttributeType_ATTRIBUTE_TYPE_BOOLEAN_ARRAY:
+		valueType = base.AttributeType_ATTRIBUTE_TYPE_BOOLEAN
+	case base.AttributeType_ATTRIBUTE_TYPE_STRING_ARRAY:
+		valueType = base.AttributeType_ATTRIBUTE_TYPE_STRING
+	case base.AttributeType_ATTRIBUTE_TYPE_INTEGER_ARRAY:
+		valueType = base.AttributeType_ATTRIBUTE_TYPE_INTEGER
+	case base.AttributeType_ATTRIBUTE_TYPE_DOUBLE_ARRAY:
+		valueType = base.AttributeType_ATTRIBUTE_TYPE_DOUBLE
+	default:
+		valueType = attributeType
+	}
+
+	if ValidateValue(predicate.GetValue(), valueType) != nil {
+		return errors.New(base.ErrorCode_ERROR_CODE_ATTRIBUTE_TYPE_MISMATCH.String())
+	}
+
+	operator := predicate.GetOperator()
+	switch {
+	case valueType != attributeType && operator != base.AttributePredicate_OPERATOR_CONTAINS,
+		valueType == attributeType && operator == base.AttributePredicate_OPERATOR_CONTAINS,
+		attributeType == base.AttributeType_ATTRIBUTE_TYPE_BOOLEAN && operator != base.AttributePredicate_OPERATOR_EQUAL && operator != base.AttributePredicate_OPERATOR_NOT_EQUAL:
+		return errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+	}
+
+	return nil
+}
+
+// MatchesPredicate reports whether the value of an attribute satisfies a predicate. Values that cannot be
+// compared with the value of the predicate do not satisfy it, predicates that cannot be evaluated are an error.
+func MatchesPredicate(predicate *base.AttributePredicate, value *anypb.Any) (bool, error) {
+	target, err := predicate.GetValue().UnmarshalNew()
+	if err != nil {
+		return false, errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+	}
+	operator := predicate.GetOperator()
+	if _, ok := base.AttributePredicate_Operator_name[int32(operator)]; !ok || operator == base.AttributePredicate_OPERATOR_UNSPECIFIED {
+		return false, errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+	}
+
+	// Values that cannot be unmarshalled satisfy no predicate.
+	actual, _ := value.UnmarshalNew()
+
+	switch t := target.(type) {
+	case *base.BooleanValue:
+		if operator == base.AttributePredicate_OPERATOR_CONTAINS {
+			a, ok := actual.(*base.BooleanArrayValue)
+			return ok && slices.Contains(a.GetData(), t.GetData()), nil
+		}
+		a, ok := actual.(*base.BooleanValue)
+		return ok && compare(operator, boolToInt(a.GetData()), boolToInt(t.GetData())), nil
+	case *base.StringValue:
+		if operator == base.AttributePredicate_OPERATOR_CONTAINS {
+			a, ok := actual.(*base.StringArrayValue)
+			return ok && slices.Contains(a.GetData(), t.GetData()), nil
+		}
+		a, ok := actual.(*base.StringValue)
+		return ok && compare(operator, a.GetData(), t.GetData()), nil
+	case *base.IntegerValue:
+		if operator == base.AttributePredicate_OPERATOR_CONTAINS {
+			a, ok := actual.(*base.IntegerArrayValue)
+			return ok && slices.Contains(a.GetData(), t.GetData()), nil
+		}
+		a, ok := actual.(*base.IntegerValue)
+		return ok && compare(operator, a.GetData(), t.GetData()), nil
+	case *base.DoubleValue:
+		if operator == base.AttributePredicate_OPERATOR_CONTAINS {
+			a, ok := actual.(*base.DoubleArrayValue)
+			return ok && slices.Contains(a.GetData(), t.GetData()), nil
+		}
+		a, ok := actual.(*base.DoubleValue)
+		return ok && compare(operator, a.GetData(), t.GetData()), nil
+	default:
+		return false, errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())
+	}
+}
+
+// compare applies a comparison operator to two values.
+func compare[T cmp.Ordered](operator base.AttributePredicate_Operator, a, b T) bool {
+	c := cmp.Compare(a, b)
+	switch operator {
+	case base.AttributePredicate_OPERATOR_EQUAL:
+		return c == 0
+	case base.AttributePredicate_OPERATOR_NOT_EQUAL:
+		return c != 0
+	case base.AttributePredicate_OPERATOR_LESS_THAN:
+		return c < 0
+	case base.AttributePredicate_OPERATOR_LESS_THAN_OR_EQUAL:
+		return c <= 0
+	case base.AttributePredicate_OPERATOR_GREATER_THAN:
+		return c > 0
+	case base.AttributePredicate_OPERATOR_GREATER_THAN_OR_EQUAL:
+		return c >= 0
+	default:
+		return false
+	}
+}
+
+// boolToInt orders false before true.
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
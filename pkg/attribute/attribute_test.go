@@ -585,6 +585,129 @@ var _ = Describe("attribute", func() {
 			}
 		})
 
+		It("ValidatePredicate", func() {
+			predicate := func(operator base.AttributePredicate_Operator, value *anypb.Any) *base.AttributePredicate {
+				return &base.AttributePredicate{Attribute: "attribute", Operator: operator, Value: value}
+			}
+
+			tests := []struct {
+				predicate     *base.AttributePredicate
+				attributeType base.AttributeType
+				err           error
+			}{
+				{
+					predicate:     predicate(base.AttributePredicate_OPERATOR_GREATER_THAN_OR_EQUAL, integerValue),
+					attributeType: base.AttributeType_ATTRIBUTE_TYPE_INTEGER,
+				},
+				{
+					predicate:     predicate(base.AttributePredicate_OPERATOR_CONTAINS, stringValue),
+					attributeType: base.AttributeType_ATTRIBUTE_TYPE_STRING_ARRAY,
+				},
+				{
+					predicate:     predicate(base.AttributePredicate_OPERATOR_EQUAL, isPublic),
+					attributeType: base.AttributeType_ATTRIBUTE_TYPE_BOOLEAN,
+				},
+				{
+					predicate:     predicate(base.AttributePredicate_OPERATOR_EQUAL, stringValue),
+					attributeType: base.AttributeType_ATTRIBUTE_TYPE_INTEGER,
+					err:           errors.New(base.ErrorCode_ERROR_CODE_ATTRIBUTE_TYPE_MISMATCH.String()),
+				},
+				{
+					predicate:     predicate(base.AttributePredicate_OPERATOR_EQUAL, stringValue),
+					attributeType: base.AttributeType_ATTRIBUTE_TYPE_STRING_ARRAY,
+					err:           errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String()),
+				},
+				{
+					predicate:     predicate(base.AttributePredicate_OPERATOR_CONTAINS, stringValue),
+					attributeType: base.AttributeType_ATTRIBUTE_TYPE_STRING,
+					err:           errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String()),
+				},
+				{
+					predicate:     predicate(base.AttributePredicate_OPERATOR_LESS_THAN, isPublic),
+					attributeType: base.AttributeType_ATTRIBUTE_TYPE_BOOLEAN,
+					err:           errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String()),
+				},
+			}
+
+			for _, tt := range tests {
+				err := ValidatePredicate(tt.predicate, tt.attributeType)
+				if tt.err == nil {
+					Expect(err).ShouldNot(HaveOccurred())
+				} else {
+					Expect(err).Should(Equal(tt.err))
+				}
+			}
+		})
+
+		It("MatchesPredicate", func() {
+			year, _ := anypb.New(&base.IntegerValue{Data: 2025})
+			status, _ := anypb.New(&base.StringValue{Data: "published"})
+
+			tests := []struct {
+				predicate *base.AttributePredicate
+				value     *anypb.Any
+				result    bool
+			}{
+				{
+					predicate: &base.AttributePredicate{Attribute: "created_year", Operator: base.AttributePredicate_OPERATOR_GREATER_THAN_OR_EQUAL, Value: year},
+					value:     year,
+					result:    true,
+				},
+				{
+					predicate: &base.AttributePredicate{Attribute: "created_year", Operator: base.AttributePredicate_OPERATOR_GREATER_THAN, Value: year},
+					value:     year,
+					result:    false,
+				},
+				{
+					predicate: &base.AttributePredicate{Attribute: "created_year", Operator: base.AttributePredicate_OPERATOR_LESS_THAN, Value: year},
+					value:     integerValue,
+					result:    true,
+				},
+				{
+					predicate: &base.AttributePredicate{Attribute: "status", Operator: base.AttributePredicate_OPERATOR_EQUAL, Value: status},
+					value:     stringValue,
+					result:    false,
+				},
+				{
+					predicate: &base.AttributePredicate{Attribute: "status", Operator: base.AttributePredicate_OPERATOR_NOT_EQUAL, Value: status},
+					value:     stringValue,
+					result:    true,
+				},
+				{
+					predicate: &base.AttributePredicate{Attribute: "ip_addresses", Operator: base.AttributePredicate_OPERATOR_CONTAINS, Value: stringValue},
+					value:     stringArrayValue,
+					result:    false,
+				},
+				{
+					predicate: &base.AttributePredicate{Attribute: "scores", Operator: base.AttributePredicate_OPERATOR_CONTAINS, Value: integerValue},
+					value:     integerArrayValue,
+					result:    true,
+				},
+				{
+					predicate: &base.AttributePredicate{Attribute: "is_public", Operator: base.AttributePredicate_OPERATOR_EQUAL, Value: isPublic},
+					value:     isPublic,
+					result:    true,
+				},
+				{
+					predicate: &base.AttributePredicate{Attribute: "created_year", Operator: base.AttributePredicate_OPERATOR_EQUAL, Value: year},
+					value:     doubleValue,
+					result:    false,
+				},
+			}
+
+			for _, tt := range tests {
+				result, err := MatchesPredicate(tt.predicate, tt.value)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result).Should(Equal(tt.result))
+			}
+
+			_, err := MatchesPredicate(&base.AttributePredicate{Attribute: "created_year", Operator: base.AttributePredicate_OPERATOR_EQUAL, Value: &anypb.Any{TypeUrl: "unknown"}}, year)
+			Expect(err).Should(Equal(errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())))
+
+			_, err = MatchesPredicate(&base.AttributePredicate{Attribute: "created_year", Value: year}, year)
+			Expect(err).Should(Equal(errors.New(base.ErrorCode_ERROR_CODE_INVALID_ARGUMENT.String())))
+		})
+
 		It("EntityAndAttributeToString", func() {
 			tests := []struct {
 				entity    *base.Entity
@@ -55,6 +55,26 @@ func NewConfigCommand() *cobra.Command {
 	f.StringSlice("log-headers", conf.Log.Headers, "allows setting custom headers for the log exporter in key-value pairs")
 	f.String("log-protocol", conf.Log.Protocol, "allows setting the communication protocol for the log exporter, with options http or grpc")
 	f.String("log-service-name", conf.Log.ServiceName, "service name for the log exporter")
+	f.Bool("audit-enabled", conf.Audit.Enabled, "switch option for recording the mutations made through the API")
+	f.Bool("audit-file-enabled", conf.Audit.File.Enabled, "switch option for writing audit records to a local JSONL file")
+	f.String("audit-file-path", conf.Audit.File.Path, "path of the audit JSONL file")
+	f.Int("audit-file-max-size", conf.Audit.File.MaxSize, "size in megabytes at which the audit file is rotated")
+	f.Int("audit-file-max-backups", conf.Audit.File.MaxBackups, "number of rotated audit files kept")
+	f.Bool("audit-postgres-enabled", conf.Audit.Postgres.Enabled, "switch option for writing audit records to the database, required to query them")
+	f.Bool("audit-otlp-enabled", conf.Audit.Otlp.Enabled, "switch option for exporting audit records as OTLP logs")
+	f.String("audit-otlp-endpoint", conf.Audit.Otlp.Endpoint, "export uri for audit records")
+	f.Bool("audit-otlp-insecure", conf.Audit.Otlp.Insecure, "use https or http for audit records")
+	f.String("audit-otlp-urlpath", conf.Audit.Otlp.Urlpath, "allow to set url path for the audit otlp exporter")
+	f.StringSlice("audit-otlp-headers", conf.Audit.Otlp.Headers, "allows setting custom headers for the audit exporter in key-value pairs")
+	f.String("audit-otlp-protocol", conf.Audit.Otlp.Protocol, "allows setting the communication protocol for the audit exporter, with options http or grpc")
+	f.Bool("decisions-enabled", conf.DecisionLog.Enabled, "switch option for logging the answers of permission checks and lookups")
+	f.Float64("decisions-sample-rate", conf.DecisionLog.SampleRate, "fraction of the decisions logged when no tenant or permission rate matches")
+	f.Bool("decisions-redact-context", conf.DecisionLog.RedactContext, "redact the values of the contextual data and attributes of logged decisions")
+	f.Bool("decisions-file-enabled", conf.DecisionLog.File.Enabled, "switch option for writing decisions to a local JSONL file")
+	f.String("decisions-file-path", conf.DecisionLog.File.Path, "path of the decision JSONL file")
+	f.Int("decisions-file-max-size", conf.DecisionLog.File.MaxSize, "size in megabytes at which the decision file is rotated")
+	f.Int("decisions-file-max-backups", conf.DecisionLog.File.MaxBackups, "number of rotated decision files kept")
+	f.Bool("decisions-exporter-enabled", conf.DecisionLog.Exporter.Enabled, "switch option for emitting decisions through the log exporter")
 	f.Bool("authn-enabled", conf.Authn.Enabled, "enable server authentication")
 	f.String("authn-method", conf.Authn.Method, "server authentication method")
 	f.StringSlice("authn-preshared-keys", conf.Authn.Preshared.Keys, "preshared key/keys for server authentication")
@@ -65,6 +85,10 @@ func NewConfigCommand() *cobra.Command {
 	f.Duration("authn-oidc-backoff-frequency", conf.Authn.Oidc.BackoffFrequency, "backoff frequency for the OpenID Connect configuration")
 	f.Int("authn-oidc-backoff-max-retries", conf.Authn.Oidc.BackoffMaxRetries, "defines the maximum number of retries for the OpenID Connect configuration")
 	f.StringSlice("authn-oidc-valid-methods", conf.Authn.Oidc.ValidMethods, "list of valid JWT signing methods for OpenID Connect")
+	f.String("authn-oidc-tenant-claim", conf.Authn.Oidc.TenantClaim, "claim of the OpenID Connect token holding the tenants it may access")
+	f.String("authn-oidc-scope-claim", conf.Authn.Oidc.ScopeClaim, "claim of the OpenID Connect token holding its scopes")
+	f.String("authn-mtls-ca", conf.Authn.Mtls.CAPath, "path of the PEM bundle of the certificate authorities of the clients")
+	f.Duration("authn-mtls-reload-interval", conf.Authn.Mtls.ReloadInterval, "interval at which the client certificate authorities are reloaded from disk")
 	f.Bool("tracer-enabled", conf.Tracer.Enabled, "switch option for tracing")
 	f.String("tracer-exporter", conf.Tracer.Exporter, "can be; jaeger, signoz, zipkin or otlp. (integrated tracing tools)")
 	f.String("tracer-endpoint", conf.Tracer.Endpoint, "export uri for tracing data")
@@ -86,6 +110,10 @@ func NewConfigCommand() *cobra.Command {
 	f.Bool("service-watch-enabled", conf.Service.Watch.Enabled, "switch option for watch service")
 	f.Int64("service-schema-cache-number-of-counters", conf.Service.Schema.Cache.NumberOfCounters, "schema service cache number of counters")
 	f.String("service-schema-cache-max-cost", conf.Service.Schema.Cache.MaxCost, "schema service cache max cost")
+	f.Bool("service-schema-shadow-enabled", conf.Service.Schema.Shadow.Enabled, "switch option for evaluating checks against the shadow schema versions")
+	f.Int("service-schema-shadow-concurrency", conf.Service.Schema.Shadow.Concurrency, "maximum number of shadow evaluations in progress")
+	f.Duration("service-schema-shadow-refresh-interval", conf.Service.Schema.Shadow.RefreshInterval, "how long the shadow schema version of a tenant is cached")
+	f.Duration("service-schema-shadow-timeout", conf.Service.Schema.Shadow.Timeout, "timeout of a shadow evaluation")
 	f.Int("service-permission-bulk-limit", conf.Service.Permission.BulkLimit, "bulk operations limit")
 	f.Int("service-permission-concurrency-limit", conf.Service.Permission.ConcurrencyLimit, "concurrency limit")
 	f.Int64("service-permission-cache-number-of-counters", conf.Service.Permission.Cache.NumberOfCounters, "permission service cache number of counters")
@@ -94,6 +122,9 @@ func NewConfigCommand() *cobra.Command {
 	f.String("database-uri", conf.Database.URI, "uri of your data source to store relation tuples and schema")
 	f.String("database-writer-uri", conf.Database.Writer.URI, "writer uri of your data source to store relation tuples and schema")
 	f.String("database-reader-uri", conf.Database.Reader.URI, "reader uri of your data source to store relation tuples and schema")
+	f.StringSlice("database-reader-uris", conf.Database.Reader.URIs, "uris of additional read replicas, reads are routed among the healthy ones")
+	f.Duration("database-reader-replica-wait", conf.Database.Reader.ReplicaWait, "how long a read waits for a replica to replay the transaction of its snap token before it is sent to the writer")
+	f.Duration("database-reader-health-check-interval", conf.Database.Reader.HealthCheckInterval, "period between the checks of the health and lag of the read replicas")
 	f.Bool("database-auto-migrate", conf.Database.AutoMigrate, "auto migrate database tables")
 	f.Int("database-max-connections", conf.Database.MaxConnections, "maximum number of connections in the pool")
 	f.Int("database-max-open-connections", conf.Database.MaxOpenConnections, "deprecated: use database-max-connections instead. maximum number of parallel connections that can be made to the database at any time")
@@ -108,6 +139,8 @@ func NewConfigCommand() *cobra.Command {
 	f.Int("database-max-data-per-write", conf.Database.MaxDataPerWrite, "sets the maximum amount of data per write operation to the database")
 	f.Int("database-max-retries", conf.Database.MaxRetries, "defines the maximum number of retries for database operations in case of failure")
 	f.Int("database-watch-buffer-size", conf.Database.WatchBufferSize, "specifies the buffer size for database watch operations, impacting how many changes can be queued")
+	f.Duration("database-idempotency-key-retention", conf.Database.IdempotencyKeyRetention, "how long the idempotency key of a write is remembered")
+	f.Duration("database-snapshot-refresh-interval", conf.Database.SnapshotRefreshInterval, "how long the recent snapshot shared by the reads that minimize latency is used before it is refreshed")
 	f.Bool("database-garbage-collection-enabled", conf.Database.GarbageCollection.Enabled, "use database garbage collection for expired relationships and attributes")
 	f.Duration("database-garbage-collection-interval", conf.Database.GarbageCollection.Interval, "interval for database garbage collection")
 	f.Duration("database-garbage-collection-timeout", conf.Database.GarbageCollection.Timeout, "timeout for database garbage collection")
@@ -186,6 +219,29 @@ func conf() func(cmd *cobra.Command, args []string) error { // Return config han
 			[]string{"logger.headers", fmt.Sprintf("%v", cfg.Log.Headers), getKeyOrigin(cmd, "log-headers", "PERMIFY_LOG_HEADERS")},
 			[]string{"logger.protocol", cfg.Log.Protocol, getKeyOrigin(cmd, "log-protocol", "PERMIFY_LOG_PROTOCOL")},
 			[]string{"logger.service_name", cfg.Log.ServiceName, getKeyOrigin(cmd, "log-service-name", "PERMIFY_LOG_SERVICE_NAME")},
+			// AUDIT
+			[]string{"audit.enabled", fmt.Sprintf("%v", cfg.Audit.Enabled), getKeyOrigin(cmd, "audit-enabled", "PERMIFY_AUDIT_ENABLED")},
+			[]string{"audit.file.enabled", fmt.Sprintf("%v", cfg.Audit.File.Enabled), getKeyOrigin(cmd, "audit-file-enabled", "PERMIFY_AUDIT_FILE_ENABLED")},
+			[]string{"audit.file.path", cfg.Audit.File.Path, getKeyOrigin(cmd, "audit-file-path", "PERMIFY_AUDIT_FILE_PATH")},
+			[]string{"audit.file.max_size", fmt.Sprintf("%v", cfg.Audit.File.MaxSize), getKeyOrigin(cmd, "audit-file-max-size", "PERMIFY_AUDIT_FILE_MAX_SIZE")},
+			[]string{"audit.file.max_backups", fmt.Sprintf("%v", cfg.Audit.File.MaxBackups), getKeyOrigin(cmd, "audit-file-max-backups", "PERMIFY_AUDIT_FILE_MAX_BACKUPS")},
+			[]string{"audit.postgres.enabled", fmt.Sprintf("%v", cfg.Audit.Postgres.Enabled), getKeyOrigin(cmd, "audit-postgres-enabled", "PERMIFY_AUDIT_POSTGRES_ENABLED")},
+			[]string{"audit.otlp.enabled", fmt.Sprintf("%v", cfg.Audit.Otlp.Enabled), getKeyOrigin(cmd, "audit-otlp-enabled", "PERMIFY_AUDIT_OTLP_ENABLED")},
+			[]string{"audit.otlp.endpoint", HideSecret(cfg.Audit.Otlp.Endpoint), getKeyOrigin(cmd, "audit-otlp-endpoint", "PERMIFY_AUDIT_OTLP_ENDPOINT")},
+			[]string{"audit.otlp.insecure", fmt.Sprintf("%v", cfg.Audit.Otlp.Insecure), getKeyOrigin(cmd, "audit-otlp-insecure", "PERMIFY_AUDIT_OTLP_INSECURE")},
+			[]string{"audit.otlp.urlpath", cfg.Audit.Otlp.Urlpath, getKeyOrigin(cmd, "audit-otlp-urlpath", "PERMIFY_AUDIT_OTLP_URL_PATH")},
+			[]string{"audit.otlp.headers", fmt.Sprintf("%v", cfg.Audit.Otlp.Headers), getKeyOrigin(cmd, "audit-otlp-headers", "PERMIFY_AUDIT_OTLP_HEADERS")},
+			[]string{"audit.otlp.protocol", cfg.Audit.Otlp.Protocol, getKeyOrigin(cmd, "audit-otlp-protocol", "PERMIFY_AUDIT_OTLP_PROTOCOL")},
+			// DECISIONS
+			[]string{"decisions.enabled", fmt.Sprintf("%v", cfg.DecisionLog.Enabled), getKeyOrigin(cmd, "decisions-enabled", "PERMIFY_DECISIONS_ENABLED")},
+			[]string{"decisions.sample_rate", fmt.Sprintf("%v", cfg.DecisionLog.SampleRate), getKeyOrigin(cmd, "decisions-sample-rate", "PERMIFY_DECISIONS_SAMPLE_RATE")},
+			[]string{"decisions.rates", fmt.Sprintf("%v", cfg.DecisionLog.Rates), getKeyOrigin(cmd, "", "")},
+			[]string{"decisions.redact_context", fmt.Sprintf("%v", cfg.DecisionLog.RedactContext), getKeyOrigin(cmd, "decisions-redact-context", "PERMIFY_DECISIONS_REDACT_CONTEXT")},
+			[]string{"decisions.file.enabled", fmt.Sprintf("%v", cfg.DecisionLog.File.Enabled), getKeyOrigin(cmd, "decisions-file-enabled", "PERMIFY_DECISIONS_FILE_ENABLED")},
+			[]string{"decisions.file.path", cfg.DecisionLog.File.Path, getKeyOrigin(cmd, "decisions-file-path", "PERMIFY_DECISIONS_FILE_PATH")},
+			[]string{"decisions.file.max_size", fmt.Sprintf("%v", cfg.DecisionLog.File.MaxSize), getKeyOrigin(cmd, "decisions-file-max-size", "PERMIFY_DECISIONS_FILE_MAX_SIZE")},
+			[]string{"decisions.file.max_backups", fmt.Sprintf("%v", cfg.DecisionLog.File.MaxBackups), getKeyOrigin(cmd, "decisions-file-max-backups", "PERMIFY_DECISIONS_FILE_MAX_BACKUPS")},
+			[]string{"decisions.exporter.enabled", fmt.Sprintf("%v", cfg.DecisionLog.Exporter.Enabled), getKeyOrigin(cmd, "decisions-exporter-enabled", "PERMIFY_DECISIONS_EXPORTER_ENABLED")},
 			// AUTHN
 			[]string{"authn.enabled", fmt.Sprintf("%v", cfg.Authn.Enabled), getKeyOrigin(cmd, "authn-enabled", "PERMIFY_AUTHN_ENABLED")},
 			[]string{"authn.method", cfg.Authn.Method, getKeyOrigin(cmd, "authn-method", "PERMIFY_AUTHN_METHOD")},
@@ -197,6 +253,10 @@ func conf() func(cmd *cobra.Command, args []string) error { // Return config han
 			[]string{"authn.oidc.backoff_max_retries", fmt.Sprintf("%v", cfg.Authn.Oidc.BackoffMaxRetries), getKeyOrigin(cmd, "authn-oidc-backoff-max-retries", "PERMIFY_AUTHN_OIDC_BACKOFF_RETRIES")},
 			[]string{"authn.oidc.backoff_frequency", fmt.Sprintf("%v", cfg.Authn.Oidc.BackoffFrequency), getKeyOrigin(cmd, "authn-oidc-backoff-frequency", "PERMIFY_AUTHN_OIDC_BACKOFF_FREQUENCY")},
 			[]string{"authn.oidc.valid_methods", fmt.Sprintf("%v", cfg.Authn.Oidc.ValidMethods), getKeyOrigin(cmd, "authn-oidc-valid-methods", "PERMIFY_AUTHN_OIDC_VALID_METHODS")},
+			[]string{"authn.oidc.tenant_claim", cfg.Authn.Oidc.TenantClaim, getKeyOrigin(cmd, "authn-oidc-tenant-claim", "PERMIFY_AUTHN_OIDC_TENANT_CLAIM")},
+			[]string{"authn.oidc.scope_claim", cfg.Authn.Oidc.ScopeClaim, getKeyOrigin(cmd, "authn-oidc-scope-claim", "PERMIFY_AUTHN_OIDC_SCOPE_CLAIM")},
+			[]string{"authn.mtls.ca", cfg.Authn.Mtls.CAPath, getKeyOrigin(cmd, "authn-mtls-ca", "PERMIFY_AUTHN_MTLS_CA")},
+			[]string{"authn.mtls.reload_interval", fmt.Sprintf("%v", cfg.Authn.Mtls.ReloadInterval), getKeyOrigin(cmd, "authn-mtls-reload-interval", "PERMIFY_AUTHN_MTLS_RELOAD_INTERVAL")},
 			// TRACER
 			[]string{"tracer.enabled", fmt.Sprintf("%v", cfg.Tracer.Enabled), getKeyOrigin(cmd, "tracer-enabled", "PERMIFY_TRACER_ENABLED")},
 			[]string{"tracer.exporter", cfg.Tracer.Exporter, getKeyOrigin(cmd, "tracer-exporter", "PERMIFY_TRACER_EXPORTER")},
@@ -220,6 +280,10 @@ func conf() func(cmd *cobra.Command, args []string) error { // Return config han
 			[]string{"service.circuit_breaker", fmt.Sprintf("%v", cfg.Service.CircuitBreaker), getKeyOrigin(cmd, "service-circuit-breaker", "PERMIFY_SERVICE_CIRCUIT_BREAKER")},
 			[]string{"service.schema.cache.number_of_counters", fmt.Sprintf("%v", cfg.Service.Schema.Cache.NumberOfCounters), getKeyOrigin(cmd, "service-schema-cache-number-of-counters", "PERMIFY_SERVICE_WATCH_ENABLED")},
 			[]string{"service.schema.cache.max_cost", cfg.Service.Schema.Cache.MaxCost, getKeyOrigin(cmd, "service-schema-cache-max-cost", "PERMIFY_SERVICE_SCHEMA_CACHE_MAX_COST")},
+			[]string{"service.schema.shadow.enabled", fmt.Sprintf("%v", cfg.Service.Schema.Shadow.Enabled), getKeyOrigin(cmd, "service-schema-shadow-enabled", "PERMIFY_SERVICE_SCHEMA_SHADOW_ENABLED")},
+			[]string{"service.schema.shadow.concurrency", fmt.Sprintf("%v", cfg.Service.Schema.Shadow.Concurrency), getKeyOrigin(cmd, "service-schema-shadow-concurrency", "PERMIFY_SERVICE_SCHEMA_SHADOW_CONCURRENCY")},
+			[]string{"service.schema.shadow.refresh_interval", fmt.Sprintf("%v", cfg.Service.Schema.Shadow.RefreshInterval), getKeyOrigin(cmd, "service-schema-shadow-refresh-interval", "PERMIFY_SERVICE_SCHEMA_SHADOW_REFRESH_INTERVAL")},
+			[]string{"service.schema.shadow.timeout", fmt.Sprintf("%v", cfg.Service.Schema.Shadow.Timeout), getKeyOrigin(cmd, "service-schema-shadow-timeout", "PERMIFY_SERVICE_SCHEMA_SHADOW_TIMEOUT")},
 			[]string{"service.permission.bulk_limit", fmt.Sprintf("%v", cfg.Service.Permission.BulkLimit), getKeyOrigin(cmd, "service-permission-bulk-limit", "PERMIFY_SERVICE_PERMISSION_BULK_LIMIT")},
 			[]string{"service.permission.concurrency_limit", fmt.Sprintf("%v", cfg.Service.Permission.ConcurrencyLimit), getKeyOrigin(cmd, "service-permission-concurrency-limit", "PERMIFY_SERVICE_PERMISSION_CONCURRENCY_LIMIT")},
 			[]string{"service.permission.cache.number_of_counters", fmt.Sprintf("%v", cfg.Service.Permission.Cache.NumberOfCounters), getKeyOrigin(cmd, "service-permission-cache-number-of-counters", "PERMIFY_SERVICE_PERMISSION_CACHE_NUMBER_OF_COUNTERS")},
@@ -229,6 +293,9 @@ func conf() func(cmd *cobra.Command, args []string) error { // Return config han
 			[]string{"database.uri", HideSecret(cfg.Database.URI), getKeyOrigin(cmd, "database-uri", "PERMIFY_DATABASE_URI")},
 			[]string{"database.writer.uri", HideSecret(cfg.Database.Writer.URI), getKeyOrigin(cmd, "database-writer-uri", "PERMIFY_DATABASE_WRITER_URI")},
 			[]string{"database.reader.uri", HideSecret(cfg.Database.Reader.URI), getKeyOrigin(cmd, "database-reader-uri", "PERMIFY_DATABASE_READER_URI")},
+			[]string{"database.reader.uris", fmt.Sprintf("%v", HideSecrets(cfg.Database.Reader.URIs...)), getKeyOrigin(cmd, "database-reader-uris", "PERMIFY_DATABASE_READER_URIS")},
+			[]string{"database.reader.replica_wait", fmt.Sprintf("%v", cfg.Database.Reader.ReplicaWait), getKeyOrigin(cmd, "database-reader-replica-wait", "PERMIFY_DATABASE_READER_REPLICA_WAIT")},
+			[]string{"database.reader.health_check_interval", fmt.Sprintf("%v", cfg.Database.Reader.HealthCheckInterval), getKeyOrigin(cmd, "database-reader-health-check-interval", "PERMIFY_DATABASE_READER_HEALTH_CHECK_INTERVAL")},
 			[]string{"database.auto_migrate", fmt.Sprintf("%v", cfg.Database.AutoMigrate), getKeyOrigin(cmd, "database-auto-migrate", "PERMIFY_DATABASE_AUTO_MIGRATE")},
 			[]string{"database.max_connections", fmt.Sprintf("%v", cfg.Database.MaxConnections), getKeyOrigin(cmd, "database-max-connections", "PERMIFY_DATABASE_MAX_CONNECTIONS")},
 			[]string{"database.max_open_connections", fmt.Sprintf("%v", cfg.Database.MaxOpenConnections), getKeyOrigin(cmd, "database-max-open-connections", "PERMIFY_DATABASE_MAX_OPEN_CONNECTIONS")},
@@ -243,6 +310,8 @@ func conf() func(cmd *cobra.Command, args []string) error { // Return config han
 			[]string{"database.max_data_per_write", fmt.Sprintf("%v", cfg.Database.MaxDataPerWrite), getKeyOrigin(cmd, "database-max-data-per-write", "PERMIFY_DATABASE_MAX_DATA_PER_WRITE")},
 			[]string{"database.max_retries", fmt.Sprintf("%v", cfg.Database.MaxRetries), getKeyOrigin(cmd, "database-max-retries", "PERMIFY_DATABASE_MAX_RETRIES")},
 			[]string{"database.watch_buffer_size", fmt.Sprintf("%v", cfg.Database.WatchBufferSize), getKeyOrigin(cmd, "database-watch-buffer-size", "PERMIFY_DATABASE_WATCH_BUFFER_SIZE")},
+			[]string{"database.idempotency_key_retention", fmt.Sprintf("%v", cfg.Database.IdempotencyKeyRetention), getKeyOrigin(cmd, "database-idempotency-key-retention", "PERMIFY_DATABASE_IDEMPOTENCY_KEY_RETENTION")},
+			[]string{"database.snapshot_refresh_interval", fmt.Sprintf("%v", cfg.Database.SnapshotRefreshInterval), getKeyOrigin(cmd, "database-snapshot-refresh-interval", "PERMIFY_DATABASE_SNAPSHOT_REFRESH_INTERVAL")},
 			[]string{"database.garbage_collection.enabled", fmt.Sprintf("%v", cfg.Database.GarbageCollection.Enabled), getKeyOrigin(cmd, "database-garbage-collection-enabled", "PERMIFY_DATABASE_GARBAGE_COLLECTION_ENABLED")},
 			[]string{"database.garbage_collection.interval", fmt.Sprintf("%v", cfg.Database.GarbageCollection.Interval), getKeyOrigin(cmd, "database-garbage-collection-interval", "PERMIFY_DATABASE_GARBAGE_COLLECTION_INTERVAL")},
 			[]string{"database.garbage_collection.timeout", fmt.Sprintf("%v", cfg.Database.GarbageCollection.Timeout), getKeyOrigin(cmd, "database-garbage-collection-timeout", "PERMIFY_DATABASE_GARBAGE_COLLECTION_TIMEOUT")},
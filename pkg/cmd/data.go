@@ -0,0 +1,313 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/Permify/permify/internal/analyzer"
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/internal/verifier"
+	"github.com/Permify/permify/pkg/attribute"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+const (
+	dataConfig           = "config"
+	dataDatabaseEngine   = "database-engine"
+	dataDatabaseURI      = "database-uri"
+	dataTenant           = "tenant"
+	dataSchemaVersion    = "schema-version"
+	dataPageSize         = "page-size"
+	dataAction           = "action"
+	dataQuarantineTenant = "quarantine-tenant"
+	dataActor            = "actor"
+	dataReason           = "reason"
+	dataFormat           = "format"
+	dataTop              = "top"
+
+	// Actions of the verify command on the violations
+	dataActionReport     = "report"
+	dataActionDelete     = "delete"
+	dataActionQuarantine = "quarantine"
+
+	// Output formats of the analyze command, besides text
+	dataFormatJSON = "json"
+)
+
+// NewDataCommand - Creates new data command
+func NewDataCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "data",
+		Short: "maintain the stored relationships and attributes",
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(NewDataVerifyCommand())
+	cmd.AddCommand(NewDataAnalyzeCommand())
+
+	return cmd
+}
+
+// NewDataVerifyCommand - Creates new data verify command
+func NewDataVerifyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "validate the stored relationships and attributes against the schema",
+		Long: `Scan the relationships and attributes of a tenant in batches at a single snapshot and validate them against a
+schema version, such as relationships of relations or subject types the schema no longer has, relationships
+assigning a second subject through a single relation, or attributes whose values do not match their type.
+
+Violations are printed as they are found. With the delete action they are deleted once their batch is
+validated, with the quarantine action they are moved to the quarantine tenant in the same transaction.`,
+		RunE: verifyData(),
+		Args: cobra.NoArgs,
+	}
+
+	f := dataFlags(cmd)
+	f.String(dataTenant, "t1", "tenant to verify")
+	f.String(dataSchemaVersion, "", "schema version the data is validated against, the head version of the tenant if empty")
+	f.Uint32(dataPageSize, verifier.DefaultPageSize, "number of relationships or attributes validated in a batch, at most 1000")
+	f.String(dataAction, dataActionReport, "what is done with the violations, one of report, delete or quarantine")
+	f.String(dataQuarantineTenant, "", "tenant the violations are moved to by the quarantine action")
+	f.String(dataActor, "", "actor recorded with the transactions removing the violations")
+	f.String(dataReason, "", "reason recorded with the transactions removing the violations")
+	f.String(dataFormat, historyFormatText, "output format, one of text or jsonl")
+
+	return cmd
+}
+
+// NewDataAnalyzeCommand - Creates new data analyze command
+func NewDataAnalyzeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "analyze",
+		Short: "find cycles, deep chains and hotspots in the stored relationships",
+		Long: `Scan the relationships of a tenant at a single snapshot. For every recursive relation of the schema, a relation
+through which a permission refers to itself such as parent in view = parent.view, report the cycles its
+relationships form and the longest chain they build, which checks follow up to their depth limit. List the
+entities with the most relationships of a relation (fan-out) and the subjects of the most relationships (fan-in).
+
+The relationships of the recursive relations and the counts are held in memory during the analysis.`,
+		RunE: analyzeData(),
+		Args: cobra.NoArgs,
+	}
+
+	f := dataFlags(cmd)
+	f.String(dataTenant, "t1", "tenant to analyze")
+	f.String(dataSchemaVersion, "", "schema version the recursive relations are read from, the head version of the tenant if empty")
+	f.Uint32(dataPageSize, analyzer.DefaultPageSize, "number of relationships read in a batch, at most 1000")
+	f.Uint32(dataTop, analyzer.DefaultTop, "number of entities and subjects listed by fan-out and by fan-in, at most 1000")
+	f.String(dataFormat, historyFormatText, "output format, one of text or json")
+
+	return cmd
+}
+
+// dataFlags - Adds the database flags of the data commands
+func dataFlags(cmd *cobra.Command) *pflag.FlagSet {
+	f := cmd.Flags()
+	f.StringP(dataConfig, "c", "", "config file whose database section is used")
+	f.String(dataDatabaseEngine, "postgres", "database engine, overrides the config file")
+	f.String(dataDatabaseURI, "", "database URI, overrides the config file")
+	return f
+}
+
+// dataDatabaseConfig - Reads the database config of the data commands from the config file and the flags
+func dataDatabaseConfig(cmd *cobra.Command) (config.Database, error) {
+	conf := config.DefaultConfig().Database
+	conf.Engine, _ = cmd.Flags().GetString(dataDatabaseEngine)
+	if path, _ := cmd.Flags().GetString(dataConfig); path != "" {
+		cfg, err := config.NewConfigWithFile(path)
+		if err != nil {
+			return conf, err
+		}
+		conf = cfg.Database
+	}
+	if cmd.Flags().Changed(dataDatabaseEngine) {
+		conf.Engine, _ = cmd.Flags().GetString(dataDatabaseEngine)
+	}
+	if cmd.Flags().Changed(dataDatabaseURI) {
+		conf.URI, _ = cmd.Flags().GetString(dataDatabaseURI)
+	}
+	if conf.URI == "" && conf.Writer.URI == "" {
+		return conf, fmt.Errorf("a database URI is required, set it with --%s or a config file", dataDatabaseURI)
+	}
+	return conf, nil
+}
+
+// verifyData - permify data verify command
+func verifyData() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		conf, err := dataDatabaseConfig(cmd)
+		if err != nil {
+			return err
+		}
+
+		format, _ := cmd.Flags().GetString(dataFormat)
+		if format != historyFormatText && format != historyFormatJSONL {
+			return fmt.Errorf("unknown verify format %q, expected %s or %s", format, historyFormatText, historyFormatJSONL)
+		}
+
+		request := &base.DataVerifyRequest{Metadata: &base.DataVerifyRequestMetadata{}}
+		request.TenantId, _ = cmd.Flags().GetString(dataTenant)
+		request.Metadata.SchemaVersion, _ = cmd.Flags().GetString(dataSchemaVersion)
+		request.PageSize, _ = cmd.Flags().GetUint32(dataPageSize)
+		request.QuarantineTenantId, _ = cmd.Flags().GetString(dataQuarantineTenant)
+
+		switch action, _ := cmd.Flags().GetString(dataAction); action {
+		case dataActionReport:
+			request.Action = base.DataVerifyAction_DATA_VERIFY_ACTION_REPORT
+		case dataActionDelete:
+			request.Action = base.DataVerifyAction_DATA_VERIFY_ACTION_DELETE
+		case dataActionQuarantine:
+			request.Action = base.DataVerifyAction_DATA_VERIFY_ACTION_QUARANTINE
+		default:
+			return fmt.Errorf("unknown verify action %q, expected %s, %s or %s", action, dataActionReport, dataActionDelete, dataActionQuarantine)
+		}
+
+		actor, _ := cmd.Flags().GetString(dataActor)
+		reason, _ := cmd.Flags().GetString(dataReason)
+		if actor != "" || reason != "" {
+			request.Metadata.Metadata = &base.TransactionMetadata{Actor: actor, Reason: reason}
+		}
+
+		if err = request.Validate(); err != nil {
+			return err
+		}
+
+		db, err := factories.DatabaseFactory(conf)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		ctx := context.Background()
+		ver, err := verifier.NewVerifier(ctx, factories.SchemaReaderFactory(db), factories.DataReaderFactory(db), factories.DataWriterFactory(db), request)
+		if err != nil {
+			return err
+		}
+
+		summary, err := ver.Run(ctx, func(violation *base.DataViolation) error {
+			return writeViolation(cmd.OutOrStdout(), format, violation)
+		})
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(cmd.ErrOrStderr(), "verified %d relationships and %d attributes against schema version %s, %d violations, %d removed, at snap token %s\n",
+			summary.GetRelationships(), summary.GetAttributes(), summary.GetSchemaVersion(), summary.GetViolations(), summary.GetRemoved(), summary.GetSnapToken())
+
+		return nil
+	}
+}
+
+// writeViolation - Writes a violation as a line of text or JSON
+func writeViolation(w io.Writer, format string, violation *base.DataViolation) error {
+	if format == historyFormatJSONL {
+		line, err := protojson.Marshal(violation)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", line)
+		return err
+	}
+
+	var item string
+	if violation.GetTuple() != nil {
+		item = tuple.ToString(violation.GetTuple())
+	} else {
+		item = attribute.ToString(violation.GetAttribute())
+	}
+	_, err := fmt.Fprintf(w, "%s\t%s\n", item, violation.GetReason())
+	return err
+}
+
+// analyzeData - permify data analyze command
+func analyzeData() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		conf, err := dataDatabaseConfig(cmd)
+		if err != nil {
+			return err
+		}
+
+		format, _ := cmd.Flags().GetString(dataFormat)
+		if format != historyFormatText && format != dataFormatJSON {
+			return fmt.Errorf("unknown analyze format %q, expected %s or %s", format, historyFormatText, dataFormatJSON)
+		}
+
+		request := &base.DataAnalyzeRequest{Metadata: &base.DataAnalyzeRequestMetadata{}}
+		request.TenantId, _ = cmd.Flags().GetString(dataTenant)
+		request.Metadata.SchemaVersion, _ = cmd.Flags().GetString(dataSchemaVersion)
+		request.PageSize, _ = cmd.Flags().GetUint32(dataPageSize)
+		request.Top, _ = cmd.Flags().GetUint32(dataTop)
+		if err = request.Validate(); err != nil {
+			return err
+		}
+
+		db, err := factories.DatabaseFactory(conf)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		ctx := context.Background()
+		an, err := analyzer.NewAnalyzer(ctx, factories.SchemaReaderFactory(db), factories.DataReaderFactory(db), request)
+		if err != nil {
+			return err
+		}
+
+		analysis, err := an.Run(ctx)
+		if err != nil {
+			return err
+		}
+
+		if format == dataFormatJSON {
+			out, err := protojson.MarshalOptions{Multiline: true}.Marshal(analysis)
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "%s\n", out)
+			return err
+		}
+		return writeAnalysis(cmd.OutOrStdout(), analysis)
+	}
+}
+
+// writeAnalysis - Writes an analysis as text
+func writeAnalysis(w io.Writer, analysis *base.DataAnalyzeResponse) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "analyzed %d relationships against schema version %s at snap token %s\n", analysis.GetRelationships(), analysis.GetSchemaVersion(), analysis.GetSnapToken())
+
+	for _, r := range analysis.GetRecursiveRelations() {
+		fmt.Fprintf(&b, "\n%s#%s (%s): %d relationships, max depth %d", r.GetEntityType(), r.GetRelation(), strings.Join(r.GetPermissions(), ", "), r.GetTuples(), r.GetMaxDepth())
+		if r.GetDeepestEntityId() != "" {
+			fmt.Fprintf(&b, " from %s:%s", r.GetEntityType(), r.GetDeepestEntityId())
+		}
+		fmt.Fprintf(&b, ", %d cycles\n", r.GetCycleCount())
+		for _, c := range r.GetCycles() {
+			fmt.Fprintf(&b, "  cycle %s -> %s\n", strings.Join(c.GetEntityIds(), " -> "), c.GetEntityIds()[0])
+		}
+	}
+
+	for _, section := range []struct {
+		title    string
+		hotspots []*base.DataHotspot
+	}{
+		{"fan-out", analysis.GetFanOut()},
+		{"fan-in", analysis.GetFanIn()},
+	} {
+		fmt.Fprintf(&b, "\n%s\n", section.title)
+		for _, h := range section.hotspots {
+			fmt.Fprintf(&b, "  %d\t%s\n", h.GetCount(), tuple.SubjectToString(&base.Subject{Type: h.GetEntity().GetType(), Id: h.GetEntity().GetId(), Relation: h.GetRelation()}))
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
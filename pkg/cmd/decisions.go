@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/decision"
+	"github.com/Permify/permify/internal/engines"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/internal/invoke"
+)
+
+const (
+	decisionsConfig         = "config"
+	decisionsDatabaseEngine = "database-engine"
+	decisionsDatabaseURI    = "database-uri"
+	decisionsSchemaVersion  = "schema-version"
+	decisionsFailOnChange   = "fail-on-change"
+)
+
+// NewDecisionsCommand - Creates new decisions command
+func NewDecisionsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decisions",
+		Short: "work with the decision log of permission checks and lookups",
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(NewDecisionsReplayCommand())
+
+	return cmd
+}
+
+// NewDecisionsReplayCommand - Creates new decisions replay command
+func NewDecisionsReplayCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay <file>...",
+		Short: "re-run logged decisions against a schema version and report the changed answers",
+		Long: `Re-run the decisions of decision log files against a schema version and report the answers that changed.
+
+Each decision is evaluated on the data of the snap token it was made with, against the given schema version,
+or the head schema version of its tenant if none is given. Decisions whose contextual data was redacted are
+evaluated with the redacted values and are marked as such.`,
+		RunE: replayDecisions(),
+		Args: cobra.MinimumNArgs(1),
+	}
+
+	f := cmd.Flags()
+	f.StringP(decisionsConfig, "c", "", "config file whose database section is used")
+	f.String(decisionsDatabaseEngine, "postgres", "database engine, overrides the config file")
+	f.String(decisionsDatabaseURI, "", "database URI, overrides the config file")
+	f.String(decisionsSchemaVersion, "", "schema version the decisions are evaluated against, the head version of each tenant if empty")
+	f.Bool(decisionsFailOnChange, false, "exit with an error if any answer changed")
+
+	return cmd
+}
+
+// replayDecisions - permify decisions replay command
+func replayDecisions() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		conf := config.DefaultConfig().Database
+		conf.Engine, _ = cmd.Flags().GetString(decisionsDatabaseEngine)
+		if path, _ := cmd.Flags().GetString(decisionsConfig); path != "" {
+			cfg, err := config.NewConfigWithFile(path)
+			if err != nil {
+				return err
+			}
+			conf = cfg.Database
+		}
+		if cmd.Flags().Changed(decisionsDatabaseEngine) {
+			conf.Engine, _ = cmd.Flags().GetString(decisionsDatabaseEngine)
+		}
+		if cmd.Flags().Changed(decisionsDatabaseURI) {
+			conf.URI, _ = cmd.Flags().GetString(decisionsDatabaseURI)
+		}
+		if conf.URI == "" && conf.Writer.URI == "" {
+			return fmt.Errorf("a database URI is required, set it with --%s or a config file", decisionsDatabaseURI)
+		}
+
+		db, err := factories.DatabaseFactory(conf)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		schemaReader := factories.SchemaReaderFactory(db)
+		dataReader := factories.DataReaderFactory(db)
+
+		checkEngine := engines.NewCheckEngine(schemaReader, dataReader)
+		invoker := invoke.NewDirectInvoker(
+			schemaReader,
+			dataReader,
+			checkEngine,
+			engines.NewExpandEngine(schemaReader, dataReader),
+			engines.NewLookupEngine(checkEngine, schemaReader, dataReader),
+			engines.NewSubjectPermission(checkEngine, schemaReader),
+		)
+		checkEngine.SetInvoker(invoker)
+
+		schemaVersion, _ := cmd.Flags().GetString(decisionsSchemaVersion)
+
+		var total, changed, failed, redacted int
+		for _, path := range args {
+			err = readDecisions(path, func(record *decision.Record) {
+				total++
+				if record.Redacted {
+					redacted++
+				}
+
+				result, err := decision.Replay(context.Background(), invoker, record, schemaVersion)
+				switch {
+				case err != nil && record.Error != "":
+					return
+				case err != nil:
+					changed++
+					printDecision(cmd, record, "changed", string(record.Result), "error: "+err.Error())
+					return
+				case record.Error != "":
+					changed++
+					printDecision(cmd, record, "changed", "error: "+record.Error, string(result))
+					return
+				}
+
+				equal, err := decision.SameResult(record.Method, record.Result, result)
+				if err != nil {
+					failed++
+					printDecision(cmd, record, "failed", string(record.Result), "error: "+err.Error())
+					return
+				}
+				if !equal {
+					changed++
+					printDecision(cmd, record, "changed", string(record.Result), string(result))
+				}
+			})
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "replayed %d decision(s): %d changed, %d failed, %d redacted\n", total, changed, failed, redacted)
+
+		if failOnChange, _ := cmd.Flags().GetBool(decisionsFailOnChange); failOnChange && changed > 0 {
+			return fmt.Errorf("%d decision(s) changed", changed)
+		}
+
+		return nil
+	}
+}
+
+// readDecisions - Calls fn with every record of a decision log file
+func readDecisions(path string, fn func(record *decision.Record)) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	// requests with large contexts do not fit the default buffer
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		record := &decision.Record{}
+		if err = json.Unmarshal(scanner.Bytes(), record); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		fn(record)
+	}
+	return scanner.Err()
+}
+
+// printDecision - Prints a replayed decision with its recorded and replayed answers
+func printDecision(cmd *cobra.Command, record *decision.Record, outcome, recorded, replayed string) {
+	name := record.Method + " " + record.TenantID
+	if record.Redacted {
+		name += " (redacted)"
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%s %s: %s\n  recorded: %s\n  replayed: %s\n", record.ID, name, outcome, recorded, replayed)
+}
@@ -0,0 +1,16 @@
+package flags
+
+import (
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// RegisterFormatFlags registers fmt flags.
+func RegisterFormatFlags(flags *pflag.FlagSet) {
+	if err := viper.BindPFlag("check", flags.Lookup("check")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("diff", flags.Lookup("diff")); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,16 @@
+package flags
+
+import (
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// RegisterLintFlags registers lint flags.
+func RegisterLintFlags(flags *pflag.FlagSet) {
+	if err := viper.BindPFlag("config.file", flags.Lookup("config")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("rule", flags.Lookup("rule")); err != nil {
+		panic(err)
+	}
+}
@@ -215,6 +215,148 @@ func RegisterServeFlags(flags *pflag.FlagSet) {
 		panic(err)
 	}
 
+	// AUDIT - Audit log configuration flags
+	if err = viper.BindPFlag("audit.enabled", flags.Lookup("audit-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("audit.enabled", "PERMIFY_AUDIT_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("audit.file.enabled", flags.Lookup("audit-file-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("audit.file.enabled", "PERMIFY_AUDIT_FILE_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("audit.file.path", flags.Lookup("audit-file-path")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("audit.file.path", "PERMIFY_AUDIT_FILE_PATH"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("audit.file.max_size", flags.Lookup("audit-file-max-size")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("audit.file.max_size", "PERMIFY_AUDIT_FILE_MAX_SIZE"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("audit.file.max_backups", flags.Lookup("audit-file-max-backups")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("audit.file.max_backups", "PERMIFY_AUDIT_FILE_MAX_BACKUPS"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("audit.postgres.enabled", flags.Lookup("audit-postgres-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("audit.postgres.enabled", "PERMIFY_AUDIT_POSTGRES_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("audit.otlp.enabled", flags.Lookup("audit-otlp-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("audit.otlp.enabled", "PERMIFY_AUDIT_OTLP_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("audit.otlp.endpoint", flags.Lookup("audit-otlp-endpoint")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("audit.otlp.endpoint", "PERMIFY_AUDIT_OTLP_ENDPOINT"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("audit.otlp.insecure", flags.Lookup("audit-otlp-insecure")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("audit.otlp.insecure", "PERMIFY_AUDIT_OTLP_INSECURE"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("audit.otlp.urlpath", flags.Lookup("audit-otlp-urlpath")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("audit.otlp.urlpath", "PERMIFY_AUDIT_OTLP_URL_PATH"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("audit.otlp.headers", flags.Lookup("audit-otlp-headers")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("audit.otlp.headers", "PERMIFY_AUDIT_OTLP_HEADERS"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("audit.otlp.protocol", flags.Lookup("audit-otlp-protocol")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("audit.otlp.protocol", "PERMIFY_AUDIT_OTLP_PROTOCOL"); err != nil {
+		panic(err)
+	}
+
+	// DECISIONS - Decision log configuration flags
+	if err = viper.BindPFlag("decisions.enabled", flags.Lookup("decisions-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("decisions.enabled", "PERMIFY_DECISIONS_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("decisions.sample_rate", flags.Lookup("decisions-sample-rate")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("decisions.sample_rate", "PERMIFY_DECISIONS_SAMPLE_RATE"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("decisions.redact_context", flags.Lookup("decisions-redact-context")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("decisions.redact_context", "PERMIFY_DECISIONS_REDACT_CONTEXT"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("decisions.file.enabled", flags.Lookup("decisions-file-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("decisions.file.enabled", "PERMIFY_DECISIONS_FILE_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("decisions.file.path", flags.Lookup("decisions-file-path")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("decisions.file.path", "PERMIFY_DECISIONS_FILE_PATH"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("decisions.file.max_size", flags.Lookup("decisions-file-max-size")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("decisions.file.max_size", "PERMIFY_DECISIONS_FILE_MAX_SIZE"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("decisions.file.max_backups", flags.Lookup("decisions-file-max-backups")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("decisions.file.max_backups", "PERMIFY_DECISIONS_FILE_MAX_BACKUPS"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("decisions.exporter.enabled", flags.Lookup("decisions-exporter-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("decisions.exporter.enabled", "PERMIFY_DECISIONS_EXPORTER_ENABLED"); err != nil {
+		panic(err)
+	}
+
 	// AUTHN - Authentication configuration flags
 	if err = viper.BindPFlag("authn.enabled", flags.Lookup("authn-enabled")); err != nil {
 		panic(err)
@@ -286,6 +428,34 @@ func RegisterServeFlags(flags *pflag.FlagSet) {
 		panic(err)
 	}
 
+	if err = viper.BindPFlag("authn.oidc.tenant_claim", flags.Lookup("authn-oidc-tenant-claim")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("authn.oidc.tenant_claim", "PERMIFY_AUTHN_OIDC_TENANT_CLAIM"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("authn.oidc.scope_claim", flags.Lookup("authn-oidc-scope-claim")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("authn.oidc.scope_claim", "PERMIFY_AUTHN_OIDC_SCOPE_CLAIM"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("authn.mtls.ca", flags.Lookup("authn-mtls-ca")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("authn.mtls.ca", "PERMIFY_AUTHN_MTLS_CA"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("authn.mtls.reload_interval", flags.Lookup("authn-mtls-reload-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("authn.mtls.reload_interval", "PERMIFY_AUTHN_MTLS_RELOAD_INTERVAL"); err != nil {
+		panic(err)
+	}
+
 	// TRACER
 	if err = viper.BindPFlag("tracer.enabled", flags.Lookup("tracer-enabled")); err != nil {
 		panic(err)
@@ -435,6 +605,34 @@ func RegisterServeFlags(flags *pflag.FlagSet) {
 	if err = viper.BindEnv("service.schema.cache.max_cost", "PERMIFY_SERVICE_SCHEMA_CACHE_MAX_COST"); err != nil {
 		panic(err)
 	}
+
+	if err = viper.BindPFlag("service.schema.shadow.enabled", flags.Lookup("service-schema-shadow-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.schema.shadow.enabled", "PERMIFY_SERVICE_SCHEMA_SHADOW_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.schema.shadow.concurrency", flags.Lookup("service-schema-shadow-concurrency")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.schema.shadow.concurrency", "PERMIFY_SERVICE_SCHEMA_SHADOW_CONCURRENCY"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.schema.shadow.refresh_interval", flags.Lookup("service-schema-shadow-refresh-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.schema.shadow.refresh_interval", "PERMIFY_SERVICE_SCHEMA_SHADOW_REFRESH_INTERVAL"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("service.schema.shadow.timeout", flags.Lookup("service-schema-shadow-timeout")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.schema.shadow.timeout", "PERMIFY_SERVICE_SCHEMA_SHADOW_TIMEOUT"); err != nil {
+		panic(err)
+	}
 	// Permission service configuration
 	if err = viper.BindPFlag("service.permission.bulk_limit", flags.Lookup("service-permission-bulk-limit")); err != nil { // Bulk limit flag
 		panic(err) // Fatal error
@@ -493,6 +691,27 @@ func RegisterServeFlags(flags *pflag.FlagSet) {
 		panic(err)
 	}
 
+	if err = viper.BindPFlag("database.reader.uris", flags.Lookup("database-reader-uris")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.reader.uris", "PERMIFY_DATABASE_READER_URIS"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("database.reader.replica_wait", flags.Lookup("database-reader-replica-wait")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.reader.replica_wait", "PERMIFY_DATABASE_READER_REPLICA_WAIT"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("database.reader.health_check_interval", flags.Lookup("database-reader-health-check-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.reader.health_check_interval", "PERMIFY_DATABASE_READER_HEALTH_CHECK_INTERVAL"); err != nil {
+		panic(err)
+	}
+
 	if err = viper.BindPFlag("database.auto_migrate", flags.Lookup("database-auto-migrate")); err != nil {
 		panic(err)
 	}
@@ -591,6 +810,20 @@ func RegisterServeFlags(flags *pflag.FlagSet) {
 		panic(err)
 	}
 
+	if err = viper.BindPFlag("database.idempotency_key_retention", flags.Lookup("database-idempotency-key-retention")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.idempotency_key_retention", "PERMIFY_DATABASE_IDEMPOTENCY_KEY_RETENTION"); err != nil {
+		panic(err)
+	}
+
+	if err = viper.BindPFlag("database.snapshot_refresh_interval", flags.Lookup("database-snapshot-refresh-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.snapshot_refresh_interval", "PERMIFY_DATABASE_SNAPSHOT_REFRESH_INTERVAL"); err != nil {
+		panic(err)
+	}
+
 	if err = viper.BindPFlag("database.garbage_collection.enabled", flags.Lookup("database-garbage-collection-enabled")); err != nil {
 		panic(err)
 	}
@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.yaml.in/yaml/v3"
+
+	"github.com/Permify/permify/pkg/cmd/flags"
+	"github.com/Permify/permify/pkg/dsl/formatter"
+)
+
+// NewFormatCommand - creates a new fmt command
+func NewFormatCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "fmt <file>...",
+		Short: "rewrites schema files, or the schema of validation files, in the canonical style",
+		RunE:  runFormat(),
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	f := command.Flags()
+	f.Bool("check", false, "do not rewrite the files, fail if any of them is not formatted")
+	f.Bool("diff", false, "do not rewrite the files, print the changes as a unified diff")
+
+	command.PreRun = func(cmd *cobra.Command, args []string) {
+		flags.RegisterFormatFlags(f)
+	}
+
+	return command
+}
+
+// runFormat returns a function that formats the given files. Files with a .yaml or .yml extension
+// are treated as validation files, only their schema field is formatted.
+func runFormat() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		check := viper.GetBool("check")
+		diff := viper.GetBool("diff")
+
+		var unformatted []string
+		for _, path := range args {
+			original, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+
+			var formatted string
+			switch strings.ToLower(filepath.Ext(path)) {
+			case ".yaml", ".yml":
+				formatted, err = formatValidationFile(string(original))
+			default:
+				formatted, err = formatter.Format(string(original))
+			}
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+
+			if formatted == string(original) {
+				continue
+			}
+			unformatted = append(unformatted, path)
+
+			if diff {
+				text, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+					A:        difflib.SplitLines(string(original)),
+					B:        difflib.SplitLines(formatted),
+					FromFile: path,
+					ToFile:   path + " (formatted)",
+					Context:  3,
+				})
+				if err != nil {
+					return err
+				}
+				fmt.Fprint(cmd.OutOrStdout(), text)
+				continue
+			}
+
+			if check {
+				fmt.Fprintln(cmd.OutOrStdout(), path)
+				continue
+			}
+
+			info, err := os.Stat(path)
+			if err != nil {
+				return err
+			}
+			if err = os.WriteFile(path, []byte(formatted), info.Mode().Perm()); err != nil {
+				return err
+			}
+		}
+
+		if check && len(unformatted) > 0 {
+			return fmt.Errorf("%d file(s) are not formatted", len(unformatted))
+		}
+
+		return nil
+	}
+}
+
+// formatValidationFile formats the schema field of a validation file. The rest of the file is kept as it is,
+// the schema is written back as a literal block scalar. Schemas referenced by a URL or a file path are skipped.
+func formatValidationFile(content string) (string, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &document); err != nil {
+		return "", err
+	}
+	if len(document.Content) == 0 || document.Content[0].Kind != yaml.MappingNode {
+		return content, nil
+	}
+
+	// find the schema field of the top level mapping
+	var key, value *yaml.Node
+	mapping := document.Content[0]
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == "schema" {
+			key, value = mapping.Content[i], mapping.Content[i+1]
+			break
+		}
+	}
+	if key == nil || value.Kind != yaml.ScalarNode {
+		return content, nil
+	}
+
+	// a single line without spaces is a URL or a file path, not a schema
+	if !strings.ContainsAny(strings.TrimSpace(value.Value), " \n") {
+		return content, nil
+	}
+
+	formatted, err := formatter.Format(value.Value)
+	if err != nil {
+		return "", err
+	}
+
+	lines := strings.Split(content, "\n")
+	indent := strings.Repeat(" ", key.Column-1)
+
+	// the value ends before the next line that is indented at most as much as the key
+	start := key.Line - 1
+	end := start + 1
+	for end < len(lines) {
+		line := lines[end]
+		if strings.TrimSpace(line) != "" && len(line)-len(strings.TrimLeft(line, " ")) <= key.Column-1 {
+			break
+		}
+		end++
+	}
+	// the blank lines after the value are kept
+	for end > start+1 && strings.TrimSpace(lines[end-1]) == "" {
+		end--
+	}
+
+	block := []string{indent + "schema: |-"}
+	for _, line := range strings.Split(strings.TrimSuffix(formatted, "\n"), "\n") {
+		if line == "" {
+			block = append(block, "")
+			continue
+		}
+		block = append(block, indent+"    "+line)
+	}
+
+	result := make([]string, 0, len(lines)-(end-start)+len(block))
+	result = append(result, lines[:start]...)
+	result = append(result, block...)
+	result = append(result, lines[end:]...)
+	return strings.Join(result, "\n"), nil
+}
@@ -0,0 +1,251 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/internal/storage"
+	"github.com/Permify/permify/pkg/attribute"
+	"github.com/Permify/permify/pkg/database"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/tuple"
+)
+
+const (
+	historyConfig         = "config"
+	historyDatabaseEngine = "database-engine"
+	historyDatabaseURI    = "database-uri"
+	historyTenant         = "tenant"
+	historyRelation       = "relation"
+	historySubject        = "subject"
+	historyAttributeNames = "attributes"
+	historyPageSize       = "page-size"
+	historyFormat         = "format"
+
+	// Output formats of the history command
+	historyFormatText  = "text"
+	historyFormatJSONL = "jsonl"
+)
+
+// NewHistoryCommand - Creates new history command
+func NewHistoryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "show the history of relationships and attributes",
+		Long: `Show every version of the relationships or attributes of an entity, with the transaction and time each one
+was written at and, once it was replaced or deleted, the transaction and time it expired at.
+
+Versions that expired before the garbage collection window are not shown. The memory engine keeps no history
+and only shows the stored data.`,
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(NewHistoryRelationshipsCommand())
+	cmd.AddCommand(NewHistoryAttributesCommand())
+
+	return cmd
+}
+
+// NewHistoryRelationshipsCommand - Creates new history relationships command
+func NewHistoryRelationshipsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relationships <entity>",
+		Short: "show the history of the relationships of an entity, given as type:id or type",
+		RunE:  historyRelationships(),
+		Args:  cobra.ExactArgs(1),
+	}
+
+	f := historyFlags(cmd)
+	f.String(historyRelation, "", "relation to show, every relation if empty")
+	f.String(historySubject, "", "subject to show, given as type:id or type, every subject if empty")
+
+	return cmd
+}
+
+// NewHistoryAttributesCommand - Creates new history attributes command
+func NewHistoryAttributesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attributes <entity>",
+		Short: "show the history of the attributes of an entity, given as type:id or type",
+		RunE:  historyAttributes(),
+		Args:  cobra.ExactArgs(1),
+	}
+
+	f := historyFlags(cmd)
+	f.StringSlice(historyAttributeNames, nil, "attributes to show, every attribute if empty")
+
+	return cmd
+}
+
+// historyFlags - Registers the flags shared by the history commands
+func historyFlags(cmd *cobra.Command) *pflag.FlagSet {
+	f := cmd.Flags()
+	f.StringP(historyConfig, "c", "", "config file whose database section is used")
+	f.String(historyDatabaseEngine, "postgres", "database engine, overrides the config file")
+	f.String(historyDatabaseURI, "", "database URI, overrides the config file")
+	f.String(historyTenant, "t1", "tenant to read")
+	f.Uint32(historyPageSize, 100, "number of versions read at once")
+	f.String(historyFormat, historyFormatText, "output format, one of text or jsonl")
+	return f
+}
+
+// historyRelationships - permify history relationships command
+func historyRelationships() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		entity, err := historyEntityFilter(args[0])
+		if err != nil {
+			return err
+		}
+		filter := &base.TupleFilter{Entity: entity, Subject: &base.SubjectFilter{}}
+		filter.Relation, _ = cmd.Flags().GetString(historyRelation)
+		if subject, _ := cmd.Flags().GetString(historySubject); subject != "" {
+			sf, err := historyEntityFilter(subject)
+			if err != nil {
+				return err
+			}
+			filter.Subject = &base.SubjectFilter{Type: sf.GetType(), Ids: sf.GetIds()}
+		}
+
+		return readHistory(cmd, func(ctx context.Context, dr storage.DataReader, tenantID string, pagination database.Pagination) ([]*base.DataHistoryRecord, database.EncodedContinuousToken, error) {
+			return dr.ReadRelationshipHistory(ctx, tenantID, filter, pagination)
+		})
+	}
+}
+
+// historyAttributes - permify history attributes command
+func historyAttributes() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		entity, err := historyEntityFilter(args[0])
+		if err != nil {
+			return err
+		}
+		filter := &base.AttributeFilter{Entity: entity}
+		filter.Attributes, _ = cmd.Flags().GetStringSlice(historyAttributeNames)
+
+		return readHistory(cmd, func(ctx context.Context, dr storage.DataReader, tenantID string, pagination database.Pagination) ([]*base.DataHistoryRecord, database.EncodedContinuousToken, error) {
+			return dr.ReadAttributeHistory(ctx, tenantID, filter, pagination)
+		})
+	}
+}
+
+// readHistory - Opens the database and prints every page of versions read by the read function
+func readHistory(cmd *cobra.Command, read func(ctx context.Context, dr storage.DataReader, tenantID string, pagination database.Pagination) ([]*base.DataHistoryRecord, database.EncodedContinuousToken, error)) error {
+	conf := config.DefaultConfig().Database
+	conf.Engine, _ = cmd.Flags().GetString(historyDatabaseEngine)
+	if path, _ := cmd.Flags().GetString(historyConfig); path != "" {
+		cfg, err := config.NewConfigWithFile(path)
+		if err != nil {
+			return err
+		}
+		conf = cfg.Database
+	}
+	if cmd.Flags().Changed(historyDatabaseEngine) {
+		conf.Engine, _ = cmd.Flags().GetString(historyDatabaseEngine)
+	}
+	if cmd.Flags().Changed(historyDatabaseURI) {
+		conf.URI, _ = cmd.Flags().GetString(historyDatabaseURI)
+	}
+	if conf.URI == "" && conf.Writer.URI == "" {
+		return fmt.Errorf("a database URI is required, set it with --%s or a config file", historyDatabaseURI)
+	}
+
+	format, _ := cmd.Flags().GetString(historyFormat)
+	if format != historyFormatText && format != historyFormatJSONL {
+		return fmt.Errorf("unknown history format %q, expected %s or %s", format, historyFormatText, historyFormatJSONL)
+	}
+	tenantID, _ := cmd.Flags().GetString(historyTenant)
+	pageSize, _ := cmd.Flags().GetUint32(historyPageSize)
+
+	db, err := factories.DatabaseFactory(conf)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	dr := factories.DataReaderFactory(db)
+
+	versions := 0
+	ct := ""
+	for {
+		records, next, err := read(ctx, dr, tenantID, database.NewPagination(database.Size(pageSize), database.Token(ct)))
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			if err = writeHistoryRecord(cmd.OutOrStdout(), format, record); err != nil {
+				return err
+			}
+			versions++
+		}
+		ct = next.String()
+		if ct == "" {
+			break
+		}
+	}
+
+	fmt.Fprintf(cmd.ErrOrStderr(), "%d versions\n", versions)
+
+	return nil
+}
+
+// writeHistoryRecord - Writes a version as a line of text or as JSON
+func writeHistoryRecord(w io.Writer, format string, record *base.DataHistoryRecord) error {
+	if format == historyFormatJSONL {
+		line, err := protojson.Marshal(record)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", line)
+		return err
+	}
+
+	var item string
+	switch {
+	case record.GetTuple() != nil:
+		item = tuple.ToString(record.GetTuple())
+	case record.GetAttribute() != nil:
+		item = attribute.ToString(record.GetAttribute())
+	}
+
+	written := "written"
+	if record.GetCreatedTxId() != 0 {
+		written = fmt.Sprintf("written in tx %d at %s", record.GetCreatedTxId(), historyTime(record.GetCreatedAt().AsTime(), record.GetCreatedAt() != nil))
+	}
+	expired := "active"
+	if record.GetExpiredTxId() != 0 {
+		expired = fmt.Sprintf("expired in tx %d at %s", record.GetExpiredTxId(), historyTime(record.GetExpiredAt().AsTime(), record.GetExpiredAt() != nil))
+	}
+
+	_, err := fmt.Fprintf(w, "%s\t%s\t%s\n", item, written, expired)
+	return err
+}
+
+// historyTime - Formats the time of a transaction, which is unknown once the transaction was garbage collected
+func historyTime(t time.Time, known bool) string {
+	if !known {
+		return "unknown time"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// historyEntityFilter - Parses an entity given as type:id or type into an entity filter
+func historyEntityFilter(entity string) (*base.EntityFilter, error) {
+	if !strings.Contains(entity, ":") {
+		return &base.EntityFilter{Type: entity}, nil
+	}
+	e, err := tuple.E(entity)
+	if err != nil {
+		return nil, err
+	}
+	return &base.EntityFilter{Type: e.GetType(), Ids: []string{e.GetId()}}, nil
+}
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/internal/importer"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+const (
+	importConfig         = "config"
+	importDatabaseEngine = "database-engine"
+	importDatabaseURI    = "database-uri"
+	importTenant         = "tenant"
+	importSchemaVersion  = "schema-version"
+	importFormat         = "format"
+	importChunkSize      = "chunk-size"
+	importCommitInterval = "commit-interval"
+)
+
+// NewImportCommand - Creates new import command
+func NewImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [file]",
+		Short: "bulk load tuples and attributes into a tenant",
+		Long: `Bulk load tuples and attributes from a file, or the standard input if no file is given, without the
+per write size limit.
+
+NDJSON input has one row per line, {"tuple": ...} or {"attribute": ...}, holding either the JSON form of the
+row or its string notation such as "document:1#owner@user:1". Proto input is a stream of size delimited
+DataImportRequest messages.
+
+Every row is validated against the schema, invalid rows are reported and skipped. The rows are committed
+every commit interval rows, or in a single transaction when it is zero. Cardinality limits of single relations
+are enforced at every commit.`,
+		RunE: importData(),
+		Args: cobra.MaximumNArgs(1),
+	}
+
+	f := cmd.Flags()
+	f.StringP(importConfig, "c", "", "config file whose database section is used")
+	f.String(importDatabaseEngine, "postgres", "database engine, overrides the config file")
+	f.String(importDatabaseURI, "", "database URI, overrides the config file")
+	f.String(importTenant, "t1", "tenant to import into")
+	f.String(importSchemaVersion, "", "schema version the rows are validated against, the head version of the tenant if empty")
+	f.String(importFormat, importer.FormatNDJSON, "input format, one of ndjson or proto")
+	f.Int(importChunkSize, 1000, "number of NDJSON rows validated and copied at once")
+	f.Uint32(importCommitInterval, 0, "number of rows committed per transaction, a single transaction if zero")
+
+	return cmd
+}
+
+// importData - permify import command
+func importData() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		conf := config.DefaultConfig().Database
+		conf.Engine, _ = cmd.Flags().GetString(importDatabaseEngine)
+		if path, _ := cmd.Flags().GetString(importConfig); path != "" {
+			cfg, err := config.NewConfigWithFile(path)
+			if err != nil {
+				return err
+			}
+			conf = cfg.Database
+		}
+		if cmd.Flags().Changed(importDatabaseEngine) {
+			conf.Engine, _ = cmd.Flags().GetString(importDatabaseEngine)
+		}
+		if cmd.Flags().Changed(importDatabaseURI) {
+			conf.URI, _ = cmd.Flags().GetString(importDatabaseURI)
+		}
+		if conf.URI == "" && conf.Writer.URI == "" {
+			return fmt.Errorf("a database URI is required, set it with --%s or a config file", importDatabaseURI)
+		}
+
+		tenantID, _ := cmd.Flags().GetString(importTenant)
+		metadata := &base.DataImportRequestMetadata{}
+		metadata.SchemaVersion, _ = cmd.Flags().GetString(importSchemaVersion)
+		metadata.CommitInterval, _ = cmd.Flags().GetUint32(importCommitInterval)
+
+		var in io.Reader = cmd.InOrStdin()
+		if len(args) == 1 && args[0] != "-" {
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			in = file
+		}
+		format, _ := cmd.Flags().GetString(importFormat)
+		chunkSize, _ := cmd.Flags().GetInt(importChunkSize)
+		reader, err := importer.NewReader(format, in, chunkSize)
+		if err != nil {
+			return err
+		}
+
+		db, err := factories.DatabaseFactory(conf)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		ctx := context.Background()
+		imp, err := importer.NewImporter(ctx, factories.SchemaReaderFactory(db), factories.DataWriterFactory(db), tenantID, metadata)
+		if err != nil {
+			return err
+		}
+		defer imp.Close(ctx)
+
+		for {
+			chunk, err := reader.Next()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			committed, err := imp.Load(ctx, chunk.GetTuples(), chunk.GetAttributes())
+			if err != nil {
+				return err
+			}
+			if committed {
+				summary := imp.Summary()
+				fmt.Fprintf(cmd.ErrOrStderr(), "committed %d tuples and %d attributes, %d rows rejected, at snap token %s\n", summary.GetTuples(), summary.GetAttributes(), summary.GetRejected(), summary.GetSnapToken())
+			}
+		}
+
+		summary, err := imp.Finish(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, rejection := range summary.GetRejections() {
+			fmt.Fprintf(cmd.ErrOrStderr(), "rejected row %d %s: %s\n", rejection.GetPosition(), rejection.GetRow(), rejection.GetReason())
+		}
+		if summary.GetRejected() > uint64(len(summary.GetRejections())) {
+			fmt.Fprintf(cmd.ErrOrStderr(), "%d more rows rejected\n", summary.GetRejected()-uint64(len(summary.GetRejections())))
+		}
+
+		fmt.Fprintf(cmd.ErrOrStderr(), "imported %d tuples and %d attributes, %d rows rejected, at snap token %s\n", summary.GetTuples(), summary.GetAttributes(), summary.GetRejected(), summary.GetSnapToken())
+
+		return nil
+	}
+}
@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.yaml.in/yaml/v3"
+
+	"github.com/Permify/permify/internal/config"
+	internalSchema "github.com/Permify/permify/internal/schema"
+	"github.com/Permify/permify/pkg/cmd/flags"
+	"github.com/Permify/permify/pkg/development/file"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+	"github.com/Permify/permify/pkg/schema"
+)
+
+// NewLintCommand - creates a new lint command
+func NewLintCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "lint <file>...",
+		Short: "statically analyzes schema files, or the schema of validation files, and reports findings",
+		RunE:  runLint(),
+		Args:  cobra.MinimumNArgs(1),
+		// Findings are reported as lint output, not as a misuse of the command
+		SilenceUsage: true,
+	}
+
+	f := command.Flags()
+	f.StringP("config", "c", "", "config file whose service.schema.lint section sets the rule severities")
+	f.StringToString("rule", map[string]string{}, "severity of a rule, e.g. --rule unused-relation=off (error, warning, info or off)")
+
+	command.PreRun = func(cmd *cobra.Command, args []string) {
+		flags.RegisterLintFlags(f)
+	}
+
+	return command
+}
+
+// runLint returns a function that lints the given files. Files with a .yaml or .yml extension
+// are treated as validation files, their schema field is linted. The command fails if any
+// finding has error severity, with the findings and their count as its only output.
+func runLint() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		rules := map[string]string{}
+
+		// the rules of the config file are overridden by the rules given as flags
+		if path := viper.GetString("config.file"); path != "" {
+			cfg, err := config.NewConfigWithFile(path)
+			if err != nil {
+				return err
+			}
+			for rule, severity := range cfg.Service.Schema.Lint.Rules {
+				rules[rule] = severity
+			}
+		}
+		for rule, severity := range viper.GetStringMapString("rule") {
+			rules[rule] = severity
+		}
+
+		linter, err := internalSchema.NewLinter(rules)
+		if err != nil {
+			return err
+		}
+
+		failed := 0
+		for _, path := range args {
+			sch, line, column, err := readLintSchema(path)
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+
+			findings, err := linter.Lint(sch)
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+
+			for _, finding := range findings {
+				fmt.Fprintf(cmd.OutOrStdout(), "%s:%d:%d: %s %s: %s\n", path, int(finding.GetLine())+line, int(finding.GetColumn())+column,
+					lintSeverityName(finding.GetSeverity()), finding.GetRule(), finding.GetMessage())
+				if finding.GetSeverity() == base.SchemaLintFinding_SEVERITY_ERROR {
+					failed++
+				}
+			}
+		}
+
+		if failed > 0 {
+			// the findings are already printed, so the error only sets the exit status
+			fmt.Fprintf(cmd.ErrOrStderr(), "%d lint error(s) found\n", failed)
+			cmd.SilenceErrors = true
+			return fmt.Errorf("%d lint error(s) found", failed)
+		}
+
+		return nil
+	}
+}
+
+// readLintSchema returns the schema of a schema file, or of the schema field of a validation file.
+// It also returns the line and column offsets of the schema in the file, so findings point into the file.
+func readLintSchema(path string) (sch string, line, column int, err error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		u, err := url.Parse(path)
+		if err != nil {
+			return "", 0, 0, err
+		}
+
+		decoder, err := file.NewDecoderFromURL(u)
+		if err != nil {
+			return "", 0, 0, err
+		}
+
+		s := &file.Shape{}
+		if err = decoder.Decode(s); err != nil {
+			return "", 0, 0, err
+		}
+
+		sch, err = schema.NewSchemaLoader().LoadSchema(s.Schema)
+		if err != nil {
+			return "", 0, 0, err
+		}
+
+		// only a schema written inline as a block scalar has a position in the file
+		if content, err := os.ReadFile(path); err == nil {
+			line, column = lintSchemaOffset(content)
+		}
+		return sch, line, column, nil
+	default:
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return "", 0, 0, err
+		}
+		return string(content), 0, 0, nil
+	}
+}
+
+// lintSchemaOffset returns the line and column offsets of the schema field of a validation file
+// written as a literal or folded block scalar, or zero offsets if it is written in another way.
+func lintSchemaOffset(content []byte) (line, column int) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return 0, 0
+	}
+	if len(document.Content) == 0 || document.Content[0].Kind != yaml.MappingNode {
+		return 0, 0
+	}
+
+	mapping := document.Content[0]
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		value := mapping.Content[i+1]
+		if mapping.Content[i].Value != "schema" || value.Style&(yaml.LiteralStyle|yaml.FoldedStyle) == 0 {
+			continue
+		}
+
+		// the block starts on the line after its indicator, indented by the first non-empty line
+		lines := strings.Split(string(content), "\n")
+		for l := value.Line; l < len(lines); l++ {
+			if strings.TrimSpace(lines[l]) != "" {
+				return value.Line, len(lines[l]) - len(strings.TrimLeft(lines[l], " "))
+			}
+		}
+	}
+	return 0, 0
+}
+
+// lintSeverityName returns the name of a severity as it is written in the lint configuration.
+func lintSeverityName(severity base.SchemaLintFinding_Severity) string {
+	switch severity {
+	case base.SchemaLintFinding_SEVERITY_ERROR:
+		return internalSchema.LintSeverityError
+	case base.SchemaLintFinding_SEVERITY_WARNING:
+		return internalSchema.LintSeverityWarning
+	default:
+		return internalSchema.LintSeverityInfo
+	}
+}
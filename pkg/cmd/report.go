@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/engines"
+	"github.com/Permify/permify/internal/factories"
+	"github.com/Permify/permify/internal/invoke"
+	"github.com/Permify/permify/internal/report"
+	base "github.com/Permify/permify/pkg/pb/base/v1"
+)
+
+const (
+	reportConfig         = "config"
+	reportDatabaseEngine = "database-engine"
+	reportDatabaseURI    = "database-uri"
+	reportTenant         = "tenant"
+	reportEntityTypes    = "entity-types"
+	reportPermissions    = "permissions"
+	reportSubjectTypes   = "subject-types"
+	reportSnapToken      = "snap-token"
+	reportSchemaVersion  = "schema-version"
+	reportDepth          = "depth"
+	reportFormat         = "format"
+	reportOutput         = "output"
+)
+
+// NewReportCommand - Creates new report command
+func NewReportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "generate reports on the authorization data",
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(NewReportAccessCommand())
+
+	return cmd
+}
+
+// NewReportAccessCommand - Creates new report access command
+func NewReportAccessCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "access",
+		Short: "export who holds which permission on which entity for access reviews",
+		Long: `Export an access review matrix: every subject holding a permission on an entity of the given entity types,
+and whether it holds the permission directly or inherits it, with the relations granting it.
+
+The matrix is read at one snap token, the head snapshot of the tenant if none is given, and is written as
+it is enumerated, as CSV, JSON Lines or Parquet.`,
+		RunE: reportAccess(),
+		Args: cobra.NoArgs,
+	}
+
+	f := cmd.Flags()
+	f.StringP(reportConfig, "c", "", "config file whose database section is used")
+	f.String(reportDatabaseEngine, "postgres", "database engine, overrides the config file")
+	f.String(reportDatabaseURI, "", "database URI, overrides the config file")
+	f.String(reportTenant, "t1", "tenant to review")
+	f.StringSlice(reportEntityTypes, nil, "entity types to review, required")
+	f.StringSlice(reportPermissions, nil, "permissions to review, every permission of the entity types if empty")
+	f.StringSlice(reportSubjectTypes, nil, "subject types to review, every entity type related directly as a subject if empty")
+	f.String(reportSnapToken, "", "snap token the matrix is read at, the head snapshot of the tenant if empty")
+	f.String(reportSchemaVersion, "", "schema version the permissions are evaluated with, the head version of the tenant if empty")
+	f.Int32(reportDepth, 50, "depth of the permission lookups")
+	f.String(reportFormat, report.FormatCSV, "output format, one of csv, jsonl or parquet")
+	f.StringP(reportOutput, "o", "", "output file, the standard output if empty")
+
+	return cmd
+}
+
+// reportAccess - permify report access command
+func reportAccess() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		conf := config.DefaultConfig().Database
+		conf.Engine, _ = cmd.Flags().GetString(reportDatabaseEngine)
+		if path, _ := cmd.Flags().GetString(reportConfig); path != "" {
+			cfg, err := config.NewConfigWithFile(path)
+			if err != nil {
+				return err
+			}
+			conf = cfg.Database
+		}
+		if cmd.Flags().Changed(reportDatabaseEngine) {
+			conf.Engine, _ = cmd.Flags().GetString(reportDatabaseEngine)
+		}
+		if cmd.Flags().Changed(reportDatabaseURI) {
+			conf.URI, _ = cmd.Flags().GetString(reportDatabaseURI)
+		}
+		if conf.URI == "" && conf.Writer.URI == "" {
+			return fmt.Errorf("a database URI is required, set it with --%s or a config file", reportDatabaseURI)
+		}
+
+		request := &base.AuditAccessReviewRequest{Metadata: &base.AuditAccessReviewRequestMetadata{}}
+		request.TenantId, _ = cmd.Flags().GetString(reportTenant)
+		request.EntityTypes, _ = cmd.Flags().GetStringSlice(reportEntityTypes)
+		request.Permissions, _ = cmd.Flags().GetStringSlice(reportPermissions)
+		request.SubjectTypes, _ = cmd.Flags().GetStringSlice(reportSubjectTypes)
+		request.Metadata.SnapToken, _ = cmd.Flags().GetString(reportSnapToken)
+		request.Metadata.SchemaVersion, _ = cmd.Flags().GetString(reportSchemaVersion)
+		request.Metadata.Depth, _ = cmd.Flags().GetInt32(reportDepth)
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		format, _ := cmd.Flags().GetString(reportFormat)
+		var out io.Writer = cmd.OutOrStdout()
+		if path, _ := cmd.Flags().GetString(reportOutput); path != "" {
+			file, err := os.Create(path)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			out = file
+		}
+		writer, err := report.NewWriter(format, out)
+		if err != nil {
+			return err
+		}
+
+		db, err := factories.DatabaseFactory(conf)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		schemaReader := factories.SchemaReaderFactory(db)
+		dataReader := factories.DataReaderFactory(db)
+
+		checkEngine := engines.NewCheckEngine(schemaReader, dataReader)
+		invoker := invoke.NewDirectInvoker(
+			schemaReader,
+			dataReader,
+			checkEngine,
+			engines.NewExpandEngine(schemaReader, dataReader),
+			engines.NewLookupEngine(checkEngine, schemaReader, dataReader),
+			engines.NewSubjectPermission(checkEngine, schemaReader),
+		)
+		checkEngine.SetInvoker(invoker)
+
+		rows := 0
+		err = report.NewAccessReviewer(schemaReader, dataReader, invoker).Review(context.Background(), request, func(entry *base.AccessReviewEntry) error {
+			rows++
+			return writer.Write(report.NewRow(entry))
+		})
+		if err = errors.Join(err, writer.Close()); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(cmd.ErrOrStderr(), "exported %d entries at snap token %s and schema version %s\n", rows, request.GetMetadata().GetSnapToken(), request.GetMetadata().GetSchemaVersion())
+
+		return nil
+	}
+}
@@ -31,10 +31,13 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/Permify/permify/internal"
+	"github.com/Permify/permify/internal/audit"
 	"github.com/Permify/permify/internal/config"
+	"github.com/Permify/permify/internal/decision"
 	"github.com/Permify/permify/internal/engines"
 	"github.com/Permify/permify/internal/factories"
 	"github.com/Permify/permify/internal/servers"
+	"github.com/Permify/permify/internal/shadow"
 	"github.com/Permify/permify/internal/storage"
 	pkgcache "github.com/Permify/permify/pkg/cache"
 	"github.com/Permify/permify/pkg/cache/ristretto"
@@ -85,6 +88,26 @@ func NewServeCommand() *cobra.Command {
 	f.StringSlice("log-headers", conf.Log.Headers, "allows setting custom headers for the log exporter in key-value pairs")
 	f.String("log-protocol", conf.Log.Protocol, "allows setting the communication protocol for the log exporter, with options http or grpc")
 	f.String("log-service-name", conf.Log.ServiceName, "override the service name reported by the log exporter")
+	f.Bool("audit-enabled", conf.Audit.Enabled, "switch option for recording the mutations made through the API")
+	f.Bool("audit-file-enabled", conf.Audit.File.Enabled, "switch option for writing audit records to a local JSONL file")
+	f.String("audit-file-path", conf.Audit.File.Path, "path of the audit JSONL file")
+	f.Int("audit-file-max-size", conf.Audit.File.MaxSize, "size in megabytes at which the audit file is rotated")
+	f.Int("audit-file-max-backups", conf.Audit.File.MaxBackups, "number of rotated audit files kept")
+	f.Bool("audit-postgres-enabled", conf.Audit.Postgres.Enabled, "switch option for writing audit records to the database, required to query them")
+	f.Bool("audit-otlp-enabled", conf.Audit.Otlp.Enabled, "switch option for exporting audit records as OTLP logs")
+	f.String("audit-otlp-endpoint", conf.Audit.Otlp.Endpoint, "export uri for audit records")
+	f.Bool("audit-otlp-insecure", conf.Audit.Otlp.Insecure, "use https or http for audit records")
+	f.String("audit-otlp-urlpath", conf.Audit.Otlp.Urlpath, "allow to set url path for the audit otlp exporter")
+	f.StringSlice("audit-otlp-headers", conf.Audit.Otlp.Headers, "allows setting custom headers for the audit exporter in key-value pairs")
+	f.String("audit-otlp-protocol", conf.Audit.Otlp.Protocol, "allows setting the communication protocol for the audit exporter, with options http or grpc")
+	f.Bool("decisions-enabled", conf.DecisionLog.Enabled, "switch option for logging the answers of permission checks and lookups")
+	f.Float64("decisions-sample-rate", conf.DecisionLog.SampleRate, "fraction of the decisions logged when no tenant or permission rate matches")
+	f.Bool("decisions-redact-context", conf.DecisionLog.RedactContext, "redact the values of the contextual data and attributes of logged decisions")
+	f.Bool("decisions-file-enabled", conf.DecisionLog.File.Enabled, "switch option for writing decisions to a local JSONL file")
+	f.String("decisions-file-path", conf.DecisionLog.File.Path, "path of the decision JSONL file")
+	f.Int("decisions-file-max-size", conf.DecisionLog.File.MaxSize, "size in megabytes at which the decision file is rotated")
+	f.Int("decisions-file-max-backups", conf.DecisionLog.File.MaxBackups, "number of rotated decision files kept")
+	f.Bool("decisions-exporter-enabled", conf.DecisionLog.Exporter.Enabled, "switch option for emitting decisions through the log exporter")
 	f.Bool("authn-enabled", conf.Authn.Enabled, "enable server authentication")
 	f.String("authn-method", conf.Authn.Method, "server authentication method")
 	f.StringSlice("authn-preshared-keys", conf.Authn.Preshared.Keys, "preshared key/keys for server authentication")
@@ -95,6 +118,10 @@ func NewServeCommand() *cobra.Command {
 	f.Duration("authn-oidc-backoff-frequency", conf.Authn.Oidc.BackoffFrequency, "backoff frequency for the OpenID Connect configuration")
 	f.Int("authn-oidc-backoff-max-retries", conf.Authn.Oidc.BackoffMaxRetries, "defines the maximum number of retries for the OpenID Connect configuration")
 	f.StringSlice("authn-oidc-valid-methods", conf.Authn.Oidc.ValidMethods, "list of valid JWT signing methods for OpenID Connect")
+	f.String("authn-oidc-tenant-claim", conf.Authn.Oidc.TenantClaim, "claim of the OpenID Connect token holding the tenants it may access")
+	f.String("authn-oidc-scope-claim", conf.Authn.Oidc.ScopeClaim, "claim of the OpenID Connect token holding its scopes")
+	f.String("authn-mtls-ca", conf.Authn.Mtls.CAPath, "path of the PEM bundle of the certificate authorities of the clients")
+	f.Duration("authn-mtls-reload-interval", conf.Authn.Mtls.ReloadInterval, "interval at which the client certificate authorities are reloaded from disk")
 	f.Bool("tracer-enabled", conf.Tracer.Enabled, "switch option for tracing")
 	f.String("tracer-exporter", conf.Tracer.Exporter, "can be; jaeger, signoz, zipkin or otlp. (integrated tracing tools)")
 	f.String("tracer-endpoint", conf.Tracer.Endpoint, "export uri for tracing data")
@@ -116,6 +143,10 @@ func NewServeCommand() *cobra.Command {
 	f.Bool("service-watch-enabled", conf.Service.Watch.Enabled, "switch option for watch service")
 	f.Int64("service-schema-cache-number-of-counters", conf.Service.Schema.Cache.NumberOfCounters, "schema service cache number of counters")
 	f.String("service-schema-cache-max-cost", conf.Service.Schema.Cache.MaxCost, "schema service cache max cost")
+	f.Bool("service-schema-shadow-enabled", conf.Service.Schema.Shadow.Enabled, "switch option for evaluating checks against the shadow schema versions")
+	f.Int("service-schema-shadow-concurrency", conf.Service.Schema.Shadow.Concurrency, "maximum number of shadow evaluations in progress")
+	f.Duration("service-schema-shadow-refresh-interval", conf.Service.Schema.Shadow.RefreshInterval, "how long the shadow schema version of a tenant is cached")
+	f.Duration("service-schema-shadow-timeout", conf.Service.Schema.Shadow.Timeout, "timeout of a shadow evaluation")
 	f.Int("service-permission-bulk-limit", conf.Service.Permission.BulkLimit, "bulk operations limit")
 	f.Int("service-permission-concurrency-limit", conf.Service.Permission.ConcurrencyLimit, "concurrency limit")
 	f.Int64("service-permission-cache-number-of-counters", conf.Service.Permission.Cache.NumberOfCounters, "permission service cache number of counters")
@@ -124,6 +155,9 @@ func NewServeCommand() *cobra.Command {
 	f.String("database-uri", conf.Database.URI, "uri of your data source to store relation tuples and schema")
 	f.String("database-writer-uri", conf.Database.Writer.URI, "writer uri of your data source to store relation tuples and schema")
 	f.String("database-reader-uri", conf.Database.Reader.URI, "reader uri of your data source to store relation tuples and schema")
+	f.StringSlice("database-reader-uris", conf.Database.Reader.URIs, "uris of additional read replicas, reads are routed among the healthy ones")
+	f.Duration("database-reader-replica-wait", conf.Database.Reader.ReplicaWait, "how long a read waits for a replica to replay the transaction of its snap token before it is sent to the writer")
+	f.Duration("database-reader-health-check-interval", conf.Database.Reader.HealthCheckInterval, "period between the checks of the health and lag of the read replicas")
 	f.Bool("database-auto-migrate", conf.Database.AutoMigrate, "auto migrate database tables")
 	f.Int("database-max-connections", conf.Database.MaxConnections, "maximum number of connections in the pool")
 	f.Int("database-max-open-connections", conf.Database.MaxOpenConnections, "deprecated: use database-max-connections instead. maximum number of parallel connections that can be made to the database at any time")
@@ -138,6 +172,8 @@ func NewServeCommand() *cobra.Command {
 	f.Int("database-max-data-per-write", conf.Database.MaxDataPerWrite, "sets the maximum amount of data per write operation to the database")
 	f.Int("database-max-retries", conf.Database.MaxRetries, "defines the maximum number of retries for database operations in case of failure")
 	f.Int("database-watch-buffer-size", conf.Database.WatchBufferSize, "specifies the buffer size for database watch operations, impacting how many changes can be queued")
+	f.Duration("database-idempotency-key-retention", conf.Database.IdempotencyKeyRetention, "how long the idempotency key of a write is remembered")
+	f.Duration("database-snapshot-refresh-interval", conf.Database.SnapshotRefreshInterval, "how long the recent snapshot shared by the reads that minimize latency is used before it is refreshed")
 	f.Bool("database-garbage-collection-enabled", conf.Database.GarbageCollection.Enabled, "use database garbage collection for expired relationships and attributes")
 	f.Duration("database-garbage-collection-interval", conf.Database.GarbageCollection.Interval, "interval for database garbage collection")
 	f.Duration("database-garbage-collection-timeout", conf.Database.GarbageCollection.Timeout, "timeout for database garbage collection")
@@ -514,6 +550,33 @@ func serve() func(cmd *cobra.Command, args []string) error {
 		// Associate the invoker with the checkEngine.
 		checkEngine.SetInvoker(invoker)
 
+		// Share the snapshot selector between the invokers and the data reads, so that the reads that minimize
+		// latency are answered at the same recent snapshot.
+		snapshots := invoke.NewSnapshots(dataReader, factories.SnapTokenDecoderFactory(db), cfg.Database.SnapshotRefreshInterval)
+		invoker.SetSnapshots(snapshots)
+
+		// Log the decisions made through the invoker if enabled
+		if cfg.DecisionLog.Enabled {
+			decisions, err := decision.New(cfg.DecisionLog, cfg.Log)
+			if err != nil {
+				slog.Error("failed to initialize decision log", slog.Any("error", err))
+				return err
+			}
+			defer func() {
+				if err := decisions.Close(context.Background()); err != nil {
+					slog.Error("failed to close decision log", slog.Any("error", err))
+				}
+			}()
+			invoker.SetDecisionLogger(decisions)
+		}
+
+		// Evaluate a sample of the checks against the shadow schema versions if enabled
+		if cfg.Service.Schema.Shadow.Enabled {
+			shadows := shadow.NewEvaluator(schemaReader, invoker, cfg.Service.Schema.Shadow)
+			defer shadows.Wait()
+			invoker.SetShadowEvaluator(shadows)
+		}
+
 		// Create a local invoker for local operations.
 		localInvoker := invoke.NewDirectInvoker(
 			schemaReader,
@@ -523,6 +586,22 @@ func serve() func(cmd *cobra.Command, args []string) error {
 			lookupEngine,
 			subjectPermissionEngine,
 		)
+		localInvoker.SetSnapshots(snapshots)
+
+		// Initialize the audit log of the mutations made through the API
+		var auditor *audit.Auditor
+		if cfg.Audit.Enabled {
+			auditor, err = audit.New(cfg.Audit, db)
+			if err != nil {
+				slog.Error("failed to initialize audit log", slog.Any("error", err))
+				return err
+			}
+			defer func() {
+				if err = auditor.Close(context.Background()); err != nil {
+					slog.Error("failed to close audit log", slog.Any("error", err))
+				}
+			}()
+		}
 
 		// Initialize the container which brings together multiple components such as the invoker, data readers/writers, and schema handlers.
 		container := servers.NewContainer(
@@ -537,6 +616,7 @@ func serve() func(cmd *cobra.Command, args []string) error {
 			tenantWriter,
 			watcher,
 		)
+		container.Snapshots = snapshots
 
 		// Create an error group with the provided context
 		var g *errgroup.Group
@@ -551,6 +631,8 @@ func serve() func(cmd *cobra.Command, args []string) error {
 				&cfg.Distributed,
 				&cfg.Authn,
 				&cfg.Profiler,
+				&cfg.Service.Schema.Lint,
+				auditor,
 				localInvoker,
 			)
 		})
@@ -3,6 +3,7 @@ package memory
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/go-memdb"
 )
@@ -14,14 +15,47 @@ type Memory struct {
 	aid uint64
 
 	DB *memdb.MemDB
+
+	// heads holds the snapshot of the last write of every tenant
+	heads map[string]uint64
+
+	// idempotencyKeyRetention is how long the idempotency key of a write is remembered
+	idempotencyKeyRetention time.Duration
+
+	// transactionRetention is how long the transactions of the writes are kept for watch and history
+	transactionRetention time.Duration
+}
+
+// Option - Option type
+type Option func(*Memory)
+
+// IdempotencyKeyRetention - Defines how long the idempotency key of a write is remembered
+func IdempotencyKeyRetention(d time.Duration) Option {
+	return func(m *Memory) {
+		m.idempotencyKeyRetention = d
+	}
+}
+
+// TransactionRetention - Defines how long the transactions of the writes are kept for watch and history
+func TransactionRetention(d time.Duration) Option {
+	return func(m *Memory) {
+		m.transactionRetention = d
+	}
 }
 
 // New - Creates new database schema in memory
-func New(schema *memdb.DBSchema) (*Memory, error) {
+func New(schema *memdb.DBSchema, opts ...Option) (*Memory, error) {
 	db, err := memdb.NewMemDB(schema)
-	return &Memory{
-		DB: db,
-	}, err
+	m := &Memory{
+		DB:                      db,
+		heads:                   map[string]uint64{},
+		idempotencyKeyRetention: 24 * time.Hour,
+		transactionRetention:    24 * time.Hour,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m, err
 }
 
 func (m *Memory) RelationTupleID() (id uint64) {
@@ -46,6 +80,32 @@ func (m *Memory) AttributeID() (id uint64) {
 	return id
 }
 
+// SetHead - Records the snapshot of the last write of the tenants
+func (m *Memory) SetHead(value uint64, tenantIDs ...string) {
+	m.Lock()
+	defer m.Unlock()
+	for _, tenantID := range tenantIDs {
+		m.heads[tenantID] = value
+	}
+}
+
+// GetHead - Gets the snapshot of the last write of a tenant, zero if it was never written
+func (m *Memory) GetHead(tenantID string) uint64 {
+	m.RLock()
+	defer m.RUnlock()
+	return m.heads[tenantID]
+}
+
+// GetIdempotencyKeyRetention - Gets how long the idempotency key of a write is remembered
+func (m *Memory) GetIdempotencyKeyRetention() time.Duration {
+	return m.idempotencyKeyRetention
+}
+
+// GetTransactionRetention - Gets how long the transactions of the writes are kept for watch and history
+func (m *Memory) GetTransactionRetention() time.Duration {
+	return m.transactionRetention
+}
+
 // GetEngineType - Gets engine type, returns as string
 func (m *Memory) GetEngineType() string {
 	return "memory"
@@ -1,5 +1,7 @@
 package postgres
 
+import "time"
+
 const (
 	_defaultMaxConnections              = 0 // 0 = use pgxpool default (unlimited). Set explicitly to override.
 	_defaultMaxIdleConnections          = 0 // Deprecated: Use _defaultMinConnections instead. Kept for backward compatibility (maps to MinConnections if MinConnections is not set).
@@ -11,4 +13,8 @@ const (
 	_defaultHealthCheckPeriod           = 0 // 0 = use pgxpool default (1 minute). Set explicitly to override.
 	_defaultMaxConnectionLifetimeJitter = 0 // 0 = will default to 20% of MaxConnLifetime if MaxConnLifetime is set. Set explicitly to override.
 	_defaultConnectTimeout              = 0 // 0 = use pgx default (no timeout). Set explicitly to override.
+	_defaultIdempotencyKeyRetention     = 24 * time.Hour
+	_defaultReplicaWait                 = 100 * time.Millisecond
+	_defaultReplicaHealthCheckInterval  = 5 * time.Second
+	_replicaPollInterval                = 10 * time.Millisecond
 )
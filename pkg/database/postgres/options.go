@@ -99,3 +99,31 @@ func MaxRetries(v int) Option {
 		c.maxRetries = v
 	}
 }
+
+// IdempotencyKeyRetention - Defines how long the idempotency key of a write is remembered
+func IdempotencyKeyRetention(d time.Duration) Option {
+	return func(c *Postgres) {
+		c.idempotencyKeyRetention = d
+	}
+}
+
+// GarbageCollectionWindow - Defines the window of the garbage collection, reads at a point in time older than it are refused
+func GarbageCollectionWindow(d time.Duration) Option {
+	return func(c *Postgres) {
+		c.garbageCollectionWindow = d
+	}
+}
+
+// ReplicaWait - Defines how long a read waits for a replica to replay the transaction of its snap token before it is sent to the writer
+func ReplicaWait(d time.Duration) Option {
+	return func(c *Postgres) {
+		c.replicaWait = d
+	}
+}
+
+// ReplicaHealthCheckInterval - Defines the period between the checks of the health and lag of the replicas
+func ReplicaHealthCheckInterval(d time.Duration) Option {
+	return func(c *Postgres) {
+		c.replicaHealthCheckInterval = d
+	}
+}
@@ -49,20 +49,35 @@ type Postgres struct {
 	maxConnectionLifetimeJitter time.Duration
 	// connectTimeout is the maximum time to wait when establishing a new connection
 	connectTimeout time.Duration
+	// idempotencyKeyRetention is how long the idempotency key of a write is remembered
+	idempotencyKeyRetention time.Duration
+	// garbageCollectionWindow is how far back the garbage collector keeps the data needed to read at a point in time, zero if it is disabled
+	garbageCollectionWindow time.Duration
+	// replicaWait is how long a read waits for a replica to replay the transaction of its snap token before it is sent to the writer
+	replicaWait time.Duration
+	// replicaHealthCheckInterval is the period between the checks of the health and lag of the replicas
+	replicaHealthCheckInterval time.Duration
+	// replicas routes the reads among the read replicas, nil if the reads are not sent to replicas
+	replicas *replicaSet
 }
 
 // New -
 func New(uri string, opts ...Option) (*Postgres, error) {
-	return newDB(uri, uri, opts...)
+	return newDB(uri, []string{uri}, opts...)
 }
 
 // NewWithSeparateURIs -
 func NewWithSeparateURIs(writerUri, readerUri string, opts ...Option) (*Postgres, error) {
-	return newDB(writerUri, readerUri, opts...)
+	return newDB(writerUri, []string{readerUri}, opts...)
+}
+
+// NewWithReplicas - Creates new postgresql db instance whose reads are routed among several read replicas
+func NewWithReplicas(writerUri string, readerUris []string, opts ...Option) (*Postgres, error) {
+	return newDB(writerUri, readerUris, opts...)
 }
 
 // new - Creates new postgresql db instance
-func newDB(writerUri, readerUri string, opts ...Option) (*Postgres, error) {
+func newDB(writerUri string, readerUris []string, opts ...Option) (*Postgres, error) {
 	pg := &Postgres{
 		maxConnections:              _defaultMaxConnections,
 		maxIdleConnections:          _defaultMaxIdleConnections,
@@ -74,6 +89,9 @@ func newDB(writerUri, readerUri string, opts ...Option) (*Postgres, error) {
 		healthCheckPeriod:           _defaultHealthCheckPeriod,
 		maxConnectionLifetimeJitter: _defaultMaxConnectionLifetimeJitter,
 		connectTimeout:              _defaultConnectTimeout,
+		idempotencyKeyRetention:     _defaultIdempotencyKeyRetention,
+		replicaWait:                 _defaultReplicaWait,
+		replicaHealthCheckInterval:  _defaultReplicaHealthCheckInterval,
 	}
 
 	// Custom options
@@ -83,95 +101,106 @@ func newDB(writerUri, readerUri string, opts ...Option) (*Postgres, error) {
 
 	pg.Builder = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
 
+	if len(readerUris) == 0 {
+		readerUris = []string{writerUri}
+	}
+
 	writeConfig, err := pgxpool.ParseConfig(writerUri)
 	if err != nil {
 		return nil, err
 	}
+	pg.configurePool(writeConfig)
 
-	readConfig, err := pgxpool.ParseConfig(readerUri)
+	readConfigs := make([]*pgxpool.Config, 0, len(readerUris))
+	for _, uri := range readerUris {
+		readConfig, err := pgxpool.ParseConfig(uri)
+		if err != nil {
+			return nil, err
+		}
+		pg.configurePool(readConfig)
+		readConfigs = append(readConfigs, readConfig)
+	}
+
+	// Create connection pools for both writing and reading operations using the configured settings.
+	var readPools []*pgxpool.Pool
+	pg.WritePool, readPools, err = createPools(
+		context.Background(), // Context used to control the lifecycle of the pools.
+		writeConfig,          // Configuration settings for the write pool.
+		readConfigs,          // Configuration settings for the read pools.
+	)
+	// Handle errors during the creation of the connection pools.
 	if err != nil {
 		return nil, err
 	}
+	pg.ReadPool = readPools[0]
+
+	// Reads are only routed when they are sent to replicas rather than to the writer itself
+	if len(readerUris) > 1 || readerUris[0] != writerUri {
+		if err = pg.startReplicas(readPools, readConfigs); err != nil {
+			pg.Close()
+			return nil, err
+		}
+	}
+
+	return pg, nil
+}
 
-	// Set the default execution mode for queries using the write and read configurations.
-	setDefaultQueryExecMode(writeConfig.ConnConfig)
-	setDefaultQueryExecMode(readConfig.ConnConfig)
+// configurePool - Applies the connection and pool options to the configuration of a pool
+func (p *Postgres) configurePool(config *pgxpool.Config) {
+	// Set the default execution mode for queries.
+	setDefaultQueryExecMode(config.ConnConfig)
 
-	// Set the plan cache mode for both write and read configurations to optimize query planning.
-	setPlanCacheMode(writeConfig.ConnConfig)
-	setPlanCacheMode(readConfig.ConnConfig)
+	// Set the plan cache mode to optimize query planning.
+	setPlanCacheMode(config.ConnConfig)
 
-	// Set the minimum number of connections in the pool for both write and read configurations.
+	// Set the minimum number of connections in the pool.
 	// For backward compatibility: if MinConnections is not set (0) but MaxIdleConnections is set, use MaxIdleConnections (old behavior).
-	minConns := pg.minConnections
-	if minConns == 0 && pg.maxIdleConnections > 0 {
-		minConns = pg.maxIdleConnections
+	minConns := p.minConnections
+	if minConns == 0 && p.maxIdleConnections > 0 {
+		minConns = p.maxIdleConnections
 	}
 	if minConns > 0 {
-		writeConfig.MinConns = int32(minConns)
-		readConfig.MinConns = int32(minConns)
+		config.MinConns = int32(minConns)
 	}
 
 	// Set the minimum number of idle connections in the pool.
 	// Note: MinIdleConnections was not set in the old code, so we only set it if explicitly configured.
-	if pg.minIdleConnections > 0 {
-		writeConfig.MinIdleConns = int32(pg.minIdleConnections)
-		readConfig.MinIdleConns = int32(pg.minIdleConnections)
+	if p.minIdleConnections > 0 {
+		config.MinIdleConns = int32(p.minIdleConnections)
 	}
 
-	// Set the maximum number of connections in the pool for both write and read configurations.
+	// Set the maximum number of connections in the pool.
 	// pgxpool default is 0 (unlimited), so only set if explicitly configured.
 	// Note: MaxOpenConnections is already mapped to MaxConnections via options.go, so no backward compatibility needed here.
-	if pg.maxConnections > 0 {
-		writeConfig.MaxConns = int32(pg.maxConnections)
-		readConfig.MaxConns = int32(pg.maxConnections)
+	if p.maxConnections > 0 {
+		config.MaxConns = int32(p.maxConnections)
 	}
 
-	// Set the maximum amount of time a connection may be idle before being closed for both configurations.
-	writeConfig.MaxConnIdleTime = pg.maxConnectionIdleTime
-	readConfig.MaxConnIdleTime = pg.maxConnectionIdleTime
+	// Set the maximum amount of time a connection may be idle before being closed.
+	config.MaxConnIdleTime = p.maxConnectionIdleTime
 
-	// Set the maximum lifetime of a connection in the pool for both configurations.
-	writeConfig.MaxConnLifetime = pg.maxConnectionLifeTime
-	readConfig.MaxConnLifetime = pg.maxConnectionLifeTime
+	// Set the maximum lifetime of a connection in the pool.
+	config.MaxConnLifetime = p.maxConnectionLifeTime
 
 	// Set a jitter to the maximum connection lifetime to prevent all connections from expiring at the same time.
-	if pg.maxConnectionLifetimeJitter > 0 {
-		writeConfig.MaxConnLifetimeJitter = pg.maxConnectionLifetimeJitter
-		readConfig.MaxConnLifetimeJitter = pg.maxConnectionLifetimeJitter
+	if p.maxConnectionLifetimeJitter > 0 {
+		config.MaxConnLifetimeJitter = p.maxConnectionLifetimeJitter
 	} else {
 		// Default to 20% of MaxConnLifetime if not explicitly set
-		writeConfig.MaxConnLifetimeJitter = time.Duration(0.2 * float64(pg.maxConnectionLifeTime))
-		readConfig.MaxConnLifetimeJitter = time.Duration(0.2 * float64(pg.maxConnectionLifeTime))
+		config.MaxConnLifetimeJitter = time.Duration(0.2 * float64(p.maxConnectionLifeTime))
 	}
 
-	// Set the health check period for both configurations.
-	if pg.healthCheckPeriod > 0 {
-		writeConfig.HealthCheckPeriod = pg.healthCheckPeriod
-		readConfig.HealthCheckPeriod = pg.healthCheckPeriod
+	// Set the health check period.
+	if p.healthCheckPeriod > 0 {
+		config.HealthCheckPeriod = p.healthCheckPeriod
 	}
 
-	// Set the connect timeout for both configurations.
-	if pg.connectTimeout > 0 {
-		writeConfig.ConnConfig.ConnectTimeout = pg.connectTimeout
-		readConfig.ConnConfig.ConnectTimeout = pg.connectTimeout
+	// Set the connect timeout.
+	if p.connectTimeout > 0 {
+		config.ConnConfig.ConnectTimeout = p.connectTimeout
 	}
 
-	writeConfig.ConnConfig.Tracer = otelpgx.NewTracer()
-	readConfig.ConnConfig.Tracer = otelpgx.NewTracer()
-
-	// Create connection pools for both writing and reading operations using the configured settings.
-	pg.WritePool, pg.ReadPool, err = createPools(
-		context.Background(), // Context used to control the lifecycle of the pools.
-		writeConfig,          // Configuration settings for the write pool.
-		readConfig,           // Configuration settings for the read pool.
-	)
-	// Handle errors during the creation of the connection pools.
-	if err != nil {
-		return nil, err
-	}
-
-	return pg, nil
+	config.ConnConfig.Tracer = otelpgx.NewTracer()
 }
 
 func (p *Postgres) GetMaxDataPerWrite() int {
@@ -186,6 +215,14 @@ func (p *Postgres) GetWatchBufferSize() int {
 	return p.watchBufferSize
 }
 
+func (p *Postgres) GetIdempotencyKeyRetention() time.Duration {
+	return p.idempotencyKeyRetention
+}
+
+func (p *Postgres) GetGarbageCollectionWindow() time.Duration {
+	return p.garbageCollectionWindow
+}
+
 // GetEngineType - Get the engine type which is postgresql in string
 func (p *Postgres) GetEngineType() string {
 	return "postgres"
@@ -194,6 +231,7 @@ func (p *Postgres) GetEngineType() string {
 // Close - Close postgresql instance
 func (p *Postgres) Close() error {
 	p.ReadPool.Close()
+	p.stopReplicas()
 	p.WritePool.Close()
 	return nil
 }
@@ -269,8 +307,8 @@ func setPlanCacheMode(config *pgx.ConnConfig) {
 	}
 }
 
-// createPools initializes read and write connection pools with appropriate configurations and error handling.
-func createPools(ctx context.Context, wConfig, rConfig *pgxpool.Config) (*pgxpool.Pool, *pgxpool.Pool, error) {
+// createPools initializes the write and read connection pools with appropriate configurations and error handling.
+func createPools(ctx context.Context, wConfig *pgxpool.Config, rConfigs []*pgxpool.Config) (*pgxpool.Pool, []*pgxpool.Pool, error) {
 	// Context with timeout for creating the pools
 	initCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
@@ -281,18 +319,29 @@ func createPools(ctx context.Context, wConfig, rConfig *pgxpool.Config) (*pgxpoo
 		return nil, nil, fmt.Errorf("failed to create write pool: %w", err)
 	}
 
-	// Create read pool using the same configuration
-	readPool, err := pgxpool.NewWithConfig(initCtx, rConfig)
-	if err != nil {
-		writePool.Close() // Ensure write pool is closed on failure
-		return nil, nil, fmt.Errorf("failed to create read pool: %w", err)
+	closeAll := func(readPools []*pgxpool.Pool) {
+		writePool.Close()
+		for _, readPool := range readPools {
+			readPool.Close()
+		}
+	}
+
+	// Create a read pool for every reader
+	readPools := make([]*pgxpool.Pool, 0, len(rConfigs))
+	for _, rConfig := range rConfigs {
+		readPool, err := pgxpool.NewWithConfig(initCtx, rConfig)
+		if err != nil {
+			closeAll(readPools) // Ensure the pools created so far are closed on failure
+			return nil, nil, fmt.Errorf("failed to create read pool: %w", err)
+		}
+		readPools = append(readPools, readPool)
 	}
 
 	// Set up retry policy for pinging pools
 	retryPolicy := backoff.NewExponentialBackOff()
 	retryPolicy.MaxElapsedTime = 1 * time.Minute
 
-	// Attempt to ping both pools to confirm connectivity
+	// Attempt to ping every pool to confirm connectivity
 	err = backoff.Retry(func() error {
 		pingCtx, pingCancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer pingCancel()
@@ -300,17 +349,18 @@ func createPools(ctx context.Context, wConfig, rConfig *pgxpool.Config) (*pgxpoo
 		if err := writePool.Ping(pingCtx); err != nil {
 			return fmt.Errorf("write pool ping failed: %w", err)
 		}
-		if err := readPool.Ping(pingCtx); err != nil {
-			return fmt.Errorf("read pool ping failed: %w", err)
+		for _, readPool := range readPools {
+			if err := readPool.Ping(pingCtx); err != nil {
+				return fmt.Errorf("read pool ping failed: %w", err)
+			}
 		}
 		return nil
 	}, retryPolicy)
 	// Handle errors from pinging
 	if err != nil {
-		writePool.Close()
-		readPool.Close()
+		closeAll(readPools)
 		return nil, nil, fmt.Errorf("pinging pools failed: %w", err)
 	}
 
-	return writePool, readPool, nil
+	return writePool, readPools, nil
 }
@@ -226,6 +226,20 @@ var _ = Describe("Postgres", func() {
 			option(pg)
 			Expect(pg.maxIdleConnections).Should(Equal(5))
 		})
+
+		It("Case 12: ReplicaWait should set replicaWait", func() {
+			pg := &Postgres{}
+			option := ReplicaWait(250 * time.Millisecond)
+			option(pg)
+			Expect(pg.replicaWait).Should(Equal(250 * time.Millisecond))
+		})
+
+		It("Case 13: ReplicaHealthCheckInterval should set replicaHealthCheckInterval", func() {
+			pg := &Postgres{}
+			option := ReplicaHealthCheckInterval(10 * time.Second)
+			option(pg)
+			Expect(pg.replicaHealthCheckInterval).Should(Equal(10 * time.Second))
+		})
 	})
 
 	Context("Backward Compatibility", func() {
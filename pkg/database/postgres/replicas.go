@@ -0,0 +1,248 @@
+package postgres
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// replicaStateQuery reads the oldest transaction a replica may still be replaying, every transaction before it
+	// is visible on the replica, and how long ago the replica replayed the last transaction of the writer. The replay
+	// time is null when the reader is not a standby.
+	replicaStateQuery = "SELECT pg_snapshot_xmin(pg_current_snapshot()), COALESCE(EXTRACT(EPOCH FROM (now() - pg_last_xact_replay_timestamp())), 0)::float8"
+	// replicaReplayedQuery reads whether a replica has replayed a transaction, along with its oldest transaction.
+	replicaReplayedQuery = "SELECT pg_visible_in_snapshot($1::text::xid8, pg_current_snapshot()), pg_snapshot_xmin(pg_current_snapshot())"
+
+	// Reasons of the reads sent to the writer instead of a replica
+	fallbackLagging   = "lagging"
+	fallbackUnhealthy = "unhealthy"
+)
+
+// rowQuerier - Runs the queries of the health and replay checks on a replica
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// replica - A read replica and the state observed by its last checks
+type replica struct {
+	pool *pgxpool.Pool
+	conn rowQuerier
+	// name identifies the replica in the metrics and logs, as host:port
+	name string
+
+	healthy atomic.Bool
+	// lag is how long ago the replica replayed the last transaction of the writer, in nanoseconds
+	lag atomic.Int64
+	// xmin is the oldest transaction the replica may still be replaying
+	xmin atomic.Uint64
+}
+
+// replicaSet - Routes the reads among the read replicas by their health, lag and the transactions they replayed
+type replicaSet struct {
+	replicas []*replica
+	wait     time.Duration
+
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	fallbacks    metric.Int64Counter
+	registration metric.Registration
+}
+
+// ReadPoolAt - Returns the pool a read at the snapshot of a transaction is sent to: the least lagging healthy replica
+// that replayed the transaction, waiting briefly for one to replay it, or the writer otherwise. A zero transaction
+// returns the least lagging healthy replica.
+func (p *Postgres) ReadPoolAt(ctx context.Context, xid uint64) *pgxpool.Pool {
+	if p.replicas == nil {
+		return p.ReadPool
+	}
+	if r := p.replicas.route(ctx, xid); r != nil {
+		return r.pool
+	}
+	return p.WritePool
+}
+
+// startReplicas - Checks the replicas once, then keeps checking them in the background
+func (p *Postgres) startReplicas(pools []*pgxpool.Pool, configs []*pgxpool.Config) error {
+	set := &replicaSet{wait: p.replicaWait, done: make(chan struct{})}
+	for i, pool := range pools {
+		set.replicas = append(set.replicas, &replica{
+			pool: pool,
+			conn: pool,
+			name: fmt.Sprintf("%s:%d", configs[i].ConnConfig.Host, configs[i].ConnConfig.Port),
+		})
+	}
+
+	meter := otel.Meter("permify")
+	var err error
+	set.fallbacks, err = meter.Int64Counter("replica_fallbacks", metric.WithDescription("Number of reads sent to the writer because no healthy replica replayed their snapshot"))
+	if err != nil {
+		return err
+	}
+	lag, err := meter.Float64ObservableGauge("replica_lag", metric.WithUnit("s"), metric.WithDescription("Time since the replica replayed the last transaction of the writer"))
+	if err != nil {
+		return err
+	}
+	set.registration, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		for _, r := range set.replicas {
+			o.ObserveFloat64(lag, time.Duration(r.lag.Load()).Seconds(), metric.WithAttributes(attribute.String("replica", r.name)))
+		}
+		return nil
+	}, lag)
+	if err != nil {
+		return err
+	}
+
+	var ctx context.Context
+	ctx, set.cancel = context.WithCancel(context.Background())
+	set.check(ctx)
+	interval := p.replicaHealthCheckInterval
+	if interval <= 0 {
+		interval = _defaultReplicaHealthCheckInterval
+	}
+	go set.run(ctx, interval)
+
+	p.replicas = set
+	return nil
+}
+
+// stopReplicas - Stops checking the replicas and closes the pools of the replicas other than the read pool
+func (p *Postgres) stopReplicas() {
+	if p.replicas == nil {
+		return
+	}
+	p.replicas.cancel()
+	<-p.replicas.done
+	if p.replicas.registration != nil {
+		_ = p.replicas.registration.Unregister()
+	}
+	for _, r := range p.replicas.replicas {
+		if r.pool != p.ReadPool {
+			r.pool.Close()
+		}
+	}
+}
+
+// run - Checks the replicas every interval until the context is cancelled
+func (s *replicaSet) run(ctx context.Context, interval time.Duration) {
+	defer close(s.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.check(ctx)
+		}
+	}
+}
+
+// check - Reads the health, lag and replayed transactions of every replica
+func (s *replicaSet) check(ctx context.Context) {
+	for _, r := range s.replicas {
+		checkCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+		var xmin XID8
+		var lag float64
+		err := r.conn.QueryRow(checkCtx, replicaStateQuery).Scan(&xmin, &lag)
+		cancel()
+		if err != nil {
+			if r.healthy.Swap(false) {
+				slog.Warn("read replica is unhealthy", slog.String("replica", r.name), slog.Any("error", err))
+			}
+			continue
+		}
+		if !r.healthy.Swap(true) {
+			slog.Info("read replica is healthy", slog.String("replica", r.name))
+		}
+		r.lag.Store(int64(lag * float64(time.Second)))
+		r.replayed(xmin.Uint)
+	}
+}
+
+// route - Returns the replica a read at the snapshot of a transaction is sent to, nil if it is sent to the writer
+func (s *replicaSet) route(ctx context.Context, xid uint64) *replica {
+	candidates := s.healthy()
+	if len(candidates) == 0 {
+		s.fallbacks.Add(ctx, 1, metric.WithAttributes(attribute.String("reason", fallbackUnhealthy)))
+		return nil
+	}
+
+	// Replicas whose last check already saw the transaction replayed need no round trip
+	if xid == 0 {
+		return candidates[0]
+	}
+	for _, r := range candidates {
+		if r.xmin.Load() > xid {
+			return r
+		}
+	}
+
+	// Wait for the least lagging replica to replay the transaction
+	r := candidates[0]
+	deadline := time.Now().Add(s.wait)
+	for {
+		var visible bool
+		var xmin XID8
+		err := r.conn.QueryRow(ctx, replicaReplayedQuery, strconv.FormatUint(xid, 10)).Scan(&visible, &xmin)
+		if err != nil {
+			slog.WarnContext(ctx, "failed to check the replay of the read replica", slog.String("replica", r.name), slog.Any("error", err))
+			break
+		}
+		r.replayed(xmin.Uint)
+		if visible {
+			return r
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(min(remaining, _replicaPollInterval)):
+		}
+	}
+
+	s.fallbacks.Add(ctx, 1, metric.WithAttributes(attribute.String("reason", fallbackLagging)))
+	return nil
+}
+
+// healthy - Returns the healthy replicas, least lagging first
+func (s *replicaSet) healthy() []*replica {
+	healthy := make([]*replica, 0, len(s.replicas))
+	for _, r := range s.replicas {
+		if r.healthy.Load() {
+			healthy = append(healthy, r)
+		}
+	}
+	slices.SortStableFunc(healthy, func(a, b *replica) int {
+		return cmp.Compare(a.lag.Load(), b.lag.Load())
+	})
+	return healthy
+}
+
+// replayed - Records the oldest transaction the replica may still be replaying, which only moves forward
+func (r *replica) replayed(xmin uint64) {
+	for {
+		current := r.xmin.Load()
+		if xmin <= current || r.xmin.CompareAndSwap(current, xmin) {
+			return
+		}
+	}
+}
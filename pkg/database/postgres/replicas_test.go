@@ -0,0 +1,161 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+)
+
+// fakeReplica - Answers the health and replay checks of a replica from its fields
+type fakeReplica struct {
+	down    bool
+	xmin    atomic.Uint64
+	lag     float64
+	queries atomic.Int32
+}
+
+type fakeRow struct {
+	values []any
+	err    error
+}
+
+func (r fakeRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	for i, d := range dest {
+		switch d := d.(type) {
+		case *XID8:
+			if err := d.Set(r.values[i]); err != nil {
+				return err
+			}
+		case *float64:
+			*d = r.values[i].(float64)
+		case *bool:
+			*d = r.values[i].(bool)
+		}
+	}
+	return nil
+}
+
+func (f *fakeReplica) QueryRow(_ context.Context, sql string, args ...any) pgx.Row {
+	f.queries.Add(1)
+	if f.down {
+		return fakeRow{err: errors.New("connection refused")}
+	}
+	xmin := f.xmin.Load()
+	if sql == replicaReplayedQuery {
+		var xid uint64
+		for _, c := range args[0].(string) {
+			xid = xid*10 + uint64(c-'0')
+		}
+		return fakeRow{values: []any{xid < xmin, xmin}}
+	}
+	return fakeRow{values: []any{xmin, f.lag}}
+}
+
+var _ = Describe("Replicas", func() {
+	var set *replicaSet
+
+	newSet := func(wait time.Duration, fakes ...*fakeReplica) *replicaSet {
+		s := &replicaSet{wait: wait}
+		for i, f := range fakes {
+			s.replicas = append(s.replicas, &replica{conn: f, name: string(rune('a' + i))})
+		}
+		var err error
+		s.fallbacks, err = otel.Meter("permify").Int64Counter("replica_fallbacks")
+		Expect(err).ShouldNot(HaveOccurred())
+		s.check(context.Background())
+		return s
+	}
+
+	Context("route", func() {
+		It("Case 1: Should prefer the least lagging healthy replica", func() {
+			lagging := &fakeReplica{lag: 3}
+			lagging.xmin.Store(100)
+			fresh := &fakeReplica{lag: 0.5}
+			fresh.xmin.Store(100)
+			down := &fakeReplica{down: true}
+
+			set = newSet(0, lagging, down, fresh)
+			Expect(set.route(context.Background(), 0)).Should(Equal(set.replicas[2]))
+			Expect(set.route(context.Background(), 50)).Should(Equal(set.replicas[2]))
+		})
+
+		It("Case 2: Should not query a replica whose last check saw the transaction replayed", func() {
+			f := &fakeReplica{}
+			f.xmin.Store(100)
+
+			set = newSet(0, f)
+			queries := f.queries.Load()
+			Expect(set.route(context.Background(), 99)).Should(Equal(set.replicas[0]))
+			Expect(f.queries.Load()).Should(Equal(queries))
+		})
+
+		It("Case 3: Should use another replica that replayed the transaction", func() {
+			fresh := &fakeReplica{lag: 0.1}
+			fresh.xmin.Store(10)
+			caughtUp := &fakeReplica{lag: 1}
+			caughtUp.xmin.Store(200)
+
+			set = newSet(0, fresh, caughtUp)
+			Expect(set.route(context.Background(), 150)).Should(Equal(set.replicas[1]))
+		})
+
+		It("Case 4: Should wait for a replica to replay the transaction", func() {
+			f := &fakeReplica{}
+			f.xmin.Store(10)
+
+			set = newSet(time.Second, f)
+			go func() {
+				time.Sleep(30 * time.Millisecond)
+				f.xmin.Store(200)
+			}()
+			Expect(set.route(context.Background(), 150)).Should(Equal(set.replicas[0]))
+			Expect(set.replicas[0].xmin.Load()).Should(Equal(uint64(200)))
+		})
+
+		It("Case 5: Should fall back to the writer when no replica replays the transaction in time", func() {
+			f := &fakeReplica{}
+			f.xmin.Store(10)
+
+			set = newSet(20*time.Millisecond, f)
+			Expect(set.route(context.Background(), 150)).Should(BeNil())
+		})
+
+		It("Case 6: Should fall back to the writer when no replica is healthy", func() {
+			set = newSet(time.Second, &fakeReplica{down: true})
+			Expect(set.route(context.Background(), 0)).Should(BeNil())
+		})
+	})
+
+	Context("check", func() {
+		It("Case 1: Should record the health, lag and replayed transactions of the replicas", func() {
+			f := &fakeReplica{lag: 2}
+			f.xmin.Store(42)
+
+			set = newSet(0, f)
+			Expect(set.replicas[0].healthy.Load()).Should(BeTrue())
+			Expect(time.Duration(set.replicas[0].lag.Load())).Should(Equal(2 * time.Second))
+			Expect(set.replicas[0].xmin.Load()).Should(Equal(uint64(42)))
+
+			f.down = true
+			set.check(context.Background())
+			Expect(set.replicas[0].healthy.Load()).Should(BeFalse())
+			Expect(set.replicas[0].xmin.Load()).Should(Equal(uint64(42)))
+		})
+	})
+
+	Context("ReadPoolAt", func() {
+		It("Case 1: Should return the read pool when the reads are not sent to replicas", func() {
+			pg := &Postgres{}
+			Expect(pg.ReadPoolAt(context.Background(), 10)).Should(BeNil())
+		})
+	})
+})
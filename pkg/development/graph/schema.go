@@ -203,6 +203,14 @@ func (b Builder) buildPermissionGraph(entity *base.EntityDefinition, from *Node,
 					Label: leaf.GetComputedAttribute().GetName(),
 				})
 
+			// Handle Count type leaf
+			case *base.Leaf_Count:
+				g.AddEdge(from, &Node{
+					Type:  "relation",
+					ID:    fmt.Sprintf("%s#%s", entity.GetName(), leaf.GetCount().GetRelation()),
+					Label: leaf.GetCount().GetRelation(),
+				})
+
 			// Handle Call type leaf
 			case *base.Leaf_Call:
 				g.AddEdge(from, &Node{
@@ -19,13 +19,16 @@ func (o Operator) String() string {
 }
 
 const (
-	IDENTIFIER ExpressionType = "identifier"
-	CALL       ExpressionType = "call"
-	INFLIX     ExpressionType = "inflix"
+	IDENTIFIER  ExpressionType = "identifier"
+	CALL        ExpressionType = "call"
+	INFLIX      ExpressionType = "inflix"
+	AGGREGATION ExpressionType = "aggregation"
 
 	AND Operator = "and"
 	OR  Operator = "or"
 	NOT Operator = "not"
+
+	COUNT = "count"
 )
 
 // Node defines an interface for a tree node.
@@ -76,6 +79,42 @@ func (ls *Call) GetType() ExpressionType {
 	return CALL
 }
 
+// Aggregation represents an expression that aggregates the subjects of a relation and compares
+// the result with a constant, e.g. count(approver) >= 2
+type Aggregation struct {
+	Function   token.Token // Function is the aggregation function token, e.g. count
+	Relation   Identifier  // Relation is the relation whose subjects are aggregated
+	Comparison token.Token // Comparison is the comparison operator, e.g. >=
+	Value      token.Token // Value is the integer the aggregated result is compared with
+}
+
+// expressionNode is a marker method to differentiate Expression and Statement interfaces
+func (ls *Aggregation) expressionNode() {}
+
+// String returns a string representation of the aggregation expression
+func (ls *Aggregation) String() string {
+	var sb strings.Builder
+	sb.WriteString(ls.Function.Literal)
+	sb.WriteString("(")
+	sb.WriteString(ls.Relation.String())
+	sb.WriteString(")")
+	sb.WriteString(" ")
+	sb.WriteString(ls.Comparison.Literal)
+	sb.WriteString(" ")
+	sb.WriteString(ls.Value.Literal)
+	return sb.String()
+}
+
+// IsInfix returns false since an aggregation is not an infix expression
+func (ls *Aggregation) IsInfix() bool {
+	return false
+}
+
+// GetType returns the type of the expression which is Aggregation
+func (ls *Aggregation) GetType() ExpressionType {
+	return AGGREGATION
+}
+
 // Identifier represents an expression that identifies an entity, permission or relation
 type Identifier struct {
 	Idents []token.Token // Idents is a slice of tokens that make up the identifier
@@ -16,6 +16,9 @@ type Schema struct {
 	// The list of statements in the schema
 	Statements []Statement
 
+	// Comments after the last statement of the schema
+	Comments []Comment
+
 	// references - Map of all relational references extracted from the schema
 	references *References
 }
@@ -31,11 +31,15 @@ type Statement interface {
 
 // EntityStatement represents a statement that refers to an entity.
 type EntityStatement struct {
-	Entity               token.Token // token.ENTITY
-	Name                 token.Token // token.IDENT
-	RelationStatements   []Statement // Statements that define relationships between entities
-	AttributeStatements  []Statement // Statements that define attributes of the entity
-	PermissionStatements []Statement // Statements that define permissions performed on the entity
+	Entity               token.Token  // token.ENTITY
+	Name                 token.Token  // token.IDENT
+	RelationStatements   []Statement  // Statements that define relationships between entities
+	AttributeStatements  []Statement  // Statements that define attributes of the entity
+	PermissionStatements []Statement  // Statements that define permissions performed on the entity
+	Annotations          []Annotation // Annotations attached to the entity, e.g. @description("...")
+	LeadingComments      []Comment    // Comments on the lines preceding the entity
+	TrailingComments     []Comment    // Comments following the opening brace on the same line
+	ClosingComments      []Comment    // Comments after the last statement of the entity, before the closing brace
 }
 
 // statementNode is a dummy method that satisfies the Statement interface.
@@ -44,6 +48,7 @@ func (ls *EntityStatement) statementNode() {}
 // String returns a string representation of the EntityStatement.
 func (ls *EntityStatement) String() string {
 	var sb strings.Builder
+	writeAnnotations(&sb, "", ls.Annotations)
 	sb.WriteString("entity")
 	sb.WriteString(" ")
 	sb.WriteString(ls.Name.Literal)
@@ -90,9 +95,12 @@ func (ls *EntityStatement) StatementType() StatementType {
 
 // AttributeStatement represents a statement that defines an attribute of an entity.
 type AttributeStatement struct {
-	Attribute     token.Token // token.ATTRIBUTE
-	Name          token.Token // token.IDENT
-	AttributeType AttributeTypeStatement
+	Attribute        token.Token // token.ATTRIBUTE
+	Name             token.Token // token.IDENT
+	AttributeType    AttributeTypeStatement
+	Annotations      []Annotation // Annotations attached to the attribute, e.g. @deprecated
+	LeadingComments  []Comment    // Comments on the lines preceding the attribute
+	TrailingComments []Comment    // Comments following the attribute on the same line
 }
 
 // statementNode is a dummy method that satisfies the Statement interface.
@@ -101,6 +109,7 @@ func (as *AttributeStatement) statementNode() {}
 // String returns a string representation of the AttributeStatement.
 func (as *AttributeStatement) String() string {
 	var sb strings.Builder
+	writeAnnotations(&sb, "\t", as.Annotations)
 	sb.WriteString("\t")
 	sb.WriteString("attribute")
 	sb.WriteString(" ")
@@ -122,9 +131,14 @@ func (as *AttributeStatement) StatementType() StatementType {
 
 // RelationStatement represents a statement that defines a relationship between two entities.
 type RelationStatement struct {
-	Relation      token.Token             // token.RELATION
-	Name          token.Token             // token.IDENT
-	RelationTypes []RelationTypeStatement // Statements that define the types of the relationship
+	Relation         token.Token             // token.RELATION
+	Name             token.Token             // token.IDENT
+	RelationTypes    []RelationTypeStatement // Statements that define the types of the relationship
+	Cardinality      token.Token             // token.IDENT, "single" when the relation holds at most one subject
+	OnConflict       token.Token             // token.IDENT, "reject" or "replace" for single relations
+	Annotations      []Annotation            // Annotations attached to the relation, e.g. @deprecated
+	LeadingComments  []Comment               // Comments on the lines preceding the relation
+	TrailingComments []Comment               // Comments following the relation on the same line
 }
 
 // statementNode is a dummy method that satisfies the Statement interface.
@@ -133,6 +147,7 @@ func (ls *RelationStatement) statementNode() {}
 // String returns a string representation of the RelationStatement.
 func (ls *RelationStatement) String() string {
 	var sb strings.Builder
+	writeAnnotations(&sb, "\t", ls.Annotations)
 	sb.WriteString("\t")
 	sb.WriteString("relation")
 	sb.WriteString(" ")
@@ -145,6 +160,16 @@ func (ls *RelationStatement) String() string {
 		sb.WriteString(" ")
 	}
 
+	// Append the cardinality constraint and its conflict behaviour, if any.
+	if ls.Cardinality.Literal != "" {
+		sb.WriteString(ls.Cardinality.Literal)
+		sb.WriteString(" ")
+		if ls.OnConflict.Literal != "" {
+			sb.WriteString(ls.OnConflict.Literal)
+			sb.WriteString(" ")
+		}
+	}
+
 	// Return the final string.
 	return sb.String()
 }
@@ -212,6 +237,15 @@ func (ls *RelationTypeStatement) StatementType() StatementType {
 
 func (ls *RelationTypeStatement) statementNode() {}
 
+const (
+	// SINGLE is the cardinality modifier restricting a relation to at most one subject.
+	SINGLE = "single"
+	// REJECT is the conflict modifier rejecting writes that exceed the cardinality.
+	REJECT = "reject"
+	// REPLACE is the conflict modifier replacing the existing subject on write.
+	REPLACE = "replace"
+)
+
 // IsDirectEntityReference returns true if the RelationTypeStatement is a direct entity reference.
 func IsDirectEntityReference(s RelationTypeStatement) bool {
 	return s.Relation.Literal == ""
@@ -223,6 +257,9 @@ type PermissionStatement struct {
 	Permission          token.Token // token.PERMISSION
 	Name                token.Token // token.IDENT
 	ExpressionStatement Statement
+	Annotations         []Annotation // Annotations attached to the permission, e.g. @deprecated
+	LeadingComments     []Comment    // Comments on the lines preceding the permission
+	TrailingComments    []Comment    // Comments following the permission on the same line
 }
 
 // statementNode is a marker method used to implement the Statement interface.
@@ -231,6 +268,7 @@ func (ls *PermissionStatement) statementNode() {}
 // String returns a string representation of the permission statement.
 func (ls *PermissionStatement) String() string {
 	var sb strings.Builder
+	writeAnnotations(&sb, "\t", ls.Annotations)
 	sb.WriteString("\t")
 	sb.WriteString("permission")
 	sb.WriteString(" ")
@@ -277,10 +315,13 @@ func (es *ExpressionStatement) StatementType() StatementType {
 
 // RuleStatement represents a rule statement, which consists of a rule name, a list of parameters and a body.
 type RuleStatement struct {
-	Rule       token.Token // token.RULE
-	Name       token.Token // token.IDENT
-	Arguments  map[token.Token]AttributeTypeStatement
-	Expression string
+	Rule             token.Token // token.RULE
+	Name             token.Token // token.IDENT
+	Arguments        map[token.Token]AttributeTypeStatement
+	Expression       string
+	Annotations      []Annotation // Annotations attached to the rule, e.g. @description("...")
+	LeadingComments  []Comment    // Comments on the lines preceding the rule
+	TrailingComments []Comment    // Comments following the opening brace on the same line
 }
 
 // statementNode is a marker method used to implement the Statement interface.
@@ -289,6 +330,7 @@ func (rs *RuleStatement) statementNode() {}
 // String returns a string representation of the permission statement.
 func (rs *RuleStatement) String() string {
 	var sb strings.Builder
+	writeAnnotations(&sb, "", rs.Annotations)
 	sb.WriteString("rule")
 	sb.WriteString(" ")
 	sb.WriteString(rs.Name.Literal)
@@ -328,3 +370,59 @@ func (rs *RuleStatement) GetName() string {
 func (rs *RuleStatement) StatementType() StatementType {
 	return RULE_STATEMENT
 }
+
+const (
+	// DESCRIPTION is the annotation describing a statement, e.g. @description("...").
+	DESCRIPTION = "description"
+	// OWNER is the annotation naming the owner of a statement, e.g. @owner("team-billing").
+	OWNER = "owner"
+	// DEPRECATED is the annotation marking a statement as deprecated.
+	DEPRECATED = "deprecated"
+)
+
+// annotationValueReplacer escapes the characters the lexer unescapes in string literals.
+var annotationValueReplacer = strings.NewReplacer("\\", "\\\\", "\"", "\\\"", "\n", "\\n", "\t", "\\t")
+
+// Annotation represents metadata attached to a statement, e.g. @description("...") or @deprecated.
+type Annotation struct {
+	Sign  token.Token // token.SIGN
+	Name  token.Token // token.IDENT
+	Value token.Token // token.STRING, empty for annotations without a value
+}
+
+// String returns a string representation of the Annotation.
+func (a *Annotation) String() string {
+	var sb strings.Builder
+	sb.WriteString("@")
+	sb.WriteString(a.Name.Literal)
+	if a.Value.Type == token.STRING {
+		sb.WriteString("(\"")
+		sb.WriteString(annotationValueReplacer.Replace(a.Value.Literal))
+		sb.WriteString("\")")
+	}
+	return sb.String()
+}
+
+// writeAnnotations writes each annotation on its own line, prefixed with the given indentation.
+func writeAnnotations(sb *strings.Builder, indent string, annotations []Annotation) {
+	for _, annotation := range annotations {
+		sb.WriteString(indent)
+		sb.WriteString(annotation.String())
+		sb.WriteString("\n")
+	}
+}
+
+// Comment represents a single-line or multi-line comment in the schema.
+// Comments do not affect the compiled schema; they are kept so the schema can be rewritten without losing them.
+type Comment struct {
+	Token  token.Token // token.SINGLE_LINE_COMMENT or token.MULTI_LINE_COMMENT
+	Inline bool        // true if the comment follows other tokens on the same line
+}
+
+// String returns the comment with its delimiters.
+func (c *Comment) String() string {
+	if c.Token.Type == token.MULTI_LINE_COMMENT {
+		return "/*" + c.Token.Literal + "*/"
+	}
+	return "//" + c.Token.Literal
+}
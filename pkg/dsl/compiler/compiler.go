@@ -3,6 +3,7 @@ package compiler
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/google/cel-go/cel"
@@ -82,6 +83,7 @@ func (t *Compiler) compileEntity(sc *ast.EntityStatement) (*base.EntityDefinitio
 		Attributes:  map[string]*base.AttributeDefinition{},
 		Permissions: map[string]*base.PermissionDefinition{},
 		References:  map[string]base.EntityDefinition_Reference{},
+		Annotations: compileAnnotations(sc.Annotations),
 	}
 
 	// Compile relations
@@ -96,6 +98,7 @@ func (t *Compiler) compileEntity(sc *ast.EntityStatement) (*base.EntityDefinitio
 		relationDefinition := &base.RelationDefinition{
 			Name:               st.Name.Literal,
 			RelationReferences: []*base.RelationReference{},
+			Annotations:        compileAnnotations(st.Annotations),
 		}
 
 		// Compile the relation types
@@ -106,6 +109,15 @@ func (t *Compiler) compileEntity(sc *ast.EntityStatement) (*base.EntityDefinitio
 			})
 		}
 
+		// Compile the cardinality constraint
+		if st.Cardinality.Literal == ast.SINGLE {
+			relationDefinition.Cardinality = base.RelationDefinition_CARDINALITY_SINGLE
+			relationDefinition.OnConflict = base.RelationDefinition_ON_CONFLICT_REJECT
+			if st.OnConflict.Literal == ast.REPLACE {
+				relationDefinition.OnConflict = base.RelationDefinition_ON_CONFLICT_REPLACE
+			}
+		}
+
 		// Add the relation definition and reference
 		entityDefinition.Relations[relationDefinition.GetName()] = relationDefinition
 		entityDefinition.References[relationDefinition.GetName()] = base.EntityDefinition_REFERENCE_RELATION
@@ -123,8 +135,9 @@ func (t *Compiler) compileEntity(sc *ast.EntityStatement) (*base.EntityDefinitio
 		}
 
 		attributeDefinition := &base.AttributeDefinition{
-			Name: st.Name.Literal,
-			Type: typ,
+			Name:        st.Name.Literal,
+			Type:        typ,
+			Annotations: compileAnnotations(st.Annotations),
 		}
 
 		entityDefinition.Attributes[attributeDefinition.GetName()] = attributeDefinition
@@ -147,8 +160,9 @@ func (t *Compiler) compileEntity(sc *ast.EntityStatement) (*base.EntityDefinitio
 
 		// Initialize the permission definition and reference
 		permissionDefinition := &base.PermissionDefinition{
-			Name:  st.Name.Literal,
-			Child: ch,
+			Name:        st.Name.Literal,
+			Child:       ch,
+			Annotations: compileAnnotations(st.Annotations),
 		}
 		entityDefinition.Permissions[permissionDefinition.GetName()] = permissionDefinition
 		entityDefinition.References[permissionDefinition.GetName()] = base.EntityDefinition_REFERENCE_PERMISSION
@@ -164,8 +178,9 @@ func (t *Compiler) compileRule(sc *ast.RuleStatement) (*base.RuleDefinition, err
 	// Initialize a new base.RuleDefinition with the name and body from the rule statement.
 	// The Arguments field is initialized as an empty map.
 	ruleDefinition := &base.RuleDefinition{
-		Name:      sc.Name.Literal,
-		Arguments: map[string]base.AttributeType{},
+		Name:        sc.Name.Literal,
+		Arguments:   map[string]base.AttributeType{},
+		Annotations: compileAnnotations(sc.Annotations),
 	}
 
 	var envOptions []cel.EnvOption
@@ -305,7 +320,15 @@ func (t *Compiler) compileLeaf(entityName string, expression ast.Expression) (*b
 		// Compile the call and return the result.
 		return t.compileCall(entityName, call)
 
-	// Default case when the expression type is neither an Identifier nor a Call.
+	// Case when the expression is an Aggregation (e.g. count(approver) >= 2).
+	case ast.AGGREGATION:
+		// Type assertion to get the underlying Aggregation.
+		aggregation := expression.(*ast.Aggregation)
+
+		// Compile the aggregation and return the result.
+		return t.compileAggregation(entityName, aggregation)
+
+	// Default case when the expression type is neither an Identifier, a Call nor an Aggregation.
 	default:
 		// Return a nil Child and an error indicating that the relation definition was not found.
 		return nil, compileError(token.PositionInfo{}, base.ErrorCode_ERROR_CODE_RELATION_DEFINITION_NOT_FOUND.String())
@@ -484,6 +507,87 @@ func (t *Compiler) compileCall(entityName string, call *ast.Call) (*base.Child,
 	return child, nil
 }
 
+// compileAggregation compiles an aggregation such as count(approver) >= 2 into a base.Child
+// containing a Count leaf. Only relations with direct subject types can be counted.
+func (t *Compiler) compileAggregation(entityName string, aggregation *ast.Aggregation) (*base.Child, error) {
+	// An aggregation can only be applied to a relation of the entity itself.
+	if len(aggregation.Relation.Idents) != 1 {
+		return nil, compileError(aggregation.Relation.Idents[len(aggregation.Relation.Idents)-1].PositionInfo, base.ErrorCode_ERROR_CODE_NOT_SUPPORTED_RELATION_WALK.String())
+	}
+
+	relation := aggregation.Relation.Idents[0]
+
+	// If reference validation is enabled, the counted identifier must be a relation
+	// whose types are all direct subjects, since usersets can not be counted without expanding them.
+	if t.withReferenceValidation {
+		types, exist := t.schema.GetReferences().GetRelationReferenceTypesIfExist(utils.Key(entityName, relation.Literal))
+		if !exist {
+			return nil, compileError(relation.PositionInfo, base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE.String())
+		}
+
+		for _, typ := range types {
+			if typ.Relation.Literal != "" {
+				return nil, compileError(relation.PositionInfo, base.ErrorCode_ERROR_CODE_NOT_SUPPORTED_COUNT.String())
+			}
+		}
+	}
+
+	// Map the comparison operator to its base representation.
+	var comparison base.Count_Comparison
+	switch aggregation.Comparison.Literal {
+	case "==":
+		comparison = base.Count_COMPARISON_EQUAL
+	case "!=":
+		comparison = base.Count_COMPARISON_NOT_EQUAL
+	case ">":
+		comparison = base.Count_COMPARISON_GREATER_THAN
+	case ">=":
+		comparison = base.Count_COMPARISON_GREATER_THAN_OR_EQUAL
+	case "<":
+		comparison = base.Count_COMPARISON_LESS_THAN
+	case "<=":
+		comparison = base.Count_COMPARISON_LESS_THAN_OR_EQUAL
+	default:
+		return nil, compileError(aggregation.Comparison.PositionInfo, base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+	}
+
+	value, err := strconv.ParseUint(aggregation.Value.Literal, 10, 32)
+	if err != nil {
+		return nil, compileError(aggregation.Value.PositionInfo, base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+	}
+
+	return &base.Child{
+		Type: &base.Child_Leaf{Leaf: &base.Leaf{
+			Type: &base.Leaf_Count{Count: &base.Count{
+				Relation:   relation.Literal,
+				Comparison: comparison,
+				Value:      uint32(value),
+			}},
+		}},
+	}, nil
+}
+
+// compileAnnotations converts the annotations of a statement into a base.Annotations object.
+// It returns nil if the statement has no annotations.
+func compileAnnotations(annotations []ast.Annotation) *base.Annotations {
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	result := &base.Annotations{}
+	for _, annotation := range annotations {
+		switch annotation.Name.Literal {
+		case ast.DESCRIPTION:
+			result.Description = annotation.Value.Literal
+		case ast.OWNER:
+			result.Owner = annotation.Value.Literal
+		case ast.DEPRECATED:
+			result.Deprecated = true
+		}
+	}
+	return result
+}
+
 // compileComputedUserSetIdentifier takes a string that represents a user set relation
 // and compiles it into a base.Leaf object containing that relation. It returns the resulting Leaf and no error.
 func (t *Compiler) compileComputedUserSetIdentifier(r string) (l *base.Leaf, err error) {
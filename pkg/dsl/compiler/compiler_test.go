@@ -2116,5 +2116,197 @@ var _ = Describe("compiler", func() {
 
 			Expect(err.Error()).Should(Equal("15:29: schema compile"))
 		})
+
+		It("Case 23", func() {
+			sch, err := parser.NewParser(`
+				entity user {}
+
+				entity folder {}
+
+				entity document {
+					relation parent @folder single
+					relation owner @user single replace
+					relation reviewer @user single reject
+					relation viewer @user
+				}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+
+			var is []*base.EntityDefinition
+			is, _, err = c.Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			relations := is[2].GetRelations()
+
+			Expect(relations["parent"].GetCardinality()).Should(Equal(base.RelationDefinition_CARDINALITY_SINGLE))
+			Expect(relations["parent"].GetOnConflict()).Should(Equal(base.RelationDefinition_ON_CONFLICT_REJECT))
+
+			Expect(relations["owner"].GetCardinality()).Should(Equal(base.RelationDefinition_CARDINALITY_SINGLE))
+			Expect(relations["owner"].GetOnConflict()).Should(Equal(base.RelationDefinition_ON_CONFLICT_REPLACE))
+
+			Expect(relations["reviewer"].GetCardinality()).Should(Equal(base.RelationDefinition_CARDINALITY_SINGLE))
+			Expect(relations["reviewer"].GetOnConflict()).Should(Equal(base.RelationDefinition_ON_CONFLICT_REJECT))
+
+			Expect(relations["viewer"].GetCardinality()).Should(Equal(base.RelationDefinition_CARDINALITY_UNSPECIFIED))
+			Expect(relations["viewer"].GetOnConflict()).Should(Equal(base.RelationDefinition_ON_CONFLICT_UNSPECIFIED))
+		})
+
+		It("Case 24", func() {
+			sch, err := parser.NewParser(`
+				entity user {}
+
+				entity document {
+					relation approver @user
+					relation owner @user
+
+					permission publish = count(approver) >= 2 and owner
+				}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+
+			var is []*base.EntityDefinition
+			is, _, err = c.Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(is[1].GetPermissions()["publish"]).Should(Equal(&base.PermissionDefinition{
+				Name: "publish",
+				Child: &base.Child{
+					Type: &base.Child_Rewrite{
+						Rewrite: &base.Rewrite{
+							RewriteOperation: base.Rewrite_OPERATION_INTERSECTION,
+							Children: []*base.Child{
+								{
+									Type: &base.Child_Leaf{
+										Leaf: &base.Leaf{
+											Type: &base.Leaf_Count{
+												Count: &base.Count{
+													Relation:   "approver",
+													Comparison: base.Count_COMPARISON_GREATER_THAN_OR_EQUAL,
+													Value:      2,
+												},
+											},
+										},
+									},
+								},
+								{
+									Type: &base.Child_Leaf{
+										Leaf: &base.Leaf{
+											Type: &base.Leaf_ComputedUserSet{
+												ComputedUserSet: &base.ComputedUserSet{
+													Relation: "owner",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}))
+		})
+
+		It("Case 25", func() {
+			sch, err := parser.NewParser(`
+				entity user {}
+
+				entity team {
+					relation member @user
+				}
+
+				entity document {
+					relation approver @user @team#member
+
+					permission publish = count(approver) >= 2
+				}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+
+			_, _, err = c.Compile()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("not supported count"))
+
+			sch, err = parser.NewParser(`
+				entity user {}
+
+				entity document {
+					relation approver @user
+
+					permission publish = count(reviewer) >= 2
+				}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c = NewCompiler(true, sch)
+
+			_, _, err = c.Compile()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("undefined relation reference"))
+		})
+
+		It("Case 26", func() {
+			sch, err := parser.NewParser(`
+				entity user {}
+
+				@description("Shared document")
+				@owner("team-docs")
+				entity document {
+					@deprecated
+					relation viewer @user
+
+					@owner("team-growth")
+					attribute public boolean
+
+					relation owner @user
+
+					@description("Who can view the document")
+					permission view = owner or viewer
+				}
+
+				@deprecated
+				rule check_public(public boolean) {
+					public == true
+				}
+			`).Parse()
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			c := NewCompiler(true, sch)
+
+			is, rs, err := c.Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(is[0].GetAnnotations()).Should(BeNil())
+
+			document := is[1]
+			Expect(document.GetAnnotations()).Should(Equal(&base.Annotations{
+				Description: "Shared document",
+				Owner:       "team-docs",
+			}))
+			Expect(document.GetRelations()["viewer"].GetAnnotations()).Should(Equal(&base.Annotations{
+				Deprecated: true,
+			}))
+			Expect(document.GetRelations()["owner"].GetAnnotations()).Should(BeNil())
+			Expect(document.GetAttributes()["public"].GetAnnotations()).Should(Equal(&base.Annotations{
+				Owner: "team-growth",
+			}))
+			Expect(document.GetPermissions()["view"].GetAnnotations()).Should(Equal(&base.Annotations{
+				Description: "Who can view the document",
+			}))
+
+			Expect(rs[0].GetAnnotations()).Should(Equal(&base.Annotations{
+				Deprecated: true,
+			}))
+		})
 	})
 })
@@ -0,0 +1,233 @@
+package formatter
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Permify/permify/pkg/dsl/ast"
+	"github.com/Permify/permify/pkg/dsl/parser"
+	"github.com/Permify/permify/pkg/dsl/token"
+)
+
+// indentation is the indentation used for the statements inside entities and rules
+const indentation = "    "
+
+// Format parses the given schema and returns it rewritten in the canonical style.
+// Comments are preserved: comments on their own lines stay above the statement that follows them,
+// and comments at the end of a line stay at the end of that line.
+func Format(schema string) (string, error) {
+	sch, err := parser.NewParser(schema).Parse()
+	if err != nil {
+		return "", err
+	}
+	return Print(sch), nil
+}
+
+// Print writes the parsed schema in the canonical style:
+//   - statements are separated by a single blank line
+//   - entity bodies list relations, attributes and permissions in this order, each group separated by a blank line
+//   - nested statements and rule bodies are indented with four spaces
+//   - operators and relation types are separated by a single space
+func Print(sch *ast.Schema) string {
+	var sb strings.Builder
+
+	for i, statement := range sch.Statements {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		switch st := statement.(type) {
+		case *ast.EntityStatement:
+			writeEntity(&sb, st)
+		case *ast.RuleStatement:
+			writeRule(&sb, st)
+		}
+	}
+
+	if len(sch.Comments) > 0 {
+		if len(sch.Statements) > 0 {
+			sb.WriteString("\n")
+		}
+		writeComments(&sb, "", sch.Comments)
+	}
+
+	return sb.String()
+}
+
+// writeEntity writes an entity statement with its relations, attributes and permissions.
+func writeEntity(sb *strings.Builder, st *ast.EntityStatement) {
+	writeComments(sb, "", st.LeadingComments)
+	writeAnnotations(sb, "", st.Annotations)
+
+	sb.WriteString("entity ")
+	sb.WriteString(st.Name.Literal)
+
+	if len(st.RelationStatements) == 0 && len(st.AttributeStatements) == 0 && len(st.PermissionStatements) == 0 &&
+		len(st.TrailingComments) == 0 && len(st.ClosingComments) == 0 {
+		sb.WriteString(" {}\n")
+		return
+	}
+
+	sb.WriteString(" {")
+	writeTrailingComments(sb, st.TrailingComments)
+	sb.WriteString("\n")
+
+	groups := [][]ast.Statement{st.RelationStatements, st.AttributeStatements, st.PermissionStatements}
+	written := false
+	for _, group := range groups {
+		if len(group) == 0 {
+			continue
+		}
+		if written {
+			sb.WriteString("\n")
+		}
+		for _, statement := range group {
+			writeEntityMember(sb, statement)
+		}
+		written = true
+	}
+
+	writeComments(sb, indentation, st.ClosingComments)
+	sb.WriteString("}\n")
+}
+
+// writeEntityMember writes a relation, attribute or permission statement on its own line.
+func writeEntityMember(sb *strings.Builder, statement ast.Statement) {
+	switch st := statement.(type) {
+	case *ast.RelationStatement:
+		writeComments(sb, indentation, st.LeadingComments)
+		writeAnnotations(sb, indentation, st.Annotations)
+		sb.WriteString(indentation)
+		sb.WriteString("relation ")
+		sb.WriteString(st.Name.Literal)
+		for _, typ := range st.RelationTypes {
+			sb.WriteString(" ")
+			sb.WriteString(typ.String())
+		}
+		if st.Cardinality.Literal != "" {
+			sb.WriteString(" ")
+			sb.WriteString(st.Cardinality.Literal)
+			if st.OnConflict.Literal != "" {
+				sb.WriteString(" ")
+				sb.WriteString(st.OnConflict.Literal)
+			}
+		}
+		writeTrailingComments(sb, st.TrailingComments)
+	case *ast.AttributeStatement:
+		writeComments(sb, indentation, st.LeadingComments)
+		writeAnnotations(sb, indentation, st.Annotations)
+		sb.WriteString(indentation)
+		sb.WriteString("attribute ")
+		sb.WriteString(st.Name.Literal)
+		sb.WriteString(" ")
+		sb.WriteString(st.AttributeType.String())
+		writeTrailingComments(sb, st.TrailingComments)
+	case *ast.PermissionStatement:
+		writeComments(sb, indentation, st.LeadingComments)
+		writeAnnotations(sb, indentation, st.Annotations)
+		sb.WriteString(indentation)
+		sb.WriteString("permission ")
+		sb.WriteString(st.Name.Literal)
+		sb.WriteString(" = ")
+		if es, ok := st.ExpressionStatement.(*ast.ExpressionStatement); ok && es.Expression != nil {
+			sb.WriteString(formatExpression(es.Expression))
+		}
+		writeTrailingComments(sb, st.TrailingComments)
+	}
+	sb.WriteString("\n")
+}
+
+// writeRule writes a rule statement, keeping the arguments in their declaration order.
+func writeRule(sb *strings.Builder, st *ast.RuleStatement) {
+	writeComments(sb, "", st.LeadingComments)
+	writeAnnotations(sb, "", st.Annotations)
+
+	sb.WriteString("rule ")
+	sb.WriteString(st.Name.Literal)
+	sb.WriteString("(")
+
+	// the arguments are kept in a map, so they are sorted by their position in the source
+	names := make([]token.Token, 0, len(st.Arguments))
+	for name := range st.Arguments {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if names[i].PositionInfo.LinePosition != names[j].PositionInfo.LinePosition {
+			return names[i].PositionInfo.LinePosition < names[j].PositionInfo.LinePosition
+		}
+		return names[i].PositionInfo.ColumnPosition < names[j].PositionInfo.ColumnPosition
+	})
+
+	arguments := make([]string, 0, len(names))
+	for _, name := range names {
+		typ := st.Arguments[name]
+		arguments = append(arguments, name.Literal+" "+typ.String())
+	}
+	sb.WriteString(strings.Join(arguments, ", "))
+
+	sb.WriteString(") {")
+	writeTrailingComments(sb, st.TrailingComments)
+	sb.WriteString("\n")
+
+	// every line of the body is indented once, blank lines around the body are dropped
+	lines := strings.Split(strings.TrimSpace(st.Expression), "\n")
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line != "" {
+			sb.WriteString(indentation)
+			sb.WriteString(line)
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("}\n")
+}
+
+// formatExpression writes a permission expression with single spaces around the operators.
+// Parentheses are only kept where they are needed, or where they make the grouping of
+// different operators explicit.
+func formatExpression(expression ast.Expression) string {
+	infix, ok := expression.(*ast.InfixExpression)
+	if !ok {
+		return expression.String()
+	}
+	return formatOperand(infix.Left, infix.Operator, false) + " " + infix.Operator.String() + " " + formatOperand(infix.Right, infix.Operator, true)
+}
+
+// formatOperand writes an operand of an infix expression. Operators are left associative and share the
+// same precedence, so an operand is wrapped in parentheses if it is on the right side or uses a different operator.
+func formatOperand(expression ast.Expression, operator ast.Operator, right bool) string {
+	infix, ok := expression.(*ast.InfixExpression)
+	if !ok {
+		return expression.String()
+	}
+	if right || infix.Operator != operator {
+		return "(" + formatExpression(infix) + ")"
+	}
+	return formatExpression(infix)
+}
+
+// writeAnnotations writes each annotation on its own line.
+func writeAnnotations(sb *strings.Builder, indent string, annotations []ast.Annotation) {
+	for _, annotation := range annotations {
+		sb.WriteString(indent)
+		sb.WriteString(annotation.String())
+		sb.WriteString("\n")
+	}
+}
+
+// writeComments writes each comment on its own line.
+func writeComments(sb *strings.Builder, indent string, comments []ast.Comment) {
+	for _, comment := range comments {
+		sb.WriteString(indent)
+		sb.WriteString(strings.TrimRight(comment.String(), " \t"))
+		sb.WriteString("\n")
+	}
+}
+
+// writeTrailingComments writes the comments at the end of the current line.
+func writeTrailingComments(sb *strings.Builder, comments []ast.Comment) {
+	for _, comment := range comments {
+		sb.WriteString(" ")
+		sb.WriteString(strings.TrimRight(comment.String(), " \t"))
+	}
+}
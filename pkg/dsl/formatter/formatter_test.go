@@ -0,0 +1,155 @@
+package formatter
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// TestFormatter -
+func TestFormatter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "formatter-suite")
+}
+
+var _ = Describe("formatter", func() {
+	Context("Format", func() {
+		It("Case 1 - Canonical indentation, ordering and operator spacing", func() {
+			formatted, err := Format(`
+entity user {}
+	entity   document {
+		permission view = (owner or   editor) or viewer
+	action edit = owner or (editor and viewer)
+
+		relation owner @user
+  attribute public   boolean
+		relation editor   @user @organization#member single   reject
+		relation viewer @user
+	}`)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(formatted).Should(Equal(`entity user {}
+
+entity document {
+    relation owner @user
+    relation editor @user @organization#member single reject
+    relation viewer @user
+
+    attribute public boolean
+
+    permission view = owner or editor or viewer
+    permission edit = owner or (editor and viewer)
+}
+`))
+		})
+
+		It("Case 2 - Parentheses around different operators are kept", func() {
+			formatted, err := Format(`
+entity document {
+    relation owner @user
+    relation editor @user
+    relation banned @user
+
+    permission edit = (owner or editor) and owner
+    permission view = ((owner or editor) not banned) and count(editor) >= 2
+}`)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(formatted).Should(ContainSubstring("    permission edit = (owner or editor) and owner\n"))
+			Expect(formatted).Should(ContainSubstring("    permission view = ((owner or editor) not banned) and count(editor) >= 2\n"))
+		})
+
+		It("Case 3 - Comments are preserved", func() {
+			formatted, err := Format(`// Schema of the documents service
+
+entity user {}
+
+// Documents shared within an organization
+entity document { // document entity
+	relation owner @user // the creator
+	// viewers are granted explicitly
+	relation viewer @user
+
+	permission view = owner or  // owners can always view
+		viewer
+	// TODO: add editors
+}
+
+rule is_public(public boolean) {
+	// public documents are visible to everyone
+	public == true
+}
+
+/* end of schema */`)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(formatted).Should(Equal(`// Schema of the documents service
+entity user {}
+
+// Documents shared within an organization
+entity document { // document entity
+    relation owner @user // the creator
+    // viewers are granted explicitly
+    relation viewer @user
+
+    permission view = owner or viewer // owners can always view
+    // TODO: add editors
+}
+
+rule is_public(public boolean) {
+    // public documents are visible to everyone
+    public == true
+}
+
+/* end of schema */
+`))
+		})
+
+		It("Case 4 - Annotations and rule arguments", func() {
+			formatted, err := Format(`
+@owner("team-docs")
+entity document {
+	@deprecated
+	relation viewer @user
+	attribute ip_range string[]
+	permission view = check_ip(ip_range, request.ip) and viewer
+}
+
+rule check_ip(ip_range string[], ip string) {
+		ip in ip_range
+}`)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(formatted).Should(Equal(`@owner("team-docs")
+entity document {
+    @deprecated
+    relation viewer @user
+
+    attribute ip_range string[]
+
+    permission view = check_ip(ip_range, request.ip) and viewer
+}
+
+rule check_ip(ip_range string[], ip string) {
+    ip in ip_range
+}
+`))
+		})
+
+		It("Case 5 - Formatting is idempotent", func() {
+			formatted, err := Format(`
+// comment
+entity document { relation owner @user // owner
+permission view = owner }
+rule check(a integer) { a > 1 // at least two
+}`)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			again, err := Format(formatted)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(again).Should(Equal(formatted))
+		})
+
+		It("Case 6 - Invalid schemas are not formatted", func() {
+			_, err := Format(`entity document { relation owner user }`)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
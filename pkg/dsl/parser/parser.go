@@ -45,6 +45,8 @@ type Parser struct {
 	infixParseFunc map[token.Type]infixParseFn
 	// references to entities, rules, relations, attributes, and permissions
 	references *ast.References
+	// comments skipped by next that have not been attached to a statement yet
+	comments []ast.Comment
 }
 
 type (
@@ -82,6 +84,13 @@ func (p *Parser) next() {
 	for {
 		// retrieve the next token from the lexer
 		peek := p.l.NextToken()
+		// keep the comments so they can be attached to the statements around them
+		if peek.Type == token.SINGLE_LINE_COMMENT || peek.Type == token.MULTI_LINE_COMMENT {
+			p.comments = append(p.comments, ast.Comment{
+				Token:  peek,
+				Inline: p.peekToken.Type != "" && p.peekToken.Type != token.NEWLINE && p.peekToken.PositionInfo.LinePosition == peek.PositionInfo.LinePosition,
+			})
+		}
 		// if the token is not an ignored token (e.g. whitespace or comments), update the currentToken and peekToken fields and exit the loop
 		if !token.IsIgnores(peek.Type) {
 			// store the current token as previous before advancing
@@ -109,6 +118,46 @@ func (p *Parser) nextWithIgnores() {
 	p.peekToken = peek
 }
 
+// takeComments removes and returns the pending comments that appear before the given token
+func (p *Parser) takeComments(before token.Token) []ast.Comment {
+	var taken []ast.Comment
+	remaining := p.comments[:0]
+	for _, comment := range p.comments {
+		if isBefore(comment.Token, before) {
+			taken = append(taken, comment)
+		} else {
+			remaining = append(remaining, comment)
+		}
+	}
+	p.comments = remaining
+	return taken
+}
+
+// isBefore checks if the first token is positioned before the second one in the input
+func isBefore(first, second token.Token) bool {
+	if first.PositionInfo.LinePosition != second.PositionInfo.LinePosition {
+		return first.PositionInfo.LinePosition < second.PositionInfo.LinePosition
+	}
+	return first.PositionInfo.ColumnPosition < second.PositionInfo.ColumnPosition
+}
+
+// takeTrailingComments removes and returns the pending comments at the end of the lines between
+// the given token and the current token, e.g. the lines of a multi-line permission expression
+func (p *Parser) takeTrailingComments(from token.Token) []ast.Comment {
+	var taken []ast.Comment
+	remaining := p.comments[:0]
+	for _, comment := range p.comments {
+		line := comment.Token.PositionInfo.LinePosition
+		if comment.Inline && line >= from.PositionInfo.LinePosition && line <= p.currentToken.PositionInfo.LinePosition {
+			taken = append(taken, comment)
+		} else {
+			remaining = append(remaining, comment)
+		}
+	}
+	p.comments = remaining
+	return taken
+}
+
 // currentTokenIs checks if the Parser's currentToken is any of the given token types
 func (p *Parser) currentTokenIs(tokens ...token.Type) bool {
 	// iterate through the given token types and check if any of them match the currentToken's type
@@ -177,6 +226,10 @@ func (p *Parser) Parse() (*ast.Schema, error) {
 		p.next()
 	}
 
+	// the comments left after the last statement belong to the schema itself
+	schema.Comments = p.comments
+	p.comments = nil
+
 	schema.SetReferences(p.references)
 
 	// return the parsed schema object and nil to indicate that there were no errors
@@ -199,13 +252,42 @@ func (p *Parser) ParsePartial(entityName string) (ast.Statement, error) {
 }
 
 func (p *Parser) parsePartialStatement(entityName string) (ast.Statement, error) {
+	// parse the annotations preceding the statement, if any
+	var annotations []ast.Annotation
+	if p.currentTokenIs(token.SIGN) {
+		var err error
+		annotations, err = p.parseAnnotations()
+		if err != nil {
+			return nil, err
+		}
+		if !p.currentTokenIs(token.ATTRIBUTE, token.RELATION, token.PERMISSION) {
+			p.currentError(token.RELATION, token.PERMISSION, token.ATTRIBUTE)
+			return nil, p.Error()
+		}
+	}
+
 	switch p.currentToken.Type {
 	case token.ATTRIBUTE:
-		return p.parseAttributeStatement(entityName)
+		stmt, err := p.parseAttributeStatement(entityName)
+		if err != nil {
+			return nil, err
+		}
+		stmt.Annotations = annotations
+		return stmt, nil
 	case token.RELATION:
-		return p.parseRelationStatement(entityName)
+		stmt, err := p.parseRelationStatement(entityName)
+		if err != nil {
+			return nil, err
+		}
+		stmt.Annotations = annotations
+		return stmt, nil
 	case token.PERMISSION:
-		return p.parsePermissionStatement(entityName)
+		stmt, err := p.parsePermissionStatement(entityName)
+		if err != nil {
+			return nil, err
+		}
+		stmt.Annotations = annotations
+		return stmt, nil
 	default:
 		return nil, nil
 	}
@@ -221,15 +303,103 @@ func (p *Parser) parseStatement() (ast.Statement, error) {
 	case token.RULE:
 		// if the currentToken is RULE, parse a RuleStatement
 		return p.parseRuleStatement()
+	case token.SIGN:
+		// if the currentToken is SIGN, parse the annotations and attach them to the following statement
+		return p.parseAnnotatedStatement()
 	default:
 		return nil, nil
 	}
 }
 
+// parseAnnotatedStatement parses the annotations preceding an ENTITY or RULE statement,
+// then the statement itself, and attaches the annotations to it
+func (p *Parser) parseAnnotatedStatement() (ast.Statement, error) {
+	leadingComments := p.takeComments(p.currentToken)
+	annotations, err := p.parseAnnotations()
+	if err != nil {
+		return nil, err
+	}
+
+	switch p.currentToken.Type {
+	case token.ENTITY:
+		stmt, err := p.parseEntityStatement()
+		if err != nil {
+			return nil, err
+		}
+		stmt.Annotations = annotations
+		stmt.LeadingComments = append(leadingComments, stmt.LeadingComments...)
+		return stmt, nil
+	case token.RULE:
+		stmt, err := p.parseRuleStatement()
+		if err != nil {
+			return nil, err
+		}
+		stmt.Annotations = annotations
+		stmt.LeadingComments = append(leadingComments, stmt.LeadingComments...)
+		return stmt, nil
+	default:
+		p.currentError(token.ENTITY, token.RULE)
+		return nil, p.Error()
+	}
+}
+
+// parseAnnotations parses consecutive annotations such as @description("...") or @deprecated,
+// each followed by optional newlines. The current token is left on the token following the last annotation.
+func (p *Parser) parseAnnotations() ([]ast.Annotation, error) {
+	var annotations []ast.Annotation
+	seen := map[string]struct{}{}
+
+	for p.currentTokenIs(token.SIGN) {
+		annotation := ast.Annotation{Sign: p.currentToken}
+
+		// expect the annotation name after the SIGN token
+		if !p.expectAndNext(token.IDENT) {
+			return nil, p.Error()
+		}
+		annotation.Name = p.currentToken
+
+		switch annotation.Name.Literal {
+		case ast.DESCRIPTION, ast.OWNER:
+			// annotations with a value take a single string argument, e.g. @owner("team-billing")
+			if !p.expectAndNext(token.LP) {
+				return nil, p.Error()
+			}
+			if !p.expectAndNext(token.STRING) {
+				return nil, p.Error()
+			}
+			annotation.Value = p.currentToken
+			if !p.expectAndNext(token.RP) {
+				return nil, p.Error()
+			}
+		case ast.DEPRECATED:
+		default:
+			p.annotationError(ast.DESCRIPTION, ast.OWNER, ast.DEPRECATED)
+			return nil, p.Error()
+		}
+
+		// a statement can have each annotation at most once
+		if _, ok := seen[annotation.Name.Literal]; ok {
+			p.duplicationError(annotation.Name.Literal)
+			return nil, p.Error()
+		}
+		seen[annotation.Name.Literal] = struct{}{}
+
+		annotations = append(annotations, annotation)
+
+		// skip the newlines between the annotation and the next annotation or statement
+		p.next()
+		for p.currentTokenIs(token.NEWLINE) {
+			p.next()
+		}
+	}
+
+	return annotations, nil
+}
+
 // parseEntityStatement method parses an ENTITY statement and returns an EntityStatement AST node
 func (p *Parser) parseEntityStatement() (*ast.EntityStatement, error) {
 	// create a new EntityStatement object and set its Entity field to the currentToken
-	stmt := &ast.EntityStatement{Entity: p.currentToken}
+	stmt := &ast.EntityStatement{Entity: p.currentToken, LeadingComments: p.takeComments(p.currentToken)}
 	// expect the next token to be an identifier token, and set the EntityStatement's Name field to the identifier's value
 	if !p.expectAndNext(token.IDENT) {
 		return nil, p.Error()
@@ -247,6 +417,7 @@ func (p *Parser) parseEntityStatement() (*ast.EntityStatement, error) {
 	if !p.expectAndNext(token.LCB) {
 		return nil, p.Error()
 	}
+	stmt.TrailingComments = p.takeTrailingComments(p.currentToken)
 
 	// loop through the entity's body until a right brace token is encountered
 	for !p.currentTokenIs(token.RCB) {
@@ -255,6 +426,20 @@ func (p *Parser) parseEntityStatement() (*ast.EntityStatement, error) {
 			p.currentError(token.RCB)
 			return nil, p.Error()
 		}
+		// parse the annotations preceding the next relation, attribute or permission, if any
+		var annotations []ast.Annotation
+		var leadingComments []ast.Comment
+		if p.currentTokenIs(token.SIGN) {
+			leadingComments = p.takeComments(p.currentToken)
+			annotations, err = p.parseAnnotations()
+			if err != nil {
+				return nil, p.Error()
+			}
+			if !p.currentTokenIs(token.RELATION, token.ATTRIBUTE, token.PERMISSION) {
+				p.currentError(token.RELATION, token.PERMISSION, token.ATTRIBUTE)
+				return nil, p.Error()
+			}
+		}
 		// based on the currentToken's type, parse a RelationStatement or PermissionStatement and add it to the EntityStatement's corresponding field
 		switch p.currentToken.Type {
 		case token.RELATION:
@@ -262,18 +447,24 @@ func (p *Parser) parseEntityStatement() (*ast.EntityStatement, error) {
 			if err != nil {
 				return nil, p.Error()
 			}
+			relation.Annotations = annotations
+			relation.LeadingComments = append(leadingComments, relation.LeadingComments...)
 			stmt.RelationStatements = append(stmt.RelationStatements, relation)
 		case token.ATTRIBUTE:
 			attribute, err := p.parseAttributeStatement(stmt.Name.Literal)
 			if err != nil {
 				return nil, p.Error()
 			}
+			attribute.Annotations = annotations
+			attribute.LeadingComments = append(leadingComments, attribute.LeadingComments...)
 			stmt.AttributeStatements = append(stmt.AttributeStatements, attribute)
 		case token.PERMISSION:
 			action, err := p.parsePermissionStatement(stmt.Name.Literal)
 			if err != nil {
 				return nil, p.Error()
 			}
+			action.Annotations = annotations
+			action.LeadingComments = append(leadingComments, action.LeadingComments...)
 			stmt.PermissionStatements = append(stmt.PermissionStatements, action)
 		default:
 			// if the currentToken is not recognized, check if it is a newline, left brace, or right brace token, and skip it if it is
@@ -286,6 +477,7 @@ func (p *Parser) parseEntityStatement() (*ast.EntityStatement, error) {
 		// move to the next token in the input string
 		p.next()
 	}
+	stmt.ClosingComments = p.takeComments(p.currentToken)
 
 	// return the parsed EntityStatement and nil for the error value
 	return stmt, nil
@@ -300,7 +492,7 @@ func (p *Parser) parseEntityStatement() (*ast.EntityStatement, error) {
 // This method assumes the current token points to the 'rule' token when it is called.
 func (p *Parser) parseRuleStatement() (*ast.RuleStatement, error) {
 	// Create a new RuleStatement
-	stmt := &ast.RuleStatement{Rule: p.currentToken}
+	stmt := &ast.RuleStatement{Rule: p.currentToken, LeadingComments: p.takeComments(p.currentToken)}
 
 	// Expect the next token to be an identifier (the name of the rule).
 	// If it's not an identifier, return an error.
@@ -373,9 +565,14 @@ func (p *Parser) parseRuleStatement() (*ast.RuleStatement, error) {
 	if !p.expectAndNext(token.LCB) {
 		return nil, p.Error()
 	}
+	stmt.TrailingComments = p.takeTrailingComments(p.currentToken)
 
 	p.next()
 
+	// The comments at the beginning of the body have already been skipped by the lookahead,
+	// they are put back in their place among the body tokens.
+	bodyComments := p.takeComments(p.peekToken)
+
 	// Collect tokens for the body until a closing curly bracket '}' is encountered.
 	var bodyTokens []token.Token
 	for !p.peekTokenIs(token.RCB) {
@@ -385,6 +582,10 @@ func (p *Parser) parseRuleStatement() (*ast.RuleStatement, error) {
 			return nil, p.Error()
 		}
 
+		for len(bodyComments) > 0 && isBefore(bodyComments[0].Token, p.currentToken) {
+			bodyTokens = append(bodyTokens, bodyComments[0].Token)
+			bodyComments = bodyComments[1:]
+		}
 		bodyTokens = append(bodyTokens, p.currentToken)
 		p.nextWithIgnores()
 	}
@@ -392,7 +593,14 @@ func (p *Parser) parseRuleStatement() (*ast.RuleStatement, error) {
 	// Combine all the body tokens into a single string
 	var bodyStr strings.Builder
 	for _, t := range bodyTokens {
-		bodyStr.WriteString(t.Literal)
+		switch t.Type {
+		case token.SINGLE_LINE_COMMENT, token.MULTI_LINE_COMMENT:
+			// the lexer strips the comment delimiters, write them back so the comment stays a comment
+			comment := ast.Comment{Token: t}
+			bodyStr.WriteString(comment.String())
+		default:
+			bodyStr.WriteString(t.Literal)
+		}
 	}
 	stmt.Expression = bodyStr.String()
 
@@ -416,7 +624,7 @@ func (p *Parser) parseRuleStatement() (*ast.RuleStatement, error) {
 // parseRelationStatement method parses a RELATION statement and returns a RelationStatement AST node
 func (p *Parser) parseAttributeStatement(entityName string) (*ast.AttributeStatement, error) {
 	// create a new RelationStatement object and set its Relation field to the currentToken
-	stmt := &ast.AttributeStatement{Attribute: p.currentToken}
+	stmt := &ast.AttributeStatement{Attribute: p.currentToken, LeadingComments: p.takeComments(p.currentToken)}
 
 	// expect the next token to be an identifier token, and set the RelationStatement's Name field to the identifier's value
 	if !p.expectAndNext(token.IDENT) {
@@ -448,6 +656,7 @@ func (p *Parser) parseAttributeStatement(entityName string) (*ast.AttributeState
 		p.duplicationError(key) // Generate an error message indicating a duplication error
 		return nil, p.Error()
 	}
+	stmt.TrailingComments = p.takeTrailingComments(stmt.Attribute)
 
 	// return the parsed RelationStatement and nil for the error value
 	return stmt, nil
@@ -456,7 +665,7 @@ func (p *Parser) parseAttributeStatement(entityName string) (*ast.AttributeState
 // parseRelationStatement method parses a RELATION statement and returns a RelationStatement AST node
 func (p *Parser) parseRelationStatement(entityName string) (*ast.RelationStatement, error) {
 	// create a new RelationStatement object and set its Relation field to the currentToken
-	stmt := &ast.RelationStatement{Relation: p.currentToken}
+	stmt := &ast.RelationStatement{Relation: p.currentToken, LeadingComments: p.takeComments(p.currentToken)}
 
 	// expect the next token to be an identifier token, and set the RelationStatement's Name field to the identifier's value
 	if !p.expectAndNext(token.IDENT) {
@@ -480,6 +689,14 @@ func (p *Parser) parseRelationStatement(entityName string) (*ast.RelationStateme
 		stmt.RelationTypes = append(stmt.RelationTypes, *relationStatement)
 	}
 
+	// parse the optional cardinality modifier, e.g. "relation parent @folder single replace"
+	if p.peekTokenIs(token.IDENT) {
+		err := p.parseRelationCardinality(stmt)
+		if err != nil {
+			return nil, p.Error()
+		}
+	}
+
 	key := utils.Key(entityName, relationName)
 
 	// add the relation reference to the Parser's relationReferences and relationalReferences maps
@@ -488,11 +705,38 @@ func (p *Parser) parseRelationStatement(entityName string) (*ast.RelationStateme
 		p.duplicationError(key) // Generate an error message indicating a duplication error
 		return nil, p.Error()
 	}
+	stmt.TrailingComments = p.takeTrailingComments(stmt.Relation)
 
 	// return the parsed RelationStatement and nil for the error value
 	return stmt, nil
 }
 
+// parseRelationCardinality parses the cardinality modifier that may follow the relation types of a RELATION statement.
+// The modifier is "single", optionally followed by the conflict behaviour "reject" or "replace".
+func (p *Parser) parseRelationCardinality(stmt *ast.RelationStatement) error {
+	// the cardinality modifier must be "single"
+	if !p.expectAndNext(token.IDENT) {
+		return p.Error()
+	}
+	if p.currentToken.Literal != ast.SINGLE {
+		p.modifierError(ast.SINGLE)
+		return p.Error()
+	}
+	stmt.Cardinality = p.currentToken
+
+	// the conflict behaviour is optional and defaults to "reject"
+	if p.peekTokenIs(token.IDENT) {
+		p.next()
+		if p.currentToken.Literal != ast.REJECT && p.currentToken.Literal != ast.REPLACE {
+			p.modifierError(ast.REJECT, ast.REPLACE)
+			return p.Error()
+		}
+		stmt.OnConflict = p.currentToken
+	}
+
+	return nil
+}
+
 // parseRelationTypeStatement method parses a single relation type within a RELATION statement and returns a RelationTypeStatement AST node
 func (p *Parser) parseRelationTypeStatement() (*ast.RelationTypeStatement, error) {
 	// expect the currentToken to be a SIGN token, indicating the start of the relation type
@@ -522,9 +766,9 @@ func (p *Parser) parseRelationTypeStatement() (*ast.RelationTypeStatement, error
 }
 
 // parsePermissionStatement method parses an PERMISSION statement and returns an PermissionStatement AST node
-func (p *Parser) parsePermissionStatement(entityName string) (ast.Statement, error) {
+func (p *Parser) parsePermissionStatement(entityName string) (*ast.PermissionStatement, error) {
 	// create a new PermissionStatement object and set its Permission field to the currentToken
-	stmt := &ast.PermissionStatement{Permission: p.currentToken}
+	stmt := &ast.PermissionStatement{Permission: p.currentToken, LeadingComments: p.takeComments(p.currentToken)}
 
 	// expect the next token to be an identifier token, and set the PermissionStatement's Name field to the identifier's value
 	if !p.expectAndNext(token.IDENT) {
@@ -553,6 +797,7 @@ func (p *Parser) parsePermissionStatement(entityName string) (ast.Statement, err
 		return nil, p.Error()
 	}
 	stmt.ExpressionStatement = ex
+	stmt.TrailingComments = p.takeTrailingComments(stmt.Permission)
 
 	// return the parsed PermissionStatement and nil for the error value
 	return stmt, nil
@@ -720,12 +965,65 @@ func (p *Parser) parseIdentifierOrCall() (ast.Expression, error) {
 	}
 
 	if p.peekTokenIs(token.LP) {
-		return p.parseCallExpression()
+		call, err := p.parseCallExpression()
+		if err != nil {
+			return nil, err
+		}
+
+		// count(relation) followed by a comparison operator is an aggregation, not a rule call
+		if c, ok := call.(*ast.Call); ok && c.Name.Literal == ast.COUNT && p.peekTokenIs(token.GT, token.LT, token.ASSIGN, token.EXCL) {
+			return p.parseAggregationExpression(c)
+		}
+
+		return call, nil
 	}
 
 	return p.parseIdentifierExpression()
 }
 
+// parseAggregationExpression parses the comparison part of an aggregation expression such as
+// "count(approver) >= 2", using the already parsed call as the aggregation function and its argument.
+// Supported comparison operators are ==, !=, >, >=, < and <=.
+func (p *Parser) parseAggregationExpression(call *ast.Call) (ast.Expression, error) {
+	// An aggregation is applied to exactly one relation.
+	if len(call.Arguments) != 1 {
+		p.currentError(token.IDENT)
+		return nil, p.Error()
+	}
+
+	aggregation := &ast.Aggregation{
+		Function: call.Name,
+		Relation: call.Arguments[0],
+	}
+
+	// Consume the first character of the comparison operator.
+	p.next()
+	aggregation.Comparison = p.currentToken
+
+	switch p.currentToken.Type {
+	case token.GT, token.LT:
+		// > and < may be followed by = to form >= and <=
+		if p.peekTokenIs(token.ASSIGN) {
+			p.next()
+			aggregation.Comparison.Literal += p.currentToken.Literal
+		}
+	case token.ASSIGN, token.EXCL:
+		// = and ! must be followed by = to form == and !=
+		if !p.expectAndNext(token.ASSIGN) {
+			return nil, p.Error()
+		}
+		aggregation.Comparison.Literal += p.currentToken.Literal
+	}
+
+	// The comparison operator must be followed by an integer.
+	if !p.expectAndNext(token.INTEGER) {
+		return nil, p.Error()
+	}
+	aggregation.Value = p.currentToken
+
+	return aggregation, nil
+}
+
 // parseIdentifier parses an identifier expression that may consist of one or more dot-separated
 // identifiers, such as "x", "foo.bar", or "a.b.c.d".
 // It constructs a new Identifier expression with the first token as the prefix and subsequent
@@ -887,6 +1185,24 @@ func (p *Parser) currentError(t ...token.Type) {
 	p.errors = append(p.errors, msg)
 }
 
+// modifierError adds an error message to the parser's error list indicating that the current identifier
+// is not one of the accepted modifiers.
+// It takes one or more modifier literals as arguments that indicate the accepted values.
+func (p *Parser) modifierError(modifiers ...string) {
+	msg := fmt.Sprintf("%v:%v:expected modifier to be %s, got %s instead", p.l.GetLinePosition(),
+		p.l.GetColumnPosition(), strings.Join(modifiers, ", "), p.currentToken.Literal)
+	p.errors = append(p.errors, msg)
+}
+
+// annotationError adds an error message to the parser's error list indicating that the current identifier
+// is not one of the supported annotations.
+// It takes one or more annotation names as arguments that indicate the supported values.
+func (p *Parser) annotationError(annotations ...string) {
+	msg := fmt.Sprintf("%v:%v:expected annotation to be %s, got %s instead", p.l.GetLinePosition(),
+		p.l.GetColumnPosition(), strings.Join(annotations, ", "), p.currentToken.Literal)
+	p.errors = append(p.errors, msg)
+}
+
 // tokenTypesToStrings converts a slice of token types to a slice of their string representations.
 func tokenTypesToStrings(types []token.Type) []string {
 	strs := make([]string, len(types))
@@ -1000,5 +1000,263 @@ rule confidentiality_level_low(confidentiality_level double) {
 			// Ensure the error message contains the expected string
 			Expect(err.Error()).Should(ContainSubstring("7:15:expected token to be RELATION, PERMISSION, ATTRIBUTE, got OR instead"))
 		})
+		It("Case // Test case 30 - Relation with cardinality modifiers", func() {
+			pr := NewParser(` // Create parser
+			entity document {
+				relation parent @folder single
+				relation owner @user @organization#member single replace
+				relation reviewer @user single reject
+				relation viewer @user
+			}
+			`)
+
+			schema, err := pr.Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			st := schema.Statements[0].(*ast.EntityStatement)
+
+			r1 := st.RelationStatements[0].(*ast.RelationStatement)
+			Expect(r1.Cardinality.Literal).Should(Equal(ast.SINGLE))
+			Expect(r1.OnConflict.Literal).Should(Equal(""))
+			Expect(r1.String()).Should(Equal("\trelation parent @folder single "))
+
+			r2 := st.RelationStatements[1].(*ast.RelationStatement)
+			Expect(r2.RelationTypes).Should(HaveLen(2))
+			Expect(r2.Cardinality.Literal).Should(Equal(ast.SINGLE))
+			Expect(r2.OnConflict.Literal).Should(Equal(ast.REPLACE))
+			Expect(r2.String()).Should(Equal("\trelation owner @user @organization#member single replace "))
+
+			r3 := st.RelationStatements[2].(*ast.RelationStatement)
+			Expect(r3.OnConflict.Literal).Should(Equal(ast.REJECT))
+
+			r4 := st.RelationStatements[3].(*ast.RelationStatement)
+			Expect(r4.Cardinality.Literal).Should(Equal(""))
+			Expect(r4.String()).Should(Equal("\trelation viewer @user "))
+		}) // End test case
+		It("Case // Test case 31 - Relation with unknown cardinality modifiers - should fail", func() {
+			pr := NewParser(` // Create parser
+			entity document {
+				relation parent @folder many
+			}
+			`)
+
+			_, err := pr.Parse()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("expected modifier to be single, got many instead"))
+
+			pr = NewParser(` // Create parser
+			entity document {
+				relation parent @folder single overwrite
+			}
+			`)
+
+			_, err = pr.Parse()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("expected modifier to be reject, replace, got overwrite instead"))
+		}) // End test case
+		It("Case // Test case 32 - Permission with count aggregation", func() {
+			pr := NewParser(` // Create parser
+			entity document {
+				relation approver @user
+				relation owner @user
+
+				permission publish = count(approver) >= 2 and owner
+				permission lock = count(approver) == 0
+				permission archive = owner not count(approver) != 1
+			}
+			`)
+
+			schema, err := pr.Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			st := schema.Statements[0].(*ast.EntityStatement)
+
+			p1 := st.PermissionStatements[0].(*ast.PermissionStatement)
+			es := p1.ExpressionStatement.(*ast.ExpressionStatement)
+			a1 := es.Expression.(*ast.InfixExpression).Left.(*ast.Aggregation)
+			Expect(a1.Function.Literal).Should(Equal(ast.COUNT))
+			Expect(a1.Relation.String()).Should(Equal("approver"))
+			Expect(a1.Comparison.Literal).Should(Equal(">="))
+			Expect(a1.Value.Literal).Should(Equal("2"))
+			Expect(es.String()).Should(Equal("(count(approver) >= 2 and owner)"))
+
+			p2 := st.PermissionStatements[1].(*ast.PermissionStatement)
+			es = p2.ExpressionStatement.(*ast.ExpressionStatement)
+			Expect(es.Expression.GetType()).Should(Equal(ast.AGGREGATION))
+			Expect(es.String()).Should(Equal("count(approver) == 0"))
+
+			p3 := st.PermissionStatements[2].(*ast.PermissionStatement)
+			es = p3.ExpressionStatement.(*ast.ExpressionStatement)
+			Expect(es.String()).Should(Equal("(owner not count(approver) != 1)"))
+		}) // End test case
+		It("Case // Test case 33 - Permission with invalid count aggregation - should fail", func() {
+			pr := NewParser(` // Create parser
+			entity document {
+				relation approver @user
+
+				permission publish = count(approver) >= two
+			}
+			`)
+
+			_, err := pr.Parse()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("expected next token to be INTEGER, got IDENT instead"))
+
+			pr = NewParser(` // Create parser
+			entity document {
+				relation approver @user
+
+				permission publish = count(approver) = 2
+			}
+			`)
+
+			_, err = pr.Parse()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("expected next token to be ASSIGN, got INTEGER instead"))
+		}) // End test case
+		It("Case // Test case 34 - Annotations on entities, relations, attributes, permissions and rules", func() {
+			pr := NewParser(` // Create parser
+			@description("Shared document")
+			@owner("team-docs")
+			entity document {
+				@deprecated
+				relation viewer @user
+
+				@description("Whether the document is public")
+				attribute public boolean
+
+				@description("Who can \"view\" the document")
+				@owner("team-docs")
+				@deprecated
+				permission view = viewer or public
+			}
+
+			@owner("team-security")
+			rule check_public(public boolean) {
+				public == true
+			}
+			`)
+
+			schema, err := pr.Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			st := schema.Statements[0].(*ast.EntityStatement)
+			Expect(st.Annotations).Should(HaveLen(2))
+			Expect(st.Annotations[0].Name.Literal).Should(Equal(ast.DESCRIPTION))
+			Expect(st.Annotations[0].Value.Literal).Should(Equal("Shared document"))
+			Expect(st.Annotations[1].Name.Literal).Should(Equal(ast.OWNER))
+			Expect(st.Annotations[1].Value.Literal).Should(Equal("team-docs"))
+
+			r1 := st.RelationStatements[0].(*ast.RelationStatement)
+			Expect(r1.Annotations).Should(HaveLen(1))
+			Expect(r1.Annotations[0].Name.Literal).Should(Equal(ast.DEPRECATED))
+
+			a1 := st.AttributeStatements[0].(*ast.AttributeStatement)
+			Expect(a1.Annotations).Should(HaveLen(1))
+			Expect(a1.Annotations[0].Value.Literal).Should(Equal("Whether the document is public"))
+
+			p1 := st.PermissionStatements[0].(*ast.PermissionStatement)
+			Expect(p1.Annotations).Should(HaveLen(3))
+			Expect(p1.Annotations[0].Value.Literal).Should(Equal("Who can \"view\" the document"))
+			Expect(p1.String()).Should(Equal("\t@description(\"Who can \\\"view\\\" the document\")\n\t@owner(\"team-docs\")\n\t@deprecated\n\tpermission view = (viewer or public)"))
+
+			ru := schema.Statements[1].(*ast.RuleStatement)
+			Expect(ru.Annotations).Should(HaveLen(1))
+			Expect(ru.Annotations[0].Value.Literal).Should(Equal("team-security"))
+
+			// the string form of an annotated statement parses back into the same annotations
+			reparsed, err := NewParser(st.String()).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(reparsed.Statements[0].String()).Should(Equal(st.String()))
+		}) // End test case
+		It("Case // Test case 35 - Invalid annotations - should fail", func() {
+			pr := NewParser(` // Create parser
+			entity document {
+				@pinned
+				relation viewer @user
+			}
+			`)
+
+			_, err := pr.Parse()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("expected annotation to be description, owner, deprecated, got pinned instead"))
+
+			pr = NewParser(` // Create parser
+			entity document {
+				@owner(team)
+				relation viewer @user
+			}
+			`)
+
+			_, err = pr.Parse()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("expected next token to be STRING, got IDENT instead"))
+
+			pr = NewParser(` // Create parser
+			@deprecated
+			@deprecated
+			entity document {}
+			`)
+
+			_, err = pr.Parse()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("duplication found for deprecated"))
+
+			pr = NewParser(` // Create parser
+			entity document {
+				@deprecated
+			}
+			`)
+
+			_, err = pr.Parse()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("expected token to be RELATION, PERMISSION, ATTRIBUTE, got RCB instead"))
+		}) // End test case
+		It("Case // Test case 36 - Comments are attached to the statements around them", func() {
+			pr := NewParser(`// header
+			entity document { // document entity
+				// the creator
+				@deprecated
+				relation owner @user // owner
+				permission view = owner or /* inline */
+					owner
+				// closing
+			}
+
+			rule check(a integer) { // rule
+				// body comment
+				a > 1
+			}
+			// footer`)
+
+			schema, err := pr.Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			st := schema.Statements[0].(*ast.EntityStatement)
+			Expect(st.LeadingComments).Should(HaveLen(1))
+			Expect(st.LeadingComments[0].String()).Should(Equal("// header"))
+			Expect(st.TrailingComments).Should(HaveLen(1))
+			Expect(st.TrailingComments[0].String()).Should(Equal("// document entity"))
+			Expect(st.ClosingComments).Should(HaveLen(1))
+			Expect(st.ClosingComments[0].String()).Should(Equal("// closing"))
+
+			r1 := st.RelationStatements[0].(*ast.RelationStatement)
+			Expect(r1.LeadingComments).Should(HaveLen(1))
+			Expect(r1.LeadingComments[0].String()).Should(Equal("// the creator"))
+			Expect(r1.TrailingComments).Should(HaveLen(1))
+			Expect(r1.TrailingComments[0].Inline).Should(BeTrue())
+			Expect(r1.TrailingComments[0].String()).Should(Equal("// owner"))
+
+			p1 := st.PermissionStatements[0].(*ast.PermissionStatement)
+			Expect(p1.LeadingComments).Should(BeEmpty())
+			Expect(p1.TrailingComments).Should(HaveLen(1))
+			Expect(p1.TrailingComments[0].String()).Should(Equal("/* inline */"))
+
+			rs := schema.Statements[1].(*ast.RuleStatement)
+			Expect(rs.TrailingComments).Should(HaveLen(1))
+			Expect(rs.TrailingComments[0].String()).Should(Equal("// rule"))
+			Expect(rs.Expression).Should(ContainSubstring("// body comment"))
+
+			Expect(schema.Comments).Should(HaveLen(1))
+			Expect(schema.Comments[0].String()).Should(Equal("// footer"))
+		}) // End test case
 	}) // End context
 }) // End describe
@@ -315,6 +315,231 @@ func (EntityDefinition_Reference) EnumDescriptor() ([]byte, []int) {
 	return file_base_v1_base_proto_rawDescGZIP(), []int{5, 0}
 }
 
+// The Cardinality enum limits how many subjects a single entity can hold for the relation.
+type RelationDefinition_Cardinality int32
+
+const (
+	RelationDefinition_CARDINALITY_UNSPECIFIED RelationDefinition_Cardinality = 0 // Default, no limit on the number of subjects.
+	RelationDefinition_CARDINALITY_SINGLE      RelationDefinition_Cardinality = 1 // An entity can hold at most one subject for the relation.
+)
+
+// Enum value maps for RelationDefinition_Cardinality.
+var (
+	RelationDefinition_Cardinality_name = map[int32]string{
+		0: "CARDINALITY_UNSPECIFIED",
+		1: "CARDINALITY_SINGLE",
+	}
+	RelationDefinition_Cardinality_value = map[string]int32{
+		"CARDINALITY_UNSPECIFIED": 0,
+		"CARDINALITY_SINGLE":      1,
+	}
+)
+
+func (x RelationDefinition_Cardinality) Enum() *RelationDefinition_Cardinality {
+	p := new(RelationDefinition_Cardinality)
+	*p = x
+	return p
+}
+
+func (x RelationDefinition_Cardinality) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RelationDefinition_Cardinality) Descriptor() protoreflect.EnumDescriptor {
+	return file_base_v1_base_proto_enumTypes[5].Descriptor()
+}
+
+func (RelationDefinition_Cardinality) Type() protoreflect.EnumType {
+	return &file_base_v1_base_proto_enumTypes[5]
+}
+
+func (x RelationDefinition_Cardinality) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RelationDefinition_Cardinality.Descriptor instead.
+func (RelationDefinition_Cardinality) EnumDescriptor() ([]byte, []int) {
+	return file_base_v1_base_proto_rawDescGZIP(), []int{8, 0}
+}
+
+// The OnConflict enum decides what happens when a write would exceed the cardinality of the relation.
+type RelationDefinition_OnConflict int32
+
+const (
+	RelationDefinition_ON_CONFLICT_UNSPECIFIED RelationDefinition_OnConflict = 0 // Default, behaves like ON_CONFLICT_REJECT.
+	RelationDefinition_ON_CONFLICT_REJECT      RelationDefinition_OnConflict = 1 // The write is rejected.
+	RelationDefinition_ON_CONFLICT_REPLACE     RelationDefinition_OnConflict = 2 // The existing subject is replaced by the written one.
+)
+
+// Enum value maps for RelationDefinition_OnConflict.
+var (
+	RelationDefinition_OnConflict_name = map[int32]string{
+		0: "ON_CONFLICT_UNSPECIFIED",
+		1: "ON_CONFLICT_REJECT",
+		2: "ON_CONFLICT_REPLACE",
+	}
+	RelationDefinition_OnConflict_value = map[string]int32{
+		"ON_CONFLICT_UNSPECIFIED": 0,
+		"ON_CONFLICT_REJECT":      1,
+		"ON_CONFLICT_REPLACE":     2,
+	}
+)
+
+func (x RelationDefinition_OnConflict) Enum() *RelationDefinition_OnConflict {
+	p := new(RelationDefinition_OnConflict)
+	*p = x
+	return p
+}
+
+func (x RelationDefinition_OnConflict) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RelationDefinition_OnConflict) Descriptor() protoreflect.EnumDescriptor {
+	return file_base_v1_base_proto_enumTypes[6].Descriptor()
+}
+
+func (RelationDefinition_OnConflict) Type() protoreflect.EnumType {
+	return &file_base_v1_base_proto_enumTypes[6]
+}
+
+func (x RelationDefinition_OnConflict) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RelationDefinition_OnConflict.Descriptor instead.
+func (RelationDefinition_OnConflict) EnumDescriptor() ([]byte, []int) {
+	return file_base_v1_base_proto_rawDescGZIP(), []int{8, 1}
+}
+
+// Comparison enumerates the supported comparison operators.
+type Count_Comparison int32
+
+const (
+	Count_COMPARISON_UNSPECIFIED           Count_Comparison = 0 // Default, unspecified comparison.
+	Count_COMPARISON_EQUAL                 Count_Comparison = 1 // ==
+	Count_COMPARISON_NOT_EQUAL             Count_Comparison = 2 // !=
+	Count_COMPARISON_GREATER_THAN          Count_Comparison = 3 // >
+	Count_COMPARISON_GREATER_THAN_OR_EQUAL Count_Comparison = 4 // >=
+	Count_COMPARISON_LESS_THAN             Count_Comparison = 5 // <
+	Count_COMPARISON_LESS_THAN_OR_EQUAL    Count_Comparison = 6 // <=
+)
+
+// Enum value maps for Count_Comparison.
+var (
+	Count_Comparison_name = map[int32]string{
+		0: "COMPARISON_UNSPECIFIED",
+		1: "COMPARISON_EQUAL",
+		2: "COMPARISON_NOT_EQUAL",
+		3: "COMPARISON_GREATER_THAN",
+		4: "COMPARISON_GREATER_THAN_OR_EQUAL",
+		5: "COMPARISON_LESS_THAN",
+		6: "COMPARISON_LESS_THAN_OR_EQUAL",
+	}
+	Count_Comparison_value = map[string]int32{
+		"COMPARISON_UNSPECIFIED":           0,
+		"COMPARISON_EQUAL":                 1,
+		"COMPARISON_NOT_EQUAL":             2,
+		"COMPARISON_GREATER_THAN":          3,
+		"COMPARISON_GREATER_THAN_OR_EQUAL": 4,
+		"COMPARISON_LESS_THAN":             5,
+		"COMPARISON_LESS_THAN_OR_EQUAL":    6,
+	}
+)
+
+func (x Count_Comparison) Enum() *Count_Comparison {
+	p := new(Count_Comparison)
+	*p = x
+	return p
+}
+
+func (x Count_Comparison) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Count_Comparison) Descriptor() protoreflect.EnumDescriptor {
+	return file_base_v1_base_proto_enumTypes[7].Descriptor()
+}
+
+func (Count_Comparison) Type() protoreflect.EnumType {
+	return &file_base_v1_base_proto_enumTypes[7]
+}
+
+func (x Count_Comparison) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Count_Comparison.Descriptor instead.
+func (Count_Comparison) EnumDescriptor() ([]byte, []int) {
+	return file_base_v1_base_proto_rawDescGZIP(), []int{15, 0}
+}
+
+// Operator comparing the value of the attribute with the value of the predicate.
+type AttributePredicate_Operator int32
+
+const (
+	AttributePredicate_OPERATOR_UNSPECIFIED           AttributePredicate_Operator = 0
+	AttributePredicate_OPERATOR_EQUAL                 AttributePredicate_Operator = 1
+	AttributePredicate_OPERATOR_NOT_EQUAL             AttributePredicate_Operator = 2
+	AttributePredicate_OPERATOR_LESS_THAN             AttributePredicate_Operator = 3
+	AttributePredicate_OPERATOR_LESS_THAN_OR_EQUAL    AttributePredicate_Operator = 4
+	AttributePredicate_OPERATOR_GREATER_THAN          AttributePredicate_Operator = 5
+	AttributePredicate_OPERATOR_GREATER_THAN_OR_EQUAL AttributePredicate_Operator = 6
+	// The array attribute contains the value of the predicate.
+	AttributePredicate_OPERATOR_CONTAINS AttributePredicate_Operator = 7
+)
+
+// Enum value maps for AttributePredicate_Operator.
+var (
+	AttributePredicate_Operator_name = map[int32]string{
+		0: "OPERATOR_UNSPECIFIED",
+		1: "OPERATOR_EQUAL",
+		2: "OPERATOR_NOT_EQUAL",
+		3: "OPERATOR_LESS_THAN",
+		4: "OPERATOR_LESS_THAN_OR_EQUAL",
+		5: "OPERATOR_GREATER_THAN",
+		6: "OPERATOR_GREATER_THAN_OR_EQUAL",
+		7: "OPERATOR_CONTAINS",
+	}
+	AttributePredicate_Operator_value = map[string]int32{
+		"OPERATOR_UNSPECIFIED":           0,
+		"OPERATOR_EQUAL":                 1,
+		"OPERATOR_NOT_EQUAL":             2,
+		"OPERATOR_LESS_THAN":             3,
+		"OPERATOR_LESS_THAN_OR_EQUAL":    4,
+		"OPERATOR_GREATER_THAN":          5,
+		"OPERATOR_GREATER_THAN_OR_EQUAL": 6,
+		"OPERATOR_CONTAINS":              7,
+	}
+)
+
+func (x AttributePredicate_Operator) Enum() *AttributePredicate_Operator {
+	p := new(AttributePredicate_Operator)
+	*p = x
+	return p
+}
+
+func (x AttributePredicate_Operator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttributePredicate_Operator) Descriptor() protoreflect.EnumDescriptor {
+	return file_base_v1_base_proto_enumTypes[8].Descriptor()
+}
+
+func (AttributePredicate_Operator) Type() protoreflect.EnumType {
+	return &file_base_v1_base_proto_enumTypes[8]
+}
+
+func (x AttributePredicate_Operator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttributePredicate_Operator.Descriptor instead.
+func (AttributePredicate_Operator) EnumDescriptor() ([]byte, []int) {
+	return file_base_v1_base_proto_rawDescGZIP(), []int{28, 0}
+}
+
 // Operation is an enum representing the type of operation to be applied on the tree node.
 type ExpandTreeNode_Operation int32
 
@@ -352,11 +577,11 @@ func (x ExpandTreeNode_Operation) String() string {
 }
 
 func (ExpandTreeNode_Operation) Descriptor() protoreflect.EnumDescriptor {
-	return file_base_v1_base_proto_enumTypes[5].Descriptor()
+	return file_base_v1_base_proto_enumTypes[9].Descriptor()
 }
 
 func (ExpandTreeNode_Operation) Type() protoreflect.EnumType {
-	return &file_base_v1_base_proto_enumTypes[5]
+	return &file_base_v1_base_proto_enumTypes[9]
 }
 
 func (x ExpandTreeNode_Operation) Number() protoreflect.EnumNumber {
@@ -365,7 +590,60 @@ func (x ExpandTreeNode_Operation) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ExpandTreeNode_Operation.Descriptor instead.
 func (ExpandTreeNode_Operation) EnumDescriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{29, 0}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{33, 0}
+}
+
+// Access tells whether a permission is held through a relation of the entity itself or of another entity.
+type AccessReviewEntry_Access int32
+
+const (
+	AccessReviewEntry_ACCESS_UNSPECIFIED AccessReviewEntry_Access = 0 // Default access, not specified.
+	AccessReviewEntry_ACCESS_DIRECT      AccessReviewEntry_Access = 1 // Held through a relation of the entity.
+	AccessReviewEntry_ACCESS_INHERITED   AccessReviewEntry_Access = 2 // Held through relations of other entities, such as parents or groups.
+	AccessReviewEntry_ACCESS_CONDITIONAL AccessReviewEntry_Access = 3 // Held through attributes or rules rather than relations.
+)
+
+// Enum value maps for AccessReviewEntry_Access.
+var (
+	AccessReviewEntry_Access_name = map[int32]string{
+		0: "ACCESS_UNSPECIFIED",
+		1: "ACCESS_DIRECT",
+		2: "ACCESS_INHERITED",
+		3: "ACCESS_CONDITIONAL",
+	}
+	AccessReviewEntry_Access_value = map[string]int32{
+		"ACCESS_UNSPECIFIED": 0,
+		"ACCESS_DIRECT":      1,
+		"ACCESS_INHERITED":   2,
+		"ACCESS_CONDITIONAL": 3,
+	}
+)
+
+func (x AccessReviewEntry_Access) Enum() *AccessReviewEntry_Access {
+	p := new(AccessReviewEntry_Access)
+	*p = x
+	return p
+}
+
+func (x AccessReviewEntry_Access) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccessReviewEntry_Access) Descriptor() protoreflect.EnumDescriptor {
+	return file_base_v1_base_proto_enumTypes[10].Descriptor()
+}
+
+func (AccessReviewEntry_Access) Type() protoreflect.EnumType {
+	return &file_base_v1_base_proto_enumTypes[10]
+}
+
+func (x AccessReviewEntry_Access) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccessReviewEntry_Access.Descriptor instead.
+func (AccessReviewEntry_Access) EnumDescriptor() ([]byte, []int) {
+	return file_base_v1_base_proto_rawDescGZIP(), []int{40, 0}
 }
 
 type DataChange_Operation int32
@@ -401,11 +679,11 @@ func (x DataChange_Operation) String() string {
 }
 
 func (DataChange_Operation) Descriptor() protoreflect.EnumDescriptor {
-	return file_base_v1_base_proto_enumTypes[6].Descriptor()
+	return file_base_v1_base_proto_enumTypes[11].Descriptor()
 }
 
 func (DataChange_Operation) Type() protoreflect.EnumType {
-	return &file_base_v1_base_proto_enumTypes[6]
+	return &file_base_v1_base_proto_enumTypes[11]
 }
 
 func (x DataChange_Operation) Number() protoreflect.EnumNumber {
@@ -414,7 +692,7 @@ func (x DataChange_Operation) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DataChange_Operation.Descriptor instead.
 func (DataChange_Operation) EnumDescriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{36, 0}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{44, 0}
 }
 
 // Context encapsulates the information related to a single operation,
@@ -580,6 +858,7 @@ type Leaf struct {
 	//	*Leaf_TupleToUserSet
 	//	*Leaf_ComputedAttribute
 	//	*Leaf_Call
+	//	*Leaf_Count
 	Type          isLeaf_Type `protobuf_oneof:"type"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -658,6 +937,15 @@ func (x *Leaf) GetCall() *Call {
 	return nil
 }
 
+func (x *Leaf) GetCount() *Count {
+	if x != nil {
+		if x, ok := x.Type.(*Leaf_Count); ok {
+			return x.Count
+		}
+	}
+	return nil
+}
+
 type isLeaf_Type interface {
 	isLeaf_Type()
 }
@@ -682,6 +970,11 @@ type Leaf_Call struct {
 	Call *Call `protobuf:"bytes,4,opt,name=call,proto3,oneof"`
 }
 
+type Leaf_Count struct {
+	// A comparison on the number of subjects of a relation.
+	Count *Count `protobuf:"bytes,5,opt,name=count,proto3,oneof"`
+}
+
 func (*Leaf_ComputedUserSet) isLeaf_Type() {}
 
 func (*Leaf_TupleToUserSet) isLeaf_Type() {}
@@ -690,6 +983,8 @@ func (*Leaf_ComputedAttribute) isLeaf_Type() {}
 
 func (*Leaf_Call) isLeaf_Type() {}
 
+func (*Leaf_Count) isLeaf_Type() {}
+
 // The Rewrite message represents a specific rewrite operation.
 // This operation could be one of the following: union, intersection, or exclusion.
 type Rewrite struct {
@@ -823,7 +1118,9 @@ type EntityDefinition struct {
 	// Map of attribute definitions within this entity. The key is the attribute name, and the value is the AttributeDefinition.
 	Attributes map[string]*AttributeDefinition `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Map of references indicating whether a string pertains to a relation, permission, or attribute.
-	References    map[string]EntityDefinition_Reference `protobuf:"bytes,5,rep,name=references,proto3" json:"references,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=base.v1.EntityDefinition_Reference"`
+	References map[string]EntityDefinition_Reference `protobuf:"bytes,5,rep,name=references,proto3" json:"references,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=base.v1.EntityDefinition_Reference"`
+	// Annotations attached to the entity in the schema.
+	Annotations   *Annotations `protobuf:"bytes,6,opt,name=annotations,proto3" json:"annotations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -893,6 +1190,13 @@ func (x *EntityDefinition) GetReferences() map[string]EntityDefinition_Reference
 	return nil
 }
 
+func (x *EntityDefinition) GetAnnotations() *Annotations {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
 // The RuleDefinition message provides detailed information about a specific rule.
 type RuleDefinition struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -901,7 +1205,9 @@ type RuleDefinition struct {
 	// Map of arguments for this rule. The key is the attribute name, and the value is the AttributeType.
 	Arguments map[string]AttributeType `protobuf:"bytes,2,rep,name=arguments,proto3" json:"arguments,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=base.v1.AttributeType"`
 	// The expression for this rule in the form of a google.api.expr.v1alpha1.CheckedExpr.
-	Expression    *v1alpha1.CheckedExpr `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
+	Expression *v1alpha1.CheckedExpr `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
+	// Annotations attached to the rule in the schema.
+	Annotations   *Annotations `protobuf:"bytes,4,opt,name=annotations,proto3" json:"annotations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -957,13 +1263,22 @@ func (x *RuleDefinition) GetExpression() *v1alpha1.CheckedExpr {
 	return nil
 }
 
+func (x *RuleDefinition) GetAnnotations() *Annotations {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
 // The AttributeDefinition message provides detailed information about a specific attribute.
 type AttributeDefinition struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the attribute, which follows a specific string pattern and has a maximum byte size.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// The type of the attribute.
-	Type          AttributeType `protobuf:"varint,2,opt,name=type,proto3,enum=base.v1.AttributeType" json:"type,omitempty"`
+	Type AttributeType `protobuf:"varint,2,opt,name=type,proto3,enum=base.v1.AttributeType" json:"type,omitempty"`
+	// Annotations attached to the attribute in the schema.
+	Annotations   *Annotations `protobuf:"bytes,3,opt,name=annotations,proto3" json:"annotations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1012,6 +1327,13 @@ func (x *AttributeDefinition) GetType() AttributeType {
 	return AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED
 }
 
+func (x *AttributeDefinition) GetAnnotations() *Annotations {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
 // The RelationDefinition message provides detailed information about a specific relation.
 type RelationDefinition struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1019,8 +1341,14 @@ type RelationDefinition struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// A list of references to other relations.
 	RelationReferences []*RelationReference `protobuf:"bytes,2,rep,name=relation_references,json=relationReferences,proto3" json:"relation_references,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// The cardinality constraint of the relation.
+	Cardinality RelationDefinition_Cardinality `protobuf:"varint,3,opt,name=cardinality,proto3,enum=base.v1.RelationDefinition_Cardinality" json:"cardinality,omitempty"`
+	// The behaviour applied when a write violates the cardinality constraint.
+	OnConflict RelationDefinition_OnConflict `protobuf:"varint,4,opt,name=on_conflict,json=onConflict,proto3,enum=base.v1.RelationDefinition_OnConflict" json:"on_conflict,omitempty"`
+	// Annotations attached to the relation in the schema.
+	Annotations   *Annotations `protobuf:"bytes,5,opt,name=annotations,proto3" json:"annotations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RelationDefinition) Reset() {
@@ -1067,13 +1395,36 @@ func (x *RelationDefinition) GetRelationReferences() []*RelationReference {
 	return nil
 }
 
+func (x *RelationDefinition) GetCardinality() RelationDefinition_Cardinality {
+	if x != nil {
+		return x.Cardinality
+	}
+	return RelationDefinition_CARDINALITY_UNSPECIFIED
+}
+
+func (x *RelationDefinition) GetOnConflict() RelationDefinition_OnConflict {
+	if x != nil {
+		return x.OnConflict
+	}
+	return RelationDefinition_ON_CONFLICT_UNSPECIFIED
+}
+
+func (x *RelationDefinition) GetAnnotations() *Annotations {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
 // The PermissionDefinition message provides detailed information about a specific permission.
 type PermissionDefinition struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the permission, which follows a specific string pattern and has a maximum byte size.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// The child related to this permission.
-	Child         *Child `protobuf:"bytes,2,opt,name=child,proto3" json:"child,omitempty"`
+	Child *Child `protobuf:"bytes,2,opt,name=child,proto3" json:"child,omitempty"`
+	// Annotations attached to the permission in the schema.
+	Annotations   *Annotations `protobuf:"bytes,3,opt,name=annotations,proto3" json:"annotations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1122,6 +1473,78 @@ func (x *PermissionDefinition) GetChild() *Child {
 	return nil
 }
 
+func (x *PermissionDefinition) GetAnnotations() *Annotations {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+// The Annotations message holds the metadata attached to a schema definition with annotations
+// such as @description("..."), @owner("...") and @deprecated.
+type Annotations struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A free form description of the definition.
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// The owner of the definition, e.g. the name of a team.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Whether the definition is deprecated.
+	Deprecated    bool `protobuf:"varint,3,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Annotations) Reset() {
+	*x = Annotations{}
+	mi := &file_base_v1_base_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Annotations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Annotations) ProtoMessage() {}
+
+func (x *Annotations) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_base_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Annotations.ProtoReflect.Descriptor instead.
+func (*Annotations) Descriptor() ([]byte, []int) {
+	return file_base_v1_base_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Annotations) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Annotations) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Annotations) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
 // The RelationReference message provides a reference to a specific relation.
 type RelationReference struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1135,7 +1558,7 @@ type RelationReference struct {
 
 func (x *RelationReference) Reset() {
 	*x = RelationReference{}
-	mi := &file_base_v1_base_proto_msgTypes[10]
+	mi := &file_base_v1_base_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1147,7 +1570,7 @@ func (x *RelationReference) String() string {
 func (*RelationReference) ProtoMessage() {}
 
 func (x *RelationReference) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[10]
+	mi := &file_base_v1_base_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1160,7 +1583,7 @@ func (x *RelationReference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RelationReference.ProtoReflect.Descriptor instead.
 func (*RelationReference) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{10}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *RelationReference) GetType() string {
@@ -1189,7 +1612,7 @@ type Entrance struct {
 
 func (x *Entrance) Reset() {
 	*x = Entrance{}
-	mi := &file_base_v1_base_proto_msgTypes[11]
+	mi := &file_base_v1_base_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1201,7 +1624,7 @@ func (x *Entrance) String() string {
 func (*Entrance) ProtoMessage() {}
 
 func (x *Entrance) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[11]
+	mi := &file_base_v1_base_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1214,7 +1637,7 @@ func (x *Entrance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Entrance.ProtoReflect.Descriptor instead.
 func (*Entrance) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{11}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Entrance) GetType() string {
@@ -1244,7 +1667,7 @@ type Argument struct {
 
 func (x *Argument) Reset() {
 	*x = Argument{}
-	mi := &file_base_v1_base_proto_msgTypes[12]
+	mi := &file_base_v1_base_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1256,7 +1679,7 @@ func (x *Argument) String() string {
 func (*Argument) ProtoMessage() {}
 
 func (x *Argument) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[12]
+	mi := &file_base_v1_base_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1269,7 +1692,7 @@ func (x *Argument) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Argument.ProtoReflect.Descriptor instead.
 func (*Argument) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{12}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Argument) GetType() isArgument_Type {
@@ -1309,7 +1732,7 @@ type Call struct {
 
 func (x *Call) Reset() {
 	*x = Call{}
-	mi := &file_base_v1_base_proto_msgTypes[13]
+	mi := &file_base_v1_base_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1321,7 +1744,7 @@ func (x *Call) String() string {
 func (*Call) ProtoMessage() {}
 
 func (x *Call) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[13]
+	mi := &file_base_v1_base_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1334,7 +1757,7 @@ func (x *Call) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Call.ProtoReflect.Descriptor instead.
 func (*Call) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{13}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Call) GetRuleName() string {
@@ -1351,29 +1774,32 @@ func (x *Call) GetArguments() []*Argument {
 	return nil
 }
 
-// ComputedAttribute defines a computed attribute which includes its name.
-type ComputedAttribute struct {
+// Count compares the number of distinct subjects assigned to a relation of the entity with a value,
+// e.g. count(approver) >= 2.
+type Count struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Name of the computed attribute
+	Relation      string                 `protobuf:"bytes,1,opt,name=relation,proto3" json:"relation,omitempty"`                                    // Relation name
+	Comparison    Count_Comparison       `protobuf:"varint,2,opt,name=comparison,proto3,enum=base.v1.Count_Comparison" json:"comparison,omitempty"` // Comparison operator
+	Value         uint32                 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`                                         // Value the number of subjects is compared with
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ComputedAttribute) Reset() {
-	*x = ComputedAttribute{}
-	mi := &file_base_v1_base_proto_msgTypes[14]
+func (x *Count) Reset() {
+	*x = Count{}
+	mi := &file_base_v1_base_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ComputedAttribute) String() string {
+func (x *Count) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ComputedAttribute) ProtoMessage() {}
+func (*Count) ProtoMessage() {}
 
-func (x *ComputedAttribute) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[14]
+func (x *Count) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_base_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1384,9 +1810,68 @@ func (x *ComputedAttribute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ComputedAttribute.ProtoReflect.Descriptor instead.
-func (*ComputedAttribute) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use Count.ProtoReflect.Descriptor instead.
+func (*Count) Descriptor() ([]byte, []int) {
+	return file_base_v1_base_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Count) GetRelation() string {
+	if x != nil {
+		return x.Relation
+	}
+	return ""
+}
+
+func (x *Count) GetComparison() Count_Comparison {
+	if x != nil {
+		return x.Comparison
+	}
+	return Count_COMPARISON_UNSPECIFIED
+}
+
+func (x *Count) GetValue() uint32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// ComputedAttribute defines a computed attribute which includes its name.
+type ComputedAttribute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Name of the computed attribute
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComputedAttribute) Reset() {
+	*x = ComputedAttribute{}
+	mi := &file_base_v1_base_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComputedAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComputedAttribute) ProtoMessage() {}
+
+func (x *ComputedAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_base_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComputedAttribute.ProtoReflect.Descriptor instead.
+func (*ComputedAttribute) Descriptor() ([]byte, []int) {
+	return file_base_v1_base_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ComputedAttribute) GetName() string {
@@ -1406,7 +1891,7 @@ type ComputedUserSet struct {
 
 func (x *ComputedUserSet) Reset() {
 	*x = ComputedUserSet{}
-	mi := &file_base_v1_base_proto_msgTypes[15]
+	mi := &file_base_v1_base_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1418,7 +1903,7 @@ func (x *ComputedUserSet) String() string {
 func (*ComputedUserSet) ProtoMessage() {}
 
 func (x *ComputedUserSet) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[15]
+	mi := &file_base_v1_base_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1431,7 +1916,7 @@ func (x *ComputedUserSet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ComputedUserSet.ProtoReflect.Descriptor instead.
 func (*ComputedUserSet) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{15}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ComputedUserSet) GetRelation() string {
@@ -1452,7 +1937,7 @@ type TupleToUserSet struct {
 
 func (x *TupleToUserSet) Reset() {
 	*x = TupleToUserSet{}
-	mi := &file_base_v1_base_proto_msgTypes[16]
+	mi := &file_base_v1_base_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1464,7 +1949,7 @@ func (x *TupleToUserSet) String() string {
 func (*TupleToUserSet) ProtoMessage() {}
 
 func (x *TupleToUserSet) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[16]
+	mi := &file_base_v1_base_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1477,7 +1962,7 @@ func (x *TupleToUserSet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TupleToUserSet.ProtoReflect.Descriptor instead.
 func (*TupleToUserSet) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{16}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *TupleToUserSet) GetTupleSet() *TupleSet {
@@ -1504,7 +1989,7 @@ type TupleSet struct {
 
 func (x *TupleSet) Reset() {
 	*x = TupleSet{}
-	mi := &file_base_v1_base_proto_msgTypes[17]
+	mi := &file_base_v1_base_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1516,7 +2001,7 @@ func (x *TupleSet) String() string {
 func (*TupleSet) ProtoMessage() {}
 
 func (x *TupleSet) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[17]
+	mi := &file_base_v1_base_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1529,7 +2014,7 @@ func (x *TupleSet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TupleSet.ProtoReflect.Descriptor instead.
 func (*TupleSet) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{17}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *TupleSet) GetRelation() string {
@@ -1551,7 +2036,7 @@ type Tuple struct {
 
 func (x *Tuple) Reset() {
 	*x = Tuple{}
-	mi := &file_base_v1_base_proto_msgTypes[18]
+	mi := &file_base_v1_base_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1563,7 +2048,7 @@ func (x *Tuple) String() string {
 func (*Tuple) ProtoMessage() {}
 
 func (x *Tuple) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[18]
+	mi := &file_base_v1_base_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1576,7 +2061,7 @@ func (x *Tuple) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Tuple.ProtoReflect.Descriptor instead.
 func (*Tuple) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{18}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Tuple) GetEntity() *Entity {
@@ -1612,7 +2097,7 @@ type Attribute struct {
 
 func (x *Attribute) Reset() {
 	*x = Attribute{}
-	mi := &file_base_v1_base_proto_msgTypes[19]
+	mi := &file_base_v1_base_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1624,7 +2109,7 @@ func (x *Attribute) String() string {
 func (*Attribute) ProtoMessage() {}
 
 func (x *Attribute) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[19]
+	mi := &file_base_v1_base_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1637,7 +2122,7 @@ func (x *Attribute) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Attribute.ProtoReflect.Descriptor instead.
 func (*Attribute) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{19}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Attribute) GetEntity() *Entity {
@@ -1671,7 +2156,7 @@ type Tuples struct {
 
 func (x *Tuples) Reset() {
 	*x = Tuples{}
-	mi := &file_base_v1_base_proto_msgTypes[20]
+	mi := &file_base_v1_base_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1683,7 +2168,7 @@ func (x *Tuples) String() string {
 func (*Tuples) ProtoMessage() {}
 
 func (x *Tuples) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[20]
+	mi := &file_base_v1_base_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1696,7 +2181,7 @@ func (x *Tuples) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Tuples.ProtoReflect.Descriptor instead.
 func (*Tuples) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{20}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Tuples) GetTuples() []*Tuple {
@@ -1716,7 +2201,7 @@ type Attributes struct {
 
 func (x *Attributes) Reset() {
 	*x = Attributes{}
-	mi := &file_base_v1_base_proto_msgTypes[21]
+	mi := &file_base_v1_base_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1728,7 +2213,7 @@ func (x *Attributes) String() string {
 func (*Attributes) ProtoMessage() {}
 
 func (x *Attributes) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[21]
+	mi := &file_base_v1_base_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1741,7 +2226,7 @@ func (x *Attributes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Attributes.ProtoReflect.Descriptor instead.
 func (*Attributes) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{21}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *Attributes) GetAttributes() []*Attribute {
@@ -1762,7 +2247,7 @@ type Entity struct {
 
 func (x *Entity) Reset() {
 	*x = Entity{}
-	mi := &file_base_v1_base_proto_msgTypes[22]
+	mi := &file_base_v1_base_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1774,7 +2259,7 @@ func (x *Entity) String() string {
 func (*Entity) ProtoMessage() {}
 
 func (x *Entity) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[22]
+	mi := &file_base_v1_base_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1787,7 +2272,7 @@ func (x *Entity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Entity.ProtoReflect.Descriptor instead.
 func (*Entity) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{22}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *Entity) GetType() string {
@@ -1815,7 +2300,7 @@ type EntityAndRelation struct {
 
 func (x *EntityAndRelation) Reset() {
 	*x = EntityAndRelation{}
-	mi := &file_base_v1_base_proto_msgTypes[23]
+	mi := &file_base_v1_base_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1827,7 +2312,7 @@ func (x *EntityAndRelation) String() string {
 func (*EntityAndRelation) ProtoMessage() {}
 
 func (x *EntityAndRelation) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[23]
+	mi := &file_base_v1_base_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1840,7 +2325,7 @@ func (x *EntityAndRelation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EntityAndRelation.ProtoReflect.Descriptor instead.
 func (*EntityAndRelation) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{23}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *EntityAndRelation) GetEntity() *Entity {
@@ -1869,7 +2354,7 @@ type Subject struct {
 
 func (x *Subject) Reset() {
 	*x = Subject{}
-	mi := &file_base_v1_base_proto_msgTypes[24]
+	mi := &file_base_v1_base_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1881,7 +2366,7 @@ func (x *Subject) String() string {
 func (*Subject) ProtoMessage() {}
 
 func (x *Subject) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[24]
+	mi := &file_base_v1_base_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1894,7 +2379,7 @@ func (x *Subject) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Subject.ProtoReflect.Descriptor instead.
 func (*Subject) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{24}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *Subject) GetType() string {
@@ -1929,7 +2414,7 @@ type AttributeFilter struct {
 
 func (x *AttributeFilter) Reset() {
 	*x = AttributeFilter{}
-	mi := &file_base_v1_base_proto_msgTypes[25]
+	mi := &file_base_v1_base_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1941,7 +2426,7 @@ func (x *AttributeFilter) String() string {
 func (*AttributeFilter) ProtoMessage() {}
 
 func (x *AttributeFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[25]
+	mi := &file_base_v1_base_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1954,7 +2439,7 @@ func (x *AttributeFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttributeFilter.ProtoReflect.Descriptor instead.
 func (*AttributeFilter) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{25}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *AttributeFilter) GetEntity() *EntityFilter {
@@ -1971,31 +2456,35 @@ func (x *AttributeFilter) GetAttributes() []string {
 	return nil
 }
 
-// TupleFilter is used to filter tuples based on the entity, relation and the subject.
-type TupleFilter struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entity        *EntityFilter          `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
-	Relation      string                 `protobuf:"bytes,2,opt,name=relation,proto3" json:"relation,omitempty"`
-	Subject       *SubjectFilter         `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"` // The subject filter
+// AttributePredicate is a condition on the value of an attribute of an entity. Entities without the attribute do not satisfy it.
+type AttributePredicate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the attribute.
+	Attribute string `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	// Operator of the predicate.
+	Operator AttributePredicate_Operator `protobuf:"varint,2,opt,name=operator,proto3,enum=base.v1.AttributePredicate_Operator" json:"operator,omitempty"`
+	// Value the attribute is compared with. It has the type of the attribute, or the type of its
+	// elements for OPERATOR_CONTAINS.
+	Value         *anypb.Any `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TupleFilter) Reset() {
-	*x = TupleFilter{}
-	mi := &file_base_v1_base_proto_msgTypes[26]
+func (x *AttributePredicate) Reset() {
+	*x = AttributePredicate{}
+	mi := &file_base_v1_base_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TupleFilter) String() string {
+func (x *AttributePredicate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TupleFilter) ProtoMessage() {}
+func (*AttributePredicate) ProtoMessage() {}
 
-func (x *TupleFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[26]
+func (x *AttributePredicate) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_base_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2006,56 +2495,57 @@ func (x *TupleFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TupleFilter.ProtoReflect.Descriptor instead.
-func (*TupleFilter) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use AttributePredicate.ProtoReflect.Descriptor instead.
+func (*AttributePredicate) Descriptor() ([]byte, []int) {
+	return file_base_v1_base_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *TupleFilter) GetEntity() *EntityFilter {
+func (x *AttributePredicate) GetAttribute() string {
 	if x != nil {
-		return x.Entity
+		return x.Attribute
 	}
-	return nil
+	return ""
 }
 
-func (x *TupleFilter) GetRelation() string {
+func (x *AttributePredicate) GetOperator() AttributePredicate_Operator {
 	if x != nil {
-		return x.Relation
+		return x.Operator
 	}
-	return ""
+	return AttributePredicate_OPERATOR_UNSPECIFIED
 }
 
-func (x *TupleFilter) GetSubject() *SubjectFilter {
+func (x *AttributePredicate) GetValue() *anypb.Any {
 	if x != nil {
-		return x.Subject
+		return x.Value
 	}
 	return nil
 }
 
-// EntityFilter is used to filter entities based on the type and ids.
-type EntityFilter struct {
+// TupleFilter is used to filter tuples based on the entity, relation and the subject.
+type TupleFilter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // Type of the entity
-	Ids           []string               `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`   // List of entity IDs
+	Entity        *EntityFilter          `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	Relation      string                 `protobuf:"bytes,2,opt,name=relation,proto3" json:"relation,omitempty"`
+	Subject       *SubjectFilter         `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"` // The subject filter
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EntityFilter) Reset() {
-	*x = EntityFilter{}
-	mi := &file_base_v1_base_proto_msgTypes[27]
+func (x *TupleFilter) Reset() {
+	*x = TupleFilter{}
+	mi := &file_base_v1_base_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EntityFilter) String() string {
+func (x *TupleFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EntityFilter) ProtoMessage() {}
+func (*TupleFilter) ProtoMessage() {}
 
-func (x *EntityFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[27]
+func (x *TupleFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_base_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2066,50 +2556,62 @@ func (x *EntityFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EntityFilter.ProtoReflect.Descriptor instead.
-func (*EntityFilter) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use TupleFilter.ProtoReflect.Descriptor instead.
+func (*TupleFilter) Descriptor() ([]byte, []int) {
+	return file_base_v1_base_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *EntityFilter) GetType() string {
+func (x *TupleFilter) GetEntity() *EntityFilter {
 	if x != nil {
-		return x.Type
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *TupleFilter) GetRelation() string {
+	if x != nil {
+		return x.Relation
 	}
 	return ""
 }
 
-func (x *EntityFilter) GetIds() []string {
+func (x *TupleFilter) GetSubject() *SubjectFilter {
 	if x != nil {
-		return x.Ids
+		return x.Subject
 	}
 	return nil
 }
 
-// SubjectFilter is used to filter subjects based on the type, ids and relation.
-type SubjectFilter struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // Type of the subject
-	Ids           []string               `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`   // List of subject IDs
-	Relation      string                 `protobuf:"bytes,3,opt,name=relation,proto3" json:"relation,omitempty"`
+// Precondition is a condition on the stored data of a tenant that must hold for a write to be applied.
+// It is evaluated in the transaction of the write, so nothing is written when it does not hold.
+type Precondition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*Precondition_TupleExists
+	//	*Precondition_TupleNotExists
+	//	*Precondition_FilterMatchesNone
+	//	*Precondition_HeadSnapToken
+	Type          isPrecondition_Type `protobuf_oneof:"type"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubjectFilter) Reset() {
-	*x = SubjectFilter{}
-	mi := &file_base_v1_base_proto_msgTypes[28]
+func (x *Precondition) Reset() {
+	*x = Precondition{}
+	mi := &file_base_v1_base_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubjectFilter) String() string {
+func (x *Precondition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubjectFilter) ProtoMessage() {}
+func (*Precondition) ProtoMessage() {}
 
-func (x *SubjectFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[28]
+func (x *Precondition) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_base_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2120,57 +2622,242 @@ func (x *SubjectFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubjectFilter.ProtoReflect.Descriptor instead.
-func (*SubjectFilter) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use Precondition.ProtoReflect.Descriptor instead.
+func (*Precondition) Descriptor() ([]byte, []int) {
+	return file_base_v1_base_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *SubjectFilter) GetType() string {
+func (x *Precondition) GetType() isPrecondition_Type {
 	if x != nil {
 		return x.Type
 	}
-	return ""
+	return nil
 }
 
-func (x *SubjectFilter) GetIds() []string {
+func (x *Precondition) GetTupleExists() *Tuple {
 	if x != nil {
-		return x.Ids
+		if x, ok := x.Type.(*Precondition_TupleExists); ok {
+			return x.TupleExists
+		}
 	}
 	return nil
 }
 
-func (x *SubjectFilter) GetRelation() string {
+func (x *Precondition) GetTupleNotExists() *Tuple {
 	if x != nil {
-		return x.Relation
+		if x, ok := x.Type.(*Precondition_TupleNotExists); ok {
+			return x.TupleNotExists
+		}
+	}
+	return nil
+}
+
+func (x *Precondition) GetFilterMatchesNone() *TupleFilter {
+	if x != nil {
+		if x, ok := x.Type.(*Precondition_FilterMatchesNone); ok {
+			return x.FilterMatchesNone
+		}
+	}
+	return nil
+}
+
+func (x *Precondition) GetHeadSnapToken() string {
+	if x != nil {
+		if x, ok := x.Type.(*Precondition_HeadSnapToken); ok {
+			return x.HeadSnapToken
+		}
 	}
 	return ""
 }
 
-// ExpandTreeNode represents a node in an expansion tree with a specific operation and its children.
-type ExpandTreeNode struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Operation     ExpandTreeNode_Operation `protobuf:"varint,1,opt,name=operation,proto3,enum=base.v1.ExpandTreeNode_Operation" json:"operation,omitempty"` // Operation to be applied on this tree node
-	Children      []*Expand                `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`                                          // The children of this tree node
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type isPrecondition_Type interface {
+	isPrecondition_Type()
 }
 
-func (x *ExpandTreeNode) Reset() {
-	*x = ExpandTreeNode{}
-	mi := &file_base_v1_base_proto_msgTypes[29]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+type Precondition_TupleExists struct {
+	// tuple_exists requires the tuple to be stored.
+	TupleExists *Tuple `protobuf:"bytes,1,opt,name=tuple_exists,proto3,oneof"`
 }
 
-func (x *ExpandTreeNode) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type Precondition_TupleNotExists struct {
+	// tuple_not_exists requires the tuple not to be stored.
+	TupleNotExists *Tuple `protobuf:"bytes,2,opt,name=tuple_not_exists,proto3,oneof"`
 }
 
-func (*ExpandTreeNode) ProtoMessage() {}
+type Precondition_FilterMatchesNone struct {
+	// filter_matches_none requires no stored tuple to match the filter.
+	FilterMatchesNone *TupleFilter `protobuf:"bytes,3,opt,name=filter_matches_none,proto3,oneof"`
+}
 
-func (x *ExpandTreeNode) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[29]
-	if x != nil {
+type Precondition_HeadSnapToken struct {
+	// head_snap_token requires the head snapshot of the tenant to be the given snap token,
+	// that is nothing has been written to the tenant since.
+	HeadSnapToken string `protobuf:"bytes,4,opt,name=head_snap_token,proto3,oneof"`
+}
+
+func (*Precondition_TupleExists) isPrecondition_Type() {}
+
+func (*Precondition_TupleNotExists) isPrecondition_Type() {}
+
+func (*Precondition_FilterMatchesNone) isPrecondition_Type() {}
+
+func (*Precondition_HeadSnapToken) isPrecondition_Type() {}
+
+// EntityFilter is used to filter entities based on the type and ids.
+type EntityFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`             // Type of the entity
+	Ids           []string               `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`               // List of entity IDs
+	Predicates    []*AttributePredicate  `protobuf:"bytes,3,rep,name=predicates,proto3" json:"predicates,omitempty"` // Predicates the attributes of the entities must satisfy
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntityFilter) Reset() {
+	*x = EntityFilter{}
+	mi := &file_base_v1_base_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityFilter) ProtoMessage() {}
+
+func (x *EntityFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_base_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityFilter.ProtoReflect.Descriptor instead.
+func (*EntityFilter) Descriptor() ([]byte, []int) {
+	return file_base_v1_base_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *EntityFilter) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EntityFilter) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *EntityFilter) GetPredicates() []*AttributePredicate {
+	if x != nil {
+		return x.Predicates
+	}
+	return nil
+}
+
+// SubjectFilter is used to filter subjects based on the type, ids and relation.
+type SubjectFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // Type of the subject
+	Ids           []string               `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`   // List of subject IDs
+	Relation      string                 `protobuf:"bytes,3,opt,name=relation,proto3" json:"relation,omitempty"`
+	Predicates    []*AttributePredicate  `protobuf:"bytes,4,rep,name=predicates,proto3" json:"predicates,omitempty"` // Predicates the attributes of the subjects must satisfy
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubjectFilter) Reset() {
+	*x = SubjectFilter{}
+	mi := &file_base_v1_base_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubjectFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubjectFilter) ProtoMessage() {}
+
+func (x *SubjectFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_base_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubjectFilter.ProtoReflect.Descriptor instead.
+func (*SubjectFilter) Descriptor() ([]byte, []int) {
+	return file_base_v1_base_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SubjectFilter) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SubjectFilter) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *SubjectFilter) GetRelation() string {
+	if x != nil {
+		return x.Relation
+	}
+	return ""
+}
+
+func (x *SubjectFilter) GetPredicates() []*AttributePredicate {
+	if x != nil {
+		return x.Predicates
+	}
+	return nil
+}
+
+// ExpandTreeNode represents a node in an expansion tree with a specific operation and its children.
+type ExpandTreeNode struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Operation     ExpandTreeNode_Operation `protobuf:"varint,1,opt,name=operation,proto3,enum=base.v1.ExpandTreeNode_Operation" json:"operation,omitempty"` // Operation to be applied on this tree node
+	Children      []*Expand                `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`                                          // The children of this tree node
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpandTreeNode) Reset() {
+	*x = ExpandTreeNode{}
+	mi := &file_base_v1_base_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpandTreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpandTreeNode) ProtoMessage() {}
+
+func (x *ExpandTreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_base_proto_msgTypes[33]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2182,7 +2869,7 @@ func (x *ExpandTreeNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExpandTreeNode.ProtoReflect.Descriptor instead.
 func (*ExpandTreeNode) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{29}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ExpandTreeNode) GetOperation() ExpandTreeNode_Operation {
@@ -2222,7 +2909,7 @@ type Expand struct {
 
 func (x *Expand) Reset() {
 	*x = Expand{}
-	mi := &file_base_v1_base_proto_msgTypes[30]
+	mi := &file_base_v1_base_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2234,7 +2921,7 @@ func (x *Expand) String() string {
 func (*Expand) ProtoMessage() {}
 
 func (x *Expand) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[30]
+	mi := &file_base_v1_base_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2247,7 +2934,7 @@ func (x *Expand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Expand.ProtoReflect.Descriptor instead.
 func (*Expand) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{30}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *Expand) GetEntity() *Entity {
@@ -2310,38 +2997,415 @@ type Expand_Leaf struct {
 	Leaf *ExpandLeaf `protobuf:"bytes,5,opt,name=leaf,proto3,oneof"`
 }
 
-func (*Expand_Expand) isExpand_Node() {}
+func (*Expand_Expand) isExpand_Node() {}
+
+func (*Expand_Leaf) isExpand_Node() {}
+
+// ExpandLeaf is the leaf node of an Expand tree and can be either a set of Subjects or a set of Values.
+type ExpandLeaf struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*ExpandLeaf_Subjects
+	//	*ExpandLeaf_Values
+	//	*ExpandLeaf_Value
+	Type          isExpandLeaf_Type `protobuf_oneof:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpandLeaf) Reset() {
+	*x = ExpandLeaf{}
+	mi := &file_base_v1_base_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpandLeaf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpandLeaf) ProtoMessage() {}
+
+func (x *ExpandLeaf) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_base_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpandLeaf.ProtoReflect.Descriptor instead.
+func (*ExpandLeaf) Descriptor() ([]byte, []int) {
+	return file_base_v1_base_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ExpandLeaf) GetType() isExpandLeaf_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *ExpandLeaf) GetSubjects() *Subjects {
+	if x != nil {
+		if x, ok := x.Type.(*ExpandLeaf_Subjects); ok {
+			return x.Subjects
+		}
+	}
+	return nil
+}
+
+func (x *ExpandLeaf) GetValues() *Values {
+	if x != nil {
+		if x, ok := x.Type.(*ExpandLeaf_Values); ok {
+			return x.Values
+		}
+	}
+	return nil
+}
+
+func (x *ExpandLeaf) GetValue() *anypb.Any {
+	if x != nil {
+		if x, ok := x.Type.(*ExpandLeaf_Value); ok {
+			return x.Value
+		}
+	}
+	return nil
+}
+
+type isExpandLeaf_Type interface {
+	isExpandLeaf_Type()
+}
+
+type ExpandLeaf_Subjects struct {
+	// subjects are used when the leaf is a set of subjects.
+	Subjects *Subjects `protobuf:"bytes,1,opt,name=subjects,proto3,oneof"`
+}
+
+type ExpandLeaf_Values struct {
+	// values are used when the leaf node is a set of values.
+	Values *Values `protobuf:"bytes,2,opt,name=values,proto3,oneof"`
+}
+
+type ExpandLeaf_Value struct {
+	// value is used when the leaf node is a single value.
+	Value *anypb.Any `protobuf:"bytes,3,opt,name=value,proto3,oneof"`
+}
+
+func (*ExpandLeaf_Subjects) isExpandLeaf_Type() {}
+
+func (*ExpandLeaf_Values) isExpandLeaf_Type() {}
+
+func (*ExpandLeaf_Value) isExpandLeaf_Type() {}
+
+type Values struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        map[string]*anypb.Any  `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Values) Reset() {
+	*x = Values{}
+	mi := &file_base_v1_base_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Values) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Values) ProtoMessage() {}
+
+func (x *Values) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_base_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Values.ProtoReflect.Descriptor instead.
+func (*Values) Descriptor() ([]byte, []int) {
+	return file_base_v1_base_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Values) GetValues() map[string]*anypb.Any {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// Subjects holds a repeated field of Subject type.
+type Subjects struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subjects      []*Subject             `protobuf:"bytes,1,rep,name=subjects,proto3" json:"subjects,omitempty"` // A list of subjects.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subjects) Reset() {
+	*x = Subjects{}
+	mi := &file_base_v1_base_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subjects) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subjects) ProtoMessage() {}
+
+func (x *Subjects) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_base_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subjects.ProtoReflect.Descriptor instead.
+func (*Subjects) Descriptor() ([]byte, []int) {
+	return file_base_v1_base_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Subjects) GetSubjects() []*Subject {
+	if x != nil {
+		return x.Subjects
+	}
+	return nil
+}
+
+// Tenant represents a tenant with an id, a name, and a timestamp indicating when it was created.
+type Tenant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                 // The ID of the tenant.
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`             // The name of the tenant.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,proto3" json:"created_at,omitempty"` // The time at which the tenant was created.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tenant) Reset() {
+	*x = Tenant{}
+	mi := &file_base_v1_base_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant) ProtoMessage() {}
+
+func (x *Tenant) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_base_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
+func (*Tenant) Descriptor() ([]byte, []int) {
+	return file_base_v1_base_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Tenant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tenant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tenant) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// AuditRecord represents a mutation made through the API, along with who made it and its outcome.
+type AuditRecord struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                      // The ID of the record.
+	TenantId            string                 `protobuf:"bytes,2,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`                        // The tenant the mutation was made in.
+	Actor               string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`                                // The authenticated identity of the caller, empty if authentication is disabled.
+	Method              string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`                              // The RPC of the mutation, e.g. "Data.Write".
+	Summary             string                 `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`                            // A short summary of the request, e.g. the number of tuples written.
+	SnapToken           string                 `protobuf:"bytes,6,opt,name=snap_token,proto3" json:"snap_token,omitempty"`                      // The snap token resulting from a data mutation.
+	SchemaVersion       string                 `protobuf:"bytes,7,opt,name=schema_version,proto3" json:"schema_version,omitempty"`              // The schema version resulting from a schema mutation.
+	Outcome             string                 `protobuf:"bytes,8,opt,name=outcome,proto3" json:"outcome,omitempty"`                            // The status code of the request, "OK" if it succeeded.
+	Error               string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`                                // The error message if the request failed.
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,proto3" json:"created_at,omitempty"`                     // The time at which the mutation was made.
+	TransactionMetadata *TransactionMetadata   `protobuf:"bytes,11,opt,name=transaction_metadata,proto3" json:"transaction_metadata,omitempty"` // The metadata the mutation was made with, if any.
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AuditRecord) Reset() {
+	*x = AuditRecord{}
+	mi := &file_base_v1_base_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditRecord) ProtoMessage() {}
+
+func (x *AuditRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_base_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditRecord.ProtoReflect.Descriptor instead.
+func (*AuditRecord) Descriptor() ([]byte, []int) {
+	return file_base_v1_base_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AuditRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetSnapToken() string {
+	if x != nil {
+		return x.SnapToken
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
 
-func (*Expand_Leaf) isExpand_Node() {}
+func (x *AuditRecord) GetTransactionMetadata() *TransactionMetadata {
+	if x != nil {
+		return x.TransactionMetadata
+	}
+	return nil
+}
 
-// ExpandLeaf is the leaf node of an Expand tree and can be either a set of Subjects or a set of Values.
-type ExpandLeaf struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Type:
-	//
-	//	*ExpandLeaf_Subjects
-	//	*ExpandLeaf_Values
-	//	*ExpandLeaf_Value
-	Type          isExpandLeaf_Type `protobuf_oneof:"type"`
+// AccessReviewEntry represents a permission a subject holds on an entity, and how the subject came to hold it.
+type AccessReviewEntry struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	EntityType    string                   `protobuf:"bytes,1,opt,name=entity_type,proto3" json:"entity_type,omitempty"`                              // The type of the entity.
+	EntityId      string                   `protobuf:"bytes,2,opt,name=entity_id,proto3" json:"entity_id,omitempty"`                                  // The ID of the entity.
+	Permission    string                   `protobuf:"bytes,3,opt,name=permission,proto3" json:"permission,omitempty"`                                // The permission held on the entity.
+	Subject       *Subject                 `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`                                      // The subject holding the permission.
+	Access        AccessReviewEntry_Access `protobuf:"varint,5,opt,name=access,proto3,enum=base.v1.AccessReviewEntry_Access" json:"access,omitempty"` // How the permission is held.
+	Path          []string                 `protobuf:"bytes,6,rep,name=path,proto3" json:"path,omitempty"`                                            // The relations granting the permission, from the entity to the relation holding the subject, e.g. "doc:1#parent", "folder:1#collaborator".
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExpandLeaf) Reset() {
-	*x = ExpandLeaf{}
-	mi := &file_base_v1_base_proto_msgTypes[31]
+func (x *AccessReviewEntry) Reset() {
+	*x = AccessReviewEntry{}
+	mi := &file_base_v1_base_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExpandLeaf) String() string {
+func (x *AccessReviewEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExpandLeaf) ProtoMessage() {}
+func (*AccessReviewEntry) ProtoMessage() {}
 
-func (x *ExpandLeaf) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[31]
+func (x *AccessReviewEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_base_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2352,92 +3416,82 @@ func (x *ExpandLeaf) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExpandLeaf.ProtoReflect.Descriptor instead.
-func (*ExpandLeaf) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use AccessReviewEntry.ProtoReflect.Descriptor instead.
+func (*AccessReviewEntry) Descriptor() ([]byte, []int) {
+	return file_base_v1_base_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ExpandLeaf) GetType() isExpandLeaf_Type {
+func (x *AccessReviewEntry) GetEntityType() string {
 	if x != nil {
-		return x.Type
+		return x.EntityType
 	}
-	return nil
+	return ""
 }
 
-func (x *ExpandLeaf) GetSubjects() *Subjects {
+func (x *AccessReviewEntry) GetEntityId() string {
 	if x != nil {
-		if x, ok := x.Type.(*ExpandLeaf_Subjects); ok {
-			return x.Subjects
-		}
+		return x.EntityId
 	}
-	return nil
+	return ""
 }
 
-func (x *ExpandLeaf) GetValues() *Values {
+func (x *AccessReviewEntry) GetPermission() string {
 	if x != nil {
-		if x, ok := x.Type.(*ExpandLeaf_Values); ok {
-			return x.Values
-		}
+		return x.Permission
 	}
-	return nil
+	return ""
 }
 
-func (x *ExpandLeaf) GetValue() *anypb.Any {
+func (x *AccessReviewEntry) GetSubject() *Subject {
 	if x != nil {
-		if x, ok := x.Type.(*ExpandLeaf_Value); ok {
-			return x.Value
-		}
+		return x.Subject
 	}
 	return nil
 }
 
-type isExpandLeaf_Type interface {
-	isExpandLeaf_Type()
-}
-
-type ExpandLeaf_Subjects struct {
-	// subjects are used when the leaf is a set of subjects.
-	Subjects *Subjects `protobuf:"bytes,1,opt,name=subjects,proto3,oneof"`
-}
-
-type ExpandLeaf_Values struct {
-	// values are used when the leaf node is a set of values.
-	Values *Values `protobuf:"bytes,2,opt,name=values,proto3,oneof"`
+func (x *AccessReviewEntry) GetAccess() AccessReviewEntry_Access {
+	if x != nil {
+		return x.Access
+	}
+	return AccessReviewEntry_ACCESS_UNSPECIFIED
 }
 
-type ExpandLeaf_Value struct {
-	// value is used when the leaf node is a single value.
-	Value *anypb.Any `protobuf:"bytes,3,opt,name=value,proto3,oneof"`
+func (x *AccessReviewEntry) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
 }
 
-func (*ExpandLeaf_Subjects) isExpandLeaf_Type() {}
-
-func (*ExpandLeaf_Values) isExpandLeaf_Type() {}
-
-func (*ExpandLeaf_Value) isExpandLeaf_Type() {}
-
-type Values struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Values        map[string]*anypb.Any  `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+// Consistency selects the snapshot a read is answered at.
+type Consistency struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Requirement:
+	//
+	//	*Consistency_MinimizeLatency
+	//	*Consistency_AtLeastAsFresh
+	//	*Consistency_AtExactSnapshot
+	//	*Consistency_FullyConsistent
+	Requirement   isConsistency_Requirement `protobuf_oneof:"requirement"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Values) Reset() {
-	*x = Values{}
-	mi := &file_base_v1_base_proto_msgTypes[32]
+func (x *Consistency) Reset() {
+	*x = Consistency{}
+	mi := &file_base_v1_base_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Values) String() string {
+func (x *Consistency) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Values) ProtoMessage() {}
+func (*Consistency) ProtoMessage() {}
 
-func (x *Values) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[32]
+func (x *Consistency) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_base_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2448,88 +3502,114 @@ func (x *Values) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Values.ProtoReflect.Descriptor instead.
-func (*Values) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use Consistency.ProtoReflect.Descriptor instead.
+func (*Consistency) Descriptor() ([]byte, []int) {
+	return file_base_v1_base_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *Values) GetValues() map[string]*anypb.Any {
+func (x *Consistency) GetRequirement() isConsistency_Requirement {
 	if x != nil {
-		return x.Values
+		return x.Requirement
 	}
 	return nil
 }
 
-// Subjects holds a repeated field of Subject type.
-type Subjects struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Subjects      []*Subject             `protobuf:"bytes,1,rep,name=subjects,proto3" json:"subjects,omitempty"` // A list of subjects.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Consistency) GetMinimizeLatency() bool {
+	if x != nil {
+		if x, ok := x.Requirement.(*Consistency_MinimizeLatency); ok {
+			return x.MinimizeLatency
+		}
+	}
+	return false
 }
 
-func (x *Subjects) Reset() {
-	*x = Subjects{}
-	mi := &file_base_v1_base_proto_msgTypes[33]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Consistency) GetAtLeastAsFresh() string {
+	if x != nil {
+		if x, ok := x.Requirement.(*Consistency_AtLeastAsFresh); ok {
+			return x.AtLeastAsFresh
+		}
+	}
+	return ""
 }
 
-func (x *Subjects) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Consistency) GetAtExactSnapshot() string {
+	if x != nil {
+		if x, ok := x.Requirement.(*Consistency_AtExactSnapshot); ok {
+			return x.AtExactSnapshot
+		}
+	}
+	return ""
 }
 
-func (*Subjects) ProtoMessage() {}
-
-func (x *Subjects) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[33]
+func (x *Consistency) GetFullyConsistent() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		if x, ok := x.Requirement.(*Consistency_FullyConsistent); ok {
+			return x.FullyConsistent
 		}
-		return ms
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use Subjects.ProtoReflect.Descriptor instead.
-func (*Subjects) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{33}
+type isConsistency_Requirement interface {
+	isConsistency_Requirement()
 }
 
-func (x *Subjects) GetSubjects() []*Subject {
-	if x != nil {
-		return x.Subjects
-	}
-	return nil
+type Consistency_MinimizeLatency struct {
+	// Answer at a recent snapshot of the tenant, shared by the requests and refreshed periodically so that their
+	// cache keys line up. Writes may not be visible until the next refresh.
+	MinimizeLatency bool `protobuf:"varint,1,opt,name=minimize_latency,proto3,oneof"`
 }
 
-// Tenant represents a tenant with an id, a name, and a timestamp indicating when it was created.
-type Tenant struct {
+type Consistency_AtLeastAsFresh struct {
+	// Answer at a snapshot at least as fresh as the given snap token: the recent snapshot of the tenant if it is,
+	// the snap token itself otherwise.
+	AtLeastAsFresh string `protobuf:"bytes,2,opt,name=at_least_as_fresh,proto3,oneof"`
+}
+
+type Consistency_AtExactSnapshot struct {
+	// Answer at exactly the given snap token.
+	AtExactSnapshot string `protobuf:"bytes,3,opt,name=at_exact_snapshot,proto3,oneof"`
+}
+
+type Consistency_FullyConsistent struct {
+	// Answer at the head snapshot of the tenant, reading every committed write.
+	FullyConsistent bool `protobuf:"varint,4,opt,name=fully_consistent,proto3,oneof"`
+}
+
+func (*Consistency_MinimizeLatency) isConsistency_Requirement() {}
+
+func (*Consistency_AtLeastAsFresh) isConsistency_Requirement() {}
+
+func (*Consistency_AtExactSnapshot) isConsistency_Requirement() {}
+
+func (*Consistency_FullyConsistent) isConsistency_Requirement() {}
+
+// TransactionMetadata describes who made a write and why. It is persisted alongside the transaction of the write.
+type TransactionMetadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                 // The ID of the tenant.
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`             // The name of the tenant.
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,proto3" json:"created_at,omitempty"` // The time at which the tenant was created.
+	Actor         string                 `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`                                                                             // The user or service on whose behalf the write is made.
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                                                                           // Why the write is made, e.g. a ticket reference.
+	RequestId     string                 `protobuf:"bytes,3,opt,name=request_id,proto3" json:"request_id,omitempty"`                                                                   // The ID of the request that caused the write, for correlating it with other systems.
+	Labels        map[string]string      `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Arbitrary labels of the write.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Tenant) Reset() {
-	*x = Tenant{}
-	mi := &file_base_v1_base_proto_msgTypes[34]
+func (x *TransactionMetadata) Reset() {
+	*x = TransactionMetadata{}
+	mi := &file_base_v1_base_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Tenant) String() string {
+func (x *TransactionMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tenant) ProtoMessage() {}
+func (*TransactionMetadata) ProtoMessage() {}
 
-func (x *Tenant) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[34]
+func (x *TransactionMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_base_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2540,28 +3620,35 @@ func (x *Tenant) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
-func (*Tenant) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use TransactionMetadata.ProtoReflect.Descriptor instead.
+func (*TransactionMetadata) Descriptor() ([]byte, []int) {
+	return file_base_v1_base_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *Tenant) GetId() string {
+func (x *TransactionMetadata) GetActor() string {
 	if x != nil {
-		return x.Id
+		return x.Actor
 	}
 	return ""
 }
 
-func (x *Tenant) GetName() string {
+func (x *TransactionMetadata) GetReason() string {
 	if x != nil {
-		return x.Name
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *Tenant) GetCreatedAt() *timestamppb.Timestamp {
+func (x *TransactionMetadata) GetRequestId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *TransactionMetadata) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
 	}
 	return nil
 }
@@ -2571,13 +3658,14 @@ type DataChanges struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SnapToken     string                 `protobuf:"bytes,1,opt,name=snap_token,proto3" json:"snap_token,omitempty"`     // The snapshot token.
 	DataChanges   []*DataChange          `protobuf:"bytes,2,rep,name=data_changes,proto3" json:"data_changes,omitempty"` // The list of data changes.
+	Metadata      *TransactionMetadata   `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`         // The metadata the changes were written with, if any.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DataChanges) Reset() {
 	*x = DataChanges{}
-	mi := &file_base_v1_base_proto_msgTypes[35]
+	mi := &file_base_v1_base_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2589,7 +3677,7 @@ func (x *DataChanges) String() string {
 func (*DataChanges) ProtoMessage() {}
 
 func (x *DataChanges) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[35]
+	mi := &file_base_v1_base_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2602,7 +3690,7 @@ func (x *DataChanges) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataChanges.ProtoReflect.Descriptor instead.
 func (*DataChanges) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{35}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *DataChanges) GetSnapToken() string {
@@ -2619,6 +3707,13 @@ func (x *DataChanges) GetDataChanges() []*DataChange {
 	return nil
 }
 
+func (x *DataChanges) GetMetadata() *TransactionMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 // DataChange represents a single change in data, with an operation type and the actual change which could be a tuple or an attribute.
 type DataChange struct {
 	state     protoimpl.MessageState `protogen:"open.v1"`
@@ -2634,7 +3729,7 @@ type DataChange struct {
 
 func (x *DataChange) Reset() {
 	*x = DataChange{}
-	mi := &file_base_v1_base_proto_msgTypes[36]
+	mi := &file_base_v1_base_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2646,7 +3741,7 @@ func (x *DataChange) String() string {
 func (*DataChange) ProtoMessage() {}
 
 func (x *DataChange) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[36]
+	mi := &file_base_v1_base_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2659,7 +3754,7 @@ func (x *DataChange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataChange.ProtoReflect.Descriptor instead.
 func (*DataChange) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{36}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *DataChange) GetOperation() DataChange_Operation {
@@ -2720,7 +3815,7 @@ type StringValue struct {
 
 func (x *StringValue) Reset() {
 	*x = StringValue{}
-	mi := &file_base_v1_base_proto_msgTypes[37]
+	mi := &file_base_v1_base_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2732,7 +3827,7 @@ func (x *StringValue) String() string {
 func (*StringValue) ProtoMessage() {}
 
 func (x *StringValue) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[37]
+	mi := &file_base_v1_base_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2745,7 +3840,7 @@ func (x *StringValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StringValue.ProtoReflect.Descriptor instead.
 func (*StringValue) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{37}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *StringValue) GetData() string {
@@ -2765,7 +3860,7 @@ type IntegerValue struct {
 
 func (x *IntegerValue) Reset() {
 	*x = IntegerValue{}
-	mi := &file_base_v1_base_proto_msgTypes[38]
+	mi := &file_base_v1_base_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2777,7 +3872,7 @@ func (x *IntegerValue) String() string {
 func (*IntegerValue) ProtoMessage() {}
 
 func (x *IntegerValue) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[38]
+	mi := &file_base_v1_base_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2790,7 +3885,7 @@ func (x *IntegerValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntegerValue.ProtoReflect.Descriptor instead.
 func (*IntegerValue) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{38}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *IntegerValue) GetData() int32 {
@@ -2810,7 +3905,7 @@ type DoubleValue struct {
 
 func (x *DoubleValue) Reset() {
 	*x = DoubleValue{}
-	mi := &file_base_v1_base_proto_msgTypes[39]
+	mi := &file_base_v1_base_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2822,7 +3917,7 @@ func (x *DoubleValue) String() string {
 func (*DoubleValue) ProtoMessage() {}
 
 func (x *DoubleValue) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[39]
+	mi := &file_base_v1_base_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2835,7 +3930,7 @@ func (x *DoubleValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DoubleValue.ProtoReflect.Descriptor instead.
 func (*DoubleValue) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{39}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *DoubleValue) GetData() float64 {
@@ -2855,7 +3950,7 @@ type BooleanValue struct {
 
 func (x *BooleanValue) Reset() {
 	*x = BooleanValue{}
-	mi := &file_base_v1_base_proto_msgTypes[40]
+	mi := &file_base_v1_base_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2867,7 +3962,7 @@ func (x *BooleanValue) String() string {
 func (*BooleanValue) ProtoMessage() {}
 
 func (x *BooleanValue) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[40]
+	mi := &file_base_v1_base_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2880,7 +3975,7 @@ func (x *BooleanValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BooleanValue.ProtoReflect.Descriptor instead.
 func (*BooleanValue) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{40}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *BooleanValue) GetData() bool {
@@ -2900,7 +3995,7 @@ type StringArrayValue struct {
 
 func (x *StringArrayValue) Reset() {
 	*x = StringArrayValue{}
-	mi := &file_base_v1_base_proto_msgTypes[41]
+	mi := &file_base_v1_base_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2912,7 +4007,7 @@ func (x *StringArrayValue) String() string {
 func (*StringArrayValue) ProtoMessage() {}
 
 func (x *StringArrayValue) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[41]
+	mi := &file_base_v1_base_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2925,7 +4020,7 @@ func (x *StringArrayValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StringArrayValue.ProtoReflect.Descriptor instead.
 func (*StringArrayValue) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{41}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *StringArrayValue) GetData() []string {
@@ -2945,7 +4040,7 @@ type IntegerArrayValue struct {
 
 func (x *IntegerArrayValue) Reset() {
 	*x = IntegerArrayValue{}
-	mi := &file_base_v1_base_proto_msgTypes[42]
+	mi := &file_base_v1_base_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2957,7 +4052,7 @@ func (x *IntegerArrayValue) String() string {
 func (*IntegerArrayValue) ProtoMessage() {}
 
 func (x *IntegerArrayValue) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[42]
+	mi := &file_base_v1_base_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2970,7 +4065,7 @@ func (x *IntegerArrayValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntegerArrayValue.ProtoReflect.Descriptor instead.
 func (*IntegerArrayValue) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{42}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *IntegerArrayValue) GetData() []int32 {
@@ -2990,7 +4085,7 @@ type DoubleArrayValue struct {
 
 func (x *DoubleArrayValue) Reset() {
 	*x = DoubleArrayValue{}
-	mi := &file_base_v1_base_proto_msgTypes[43]
+	mi := &file_base_v1_base_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3002,7 +4097,7 @@ func (x *DoubleArrayValue) String() string {
 func (*DoubleArrayValue) ProtoMessage() {}
 
 func (x *DoubleArrayValue) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[43]
+	mi := &file_base_v1_base_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3015,7 +4110,7 @@ func (x *DoubleArrayValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DoubleArrayValue.ProtoReflect.Descriptor instead.
 func (*DoubleArrayValue) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{43}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *DoubleArrayValue) GetData() []float64 {
@@ -3035,7 +4130,7 @@ type BooleanArrayValue struct {
 
 func (x *BooleanArrayValue) Reset() {
 	*x = BooleanArrayValue{}
-	mi := &file_base_v1_base_proto_msgTypes[44]
+	mi := &file_base_v1_base_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3047,7 +4142,7 @@ func (x *BooleanArrayValue) String() string {
 func (*BooleanArrayValue) ProtoMessage() {}
 
 func (x *BooleanArrayValue) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[44]
+	mi := &file_base_v1_base_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3060,7 +4155,7 @@ func (x *BooleanArrayValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BooleanArrayValue.ProtoReflect.Descriptor instead.
 func (*BooleanArrayValue) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{44}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *BooleanArrayValue) GetData() []bool {
@@ -3088,7 +4183,7 @@ type DataBundle struct {
 
 func (x *DataBundle) Reset() {
 	*x = DataBundle{}
-	mi := &file_base_v1_base_proto_msgTypes[45]
+	mi := &file_base_v1_base_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3100,7 +4195,7 @@ func (x *DataBundle) String() string {
 func (*DataBundle) ProtoMessage() {}
 
 func (x *DataBundle) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[45]
+	mi := &file_base_v1_base_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3113,7 +4208,7 @@ func (x *DataBundle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataBundle.ProtoReflect.Descriptor instead.
 func (*DataBundle) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{45}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *DataBundle) GetName() string {
@@ -3159,7 +4254,7 @@ type Operation struct {
 
 func (x *Operation) Reset() {
 	*x = Operation{}
-	mi := &file_base_v1_base_proto_msgTypes[46]
+	mi := &file_base_v1_base_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3171,7 +4266,7 @@ func (x *Operation) String() string {
 func (*Operation) ProtoMessage() {}
 
 func (x *Operation) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[46]
+	mi := &file_base_v1_base_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3184,7 +4279,7 @@ func (x *Operation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Operation.ProtoReflect.Descriptor instead.
 func (*Operation) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{46}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *Operation) GetRelationshipsWrite() []string {
@@ -3227,7 +4322,7 @@ type Partials struct {
 
 func (x *Partials) Reset() {
 	*x = Partials{}
-	mi := &file_base_v1_base_proto_msgTypes[47]
+	mi := &file_base_v1_base_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3239,7 +4334,7 @@ func (x *Partials) String() string {
 func (*Partials) ProtoMessage() {}
 
 func (x *Partials) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_base_proto_msgTypes[47]
+	mi := &file_base_v1_base_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3252,7 +4347,7 @@ func (x *Partials) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Partials.ProtoReflect.Descriptor instead.
 func (*Partials) Descriptor() ([]byte, []int) {
-	return file_base_v1_base_proto_rawDescGZIP(), []int{47}
+	return file_base_v1_base_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *Partials) GetWrite() []string {
@@ -3290,12 +4385,13 @@ const file_base_v1_base_proto_rawDesc = "" +
 	"\x05Child\x12-\n" +
 	"\x04leaf\x18\x01 \x01(\v2\r.base.v1.LeafB\b\xfaB\x05\x8a\x01\x02\x10\x01H\x00R\x04leaf\x126\n" +
 	"\arewrite\x18\x02 \x01(\v2\x10.base.v1.RewriteB\b\xfaB\x05\x8a\x01\x02\x10\x01H\x00R\arewriteB\v\n" +
-	"\x04type\x12\x03\xf8B\x01\"\xbb\x02\n" +
+	"\x04type\x12\x03\xf8B\x01\"\xed\x02\n" +
 	"\x04Leaf\x12P\n" +
 	"\x11computed_user_set\x18\x01 \x01(\v2\x18.base.v1.ComputedUserSetB\b\xfaB\x05\x8a\x01\x02\x10\x01H\x00R\x0fcomputedUserSet\x12N\n" +
 	"\x11tuple_to_user_set\x18\x02 \x01(\v2\x17.base.v1.TupleToUserSetB\b\xfaB\x05\x8a\x01\x02\x10\x01H\x00R\x0etupleToUserSet\x12U\n" +
 	"\x12computed_attribute\x18\x03 \x01(\v2\x1a.base.v1.ComputedAttributeB\b\xfaB\x05\x8a\x01\x02\x10\x01H\x00R\x11computedAttribute\x12-\n" +
-	"\x04call\x18\x04 \x01(\v2\r.base.v1.CallB\b\xfaB\x05\x8a\x01\x02\x10\x01H\x00R\x04callB\v\n" +
+	"\x04call\x18\x04 \x01(\v2\r.base.v1.CallB\b\xfaB\x05\x8a\x01\x02\x10\x01H\x00R\x04call\x120\n" +
+	"\x05count\x18\x05 \x01(\v2\x0e.base.v1.CountB\b\xfaB\x05\x8a\x01\x02\x10\x01H\x00R\x05countB\v\n" +
 	"\x04type\x12\x03\xf8B\x01\"\xf0\x01\n" +
 	"\aRewrite\x12G\n" +
 	"\x11rewrite_operation\x18\x01 \x01(\x0e2\x1a.base.v1.Rewrite.OperationR\x10rewriteOperation\x12*\n" +
@@ -3323,7 +4419,7 @@ const file_base_v1_base_proto_rawDesc = "" +
 	"\tReference\x12\x19\n" +
 	"\x15REFERENCE_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10REFERENCE_ENTITY\x10\x01\x12\x12\n" +
-	"\x0eREFERENCE_RULE\x10\x02\"\xdc\x06\n" +
+	"\x0eREFERENCE_RULE\x10\x02\"\x94\a\n" +
 	"\x10EntityDefinition\x12.\n" +
 	"\x04name\x18\x01 \x01(\tB\x1a\xfaB\x17r\x15(@2\x11^[a-zA-Z_]{1,64}$R\x04name\x12F\n" +
 	"\trelations\x18\x02 \x03(\v2(.base.v1.EntityDefinition.RelationsEntryR\trelations\x12L\n" +
@@ -3333,7 +4429,8 @@ const file_base_v1_base_proto_rawDesc = "" +
 	"attributes\x12I\n" +
 	"\n" +
 	"references\x18\x05 \x03(\v2).base.v1.EntityDefinition.ReferencesEntryR\n" +
-	"references\x1aY\n" +
+	"references\x126\n" +
+	"\vannotations\x18\x06 \x01(\v2\x14.base.v1.AnnotationsR\vannotations\x1aY\n" +
 	"\x0eRelationsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x121\n" +
 	"\x05value\x18\x02 \x01(\v2\x1b.base.v1.RelationDefinitionR\x05value:\x028\x01\x1a]\n" +
@@ -3350,25 +4447,46 @@ const file_base_v1_base_proto_rawDesc = "" +
 	"\x15REFERENCE_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12REFERENCE_RELATION\x10\x01\x12\x18\n" +
 	"\x14REFERENCE_PERMISSION\x10\x02\x12\x17\n" +
-	"\x13REFERENCE_ATTRIBUTE\x10\x03\"\xa3\x02\n" +
+	"\x13REFERENCE_ATTRIBUTE\x10\x03\"\xdb\x02\n" +
 	"\x0eRuleDefinition\x12.\n" +
 	"\x04name\x18\x01 \x01(\tB\x1a\xfaB\x17r\x15(@2\x11^[a-zA-Z_]{1,64}$R\x04name\x12D\n" +
 	"\targuments\x18\x02 \x03(\v2&.base.v1.RuleDefinition.ArgumentsEntryR\targuments\x12E\n" +
 	"\n" +
 	"expression\x18\x03 \x01(\v2%.google.api.expr.v1alpha1.CheckedExprR\n" +
-	"expression\x1aT\n" +
+	"expression\x126\n" +
+	"\vannotations\x18\x04 \x01(\v2\x14.base.v1.AnnotationsR\vannotations\x1aT\n" +
 	"\x0eArgumentsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
-	"\x05value\x18\x02 \x01(\x0e2\x16.base.v1.AttributeTypeR\x05value:\x028\x01\"q\n" +
+	"\x05value\x18\x02 \x01(\x0e2\x16.base.v1.AttributeTypeR\x05value:\x028\x01\"\xa9\x01\n" +
 	"\x13AttributeDefinition\x12.\n" +
 	"\x04name\x18\x01 \x01(\tB\x1a\xfaB\x17r\x15(@2\x11^[a-zA-Z_]{1,64}$R\x04name\x12*\n" +
-	"\x04type\x18\x02 \x01(\x0e2\x16.base.v1.AttributeTypeR\x04type\"\x91\x01\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x16.base.v1.AttributeTypeR\x04type\x126\n" +
+	"\vannotations\x18\x03 \x01(\v2\x14.base.v1.AnnotationsR\vannotations\"\xfd\x03\n" +
 	"\x12RelationDefinition\x12.\n" +
 	"\x04name\x18\x01 \x01(\tB\x1a\xfaB\x17r\x15(@2\x11^[a-zA-Z_]{1,64}$R\x04name\x12K\n" +
-	"\x13relation_references\x18\x02 \x03(\v2\x1a.base.v1.RelationReferenceR\x12relationReferences\"l\n" +
+	"\x13relation_references\x18\x02 \x03(\v2\x1a.base.v1.RelationReferenceR\x12relationReferences\x12I\n" +
+	"\vcardinality\x18\x03 \x01(\x0e2'.base.v1.RelationDefinition.CardinalityR\vcardinality\x12G\n" +
+	"\von_conflict\x18\x04 \x01(\x0e2&.base.v1.RelationDefinition.OnConflictR\n" +
+	"onConflict\x126\n" +
+	"\vannotations\x18\x05 \x01(\v2\x14.base.v1.AnnotationsR\vannotations\"B\n" +
+	"\vCardinality\x12\x1b\n" +
+	"\x17CARDINALITY_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12CARDINALITY_SINGLE\x10\x01\"Z\n" +
+	"\n" +
+	"OnConflict\x12\x1b\n" +
+	"\x17ON_CONFLICT_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12ON_CONFLICT_REJECT\x10\x01\x12\x17\n" +
+	"\x13ON_CONFLICT_REPLACE\x10\x02\"\xa4\x01\n" +
 	"\x14PermissionDefinition\x12.\n" +
 	"\x04name\x18\x01 \x01(\tB\x1a\xfaB\x17r\x15(@2\x11^[a-zA-Z_]{1,64}$R\x04name\x12$\n" +
-	"\x05child\x18\x02 \x01(\v2\x0e.base.v1.ChildR\x05child\"~\n" +
+	"\x05child\x18\x02 \x01(\v2\x0e.base.v1.ChildR\x05child\x126\n" +
+	"\vannotations\x18\x03 \x01(\v2\x14.base.v1.AnnotationsR\vannotations\"e\n" +
+	"\vAnnotations\x12 \n" +
+	"\vdescription\x18\x01 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05owner\x18\x02 \x01(\tR\x05owner\x12\x1e\n" +
+	"\n" +
+	"deprecated\x18\x03 \x01(\bR\n" +
+	"deprecated\"~\n" +
 	"\x11RelationReference\x12.\n" +
 	"\x04type\x18\x01 \x01(\tB\x1a\xfaB\x17r\x15(@2\x11^[a-zA-Z_]{1,64}$R\x04type\x129\n" +
 	"\brelation\x18\x02 \x01(\tB\x1d\xfaB\x1ar\x18(@2\x11^[a-zA-Z_]{1,64}$\xd0\x01\x01R\brelation\"l\n" +
@@ -3380,7 +4498,22 @@ const file_base_v1_base_proto_rawDesc = "" +
 	"\x04type\"T\n" +
 	"\x04Call\x12\x1b\n" +
 	"\trule_name\x18\x01 \x01(\tR\bruleName\x12/\n" +
-	"\targuments\x18\x02 \x03(\v2\x11.base.v1.ArgumentR\targuments\"C\n" +
+	"\targuments\x18\x02 \x03(\v2\x11.base.v1.ArgumentR\targuments\"\xeb\x02\n" +
+	"\x05Count\x126\n" +
+	"\brelation\x18\x01 \x01(\tB\x1a\xfaB\x17r\x15(@2\x11^[a-zA-Z_]{1,64}$R\brelation\x129\n" +
+	"\n" +
+	"comparison\x18\x02 \x01(\x0e2\x19.base.v1.Count.ComparisonR\n" +
+	"comparison\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\rR\x05value\"\xd8\x01\n" +
+	"\n" +
+	"Comparison\x12\x1a\n" +
+	"\x16COMPARISON_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10COMPARISON_EQUAL\x10\x01\x12\x18\n" +
+	"\x14COMPARISON_NOT_EQUAL\x10\x02\x12\x1b\n" +
+	"\x17COMPARISON_GREATER_THAN\x10\x03\x12$\n" +
+	" COMPARISON_GREATER_THAN_OR_EQUAL\x10\x04\x12\x18\n" +
+	"\x14COMPARISON_LESS_THAN\x10\x05\x12!\n" +
+	"\x1dCOMPARISON_LESS_THAN_OR_EQUAL\x10\x06\"C\n" +
 	"\x11ComputedAttribute\x12.\n" +
 	"\x04name\x18\x01 \x01(\tB\x1a\xfaB\x17r\x15(@2\x11^[a-zA-Z_]{1,64}$R\x04name\"I\n" +
 	"\x0fComputedUserSet\x126\n" +
@@ -3419,18 +4552,44 @@ const file_base_v1_base_proto_rawDesc = "" +
 	"\x06entity\x18\x01 \x01(\v2\x15.base.v1.EntityFilterR\x06entity\x12\x1e\n" +
 	"\n" +
 	"attributes\x18\x02 \x03(\tR\n" +
-	"attributes\"\xa9\x01\n" +
+	"attributes\"\xb7\x03\n" +
+	"\x12AttributePredicate\x12;\n" +
+	"\tattribute\x18\x01 \x01(\tB\x1d\xfaB\x1ar\x18(@2\x11^[a-zA-Z_]{1,64}$\xd0\x01\x00R\tattribute\x12L\n" +
+	"\boperator\x18\x02 \x01(\x0e2$.base.v1.AttributePredicate.OperatorB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\boperator\x124\n" +
+	"\x05value\x18\x03 \x01(\v2\x14.google.protobuf.AnyB\b\xfaB\x05\xa2\x01\x02\b\x01R\x05value\"\xdf\x01\n" +
+	"\bOperator\x12\x18\n" +
+	"\x14OPERATOR_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eOPERATOR_EQUAL\x10\x01\x12\x16\n" +
+	"\x12OPERATOR_NOT_EQUAL\x10\x02\x12\x16\n" +
+	"\x12OPERATOR_LESS_THAN\x10\x03\x12\x1f\n" +
+	"\x1bOPERATOR_LESS_THAN_OR_EQUAL\x10\x04\x12\x19\n" +
+	"\x15OPERATOR_GREATER_THAN\x10\x05\x12\"\n" +
+	"\x1eOPERATOR_GREATER_THAN_OR_EQUAL\x10\x06\x12\x15\n" +
+	"\x11OPERATOR_CONTAINS\x10\a\"\xa9\x01\n" +
 	"\vTupleFilter\x12-\n" +
 	"\x06entity\x18\x01 \x01(\v2\x15.base.v1.EntityFilterR\x06entity\x129\n" +
 	"\brelation\x18\x02 \x01(\tB\x1d\xfaB\x1ar\x18(@2\x11^[a-zA-Z_]{1,64}$\xd0\x01\x01R\brelation\x120\n" +
-	"\asubject\x18\x03 \x01(\v2\x16.base.v1.SubjectFilterR\asubject\"4\n" +
+	"\asubject\x18\x03 \x01(\v2\x16.base.v1.SubjectFilterR\asubject\"\x8e\x02\n" +
+	"\fPrecondition\x124\n" +
+	"\ftuple_exists\x18\x01 \x01(\v2\x0e.base.v1.TupleH\x00R\ftuple_exists\x12<\n" +
+	"\x10tuple_not_exists\x18\x02 \x01(\v2\x0e.base.v1.TupleH\x00R\x10tuple_not_exists\x12H\n" +
+	"\x13filter_matches_none\x18\x03 \x01(\v2\x14.base.v1.TupleFilterH\x00R\x13filter_matches_none\x123\n" +
+	"\x0fhead_snap_token\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x01H\x00R\x0fhead_snap_tokenB\v\n" +
+	"\x04type\x12\x03\xf8B\x01\"q\n" +
 	"\fEntityFilter\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x10\n" +
-	"\x03ids\x18\x02 \x03(\tR\x03ids\"p\n" +
+	"\x03ids\x18\x02 \x03(\tR\x03ids\x12;\n" +
+	"\n" +
+	"predicates\x18\x03 \x03(\v2\x1b.base.v1.AttributePredicateR\n" +
+	"predicates\"\xad\x01\n" +
 	"\rSubjectFilter\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x10\n" +
 	"\x03ids\x18\x02 \x03(\tR\x03ids\x129\n" +
-	"\brelation\x18\x03 \x01(\tB\x1d\xfaB\x1ar\x18(@2\x11^[a-zA-Z_]{1,64}$\xd0\x01\x01R\brelation\"\xf0\x01\n" +
+	"\brelation\x18\x03 \x01(\tB\x1d\xfaB\x1ar\x18(@2\x11^[a-zA-Z_]{1,64}$\xd0\x01\x01R\brelation\x12;\n" +
+	"\n" +
+	"predicates\x18\x04 \x03(\v2\x1b.base.v1.AttributePredicateR\n" +
+	"predicates\"\xf0\x01\n" +
 	"\x0eExpandTreeNode\x12?\n" +
 	"\toperation\x18\x01 \x01(\x0e2!.base.v1.ExpandTreeNode.OperationR\toperation\x12+\n" +
 	"\bchildren\x18\x02 \x03(\v2\x0f.base.v1.ExpandR\bchildren\"p\n" +
@@ -3466,12 +4625,60 @@ const file_base_v1_base_proto_rawDesc = "" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12:\n" +
 	"\n" +
 	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"created_at\"f\n" +
+	"created_at\"\x89\x03\n" +
+	"\vAuditRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\ttenant_id\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x12\x18\n" +
+	"\asummary\x18\x05 \x01(\tR\asummary\x12\x1e\n" +
+	"\n" +
+	"snap_token\x18\x06 \x01(\tR\n" +
+	"snap_token\x12&\n" +
+	"\x0eschema_version\x18\a \x01(\tR\x0eschema_version\x12\x18\n" +
+	"\aoutcome\x18\b \x01(\tR\aoutcome\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x12:\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12P\n" +
+	"\x14transaction_metadata\x18\v \x01(\v2\x1c.base.v1.TransactionMetadataR\x14transaction_metadata\"\xd1\x02\n" +
+	"\x11AccessReviewEntry\x12 \n" +
+	"\ventity_type\x18\x01 \x01(\tR\ventity_type\x12\x1c\n" +
+	"\tentity_id\x18\x02 \x01(\tR\tentity_id\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x03 \x01(\tR\n" +
+	"permission\x12*\n" +
+	"\asubject\x18\x04 \x01(\v2\x10.base.v1.SubjectR\asubject\x129\n" +
+	"\x06access\x18\x05 \x01(\x0e2!.base.v1.AccessReviewEntry.AccessR\x06access\x12\x12\n" +
+	"\x04path\x18\x06 \x03(\tR\x04path\"a\n" +
+	"\x06Access\x12\x16\n" +
+	"\x12ACCESS_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rACCESS_DIRECT\x10\x01\x12\x14\n" +
+	"\x10ACCESS_INHERITED\x10\x02\x12\x16\n" +
+	"\x12ACCESS_CONDITIONAL\x10\x03\"\x81\x02\n" +
+	"\vConsistency\x125\n" +
+	"\x10minimize_latency\x18\x01 \x01(\bB\a\xfaB\x04j\x02\b\x01H\x00R\x10minimize_latency\x127\n" +
+	"\x11at_least_as_fresh\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01H\x00R\x11at_least_as_fresh\x127\n" +
+	"\x11at_exact_snapshot\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01H\x00R\x11at_exact_snapshot\x125\n" +
+	"\x10fully_consistent\x18\x04 \x01(\bB\a\xfaB\x04j\x02\b\x01H\x00R\x10fully_consistentB\x12\n" +
+	"\vrequirement\x12\x03\xf8B\x01\"\x97\x02\n" +
+	"\x13TransactionMetadata\x12\x1e\n" +
+	"\x05actor\x18\x01 \x01(\tB\b\xfaB\x05r\x03(\x80\x02R\x05actor\x12 \n" +
+	"\x06reason\x18\x02 \x01(\tB\b\xfaB\x05r\x03(\x80\bR\x06reason\x12(\n" +
+	"\n" +
+	"request_id\x18\x03 \x01(\tB\b\xfaB\x05r\x03(\x80\x01R\n" +
+	"request_id\x12Y\n" +
+	"\x06labels\x18\x04 \x03(\v2(.base.v1.TransactionMetadata.LabelsEntryB\x17\xfaB\x14\x9a\x01\x11\x10 \"\x06r\x04 \x01(@*\x05r\x03(\x80\x02R\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa0\x01\n" +
 	"\vDataChanges\x12\x1e\n" +
 	"\n" +
 	"snap_token\x18\x01 \x01(\tR\n" +
 	"snap_token\x127\n" +
-	"\fdata_changes\x18\x02 \x03(\v2\x13.base.v1.DataChangeR\fdata_changes\"\x86\x02\n" +
+	"\fdata_changes\x18\x02 \x03(\v2\x13.base.v1.DataChangeR\fdata_changes\x128\n" +
+	"\bmetadata\x18\x03 \x01(\v2\x1c.base.v1.TransactionMetadataR\bmetadata\"\x86\x02\n" +
 	"\n" +
 	"DataChange\x12;\n" +
 	"\toperation\x18\x01 \x01(\x0e2\x1d.base.v1.DataChange.OperationR\toperation\x12&\n" +
@@ -3542,147 +4749,183 @@ func file_base_v1_base_proto_rawDescGZIP() []byte {
 	return file_base_v1_base_proto_rawDescData
 }
 
-var file_base_v1_base_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_base_v1_base_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
+var file_base_v1_base_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
+var file_base_v1_base_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
 var file_base_v1_base_proto_goTypes = []any{
-	(CheckResult)(0),                // 0: base.v1.CheckResult
-	(AttributeType)(0),              // 1: base.v1.AttributeType
-	(Rewrite_Operation)(0),          // 2: base.v1.Rewrite.Operation
-	(SchemaDefinition_Reference)(0), // 3: base.v1.SchemaDefinition.Reference
-	(EntityDefinition_Reference)(0), // 4: base.v1.EntityDefinition.Reference
-	(ExpandTreeNode_Operation)(0),   // 5: base.v1.ExpandTreeNode.Operation
-	(DataChange_Operation)(0),       // 6: base.v1.DataChange.Operation
-	(*Context)(nil),                 // 7: base.v1.Context
-	(*Child)(nil),                   // 8: base.v1.Child
-	(*Leaf)(nil),                    // 9: base.v1.Leaf
-	(*Rewrite)(nil),                 // 10: base.v1.Rewrite
-	(*SchemaDefinition)(nil),        // 11: base.v1.SchemaDefinition
-	(*EntityDefinition)(nil),        // 12: base.v1.EntityDefinition
-	(*RuleDefinition)(nil),          // 13: base.v1.RuleDefinition
-	(*AttributeDefinition)(nil),     // 14: base.v1.AttributeDefinition
-	(*RelationDefinition)(nil),      // 15: base.v1.RelationDefinition
-	(*PermissionDefinition)(nil),    // 16: base.v1.PermissionDefinition
-	(*RelationReference)(nil),       // 17: base.v1.RelationReference
-	(*Entrance)(nil),                // 18: base.v1.Entrance
-	(*Argument)(nil),                // 19: base.v1.Argument
-	(*Call)(nil),                    // 20: base.v1.Call
-	(*ComputedAttribute)(nil),       // 21: base.v1.ComputedAttribute
-	(*ComputedUserSet)(nil),         // 22: base.v1.ComputedUserSet
-	(*TupleToUserSet)(nil),          // 23: base.v1.TupleToUserSet
-	(*TupleSet)(nil),                // 24: base.v1.TupleSet
-	(*Tuple)(nil),                   // 25: base.v1.Tuple
-	(*Attribute)(nil),               // 26: base.v1.Attribute
-	(*Tuples)(nil),                  // 27: base.v1.Tuples
-	(*Attributes)(nil),              // 28: base.v1.Attributes
-	(*Entity)(nil),                  // 29: base.v1.Entity
-	(*EntityAndRelation)(nil),       // 30: base.v1.EntityAndRelation
-	(*Subject)(nil),                 // 31: base.v1.Subject
-	(*AttributeFilter)(nil),         // 32: base.v1.AttributeFilter
-	(*TupleFilter)(nil),             // 33: base.v1.TupleFilter
-	(*EntityFilter)(nil),            // 34: base.v1.EntityFilter
-	(*SubjectFilter)(nil),           // 35: base.v1.SubjectFilter
-	(*ExpandTreeNode)(nil),          // 36: base.v1.ExpandTreeNode
-	(*Expand)(nil),                  // 37: base.v1.Expand
-	(*ExpandLeaf)(nil),              // 38: base.v1.ExpandLeaf
-	(*Values)(nil),                  // 39: base.v1.Values
-	(*Subjects)(nil),                // 40: base.v1.Subjects
-	(*Tenant)(nil),                  // 41: base.v1.Tenant
-	(*DataChanges)(nil),             // 42: base.v1.DataChanges
-	(*DataChange)(nil),              // 43: base.v1.DataChange
-	(*StringValue)(nil),             // 44: base.v1.StringValue
-	(*IntegerValue)(nil),            // 45: base.v1.IntegerValue
-	(*DoubleValue)(nil),             // 46: base.v1.DoubleValue
-	(*BooleanValue)(nil),            // 47: base.v1.BooleanValue
-	(*StringArrayValue)(nil),        // 48: base.v1.StringArrayValue
-	(*IntegerArrayValue)(nil),       // 49: base.v1.IntegerArrayValue
-	(*DoubleArrayValue)(nil),        // 50: base.v1.DoubleArrayValue
-	(*BooleanArrayValue)(nil),       // 51: base.v1.BooleanArrayValue
-	(*DataBundle)(nil),              // 52: base.v1.DataBundle
-	(*Operation)(nil),               // 53: base.v1.Operation
-	(*Partials)(nil),                // 54: base.v1.Partials
-	nil,                             // 55: base.v1.SchemaDefinition.EntityDefinitionsEntry
-	nil,                             // 56: base.v1.SchemaDefinition.RuleDefinitionsEntry
-	nil,                             // 57: base.v1.SchemaDefinition.ReferencesEntry
-	nil,                             // 58: base.v1.EntityDefinition.RelationsEntry
-	nil,                             // 59: base.v1.EntityDefinition.PermissionsEntry
-	nil,                             // 60: base.v1.EntityDefinition.AttributesEntry
-	nil,                             // 61: base.v1.EntityDefinition.ReferencesEntry
-	nil,                             // 62: base.v1.RuleDefinition.ArgumentsEntry
-	nil,                             // 63: base.v1.Values.ValuesEntry
-	(*structpb.Struct)(nil),         // 64: google.protobuf.Struct
-	(*v1alpha1.CheckedExpr)(nil),    // 65: google.api.expr.v1alpha1.CheckedExpr
-	(*anypb.Any)(nil),               // 66: google.protobuf.Any
-	(*timestamppb.Timestamp)(nil),   // 67: google.protobuf.Timestamp
+	(CheckResult)(0),                    // 0: base.v1.CheckResult
+	(AttributeType)(0),                  // 1: base.v1.AttributeType
+	(Rewrite_Operation)(0),              // 2: base.v1.Rewrite.Operation
+	(SchemaDefinition_Reference)(0),     // 3: base.v1.SchemaDefinition.Reference
+	(EntityDefinition_Reference)(0),     // 4: base.v1.EntityDefinition.Reference
+	(RelationDefinition_Cardinality)(0), // 5: base.v1.RelationDefinition.Cardinality
+	(RelationDefinition_OnConflict)(0),  // 6: base.v1.RelationDefinition.OnConflict
+	(Count_Comparison)(0),               // 7: base.v1.Count.Comparison
+	(AttributePredicate_Operator)(0),    // 8: base.v1.AttributePredicate.Operator
+	(ExpandTreeNode_Operation)(0),       // 9: base.v1.ExpandTreeNode.Operation
+	(AccessReviewEntry_Access)(0),       // 10: base.v1.AccessReviewEntry.Access
+	(DataChange_Operation)(0),           // 11: base.v1.DataChange.Operation
+	(*Context)(nil),                     // 12: base.v1.Context
+	(*Child)(nil),                       // 13: base.v1.Child
+	(*Leaf)(nil),                        // 14: base.v1.Leaf
+	(*Rewrite)(nil),                     // 15: base.v1.Rewrite
+	(*SchemaDefinition)(nil),            // 16: base.v1.SchemaDefinition
+	(*EntityDefinition)(nil),            // 17: base.v1.EntityDefinition
+	(*RuleDefinition)(nil),              // 18: base.v1.RuleDefinition
+	(*AttributeDefinition)(nil),         // 19: base.v1.AttributeDefinition
+	(*RelationDefinition)(nil),          // 20: base.v1.RelationDefinition
+	(*PermissionDefinition)(nil),        // 21: base.v1.PermissionDefinition
+	(*Annotations)(nil),                 // 22: base.v1.Annotations
+	(*RelationReference)(nil),           // 23: base.v1.RelationReference
+	(*Entrance)(nil),                    // 24: base.v1.Entrance
+	(*Argument)(nil),                    // 25: base.v1.Argument
+	(*Call)(nil),                        // 26: base.v1.Call
+	(*Count)(nil),                       // 27: base.v1.Count
+	(*ComputedAttribute)(nil),           // 28: base.v1.ComputedAttribute
+	(*ComputedUserSet)(nil),             // 29: base.v1.ComputedUserSet
+	(*TupleToUserSet)(nil),              // 30: base.v1.TupleToUserSet
+	(*TupleSet)(nil),                    // 31: base.v1.TupleSet
+	(*Tuple)(nil),                       // 32: base.v1.Tuple
+	(*Attribute)(nil),                   // 33: base.v1.Attribute
+	(*Tuples)(nil),                      // 34: base.v1.Tuples
+	(*Attributes)(nil),                  // 35: base.v1.Attributes
+	(*Entity)(nil),                      // 36: base.v1.Entity
+	(*EntityAndRelation)(nil),           // 37: base.v1.EntityAndRelation
+	(*Subject)(nil),                     // 38: base.v1.Subject
+	(*AttributeFilter)(nil),             // 39: base.v1.AttributeFilter
+	(*AttributePredicate)(nil),          // 40: base.v1.AttributePredicate
+	(*TupleFilter)(nil),                 // 41: base.v1.TupleFilter
+	(*Precondition)(nil),                // 42: base.v1.Precondition
+	(*EntityFilter)(nil),                // 43: base.v1.EntityFilter
+	(*SubjectFilter)(nil),               // 44: base.v1.SubjectFilter
+	(*ExpandTreeNode)(nil),              // 45: base.v1.ExpandTreeNode
+	(*Expand)(nil),                      // 46: base.v1.Expand
+	(*ExpandLeaf)(nil),                  // 47: base.v1.ExpandLeaf
+	(*Values)(nil),                      // 48: base.v1.Values
+	(*Subjects)(nil),                    // 49: base.v1.Subjects
+	(*Tenant)(nil),                      // 50: base.v1.Tenant
+	(*AuditRecord)(nil),                 // 51: base.v1.AuditRecord
+	(*AccessReviewEntry)(nil),           // 52: base.v1.AccessReviewEntry
+	(*Consistency)(nil),                 // 53: base.v1.Consistency
+	(*TransactionMetadata)(nil),         // 54: base.v1.TransactionMetadata
+	(*DataChanges)(nil),                 // 55: base.v1.DataChanges
+	(*DataChange)(nil),                  // 56: base.v1.DataChange
+	(*StringValue)(nil),                 // 57: base.v1.StringValue
+	(*IntegerValue)(nil),                // 58: base.v1.IntegerValue
+	(*DoubleValue)(nil),                 // 59: base.v1.DoubleValue
+	(*BooleanValue)(nil),                // 60: base.v1.BooleanValue
+	(*StringArrayValue)(nil),            // 61: base.v1.StringArrayValue
+	(*IntegerArrayValue)(nil),           // 62: base.v1.IntegerArrayValue
+	(*DoubleArrayValue)(nil),            // 63: base.v1.DoubleArrayValue
+	(*BooleanArrayValue)(nil),           // 64: base.v1.BooleanArrayValue
+	(*DataBundle)(nil),                  // 65: base.v1.DataBundle
+	(*Operation)(nil),                   // 66: base.v1.Operation
+	(*Partials)(nil),                    // 67: base.v1.Partials
+	nil,                                 // 68: base.v1.SchemaDefinition.EntityDefinitionsEntry
+	nil,                                 // 69: base.v1.SchemaDefinition.RuleDefinitionsEntry
+	nil,                                 // 70: base.v1.SchemaDefinition.ReferencesEntry
+	nil,                                 // 71: base.v1.EntityDefinition.RelationsEntry
+	nil,                                 // 72: base.v1.EntityDefinition.PermissionsEntry
+	nil,                                 // 73: base.v1.EntityDefinition.AttributesEntry
+	nil,                                 // 74: base.v1.EntityDefinition.ReferencesEntry
+	nil,                                 // 75: base.v1.RuleDefinition.ArgumentsEntry
+	nil,                                 // 76: base.v1.Values.ValuesEntry
+	nil,                                 // 77: base.v1.TransactionMetadata.LabelsEntry
+	(*structpb.Struct)(nil),             // 78: google.protobuf.Struct
+	(*v1alpha1.CheckedExpr)(nil),        // 79: google.api.expr.v1alpha1.CheckedExpr
+	(*anypb.Any)(nil),                   // 80: google.protobuf.Any
+	(*timestamppb.Timestamp)(nil),       // 81: google.protobuf.Timestamp
 }
 var file_base_v1_base_proto_depIdxs = []int32{
-	25, // 0: base.v1.Context.tuples:type_name -> base.v1.Tuple
-	26, // 1: base.v1.Context.attributes:type_name -> base.v1.Attribute
-	64, // 2: base.v1.Context.data:type_name -> google.protobuf.Struct
-	9,  // 3: base.v1.Child.leaf:type_name -> base.v1.Leaf
-	10, // 4: base.v1.Child.rewrite:type_name -> base.v1.Rewrite
-	22, // 5: base.v1.Leaf.computed_user_set:type_name -> base.v1.ComputedUserSet
-	23, // 6: base.v1.Leaf.tuple_to_user_set:type_name -> base.v1.TupleToUserSet
-	21, // 7: base.v1.Leaf.computed_attribute:type_name -> base.v1.ComputedAttribute
-	20, // 8: base.v1.Leaf.call:type_name -> base.v1.Call
-	2,  // 9: base.v1.Rewrite.rewrite_operation:type_name -> base.v1.Rewrite.Operation
-	8,  // 10: base.v1.Rewrite.children:type_name -> base.v1.Child
-	55, // 11: base.v1.SchemaDefinition.entity_definitions:type_name -> base.v1.SchemaDefinition.EntityDefinitionsEntry
-	56, // 12: base.v1.SchemaDefinition.rule_definitions:type_name -> base.v1.SchemaDefinition.RuleDefinitionsEntry
-	57, // 13: base.v1.SchemaDefinition.references:type_name -> base.v1.SchemaDefinition.ReferencesEntry
-	58, // 14: base.v1.EntityDefinition.relations:type_name -> base.v1.EntityDefinition.RelationsEntry
-	59, // 15: base.v1.EntityDefinition.permissions:type_name -> base.v1.EntityDefinition.PermissionsEntry
-	60, // 16: base.v1.EntityDefinition.attributes:type_name -> base.v1.EntityDefinition.AttributesEntry
-	61, // 17: base.v1.EntityDefinition.references:type_name -> base.v1.EntityDefinition.ReferencesEntry
-	62, // 18: base.v1.RuleDefinition.arguments:type_name -> base.v1.RuleDefinition.ArgumentsEntry
-	65, // 19: base.v1.RuleDefinition.expression:type_name -> google.api.expr.v1alpha1.CheckedExpr
-	1,  // 20: base.v1.AttributeDefinition.type:type_name -> base.v1.AttributeType
-	17, // 21: base.v1.RelationDefinition.relation_references:type_name -> base.v1.RelationReference
-	8,  // 22: base.v1.PermissionDefinition.child:type_name -> base.v1.Child
-	21, // 23: base.v1.Argument.computed_attribute:type_name -> base.v1.ComputedAttribute
-	19, // 24: base.v1.Call.arguments:type_name -> base.v1.Argument
-	24, // 25: base.v1.TupleToUserSet.tupleSet:type_name -> base.v1.TupleSet
-	22, // 26: base.v1.TupleToUserSet.computed:type_name -> base.v1.ComputedUserSet
-	29, // 27: base.v1.Tuple.entity:type_name -> base.v1.Entity
-	31, // 28: base.v1.Tuple.subject:type_name -> base.v1.Subject
-	29, // 29: base.v1.Attribute.entity:type_name -> base.v1.Entity
-	66, // 30: base.v1.Attribute.value:type_name -> google.protobuf.Any
-	25, // 31: base.v1.Tuples.tuples:type_name -> base.v1.Tuple
-	26, // 32: base.v1.Attributes.attributes:type_name -> base.v1.Attribute
-	29, // 33: base.v1.EntityAndRelation.entity:type_name -> base.v1.Entity
-	34, // 34: base.v1.AttributeFilter.entity:type_name -> base.v1.EntityFilter
-	34, // 35: base.v1.TupleFilter.entity:type_name -> base.v1.EntityFilter
-	35, // 36: base.v1.TupleFilter.subject:type_name -> base.v1.SubjectFilter
-	5,  // 37: base.v1.ExpandTreeNode.operation:type_name -> base.v1.ExpandTreeNode.Operation
-	37, // 38: base.v1.ExpandTreeNode.children:type_name -> base.v1.Expand
-	29, // 39: base.v1.Expand.entity:type_name -> base.v1.Entity
-	19, // 40: base.v1.Expand.arguments:type_name -> base.v1.Argument
-	36, // 41: base.v1.Expand.expand:type_name -> base.v1.ExpandTreeNode
-	38, // 42: base.v1.Expand.leaf:type_name -> base.v1.ExpandLeaf
-	40, // 43: base.v1.ExpandLeaf.subjects:type_name -> base.v1.Subjects
-	39, // 44: base.v1.ExpandLeaf.values:type_name -> base.v1.Values
-	66, // 45: base.v1.ExpandLeaf.value:type_name -> google.protobuf.Any
-	63, // 46: base.v1.Values.values:type_name -> base.v1.Values.ValuesEntry
-	31, // 47: base.v1.Subjects.subjects:type_name -> base.v1.Subject
-	67, // 48: base.v1.Tenant.created_at:type_name -> google.protobuf.Timestamp
-	43, // 49: base.v1.DataChanges.data_changes:type_name -> base.v1.DataChange
-	6,  // 50: base.v1.DataChange.operation:type_name -> base.v1.DataChange.Operation
-	25, // 51: base.v1.DataChange.tuple:type_name -> base.v1.Tuple
-	26, // 52: base.v1.DataChange.attribute:type_name -> base.v1.Attribute
-	53, // 53: base.v1.DataBundle.operations:type_name -> base.v1.Operation
-	12, // 54: base.v1.SchemaDefinition.EntityDefinitionsEntry.value:type_name -> base.v1.EntityDefinition
-	13, // 55: base.v1.SchemaDefinition.RuleDefinitionsEntry.value:type_name -> base.v1.RuleDefinition
-	3,  // 56: base.v1.SchemaDefinition.ReferencesEntry.value:type_name -> base.v1.SchemaDefinition.Reference
-	15, // 57: base.v1.EntityDefinition.RelationsEntry.value:type_name -> base.v1.RelationDefinition
-	16, // 58: base.v1.EntityDefinition.PermissionsEntry.value:type_name -> base.v1.PermissionDefinition
-	14, // 59: base.v1.EntityDefinition.AttributesEntry.value:type_name -> base.v1.AttributeDefinition
-	4,  // 60: base.v1.EntityDefinition.ReferencesEntry.value:type_name -> base.v1.EntityDefinition.Reference
-	1,  // 61: base.v1.RuleDefinition.ArgumentsEntry.value:type_name -> base.v1.AttributeType
-	66, // 62: base.v1.Values.ValuesEntry.value:type_name -> google.protobuf.Any
-	63, // [63:63] is the sub-list for method output_type
-	63, // [63:63] is the sub-list for method input_type
-	63, // [63:63] is the sub-list for extension type_name
-	63, // [63:63] is the sub-list for extension extendee
-	0,  // [0:63] is the sub-list for field type_name
+	32, // 0: base.v1.Context.tuples:type_name -> base.v1.Tuple
+	33, // 1: base.v1.Context.attributes:type_name -> base.v1.Attribute
+	78, // 2: base.v1.Context.data:type_name -> google.protobuf.Struct
+	14, // 3: base.v1.Child.leaf:type_name -> base.v1.Leaf
+	15, // 4: base.v1.Child.rewrite:type_name -> base.v1.Rewrite
+	29, // 5: base.v1.Leaf.computed_user_set:type_name -> base.v1.ComputedUserSet
+	30, // 6: base.v1.Leaf.tuple_to_user_set:type_name -> base.v1.TupleToUserSet
+	28, // 7: base.v1.Leaf.computed_attribute:type_name -> base.v1.ComputedAttribute
+	26, // 8: base.v1.Leaf.call:type_name -> base.v1.Call
+	27, // 9: base.v1.Leaf.count:type_name -> base.v1.Count
+	2,  // 10: base.v1.Rewrite.rewrite_operation:type_name -> base.v1.Rewrite.Operation
+	13, // 11: base.v1.Rewrite.children:type_name -> base.v1.Child
+	68, // 12: base.v1.SchemaDefinition.entity_definitions:type_name -> base.v1.SchemaDefinition.EntityDefinitionsEntry
+	69, // 13: base.v1.SchemaDefinition.rule_definitions:type_name -> base.v1.SchemaDefinition.RuleDefinitionsEntry
+	70, // 14: base.v1.SchemaDefinition.references:type_name -> base.v1.SchemaDefinition.ReferencesEntry
+	71, // 15: base.v1.EntityDefinition.relations:type_name -> base.v1.EntityDefinition.RelationsEntry
+	72, // 16: base.v1.EntityDefinition.permissions:type_name -> base.v1.EntityDefinition.PermissionsEntry
+	73, // 17: base.v1.EntityDefinition.attributes:type_name -> base.v1.EntityDefinition.AttributesEntry
+	74, // 18: base.v1.EntityDefinition.references:type_name -> base.v1.EntityDefinition.ReferencesEntry
+	22, // 19: base.v1.EntityDefinition.annotations:type_name -> base.v1.Annotations
+	75, // 20: base.v1.RuleDefinition.arguments:type_name -> base.v1.RuleDefinition.ArgumentsEntry
+	79, // 21: base.v1.RuleDefinition.expression:type_name -> google.api.expr.v1alpha1.CheckedExpr
+	22, // 22: base.v1.RuleDefinition.annotations:type_name -> base.v1.Annotations
+	1,  // 23: base.v1.AttributeDefinition.type:type_name -> base.v1.AttributeType
+	22, // 24: base.v1.AttributeDefinition.annotations:type_name -> base.v1.Annotations
+	23, // 25: base.v1.RelationDefinition.relation_references:type_name -> base.v1.RelationReference
+	5,  // 26: base.v1.RelationDefinition.cardinality:type_name -> base.v1.RelationDefinition.Cardinality
+	6,  // 27: base.v1.RelationDefinition.on_conflict:type_name -> base.v1.RelationDefinition.OnConflict
+	22, // 28: base.v1.RelationDefinition.annotations:type_name -> base.v1.Annotations
+	13, // 29: base.v1.PermissionDefinition.child:type_name -> base.v1.Child
+	22, // 30: base.v1.PermissionDefinition.annotations:type_name -> base.v1.Annotations
+	28, // 31: base.v1.Argument.computed_attribute:type_name -> base.v1.ComputedAttribute
+	25, // 32: base.v1.Call.arguments:type_name -> base.v1.Argument
+	7,  // 33: base.v1.Count.comparison:type_name -> base.v1.Count.Comparison
+	31, // 34: base.v1.TupleToUserSet.tupleSet:type_name -> base.v1.TupleSet
+	29, // 35: base.v1.TupleToUserSet.computed:type_name -> base.v1.ComputedUserSet
+	36, // 36: base.v1.Tuple.entity:type_name -> base.v1.Entity
+	38, // 37: base.v1.Tuple.subject:type_name -> base.v1.Subject
+	36, // 38: base.v1.Attribute.entity:type_name -> base.v1.Entity
+	80, // 39: base.v1.Attribute.value:type_name -> google.protobuf.Any
+	32, // 40: base.v1.Tuples.tuples:type_name -> base.v1.Tuple
+	33, // 41: base.v1.Attributes.attributes:type_name -> base.v1.Attribute
+	36, // 42: base.v1.EntityAndRelation.entity:type_name -> base.v1.Entity
+	43, // 43: base.v1.AttributeFilter.entity:type_name -> base.v1.EntityFilter
+	8,  // 44: base.v1.AttributePredicate.operator:type_name -> base.v1.AttributePredicate.Operator
+	80, // 45: base.v1.AttributePredicate.value:type_name -> google.protobuf.Any
+	43, // 46: base.v1.TupleFilter.entity:type_name -> base.v1.EntityFilter
+	44, // 47: base.v1.TupleFilter.subject:type_name -> base.v1.SubjectFilter
+	32, // 48: base.v1.Precondition.tuple_exists:type_name -> base.v1.Tuple
+	32, // 49: base.v1.Precondition.tuple_not_exists:type_name -> base.v1.Tuple
+	41, // 50: base.v1.Precondition.filter_matches_none:type_name -> base.v1.TupleFilter
+	40, // 51: base.v1.EntityFilter.predicates:type_name -> base.v1.AttributePredicate
+	40, // 52: base.v1.SubjectFilter.predicates:type_name -> base.v1.AttributePredicate
+	9,  // 53: base.v1.ExpandTreeNode.operation:type_name -> base.v1.ExpandTreeNode.Operation
+	46, // 54: base.v1.ExpandTreeNode.children:type_name -> base.v1.Expand
+	36, // 55: base.v1.Expand.entity:type_name -> base.v1.Entity
+	25, // 56: base.v1.Expand.arguments:type_name -> base.v1.Argument
+	45, // 57: base.v1.Expand.expand:type_name -> base.v1.ExpandTreeNode
+	47, // 58: base.v1.Expand.leaf:type_name -> base.v1.ExpandLeaf
+	49, // 59: base.v1.ExpandLeaf.subjects:type_name -> base.v1.Subjects
+	48, // 60: base.v1.ExpandLeaf.values:type_name -> base.v1.Values
+	80, // 61: base.v1.ExpandLeaf.value:type_name -> google.protobuf.Any
+	76, // 62: base.v1.Values.values:type_name -> base.v1.Values.ValuesEntry
+	38, // 63: base.v1.Subjects.subjects:type_name -> base.v1.Subject
+	81, // 64: base.v1.Tenant.created_at:type_name -> google.protobuf.Timestamp
+	81, // 65: base.v1.AuditRecord.created_at:type_name -> google.protobuf.Timestamp
+	54, // 66: base.v1.AuditRecord.transaction_metadata:type_name -> base.v1.TransactionMetadata
+	38, // 67: base.v1.AccessReviewEntry.subject:type_name -> base.v1.Subject
+	10, // 68: base.v1.AccessReviewEntry.access:type_name -> base.v1.AccessReviewEntry.Access
+	77, // 69: base.v1.TransactionMetadata.labels:type_name -> base.v1.TransactionMetadata.LabelsEntry
+	56, // 70: base.v1.DataChanges.data_changes:type_name -> base.v1.DataChange
+	54, // 71: base.v1.DataChanges.metadata:type_name -> base.v1.TransactionMetadata
+	11, // 72: base.v1.DataChange.operation:type_name -> base.v1.DataChange.Operation
+	32, // 73: base.v1.DataChange.tuple:type_name -> base.v1.Tuple
+	33, // 74: base.v1.DataChange.attribute:type_name -> base.v1.Attribute
+	66, // 75: base.v1.DataBundle.operations:type_name -> base.v1.Operation
+	17, // 76: base.v1.SchemaDefinition.EntityDefinitionsEntry.value:type_name -> base.v1.EntityDefinition
+	18, // 77: base.v1.SchemaDefinition.RuleDefinitionsEntry.value:type_name -> base.v1.RuleDefinition
+	3,  // 78: base.v1.SchemaDefinition.ReferencesEntry.value:type_name -> base.v1.SchemaDefinition.Reference
+	20, // 79: base.v1.EntityDefinition.RelationsEntry.value:type_name -> base.v1.RelationDefinition
+	21, // 80: base.v1.EntityDefinition.PermissionsEntry.value:type_name -> base.v1.PermissionDefinition
+	19, // 81: base.v1.EntityDefinition.AttributesEntry.value:type_name -> base.v1.AttributeDefinition
+	4,  // 82: base.v1.EntityDefinition.ReferencesEntry.value:type_name -> base.v1.EntityDefinition.Reference
+	1,  // 83: base.v1.RuleDefinition.ArgumentsEntry.value:type_name -> base.v1.AttributeType
+	80, // 84: base.v1.Values.ValuesEntry.value:type_name -> google.protobuf.Any
+	85, // [85:85] is the sub-list for method output_type
+	85, // [85:85] is the sub-list for method input_type
+	85, // [85:85] is the sub-list for extension type_name
+	85, // [85:85] is the sub-list for extension extendee
+	0,  // [0:85] is the sub-list for field type_name
 }
 
 func init() { file_base_v1_base_proto_init() }
@@ -3699,20 +4942,33 @@ func file_base_v1_base_proto_init() {
 		(*Leaf_TupleToUserSet)(nil),
 		(*Leaf_ComputedAttribute)(nil),
 		(*Leaf_Call)(nil),
+		(*Leaf_Count)(nil),
 	}
-	file_base_v1_base_proto_msgTypes[12].OneofWrappers = []any{
+	file_base_v1_base_proto_msgTypes[13].OneofWrappers = []any{
 		(*Argument_ComputedAttribute)(nil),
 	}
 	file_base_v1_base_proto_msgTypes[30].OneofWrappers = []any{
+		(*Precondition_TupleExists)(nil),
+		(*Precondition_TupleNotExists)(nil),
+		(*Precondition_FilterMatchesNone)(nil),
+		(*Precondition_HeadSnapToken)(nil),
+	}
+	file_base_v1_base_proto_msgTypes[34].OneofWrappers = []any{
 		(*Expand_Expand)(nil),
 		(*Expand_Leaf)(nil),
 	}
-	file_base_v1_base_proto_msgTypes[31].OneofWrappers = []any{
+	file_base_v1_base_proto_msgTypes[35].OneofWrappers = []any{
 		(*ExpandLeaf_Subjects)(nil),
 		(*ExpandLeaf_Values)(nil),
 		(*ExpandLeaf_Value)(nil),
 	}
-	file_base_v1_base_proto_msgTypes[36].OneofWrappers = []any{
+	file_base_v1_base_proto_msgTypes[41].OneofWrappers = []any{
+		(*Consistency_MinimizeLatency)(nil),
+		(*Consistency_AtLeastAsFresh)(nil),
+		(*Consistency_AtExactSnapshot)(nil),
+		(*Consistency_FullyConsistent)(nil),
+	}
+	file_base_v1_base_proto_msgTypes[44].OneofWrappers = []any{
 		(*DataChange_Tuple)(nil),
 		(*DataChange_Attribute)(nil),
 	}
@@ -3721,8 +4977,8 @@ func file_base_v1_base_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_base_v1_base_proto_rawDesc), len(file_base_v1_base_proto_rawDesc)),
-			NumEnums:      7,
-			NumMessages:   57,
+			NumEnums:      12,
+			NumMessages:   66,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -685,6 +685,59 @@ func (m *Leaf) validate(all bool) error {
 			}
 		}
 
+	case *Leaf_Count:
+		if v == nil {
+			err := LeafValidationError{
+				field:  "Type",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofTypePresent = true
+
+		if m.GetCount() == nil {
+			err := LeafValidationError{
+				field:  "Count",
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCount()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeafValidationError{
+						field:  "Count",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeafValidationError{
+						field:  "Count",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCount()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeafValidationError{
+					field:  "Count",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -1288,6 +1341,35 @@ func (m *EntityDefinition) validate(all bool) error {
 
 	// no validation rules for References
 
+	if all {
+		switch v := interface{}(m.GetAnnotations()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EntityDefinitionValidationError{
+					field:  "Annotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EntityDefinitionValidationError{
+					field:  "Annotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnotations()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EntityDefinitionValidationError{
+				field:  "Annotations",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return EntityDefinitionMultiError(errors)
 	}
@@ -1443,6 +1525,35 @@ func (m *RuleDefinition) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetAnnotations()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RuleDefinitionValidationError{
+					field:  "Annotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RuleDefinitionValidationError{
+					field:  "Annotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnotations()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RuleDefinitionValidationError{
+				field:  "Annotations",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return RuleDefinitionMultiError(errors)
 	}
@@ -1569,6 +1680,35 @@ func (m *AttributeDefinition) validate(all bool) error {
 
 	// no validation rules for Type
 
+	if all {
+		switch v := interface{}(m.GetAnnotations()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeDefinitionValidationError{
+					field:  "Annotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeDefinitionValidationError{
+					field:  "Annotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnotations()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeDefinitionValidationError{
+				field:  "Annotations",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return AttributeDefinitionMultiError(errors)
 	}
@@ -1729,6 +1869,39 @@ func (m *RelationDefinition) validate(all bool) error {
 
 	}
 
+	// no validation rules for Cardinality
+
+	// no validation rules for OnConflict
+
+	if all {
+		switch v := interface{}(m.GetAnnotations()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationDefinitionValidationError{
+					field:  "Annotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationDefinitionValidationError{
+					field:  "Annotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnotations()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationDefinitionValidationError{
+				field:  "Annotations",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return RelationDefinitionMultiError(errors)
 	}
@@ -1884,6 +2057,35 @@ func (m *PermissionDefinition) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetAnnotations()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionDefinitionValidationError{
+					field:  "Annotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionDefinitionValidationError{
+					field:  "Annotations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnotations()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionDefinitionValidationError{
+				field:  "Annotations",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return PermissionDefinitionMultiError(errors)
 	}
@@ -1966,6 +2168,111 @@ var _ interface {
 
 var _PermissionDefinition_Name_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
 
+// Validate checks the field values on Annotations with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Annotations) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Annotations with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AnnotationsMultiError, or
+// nil if none found.
+func (m *Annotations) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Annotations) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Description
+
+	// no validation rules for Owner
+
+	// no validation rules for Deprecated
+
+	if len(errors) > 0 {
+		return AnnotationsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnnotationsMultiError is an error wrapping multiple validation errors
+// returned by Annotations.ValidateAll() if the designated constraints aren't met.
+type AnnotationsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnnotationsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnnotationsMultiError) AllErrors() []error { return m }
+
+// AnnotationsValidationError is the validation error returned by
+// Annotations.Validate if the designated constraints aren't met.
+type AnnotationsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnnotationsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnnotationsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnnotationsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnnotationsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnnotationsValidationError) ErrorName() string { return "AnnotationsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AnnotationsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnnotations.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnnotationsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnnotationsValidationError{}
+
 // Validate checks the field values on RelationReference with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -2546,31 +2853,30 @@ var _ interface {
 	ErrorName() string
 } = CallValidationError{}
 
-// Validate checks the field values on ComputedAttribute with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ComputedAttribute) Validate() error {
+// Validate checks the field values on Count with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Count) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ComputedAttribute with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ComputedAttributeMultiError, or nil if none found.
-func (m *ComputedAttribute) ValidateAll() error {
+// ValidateAll checks the field values on Count with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CountMultiError, or nil if none found.
+func (m *Count) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ComputedAttribute) validate(all bool) error {
+func (m *Count) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetName()) > 64 {
-		err := ComputedAttributeValidationError{
-			field:  "Name",
+	if len(m.GetRelation()) > 64 {
+		err := CountValidationError{
+			field:  "Relation",
 			reason: "value length must be at most 64 bytes",
 		}
 		if !all {
@@ -2579,8 +2885,135 @@ func (m *ComputedAttribute) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_ComputedAttribute_Name_Pattern.MatchString(m.GetName()) {
-		err := ComputedAttributeValidationError{
+	if !_Count_Relation_Pattern.MatchString(m.GetRelation()) {
+		err := CountValidationError{
+			field:  "Relation",
+			reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Comparison
+
+	// no validation rules for Value
+
+	if len(errors) > 0 {
+		return CountMultiError(errors)
+	}
+
+	return nil
+}
+
+// CountMultiError is an error wrapping multiple validation errors returned by
+// Count.ValidateAll() if the designated constraints aren't met.
+type CountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CountMultiError) AllErrors() []error { return m }
+
+// CountValidationError is the validation error returned by Count.Validate if
+// the designated constraints aren't met.
+type CountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CountValidationError) ErrorName() string { return "CountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CountValidationError{}
+
+var _Count_Relation_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+
+// Validate checks the field values on ComputedAttribute with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ComputedAttribute) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ComputedAttribute with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ComputedAttributeMultiError, or nil if none found.
+func (m *ComputedAttribute) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ComputedAttribute) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetName()) > 64 {
+		err := ComputedAttributeValidationError{
+			field:  "Name",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ComputedAttribute_Name_Pattern.MatchString(m.GetName()) {
+		err := ComputedAttributeValidationError{
 			field:  "Name",
 			reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
 		}
@@ -4329,6 +4762,173 @@ var _ interface {
 	ErrorName() string
 } = AttributeFilterValidationError{}
 
+// Validate checks the field values on AttributePredicate with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttributePredicate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttributePredicate with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttributePredicateMultiError, or nil if none found.
+func (m *AttributePredicate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributePredicate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetAttribute()) > 64 {
+		err := AttributePredicateValidationError{
+			field:  "Attribute",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AttributePredicate_Attribute_Pattern.MatchString(m.GetAttribute()) {
+		err := AttributePredicateValidationError{
+			field:  "Attribute",
+			reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AttributePredicate_Operator_NotInLookup[m.GetOperator()]; ok {
+		err := AttributePredicateValidationError{
+			field:  "Operator",
+			reason: "value must not be in list [OPERATOR_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := AttributePredicate_Operator_name[int32(m.GetOperator())]; !ok {
+		err := AttributePredicateValidationError{
+			field:  "Operator",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetValue() == nil {
+		err := AttributePredicateValidationError{
+			field:  "Value",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if a := m.GetValue(); a != nil {
+
+	}
+
+	if len(errors) > 0 {
+		return AttributePredicateMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributePredicateMultiError is an error wrapping multiple validation errors
+// returned by AttributePredicate.ValidateAll() if the designated constraints
+// aren't met.
+type AttributePredicateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributePredicateMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributePredicateMultiError) AllErrors() []error { return m }
+
+// AttributePredicateValidationError is the validation error returned by
+// AttributePredicate.Validate if the designated constraints aren't met.
+type AttributePredicateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributePredicateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributePredicateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttributePredicateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributePredicateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributePredicateValidationError) ErrorName() string {
+	return "AttributePredicateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributePredicateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributePredicate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributePredicateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributePredicateValidationError{}
+
+var _AttributePredicate_Attribute_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+
+var _AttributePredicate_Operator_NotInLookup = map[AttributePredicate_Operator]struct{}{
+	0: {},
+}
+
 // Validate checks the field values on TupleFilter with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -4514,43 +5114,207 @@ var _ interface {
 
 var _TupleFilter_Relation_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
 
-// Validate checks the field values on EntityFilter with the rules defined in
+// Validate checks the field values on Precondition with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *EntityFilter) Validate() error {
+func (m *Precondition) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EntityFilter with the rules defined
+// ValidateAll checks the field values on Precondition with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in EntityFilterMultiError, or
+// result is a list of violation errors wrapped in PreconditionMultiError, or
 // nil if none found.
-func (m *EntityFilter) ValidateAll() error {
+func (m *Precondition) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EntityFilter) validate(all bool) error {
+func (m *Precondition) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Type
-
-	if len(errors) > 0 {
-		return EntityFilterMultiError(errors)
-	}
-
-	return nil
-}
+	oneofTypePresent := false
+	switch v := m.Type.(type) {
+	case *Precondition_TupleExists:
+		if v == nil {
+			err := PreconditionValidationError{
+				field:  "Type",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofTypePresent = true
 
-// EntityFilterMultiError is an error wrapping multiple validation errors
-// returned by EntityFilter.ValidateAll() if the designated constraints aren't met.
-type EntityFilterMultiError []error
+		if all {
+			switch v := interface{}(m.GetTupleExists()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PreconditionValidationError{
+						field:  "TupleExists",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PreconditionValidationError{
+						field:  "TupleExists",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTupleExists()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PreconditionValidationError{
+					field:  "TupleExists",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Precondition_TupleNotExists:
+		if v == nil {
+			err := PreconditionValidationError{
+				field:  "Type",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofTypePresent = true
+
+		if all {
+			switch v := interface{}(m.GetTupleNotExists()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PreconditionValidationError{
+						field:  "TupleNotExists",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PreconditionValidationError{
+						field:  "TupleNotExists",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTupleNotExists()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PreconditionValidationError{
+					field:  "TupleNotExists",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Precondition_FilterMatchesNone:
+		if v == nil {
+			err := PreconditionValidationError{
+				field:  "Type",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofTypePresent = true
+
+		if all {
+			switch v := interface{}(m.GetFilterMatchesNone()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PreconditionValidationError{
+						field:  "FilterMatchesNone",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PreconditionValidationError{
+						field:  "FilterMatchesNone",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFilterMatchesNone()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PreconditionValidationError{
+					field:  "FilterMatchesNone",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Precondition_HeadSnapToken:
+		if v == nil {
+			err := PreconditionValidationError{
+				field:  "Type",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofTypePresent = true
+
+		if utf8.RuneCountInString(m.GetHeadSnapToken()) < 1 {
+			err := PreconditionValidationError{
+				field:  "HeadSnapToken",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofTypePresent {
+		err := PreconditionValidationError{
+			field:  "Type",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PreconditionMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreconditionMultiError is an error wrapping multiple validation errors
+// returned by Precondition.ValidateAll() if the designated constraints aren't met.
+type PreconditionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EntityFilterMultiError) Error() string {
+func (m PreconditionMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4559,11 +5323,11 @@ func (m EntityFilterMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EntityFilterMultiError) AllErrors() []error { return m }
+func (m PreconditionMultiError) AllErrors() []error { return m }
 
-// EntityFilterValidationError is the validation error returned by
-// EntityFilter.Validate if the designated constraints aren't met.
-type EntityFilterValidationError struct {
+// PreconditionValidationError is the validation error returned by
+// Precondition.Validate if the designated constraints aren't met.
+type PreconditionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4571,22 +5335,22 @@ type EntityFilterValidationError struct {
 }
 
 // Field function returns field value.
-func (e EntityFilterValidationError) Field() string { return e.field }
+func (e PreconditionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EntityFilterValidationError) Reason() string { return e.reason }
+func (e PreconditionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EntityFilterValidationError) Cause() error { return e.cause }
+func (e PreconditionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EntityFilterValidationError) Key() bool { return e.key }
+func (e PreconditionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EntityFilterValidationError) ErrorName() string { return "EntityFilterValidationError" }
+func (e PreconditionValidationError) ErrorName() string { return "PreconditionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e EntityFilterValidationError) Error() string {
+func (e PreconditionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4598,14 +5362,14 @@ func (e EntityFilterValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEntityFilter.%s: %s%s",
+		"invalid %sPrecondition.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EntityFilterValidationError{}
+var _ error = PreconditionValidationError{}
 
 var _ interface {
 	Field() string
@@ -4613,24 +5377,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EntityFilterValidationError{}
+} = PreconditionValidationError{}
 
-// Validate checks the field values on SubjectFilter with the rules defined in
+// Validate checks the field values on EntityFilter with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *SubjectFilter) Validate() error {
+func (m *EntityFilter) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SubjectFilter with the rules defined
+// ValidateAll checks the field values on EntityFilter with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SubjectFilterMultiError, or
+// result is a list of violation errors wrapped in EntityFilterMultiError, or
 // nil if none found.
-func (m *SubjectFilter) ValidateAll() error {
+func (m *EntityFilter) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SubjectFilter) validate(all bool) error {
+func (m *EntityFilter) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4639,46 +5403,53 @@ func (m *SubjectFilter) validate(all bool) error {
 
 	// no validation rules for Type
 
-	if m.GetRelation() != "" {
-
-		if len(m.GetRelation()) > 64 {
-			err := SubjectFilterValidationError{
-				field:  "Relation",
-				reason: "value length must be at most 64 bytes",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	for idx, item := range m.GetPredicates() {
+		_, _ = idx, item
 
-		if !_SubjectFilter_Relation_Pattern.MatchString(m.GetRelation()) {
-			err := SubjectFilterValidationError{
-				field:  "Relation",
-				reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EntityFilterValidationError{
+						field:  fmt.Sprintf("Predicates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EntityFilterValidationError{
+						field:  fmt.Sprintf("Predicates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-			if !all {
-				return err
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EntityFilterValidationError{
+					field:  fmt.Sprintf("Predicates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
-			errors = append(errors, err)
 		}
 
 	}
 
 	if len(errors) > 0 {
-		return SubjectFilterMultiError(errors)
+		return EntityFilterMultiError(errors)
 	}
 
 	return nil
 }
 
-// SubjectFilterMultiError is an error wrapping multiple validation errors
-// returned by SubjectFilter.ValidateAll() if the designated constraints
-// aren't met.
-type SubjectFilterMultiError []error
+// EntityFilterMultiError is an error wrapping multiple validation errors
+// returned by EntityFilter.ValidateAll() if the designated constraints aren't met.
+type EntityFilterMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SubjectFilterMultiError) Error() string {
+func (m EntityFilterMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4687,11 +5458,11 @@ func (m SubjectFilterMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SubjectFilterMultiError) AllErrors() []error { return m }
+func (m EntityFilterMultiError) AllErrors() []error { return m }
 
-// SubjectFilterValidationError is the validation error returned by
-// SubjectFilter.Validate if the designated constraints aren't met.
-type SubjectFilterValidationError struct {
+// EntityFilterValidationError is the validation error returned by
+// EntityFilter.Validate if the designated constraints aren't met.
+type EntityFilterValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4699,22 +5470,22 @@ type SubjectFilterValidationError struct {
 }
 
 // Field function returns field value.
-func (e SubjectFilterValidationError) Field() string { return e.field }
+func (e EntityFilterValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SubjectFilterValidationError) Reason() string { return e.reason }
+func (e EntityFilterValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SubjectFilterValidationError) Cause() error { return e.cause }
+func (e EntityFilterValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SubjectFilterValidationError) Key() bool { return e.key }
+func (e EntityFilterValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SubjectFilterValidationError) ErrorName() string { return "SubjectFilterValidationError" }
+func (e EntityFilterValidationError) ErrorName() string { return "EntityFilterValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SubjectFilterValidationError) Error() string {
+func (e EntityFilterValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4726,14 +5497,14 @@ func (e SubjectFilterValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSubjectFilter.%s: %s%s",
+		"invalid %sEntityFilter.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SubjectFilterValidationError{}
+var _ error = EntityFilterValidationError{}
 
 var _ interface {
 	Field() string
@@ -4741,46 +5512,208 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SubjectFilterValidationError{}
-
-var _SubjectFilter_Relation_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+} = EntityFilterValidationError{}
 
-// Validate checks the field values on ExpandTreeNode with the rules defined in
+// Validate checks the field values on SubjectFilter with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *ExpandTreeNode) Validate() error {
+func (m *SubjectFilter) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExpandTreeNode with the rules defined
+// ValidateAll checks the field values on SubjectFilter with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ExpandTreeNodeMultiError,
-// or nil if none found.
-func (m *ExpandTreeNode) ValidateAll() error {
+// result is a list of violation errors wrapped in SubjectFilterMultiError, or
+// nil if none found.
+func (m *SubjectFilter) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExpandTreeNode) validate(all bool) error {
+func (m *SubjectFilter) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Operation
+	// no validation rules for Type
 
-	for idx, item := range m.GetChildren() {
-		_, _ = idx, item
+	if m.GetRelation() != "" {
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ExpandTreeNodeValidationError{
-						field:  fmt.Sprintf("Children[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
+		if len(m.GetRelation()) > 64 {
+			err := SubjectFilterValidationError{
+				field:  "Relation",
+				reason: "value length must be at most 64 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_SubjectFilter_Relation_Pattern.MatchString(m.GetRelation()) {
+			err := SubjectFilterValidationError{
+				field:  "Relation",
+				reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	for idx, item := range m.GetPredicates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SubjectFilterValidationError{
+						field:  fmt.Sprintf("Predicates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SubjectFilterValidationError{
+						field:  fmt.Sprintf("Predicates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SubjectFilterValidationError{
+					field:  fmt.Sprintf("Predicates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SubjectFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubjectFilterMultiError is an error wrapping multiple validation errors
+// returned by SubjectFilter.ValidateAll() if the designated constraints
+// aren't met.
+type SubjectFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubjectFilterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubjectFilterMultiError) AllErrors() []error { return m }
+
+// SubjectFilterValidationError is the validation error returned by
+// SubjectFilter.Validate if the designated constraints aren't met.
+type SubjectFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubjectFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubjectFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubjectFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubjectFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubjectFilterValidationError) ErrorName() string { return "SubjectFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubjectFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubjectFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubjectFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubjectFilterValidationError{}
+
+var _SubjectFilter_Relation_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+
+// Validate checks the field values on ExpandTreeNode with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExpandTreeNode) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExpandTreeNode with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExpandTreeNodeMultiError,
+// or nil if none found.
+func (m *ExpandTreeNode) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExpandTreeNode) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Operation
+
+	for idx, item := range m.GetChildren() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExpandTreeNodeValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
@@ -5472,22 +6405,600 @@ type ValuesValidationError struct {
 }
 
 // Field function returns field value.
-func (e ValuesValidationError) Field() string { return e.field }
+func (e ValuesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValuesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValuesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValuesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValuesValidationError) ErrorName() string { return "ValuesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ValuesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValues.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValuesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValuesValidationError{}
+
+// Validate checks the field values on Subjects with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Subjects) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Subjects with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SubjectsMultiError, or nil
+// if none found.
+func (m *Subjects) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Subjects) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSubjects() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SubjectsValidationError{
+						field:  fmt.Sprintf("Subjects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SubjectsValidationError{
+						field:  fmt.Sprintf("Subjects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SubjectsValidationError{
+					field:  fmt.Sprintf("Subjects[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SubjectsMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubjectsMultiError is an error wrapping multiple validation errors returned
+// by Subjects.ValidateAll() if the designated constraints aren't met.
+type SubjectsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubjectsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubjectsMultiError) AllErrors() []error { return m }
+
+// SubjectsValidationError is the validation error returned by
+// Subjects.Validate if the designated constraints aren't met.
+type SubjectsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubjectsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubjectsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubjectsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubjectsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubjectsValidationError) ErrorName() string { return "SubjectsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubjectsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubjects.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubjectsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubjectsValidationError{}
+
+// Validate checks the field values on Tenant with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Tenant) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tenant with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TenantMultiError, or nil if none found.
+func (m *Tenant) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tenant) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TenantMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantMultiError is an error wrapping multiple validation errors returned by
+// Tenant.ValidateAll() if the designated constraints aren't met.
+type TenantMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantMultiError) AllErrors() []error { return m }
+
+// TenantValidationError is the validation error returned by Tenant.Validate if
+// the designated constraints aren't met.
+type TenantValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantValidationError) ErrorName() string { return "TenantValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TenantValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenant.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantValidationError{}
+
+// Validate checks the field values on AuditRecord with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditRecord with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditRecordMultiError, or
+// nil if none found.
+func (m *AuditRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TenantId
+
+	// no validation rules for Actor
+
+	// no validation rules for Method
+
+	// no validation rules for Summary
+
+	// no validation rules for SnapToken
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for Outcome
+
+	// no validation rules for Error
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditRecordValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditRecordValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditRecordValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTransactionMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditRecordValidationError{
+					field:  "TransactionMetadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditRecordValidationError{
+					field:  "TransactionMetadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransactionMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditRecordValidationError{
+				field:  "TransactionMetadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuditRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditRecordMultiError is an error wrapping multiple validation errors
+// returned by AuditRecord.ValidateAll() if the designated constraints aren't met.
+type AuditRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditRecordMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditRecordMultiError) AllErrors() []error { return m }
+
+// AuditRecordValidationError is the validation error returned by
+// AuditRecord.Validate if the designated constraints aren't met.
+type AuditRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditRecordValidationError) ErrorName() string { return "AuditRecordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditRecordValidationError{}
+
+// Validate checks the field values on AccessReviewEntry with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AccessReviewEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessReviewEntry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccessReviewEntryMultiError, or nil if none found.
+func (m *AccessReviewEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessReviewEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EntityType
+
+	// no validation rules for EntityId
+
+	// no validation rules for Permission
+
+	if all {
+		switch v := interface{}(m.GetSubject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessReviewEntryValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessReviewEntryValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessReviewEntryValidationError{
+				field:  "Subject",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Access
+
+	if len(errors) > 0 {
+		return AccessReviewEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessReviewEntryMultiError is an error wrapping multiple validation errors
+// returned by AccessReviewEntry.ValidateAll() if the designated constraints
+// aren't met.
+type AccessReviewEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessReviewEntryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessReviewEntryMultiError) AllErrors() []error { return m }
+
+// AccessReviewEntryValidationError is the validation error returned by
+// AccessReviewEntry.Validate if the designated constraints aren't met.
+type AccessReviewEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessReviewEntryValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ValuesValidationError) Reason() string { return e.reason }
+func (e AccessReviewEntryValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ValuesValidationError) Cause() error { return e.cause }
+func (e AccessReviewEntryValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ValuesValidationError) Key() bool { return e.key }
+func (e AccessReviewEntryValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ValuesValidationError) ErrorName() string { return "ValuesValidationError" }
+func (e AccessReviewEntryValidationError) ErrorName() string {
+	return "AccessReviewEntryValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ValuesValidationError) Error() string {
+func (e AccessReviewEntryValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5499,14 +7010,14 @@ func (e ValuesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sValues.%s: %s%s",
+		"invalid %sAccessReviewEntry.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ValuesValidationError{}
+var _ error = AccessReviewEntryValidationError{}
 
 var _ interface {
 	Field() string
@@ -5514,77 +7025,155 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ValuesValidationError{}
+} = AccessReviewEntryValidationError{}
 
-// Validate checks the field values on Subjects with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on Consistency with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Subjects) Validate() error {
+func (m *Consistency) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Subjects with the rules defined in
+// ValidateAll checks the field values on Consistency with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SubjectsMultiError, or nil
-// if none found.
-func (m *Subjects) ValidateAll() error {
+// result is a list of violation errors wrapped in ConsistencyMultiError, or
+// nil if none found.
+func (m *Consistency) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Subjects) validate(all bool) error {
+func (m *Consistency) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetSubjects() {
-		_, _ = idx, item
+	oneofRequirementPresent := false
+	switch v := m.Requirement.(type) {
+	case *Consistency_MinimizeLatency:
+		if v == nil {
+			err := ConsistencyValidationError{
+				field:  "Requirement",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofRequirementPresent = true
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SubjectsValidationError{
-						field:  fmt.Sprintf("Subjects[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SubjectsValidationError{
-						field:  fmt.Sprintf("Subjects[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+		if m.GetMinimizeLatency() != true {
+			err := ConsistencyValidationError{
+				field:  "MinimizeLatency",
+				reason: "value must equal true",
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SubjectsValidationError{
-					field:  fmt.Sprintf("Subjects[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *Consistency_AtLeastAsFresh:
+		if v == nil {
+			err := ConsistencyValidationError{
+				field:  "Requirement",
+				reason: "oneof value cannot be a typed-nil",
 			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofRequirementPresent = true
+
+		if utf8.RuneCountInString(m.GetAtLeastAsFresh()) < 1 {
+			err := ConsistencyValidationError{
+				field:  "AtLeastAsFresh",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *Consistency_AtExactSnapshot:
+		if v == nil {
+			err := ConsistencyValidationError{
+				field:  "Requirement",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofRequirementPresent = true
+
+		if utf8.RuneCountInString(m.GetAtExactSnapshot()) < 1 {
+			err := ConsistencyValidationError{
+				field:  "AtExactSnapshot",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *Consistency_FullyConsistent:
+		if v == nil {
+			err := ConsistencyValidationError{
+				field:  "Requirement",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofRequirementPresent = true
+
+		if m.GetFullyConsistent() != true {
+			err := ConsistencyValidationError{
+				field:  "FullyConsistent",
+				reason: "value must equal true",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
 
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofRequirementPresent {
+		err := ConsistencyValidationError{
+			field:  "Requirement",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return SubjectsMultiError(errors)
+		return ConsistencyMultiError(errors)
 	}
 
 	return nil
 }
 
-// SubjectsMultiError is an error wrapping multiple validation errors returned
-// by Subjects.ValidateAll() if the designated constraints aren't met.
-type SubjectsMultiError []error
+// ConsistencyMultiError is an error wrapping multiple validation errors
+// returned by Consistency.ValidateAll() if the designated constraints aren't met.
+type ConsistencyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SubjectsMultiError) Error() string {
+func (m ConsistencyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5593,11 +7182,11 @@ func (m SubjectsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SubjectsMultiError) AllErrors() []error { return m }
+func (m ConsistencyMultiError) AllErrors() []error { return m }
 
-// SubjectsValidationError is the validation error returned by
-// Subjects.Validate if the designated constraints aren't met.
-type SubjectsValidationError struct {
+// ConsistencyValidationError is the validation error returned by
+// Consistency.Validate if the designated constraints aren't met.
+type ConsistencyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5605,22 +7194,22 @@ type SubjectsValidationError struct {
 }
 
 // Field function returns field value.
-func (e SubjectsValidationError) Field() string { return e.field }
+func (e ConsistencyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SubjectsValidationError) Reason() string { return e.reason }
+func (e ConsistencyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SubjectsValidationError) Cause() error { return e.cause }
+func (e ConsistencyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SubjectsValidationError) Key() bool { return e.key }
+func (e ConsistencyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SubjectsValidationError) ErrorName() string { return "SubjectsValidationError" }
+func (e ConsistencyValidationError) ErrorName() string { return "ConsistencyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SubjectsValidationError) Error() string {
+func (e ConsistencyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5632,14 +7221,14 @@ func (e SubjectsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSubjects.%s: %s%s",
+		"invalid %sConsistency.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SubjectsValidationError{}
+var _ error = ConsistencyValidationError{}
 
 var _ interface {
 	Field() string
@@ -5647,75 +7236,125 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SubjectsValidationError{}
+} = ConsistencyValidationError{}
 
-// Validate checks the field values on Tenant with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Tenant) Validate() error {
+// Validate checks the field values on TransactionMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransactionMetadata) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Tenant with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in TenantMultiError, or nil if none found.
-func (m *Tenant) ValidateAll() error {
+// ValidateAll checks the field values on TransactionMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransactionMetadataMultiError, or nil if none found.
+func (m *TransactionMetadata) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Tenant) validate(all bool) error {
+func (m *TransactionMetadata) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if len(m.GetActor()) > 256 {
+		err := TransactionMetadataValidationError{
+			field:  "Actor",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Name
+	if len(m.GetReason()) > 1024 {
+		err := TransactionMetadataValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 1024 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TenantValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TenantValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if len(m.GetRequestId()) > 128 {
+		err := TransactionMetadataValidationError{
+			field:  "RequestId",
+			reason: "value length must be at most 128 bytes",
 		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TenantValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetLabels()) > 32 {
+		err := TransactionMetadataValidationError{
+			field:  "Labels",
+			reason: "value must contain no more than 32 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetLabels()))
+		i := 0
+		for key := range m.GetLabels() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetLabels()[key]
+			_ = val
+
+			if l := len(key); l < 1 || l > 64 {
+				err := TransactionMetadataValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be between 1 and 64 bytes, inclusive",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if len(val) > 256 {
+				err := TransactionMetadataValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be at most 256 bytes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
 			}
+
 		}
 	}
 
 	if len(errors) > 0 {
-		return TenantMultiError(errors)
+		return TransactionMetadataMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantMultiError is an error wrapping multiple validation errors returned by
-// Tenant.ValidateAll() if the designated constraints aren't met.
-type TenantMultiError []error
+// TransactionMetadataMultiError is an error wrapping multiple validation
+// errors returned by TransactionMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type TransactionMetadataMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantMultiError) Error() string {
+func (m TransactionMetadataMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5724,11 +7363,11 @@ func (m TenantMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantMultiError) AllErrors() []error { return m }
+func (m TransactionMetadataMultiError) AllErrors() []error { return m }
 
-// TenantValidationError is the validation error returned by Tenant.Validate if
-// the designated constraints aren't met.
-type TenantValidationError struct {
+// TransactionMetadataValidationError is the validation error returned by
+// TransactionMetadata.Validate if the designated constraints aren't met.
+type TransactionMetadataValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5736,22 +7375,24 @@ type TenantValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantValidationError) Field() string { return e.field }
+func (e TransactionMetadataValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantValidationError) Reason() string { return e.reason }
+func (e TransactionMetadataValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantValidationError) Cause() error { return e.cause }
+func (e TransactionMetadataValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantValidationError) Key() bool { return e.key }
+func (e TransactionMetadataValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantValidationError) ErrorName() string { return "TenantValidationError" }
+func (e TransactionMetadataValidationError) ErrorName() string {
+	return "TransactionMetadataValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TenantValidationError) Error() string {
+func (e TransactionMetadataValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5763,14 +7404,14 @@ func (e TenantValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenant.%s: %s%s",
+		"invalid %sTransactionMetadata.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantValidationError{}
+var _ error = TransactionMetadataValidationError{}
 
 var _ interface {
 	Field() string
@@ -5778,7 +7419,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantValidationError{}
+} = TransactionMetadataValidationError{}
 
 // Validate checks the field values on DataChanges with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
@@ -5838,6 +7479,35 @@ func (m *DataChanges) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataChangesValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataChangesValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataChangesValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return DataChangesMultiError(errors)
 	}
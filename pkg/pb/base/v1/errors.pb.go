@@ -33,6 +33,8 @@ const (
 	ErrorCode_ERROR_CODE_INVALID_CLAIMS       ErrorCode = 1005
 	ErrorCode_ERROR_CODE_INVALID_ISSUER       ErrorCode = 1006
 	ErrorCode_ERROR_CODE_INVALID_BEARER_TOKEN ErrorCode = 1007
+	ErrorCode_ERROR_CODE_TENANT_ACCESS_DENIED ErrorCode = 1008
+	ErrorCode_ERROR_CODE_METHOD_ACCESS_DENIED ErrorCode = 1009
 	// validation
 	ErrorCode_ERROR_CODE_VALIDATION                                        ErrorCode = 2000
 	ErrorCode_ERROR_CODE_UNDEFINED_CHILD_TYPE                              ErrorCode = 2002
@@ -62,6 +64,15 @@ const (
 	ErrorCode_ERROR_CODE_MISSING_ARGUMENT                                  ErrorCode = 2028
 	ErrorCode_ERROR_CODE_ALREADY_EXIST                                     ErrorCode = 2029
 	ErrorCode_ERROR_CODE_MAX_DATA_PER_WRITE_EXCEEDED                       ErrorCode = 2030
+	ErrorCode_ERROR_CODE_CARDINALITY_VIOLATION                             ErrorCode = 2031
+	ErrorCode_ERROR_CODE_NOT_SUPPORTED_COUNT                               ErrorCode = 2032
+	ErrorCode_ERROR_CODE_SCHEMA_SHADOW_OUTDATED                            ErrorCode = 2033
+	ErrorCode_ERROR_CODE_SCHEMA_VERSION_IN_SHADOW                          ErrorCode = 2034
+	ErrorCode_ERROR_CODE_COST_LIMIT_EXCEEDED                               ErrorCode = 2035
+	ErrorCode_ERROR_CODE_FAILED_PRECONDITION                               ErrorCode = 2036
+	ErrorCode_ERROR_CODE_HISTORY_UNAVAILABLE                               ErrorCode = 2037
+	ErrorCode_ERROR_CODE_INVALID_SNAP_TOKEN                                ErrorCode = 2038
+	ErrorCode_ERROR_CODE_IDEMPOTENCY_KEY_REUSED                            ErrorCode = 2039
 	// not found
 	ErrorCode_ERROR_CODE_NOT_FOUND                       ErrorCode = 4000
 	ErrorCode_ERROR_CODE_ENTITY_TYPE_NOT_FOUND           ErrorCode = 4001
@@ -79,6 +90,8 @@ const (
 	ErrorCode_ERROR_CODE_RULE_DEFINITION_NOT_FOUND       ErrorCode = 4013
 	ErrorCode_ERROR_CODE_ENTITY_STATEMENT_NOT_FOUND      ErrorCode = 4014
 	ErrorCode_ERROR_CODE_REFERENCE_NOT_FOUND             ErrorCode = 4015
+	ErrorCode_ERROR_CODE_SCHEMA_SHADOW_NOT_FOUND         ErrorCode = 4016
+	ErrorCode_ERROR_CODE_SCHEMA_TAG_NOT_FOUND            ErrorCode = 4017
 	// internal
 	ErrorCode_ERROR_CODE_INTERNAL                                  ErrorCode = 5000
 	ErrorCode_ERROR_CODE_CANCELLED                                 ErrorCode = 5001
@@ -112,6 +125,8 @@ var (
 		1005: "ERROR_CODE_INVALID_CLAIMS",
 		1006: "ERROR_CODE_INVALID_ISSUER",
 		1007: "ERROR_CODE_INVALID_BEARER_TOKEN",
+		1008: "ERROR_CODE_TENANT_ACCESS_DENIED",
+		1009: "ERROR_CODE_METHOD_ACCESS_DENIED",
 		2000: "ERROR_CODE_VALIDATION",
 		2002: "ERROR_CODE_UNDEFINED_CHILD_TYPE",
 		2003: "ERROR_CODE_UNDEFINED_CHILD_KIND",
@@ -140,6 +155,15 @@ var (
 		2028: "ERROR_CODE_MISSING_ARGUMENT",
 		2029: "ERROR_CODE_ALREADY_EXIST",
 		2030: "ERROR_CODE_MAX_DATA_PER_WRITE_EXCEEDED",
+		2031: "ERROR_CODE_CARDINALITY_VIOLATION",
+		2032: "ERROR_CODE_NOT_SUPPORTED_COUNT",
+		2033: "ERROR_CODE_SCHEMA_SHADOW_OUTDATED",
+		2034: "ERROR_CODE_SCHEMA_VERSION_IN_SHADOW",
+		2035: "ERROR_CODE_COST_LIMIT_EXCEEDED",
+		2036: "ERROR_CODE_FAILED_PRECONDITION",
+		2037: "ERROR_CODE_HISTORY_UNAVAILABLE",
+		2038: "ERROR_CODE_INVALID_SNAP_TOKEN",
+		2039: "ERROR_CODE_IDEMPOTENCY_KEY_REUSED",
 		4000: "ERROR_CODE_NOT_FOUND",
 		4001: "ERROR_CODE_ENTITY_TYPE_NOT_FOUND",
 		4002: "ERROR_CODE_PERMISSION_NOT_FOUND",
@@ -156,6 +180,8 @@ var (
 		4013: "ERROR_CODE_RULE_DEFINITION_NOT_FOUND",
 		4014: "ERROR_CODE_ENTITY_STATEMENT_NOT_FOUND",
 		4015: "ERROR_CODE_REFERENCE_NOT_FOUND",
+		4016: "ERROR_CODE_SCHEMA_SHADOW_NOT_FOUND",
+		4017: "ERROR_CODE_SCHEMA_TAG_NOT_FOUND",
 		5000: "ERROR_CODE_INTERNAL",
 		5001: "ERROR_CODE_CANCELLED",
 		5002: "ERROR_CODE_SQL_BUILDER",
@@ -185,6 +211,8 @@ var (
 		"ERROR_CODE_INVALID_CLAIMS":                                    1005,
 		"ERROR_CODE_INVALID_ISSUER":                                    1006,
 		"ERROR_CODE_INVALID_BEARER_TOKEN":                              1007,
+		"ERROR_CODE_TENANT_ACCESS_DENIED":                              1008,
+		"ERROR_CODE_METHOD_ACCESS_DENIED":                              1009,
 		"ERROR_CODE_VALIDATION":                                        2000,
 		"ERROR_CODE_UNDEFINED_CHILD_TYPE":                              2002,
 		"ERROR_CODE_UNDEFINED_CHILD_KIND":                              2003,
@@ -213,6 +241,15 @@ var (
 		"ERROR_CODE_MISSING_ARGUMENT":                                  2028,
 		"ERROR_CODE_ALREADY_EXIST":                                     2029,
 		"ERROR_CODE_MAX_DATA_PER_WRITE_EXCEEDED":                       2030,
+		"ERROR_CODE_CARDINALITY_VIOLATION":                             2031,
+		"ERROR_CODE_NOT_SUPPORTED_COUNT":                               2032,
+		"ERROR_CODE_SCHEMA_SHADOW_OUTDATED":                            2033,
+		"ERROR_CODE_SCHEMA_VERSION_IN_SHADOW":                          2034,
+		"ERROR_CODE_COST_LIMIT_EXCEEDED":                               2035,
+		"ERROR_CODE_FAILED_PRECONDITION":                               2036,
+		"ERROR_CODE_HISTORY_UNAVAILABLE":                               2037,
+		"ERROR_CODE_INVALID_SNAP_TOKEN":                                2038,
+		"ERROR_CODE_IDEMPOTENCY_KEY_REUSED":                            2039,
 		"ERROR_CODE_NOT_FOUND":                                         4000,
 		"ERROR_CODE_ENTITY_TYPE_NOT_FOUND":                             4001,
 		"ERROR_CODE_PERMISSION_NOT_FOUND":                              4002,
@@ -229,6 +266,8 @@ var (
 		"ERROR_CODE_RULE_DEFINITION_NOT_FOUND":                         4013,
 		"ERROR_CODE_ENTITY_STATEMENT_NOT_FOUND":                        4014,
 		"ERROR_CODE_REFERENCE_NOT_FOUND":                               4015,
+		"ERROR_CODE_SCHEMA_SHADOW_NOT_FOUND":                           4016,
+		"ERROR_CODE_SCHEMA_TAG_NOT_FOUND":                              4017,
 		"ERROR_CODE_INTERNAL":                                          5000,
 		"ERROR_CODE_CANCELLED":                                         5001,
 		"ERROR_CODE_SQL_BUILDER":                                       5002,
@@ -338,7 +377,7 @@ const file_base_v1_errors_proto_rawDesc = "" +
 	"\x14base/v1/errors.proto\x12\abase.v1\"Q\n" +
 	"\rErrorResponse\x12&\n" +
 	"\x04code\x18\x01 \x01(\x0e2\x12.base.v1.ErrorCodeR\x04code\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage*\xe0\x15\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage*\xd4\x19\n" +
 	"\tErrorCode\x12\x1a\n" +
 	"\x16ERROR_CODE_UNSPECIFIED\x10\x00\x12$\n" +
 	"\x1fERROR_CODE_MISSING_BEARER_TOKEN\x10\xe9\a\x12\x1f\n" +
@@ -347,7 +386,9 @@ const file_base_v1_errors_proto_rawDesc = "" +
 	"\x1bERROR_CODE_INVALID_AUDIENCE\x10\xec\a\x12\x1e\n" +
 	"\x19ERROR_CODE_INVALID_CLAIMS\x10\xed\a\x12\x1e\n" +
 	"\x19ERROR_CODE_INVALID_ISSUER\x10\xee\a\x12$\n" +
-	"\x1fERROR_CODE_INVALID_BEARER_TOKEN\x10\xef\a\x12\x1a\n" +
+	"\x1fERROR_CODE_INVALID_BEARER_TOKEN\x10\xef\a\x12$\n" +
+	"\x1fERROR_CODE_TENANT_ACCESS_DENIED\x10\xf0\a\x12$\n" +
+	"\x1fERROR_CODE_METHOD_ACCESS_DENIED\x10\xf1\a\x12\x1a\n" +
 	"\x15ERROR_CODE_VALIDATION\x10\xd0\x0f\x12$\n" +
 	"\x1fERROR_CODE_UNDEFINED_CHILD_TYPE\x10\xd2\x0f\x12$\n" +
 	"\x1fERROR_CODE_UNDEFINED_CHILD_KIND\x10\xd3\x0f\x12,\n" +
@@ -375,7 +416,16 @@ const file_base_v1_errors_proto_rawDesc = "" +
 	"\x1dERROR_CODE_NOT_SUPPORTED_WALK\x10\xeb\x0f\x12 \n" +
 	"\x1bERROR_CODE_MISSING_ARGUMENT\x10\xec\x0f\x12\x1d\n" +
 	"\x18ERROR_CODE_ALREADY_EXIST\x10\xed\x0f\x12+\n" +
-	"&ERROR_CODE_MAX_DATA_PER_WRITE_EXCEEDED\x10\xee\x0f\x12\x19\n" +
+	"&ERROR_CODE_MAX_DATA_PER_WRITE_EXCEEDED\x10\xee\x0f\x12%\n" +
+	" ERROR_CODE_CARDINALITY_VIOLATION\x10\xef\x0f\x12#\n" +
+	"\x1eERROR_CODE_NOT_SUPPORTED_COUNT\x10\xf0\x0f\x12&\n" +
+	"!ERROR_CODE_SCHEMA_SHADOW_OUTDATED\x10\xf1\x0f\x12(\n" +
+	"#ERROR_CODE_SCHEMA_VERSION_IN_SHADOW\x10\xf2\x0f\x12#\n" +
+	"\x1eERROR_CODE_COST_LIMIT_EXCEEDED\x10\xf3\x0f\x12#\n" +
+	"\x1eERROR_CODE_FAILED_PRECONDITION\x10\xf4\x0f\x12#\n" +
+	"\x1eERROR_CODE_HISTORY_UNAVAILABLE\x10\xf5\x0f\x12\"\n" +
+	"\x1dERROR_CODE_INVALID_SNAP_TOKEN\x10\xf6\x0f\x12&\n" +
+	"!ERROR_CODE_IDEMPOTENCY_KEY_REUSED\x10\xf7\x0f\x12\x19\n" +
 	"\x14ERROR_CODE_NOT_FOUND\x10\xa0\x1f\x12%\n" +
 	" ERROR_CODE_ENTITY_TYPE_NOT_FOUND\x10\xa1\x1f\x12$\n" +
 	"\x1fERROR_CODE_PERMISSION_NOT_FOUND\x10\xa2\x1f\x12 \n" +
@@ -391,7 +441,9 @@ const file_base_v1_errors_proto_rawDesc = "" +
 	"\x1bERROR_CODE_BUNDLE_NOT_FOUND\x10\xac\x1f\x12)\n" +
 	"$ERROR_CODE_RULE_DEFINITION_NOT_FOUND\x10\xad\x1f\x12*\n" +
 	"%ERROR_CODE_ENTITY_STATEMENT_NOT_FOUND\x10\xae\x1f\x12#\n" +
-	"\x1eERROR_CODE_REFERENCE_NOT_FOUND\x10\xaf\x1f\x12\x18\n" +
+	"\x1eERROR_CODE_REFERENCE_NOT_FOUND\x10\xaf\x1f\x12'\n" +
+	"\"ERROR_CODE_SCHEMA_SHADOW_NOT_FOUND\x10\xb0\x1f\x12$\n" +
+	"\x1fERROR_CODE_SCHEMA_TAG_NOT_FOUND\x10\xb1\x1f\x12\x18\n" +
 	"\x13ERROR_CODE_INTERNAL\x10\x88'\x12\x19\n" +
 	"\x14ERROR_CODE_CANCELLED\x10\x89'\x12\x1b\n" +
 	"\x16ERROR_CODE_SQL_BUILDER\x10\x8a'\x12\x1f\n" +
@@ -12,6 +12,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -24,6 +25,112 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// DataVerifyAction is what a verification does with the tuples and attributes that violate the schema.
+type DataVerifyAction int32
+
+const (
+	// Only report the violations.
+	DataVerifyAction_DATA_VERIFY_ACTION_REPORT DataVerifyAction = 0
+	// Delete the violating tuples and attributes.
+	DataVerifyAction_DATA_VERIFY_ACTION_DELETE DataVerifyAction = 1
+	// Copy the violating tuples and attributes to the quarantine tenant, then delete them.
+	DataVerifyAction_DATA_VERIFY_ACTION_QUARANTINE DataVerifyAction = 2
+)
+
+// Enum value maps for DataVerifyAction.
+var (
+	DataVerifyAction_name = map[int32]string{
+		0: "DATA_VERIFY_ACTION_REPORT",
+		1: "DATA_VERIFY_ACTION_DELETE",
+		2: "DATA_VERIFY_ACTION_QUARANTINE",
+	}
+	DataVerifyAction_value = map[string]int32{
+		"DATA_VERIFY_ACTION_REPORT":     0,
+		"DATA_VERIFY_ACTION_DELETE":     1,
+		"DATA_VERIFY_ACTION_QUARANTINE": 2,
+	}
+)
+
+func (x DataVerifyAction) Enum() *DataVerifyAction {
+	p := new(DataVerifyAction)
+	*p = x
+	return p
+}
+
+func (x DataVerifyAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DataVerifyAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_base_v1_service_proto_enumTypes[0].Descriptor()
+}
+
+func (DataVerifyAction) Type() protoreflect.EnumType {
+	return &file_base_v1_service_proto_enumTypes[0]
+}
+
+func (x DataVerifyAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DataVerifyAction.Descriptor instead.
+func (DataVerifyAction) EnumDescriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{0}
+}
+
+// Severity of the finding, configurable per rule.
+type SchemaLintFinding_Severity int32
+
+const (
+	SchemaLintFinding_SEVERITY_UNSPECIFIED SchemaLintFinding_Severity = 0 // Default, unspecified severity.
+	SchemaLintFinding_SEVERITY_INFO        SchemaLintFinding_Severity = 1 // The finding is informational.
+	SchemaLintFinding_SEVERITY_WARNING     SchemaLintFinding_Severity = 2 // The finding is likely a mistake.
+	SchemaLintFinding_SEVERITY_ERROR       SchemaLintFinding_Severity = 3 // The finding is a mistake.
+)
+
+// Enum value maps for SchemaLintFinding_Severity.
+var (
+	SchemaLintFinding_Severity_name = map[int32]string{
+		0: "SEVERITY_UNSPECIFIED",
+		1: "SEVERITY_INFO",
+		2: "SEVERITY_WARNING",
+		3: "SEVERITY_ERROR",
+	}
+	SchemaLintFinding_Severity_value = map[string]int32{
+		"SEVERITY_UNSPECIFIED": 0,
+		"SEVERITY_INFO":        1,
+		"SEVERITY_WARNING":     2,
+		"SEVERITY_ERROR":       3,
+	}
+)
+
+func (x SchemaLintFinding_Severity) Enum() *SchemaLintFinding_Severity {
+	p := new(SchemaLintFinding_Severity)
+	*p = x
+	return p
+}
+
+func (x SchemaLintFinding_Severity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SchemaLintFinding_Severity) Descriptor() protoreflect.EnumDescriptor {
+	return file_base_v1_service_proto_enumTypes[1].Descriptor()
+}
+
+func (SchemaLintFinding_Severity) Type() protoreflect.EnumType {
+	return &file_base_v1_service_proto_enumTypes[1]
+}
+
+func (x SchemaLintFinding_Severity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SchemaLintFinding_Severity.Descriptor instead.
+func (SchemaLintFinding_Severity) EnumDescriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{43, 0}
+}
+
 // PermissionCheckRequest is the request message for the Check method in the Permission service.
 type PermissionCheckRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -132,7 +239,14 @@ type PermissionCheckRequestMetadata struct {
 	// Token associated with the snap.
 	SnapToken string `protobuf:"bytes,2,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
 	// Depth of the check, must be greater than or equal to 3.
-	Depth         int32 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	Depth int32 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	// Tag of the schema version, used when schema_version is not set.
+	SchemaTag string `protobuf:"bytes,4,opt,name=schema_tag,proto3" json:"schema_tag,omitempty"`
+	// Time to read the data at, resolved to the last snapshot committed at or before it. Used when snap_token is not set,
+	// it must be within the garbage collection window.
+	AtTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=at_time,proto3" json:"at_time,omitempty"`
+	// Consistency of the snapshot the check is answered at. It takes precedence over snap_token and at_time when set.
+	Consistency   *Consistency `protobuf:"bytes,6,opt,name=consistency,proto3" json:"consistency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -188,13 +302,36 @@ func (x *PermissionCheckRequestMetadata) GetDepth() int32 {
 	return 0
 }
 
+func (x *PermissionCheckRequestMetadata) GetSchemaTag() string {
+	if x != nil {
+		return x.SchemaTag
+	}
+	return ""
+}
+
+func (x *PermissionCheckRequestMetadata) GetAtTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AtTime
+	}
+	return nil
+}
+
+func (x *PermissionCheckRequestMetadata) GetConsistency() *Consistency {
+	if x != nil {
+		return x.Consistency
+	}
+	return nil
+}
+
 // PermissionCheckResponse is the response message for the Check method in the Permission service.
 type PermissionCheckResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Result of the permission check.
 	Can CheckResult `protobuf:"varint,1,opt,name=can,proto3,enum=base.v1.CheckResult" json:"can,omitempty"`
 	// Metadata associated with this response.
-	Metadata      *PermissionCheckResponseMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Metadata *PermissionCheckResponseMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Warnings about the checked permission, e.g. when it is deprecated in the schema.
+	Warnings      []string `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -243,6 +380,13 @@ func (x *PermissionCheckResponse) GetMetadata() *PermissionCheckResponseMetadata
 	return nil
 }
 
+func (x *PermissionCheckResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
 // PermissionCheckResponseMetadata metadata for the PermissionCheckResponse.
 type PermissionCheckResponseMetadata struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -578,7 +722,14 @@ type PermissionExpandRequestMetadata struct {
 	// Version of the schema.
 	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
 	// Token associated with the snap.
-	SnapToken     string `protobuf:"bytes,2,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
+	SnapToken string `protobuf:"bytes,2,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
+	// Tag of the schema version, used when schema_version is not set.
+	SchemaTag string `protobuf:"bytes,3,opt,name=schema_tag,proto3" json:"schema_tag,omitempty"`
+	// Time to read the data at, resolved to the last snapshot committed at or before it. Used when snap_token is not set,
+	// it must be within the garbage collection window.
+	AtTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=at_time,proto3" json:"at_time,omitempty"`
+	// Consistency of the snapshot the expansion is answered at. It takes precedence over snap_token and at_time when set.
+	Consistency   *Consistency `protobuf:"bytes,5,opt,name=consistency,proto3" json:"consistency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -627,6 +778,27 @@ func (x *PermissionExpandRequestMetadata) GetSnapToken() string {
 	return ""
 }
 
+func (x *PermissionExpandRequestMetadata) GetSchemaTag() string {
+	if x != nil {
+		return x.SchemaTag
+	}
+	return ""
+}
+
+func (x *PermissionExpandRequestMetadata) GetAtTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AtTime
+	}
+	return nil
+}
+
+func (x *PermissionExpandRequestMetadata) GetConsistency() *Consistency {
+	if x != nil {
+		return x.Consistency
+	}
+	return nil
+}
+
 // PermissionExpandResponse is the response message for the Expand method in the Permission service.
 type PermissionExpandResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -697,8 +869,11 @@ type PermissionLookupEntityRequest struct {
 	// continuous_token is an optional parameter used for pagination.
 	// It should be the value received in the previous response.
 	ContinuousToken string `protobuf:"bytes,9,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Predicates the attributes of the returned entities must satisfy. Entities not satisfying
+	// every predicate are not checked.
+	Predicates    []*AttributePredicate `protobuf:"bytes,10,rep,name=predicates,proto3" json:"predicates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PermissionLookupEntityRequest) Reset() {
@@ -794,6 +969,13 @@ func (x *PermissionLookupEntityRequest) GetContinuousToken() string {
 	return ""
 }
 
+func (x *PermissionLookupEntityRequest) GetPredicates() []*AttributePredicate {
+	if x != nil {
+		return x.Predicates
+	}
+	return nil
+}
+
 // PermissionLookupEntityRequestMetadata metadata for the PermissionLookupEntityRequest.
 type PermissionLookupEntityRequestMetadata struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -802,7 +984,14 @@ type PermissionLookupEntityRequestMetadata struct {
 	// Token associated with the snap.
 	SnapToken string `protobuf:"bytes,2,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
 	// Depth of lookup, required, must be greater or equal to 3.
-	Depth         int32 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	Depth int32 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	// Tag of the schema version, used when schema_version is not set.
+	SchemaTag string `protobuf:"bytes,4,opt,name=schema_tag,proto3" json:"schema_tag,omitempty"`
+	// Time to read the data at, resolved to the last snapshot committed at or before it. Used when snap_token is not set,
+	// it must be within the garbage collection window.
+	AtTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=at_time,proto3" json:"at_time,omitempty"`
+	// Consistency of the snapshot the lookup is answered at. It takes precedence over snap_token and at_time when set.
+	Consistency   *Consistency `protobuf:"bytes,6,opt,name=consistency,proto3" json:"consistency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -858,6 +1047,27 @@ func (x *PermissionLookupEntityRequestMetadata) GetDepth() int32 {
 	return 0
 }
 
+func (x *PermissionLookupEntityRequestMetadata) GetSchemaTag() string {
+	if x != nil {
+		return x.SchemaTag
+	}
+	return ""
+}
+
+func (x *PermissionLookupEntityRequestMetadata) GetAtTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AtTime
+	}
+	return nil
+}
+
+func (x *PermissionLookupEntityRequestMetadata) GetConsistency() *Consistency {
+	if x != nil {
+		return x.Consistency
+	}
+	return nil
+}
+
 // PermissionLookupEntityResponse is the response message for the LookupEntity method in the Permission service.
 type PermissionLookupEntityResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -865,8 +1075,10 @@ type PermissionLookupEntityResponse struct {
 	EntityIds []string `protobuf:"bytes,1,rep,name=entity_ids,proto3" json:"entity_ids,omitempty"`
 	// continuous_token is a string that can be used to paginate and retrieve the next set of results.
 	ContinuousToken string `protobuf:"bytes,2,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Warnings about the looked up permission, e.g. when it is deprecated in the schema.
+	Warnings      []string `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PermissionLookupEntityResponse) Reset() {
@@ -913,6 +1125,13 @@ func (x *PermissionLookupEntityResponse) GetContinuousToken() string {
 	return ""
 }
 
+func (x *PermissionLookupEntityResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
 // PermissionLookupEntityStreamResponse is the response message for the LookupEntityStream method in the Permission service.
 type PermissionLookupEntityStreamResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1157,8 +1376,10 @@ type PermissionLookupSubjectRequest struct {
 	// continuous_token is an optional parameter used for pagination.
 	// It should be the value received in the previous response.
 	ContinuousToken string `protobuf:"bytes,9,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Predicates the attributes of the returned subjects must satisfy.
+	Predicates    []*AttributePredicate `protobuf:"bytes,10,rep,name=predicates,proto3" json:"predicates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PermissionLookupSubjectRequest) Reset() {
@@ -1254,6 +1475,13 @@ func (x *PermissionLookupSubjectRequest) GetContinuousToken() string {
 	return ""
 }
 
+func (x *PermissionLookupSubjectRequest) GetPredicates() []*AttributePredicate {
+	if x != nil {
+		return x.Predicates
+	}
+	return nil
+}
+
 // PermissionLookupSubjectRequestMetadata metadata for the PermissionLookupSubjectRequest.
 type PermissionLookupSubjectRequestMetadata struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1262,7 +1490,14 @@ type PermissionLookupSubjectRequestMetadata struct {
 	// Token associated with the snap.
 	SnapToken string `protobuf:"bytes,2,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
 	// Depth of the check, must be greater than or equal to 3.
-	Depth         int32 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	Depth int32 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	// Tag of the schema version, used when schema_version is not set.
+	SchemaTag string `protobuf:"bytes,4,opt,name=schema_tag,proto3" json:"schema_tag,omitempty"`
+	// Time to read the data at, resolved to the last snapshot committed at or before it. Used when snap_token is not set,
+	// it must be within the garbage collection window.
+	AtTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=at_time,proto3" json:"at_time,omitempty"`
+	// Consistency of the snapshot the lookup is answered at. It takes precedence over snap_token and at_time when set.
+	Consistency   *Consistency `protobuf:"bytes,6,opt,name=consistency,proto3" json:"consistency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1318,6 +1553,27 @@ func (x *PermissionLookupSubjectRequestMetadata) GetDepth() int32 {
 	return 0
 }
 
+func (x *PermissionLookupSubjectRequestMetadata) GetSchemaTag() string {
+	if x != nil {
+		return x.SchemaTag
+	}
+	return ""
+}
+
+func (x *PermissionLookupSubjectRequestMetadata) GetAtTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AtTime
+	}
+	return nil
+}
+
+func (x *PermissionLookupSubjectRequestMetadata) GetConsistency() *Consistency {
+	if x != nil {
+		return x.Consistency
+	}
+	return nil
+}
+
 // PermissionLookupSubjectResponse is the response message for the LookupSubject method in the Permission service.
 type PermissionLookupSubjectResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1325,8 +1581,10 @@ type PermissionLookupSubjectResponse struct {
 	SubjectIds []string `protobuf:"bytes,1,rep,name=subject_ids,proto3" json:"subject_ids,omitempty"`
 	// continuous_token is a string that can be used to paginate and retrieve the next set of results.
 	ContinuousToken string `protobuf:"bytes,2,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Warnings about the looked up permission, e.g. when it is deprecated in the schema.
+	Warnings      []string `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PermissionLookupSubjectResponse) Reset() {
@@ -1373,6 +1631,13 @@ func (x *PermissionLookupSubjectResponse) GetContinuousToken() string {
 	return ""
 }
 
+func (x *PermissionLookupSubjectResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
 // PermissionSubjectPermissionRequest is the request message for the SubjectPermission method in the Permission service.
 type PermissionSubjectPermissionRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1465,7 +1730,11 @@ type PermissionSubjectPermissionRequestMetadata struct {
 	// Whether to only check permissions.
 	OnlyPermission bool `protobuf:"varint,3,opt,name=only_permission,proto3" json:"only_permission,omitempty"`
 	// Depth of the check, must be greater than or equal to 3.
-	Depth         int32 `protobuf:"varint,4,opt,name=depth,proto3" json:"depth,omitempty"`
+	Depth int32 `protobuf:"varint,4,opt,name=depth,proto3" json:"depth,omitempty"`
+	// Tag of the schema version, used when schema_version is not set.
+	SchemaTag string `protobuf:"bytes,5,opt,name=schema_tag,proto3" json:"schema_tag,omitempty"`
+	// Consistency of the snapshot the checks is answered at. It takes precedence over snap_token when set.
+	Consistency   *Consistency `protobuf:"bytes,6,opt,name=consistency,proto3" json:"consistency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1528,6 +1797,20 @@ func (x *PermissionSubjectPermissionRequestMetadata) GetDepth() int32 {
 	return 0
 }
 
+func (x *PermissionSubjectPermissionRequestMetadata) GetSchemaTag() string {
+	if x != nil {
+		return x.SchemaTag
+	}
+	return ""
+}
+
+func (x *PermissionSubjectPermissionRequestMetadata) GetConsistency() *Consistency {
+	if x != nil {
+		return x.Consistency
+	}
+	return nil
+}
+
 // PermissionSubjectPermissionResponse is the response message for the SubjectPermission method in the Permission service.
 type PermissionSubjectPermissionResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1574,32 +1857,46 @@ func (x *PermissionSubjectPermissionResponse) GetResults() map[string]CheckResul
 	return nil
 }
 
-// WatchRequest is the request message for the Watch RPC. It contains the
-// details needed to establish a watch stream.
-type WatchRequest struct {
+// PermissionLookupEntitlementsRequest is the request message for the LookupEntitlements method in the Permission service.
+type PermissionLookupEntitlementsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Identifier of the tenant, required, and must match the pattern "[a-zA-Z0-9-,]+", max 64 bytes.
 	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
-	// Snap token to be used for watching.
-	SnapToken     string `protobuf:"bytes,2,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
+	// Metadata associated with this request, required.
+	Metadata *PermissionLookupEntitlementsRequestMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Subject whose entitlements are looked up, required.
+	Subject *Subject `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	// Types of the entities to lookup, every entity type of the schema if empty.
+	EntityTypes []string `protobuf:"bytes,4,rep,name=entity_types,proto3" json:"entity_types,omitempty"`
+	// Names of the permissions to lookup, every permission of the entity types if empty.
+	Permissions []string `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// Context associated with this request.
+	Context *Context `protobuf:"bytes,6,opt,name=context,proto3" json:"context,omitempty"`
+	// page_size is the number of entitlements to be streamed, 1000 if not set.
+	PageSize uint32 `protobuf:"varint,7,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	// continuous_token is an optional parameter used for pagination.
+	// It should be the value received with the last entitlement of the previous page.
+	ContinuousToken string `protobuf:"bytes,8,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
+	// cost_limit is the maximum number of permission checks the request may evaluate, 10000 if not set.
+	CostLimit     uint32 `protobuf:"varint,9,opt,name=cost_limit,proto3" json:"cost_limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WatchRequest) Reset() {
-	*x = WatchRequest{}
+func (x *PermissionLookupEntitlementsRequest) Reset() {
+	*x = PermissionLookupEntitlementsRequest{}
 	mi := &file_base_v1_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WatchRequest) String() string {
+func (x *PermissionLookupEntitlementsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WatchRequest) ProtoMessage() {}
+func (*PermissionLookupEntitlementsRequest) ProtoMessage() {}
 
-func (x *WatchRequest) ProtoReflect() protoreflect.Message {
+func (x *PermissionLookupEntitlementsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_base_v1_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1611,100 +1908,106 @@ func (x *WatchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WatchRequest.ProtoReflect.Descriptor instead.
-func (*WatchRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PermissionLookupEntitlementsRequest.ProtoReflect.Descriptor instead.
+func (*PermissionLookupEntitlementsRequest) Descriptor() ([]byte, []int) {
 	return file_base_v1_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *WatchRequest) GetTenantId() string {
+func (x *PermissionLookupEntitlementsRequest) GetTenantId() string {
 	if x != nil {
 		return x.TenantId
 	}
 	return ""
 }
 
-func (x *WatchRequest) GetSnapToken() string {
+func (x *PermissionLookupEntitlementsRequest) GetMetadata() *PermissionLookupEntitlementsRequestMetadata {
 	if x != nil {
-		return x.SnapToken
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-// WatchResponse is the response message for the Watch RPC. It contains the
-// changes in the data that are being watched.
-type WatchResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Changes in the data.
-	Changes       *DataChanges `protobuf:"bytes,1,opt,name=changes,proto3" json:"changes,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PermissionLookupEntitlementsRequest) GetSubject() *Subject {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
 }
 
-func (x *WatchResponse) Reset() {
-	*x = WatchResponse{}
-	mi := &file_base_v1_service_proto_msgTypes[23]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PermissionLookupEntitlementsRequest) GetEntityTypes() []string {
+	if x != nil {
+		return x.EntityTypes
+	}
+	return nil
 }
 
-func (x *WatchResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PermissionLookupEntitlementsRequest) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
 }
 
-func (*WatchResponse) ProtoMessage() {}
+func (x *PermissionLookupEntitlementsRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
 
-func (x *WatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[23]
+func (x *PermissionLookupEntitlementsRequest) GetPageSize() uint32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.PageSize
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use WatchResponse.ProtoReflect.Descriptor instead.
-func (*WatchResponse) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{23}
+func (x *PermissionLookupEntitlementsRequest) GetContinuousToken() string {
+	if x != nil {
+		return x.ContinuousToken
+	}
+	return ""
 }
 
-func (x *WatchResponse) GetChanges() *DataChanges {
+func (x *PermissionLookupEntitlementsRequest) GetCostLimit() uint32 {
 	if x != nil {
-		return x.Changes
+		return x.CostLimit
 	}
-	return nil
+	return 0
 }
 
-// SchemaWriteRequest is the request message for the Write method in the Schema service.
-// It contains tenant_id and the schema to be written.
-type SchemaWriteRequest struct {
+// PermissionLookupEntitlementsRequestMetadata metadata for the PermissionLookupEntitlementsRequest.
+type PermissionLookupEntitlementsRequestMetadata struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// tenant_id is a string that identifies the tenant. It must match the pattern "[a-zA-Z0-9-,]+",
-	// be a maximum of 64 bytes, and must not be empty.
-	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
-	// schema is the string representation of the schema to be written.
-	Schema        string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	// Version of the schema.
+	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	// Token associated with the snap.
+	SnapToken string `protobuf:"bytes,2,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
+	// Depth of lookup, required, must be greater or equal to 3.
+	Depth int32 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	// Tag of the schema version, used when schema_version is not set.
+	SchemaTag string `protobuf:"bytes,4,opt,name=schema_tag,proto3" json:"schema_tag,omitempty"`
+	// Consistency of the snapshot the lookup is answered at. It takes precedence over snap_token when set.
+	Consistency   *Consistency `protobuf:"bytes,5,opt,name=consistency,proto3" json:"consistency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SchemaWriteRequest) Reset() {
-	*x = SchemaWriteRequest{}
-	mi := &file_base_v1_service_proto_msgTypes[24]
+func (x *PermissionLookupEntitlementsRequestMetadata) Reset() {
+	*x = PermissionLookupEntitlementsRequestMetadata{}
+	mi := &file_base_v1_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SchemaWriteRequest) String() string {
+func (x *PermissionLookupEntitlementsRequestMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchemaWriteRequest) ProtoMessage() {}
+func (*PermissionLookupEntitlementsRequestMetadata) ProtoMessage() {}
 
-func (x *SchemaWriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[24]
+func (x *PermissionLookupEntitlementsRequestMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1715,50 +2018,76 @@ func (x *SchemaWriteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchemaWriteRequest.ProtoReflect.Descriptor instead.
-func (*SchemaWriteRequest) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use PermissionLookupEntitlementsRequestMetadata.ProtoReflect.Descriptor instead.
+func (*PermissionLookupEntitlementsRequestMetadata) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *SchemaWriteRequest) GetTenantId() string {
+func (x *PermissionLookupEntitlementsRequestMetadata) GetSchemaVersion() string {
 	if x != nil {
-		return x.TenantId
+		return x.SchemaVersion
 	}
 	return ""
 }
 
-func (x *SchemaWriteRequest) GetSchema() string {
+func (x *PermissionLookupEntitlementsRequestMetadata) GetSnapToken() string {
 	if x != nil {
-		return x.Schema
+		return x.SnapToken
 	}
 	return ""
 }
 
-// SchemaWriteResponse is the response message for the Write method in the Schema service.
-// It returns the version of the written schema.
-type SchemaWriteResponse struct {
+func (x *PermissionLookupEntitlementsRequestMetadata) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *PermissionLookupEntitlementsRequestMetadata) GetSchemaTag() string {
+	if x != nil {
+		return x.SchemaTag
+	}
+	return ""
+}
+
+func (x *PermissionLookupEntitlementsRequestMetadata) GetConsistency() *Consistency {
+	if x != nil {
+		return x.Consistency
+	}
+	return nil
+}
+
+// PermissionLookupEntitlementsStreamResponse is the response message for the LookupEntitlements method in the Permission service.
+type PermissionLookupEntitlementsStreamResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// schema_version is the string that identifies the version of the written schema.
-	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Type of the entity.
+	EntityType string `protobuf:"bytes,1,opt,name=entity_type,proto3" json:"entity_type,omitempty"`
+	// Name of the permission the subject holds on the entity.
+	Permission string `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+	// Identifier of the entity.
+	EntityId string `protobuf:"bytes,3,opt,name=entity_id,proto3" json:"entity_id,omitempty"`
+	// continuous_token is a string that can be used to retrieve the entitlements after this one.
+	ContinuousToken string `protobuf:"bytes,4,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *SchemaWriteResponse) Reset() {
-	*x = SchemaWriteResponse{}
-	mi := &file_base_v1_service_proto_msgTypes[25]
+func (x *PermissionLookupEntitlementsStreamResponse) Reset() {
+	*x = PermissionLookupEntitlementsStreamResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SchemaWriteResponse) String() string {
+func (x *PermissionLookupEntitlementsStreamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchemaWriteResponse) ProtoMessage() {}
+func (*PermissionLookupEntitlementsStreamResponse) ProtoMessage() {}
 
-func (x *SchemaWriteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[25]
+func (x *PermissionLookupEntitlementsStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1769,48 +2098,75 @@ func (x *SchemaWriteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchemaWriteResponse.ProtoReflect.Descriptor instead.
-func (*SchemaWriteResponse) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use PermissionLookupEntitlementsStreamResponse.ProtoReflect.Descriptor instead.
+func (*PermissionLookupEntitlementsStreamResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *SchemaWriteResponse) GetSchemaVersion() string {
+func (x *PermissionLookupEntitlementsStreamResponse) GetEntityType() string {
 	if x != nil {
-		return x.SchemaVersion
+		return x.EntityType
 	}
 	return ""
 }
 
-// It contains the tenant_id to identify the tenant and metadata of the schema to be edited,
-// with the corresponding edits to various entities
-type SchemaPartialWriteRequest struct {
+func (x *PermissionLookupEntitlementsStreamResponse) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *PermissionLookupEntitlementsStreamResponse) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *PermissionLookupEntitlementsStreamResponse) GetContinuousToken() string {
+	if x != nil {
+		return x.ContinuousToken
+	}
+	return ""
+}
+
+// PermissionSimulateRequest is the request message for the Simulate method in the Permission service.
+type PermissionSimulateRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// tenant_id is a string that identifies the tenant. It must match the pattern "[a-zA-Z0-9-,]+",
-	// be a maximum of 64 bytes, and must not be empty.
+	// Identifier of the tenant, required, and must match the pattern "[a-zA-Z0-9-,]+", max 64 bytes.
 	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
-	// metadata is the additional information needed for the Partial Write request.
-	Metadata *SchemaPartialWriteRequestMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// Map of entity name with the values needed to be updated
-	Partials      map[string]*Partials `protobuf:"bytes,3,rep,name=partials,proto3" json:"partials,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Hypothetical changes overlaid on the stored data, required.
+	Changes *SimulationChanges `protobuf:"bytes,2,opt,name=changes,proto3" json:"changes,omitempty"`
+	// The simulated request, one of them is required. Its tenant is the tenant of the simulation, and lookups
+	// are answered in full regardless of their page size and continuous token.
+	//
+	// Types that are valid to be assigned to Request:
+	//
+	//	*PermissionSimulateRequest_Check
+	//	*PermissionSimulateRequest_LookupEntity
+	//	*PermissionSimulateRequest_LookupSubject
+	//	*PermissionSimulateRequest_SubjectPermission
+	Request       isPermissionSimulateRequest_Request `protobuf_oneof:"request"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SchemaPartialWriteRequest) Reset() {
-	*x = SchemaPartialWriteRequest{}
-	mi := &file_base_v1_service_proto_msgTypes[26]
+func (x *PermissionSimulateRequest) Reset() {
+	*x = PermissionSimulateRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SchemaPartialWriteRequest) String() string {
+func (x *PermissionSimulateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchemaPartialWriteRequest) ProtoMessage() {}
+func (*PermissionSimulateRequest) ProtoMessage() {}
 
-func (x *SchemaPartialWriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[26]
+func (x *PermissionSimulateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1821,104 +2177,127 @@ func (x *SchemaPartialWriteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchemaPartialWriteRequest.ProtoReflect.Descriptor instead.
-func (*SchemaPartialWriteRequest) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use PermissionSimulateRequest.ProtoReflect.Descriptor instead.
+func (*PermissionSimulateRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *SchemaPartialWriteRequest) GetTenantId() string {
+func (x *PermissionSimulateRequest) GetTenantId() string {
 	if x != nil {
 		return x.TenantId
 	}
 	return ""
 }
 
-func (x *SchemaPartialWriteRequest) GetMetadata() *SchemaPartialWriteRequestMetadata {
+func (x *PermissionSimulateRequest) GetChanges() *SimulationChanges {
 	if x != nil {
-		return x.Metadata
+		return x.Changes
 	}
 	return nil
 }
 
-func (x *SchemaPartialWriteRequest) GetPartials() map[string]*Partials {
+func (x *PermissionSimulateRequest) GetRequest() isPermissionSimulateRequest_Request {
 	if x != nil {
-		return x.Partials
+		return x.Request
 	}
 	return nil
 }
 
-// SchemaPartialWriteRequestMetadata provides additional information for the Schema Partial Write request.
-// It contains schema_version to specify which version of the schema should be read.
-type SchemaPartialWriteRequestMetadata struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// schema_version is the string that identifies the version of the schema to be read.
-	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PermissionSimulateRequest) GetCheck() *PermissionCheckRequest {
+	if x != nil {
+		if x, ok := x.Request.(*PermissionSimulateRequest_Check); ok {
+			return x.Check
+		}
+	}
+	return nil
 }
 
-func (x *SchemaPartialWriteRequestMetadata) Reset() {
-	*x = SchemaPartialWriteRequestMetadata{}
-	mi := &file_base_v1_service_proto_msgTypes[27]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PermissionSimulateRequest) GetLookupEntity() *PermissionLookupEntityRequest {
+	if x != nil {
+		if x, ok := x.Request.(*PermissionSimulateRequest_LookupEntity); ok {
+			return x.LookupEntity
+		}
+	}
+	return nil
 }
 
-func (x *SchemaPartialWriteRequestMetadata) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PermissionSimulateRequest) GetLookupSubject() *PermissionLookupSubjectRequest {
+	if x != nil {
+		if x, ok := x.Request.(*PermissionSimulateRequest_LookupSubject); ok {
+			return x.LookupSubject
+		}
+	}
+	return nil
 }
 
-func (*SchemaPartialWriteRequestMetadata) ProtoMessage() {}
-
-func (x *SchemaPartialWriteRequestMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[27]
+func (x *PermissionSimulateRequest) GetSubjectPermission() *PermissionSubjectPermissionRequest {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		if x, ok := x.Request.(*PermissionSimulateRequest_SubjectPermission); ok {
+			return x.SubjectPermission
 		}
-		return ms
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use SchemaPartialWriteRequestMetadata.ProtoReflect.Descriptor instead.
-func (*SchemaPartialWriteRequestMetadata) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{27}
+type isPermissionSimulateRequest_Request interface {
+	isPermissionSimulateRequest_Request()
 }
 
-func (x *SchemaPartialWriteRequestMetadata) GetSchemaVersion() string {
-	if x != nil {
-		return x.SchemaVersion
-	}
-	return ""
+type PermissionSimulateRequest_Check struct {
+	Check *PermissionCheckRequest `protobuf:"bytes,3,opt,name=check,proto3,oneof"`
 }
 
-// SchemaPartialWriteResponse is the response message for the Parietal Write method in the Schema service.
-// It returns the requested schema.
-type SchemaPartialWriteResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// schema_version is the string that identifies the version of the written schema.
-	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PermissionSimulateRequest_LookupEntity struct {
+	LookupEntity *PermissionLookupEntityRequest `protobuf:"bytes,4,opt,name=lookup_entity,proto3,oneof"`
 }
 
-func (x *SchemaPartialWriteResponse) Reset() {
-	*x = SchemaPartialWriteResponse{}
-	mi := &file_base_v1_service_proto_msgTypes[28]
+type PermissionSimulateRequest_LookupSubject struct {
+	LookupSubject *PermissionLookupSubjectRequest `protobuf:"bytes,5,opt,name=lookup_subject,proto3,oneof"`
+}
+
+type PermissionSimulateRequest_SubjectPermission struct {
+	SubjectPermission *PermissionSubjectPermissionRequest `protobuf:"bytes,6,opt,name=subject_permission,proto3,oneof"`
+}
+
+func (*PermissionSimulateRequest_Check) isPermissionSimulateRequest_Request() {}
+
+func (*PermissionSimulateRequest_LookupEntity) isPermissionSimulateRequest_Request() {}
+
+func (*PermissionSimulateRequest_LookupSubject) isPermissionSimulateRequest_Request() {}
+
+func (*PermissionSimulateRequest_SubjectPermission) isPermissionSimulateRequest_Request() {}
+
+// SimulationChanges are hypothetical changes to the data of a tenant. Written attributes replace the stored
+// values of the same attributes.
+type SimulationChanges struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tuples written.
+	WriteTuples []*Tuple `protobuf:"bytes,1,rep,name=write_tuples,proto3" json:"write_tuples,omitempty"`
+	// Attributes written.
+	WriteAttributes []*Attribute `protobuf:"bytes,2,rep,name=write_attributes,proto3" json:"write_attributes,omitempty"`
+	// Tuples deleted.
+	DeleteTuples []*Tuple `protobuf:"bytes,3,rep,name=delete_tuples,proto3" json:"delete_tuples,omitempty"`
+	// Attributes deleted, identified by their entity and name. Their values are ignored.
+	DeleteAttributes []*Attribute `protobuf:"bytes,4,rep,name=delete_attributes,proto3" json:"delete_attributes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SimulationChanges) Reset() {
+	*x = SimulationChanges{}
+	mi := &file_base_v1_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SchemaPartialWriteResponse) String() string {
+func (x *SimulationChanges) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchemaPartialWriteResponse) ProtoMessage() {}
+func (*SimulationChanges) ProtoMessage() {}
 
-func (x *SchemaPartialWriteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[28]
+func (x *SimulationChanges) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1929,46 +2308,71 @@ func (x *SchemaPartialWriteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchemaPartialWriteResponse.ProtoReflect.Descriptor instead.
-func (*SchemaPartialWriteResponse) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use SimulationChanges.ProtoReflect.Descriptor instead.
+func (*SimulationChanges) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *SchemaPartialWriteResponse) GetSchemaVersion() string {
+func (x *SimulationChanges) GetWriteTuples() []*Tuple {
 	if x != nil {
-		return x.SchemaVersion
+		return x.WriteTuples
 	}
-	return ""
+	return nil
 }
 
-// SchemaReadRequest is the request message for the Read method in the Schema service.
-// It contains tenant_id and metadata about the schema to be read.
-type SchemaReadRequest struct {
+func (x *SimulationChanges) GetWriteAttributes() []*Attribute {
+	if x != nil {
+		return x.WriteAttributes
+	}
+	return nil
+}
+
+func (x *SimulationChanges) GetDeleteTuples() []*Tuple {
+	if x != nil {
+		return x.DeleteTuples
+	}
+	return nil
+}
+
+func (x *SimulationChanges) GetDeleteAttributes() []*Attribute {
+	if x != nil {
+		return x.DeleteAttributes
+	}
+	return nil
+}
+
+// PermissionSimulateResponse is the response message for the Simulate method in the Permission service.
+// Gained and lost items are the checked permission for a check, entity ids for an entity lookup, subject
+// ids for a subject lookup and permission names for a subject permission request.
+type PermissionSimulateResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// tenant_id is a string that identifies the tenant. It must match the pattern "[a-zA-Z0-9-,]+",
-	// be a maximum of 64 bytes, and must not be empty.
-	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
-	// metadata is the additional information needed for the Read request.
-	Metadata      *SchemaReadRequestMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Items granted by the changes, sorted.
+	Gained []string `protobuf:"bytes,1,rep,name=gained,proto3" json:"gained,omitempty"`
+	// Items revoked by the changes, sorted.
+	Lost []string `protobuf:"bytes,2,rep,name=lost,proto3" json:"lost,omitempty"`
+	// Snap token of the stored data the changes were overlaid on.
+	SnapToken string `protobuf:"bytes,3,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
+	// Schema version the request was answered with.
+	SchemaVersion string `protobuf:"bytes,4,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SchemaReadRequest) Reset() {
-	*x = SchemaReadRequest{}
-	mi := &file_base_v1_service_proto_msgTypes[29]
+func (x *PermissionSimulateResponse) Reset() {
+	*x = PermissionSimulateResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SchemaReadRequest) String() string {
+func (x *PermissionSimulateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchemaReadRequest) ProtoMessage() {}
+func (*PermissionSimulateResponse) ProtoMessage() {}
 
-func (x *SchemaReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[29]
+func (x *PermissionSimulateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1979,50 +2383,66 @@ func (x *SchemaReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchemaReadRequest.ProtoReflect.Descriptor instead.
-func (*SchemaReadRequest) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use PermissionSimulateResponse.ProtoReflect.Descriptor instead.
+func (*PermissionSimulateResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *SchemaReadRequest) GetTenantId() string {
+func (x *PermissionSimulateResponse) GetGained() []string {
 	if x != nil {
-		return x.TenantId
+		return x.Gained
 	}
-	return ""
+	return nil
 }
 
-func (x *SchemaReadRequest) GetMetadata() *SchemaReadRequestMetadata {
+func (x *PermissionSimulateResponse) GetLost() []string {
 	if x != nil {
-		return x.Metadata
+		return x.Lost
 	}
 	return nil
 }
 
-// SchemaReadRequestMetadata provides additional information for the Schema Read request.
-// It contains schema_version to specify which version of the schema should be read.
-type SchemaReadRequestMetadata struct {
+func (x *PermissionSimulateResponse) GetSnapToken() string {
+	if x != nil {
+		return x.SnapToken
+	}
+	return ""
+}
+
+func (x *PermissionSimulateResponse) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+// WatchRequest is the request message for the Watch RPC. It contains the
+// details needed to establish a watch stream.
+type WatchRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// schema_version is the string that identifies the version of the schema to be read.
-	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	// Identifier of the tenant, required, and must match the pattern "[a-zA-Z0-9-,]+", max 64 bytes.
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	// Snap token to be used for watching.
+	SnapToken     string `protobuf:"bytes,2,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SchemaReadRequestMetadata) Reset() {
-	*x = SchemaReadRequestMetadata{}
-	mi := &file_base_v1_service_proto_msgTypes[30]
+func (x *WatchRequest) Reset() {
+	*x = WatchRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SchemaReadRequestMetadata) String() string {
+func (x *WatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchemaReadRequestMetadata) ProtoMessage() {}
+func (*WatchRequest) ProtoMessage() {}
 
-func (x *SchemaReadRequestMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[30]
+func (x *WatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2033,43 +2453,50 @@ func (x *SchemaReadRequestMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchemaReadRequestMetadata.ProtoReflect.Descriptor instead.
-func (*SchemaReadRequestMetadata) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use WatchRequest.ProtoReflect.Descriptor instead.
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *SchemaReadRequestMetadata) GetSchemaVersion() string {
+func (x *WatchRequest) GetTenantId() string {
 	if x != nil {
-		return x.SchemaVersion
+		return x.TenantId
 	}
 	return ""
 }
 
-// SchemaReadResponse is the response message for the Read method in the Schema service.
-// It returns the requested schema.
-type SchemaReadResponse struct {
+func (x *WatchRequest) GetSnapToken() string {
+	if x != nil {
+		return x.SnapToken
+	}
+	return ""
+}
+
+// WatchResponse is the response message for the Watch RPC. It contains the
+// changes in the data that are being watched.
+type WatchResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// schema is the SchemaDefinition that represents the read schema.
-	Schema        *SchemaDefinition `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	// Changes in the data.
+	Changes       *DataChanges `protobuf:"bytes,1,opt,name=changes,proto3" json:"changes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SchemaReadResponse) Reset() {
-	*x = SchemaReadResponse{}
-	mi := &file_base_v1_service_proto_msgTypes[31]
+func (x *WatchResponse) Reset() {
+	*x = WatchResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SchemaReadResponse) String() string {
+func (x *WatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchemaReadResponse) ProtoMessage() {}
+func (*WatchResponse) ProtoMessage() {}
 
-func (x *SchemaReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[31]
+func (x *WatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2080,50 +2507,48 @@ func (x *SchemaReadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchemaReadResponse.ProtoReflect.Descriptor instead.
-func (*SchemaReadResponse) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use WatchResponse.ProtoReflect.Descriptor instead.
+func (*WatchResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *SchemaReadResponse) GetSchema() *SchemaDefinition {
+func (x *WatchResponse) GetChanges() *DataChanges {
 	if x != nil {
-		return x.Schema
+		return x.Changes
 	}
 	return nil
 }
 
-// SchemaListRequest is the request message for the List method in the Schema service.
-// It contains tenant_id for which the schemas are to be listed.
-type SchemaListRequest struct {
+// SchemaWriteRequest is the request message for the Write method in the Schema service.
+// It contains tenant_id and the schema to be written.
+type SchemaWriteRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// tenant_id is a string that identifies the tenant. It must match the pattern "[a-zA-Z0-9-,]+",
 	// be a maximum of 64 bytes, and must not be empty.
 	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
-	// page_size is the number of schemas to be returned in the response.
-	// The value should be between 1 and 100.
-	PageSize uint32 `protobuf:"varint,2,opt,name=page_size,proto3" json:"page_size,omitempty"`
-	// continuous_token is an optional parameter used for pagination.
-	// It should be the value received in the previous response.
-	ContinuousToken string `protobuf:"bytes,3,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// schema is the string representation of the schema to be written.
+	Schema string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	// metadata describing who made the write and why, persisted alongside the written version.
+	Metadata      *TransactionMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SchemaListRequest) Reset() {
-	*x = SchemaListRequest{}
-	mi := &file_base_v1_service_proto_msgTypes[32]
+func (x *SchemaWriteRequest) Reset() {
+	*x = SchemaWriteRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SchemaListRequest) String() string {
+func (x *SchemaWriteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchemaListRequest) ProtoMessage() {}
+func (*SchemaWriteRequest) ProtoMessage() {}
 
-func (x *SchemaListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[32]
+func (x *SchemaWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2134,61 +2559,57 @@ func (x *SchemaListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchemaListRequest.ProtoReflect.Descriptor instead.
-func (*SchemaListRequest) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use SchemaWriteRequest.ProtoReflect.Descriptor instead.
+func (*SchemaWriteRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *SchemaListRequest) GetTenantId() string {
+func (x *SchemaWriteRequest) GetTenantId() string {
 	if x != nil {
 		return x.TenantId
 	}
 	return ""
 }
 
-func (x *SchemaListRequest) GetPageSize() uint32 {
+func (x *SchemaWriteRequest) GetSchema() string {
 	if x != nil {
-		return x.PageSize
+		return x.Schema
 	}
-	return 0
+	return ""
 }
 
-func (x *SchemaListRequest) GetContinuousToken() string {
+func (x *SchemaWriteRequest) GetMetadata() *TransactionMetadata {
 	if x != nil {
-		return x.ContinuousToken
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-// SchemaListResponse is the response message for the List method in the Schema service.
-// It returns a paginated list of schemas
-type SchemaListResponse struct {
+// SchemaWriteResponse is the response message for the Write method in the Schema service.
+// It returns the version of the written schema.
+type SchemaWriteResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// head of the schemas is the latest version available for the tenant
-	Head string `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
-	// list of schema versions with creation timestamps
-	Schemas []*SchemaList `protobuf:"bytes,2,rep,name=schemas,proto3" json:"schemas,omitempty"`
-	// continuous_token is a string that can be used to paginate and retrieve the next set of results.
-	ContinuousToken string `protobuf:"bytes,3,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// schema_version is the string that identifies the version of the written schema.
+	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SchemaListResponse) Reset() {
-	*x = SchemaListResponse{}
-	mi := &file_base_v1_service_proto_msgTypes[33]
+func (x *SchemaWriteResponse) Reset() {
+	*x = SchemaWriteResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SchemaListResponse) String() string {
+func (x *SchemaWriteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchemaListResponse) ProtoMessage() {}
+func (*SchemaWriteResponse) ProtoMessage() {}
 
-func (x *SchemaListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[33]
+func (x *SchemaWriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2199,56 +2620,48 @@ func (x *SchemaListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchemaListResponse.ProtoReflect.Descriptor instead.
-func (*SchemaListResponse) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *SchemaListResponse) GetHead() string {
-	if x != nil {
-		return x.Head
-	}
-	return ""
-}
-
-func (x *SchemaListResponse) GetSchemas() []*SchemaList {
-	if x != nil {
-		return x.Schemas
-	}
-	return nil
+// Deprecated: Use SchemaWriteResponse.ProtoReflect.Descriptor instead.
+func (*SchemaWriteResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *SchemaListResponse) GetContinuousToken() string {
+func (x *SchemaWriteResponse) GetSchemaVersion() string {
 	if x != nil {
-		return x.ContinuousToken
+		return x.SchemaVersion
 	}
 	return ""
 }
 
-// SchemaList provides a list of schema versions with their corresponding creation timestamps
-type SchemaList struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,proto3" json:"created_at,omitempty"`
+// It contains the tenant_id to identify the tenant and metadata of the schema to be edited,
+// with the corresponding edits to various entities
+type SchemaPartialWriteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant_id is a string that identifies the tenant. It must match the pattern "[a-zA-Z0-9-,]+",
+	// be a maximum of 64 bytes, and must not be empty.
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	// metadata is the additional information needed for the Partial Write request.
+	Metadata *SchemaPartialWriteRequestMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Map of entity name with the values needed to be updated
+	Partials      map[string]*Partials `protobuf:"bytes,3,rep,name=partials,proto3" json:"partials,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SchemaList) Reset() {
-	*x = SchemaList{}
-	mi := &file_base_v1_service_proto_msgTypes[34]
+func (x *SchemaPartialWriteRequest) Reset() {
+	*x = SchemaPartialWriteRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SchemaList) String() string {
+func (x *SchemaPartialWriteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchemaList) ProtoMessage() {}
+func (*SchemaPartialWriteRequest) ProtoMessage() {}
 
-func (x *SchemaList) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[34]
+func (x *SchemaPartialWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2259,57 +2672,57 @@ func (x *SchemaList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchemaList.ProtoReflect.Descriptor instead.
-func (*SchemaList) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use SchemaPartialWriteRequest.ProtoReflect.Descriptor instead.
+func (*SchemaPartialWriteRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *SchemaList) GetVersion() string {
+func (x *SchemaPartialWriteRequest) GetTenantId() string {
 	if x != nil {
-		return x.Version
+		return x.TenantId
 	}
 	return ""
 }
 
-func (x *SchemaList) GetCreatedAt() string {
+func (x *SchemaPartialWriteRequest) GetMetadata() *SchemaPartialWriteRequestMetadata {
 	if x != nil {
-		return x.CreatedAt
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-// DataWriteRequest defines the structure of a request for writing data.
-// It contains the necessary information such as tenant_id, metadata,
-// tuples and attributes for the write operation.
-type DataWriteRequest struct {
+func (x *SchemaPartialWriteRequest) GetPartials() map[string]*Partials {
+	if x != nil {
+		return x.Partials
+	}
+	return nil
+}
+
+// SchemaPartialWriteRequestMetadata provides additional information for the Schema Partial Write request.
+// It contains schema_version to specify which version of the schema should be read.
+type SchemaPartialWriteRequestMetadata struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// tenant_id represents the unique identifier of the tenant for which data is written.
-	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
-	// metadata holds additional data related to the request.
-	Metadata *DataWriteRequestMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// tuples contains the list of tuples (entity-relation-entity triples) that need to be written.
-	Tuples []*Tuple `protobuf:"bytes,3,rep,name=tuples,proto3" json:"tuples,omitempty"`
-	// attributes contains the list of attributes (entity-attribute-value triples) that need to be written.
-	Attributes    []*Attribute `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	// schema_version is the string that identifies the version of the schema to be read.
+	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataWriteRequest) Reset() {
-	*x = DataWriteRequest{}
-	mi := &file_base_v1_service_proto_msgTypes[35]
+func (x *SchemaPartialWriteRequestMetadata) Reset() {
+	*x = SchemaPartialWriteRequestMetadata{}
+	mi := &file_base_v1_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataWriteRequest) String() string {
+func (x *SchemaPartialWriteRequestMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataWriteRequest) ProtoMessage() {}
+func (*SchemaPartialWriteRequestMetadata) ProtoMessage() {}
 
-func (x *DataWriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[35]
+func (x *SchemaPartialWriteRequestMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2320,64 +2733,3272 @@ func (x *DataWriteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataWriteRequest.ProtoReflect.Descriptor instead.
-func (*DataWriteRequest) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use SchemaPartialWriteRequestMetadata.ProtoReflect.Descriptor instead.
+func (*SchemaPartialWriteRequestMetadata) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *DataWriteRequest) GetTenantId() string {
+func (x *SchemaPartialWriteRequestMetadata) GetSchemaVersion() string {
 	if x != nil {
-		return x.TenantId
+		return x.SchemaVersion
 	}
 	return ""
 }
 
-func (x *DataWriteRequest) GetMetadata() *DataWriteRequestMetadata {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
-}
-
+// SchemaPartialWriteResponse is the response message for the Parietal Write method in the Schema service.
+// It returns the requested schema.
+type SchemaPartialWriteResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schema_version is the string that identifies the version of the written schema.
+	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaPartialWriteResponse) Reset() {
+	*x = SchemaPartialWriteResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaPartialWriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaPartialWriteResponse) ProtoMessage() {}
+
+func (x *SchemaPartialWriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaPartialWriteResponse.ProtoReflect.Descriptor instead.
+func (*SchemaPartialWriteResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SchemaPartialWriteResponse) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+// SchemaReadRequest is the request message for the Read method in the Schema service.
+// It contains tenant_id and metadata about the schema to be read.
+type SchemaReadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant_id is a string that identifies the tenant. It must match the pattern "[a-zA-Z0-9-,]+",
+	// be a maximum of 64 bytes, and must not be empty.
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	// metadata is the additional information needed for the Read request.
+	Metadata      *SchemaReadRequestMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaReadRequest) Reset() {
+	*x = SchemaReadRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaReadRequest) ProtoMessage() {}
+
+func (x *SchemaReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaReadRequest.ProtoReflect.Descriptor instead.
+func (*SchemaReadRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SchemaReadRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *SchemaReadRequest) GetMetadata() *SchemaReadRequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// SchemaReadRequestMetadata provides additional information for the Schema Read request.
+// It contains schema_version to specify which version of the schema should be read.
+type SchemaReadRequestMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schema_version is the string that identifies the version of the schema to be read.
+	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	// schema_tag is the tag of the schema version to be read, used when schema_version is not set.
+	SchemaTag     string `protobuf:"bytes,2,opt,name=schema_tag,proto3" json:"schema_tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaReadRequestMetadata) Reset() {
+	*x = SchemaReadRequestMetadata{}
+	mi := &file_base_v1_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaReadRequestMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaReadRequestMetadata) ProtoMessage() {}
+
+func (x *SchemaReadRequestMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaReadRequestMetadata.ProtoReflect.Descriptor instead.
+func (*SchemaReadRequestMetadata) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SchemaReadRequestMetadata) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *SchemaReadRequestMetadata) GetSchemaTag() string {
+	if x != nil {
+		return x.SchemaTag
+	}
+	return ""
+}
+
+// SchemaReadResponse is the response message for the Read method in the Schema service.
+// It returns the requested schema.
+type SchemaReadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schema is the SchemaDefinition that represents the read schema.
+	Schema        *SchemaDefinition `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaReadResponse) Reset() {
+	*x = SchemaReadResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaReadResponse) ProtoMessage() {}
+
+func (x *SchemaReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaReadResponse.ProtoReflect.Descriptor instead.
+func (*SchemaReadResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SchemaReadResponse) GetSchema() *SchemaDefinition {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+// SchemaListRequest is the request message for the List method in the Schema service.
+// It contains tenant_id for which the schemas are to be listed.
+type SchemaListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant_id is a string that identifies the tenant. It must match the pattern "[a-zA-Z0-9-,]+",
+	// be a maximum of 64 bytes, and must not be empty.
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	// page_size is the number of schemas to be returned in the response.
+	// The value should be between 1 and 100.
+	PageSize uint32 `protobuf:"varint,2,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	// continuous_token is an optional parameter used for pagination.
+	// It should be the value received in the previous response.
+	ContinuousToken string `protobuf:"bytes,3,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SchemaListRequest) Reset() {
+	*x = SchemaListRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaListRequest) ProtoMessage() {}
+
+func (x *SchemaListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaListRequest.ProtoReflect.Descriptor instead.
+func (*SchemaListRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SchemaListRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *SchemaListRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SchemaListRequest) GetContinuousToken() string {
+	if x != nil {
+		return x.ContinuousToken
+	}
+	return ""
+}
+
+// SchemaListResponse is the response message for the List method in the Schema service.
+// It returns a paginated list of schemas
+type SchemaListResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// head of the schemas is the latest version available for the tenant
+	Head string `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	// list of schema versions with creation timestamps
+	Schemas []*SchemaList `protobuf:"bytes,2,rep,name=schemas,proto3" json:"schemas,omitempty"`
+	// continuous_token is a string that can be used to paginate and retrieve the next set of results.
+	ContinuousToken string `protobuf:"bytes,3,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SchemaListResponse) Reset() {
+	*x = SchemaListResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaListResponse) ProtoMessage() {}
+
+func (x *SchemaListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaListResponse.ProtoReflect.Descriptor instead.
+func (*SchemaListResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SchemaListResponse) GetHead() string {
+	if x != nil {
+		return x.Head
+	}
+	return ""
+}
+
+func (x *SchemaListResponse) GetSchemas() []*SchemaList {
+	if x != nil {
+		return x.Schemas
+	}
+	return nil
+}
+
+func (x *SchemaListResponse) GetContinuousToken() string {
+	if x != nil {
+		return x.ContinuousToken
+	}
+	return ""
+}
+
+// SchemaList provides a list of schema versions with their corresponding creation timestamps
+type SchemaList struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Version   string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt string                 `protobuf:"bytes,2,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	// tags are the tags pointing to the version.
+	Tags []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// active tells whether the version is the one served to the requests that do not select a version.
+	Active bool `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	// metadata is the metadata the version was written with, if any.
+	Metadata      *TransactionMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaList) Reset() {
+	*x = SchemaList{}
+	mi := &file_base_v1_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaList) ProtoMessage() {}
+
+func (x *SchemaList) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaList.ProtoReflect.Descriptor instead.
+func (*SchemaList) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SchemaList) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SchemaList) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SchemaList) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SchemaList) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *SchemaList) GetMetadata() *TransactionMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// SchemaLintRequest is the request message for the Lint method in the Schema service.
+// It contains tenant_id and the schema to be analyzed.
+type SchemaLintRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant_id is a string that identifies the tenant. It must match the pattern "[a-zA-Z0-9-,]+",
+	// be a maximum of 64 bytes, and must not be empty.
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	// schema is the string representation of the schema to be analyzed.
+	// If it is empty, the head schema of the tenant is analyzed, and the findings point into the schema
+	// the head version was written from.
+	Schema        string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaLintRequest) Reset() {
+	*x = SchemaLintRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaLintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaLintRequest) ProtoMessage() {}
+
+func (x *SchemaLintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaLintRequest.ProtoReflect.Descriptor instead.
+func (*SchemaLintRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SchemaLintRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *SchemaLintRequest) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+// SchemaLintResponse is the response message for the Lint method in the Schema service.
+// It returns the findings of the analysis, ordered by their position in the schema.
+type SchemaLintResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// findings is the list of issues found in the schema.
+	Findings      []*SchemaLintFinding `protobuf:"bytes,1,rep,name=findings,proto3" json:"findings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaLintResponse) Reset() {
+	*x = SchemaLintResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaLintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaLintResponse) ProtoMessage() {}
+
+func (x *SchemaLintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaLintResponse.ProtoReflect.Descriptor instead.
+func (*SchemaLintResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SchemaLintResponse) GetFindings() []*SchemaLintFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+// SchemaLintFinding describes an issue found in the schema by the linter.
+type SchemaLintFinding struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rule is the identifier of the lint rule that reported the finding, e.g. "unused-relation".
+	Rule string `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	// severity is the severity of the finding.
+	Severity SchemaLintFinding_Severity `protobuf:"varint,2,opt,name=severity,proto3,enum=base.v1.SchemaLintFinding_Severity" json:"severity,omitempty"`
+	// message describes the finding.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// line is the line of the statement the finding refers to.
+	Line int32 `protobuf:"varint,4,opt,name=line,proto3" json:"line,omitempty"`
+	// column is the column of the statement the finding refers to.
+	Column        int32 `protobuf:"varint,5,opt,name=column,proto3" json:"column,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaLintFinding) Reset() {
+	*x = SchemaLintFinding{}
+	mi := &file_base_v1_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaLintFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaLintFinding) ProtoMessage() {}
+
+func (x *SchemaLintFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaLintFinding.ProtoReflect.Descriptor instead.
+func (*SchemaLintFinding) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SchemaLintFinding) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *SchemaLintFinding) GetSeverity() SchemaLintFinding_Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return SchemaLintFinding_SEVERITY_UNSPECIFIED
+}
+
+func (x *SchemaLintFinding) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SchemaLintFinding) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *SchemaLintFinding) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+// SchemaShadow is the shadow version of a tenant, a schema version that is not served but against which
+// a sample of the live checks are evaluated.
+type SchemaShadow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// version is the schema version of the shadow.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// served_version is the schema version that was served when the shadow was written, which the
+	// decisions of the shadow are compared against.
+	ServedVersion string `protobuf:"bytes,2,opt,name=served_version,proto3" json:"served_version,omitempty"`
+	// sample_rate is the fraction of the checks of the served version that are evaluated against the shadow.
+	SampleRate float64 `protobuf:"fixed64,3,opt,name=sample_rate,proto3" json:"sample_rate,omitempty"`
+	// created_at is the time the shadow was written.
+	CreatedAt     string `protobuf:"bytes,4,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaShadow) Reset() {
+	*x = SchemaShadow{}
+	mi := &file_base_v1_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaShadow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaShadow) ProtoMessage() {}
+
+func (x *SchemaShadow) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaShadow.ProtoReflect.Descriptor instead.
+func (*SchemaShadow) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SchemaShadow) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SchemaShadow) GetServedVersion() string {
+	if x != nil {
+		return x.ServedVersion
+	}
+	return ""
+}
+
+func (x *SchemaShadow) GetSampleRate() float64 {
+	if x != nil {
+		return x.SampleRate
+	}
+	return 0
+}
+
+func (x *SchemaShadow) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// SchemaShadowWriteRequest is the request message for the ShadowWrite method in the Schema service.
+type SchemaShadowWriteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant_id is a string that identifies the tenant. It must match the pattern "[a-zA-Z0-9-,]+",
+	// be a maximum of 64 bytes, and must not be empty.
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	// schema is the string representation of the candidate schema.
+	Schema string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	// sample_rate is the fraction of the checks of the served version that are evaluated against the shadow,
+	// between 0 and 1. All checks are evaluated if it is not set.
+	SampleRate    float64 `protobuf:"fixed64,3,opt,name=sample_rate,proto3" json:"sample_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaShadowWriteRequest) Reset() {
+	*x = SchemaShadowWriteRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaShadowWriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaShadowWriteRequest) ProtoMessage() {}
+
+func (x *SchemaShadowWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaShadowWriteRequest.ProtoReflect.Descriptor instead.
+func (*SchemaShadowWriteRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SchemaShadowWriteRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *SchemaShadowWriteRequest) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *SchemaShadowWriteRequest) GetSampleRate() float64 {
+	if x != nil {
+		return x.SampleRate
+	}
+	return 0
+}
+
+// SchemaShadowWriteResponse is the response message for the ShadowWrite method in the Schema service.
+type SchemaShadowWriteResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// shadow is the written shadow version.
+	Shadow        *SchemaShadow `protobuf:"bytes,1,opt,name=shadow,proto3" json:"shadow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaShadowWriteResponse) Reset() {
+	*x = SchemaShadowWriteResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaShadowWriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaShadowWriteResponse) ProtoMessage() {}
+
+func (x *SchemaShadowWriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaShadowWriteResponse.ProtoReflect.Descriptor instead.
+func (*SchemaShadowWriteResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SchemaShadowWriteResponse) GetShadow() *SchemaShadow {
+	if x != nil {
+		return x.Shadow
+	}
+	return nil
+}
+
+// SchemaShadowReadRequest is the request message for the ShadowRead method in the Schema service.
+type SchemaShadowReadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant_id is a string that identifies the tenant. It must match the pattern "[a-zA-Z0-9-,]+",
+	// be a maximum of 64 bytes, and must not be empty.
+	TenantId      string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaShadowReadRequest) Reset() {
+	*x = SchemaShadowReadRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaShadowReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaShadowReadRequest) ProtoMessage() {}
+
+func (x *SchemaShadowReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaShadowReadRequest.ProtoReflect.Descriptor instead.
+func (*SchemaShadowReadRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SchemaShadowReadRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// SchemaShadowReadResponse is the response message for the ShadowRead method in the Schema service.
+type SchemaShadowReadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// shadow is the shadow version of the tenant.
+	Shadow        *SchemaShadow `protobuf:"bytes,1,opt,name=shadow,proto3" json:"shadow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaShadowReadResponse) Reset() {
+	*x = SchemaShadowReadResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaShadowReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaShadowReadResponse) ProtoMessage() {}
+
+func (x *SchemaShadowReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaShadowReadResponse.ProtoReflect.Descriptor instead.
+func (*SchemaShadowReadResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SchemaShadowReadResponse) GetShadow() *SchemaShadow {
+	if x != nil {
+		return x.Shadow
+	}
+	return nil
+}
+
+// SchemaShadowPromoteRequest is the request message for the ShadowPromote method in the Schema service.
+type SchemaShadowPromoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant_id is a string that identifies the tenant. It must match the pattern "[a-zA-Z0-9-,]+",
+	// be a maximum of 64 bytes, and must not be empty.
+	TenantId      string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaShadowPromoteRequest) Reset() {
+	*x = SchemaShadowPromoteRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaShadowPromoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaShadowPromoteRequest) ProtoMessage() {}
+
+func (x *SchemaShadowPromoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaShadowPromoteRequest.ProtoReflect.Descriptor instead.
+func (*SchemaShadowPromoteRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SchemaShadowPromoteRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// SchemaShadowPromoteResponse is the response message for the ShadowPromote method in the Schema service.
+type SchemaShadowPromoteResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schema_version is the promoted version, now served.
+	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	// previous_version is the version served before the promotion.
+	PreviousVersion string `protobuf:"bytes,2,opt,name=previous_version,proto3" json:"previous_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SchemaShadowPromoteResponse) Reset() {
+	*x = SchemaShadowPromoteResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaShadowPromoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaShadowPromoteResponse) ProtoMessage() {}
+
+func (x *SchemaShadowPromoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaShadowPromoteResponse.ProtoReflect.Descriptor instead.
+func (*SchemaShadowPromoteResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SchemaShadowPromoteResponse) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *SchemaShadowPromoteResponse) GetPreviousVersion() string {
+	if x != nil {
+		return x.PreviousVersion
+	}
+	return ""
+}
+
+// SchemaShadowRollbackRequest is the request message for the ShadowRollback method in the Schema service.
+type SchemaShadowRollbackRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant_id is a string that identifies the tenant. It must match the pattern "[a-zA-Z0-9-,]+",
+	// be a maximum of 64 bytes, and must not be empty.
+	TenantId      string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaShadowRollbackRequest) Reset() {
+	*x = SchemaShadowRollbackRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaShadowRollbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaShadowRollbackRequest) ProtoMessage() {}
+
+func (x *SchemaShadowRollbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaShadowRollbackRequest.ProtoReflect.Descriptor instead.
+func (*SchemaShadowRollbackRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SchemaShadowRollbackRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// SchemaShadowRollbackResponse is the response message for the ShadowRollback method in the Schema service.
+type SchemaShadowRollbackResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schema_version is the discarded shadow version.
+	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaShadowRollbackResponse) Reset() {
+	*x = SchemaShadowRollbackResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaShadowRollbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaShadowRollbackResponse) ProtoMessage() {}
+
+func (x *SchemaShadowRollbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaShadowRollbackResponse.ProtoReflect.Descriptor instead.
+func (*SchemaShadowRollbackResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SchemaShadowRollbackResponse) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+// SchemaTagRequest is the request message for the Tag method in the Schema service.
+type SchemaTagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant_id is a string that identifies the tenant. It must match the pattern "[a-zA-Z0-9-,]+",
+	// be a maximum of 64 bytes, and must not be empty.
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	// schema_version is the version the tag points to.
+	SchemaVersion string `protobuf:"bytes,2,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	// tag is the name of the tag, such as prod or canary.
+	Tag           string `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaTagRequest) Reset() {
+	*x = SchemaTagRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaTagRequest) ProtoMessage() {}
+
+func (x *SchemaTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaTagRequest.ProtoReflect.Descriptor instead.
+func (*SchemaTagRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SchemaTagRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *SchemaTagRequest) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *SchemaTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+// SchemaTagResponse is the response message for the Tag method in the Schema service.
+type SchemaTagResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schema_version is the version the tag points to.
+	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	// previous_version is the version the tag pointed to before, empty if the tag is new.
+	PreviousVersion string `protobuf:"bytes,2,opt,name=previous_version,proto3" json:"previous_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SchemaTagResponse) Reset() {
+	*x = SchemaTagResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaTagResponse) ProtoMessage() {}
+
+func (x *SchemaTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaTagResponse.ProtoReflect.Descriptor instead.
+func (*SchemaTagResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SchemaTagResponse) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *SchemaTagResponse) GetPreviousVersion() string {
+	if x != nil {
+		return x.PreviousVersion
+	}
+	return ""
+}
+
+// SchemaUntagRequest is the request message for the Untag method in the Schema service.
+type SchemaUntagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant_id is a string that identifies the tenant. It must match the pattern "[a-zA-Z0-9-,]+",
+	// be a maximum of 64 bytes, and must not be empty.
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	// tag is the name of the tag to delete.
+	Tag           string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaUntagRequest) Reset() {
+	*x = SchemaUntagRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaUntagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaUntagRequest) ProtoMessage() {}
+
+func (x *SchemaUntagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaUntagRequest.ProtoReflect.Descriptor instead.
+func (*SchemaUntagRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SchemaUntagRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *SchemaUntagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+// SchemaUntagResponse is the response message for the Untag method in the Schema service.
+type SchemaUntagResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schema_version is the version the deleted tag pointed to.
+	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaUntagResponse) Reset() {
+	*x = SchemaUntagResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaUntagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaUntagResponse) ProtoMessage() {}
+
+func (x *SchemaUntagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaUntagResponse.ProtoReflect.Descriptor instead.
+func (*SchemaUntagResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SchemaUntagResponse) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+// SchemaActivateRequest is the request message for the Activate method in the Schema service.
+// Either schema_version or schema_tag must be set.
+type SchemaActivateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant_id is a string that identifies the tenant. It must match the pattern "[a-zA-Z0-9-,]+",
+	// be a maximum of 64 bytes, and must not be empty.
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	// schema_version is the version to activate.
+	SchemaVersion string `protobuf:"bytes,2,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	// schema_tag is the tag whose version is activated, used when schema_version is not set.
+	SchemaTag     string `protobuf:"bytes,3,opt,name=schema_tag,proto3" json:"schema_tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchemaActivateRequest) Reset() {
+	*x = SchemaActivateRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaActivateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaActivateRequest) ProtoMessage() {}
+
+func (x *SchemaActivateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaActivateRequest.ProtoReflect.Descriptor instead.
+func (*SchemaActivateRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SchemaActivateRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *SchemaActivateRequest) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *SchemaActivateRequest) GetSchemaTag() string {
+	if x != nil {
+		return x.SchemaTag
+	}
+	return ""
+}
+
+// SchemaActivateResponse is the response message for the Activate method in the Schema service.
+type SchemaActivateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schema_version is the activated version.
+	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	// previous_version is the version that was active before.
+	PreviousVersion string `protobuf:"bytes,2,opt,name=previous_version,proto3" json:"previous_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SchemaActivateResponse) Reset() {
+	*x = SchemaActivateResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchemaActivateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaActivateResponse) ProtoMessage() {}
+
+func (x *SchemaActivateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaActivateResponse.ProtoReflect.Descriptor instead.
+func (*SchemaActivateResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SchemaActivateResponse) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *SchemaActivateResponse) GetPreviousVersion() string {
+	if x != nil {
+		return x.PreviousVersion
+	}
+	return ""
+}
+
+// DataWriteRequest defines the structure of a request for writing data.
+// It contains the necessary information such as tenant_id, metadata,
+// tuples and attributes for the write operation.
+type DataWriteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant_id represents the unique identifier of the tenant for which data is written.
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	// metadata holds additional data related to the request.
+	Metadata *DataWriteRequestMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// tuples contains the list of tuples (entity-relation-entity triples) that need to be written.
+	Tuples []*Tuple `protobuf:"bytes,3,rep,name=tuples,proto3" json:"tuples,omitempty"`
+	// attributes contains the list of attributes (entity-attribute-value triples) that need to be written.
+	Attributes []*Attribute `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	// preconditions that must hold for the write to be applied, evaluated in its transaction.
+	// The request fails with ERROR_CODE_FAILED_PRECONDITION when one of them does not hold.
+	Preconditions []*Precondition `protobuf:"bytes,5,rep,name=preconditions,proto3" json:"preconditions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataWriteRequest) Reset() {
+	*x = DataWriteRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataWriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataWriteRequest) ProtoMessage() {}
+
+func (x *DataWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataWriteRequest.ProtoReflect.Descriptor instead.
+func (*DataWriteRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DataWriteRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *DataWriteRequest) GetMetadata() *DataWriteRequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 func (x *DataWriteRequest) GetTuples() []*Tuple {
 	if x != nil {
-		return x.Tuples
+		return x.Tuples
+	}
+	return nil
+}
+
+func (x *DataWriteRequest) GetAttributes() []*Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *DataWriteRequest) GetPreconditions() []*Precondition {
+	if x != nil {
+		return x.Preconditions
+	}
+	return nil
+}
+
+// DataWriteRequestMetadata defines the structure of metadata for a write request.
+// It includes the schema version of the data to be written.
+type DataWriteRequestMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schema_version represents the version of the schema for the data being written.
+	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	// idempotency_key makes retries of the write safe. A retry with the same key within the retention window
+	// is not applied again and returns the snap token of the original write.
+	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotency_key,proto3" json:"idempotency_key,omitempty"`
+	// metadata describing who made the write and why, persisted alongside its transaction.
+	Metadata      *TransactionMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataWriteRequestMetadata) Reset() {
+	*x = DataWriteRequestMetadata{}
+	mi := &file_base_v1_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataWriteRequestMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataWriteRequestMetadata) ProtoMessage() {}
+
+func (x *DataWriteRequestMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataWriteRequestMetadata.ProtoReflect.Descriptor instead.
+func (*DataWriteRequestMetadata) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DataWriteRequestMetadata) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *DataWriteRequestMetadata) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *DataWriteRequestMetadata) GetMetadata() *TransactionMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// DataWriteResponse defines the structure of the response after writing data.
+// It contains the snap_token generated after the write operation.
+type DataWriteResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// snap_token is the token generated after the data write operation, representing a snapshot of the data.
+	SnapToken string `protobuf:"bytes,1,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
+	// warnings about the written data, e.g. when a written relation or attribute is deprecated in the schema.
+	Warnings      []string `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataWriteResponse) Reset() {
+	*x = DataWriteResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataWriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataWriteResponse) ProtoMessage() {}
+
+func (x *DataWriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataWriteResponse.ProtoReflect.Descriptor instead.
+func (*DataWriteResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DataWriteResponse) GetSnapToken() string {
+	if x != nil {
+		return x.SnapToken
+	}
+	return ""
+}
+
+func (x *DataWriteResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// DataImportRequest is a single chunk of a bulk import stream.
+// The tenant_id must be the same on every chunk, metadata is read from the first chunk only.
+type DataImportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant_id represents the unique identifier of the tenant the data is imported into.
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	// metadata holds the options of the import, only read from the first chunk.
+	Metadata *DataImportRequestMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// tuples of the chunk. They are validated one by one, so an invalid tuple is rejected without failing the import.
+	Tuples []*Tuple `protobuf:"bytes,3,rep,name=tuples,proto3" json:"tuples,omitempty"`
+	// attributes of the chunk. They are validated one by one, so an invalid attribute is rejected without failing the import.
+	Attributes    []*Attribute `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataImportRequest) Reset() {
+	*x = DataImportRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataImportRequest) ProtoMessage() {}
+
+func (x *DataImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataImportRequest.ProtoReflect.Descriptor instead.
+func (*DataImportRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DataImportRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *DataImportRequest) GetMetadata() *DataImportRequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *DataImportRequest) GetTuples() []*Tuple {
+	if x != nil {
+		return x.Tuples
+	}
+	return nil
+}
+
+func (x *DataImportRequest) GetAttributes() []*Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// DataImportRequestMetadata defines the options of a bulk import.
+type DataImportRequestMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schema_version the rows are validated against, the head version is used when empty.
+	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	// commit_interval is the number of rows committed per transaction.
+	// When zero, the whole import is committed in a single transaction at the end of the stream.
+	CommitInterval uint32 `protobuf:"varint,2,opt,name=commit_interval,proto3" json:"commit_interval,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DataImportRequestMetadata) Reset() {
+	*x = DataImportRequestMetadata{}
+	mi := &file_base_v1_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataImportRequestMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataImportRequestMetadata) ProtoMessage() {}
+
+func (x *DataImportRequestMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataImportRequestMetadata.ProtoReflect.Descriptor instead.
+func (*DataImportRequestMetadata) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DataImportRequestMetadata) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *DataImportRequestMetadata) GetCommitInterval() uint32 {
+	if x != nil {
+		return x.CommitInterval
+	}
+	return 0
+}
+
+// DataImportRejection describes a row that was not imported.
+type DataImportRejection struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// position of the row in the stream, counting the tuples and then the attributes of each chunk.
+	Position uint64 `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	// row is the string form of the rejected tuple or attribute.
+	Row string `protobuf:"bytes,2,opt,name=row,proto3" json:"row,omitempty"`
+	// reason the row was rejected.
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataImportRejection) Reset() {
+	*x = DataImportRejection{}
+	mi := &file_base_v1_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataImportRejection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataImportRejection) ProtoMessage() {}
+
+func (x *DataImportRejection) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataImportRejection.ProtoReflect.Descriptor instead.
+func (*DataImportRejection) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DataImportRejection) GetPosition() uint64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *DataImportRejection) GetRow() string {
+	if x != nil {
+		return x.Row
+	}
+	return ""
+}
+
+func (x *DataImportRejection) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// DataImportResponse summarizes a bulk import.
+type DataImportResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// snap_token of the last committed transaction of the import.
+	SnapToken string `protobuf:"bytes,1,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
+	// tuples is the number of tuples loaded.
+	Tuples uint64 `protobuf:"varint,2,opt,name=tuples,proto3" json:"tuples,omitempty"`
+	// attributes is the number of attributes loaded.
+	Attributes uint64 `protobuf:"varint,3,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// rejected is the number of rows that were not imported.
+	Rejected uint64 `protobuf:"varint,4,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	// rejections lists the rejected rows, capped to the first thousand.
+	Rejections []*DataImportRejection `protobuf:"bytes,5,rep,name=rejections,proto3" json:"rejections,omitempty"`
+	// commits is the number of transactions committed.
+	Commits uint64 `protobuf:"varint,6,opt,name=commits,proto3" json:"commits,omitempty"`
+	// committed_tuples is the number of tuples committed, fewer than the loaded ones when the import failed.
+	CommittedTuples uint64 `protobuf:"varint,7,opt,name=committed_tuples,proto3" json:"committed_tuples,omitempty"`
+	// committed_attributes is the number of attributes committed, fewer than the loaded ones when the import failed.
+	CommittedAttributes uint64 `protobuf:"varint,8,opt,name=committed_attributes,proto3" json:"committed_attributes,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DataImportResponse) Reset() {
+	*x = DataImportResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataImportResponse) ProtoMessage() {}
+
+func (x *DataImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataImportResponse.ProtoReflect.Descriptor instead.
+func (*DataImportResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DataImportResponse) GetSnapToken() string {
+	if x != nil {
+		return x.SnapToken
+	}
+	return ""
+}
+
+func (x *DataImportResponse) GetTuples() uint64 {
+	if x != nil {
+		return x.Tuples
+	}
+	return 0
+}
+
+func (x *DataImportResponse) GetAttributes() uint64 {
+	if x != nil {
+		return x.Attributes
+	}
+	return 0
+}
+
+func (x *DataImportResponse) GetRejected() uint64 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *DataImportResponse) GetRejections() []*DataImportRejection {
+	if x != nil {
+		return x.Rejections
+	}
+	return nil
+}
+
+func (x *DataImportResponse) GetCommits() uint64 {
+	if x != nil {
+		return x.Commits
+	}
+	return 0
+}
+
+func (x *DataImportResponse) GetCommittedTuples() uint64 {
+	if x != nil {
+		return x.CommittedTuples
+	}
+	return 0
+}
+
+func (x *DataImportResponse) GetCommittedAttributes() uint64 {
+	if x != nil {
+		return x.CommittedAttributes
+	}
+	return 0
+}
+
+// Represents a request to write relationship data.
+type RelationshipWriteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier for the tenant with specific constraints.
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	// Metadata for the request. It's required.
+	Metadata *RelationshipWriteRequestMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// List of tuples for the request. Must have between 1 and 100 items.
+	Tuples        []*Tuple `protobuf:"bytes,3,rep,name=tuples,proto3" json:"tuples,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelationshipWriteRequest) Reset() {
+	*x = RelationshipWriteRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelationshipWriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationshipWriteRequest) ProtoMessage() {}
+
+func (x *RelationshipWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationshipWriteRequest.ProtoReflect.Descriptor instead.
+func (*RelationshipWriteRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RelationshipWriteRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *RelationshipWriteRequest) GetMetadata() *RelationshipWriteRequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *RelationshipWriteRequest) GetTuples() []*Tuple {
+	if x != nil {
+		return x.Tuples
+	}
+	return nil
+}
+
+// RelationshipWriteRequestMetadata
+type RelationshipWriteRequestMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SchemaVersion string                 `protobuf:"bytes,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelationshipWriteRequestMetadata) Reset() {
+	*x = RelationshipWriteRequestMetadata{}
+	mi := &file_base_v1_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelationshipWriteRequestMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationshipWriteRequestMetadata) ProtoMessage() {}
+
+func (x *RelationshipWriteRequestMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationshipWriteRequestMetadata.ProtoReflect.Descriptor instead.
+func (*RelationshipWriteRequestMetadata) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *RelationshipWriteRequestMetadata) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+// RelationshipWriteResponse
+type RelationshipWriteResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SnapToken string                 `protobuf:"bytes,1,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
+	// Warnings about the written relationships, e.g. when a written relation is deprecated in the schema.
+	Warnings      []string `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelationshipWriteResponse) Reset() {
+	*x = RelationshipWriteResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelationshipWriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationshipWriteResponse) ProtoMessage() {}
+
+func (x *RelationshipWriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationshipWriteResponse.ProtoReflect.Descriptor instead.
+func (*RelationshipWriteResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RelationshipWriteResponse) GetSnapToken() string {
+	if x != nil {
+		return x.SnapToken
+	}
+	return ""
+}
+
+func (x *RelationshipWriteResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// RelationshipReadRequest defines the structure of a request for reading relationships.
+// It contains the necessary information such as tenant_id, metadata, and filter for the read operation.
+type RelationshipReadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant_id represents the unique identifier of the tenant for which relationships are read.
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	// metadata holds additional data related to the request.
+	Metadata *RelationshipReadRequestMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// filter is used to specify criteria for the data that needs to be read.
+	Filter *TupleFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// page_size specifies the number of results to return in a single page.
+	// If more results are available, a continuous_token is included in the response.
+	PageSize uint32 `protobuf:"varint,4,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	// continuous_token is used in case of paginated reads to get the next page of results.
+	ContinuousToken string `protobuf:"bytes,5,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RelationshipReadRequest) Reset() {
+	*x = RelationshipReadRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelationshipReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationshipReadRequest) ProtoMessage() {}
+
+func (x *RelationshipReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationshipReadRequest.ProtoReflect.Descriptor instead.
+func (*RelationshipReadRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RelationshipReadRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *RelationshipReadRequest) GetMetadata() *RelationshipReadRequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *RelationshipReadRequest) GetFilter() *TupleFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *RelationshipReadRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *RelationshipReadRequest) GetContinuousToken() string {
+	if x != nil {
+		return x.ContinuousToken
+	}
+	return ""
+}
+
+// RelationshipReadRequestMetadata defines the structure of the metadata for a read request focused on relationships.
+// It includes the snap_token associated with a particular state of the database.
+type RelationshipReadRequestMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// snap_token represents a specific state or "snapshot" of the database.
+	SnapToken string `protobuf:"bytes,1,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
+	// Time to read the data at, resolved to the last snapshot committed at or before it. Used when snap_token is not set,
+	// it must be within the garbage collection window.
+	AtTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at_time,proto3" json:"at_time,omitempty"`
+	// Consistency of the snapshot the read is answered at. It takes precedence over snap_token and at_time when set.
+	Consistency   *Consistency `protobuf:"bytes,3,opt,name=consistency,proto3" json:"consistency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelationshipReadRequestMetadata) Reset() {
+	*x = RelationshipReadRequestMetadata{}
+	mi := &file_base_v1_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelationshipReadRequestMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationshipReadRequestMetadata) ProtoMessage() {}
+
+func (x *RelationshipReadRequestMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationshipReadRequestMetadata.ProtoReflect.Descriptor instead.
+func (*RelationshipReadRequestMetadata) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *RelationshipReadRequestMetadata) GetSnapToken() string {
+	if x != nil {
+		return x.SnapToken
+	}
+	return ""
+}
+
+func (x *RelationshipReadRequestMetadata) GetAtTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AtTime
+	}
+	return nil
+}
+
+func (x *RelationshipReadRequestMetadata) GetConsistency() *Consistency {
+	if x != nil {
+		return x.Consistency
+	}
+	return nil
+}
+
+// RelationshipReadResponse defines the structure of the response after reading relationships.
+// It includes the tuples representing the relationships and a continuous token for handling result pagination.
+type RelationshipReadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tuples is a list of the relationships retrieved in the read operation, represented as entity-relation-entity triples.
+	Tuples []*Tuple `protobuf:"bytes,1,rep,name=tuples,proto3" json:"tuples,omitempty"`
+	// continuous_token is used in the case of paginated reads to retrieve the next page of results.
+	ContinuousToken string `protobuf:"bytes,2,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RelationshipReadResponse) Reset() {
+	*x = RelationshipReadResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelationshipReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationshipReadResponse) ProtoMessage() {}
+
+func (x *RelationshipReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationshipReadResponse.ProtoReflect.Descriptor instead.
+func (*RelationshipReadResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RelationshipReadResponse) GetTuples() []*Tuple {
+	if x != nil {
+		return x.Tuples
+	}
+	return nil
+}
+
+func (x *RelationshipReadResponse) GetContinuousToken() string {
+	if x != nil {
+		return x.ContinuousToken
+	}
+	return ""
+}
+
+// AttributeReadRequest defines the structure of a request for reading attributes.
+// It includes the tenant_id, metadata, attribute filter, page size for pagination, and a continuous token for multi-page results.
+type AttributeReadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant_id represents the unique identifier of the tenant from which the attributes are being read.
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	// metadata holds additional information related to the request.
+	Metadata *AttributeReadRequestMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// filter specifies the criteria used to select the attributes that should be returned.
+	Filter *AttributeFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// page_size specifies the number of results to return in a single page.
+	// If more results are available, a continuous_token is included in the response.
+	PageSize uint32 `protobuf:"varint,4,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	// continuous_token is used in case of paginated reads to get the next page of results.
+	ContinuousToken string `protobuf:"bytes,5,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AttributeReadRequest) Reset() {
+	*x = AttributeReadRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeReadRequest) ProtoMessage() {}
+
+func (x *AttributeReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeReadRequest.ProtoReflect.Descriptor instead.
+func (*AttributeReadRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *AttributeReadRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *AttributeReadRequest) GetMetadata() *AttributeReadRequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *AttributeReadRequest) GetFilter() *AttributeFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *AttributeReadRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *AttributeReadRequest) GetContinuousToken() string {
+	if x != nil {
+		return x.ContinuousToken
+	}
+	return ""
+}
+
+// AttributeReadRequestMetadata defines the structure for the metadata of an attribute read request.
+// It includes the snap_token associated with a particular state of the database.
+type AttributeReadRequestMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// snap_token represents a specific state or "snapshot" of the database.
+	SnapToken string `protobuf:"bytes,1,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
+	// Consistency of the snapshot the read is answered at. It takes precedence over snap_token when set.
+	Consistency   *Consistency `protobuf:"bytes,2,opt,name=consistency,proto3" json:"consistency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeReadRequestMetadata) Reset() {
+	*x = AttributeReadRequestMetadata{}
+	mi := &file_base_v1_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeReadRequestMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeReadRequestMetadata) ProtoMessage() {}
+
+func (x *AttributeReadRequestMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeReadRequestMetadata.ProtoReflect.Descriptor instead.
+func (*AttributeReadRequestMetadata) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AttributeReadRequestMetadata) GetSnapToken() string {
+	if x != nil {
+		return x.SnapToken
+	}
+	return ""
+}
+
+func (x *AttributeReadRequestMetadata) GetConsistency() *Consistency {
+	if x != nil {
+		return x.Consistency
+	}
+	return nil
+}
+
+// AttributeReadResponse defines the structure of the response to an attribute read request.
+// It includes the attributes retrieved and a continuous token for handling result pagination.
+type AttributeReadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// attributes is a list of the attributes retrieved in the read operation.
+	Attributes []*Attribute `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	// continuous_token is used in the case of paginated reads to retrieve the next page of results.
+	ContinuousToken string `protobuf:"bytes,2,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AttributeReadResponse) Reset() {
+	*x = AttributeReadResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeReadResponse) ProtoMessage() {}
+
+func (x *AttributeReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeReadResponse.ProtoReflect.Descriptor instead.
+func (*AttributeReadResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AttributeReadResponse) GetAttributes() []*Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *AttributeReadResponse) GetContinuousToken() string {
+	if x != nil {
+		return x.ContinuousToken
+	}
+	return ""
+}
+
+// DataReadHistoryRequest defines the structure of a request for the history of tuples or attributes.
+type DataReadHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant_id represents the unique identifier of the tenant whose history is read.
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	// filter selects the tuples or the attributes whose history is read.
+	//
+	// Types that are valid to be assigned to Filter:
+	//
+	//	*DataReadHistoryRequest_TupleFilter
+	//	*DataReadHistoryRequest_AttributeFilter
+	Filter isDataReadHistoryRequest_Filter `protobuf_oneof:"filter"`
+	// page_size specifies the number of versions to return in a single page.
+	// If more versions are available, a continuous_token is included in the response.
+	PageSize uint32 `protobuf:"varint,4,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	// continuous_token is used in case of paginated reads to get the next page of results.
+	ContinuousToken string `protobuf:"bytes,5,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DataReadHistoryRequest) Reset() {
+	*x = DataReadHistoryRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataReadHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataReadHistoryRequest) ProtoMessage() {}
+
+func (x *DataReadHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataReadHistoryRequest.ProtoReflect.Descriptor instead.
+func (*DataReadHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DataReadHistoryRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *DataReadHistoryRequest) GetFilter() isDataReadHistoryRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *DataReadHistoryRequest) GetTupleFilter() *TupleFilter {
+	if x != nil {
+		if x, ok := x.Filter.(*DataReadHistoryRequest_TupleFilter); ok {
+			return x.TupleFilter
+		}
+	}
+	return nil
+}
+
+func (x *DataReadHistoryRequest) GetAttributeFilter() *AttributeFilter {
+	if x != nil {
+		if x, ok := x.Filter.(*DataReadHistoryRequest_AttributeFilter); ok {
+			return x.AttributeFilter
+		}
+	}
+	return nil
+}
+
+func (x *DataReadHistoryRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *DataReadHistoryRequest) GetContinuousToken() string {
+	if x != nil {
+		return x.ContinuousToken
+	}
+	return ""
+}
+
+type isDataReadHistoryRequest_Filter interface {
+	isDataReadHistoryRequest_Filter()
+}
+
+type DataReadHistoryRequest_TupleFilter struct {
+	// tuple_filter selects the tuples whose history is read.
+	TupleFilter *TupleFilter `protobuf:"bytes,2,opt,name=tuple_filter,proto3,oneof"`
+}
+
+type DataReadHistoryRequest_AttributeFilter struct {
+	// attribute_filter selects the attributes whose history is read.
+	AttributeFilter *AttributeFilter `protobuf:"bytes,3,opt,name=attribute_filter,proto3,oneof"`
+}
+
+func (*DataReadHistoryRequest_TupleFilter) isDataReadHistoryRequest_Filter() {}
+
+func (*DataReadHistoryRequest_AttributeFilter) isDataReadHistoryRequest_Filter() {}
+
+// DataHistoryRecord is a version of a tuple or an attribute.
+type DataHistoryRecord struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The tuple or the attribute, with its value.
+	//
+	// Types that are valid to be assigned to Item:
+	//
+	//	*DataHistoryRecord_Tuple
+	//	*DataHistoryRecord_Attribute
+	Item isDataHistoryRecord_Item `protobuf_oneof:"item"`
+	// Transaction the version was written in.
+	CreatedTxId uint64 `protobuf:"varint,3,opt,name=created_tx_id,proto3" json:"created_tx_id,omitempty"`
+	// Time the version was written at, unset once the transaction itself was garbage collected.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	// Transaction the version was superseded or deleted in, zero while it is active.
+	ExpiredTxId uint64 `protobuf:"varint,5,opt,name=expired_tx_id,proto3" json:"expired_tx_id,omitempty"`
+	// Time the version was superseded or deleted at, unset while it is active.
+	ExpiredAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expired_at,proto3" json:"expired_at,omitempty"`
+	// Metadata of the transaction the version was written in, if any.
+	CreatedMetadata *TransactionMetadata `protobuf:"bytes,7,opt,name=created_metadata,proto3" json:"created_metadata,omitempty"`
+	// Metadata of the transaction the version was superseded or deleted in, if any.
+	ExpiredMetadata *TransactionMetadata `protobuf:"bytes,8,opt,name=expired_metadata,proto3" json:"expired_metadata,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DataHistoryRecord) Reset() {
+	*x = DataHistoryRecord{}
+	mi := &file_base_v1_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataHistoryRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataHistoryRecord) ProtoMessage() {}
+
+func (x *DataHistoryRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataHistoryRecord.ProtoReflect.Descriptor instead.
+func (*DataHistoryRecord) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DataHistoryRecord) GetItem() isDataHistoryRecord_Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *DataHistoryRecord) GetTuple() *Tuple {
+	if x != nil {
+		if x, ok := x.Item.(*DataHistoryRecord_Tuple); ok {
+			return x.Tuple
+		}
+	}
+	return nil
+}
+
+func (x *DataHistoryRecord) GetAttribute() *Attribute {
+	if x != nil {
+		if x, ok := x.Item.(*DataHistoryRecord_Attribute); ok {
+			return x.Attribute
+		}
+	}
+	return nil
+}
+
+func (x *DataHistoryRecord) GetCreatedTxId() uint64 {
+	if x != nil {
+		return x.CreatedTxId
+	}
+	return 0
+}
+
+func (x *DataHistoryRecord) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DataHistoryRecord) GetExpiredTxId() uint64 {
+	if x != nil {
+		return x.ExpiredTxId
+	}
+	return 0
+}
+
+func (x *DataHistoryRecord) GetExpiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return nil
+}
+
+func (x *DataHistoryRecord) GetCreatedMetadata() *TransactionMetadata {
+	if x != nil {
+		return x.CreatedMetadata
+	}
+	return nil
+}
+
+func (x *DataHistoryRecord) GetExpiredMetadata() *TransactionMetadata {
+	if x != nil {
+		return x.ExpiredMetadata
+	}
+	return nil
+}
+
+type isDataHistoryRecord_Item interface {
+	isDataHistoryRecord_Item()
+}
+
+type DataHistoryRecord_Tuple struct {
+	Tuple *Tuple `protobuf:"bytes,1,opt,name=tuple,proto3,oneof"`
+}
+
+type DataHistoryRecord_Attribute struct {
+	Attribute *Attribute `protobuf:"bytes,2,opt,name=attribute,proto3,oneof"`
+}
+
+func (*DataHistoryRecord_Tuple) isDataHistoryRecord_Item() {}
+
+func (*DataHistoryRecord_Attribute) isDataHistoryRecord_Item() {}
+
+// DataReadHistoryResponse defines the structure of the response to a history read.
+type DataReadHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// records are the versions read, in the order they were written.
+	Records []*DataHistoryRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	// continuous_token is used in the case of paginated reads to retrieve the next page of results.
+	ContinuousToken string `protobuf:"bytes,2,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DataReadHistoryResponse) Reset() {
+	*x = DataReadHistoryResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataReadHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataReadHistoryResponse) ProtoMessage() {}
+
+func (x *DataReadHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataReadHistoryResponse.ProtoReflect.Descriptor instead.
+func (*DataReadHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DataReadHistoryResponse) GetRecords() []*DataHistoryRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *DataReadHistoryResponse) GetContinuousToken() string {
+	if x != nil {
+		return x.ContinuousToken
+	}
+	return ""
+}
+
+// DataVerifyRequest defines the structure of a request to verify the data of a tenant against its schema.
+type DataVerifyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant_id represents the unique identifier of the tenant whose data is verified.
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	// metadata holds the schema version and snapshot the data is verified at.
+	Metadata *DataVerifyRequestMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// page_size is the number of tuples or attributes read and validated in a single batch.
+	PageSize uint32 `protobuf:"varint,3,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	// action is what is done with the violating tuples and attributes.
+	Action DataVerifyAction `protobuf:"varint,4,opt,name=action,proto3,enum=base.v1.DataVerifyAction" json:"action,omitempty"`
+	// quarantine_tenant_id is the tenant the violating tuples and attributes are moved to, required by the quarantine action.
+	QuarantineTenantId string `protobuf:"bytes,5,opt,name=quarantine_tenant_id,proto3" json:"quarantine_tenant_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DataVerifyRequest) Reset() {
+	*x = DataVerifyRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataVerifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataVerifyRequest) ProtoMessage() {}
+
+func (x *DataVerifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataVerifyRequest.ProtoReflect.Descriptor instead.
+func (*DataVerifyRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *DataVerifyRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *DataVerifyRequest) GetMetadata() *DataVerifyRequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *DataVerifyRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *DataVerifyRequest) GetAction() DataVerifyAction {
+	if x != nil {
+		return x.Action
+	}
+	return DataVerifyAction_DATA_VERIFY_ACTION_REPORT
+}
+
+func (x *DataVerifyRequest) GetQuarantineTenantId() string {
+	if x != nil {
+		return x.QuarantineTenantId
+	}
+	return ""
+}
+
+// DataVerifyRequestMetadata defines the metadata of a verification.
+type DataVerifyRequestMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schema_version is the version of the schema the data is verified against, the head version if empty.
+	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	// snap_token is the snapshot the data is read at, the head snapshot if empty.
+	SnapToken string `protobuf:"bytes,2,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
+	// metadata describing who removed the violations and why, persisted alongside the removal transactions.
+	Metadata      *TransactionMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataVerifyRequestMetadata) Reset() {
+	*x = DataVerifyRequestMetadata{}
+	mi := &file_base_v1_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataVerifyRequestMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataVerifyRequestMetadata) ProtoMessage() {}
+
+func (x *DataVerifyRequestMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataVerifyRequestMetadata.ProtoReflect.Descriptor instead.
+func (*DataVerifyRequestMetadata) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DataVerifyRequestMetadata) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *DataVerifyRequestMetadata) GetSnapToken() string {
+	if x != nil {
+		return x.SnapToken
+	}
+	return ""
+}
+
+func (x *DataVerifyRequestMetadata) GetMetadata() *TransactionMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// DataViolation is a tuple or an attribute that violates the schema.
+type DataViolation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The violating tuple or attribute.
+	//
+	// Types that are valid to be assigned to Item:
+	//
+	//	*DataViolation_Tuple
+	//	*DataViolation_Attribute
+	Item isDataViolation_Item `protobuf_oneof:"item"`
+	// reason the tuple or the attribute violates the schema.
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataViolation) Reset() {
+	*x = DataViolation{}
+	mi := &file_base_v1_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataViolation) ProtoMessage() {}
+
+func (x *DataViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataViolation.ProtoReflect.Descriptor instead.
+func (*DataViolation) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DataViolation) GetItem() isDataViolation_Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *DataViolation) GetTuple() *Tuple {
+	if x != nil {
+		if x, ok := x.Item.(*DataViolation_Tuple); ok {
+			return x.Tuple
+		}
+	}
+	return nil
+}
+
+func (x *DataViolation) GetAttribute() *Attribute {
+	if x != nil {
+		if x, ok := x.Item.(*DataViolation_Attribute); ok {
+			return x.Attribute
+		}
+	}
+	return nil
+}
+
+func (x *DataViolation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type isDataViolation_Item interface {
+	isDataViolation_Item()
+}
+
+type DataViolation_Tuple struct {
+	Tuple *Tuple `protobuf:"bytes,1,opt,name=tuple,proto3,oneof"`
+}
+
+type DataViolation_Attribute struct {
+	Attribute *Attribute `protobuf:"bytes,2,opt,name=attribute,proto3,oneof"`
+}
+
+func (*DataViolation_Tuple) isDataViolation_Item() {}
+
+func (*DataViolation_Attribute) isDataViolation_Item() {}
+
+// DataVerifySummary summarizes a verification.
+type DataVerifySummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// relationships is the number of tuples verified.
+	Relationships uint64 `protobuf:"varint,1,opt,name=relationships,proto3" json:"relationships,omitempty"`
+	// attributes is the number of attributes verified.
+	Attributes uint64 `protobuf:"varint,2,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// violations is the number of tuples and attributes that violate the schema.
+	Violations uint64 `protobuf:"varint,3,opt,name=violations,proto3" json:"violations,omitempty"`
+	// removed is the number of violating tuples and attributes deleted or quarantined.
+	Removed uint64 `protobuf:"varint,4,opt,name=removed,proto3" json:"removed,omitempty"`
+	// schema_version is the version of the schema the data was verified against.
+	SchemaVersion string `protobuf:"bytes,5,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	// snap_token of the last removal transaction, or of the snapshot the data was read at if nothing was removed.
+	SnapToken     string `protobuf:"bytes,6,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataVerifySummary) Reset() {
+	*x = DataVerifySummary{}
+	mi := &file_base_v1_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataVerifySummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataVerifySummary) ProtoMessage() {}
+
+func (x *DataVerifySummary) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataVerifySummary.ProtoReflect.Descriptor instead.
+func (*DataVerifySummary) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *DataVerifySummary) GetRelationships() uint64 {
+	if x != nil {
+		return x.Relationships
+	}
+	return 0
+}
+
+func (x *DataVerifySummary) GetAttributes() uint64 {
+	if x != nil {
+		return x.Attributes
+	}
+	return 0
+}
+
+func (x *DataVerifySummary) GetViolations() uint64 {
+	if x != nil {
+		return x.Violations
+	}
+	return 0
+}
+
+func (x *DataVerifySummary) GetRemoved() uint64 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+func (x *DataVerifySummary) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *DataVerifySummary) GetSnapToken() string {
+	if x != nil {
+		return x.SnapToken
+	}
+	return ""
+}
+
+// DataVerifyResponse is a message of the verification stream: a violation as it is found, or the summary at the end.
+type DataVerifyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*DataVerifyResponse_Violation
+	//	*DataVerifyResponse_Summary
+	Result        isDataVerifyResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataVerifyResponse) Reset() {
+	*x = DataVerifyResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataVerifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataVerifyResponse) ProtoMessage() {}
+
+func (x *DataVerifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataVerifyResponse.ProtoReflect.Descriptor instead.
+func (*DataVerifyResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *DataVerifyResponse) GetResult() isDataVerifyResponse_Result {
+	if x != nil {
+		return x.Result
 	}
 	return nil
 }
 
-func (x *DataWriteRequest) GetAttributes() []*Attribute {
+func (x *DataVerifyResponse) GetViolation() *DataViolation {
 	if x != nil {
-		return x.Attributes
+		if x, ok := x.Result.(*DataVerifyResponse_Violation); ok {
+			return x.Violation
+		}
 	}
 	return nil
 }
 
-// DataWriteRequestMetadata defines the structure of metadata for a write request.
-// It includes the schema version of the data to be written.
-type DataWriteRequestMetadata struct {
+func (x *DataVerifyResponse) GetSummary() *DataVerifySummary {
+	if x != nil {
+		if x, ok := x.Result.(*DataVerifyResponse_Summary); ok {
+			return x.Summary
+		}
+	}
+	return nil
+}
+
+type isDataVerifyResponse_Result interface {
+	isDataVerifyResponse_Result()
+}
+
+type DataVerifyResponse_Violation struct {
+	Violation *DataViolation `protobuf:"bytes,1,opt,name=violation,proto3,oneof"`
+}
+
+type DataVerifyResponse_Summary struct {
+	Summary *DataVerifySummary `protobuf:"bytes,2,opt,name=summary,proto3,oneof"`
+}
+
+func (*DataVerifyResponse_Violation) isDataVerifyResponse_Result() {}
+
+func (*DataVerifyResponse_Summary) isDataVerifyResponse_Result() {}
+
+// DataAnalyzeRequest defines the structure of a request to analyze the relationships of a tenant.
+type DataAnalyzeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// schema_version represents the version of the schema for the data being written.
-	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	// tenant_id represents the unique identifier of the tenant whose relationships are analyzed.
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	// metadata holds the schema version and snapshot the relationships are analyzed at.
+	Metadata *DataAnalyzeRequestMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// page_size is the number of tuples read in a single batch.
+	PageSize uint32 `protobuf:"varint,3,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	// top is the number of entities and subjects listed by fan-out and by fan-in.
+	Top           uint32 `protobuf:"varint,4,opt,name=top,proto3" json:"top,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataWriteRequestMetadata) Reset() {
-	*x = DataWriteRequestMetadata{}
-	mi := &file_base_v1_service_proto_msgTypes[36]
+func (x *DataAnalyzeRequest) Reset() {
+	*x = DataAnalyzeRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataWriteRequestMetadata) String() string {
+func (x *DataAnalyzeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataWriteRequestMetadata) ProtoMessage() {}
+func (*DataAnalyzeRequest) ProtoMessage() {}
 
-func (x *DataWriteRequestMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[36]
+func (x *DataAnalyzeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2388,43 +6009,65 @@ func (x *DataWriteRequestMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataWriteRequestMetadata.ProtoReflect.Descriptor instead.
-func (*DataWriteRequestMetadata) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use DataAnalyzeRequest.ProtoReflect.Descriptor instead.
+func (*DataAnalyzeRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *DataWriteRequestMetadata) GetSchemaVersion() string {
+func (x *DataAnalyzeRequest) GetTenantId() string {
 	if x != nil {
-		return x.SchemaVersion
+		return x.TenantId
 	}
 	return ""
 }
 
-// DataWriteResponse defines the structure of the response after writing data.
-// It contains the snap_token generated after the write operation.
-type DataWriteResponse struct {
+func (x *DataAnalyzeRequest) GetMetadata() *DataAnalyzeRequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *DataAnalyzeRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *DataAnalyzeRequest) GetTop() uint32 {
+	if x != nil {
+		return x.Top
+	}
+	return 0
+}
+
+// DataAnalyzeRequestMetadata defines the metadata of an analysis.
+type DataAnalyzeRequestMetadata struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// snap_token is the token generated after the data write operation, representing a snapshot of the data.
-	SnapToken     string `protobuf:"bytes,1,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
+	// schema_version is the version of the schema the recursive relations are read from, the head version if empty.
+	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	// snap_token is the snapshot the tuples are read at, the head snapshot if empty.
+	SnapToken     string `protobuf:"bytes,2,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataWriteResponse) Reset() {
-	*x = DataWriteResponse{}
-	mi := &file_base_v1_service_proto_msgTypes[37]
+func (x *DataAnalyzeRequestMetadata) Reset() {
+	*x = DataAnalyzeRequestMetadata{}
+	mi := &file_base_v1_service_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataWriteResponse) String() string {
+func (x *DataAnalyzeRequestMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataWriteResponse) ProtoMessage() {}
+func (*DataAnalyzeRequestMetadata) ProtoMessage() {}
 
-func (x *DataWriteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[37]
+func (x *DataAnalyzeRequestMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2435,46 +6078,49 @@ func (x *DataWriteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataWriteResponse.ProtoReflect.Descriptor instead.
-func (*DataWriteResponse) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use DataAnalyzeRequestMetadata.ProtoReflect.Descriptor instead.
+func (*DataAnalyzeRequestMetadata) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *DataWriteResponse) GetSnapToken() string {
+func (x *DataAnalyzeRequestMetadata) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *DataAnalyzeRequestMetadata) GetSnapToken() string {
 	if x != nil {
 		return x.SnapToken
 	}
 	return ""
 }
 
-// Represents a request to write relationship data.
-type RelationshipWriteRequest struct {
+// DataCycle is a cycle formed by the tuples of a recursive relation.
+type DataCycle struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique identifier for the tenant with specific constraints.
-	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
-	// Metadata for the request. It's required.
-	Metadata *RelationshipWriteRequestMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// List of tuples for the request. Must have between 1 and 100 items.
-	Tuples        []*Tuple `protobuf:"bytes,3,rep,name=tuples,proto3" json:"tuples,omitempty"`
+	// entity_ids lists the entities of the cycle in order, each one related to the next and the last one to the first.
+	EntityIds     []string `protobuf:"bytes,1,rep,name=entity_ids,proto3" json:"entity_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RelationshipWriteRequest) Reset() {
-	*x = RelationshipWriteRequest{}
-	mi := &file_base_v1_service_proto_msgTypes[38]
+func (x *DataCycle) Reset() {
+	*x = DataCycle{}
+	mi := &file_base_v1_service_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RelationshipWriteRequest) String() string {
+func (x *DataCycle) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelationshipWriteRequest) ProtoMessage() {}
+func (*DataCycle) ProtoMessage() {}
 
-func (x *RelationshipWriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[38]
+func (x *DataCycle) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2485,55 +6131,157 @@ func (x *RelationshipWriteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelationshipWriteRequest.ProtoReflect.Descriptor instead.
-func (*RelationshipWriteRequest) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use DataCycle.ProtoReflect.Descriptor instead.
+func (*DataCycle) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *RelationshipWriteRequest) GetTenantId() string {
+func (x *DataCycle) GetEntityIds() []string {
 	if x != nil {
-		return x.TenantId
+		return x.EntityIds
+	}
+	return nil
+}
+
+// DataRecursiveRelation is the analysis of the tuples of a relation through which a permission refers to itself,
+// such as parent in view = parent.view, or whose subjects include its own userset, such as member in
+// relation member @user @group#member.
+type DataRecursiveRelation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// entity_type is the entity type of the relation, which also is the type of its subjects.
+	EntityType string `protobuf:"bytes,1,opt,name=entity_type,proto3" json:"entity_type,omitempty"`
+	// relation is the name of the relation.
+	Relation string `protobuf:"bytes,2,opt,name=relation,proto3" json:"relation,omitempty"`
+	// permissions lists the permissions that refer to themselves through the relation.
+	Permissions []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// tuples is the number of tuples of the relation whose subject is of the entity type.
+	Tuples uint64 `protobuf:"varint,4,opt,name=tuples,proto3" json:"tuples,omitempty"`
+	// max_depth is the number of tuples of the longest chain, not counting the tuples closing cycles.
+	MaxDepth uint32 `protobuf:"varint,5,opt,name=max_depth,proto3" json:"max_depth,omitempty"`
+	// deepest_entity_id is the entity the longest chain starts from.
+	DeepestEntityId string `protobuf:"bytes,6,opt,name=deepest_entity_id,proto3" json:"deepest_entity_id,omitempty"`
+	// cycle_count is the number of cycles found.
+	CycleCount uint64 `protobuf:"varint,7,opt,name=cycle_count,proto3" json:"cycle_count,omitempty"`
+	// cycles lists the cycles found, capped to the first hundred.
+	Cycles        []*DataCycle `protobuf:"bytes,8,rep,name=cycles,proto3" json:"cycles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataRecursiveRelation) Reset() {
+	*x = DataRecursiveRelation{}
+	mi := &file_base_v1_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataRecursiveRelation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataRecursiveRelation) ProtoMessage() {}
+
+func (x *DataRecursiveRelation) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataRecursiveRelation.ProtoReflect.Descriptor instead.
+func (*DataRecursiveRelation) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DataRecursiveRelation) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
 	}
 	return ""
 }
 
-func (x *RelationshipWriteRequest) GetMetadata() *RelationshipWriteRequestMetadata {
+func (x *DataRecursiveRelation) GetRelation() string {
 	if x != nil {
-		return x.Metadata
+		return x.Relation
+	}
+	return ""
+}
+
+func (x *DataRecursiveRelation) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
 	}
 	return nil
 }
 
-func (x *RelationshipWriteRequest) GetTuples() []*Tuple {
+func (x *DataRecursiveRelation) GetTuples() uint64 {
 	if x != nil {
 		return x.Tuples
 	}
+	return 0
+}
+
+func (x *DataRecursiveRelation) GetMaxDepth() uint32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *DataRecursiveRelation) GetDeepestEntityId() string {
+	if x != nil {
+		return x.DeepestEntityId
+	}
+	return ""
+}
+
+func (x *DataRecursiveRelation) GetCycleCount() uint64 {
+	if x != nil {
+		return x.CycleCount
+	}
+	return 0
+}
+
+func (x *DataRecursiveRelation) GetCycles() []*DataCycle {
+	if x != nil {
+		return x.Cycles
+	}
 	return nil
 }
 
-// RelationshipWriteRequestMetadata
-type RelationshipWriteRequestMetadata struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SchemaVersion string                 `protobuf:"bytes,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+// DataHotspot is an entity or a subject with many tuples.
+type DataHotspot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// entity is the entity of the tuples for fan-out, or their subject for fan-in.
+	Entity *Entity `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	// relation is the relation of the tuples for fan-out, or the relation of their subject for fan-in.
+	Relation string `protobuf:"bytes,2,opt,name=relation,proto3" json:"relation,omitempty"`
+	// count is the number of tuples.
+	Count         uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RelationshipWriteRequestMetadata) Reset() {
-	*x = RelationshipWriteRequestMetadata{}
-	mi := &file_base_v1_service_proto_msgTypes[39]
+func (x *DataHotspot) Reset() {
+	*x = DataHotspot{}
+	mi := &file_base_v1_service_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RelationshipWriteRequestMetadata) String() string {
+func (x *DataHotspot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelationshipWriteRequestMetadata) ProtoMessage() {}
+func (*DataHotspot) ProtoMessage() {}
 
-func (x *RelationshipWriteRequestMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[39]
+func (x *DataHotspot) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2544,41 +6292,66 @@ func (x *RelationshipWriteRequestMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelationshipWriteRequestMetadata.ProtoReflect.Descriptor instead.
-func (*RelationshipWriteRequestMetadata) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use DataHotspot.ProtoReflect.Descriptor instead.
+func (*DataHotspot) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *RelationshipWriteRequestMetadata) GetSchemaVersion() string {
+func (x *DataHotspot) GetEntity() *Entity {
 	if x != nil {
-		return x.SchemaVersion
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *DataHotspot) GetRelation() string {
+	if x != nil {
+		return x.Relation
 	}
 	return ""
 }
 
-// RelationshipWriteResponse
-type RelationshipWriteResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SnapToken     string                 `protobuf:"bytes,1,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
+func (x *DataHotspot) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// DataAnalyzeResponse is the analysis of the relationships of a tenant.
+type DataAnalyzeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schema_version is the version of the schema the recursive relations were read from.
+	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	// snap_token is the snapshot the tuples were read at.
+	SnapToken string `protobuf:"bytes,2,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
+	// relationships is the number of tuples analyzed.
+	Relationships uint64 `protobuf:"varint,3,opt,name=relationships,proto3" json:"relationships,omitempty"`
+	// recursive_relations lists the analysis of every recursive relation of the schema.
+	RecursiveRelations []*DataRecursiveRelation `protobuf:"bytes,4,rep,name=recursive_relations,proto3" json:"recursive_relations,omitempty"`
+	// fan_out lists the entities with the most tuples of a relation, most first.
+	FanOut []*DataHotspot `protobuf:"bytes,5,rep,name=fan_out,proto3" json:"fan_out,omitempty"`
+	// fan_in lists the subjects of the most tuples, most first.
+	FanIn         []*DataHotspot `protobuf:"bytes,6,rep,name=fan_in,proto3" json:"fan_in,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RelationshipWriteResponse) Reset() {
-	*x = RelationshipWriteResponse{}
-	mi := &file_base_v1_service_proto_msgTypes[40]
+func (x *DataAnalyzeResponse) Reset() {
+	*x = DataAnalyzeResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RelationshipWriteResponse) String() string {
+func (x *DataAnalyzeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelationshipWriteResponse) ProtoMessage() {}
+func (*DataAnalyzeResponse) ProtoMessage() {}
 
-func (x *RelationshipWriteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[40]
+func (x *DataAnalyzeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2589,52 +6362,87 @@ func (x *RelationshipWriteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelationshipWriteResponse.ProtoReflect.Descriptor instead.
-func (*RelationshipWriteResponse) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use DataAnalyzeResponse.ProtoReflect.Descriptor instead.
+func (*DataAnalyzeResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *RelationshipWriteResponse) GetSnapToken() string {
+func (x *DataAnalyzeResponse) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *DataAnalyzeResponse) GetSnapToken() string {
 	if x != nil {
 		return x.SnapToken
 	}
 	return ""
 }
 
-// RelationshipReadRequest defines the structure of a request for reading relationships.
-// It contains the necessary information such as tenant_id, metadata, and filter for the read operation.
-type RelationshipReadRequest struct {
+func (x *DataAnalyzeResponse) GetRelationships() uint64 {
+	if x != nil {
+		return x.Relationships
+	}
+	return 0
+}
+
+func (x *DataAnalyzeResponse) GetRecursiveRelations() []*DataRecursiveRelation {
+	if x != nil {
+		return x.RecursiveRelations
+	}
+	return nil
+}
+
+func (x *DataAnalyzeResponse) GetFanOut() []*DataHotspot {
+	if x != nil {
+		return x.FanOut
+	}
+	return nil
+}
+
+func (x *DataAnalyzeResponse) GetFanIn() []*DataHotspot {
+	if x != nil {
+		return x.FanIn
+	}
+	return nil
+}
+
+// DataDeleteRequest defines the structure of a request to delete data.
+// It includes the tenant_id and filters for selecting tuples and attributes to be deleted.
+type DataDeleteRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// tenant_id represents the unique identifier of the tenant for which relationships are read.
+	// tenant_id represents the unique identifier of the tenant from which the data will be deleted.
 	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
-	// metadata holds additional data related to the request.
-	Metadata *RelationshipReadRequestMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// filter is used to specify criteria for the data that needs to be read.
-	Filter *TupleFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
-	// page_size specifies the number of results to return in a single page.
-	// If more results are available, a continuous_token is included in the response.
-	PageSize uint32 `protobuf:"varint,4,opt,name=page_size,proto3" json:"page_size,omitempty"`
-	// continuous_token is used in case of paginated reads to get the next page of results.
-	ContinuousToken string `protobuf:"bytes,5,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// tuple_filter specifies the criteria used to select the tuples that should be deleted.
+	TupleFilter *TupleFilter `protobuf:"bytes,2,opt,name=tuple_filter,proto3" json:"tuple_filter,omitempty"`
+	// attribute_filter specifies the criteria used to select the attributes that should be deleted.
+	AttributeFilter *AttributeFilter `protobuf:"bytes,3,opt,name=attribute_filter,proto3" json:"attribute_filter,omitempty"`
+	// preconditions that must hold for the delete to be applied, evaluated in its transaction.
+	// The request fails with ERROR_CODE_FAILED_PRECONDITION when one of them does not hold.
+	Preconditions []*Precondition `protobuf:"bytes,4,rep,name=preconditions,proto3" json:"preconditions,omitempty"`
+	// metadata describing who made the delete and why, persisted alongside its transaction.
+	Metadata      *TransactionMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RelationshipReadRequest) Reset() {
-	*x = RelationshipReadRequest{}
-	mi := &file_base_v1_service_proto_msgTypes[41]
+func (x *DataDeleteRequest) Reset() {
+	*x = DataDeleteRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RelationshipReadRequest) String() string {
+func (x *DataDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelationshipReadRequest) ProtoMessage() {}
+func (*DataDeleteRequest) ProtoMessage() {}
 
-func (x *RelationshipReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[41]
+func (x *DataDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2645,71 +6453,71 @@ func (x *RelationshipReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelationshipReadRequest.ProtoReflect.Descriptor instead.
-func (*RelationshipReadRequest) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use DataDeleteRequest.ProtoReflect.Descriptor instead.
+func (*DataDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *RelationshipReadRequest) GetTenantId() string {
+func (x *DataDeleteRequest) GetTenantId() string {
 	if x != nil {
 		return x.TenantId
 	}
 	return ""
 }
 
-func (x *RelationshipReadRequest) GetMetadata() *RelationshipReadRequestMetadata {
+func (x *DataDeleteRequest) GetTupleFilter() *TupleFilter {
 	if x != nil {
-		return x.Metadata
+		return x.TupleFilter
 	}
 	return nil
 }
 
-func (x *RelationshipReadRequest) GetFilter() *TupleFilter {
+func (x *DataDeleteRequest) GetAttributeFilter() *AttributeFilter {
 	if x != nil {
-		return x.Filter
+		return x.AttributeFilter
 	}
 	return nil
 }
 
-func (x *RelationshipReadRequest) GetPageSize() uint32 {
+func (x *DataDeleteRequest) GetPreconditions() []*Precondition {
 	if x != nil {
-		return x.PageSize
+		return x.Preconditions
 	}
-	return 0
+	return nil
 }
 
-func (x *RelationshipReadRequest) GetContinuousToken() string {
+func (x *DataDeleteRequest) GetMetadata() *TransactionMetadata {
 	if x != nil {
-		return x.ContinuousToken
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-// RelationshipReadRequestMetadata defines the structure of the metadata for a read request focused on relationships.
-// It includes the snap_token associated with a particular state of the database.
-type RelationshipReadRequestMetadata struct {
+// DataDeleteResponse defines the structure of the response to a data delete request.
+// It includes a snap_token representing the state of the database after the deletion.
+type DataDeleteResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// snap_token represents a specific state or "snapshot" of the database.
+	// snap_token represents the state of the database after the requested deletions.
 	SnapToken     string `protobuf:"bytes,1,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RelationshipReadRequestMetadata) Reset() {
-	*x = RelationshipReadRequestMetadata{}
-	mi := &file_base_v1_service_proto_msgTypes[42]
+func (x *DataDeleteResponse) Reset() {
+	*x = DataDeleteResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RelationshipReadRequestMetadata) String() string {
+func (x *DataDeleteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelationshipReadRequestMetadata) ProtoMessage() {}
+func (*DataDeleteResponse) ProtoMessage() {}
 
-func (x *RelationshipReadRequestMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[42]
+func (x *DataDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2720,45 +6528,42 @@ func (x *RelationshipReadRequestMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelationshipReadRequestMetadata.ProtoReflect.Descriptor instead.
-func (*RelationshipReadRequestMetadata) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use DataDeleteResponse.ProtoReflect.Descriptor instead.
+func (*DataDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *RelationshipReadRequestMetadata) GetSnapToken() string {
+func (x *DataDeleteResponse) GetSnapToken() string {
 	if x != nil {
 		return x.SnapToken
 	}
 	return ""
 }
 
-// RelationshipReadResponse defines the structure of the response after reading relationships.
-// It includes the tuples representing the relationships and a continuous token for handling result pagination.
-type RelationshipReadResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// tuples is a list of the relationships retrieved in the read operation, represented as entity-relation-entity triples.
-	Tuples []*Tuple `protobuf:"bytes,1,rep,name=tuples,proto3" json:"tuples,omitempty"`
-	// continuous_token is used in the case of paginated reads to retrieve the next page of results.
-	ContinuousToken string `protobuf:"bytes,2,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// RelationshipDeleteRequest
+type RelationshipDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	Filter        *TupleFilter           `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RelationshipReadResponse) Reset() {
-	*x = RelationshipReadResponse{}
-	mi := &file_base_v1_service_proto_msgTypes[43]
+func (x *RelationshipDeleteRequest) Reset() {
+	*x = RelationshipDeleteRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RelationshipReadResponse) String() string {
+func (x *RelationshipDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelationshipReadResponse) ProtoMessage() {}
+func (*RelationshipDeleteRequest) ProtoMessage() {}
 
-func (x *RelationshipReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[43]
+func (x *RelationshipDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2769,59 +6574,48 @@ func (x *RelationshipReadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelationshipReadResponse.ProtoReflect.Descriptor instead.
-func (*RelationshipReadResponse) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use RelationshipDeleteRequest.ProtoReflect.Descriptor instead.
+func (*RelationshipDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *RelationshipReadResponse) GetTuples() []*Tuple {
+func (x *RelationshipDeleteRequest) GetTenantId() string {
 	if x != nil {
-		return x.Tuples
+		return x.TenantId
 	}
-	return nil
+	return ""
 }
 
-func (x *RelationshipReadResponse) GetContinuousToken() string {
+func (x *RelationshipDeleteRequest) GetFilter() *TupleFilter {
 	if x != nil {
-		return x.ContinuousToken
+		return x.Filter
 	}
-	return ""
+	return nil
 }
 
-// AttributeReadRequest defines the structure of a request for reading attributes.
-// It includes the tenant_id, metadata, attribute filter, page size for pagination, and a continuous token for multi-page results.
-type AttributeReadRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// tenant_id represents the unique identifier of the tenant from which the attributes are being read.
-	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
-	// metadata holds additional information related to the request.
-	Metadata *AttributeReadRequestMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// filter specifies the criteria used to select the attributes that should be returned.
-	Filter *AttributeFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
-	// page_size specifies the number of results to return in a single page.
-	// If more results are available, a continuous_token is included in the response.
-	PageSize uint32 `protobuf:"varint,4,opt,name=page_size,proto3" json:"page_size,omitempty"`
-	// continuous_token is used in case of paginated reads to get the next page of results.
-	ContinuousToken string `protobuf:"bytes,5,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// RelationshipDeleteResponse
+type RelationshipDeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SnapToken     string                 `protobuf:"bytes,1,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttributeReadRequest) Reset() {
-	*x = AttributeReadRequest{}
-	mi := &file_base_v1_service_proto_msgTypes[44]
+func (x *RelationshipDeleteResponse) Reset() {
+	*x = RelationshipDeleteResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttributeReadRequest) String() string {
+func (x *RelationshipDeleteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttributeReadRequest) ProtoMessage() {}
+func (*RelationshipDeleteResponse) ProtoMessage() {}
 
-func (x *AttributeReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[44]
+func (x *RelationshipDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2832,71 +6626,54 @@ func (x *AttributeReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttributeReadRequest.ProtoReflect.Descriptor instead.
-func (*AttributeReadRequest) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *AttributeReadRequest) GetTenantId() string {
-	if x != nil {
-		return x.TenantId
-	}
-	return ""
-}
-
-func (x *AttributeReadRequest) GetMetadata() *AttributeReadRequestMetadata {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
-}
-
-func (x *AttributeReadRequest) GetFilter() *AttributeFilter {
-	if x != nil {
-		return x.Filter
-	}
-	return nil
-}
-
-func (x *AttributeReadRequest) GetPageSize() uint32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use RelationshipDeleteResponse.ProtoReflect.Descriptor instead.
+func (*RelationshipDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *AttributeReadRequest) GetContinuousToken() string {
+func (x *RelationshipDeleteResponse) GetSnapToken() string {
 	if x != nil {
-		return x.ContinuousToken
+		return x.SnapToken
 	}
 	return ""
 }
 
-// AttributeReadRequestMetadata defines the structure for the metadata of an attribute read request.
-// It includes the snap_token associated with a particular state of the database.
-type AttributeReadRequestMetadata struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// snap_token represents a specific state or "snapshot" of the database.
-	SnapToken     string `protobuf:"bytes,1,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
+// BundleRunRequest is used to request the execution of a bundle.
+// It includes tenant_id, the name of the bundle, and additional arguments for execution.
+type BundleRunRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId string                 `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	// Name of the bundle to be executed.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Additional key-value pairs for execution arguments.
+	Arguments map[string]string `protobuf:"bytes,3,rep,name=arguments,proto3" json:"arguments,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// idempotency_key makes retries of the bundle safe. A retry with the same key within the retention window
+	// is not applied again and returns the snap token of the original run.
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,proto3" json:"idempotency_key,omitempty"`
+	// preconditions that must hold for the bundle to be applied, evaluated in its transaction.
+	// The request fails with ERROR_CODE_FAILED_PRECONDITION when one of them does not hold.
+	Preconditions []*Precondition `protobuf:"bytes,4,rep,name=preconditions,proto3" json:"preconditions,omitempty"`
+	// metadata describing who ran the bundle and why, persisted alongside its transaction.
+	Metadata      *TransactionMetadata `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttributeReadRequestMetadata) Reset() {
-	*x = AttributeReadRequestMetadata{}
-	mi := &file_base_v1_service_proto_msgTypes[45]
+func (x *BundleRunRequest) Reset() {
+	*x = BundleRunRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttributeReadRequestMetadata) String() string {
+func (x *BundleRunRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttributeReadRequestMetadata) ProtoMessage() {}
+func (*BundleRunRequest) ProtoMessage() {}
 
-func (x *AttributeReadRequestMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[45]
+func (x *BundleRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2907,45 +6684,79 @@ func (x *AttributeReadRequestMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttributeReadRequestMetadata.ProtoReflect.Descriptor instead.
-func (*AttributeReadRequestMetadata) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use BundleRunRequest.ProtoReflect.Descriptor instead.
+func (*BundleRunRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *AttributeReadRequestMetadata) GetSnapToken() string {
+func (x *BundleRunRequest) GetTenantId() string {
 	if x != nil {
-		return x.SnapToken
+		return x.TenantId
 	}
 	return ""
 }
 
-// AttributeReadResponse defines the structure of the response to an attribute read request.
-// It includes the attributes retrieved and a continuous token for handling result pagination.
-type AttributeReadResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// attributes is a list of the attributes retrieved in the read operation.
-	Attributes []*Attribute `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
-	// continuous_token is used in the case of paginated reads to retrieve the next page of results.
-	ContinuousToken string `protobuf:"bytes,2,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *BundleRunRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BundleRunRequest) GetArguments() map[string]string {
+	if x != nil {
+		return x.Arguments
+	}
+	return nil
+}
+
+func (x *BundleRunRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *BundleRunRequest) GetPreconditions() []*Precondition {
+	if x != nil {
+		return x.Preconditions
+	}
+	return nil
+}
+
+func (x *BundleRunRequest) GetMetadata() *TransactionMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
 }
 
-func (x *AttributeReadResponse) Reset() {
-	*x = AttributeReadResponse{}
-	mi := &file_base_v1_service_proto_msgTypes[46]
+// BundleRunResponse is the response for a BundleRunRequest.
+// It includes a snap_token, which may be used for tracking the execution or its results.
+type BundleRunResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SnapToken string                 `protobuf:"bytes,1,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
+	// warnings about the data written by the bundle, e.g. when a written relation or attribute is deprecated in the schema.
+	Warnings      []string `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BundleRunResponse) Reset() {
+	*x = BundleRunResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttributeReadResponse) String() string {
+func (x *BundleRunResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttributeReadResponse) ProtoMessage() {}
+func (*BundleRunResponse) ProtoMessage() {}
 
-func (x *AttributeReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[46]
+func (x *BundleRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2956,54 +6767,50 @@ func (x *AttributeReadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttributeReadResponse.ProtoReflect.Descriptor instead.
-func (*AttributeReadResponse) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use BundleRunResponse.ProtoReflect.Descriptor instead.
+func (*BundleRunResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *AttributeReadResponse) GetAttributes() []*Attribute {
+func (x *BundleRunResponse) GetSnapToken() string {
 	if x != nil {
-		return x.Attributes
+		return x.SnapToken
 	}
-	return nil
+	return ""
 }
 
-func (x *AttributeReadResponse) GetContinuousToken() string {
+func (x *BundleRunResponse) GetWarnings() []string {
 	if x != nil {
-		return x.ContinuousToken
+		return x.Warnings
 	}
-	return ""
+	return nil
 }
 
-// DataDeleteRequest defines the structure of a request to delete data.
-// It includes the tenant_id and filters for selecting tuples and attributes to be deleted.
-type DataDeleteRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// tenant_id represents the unique identifier of the tenant from which the data will be deleted.
-	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
-	// tuple_filter specifies the criteria used to select the tuples that should be deleted.
-	TupleFilter *TupleFilter `protobuf:"bytes,2,opt,name=tuple_filter,proto3" json:"tuple_filter,omitempty"`
-	// attribute_filter specifies the criteria used to select the attributes that should be deleted.
-	AttributeFilter *AttributeFilter `protobuf:"bytes,3,opt,name=attribute_filter,proto3" json:"attribute_filter,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// BundleWriteRequest is used to request the writing of a bundle.
+// It contains the tenant_id to identify the tenant and the Bundles object.
+type BundleWriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	Bundles       []*DataBundle          `protobuf:"bytes,2,rep,name=bundles,proto3" json:"bundles,omitempty"` // Contains the bundle data to be written.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataDeleteRequest) Reset() {
-	*x = DataDeleteRequest{}
-	mi := &file_base_v1_service_proto_msgTypes[47]
+func (x *BundleWriteRequest) Reset() {
+	*x = BundleWriteRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataDeleteRequest) String() string {
+func (x *BundleWriteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataDeleteRequest) ProtoMessage() {}
+func (*BundleWriteRequest) ProtoMessage() {}
 
-func (x *DataDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[47]
+func (x *BundleWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3014,57 +6821,49 @@ func (x *DataDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataDeleteRequest.ProtoReflect.Descriptor instead.
-func (*DataDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use BundleWriteRequest.ProtoReflect.Descriptor instead.
+func (*BundleWriteRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *DataDeleteRequest) GetTenantId() string {
+func (x *BundleWriteRequest) GetTenantId() string {
 	if x != nil {
 		return x.TenantId
 	}
 	return ""
 }
 
-func (x *DataDeleteRequest) GetTupleFilter() *TupleFilter {
-	if x != nil {
-		return x.TupleFilter
-	}
-	return nil
-}
-
-func (x *DataDeleteRequest) GetAttributeFilter() *AttributeFilter {
+func (x *BundleWriteRequest) GetBundles() []*DataBundle {
 	if x != nil {
-		return x.AttributeFilter
+		return x.Bundles
 	}
 	return nil
 }
 
-// DataDeleteResponse defines the structure of the response to a data delete request.
-// It includes a snap_token representing the state of the database after the deletion.
-type DataDeleteResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// snap_token represents the state of the database after the requested deletions.
-	SnapToken     string `protobuf:"bytes,1,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
+// BundleWriteResponse is the response for a BundleWriteRequest.
+// It includes a name which could be used as an identifier or acknowledgment.
+type BundleWriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Names         []string               `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"` // Identifier or acknowledgment of the written bundle.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataDeleteResponse) Reset() {
-	*x = DataDeleteResponse{}
-	mi := &file_base_v1_service_proto_msgTypes[48]
+func (x *BundleWriteResponse) Reset() {
+	*x = BundleWriteResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataDeleteResponse) String() string {
+func (x *BundleWriteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataDeleteResponse) ProtoMessage() {}
+func (*BundleWriteResponse) ProtoMessage() {}
 
-func (x *DataDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[48]
+func (x *BundleWriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3075,42 +6874,41 @@ func (x *DataDeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataDeleteResponse.ProtoReflect.Descriptor instead.
-func (*DataDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use BundleWriteResponse.ProtoReflect.Descriptor instead.
+func (*BundleWriteResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *DataDeleteResponse) GetSnapToken() string {
+func (x *BundleWriteResponse) GetNames() []string {
 	if x != nil {
-		return x.SnapToken
+		return x.Names
 	}
-	return ""
+	return nil
 }
 
-// RelationshipDeleteRequest
-type RelationshipDeleteRequest struct {
+type BundleReadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
-	Filter        *TupleFilter           `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RelationshipDeleteRequest) Reset() {
-	*x = RelationshipDeleteRequest{}
-	mi := &file_base_v1_service_proto_msgTypes[49]
+func (x *BundleReadRequest) Reset() {
+	*x = BundleReadRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RelationshipDeleteRequest) String() string {
+func (x *BundleReadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelationshipDeleteRequest) ProtoMessage() {}
+func (*BundleReadRequest) ProtoMessage() {}
 
-func (x *RelationshipDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[49]
+func (x *BundleReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3121,48 +6919,47 @@ func (x *RelationshipDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelationshipDeleteRequest.ProtoReflect.Descriptor instead.
-func (*RelationshipDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use BundleReadRequest.ProtoReflect.Descriptor instead.
+func (*BundleReadRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *RelationshipDeleteRequest) GetTenantId() string {
+func (x *BundleReadRequest) GetTenantId() string {
 	if x != nil {
 		return x.TenantId
 	}
 	return ""
 }
 
-func (x *RelationshipDeleteRequest) GetFilter() *TupleFilter {
+func (x *BundleReadRequest) GetName() string {
 	if x != nil {
-		return x.Filter
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-// RelationshipDeleteResponse
-type RelationshipDeleteResponse struct {
+type BundleReadResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SnapToken     string                 `protobuf:"bytes,1,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
+	Bundle        *DataBundle            `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RelationshipDeleteResponse) Reset() {
-	*x = RelationshipDeleteResponse{}
-	mi := &file_base_v1_service_proto_msgTypes[50]
+func (x *BundleReadResponse) Reset() {
+	*x = BundleReadResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RelationshipDeleteResponse) String() string {
+func (x *BundleReadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelationshipDeleteResponse) ProtoMessage() {}
+func (*BundleReadResponse) ProtoMessage() {}
 
-func (x *RelationshipDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[50]
+func (x *BundleReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3173,46 +6970,43 @@ func (x *RelationshipDeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelationshipDeleteResponse.ProtoReflect.Descriptor instead.
-func (*RelationshipDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use BundleReadResponse.ProtoReflect.Descriptor instead.
+func (*BundleReadResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *RelationshipDeleteResponse) GetSnapToken() string {
+func (x *BundleReadResponse) GetBundle() *DataBundle {
 	if x != nil {
-		return x.SnapToken
+		return x.Bundle
 	}
-	return ""
+	return nil
 }
 
-// BundleRunRequest is used to request the execution of a bundle.
-// It includes tenant_id, the name of the bundle, and additional arguments for execution.
-type BundleRunRequest struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	TenantId string                 `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
-	// Name of the bundle to be executed.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Additional key-value pairs for execution arguments.
-	Arguments     map[string]string `protobuf:"bytes,3,rep,name=arguments,proto3" json:"arguments,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+// BundleDeleteRequest is used to request the deletion of a bundle.
+// It contains the tenant_id to specify the tenant and the name of the bundle to be deleted.
+type BundleDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Name of the bundle to be deleted.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BundleRunRequest) Reset() {
-	*x = BundleRunRequest{}
-	mi := &file_base_v1_service_proto_msgTypes[51]
+func (x *BundleDeleteRequest) Reset() {
+	*x = BundleDeleteRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BundleRunRequest) String() string {
+func (x *BundleDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BundleRunRequest) ProtoMessage() {}
+func (*BundleDeleteRequest) ProtoMessage() {}
 
-func (x *BundleRunRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[51]
+func (x *BundleDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3223,56 +7017,47 @@ func (x *BundleRunRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BundleRunRequest.ProtoReflect.Descriptor instead.
-func (*BundleRunRequest) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use BundleDeleteRequest.ProtoReflect.Descriptor instead.
+func (*BundleDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *BundleRunRequest) GetTenantId() string {
+func (x *BundleDeleteRequest) GetTenantId() string {
 	if x != nil {
 		return x.TenantId
 	}
 	return ""
 }
 
-func (x *BundleRunRequest) GetName() string {
+func (x *BundleDeleteRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *BundleRunRequest) GetArguments() map[string]string {
-	if x != nil {
-		return x.Arguments
-	}
-	return nil
-}
-
-// BundleRunResponse is the response for a BundleRunRequest.
-// It includes a snap_token, which may be used for tracking the execution or its results.
-type BundleRunResponse struct {
+type BundleDeleteResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SnapToken     string                 `protobuf:"bytes,1,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BundleRunResponse) Reset() {
-	*x = BundleRunResponse{}
-	mi := &file_base_v1_service_proto_msgTypes[52]
+func (x *BundleDeleteResponse) Reset() {
+	*x = BundleDeleteResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BundleRunResponse) String() string {
+func (x *BundleDeleteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BundleRunResponse) ProtoMessage() {}
+func (*BundleDeleteResponse) ProtoMessage() {}
 
-func (x *BundleRunResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[52]
+func (x *BundleDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3283,43 +7068,44 @@ func (x *BundleRunResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BundleRunResponse.ProtoReflect.Descriptor instead.
-func (*BundleRunResponse) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use BundleDeleteResponse.ProtoReflect.Descriptor instead.
+func (*BundleDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *BundleRunResponse) GetSnapToken() string {
+func (x *BundleDeleteResponse) GetName() string {
 	if x != nil {
-		return x.SnapToken
+		return x.Name
 	}
 	return ""
 }
 
-// BundleWriteRequest is used to request the writing of a bundle.
-// It contains the tenant_id to identify the tenant and the Bundles object.
-type BundleWriteRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
-	Bundles       []*DataBundle          `protobuf:"bytes,2,rep,name=bundles,proto3" json:"bundles,omitempty"` // Contains the bundle data to be written.
+// TenantCreateRequest is the message used for the request to create a tenant.
+type TenantCreateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is a unique identifier for the tenant.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the name of the tenant.
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BundleWriteRequest) Reset() {
-	*x = BundleWriteRequest{}
-	mi := &file_base_v1_service_proto_msgTypes[53]
+func (x *TenantCreateRequest) Reset() {
+	*x = TenantCreateRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BundleWriteRequest) String() string {
+func (x *TenantCreateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BundleWriteRequest) ProtoMessage() {}
+func (*TenantCreateRequest) ProtoMessage() {}
 
-func (x *BundleWriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[53]
+func (x *TenantCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3330,49 +7116,49 @@ func (x *BundleWriteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BundleWriteRequest.ProtoReflect.Descriptor instead.
-func (*BundleWriteRequest) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use TenantCreateRequest.ProtoReflect.Descriptor instead.
+func (*TenantCreateRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *BundleWriteRequest) GetTenantId() string {
+func (x *TenantCreateRequest) GetId() string {
 	if x != nil {
-		return x.TenantId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *BundleWriteRequest) GetBundles() []*DataBundle {
+func (x *TenantCreateRequest) GetName() string {
 	if x != nil {
-		return x.Bundles
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-// BundleWriteResponse is the response for a BundleWriteRequest.
-// It includes a name which could be used as an identifier or acknowledgment.
-type BundleWriteResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Names         []string               `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"` // Identifier or acknowledgment of the written bundle.
+// TenantCreateResponse is the message returned from the request to create a tenant.
+type TenantCreateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant is the created tenant information.
+	Tenant        *Tenant `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BundleWriteResponse) Reset() {
-	*x = BundleWriteResponse{}
-	mi := &file_base_v1_service_proto_msgTypes[54]
+func (x *TenantCreateResponse) Reset() {
+	*x = TenantCreateResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BundleWriteResponse) String() string {
+func (x *TenantCreateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BundleWriteResponse) ProtoMessage() {}
+func (*TenantCreateResponse) ProtoMessage() {}
 
-func (x *BundleWriteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[54]
+func (x *TenantCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3383,41 +7169,42 @@ func (x *BundleWriteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BundleWriteResponse.ProtoReflect.Descriptor instead.
-func (*BundleWriteResponse) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use TenantCreateResponse.ProtoReflect.Descriptor instead.
+func (*TenantCreateResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *BundleWriteResponse) GetNames() []string {
+func (x *TenantCreateResponse) GetTenant() *Tenant {
 	if x != nil {
-		return x.Names
+		return x.Tenant
 	}
 	return nil
 }
 
-type BundleReadRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+// TenantDeleteRequest is the message used for the request to delete a tenant.
+type TenantDeleteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the tenant to be deleted.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BundleReadRequest) Reset() {
-	*x = BundleReadRequest{}
-	mi := &file_base_v1_service_proto_msgTypes[55]
+func (x *TenantDeleteRequest) Reset() {
+	*x = TenantDeleteRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BundleReadRequest) String() string {
+func (x *TenantDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BundleReadRequest) ProtoMessage() {}
+func (*TenantDeleteRequest) ProtoMessage() {}
 
-func (x *BundleReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[55]
+func (x *TenantDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3428,47 +7215,42 @@ func (x *BundleReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BundleReadRequest.ProtoReflect.Descriptor instead.
-func (*BundleReadRequest) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{55}
-}
-
-func (x *BundleReadRequest) GetTenantId() string {
-	if x != nil {
-		return x.TenantId
-	}
-	return ""
+// Deprecated: Use TenantDeleteRequest.ProtoReflect.Descriptor instead.
+func (*TenantDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *BundleReadRequest) GetName() string {
+func (x *TenantDeleteRequest) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-type BundleReadResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Bundle        *DataBundle            `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+// TenantDeleteResponse is the message returned from the request to delete a tenant.
+type TenantDeleteResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant_id is the tenant id that was deleted.
+	TenantId      string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BundleReadResponse) Reset() {
-	*x = BundleReadResponse{}
-	mi := &file_base_v1_service_proto_msgTypes[56]
+func (x *TenantDeleteResponse) Reset() {
+	*x = TenantDeleteResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BundleReadResponse) String() string {
+func (x *TenantDeleteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BundleReadResponse) ProtoMessage() {}
+func (*TenantDeleteResponse) ProtoMessage() {}
 
-func (x *BundleReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[56]
+func (x *TenantDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3479,43 +7261,46 @@ func (x *BundleReadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BundleReadResponse.ProtoReflect.Descriptor instead.
-func (*BundleReadResponse) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use TenantDeleteResponse.ProtoReflect.Descriptor instead.
+func (*TenantDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *BundleReadResponse) GetBundle() *DataBundle {
+func (x *TenantDeleteResponse) GetTenantId() string {
 	if x != nil {
-		return x.Bundle
+		return x.TenantId
 	}
-	return nil
+	return ""
 }
 
-// BundleDeleteRequest is used to request the deletion of a bundle.
-// It contains the tenant_id to specify the tenant and the name of the bundle to be deleted.
-type BundleDeleteRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Name of the bundle to be deleted.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// TenantListRequest is the message used for the request to list all tenants.
+type TenantListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page_size is the number of tenants to be returned in the response.
+	// The value should be between 1 and 100.
+	PageSize uint32 `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	// continuous_token is an optional parameter used for pagination.
+	// It should be the value received in the previous response.
+	ContinuousToken string `protobuf:"bytes,2,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *BundleDeleteRequest) Reset() {
-	*x = BundleDeleteRequest{}
-	mi := &file_base_v1_service_proto_msgTypes[57]
+func (x *TenantListRequest) Reset() {
+	*x = TenantListRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BundleDeleteRequest) String() string {
+func (x *TenantListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BundleDeleteRequest) ProtoMessage() {}
+func (*TenantListRequest) ProtoMessage() {}
 
-func (x *BundleDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[57]
+func (x *TenantListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3526,47 +7311,51 @@ func (x *BundleDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BundleDeleteRequest.ProtoReflect.Descriptor instead.
-func (*BundleDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use TenantListRequest.ProtoReflect.Descriptor instead.
+func (*TenantListRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *BundleDeleteRequest) GetTenantId() string {
+func (x *TenantListRequest) GetPageSize() uint32 {
 	if x != nil {
-		return x.TenantId
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *BundleDeleteRequest) GetName() string {
+func (x *TenantListRequest) GetContinuousToken() string {
 	if x != nil {
-		return x.Name
+		return x.ContinuousToken
 	}
 	return ""
 }
 
-type BundleDeleteResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// TenantListResponse is the message returned from the request to list all tenants.
+type TenantListResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenants is a list of tenants.
+	Tenants []*Tenant `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	// continuous_token is a string that can be used to paginate and retrieve the next set of results.
+	ContinuousToken string `protobuf:"bytes,2,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *BundleDeleteResponse) Reset() {
-	*x = BundleDeleteResponse{}
-	mi := &file_base_v1_service_proto_msgTypes[58]
+func (x *TenantListResponse) Reset() {
+	*x = TenantListResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BundleDeleteResponse) String() string {
+func (x *TenantListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BundleDeleteResponse) ProtoMessage() {}
+func (*TenantListResponse) ProtoMessage() {}
 
-func (x *BundleDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[58]
+func (x *TenantListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3577,44 +7366,55 @@ func (x *BundleDeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BundleDeleteResponse.ProtoReflect.Descriptor instead.
-func (*BundleDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use TenantListResponse.ProtoReflect.Descriptor instead.
+func (*TenantListResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *BundleDeleteResponse) GetName() string {
+func (x *TenantListResponse) GetTenants() []*Tenant {
 	if x != nil {
-		return x.Name
+		return x.Tenants
+	}
+	return nil
+}
+
+func (x *TenantListResponse) GetContinuousToken() string {
+	if x != nil {
+		return x.ContinuousToken
 	}
 	return ""
 }
 
-// TenantCreateRequest is the message used for the request to create a tenant.
-type TenantCreateRequest struct {
+// AuditFilter is used to filter audit records.
+type AuditFilter struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// id is a unique identifier for the tenant.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// name is the name of the tenant.
-	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// actors to filter by, empty matches every actor.
+	Actors []string `protobuf:"bytes,1,rep,name=actors,proto3" json:"actors,omitempty"`
+	// methods to filter by, e.g. "Data.Write", empty matches every method.
+	Methods []string `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+	// start_time is the inclusive lower bound of the creation time of the records.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,proto3" json:"start_time,omitempty"`
+	// end_time is the exclusive upper bound of the creation time of the records.
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,proto3" json:"end_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TenantCreateRequest) Reset() {
-	*x = TenantCreateRequest{}
-	mi := &file_base_v1_service_proto_msgTypes[59]
+func (x *AuditFilter) Reset() {
+	*x = AuditFilter{}
+	mi := &file_base_v1_service_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TenantCreateRequest) String() string {
+func (x *AuditFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TenantCreateRequest) ProtoMessage() {}
+func (*AuditFilter) ProtoMessage() {}
 
-func (x *TenantCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[59]
+func (x *AuditFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3625,49 +7425,72 @@ func (x *TenantCreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TenantCreateRequest.ProtoReflect.Descriptor instead.
-func (*TenantCreateRequest) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use AuditFilter.ProtoReflect.Descriptor instead.
+func (*AuditFilter) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *TenantCreateRequest) GetId() string {
+func (x *AuditFilter) GetActors() []string {
 	if x != nil {
-		return x.Id
+		return x.Actors
 	}
-	return ""
+	return nil
 }
 
-func (x *TenantCreateRequest) GetName() string {
+func (x *AuditFilter) GetMethods() []string {
 	if x != nil {
-		return x.Name
+		return x.Methods
 	}
-	return ""
+	return nil
 }
 
-// TenantCreateResponse is the message returned from the request to create a tenant.
-type TenantCreateResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// tenant is the created tenant information.
-	Tenant        *Tenant `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AuditFilter) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *AuditFilter) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
 }
 
-func (x *TenantCreateResponse) Reset() {
-	*x = TenantCreateResponse{}
-	mi := &file_base_v1_service_proto_msgTypes[60]
+// AuditListRequest is the request message for the List method in the Audit service.
+type AuditListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant_id is a string that identifies the tenant. It must match the pattern "[a-zA-Z0-9-,]+",
+	// be a maximum of 64 bytes, and must not be empty.
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	// filter is used to narrow down the records to be returned.
+	Filter *AuditFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// page_size is the number of records to be returned in the response.
+	// The value should be between 1 and 100.
+	PageSize uint32 `protobuf:"varint,3,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	// continuous_token is an optional parameter used for pagination.
+	// It should be the value received in the previous response.
+	ContinuousToken string `protobuf:"bytes,4,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AuditListRequest) Reset() {
+	*x = AuditListRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TenantCreateResponse) String() string {
+func (x *AuditListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TenantCreateResponse) ProtoMessage() {}
+func (*AuditListRequest) ProtoMessage() {}
 
-func (x *TenantCreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[60]
+func (x *AuditListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3678,42 +7501,65 @@ func (x *TenantCreateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TenantCreateResponse.ProtoReflect.Descriptor instead.
-func (*TenantCreateResponse) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use AuditListRequest.ProtoReflect.Descriptor instead.
+func (*AuditListRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *TenantCreateResponse) GetTenant() *Tenant {
+func (x *AuditListRequest) GetTenantId() string {
 	if x != nil {
-		return x.Tenant
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *AuditListRequest) GetFilter() *AuditFilter {
+	if x != nil {
+		return x.Filter
 	}
 	return nil
 }
 
-// TenantDeleteRequest is the message used for the request to delete a tenant.
-type TenantDeleteRequest struct {
+func (x *AuditListRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *AuditListRequest) GetContinuousToken() string {
+	if x != nil {
+		return x.ContinuousToken
+	}
+	return ""
+}
+
+// AuditListResponse is the response message for the List method in the Audit service.
+type AuditListResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// id is the unique identifier of the tenant to be deleted.
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// records is a list of audit records, newest first.
+	Records []*AuditRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	// continuous_token is a string that can be used to paginate and retrieve the next set of results.
+	ContinuousToken string `protobuf:"bytes,2,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *TenantDeleteRequest) Reset() {
-	*x = TenantDeleteRequest{}
-	mi := &file_base_v1_service_proto_msgTypes[61]
+func (x *AuditListResponse) Reset() {
+	*x = AuditListResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TenantDeleteRequest) String() string {
+func (x *AuditListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TenantDeleteRequest) ProtoMessage() {}
+func (*AuditListResponse) ProtoMessage() {}
 
-func (x *TenantDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[61]
+func (x *AuditListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3724,42 +7570,58 @@ func (x *TenantDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TenantDeleteRequest.ProtoReflect.Descriptor instead.
-func (*TenantDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use AuditListResponse.ProtoReflect.Descriptor instead.
+func (*AuditListResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *TenantDeleteRequest) GetId() string {
+func (x *AuditListResponse) GetRecords() []*AuditRecord {
 	if x != nil {
-		return x.Id
+		return x.Records
+	}
+	return nil
+}
+
+func (x *AuditListResponse) GetContinuousToken() string {
+	if x != nil {
+		return x.ContinuousToken
 	}
 	return ""
 }
 
-// TenantDeleteResponse is the message returned from the request to delete a tenant.
-type TenantDeleteResponse struct {
+// AuditAccessReviewRequest is the request message for the AccessReview method in the Audit service.
+type AuditAccessReviewRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// tenant_id is the tenant id that was deleted.
-	TenantId      string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	// tenant_id is a string that identifies the tenant. It must match the pattern "[a-zA-Z0-9-,]+",
+	// be a maximum of 64 bytes, and must not be empty.
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,proto3" json:"tenant_id,omitempty"`
+	// Metadata associated with this request, required.
+	Metadata *AuditAccessReviewRequestMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Types of the entities to review, at least one is required.
+	EntityTypes []string `protobuf:"bytes,3,rep,name=entity_types,proto3" json:"entity_types,omitempty"`
+	// Names of the permissions to review, every permission of the entity types if empty.
+	Permissions []string `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// Types of the subjects to review, every entity type the schema relates directly as a subject if empty.
+	SubjectTypes  []string `protobuf:"bytes,5,rep,name=subject_types,proto3" json:"subject_types,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TenantDeleteResponse) Reset() {
-	*x = TenantDeleteResponse{}
-	mi := &file_base_v1_service_proto_msgTypes[62]
+func (x *AuditAccessReviewRequest) Reset() {
+	*x = AuditAccessReviewRequest{}
+	mi := &file_base_v1_service_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TenantDeleteResponse) String() string {
+func (x *AuditAccessReviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TenantDeleteResponse) ProtoMessage() {}
+func (*AuditAccessReviewRequest) ProtoMessage() {}
 
-func (x *TenantDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[62]
+func (x *AuditAccessReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3770,46 +7632,74 @@ func (x *TenantDeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TenantDeleteResponse.ProtoReflect.Descriptor instead.
-func (*TenantDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use AuditAccessReviewRequest.ProtoReflect.Descriptor instead.
+func (*AuditAccessReviewRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *TenantDeleteResponse) GetTenantId() string {
+func (x *AuditAccessReviewRequest) GetTenantId() string {
 	if x != nil {
 		return x.TenantId
 	}
 	return ""
 }
 
-// TenantListRequest is the message used for the request to list all tenants.
-type TenantListRequest struct {
+func (x *AuditAccessReviewRequest) GetMetadata() *AuditAccessReviewRequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *AuditAccessReviewRequest) GetEntityTypes() []string {
+	if x != nil {
+		return x.EntityTypes
+	}
+	return nil
+}
+
+func (x *AuditAccessReviewRequest) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *AuditAccessReviewRequest) GetSubjectTypes() []string {
+	if x != nil {
+		return x.SubjectTypes
+	}
+	return nil
+}
+
+// AuditAccessReviewRequestMetadata metadata for the AuditAccessReviewRequest.
+type AuditAccessReviewRequestMetadata struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// page_size is the number of tenants to be returned in the response.
-	// The value should be between 1 and 100.
-	PageSize uint32 `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
-	// continuous_token is an optional parameter used for pagination.
-	// It should be the value received in the previous response.
-	ContinuousToken string `protobuf:"bytes,2,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Version of the schema.
+	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	// Token associated with the snap.
+	SnapToken string `protobuf:"bytes,2,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
+	// Depth of lookup, required, must be greater or equal to 3.
+	Depth         int32 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TenantListRequest) Reset() {
-	*x = TenantListRequest{}
-	mi := &file_base_v1_service_proto_msgTypes[63]
+func (x *AuditAccessReviewRequestMetadata) Reset() {
+	*x = AuditAccessReviewRequestMetadata{}
+	mi := &file_base_v1_service_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TenantListRequest) String() string {
+func (x *AuditAccessReviewRequestMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TenantListRequest) ProtoMessage() {}
+func (*AuditAccessReviewRequestMetadata) ProtoMessage() {}
 
-func (x *TenantListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[63]
+func (x *AuditAccessReviewRequestMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3820,51 +7710,58 @@ func (x *TenantListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TenantListRequest.ProtoReflect.Descriptor instead.
-func (*TenantListRequest) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use AuditAccessReviewRequestMetadata.ProtoReflect.Descriptor instead.
+func (*AuditAccessReviewRequestMetadata) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *TenantListRequest) GetPageSize() uint32 {
+func (x *AuditAccessReviewRequestMetadata) GetSchemaVersion() string {
 	if x != nil {
-		return x.PageSize
+		return x.SchemaVersion
 	}
-	return 0
+	return ""
 }
 
-func (x *TenantListRequest) GetContinuousToken() string {
+func (x *AuditAccessReviewRequestMetadata) GetSnapToken() string {
 	if x != nil {
-		return x.ContinuousToken
+		return x.SnapToken
 	}
 	return ""
 }
 
-// TenantListResponse is the message returned from the request to list all tenants.
-type TenantListResponse struct {
+func (x *AuditAccessReviewRequestMetadata) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+// AuditAccessReviewResponse is the response message for the AccessReview method in the Audit service.
+type AuditAccessReviewResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// tenants is a list of tenants.
-	Tenants []*Tenant `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
-	// continuous_token is a string that can be used to paginate and retrieve the next set of results.
-	ContinuousToken string `protobuf:"bytes,2,opt,name=continuous_token,proto3" json:"continuous_token,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// entry is a permission a subject holds on an entity.
+	Entry *AccessReviewEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	// snap_token is the snap token the review is read at, the same for every entry.
+	SnapToken     string `protobuf:"bytes,2,opt,name=snap_token,proto3" json:"snap_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TenantListResponse) Reset() {
-	*x = TenantListResponse{}
-	mi := &file_base_v1_service_proto_msgTypes[64]
+func (x *AuditAccessReviewResponse) Reset() {
+	*x = AuditAccessReviewResponse{}
+	mi := &file_base_v1_service_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TenantListResponse) String() string {
+func (x *AuditAccessReviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TenantListResponse) ProtoMessage() {}
+func (*AuditAccessReviewResponse) ProtoMessage() {}
 
-func (x *TenantListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_service_proto_msgTypes[64]
+func (x *AuditAccessReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_service_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3875,21 +7772,21 @@ func (x *TenantListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TenantListResponse.ProtoReflect.Descriptor instead.
-func (*TenantListResponse) Descriptor() ([]byte, []int) {
-	return file_base_v1_service_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use AuditAccessReviewResponse.ProtoReflect.Descriptor instead.
+func (*AuditAccessReviewResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_service_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *TenantListResponse) GetTenants() []*Tenant {
+func (x *AuditAccessReviewResponse) GetEntry() *AccessReviewEntry {
 	if x != nil {
-		return x.Tenants
+		return x.Entry
 	}
 	return nil
 }
 
-func (x *TenantListResponse) GetContinuousToken() string {
+func (x *AuditAccessReviewResponse) GetSnapToken() string {
 	if x != nil {
-		return x.ContinuousToken
+		return x.SnapToken
 	}
 	return ""
 }
@@ -3898,7 +7795,7 @@ var File_base_v1_service_proto protoreflect.FileDescriptor
 
 const file_base_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"\x15base/v1/service.proto\x12\abase.v1\x1a\x12base/v1/base.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x17validate/validate.proto\"\x80\a\n" +
+	"\x15base/v1/service.proto\x12\abase.v1\x1a\x12base/v1/base.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x17validate/validate.proto\"\x80\a\n" +
 	"\x16PermissionCheckRequest\x12\xaa\x02\n" +
 	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x12M\n" +
 	"\bmetadata\x18\x02 \x01(\v2'.base.v1.PermissionCheckRequestMetadataB\b\xfaB\x05\x8a\x01\x02\x10\x01R\bmetadata\x12D\n" +
@@ -3908,16 +7805,22 @@ const file_base_v1_service_proto_rawDesc = "" +
 	"permission\x124\n" +
 	"\asubject\x18\x05 \x01(\v2\x10.base.v1.SubjectB\b\xfaB\x05\x8a\x01\x02\x10\x01R\asubject\x12\xc4\x01\n" +
 	"\acontext\x18\x06 \x01(\v2\x10.base.v1.ContextB\x97\x01\x92A\x93\x012\x90\x01Contextual data that can be dynamically added to permission check requests. See details on [Contextual Data](../../operations/contextual-tuples)R\acontext\x12/\n" +
-	"\targuments\x18\a \x03(\v2\x11.base.v1.ArgumentR\targuments\"\xb2\x02\n" +
+	"\targuments\x18\a \x03(\v2\x11.base.v1.ArgumentR\targuments\"\xe9\x05\n" +
 	"\x1ePermissionCheckRequestMetadata\x12&\n" +
 	"\x0eschema_version\x18\x01 \x01(\tR\x0eschema_version\x12\x89\x01\n" +
 	"\n" +
 	"snap_token\x18\x02 \x01(\tBi\x92Af2dThe snap token to avoid stale cache, see more details on [Snap Tokens](../../operations/snap-tokens)R\n" +
 	"snap_token\x12\\\n" +
-	"\x05depth\x18\x03 \x01(\x05BF\x92A<2:Query limit when if recursive database queries got in loop\xfaB\x04\x1a\x02(\x03R\x05depth\"\x87\x01\n" +
+	"\x05depth\x18\x03 \x01(\x05BF\x92A<2:Query limit when if recursive database queries got in loop\xfaB\x04\x1a\x02(\x03R\x05depth\x12\x1e\n" +
+	"\n" +
+	"schema_tag\x18\x04 \x01(\tR\n" +
+	"schema_tag\x12\xcf\x01\n" +
+	"\aat_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x98\x01\x92A\x94\x012\x91\x01Time to read the data at when snap_token is not set, see more details on [Point-in-Time Reads](../../operations/snap-tokens#point-in-time-reads).R\aat_time\x12\xc2\x01\n" +
+	"\vconsistency\x18\x06 \x01(\v2\x14.base.v1.ConsistencyB\x89\x01\x92A\x85\x012\x82\x01Consistency of the snapshot the check is answered at, see more details on [Consistency](../../operations/snap-tokens#consistency).R\vconsistency\"\xa3\x01\n" +
 	"\x17PermissionCheckResponse\x12&\n" +
 	"\x03can\x18\x01 \x01(\x0e2\x14.base.v1.CheckResultR\x03can\x12D\n" +
-	"\bmetadata\x18\x02 \x01(\v2(.base.v1.PermissionCheckResponseMetadataR\bmetadata\"C\n" +
+	"\bmetadata\x18\x02 \x01(\v2(.base.v1.PermissionCheckResponseMetadataR\bmetadata\x12\x1a\n" +
+	"\bwarnings\x18\x03 \x03(\tR\bwarnings\"C\n" +
 	"\x1fPermissionCheckResponseMetadata\x12 \n" +
 	"\vcheck_count\x18\x01 \x01(\x05R\vcheck_count\"\x94\x02\n" +
 	"\x1ePermissionBulkCheckRequestItem\x12D\n" +
@@ -3943,14 +7846,19 @@ const file_base_v1_service_proto_rawDesc = "" +
 	"permission\x18\x04 \x01(\tB\x1d\xfaB\x1ar\x18(@2\x11^[a-zA-Z_]{1,64}$\xd0\x01\x01R\n" +
 	"permission\x12*\n" +
 	"\acontext\x18\x05 \x01(\v2\x10.base.v1.ContextR\acontext\x12/\n" +
-	"\targuments\x18\x06 \x03(\v2\x11.base.v1.ArgumentR\targuments\"\xd6\x01\n" +
+	"\targuments\x18\x06 \x03(\v2\x11.base.v1.ArgumentR\targuments\"\x91\x05\n" +
 	"\x1fPermissionExpandRequestMetadata\x12&\n" +
 	"\x0eschema_version\x18\x01 \x01(\tR\x0eschema_version\x12\x8a\x01\n" +
 	"\n" +
 	"snap_token\x18\x02 \x01(\tBj\x92Ag2eThe snap token to avoid stale cache, see more details on [Snap Tokens](../../operations/snap-tokens).R\n" +
-	"snap_token\"?\n" +
+	"snap_token\x12\x1e\n" +
+	"\n" +
+	"schema_tag\x18\x03 \x01(\tR\n" +
+	"schema_tag\x12\xcf\x01\n" +
+	"\aat_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x98\x01\x92A\x94\x012\x91\x01Time to read the data at when snap_token is not set, see more details on [Point-in-Time Reads](../../operations/snap-tokens#point-in-time-reads).R\aat_time\x12\xc6\x01\n" +
+	"\vconsistency\x18\x05 \x01(\v2\x14.base.v1.ConsistencyB\x8d\x01\x92A\x89\x012\x86\x01Consistency of the snapshot the expansion is answered at, see more details on [Consistency](../../operations/snap-tokens#consistency).R\vconsistency\"?\n" +
 	"\x18PermissionExpandResponse\x12#\n" +
-	"\x04tree\x18\x01 \x01(\v2\x0f.base.v1.ExpandR\x04tree\"\x81\a\n" +
+	"\x04tree\x18\x01 \x01(\v2\x0f.base.v1.ExpandR\x04tree\"\xc8\a\n" +
 	"\x1dPermissionLookupEntityRequest\x12\xaa\x02\n" +
 	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x12T\n" +
 	"\bmetadata\x18\x02 \x01(\v2..base.v1.PermissionLookupEntityRequestMetadataB\b\xfaB\x05\x8a\x01\x02\x10\x01R\bmetadata\x12?\n" +
@@ -3962,22 +7870,33 @@ const file_base_v1_service_proto_rawDesc = "" +
 	"\acontext\x18\x06 \x01(\v2\x10.base.v1.ContextR\acontext\x12G\n" +
 	"\x05scope\x18\a \x03(\v21.base.v1.PermissionLookupEntityRequest.ScopeEntryR\x05scope\x12'\n" +
 	"\tpage_size\x18\b \x01(\rB\t\xfaB\x06*\x04(\x01@\x01R\tpage_size\x124\n" +
-	"\x10continuous_token\x18\t \x01(\tB\b\xfaB\x05r\x03\xd0\x01\x01R\x10continuous_token\x1aS\n" +
+	"\x10continuous_token\x18\t \x01(\tB\b\xfaB\x05r\x03\xd0\x01\x01R\x10continuous_token\x12E\n" +
+	"\n" +
+	"predicates\x18\n" +
+	" \x03(\v2\x1b.base.v1.AttributePredicateB\b\xfaB\x05\x92\x01\x02\x10\n" +
+	"R\n" +
+	"predicates\x1aS\n" +
 	"\n" +
 	"ScopeEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
-	"\x05value\x18\x02 \x01(\v2\x19.base.v1.StringArrayValueR\x05value:\x028\x01\"\xbb\x02\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.base.v1.StringArrayValueR\x05value:\x028\x01\"\xf3\x05\n" +
 	"%PermissionLookupEntityRequestMetadata\x12&\n" +
 	"\x0eschema_version\x18\x01 \x01(\tR\x0eschema_version\x12\x8a\x01\n" +
 	"\n" +
 	"snap_token\x18\x02 \x01(\tBj\x92Ag2eThe snap token to avoid stale cache, see more details on [Snap Tokens](../../operations/snap-tokens).R\n" +
 	"snap_token\x12]\n" +
-	"\x05depth\x18\x03 \x01(\x05BG\x92A=2;Query limit when if recursive database queries got in loop.\xfaB\x04\x1a\x02(\x03R\x05depth\"l\n" +
+	"\x05depth\x18\x03 \x01(\x05BG\x92A=2;Query limit when if recursive database queries got in loop.\xfaB\x04\x1a\x02(\x03R\x05depth\x12\x1e\n" +
+	"\n" +
+	"schema_tag\x18\x04 \x01(\tR\n" +
+	"schema_tag\x12\xcf\x01\n" +
+	"\aat_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x98\x01\x92A\x94\x012\x91\x01Time to read the data at when snap_token is not set, see more details on [Point-in-Time Reads](../../operations/snap-tokens#point-in-time-reads).R\aat_time\x12\xc3\x01\n" +
+	"\vconsistency\x18\x06 \x01(\v2\x14.base.v1.ConsistencyB\x8a\x01\x92A\x86\x012\x83\x01Consistency of the snapshot the lookup is answered at, see more details on [Consistency](../../operations/snap-tokens#consistency).R\vconsistency\"\x88\x01\n" +
 	"\x1ePermissionLookupEntityResponse\x12\x1e\n" +
 	"\n" +
 	"entity_ids\x18\x01 \x03(\tR\n" +
 	"entity_ids\x12*\n" +
-	"\x10continuous_token\x18\x02 \x01(\tR\x10continuous_token\"p\n" +
+	"\x10continuous_token\x18\x02 \x01(\tR\x10continuous_token\x12\x1a\n" +
+	"\bwarnings\x18\x03 \x03(\tR\bwarnings\"p\n" +
 	"$PermissionLookupEntityStreamResponse\x12\x1c\n" +
 	"\tentity_id\x18\x01 \x01(\tR\tentity_id\x12*\n" +
 	"\x10continuous_token\x18\x02 \x01(\tR\x10continuous_token\"\xe9\x05\n" +
@@ -3998,7 +7917,7 @@ const file_base_v1_service_proto_rawDesc = "" +
 	"\n" +
 	"snap_token\x18\x02 \x01(\tBj\x92Ag2eThe snap token to avoid stale cache, see more details on [Snap Tokens](../../operations/snap-tokens).R\n" +
 	"snap_token\x12]\n" +
-	"\x05depth\x18\x03 \x01(\x05BG\x92A=2;Query limit when if recursive database queries got in loop.\xfaB\x04\x1a\x02(\x03R\x05depth\"\xa6\x06\n" +
+	"\x05depth\x18\x03 \x01(\x05BG\x92A=2;Query limit when if recursive database queries got in loop.\xfaB\x04\x1a\x02(\x03R\x05depth\"\xed\x06\n" +
 	"\x1ePermissionLookupSubjectRequest\x12\xaa\x02\n" +
 	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x12U\n" +
 	"\bmetadata\x18\x02 \x01(\v2/.base.v1.PermissionLookupSubjectRequestMetadataB\b\xfaB\x05\x8a\x01\x02\x10\x01R\bmetadata\x121\n" +
@@ -4010,44 +7929,110 @@ const file_base_v1_service_proto_rawDesc = "" +
 	"\acontext\x18\x06 \x01(\v2\x10.base.v1.ContextR\acontext\x12/\n" +
 	"\targuments\x18\a \x03(\v2\x11.base.v1.ArgumentR\targuments\x12'\n" +
 	"\tpage_size\x18\b \x01(\rB\t\xfaB\x06*\x04(\x01@\x01R\tpage_size\x124\n" +
-	"\x10continuous_token\x18\t \x01(\tB\b\xfaB\x05r\x03\xd0\x01\x01R\x10continuous_token\"\xbc\x02\n" +
+	"\x10continuous_token\x18\t \x01(\tB\b\xfaB\x05r\x03\xd0\x01\x01R\x10continuous_token\x12E\n" +
+	"\n" +
+	"predicates\x18\n" +
+	" \x03(\v2\x1b.base.v1.AttributePredicateB\b\xfaB\x05\x92\x01\x02\x10\n" +
+	"R\n" +
+	"predicates\"\xf4\x05\n" +
 	"&PermissionLookupSubjectRequestMetadata\x12&\n" +
 	"\x0eschema_version\x18\x01 \x01(\tR\x0eschema_version\x12\x8a\x01\n" +
 	"\n" +
 	"snap_token\x18\x02 \x01(\tBj\x92Ag2eThe snap token to avoid stale cache, see more details on [Snap Tokens](../../operations/snap-tokens).R\n" +
 	"snap_token\x12]\n" +
-	"\x05depth\x18\x03 \x01(\x05BG\x92A=2;Query limit when if recursive database queries got in loop.\xfaB\x04\x1a\x02(\x03R\x05depth\"o\n" +
+	"\x05depth\x18\x03 \x01(\x05BG\x92A=2;Query limit when if recursive database queries got in loop.\xfaB\x04\x1a\x02(\x03R\x05depth\x12\x1e\n" +
+	"\n" +
+	"schema_tag\x18\x04 \x01(\tR\n" +
+	"schema_tag\x12\xcf\x01\n" +
+	"\aat_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x98\x01\x92A\x94\x012\x91\x01Time to read the data at when snap_token is not set, see more details on [Point-in-Time Reads](../../operations/snap-tokens#point-in-time-reads).R\aat_time\x12\xc3\x01\n" +
+	"\vconsistency\x18\x06 \x01(\v2\x14.base.v1.ConsistencyB\x8a\x01\x92A\x86\x012\x83\x01Consistency of the snapshot the lookup is answered at, see more details on [Consistency](../../operations/snap-tokens#consistency).R\vconsistency\"\x8b\x01\n" +
 	"\x1fPermissionLookupSubjectResponse\x12 \n" +
 	"\vsubject_ids\x18\x01 \x03(\tR\vsubject_ids\x12*\n" +
-	"\x10continuous_token\x18\x02 \x01(\tR\x10continuous_token\"\xc1\x04\n" +
+	"\x10continuous_token\x18\x02 \x01(\tR\x10continuous_token\x12\x1a\n" +
+	"\bwarnings\x18\x03 \x03(\tR\bwarnings\"\xc1\x04\n" +
 	"\"PermissionSubjectPermissionRequest\x12\xaa\x02\n" +
 	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x12Y\n" +
 	"\bmetadata\x18\x02 \x01(\v23.base.v1.PermissionSubjectPermissionRequestMetadataB\b\xfaB\x05\x8a\x01\x02\x10\x01R\bmetadata\x121\n" +
 	"\x06entity\x18\x03 \x01(\v2\x0f.base.v1.EntityB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06entity\x124\n" +
 	"\asubject\x18\x04 \x01(\v2\x10.base.v1.SubjectB\b\xfaB\x05\x8a\x01\x02\x10\x01R\asubject\x12*\n" +
-	"\acontext\x18\x05 \x01(\v2\x10.base.v1.ContextR\acontext\"\xea\x02\n" +
+	"\acontext\x18\x05 \x01(\v2\x10.base.v1.ContextR\acontext\"\xd0\x04\n" +
 	"*PermissionSubjectPermissionRequestMetadata\x12&\n" +
 	"\x0eschema_version\x18\x01 \x01(\tR\x0eschema_version\x12\x8a\x01\n" +
 	"\n" +
 	"snap_token\x18\x02 \x01(\tBj\x92Ag2eThe snap token to avoid stale cache, see more details on [Snap Tokens](../../operations/snap-tokens).R\n" +
 	"snap_token\x12(\n" +
 	"\x0fonly_permission\x18\x03 \x01(\bR\x0fonly_permission\x12]\n" +
-	"\x05depth\x18\x04 \x01(\x05BG\x92A=2;Query limit when if recursive database queries got in loop.\xfaB\x04\x1a\x02(\x03R\x05depth\"\xcc\x01\n" +
+	"\x05depth\x18\x04 \x01(\x05BG\x92A=2;Query limit when if recursive database queries got in loop.\xfaB\x04\x1a\x02(\x03R\x05depth\x12\x1e\n" +
+	"\n" +
+	"schema_tag\x18\x05 \x01(\tR\n" +
+	"schema_tag\x12\xc3\x01\n" +
+	"\vconsistency\x18\x06 \x01(\v2\x14.base.v1.ConsistencyB\x8a\x01\x92A\x86\x012\x83\x01Consistency of the snapshot the checks is answered at, see more details on [Consistency](../../operations/snap-tokens#consistency).R\vconsistency\"\xcc\x01\n" +
 	"#PermissionSubjectPermissionResponse\x12S\n" +
 	"\aresults\x18\x01 \x03(\v29.base.v1.PermissionSubjectPermissionResponse.ResultsEntryR\aresults\x1aP\n" +
 	"\fResultsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
-	"\x05value\x18\x02 \x01(\x0e2\x14.base.v1.CheckResultR\x05value:\x028\x01\"\xc8\x03\n" +
+	"\x05value\x18\x02 \x01(\x0e2\x14.base.v1.CheckResultR\x05value:\x028\x01\"\xaa\x06\n" +
+	"#PermissionLookupEntitlementsRequest\x12\xaa\x02\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x12Z\n" +
+	"\bmetadata\x18\x02 \x01(\v24.base.v1.PermissionLookupEntitlementsRequestMetadataB\b\xfaB\x05\x8a\x01\x02\x10\x01R\bmetadata\x124\n" +
+	"\asubject\x18\x03 \x01(\v2\x10.base.v1.SubjectB\b\xfaB\x05\x8a\x01\x02\x10\x01R\asubject\x12E\n" +
+	"\fentity_types\x18\x04 \x03(\tB!\xfaB\x1e\x92\x01\x1b\x10d\"\x17r\x15(@2\x11^[a-zA-Z_]{1,64}$R\fentity_types\x12C\n" +
+	"\vpermissions\x18\x05 \x03(\tB!\xfaB\x1e\x92\x01\x1b\x10d\"\x17r\x15(@2\x11^[a-zA-Z_]{1,64}$R\vpermissions\x12*\n" +
+	"\acontext\x18\x06 \x01(\v2\x10.base.v1.ContextR\acontext\x12'\n" +
+	"\tpage_size\x18\a \x01(\rB\t\xfaB\x06*\x04(\x01@\x01R\tpage_size\x124\n" +
+	"\x10continuous_token\x18\b \x01(\tB\b\xfaB\x05r\x03\xd0\x01\x01R\x10continuous_token\x12-\n" +
+	"\n" +
+	"cost_limit\x18\t \x01(\rB\r\xfaB\n" +
+	"*\b\x18\xa0\x8d\x06(\x01@\x01R\n" +
+	"cost_limit\"\xa7\x04\n" +
+	"+PermissionLookupEntitlementsRequestMetadata\x12&\n" +
+	"\x0eschema_version\x18\x01 \x01(\tR\x0eschema_version\x12\x8a\x01\n" +
+	"\n" +
+	"snap_token\x18\x02 \x01(\tBj\x92Ag2eThe snap token to avoid stale cache, see more details on [Snap Tokens](../../operations/snap-tokens).R\n" +
+	"snap_token\x12]\n" +
+	"\x05depth\x18\x03 \x01(\x05BG\x92A=2;Query limit when if recursive database queries got in loop.\xfaB\x04\x1a\x02(\x03R\x05depth\x12\x1e\n" +
+	"\n" +
+	"schema_tag\x18\x04 \x01(\tR\n" +
+	"schema_tag\x12\xc3\x01\n" +
+	"\vconsistency\x18\x05 \x01(\v2\x14.base.v1.ConsistencyB\x8a\x01\x92A\x86\x012\x83\x01Consistency of the snapshot the lookup is answered at, see more details on [Consistency](../../operations/snap-tokens#consistency).R\vconsistency\"\xb8\x01\n" +
+	"*PermissionLookupEntitlementsStreamResponse\x12 \n" +
+	"\ventity_type\x18\x01 \x01(\tR\ventity_type\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x02 \x01(\tR\n" +
+	"permission\x12\x1c\n" +
+	"\tentity_id\x18\x03 \x01(\tR\tentity_id\x12*\n" +
+	"\x10continuous_token\x18\x04 \x01(\tR\x10continuous_token\"\xfb\x05\n" +
+	"\x19PermissionSimulateRequest\x12\xaa\x02\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x12>\n" +
+	"\achanges\x18\x02 \x01(\v2\x1a.base.v1.SimulationChangesB\b\xfaB\x05\x8a\x01\x02\x10\x01R\achanges\x12A\n" +
+	"\x05check\x18\x03 \x01(\v2\x1f.base.v1.PermissionCheckRequestB\b\xfaB\x05\x8a\x01\x02\b\x01H\x00R\x05check\x12X\n" +
+	"\rlookup_entity\x18\x04 \x01(\v2&.base.v1.PermissionLookupEntityRequestB\b\xfaB\x05\x8a\x01\x02\b\x01H\x00R\rlookup_entity\x12[\n" +
+	"\x0elookup_subject\x18\x05 \x01(\v2'.base.v1.PermissionLookupSubjectRequestB\b\xfaB\x05\x8a\x01\x02\b\x01H\x00R\x0elookup_subject\x12g\n" +
+	"\x12subject_permission\x18\x06 \x01(\v2+.base.v1.PermissionSubjectPermissionRequestB\b\xfaB\x05\x8a\x01\x02\b\x01H\x00R\x12subject_permissionB\x0e\n" +
+	"\arequest\x12\x03\xf8B\x01\"\xff\x01\n" +
+	"\x11SimulationChanges\x122\n" +
+	"\fwrite_tuples\x18\x01 \x03(\v2\x0e.base.v1.TupleR\fwrite_tuples\x12>\n" +
+	"\x10write_attributes\x18\x02 \x03(\v2\x12.base.v1.AttributeR\x10write_attributes\x124\n" +
+	"\rdelete_tuples\x18\x03 \x03(\v2\x0e.base.v1.TupleR\rdelete_tuples\x12@\n" +
+	"\x11delete_attributes\x18\x04 \x03(\v2\x12.base.v1.AttributeR\x11delete_attributes\"\x90\x01\n" +
+	"\x1aPermissionSimulateResponse\x12\x16\n" +
+	"\x06gained\x18\x01 \x03(\tR\x06gained\x12\x12\n" +
+	"\x04lost\x18\x02 \x03(\tR\x04lost\x12\x1e\n" +
+	"\n" +
+	"snap_token\x18\x03 \x01(\tR\n" +
+	"snap_token\x12&\n" +
+	"\x0eschema_version\x18\x04 \x01(\tR\x0eschema_version\"\xc8\x03\n" +
 	"\fWatchRequest\x12\xaa\x02\n" +
 	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x12\x8a\x01\n" +
 	"\n" +
 	"snap_token\x18\x02 \x01(\tBj\x92Ag2eThe snap token to avoid stale cache, see more details on [Snap Tokens](../../operations/snap-tokens).R\n" +
 	"snap_token\"?\n" +
 	"\rWatchResponse\x12.\n" +
-	"\achanges\x18\x01 \x01(\v2\x14.base.v1.DataChangesR\achanges\"\xd9\x02\n" +
+	"\achanges\x18\x01 \x01(\v2\x14.base.v1.DataChangesR\achanges\"\x93\x03\n" +
 	"\x12SchemaWriteRequest\x12\xaa\x02\n" +
 	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x12\x16\n" +
-	"\x06schema\x18\x02 \x01(\tR\x06schema\"=\n" +
+	"\x06schema\x18\x02 \x01(\tR\x06schema\x128\n" +
+	"\bmetadata\x18\x03 \x01(\v2\x1c.base.v1.TransactionMetadataR\bmetadata\"=\n" +
 	"\x13SchemaWriteResponse\x12&\n" +
 	"\x0eschema_version\x18\x01 \x01(\tR\x0eschema_version\"\xd9\x02\n" +
 	"\x19SchemaPartialWriteRequest\x12L\n" +
@@ -4063,9 +8048,12 @@ const file_base_v1_service_proto_rawDesc = "" +
 	"\x0eschema_version\x18\x01 \x01(\tR\x0eschema_version\"\x8a\x03\n" +
 	"\x11SchemaReadRequest\x12\xaa\x02\n" +
 	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x12H\n" +
-	"\bmetadata\x18\x02 \x01(\v2\".base.v1.SchemaReadRequestMetadataB\b\xfaB\x05\x8a\x01\x02\x10\x01R\bmetadata\"C\n" +
+	"\bmetadata\x18\x02 \x01(\v2\".base.v1.SchemaReadRequestMetadataB\b\xfaB\x05\x8a\x01\x02\x10\x01R\bmetadata\"c\n" +
 	"\x19SchemaReadRequestMetadata\x12&\n" +
-	"\x0eschema_version\x18\x01 \x01(\tR\x0eschema_version\"G\n" +
+	"\x0eschema_version\x18\x01 \x01(\tR\x0eschema_version\x12\x1e\n" +
+	"\n" +
+	"schema_tag\x18\x02 \x01(\tR\n" +
+	"schema_tag\"G\n" +
 	"\x12SchemaReadResponse\x121\n" +
 	"\x06schema\x18\x01 \x01(\v2\x19.base.v1.SchemaDefinitionR\x06schema\"\x9f\x03\n" +
 	"\x11SchemaListRequest\x12\xaa\x02\n" +
@@ -4075,46 +8063,151 @@ const file_base_v1_service_proto_rawDesc = "" +
 	"\x12SchemaListResponse\x12\x12\n" +
 	"\x04head\x18\x01 \x01(\tR\x04head\x12-\n" +
 	"\aschemas\x18\x02 \x03(\v2\x13.base.v1.SchemaListR\aschemas\x12*\n" +
-	"\x10continuous_token\x18\x03 \x01(\tR\x10continuous_token\"F\n" +
+	"\x10continuous_token\x18\x03 \x01(\tR\x10continuous_token\"\xac\x01\n" +
 	"\n" +
 	"SchemaList\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\tR\aversion\x12\x1e\n" +
 	"\n" +
 	"created_at\x18\x02 \x01(\tR\n" +
-	"created_at\"\x86\x04\n" +
+	"created_at\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12\x16\n" +
+	"\x06active\x18\x04 \x01(\bR\x06active\x128\n" +
+	"\bmetadata\x18\x05 \x01(\v2\x1c.base.v1.TransactionMetadataR\bmetadata\"\xd8\x02\n" +
+	"\x11SchemaLintRequest\x12\xaa\x02\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x12\x16\n" +
+	"\x06schema\x18\x02 \x01(\tR\x06schema\"L\n" +
+	"\x12SchemaLintResponse\x126\n" +
+	"\bfindings\x18\x01 \x03(\v2\x1a.base.v1.SchemaLintFindingR\bfindings\"\x91\x02\n" +
+	"\x11SchemaLintFinding\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x12?\n" +
+	"\bseverity\x18\x02 \x01(\x0e2#.base.v1.SchemaLintFinding.SeverityR\bseverity\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x12\n" +
+	"\x04line\x18\x04 \x01(\x05R\x04line\x12\x16\n" +
+	"\x06column\x18\x05 \x01(\x05R\x06column\"a\n" +
+	"\bSeverity\x12\x18\n" +
+	"\x14SEVERITY_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rSEVERITY_INFO\x10\x01\x12\x14\n" +
+	"\x10SEVERITY_WARNING\x10\x02\x12\x12\n" +
+	"\x0eSEVERITY_ERROR\x10\x03\"\x92\x01\n" +
+	"\fSchemaShadow\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12&\n" +
+	"\x0eserved_version\x18\x02 \x01(\tR\x0eserved_version\x12 \n" +
+	"\vsample_rate\x18\x03 \x01(\x01R\vsample_rate\x12\x1e\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\n" +
+	"created_at\"\x9a\x03\n" +
+	"\x18SchemaShadowWriteRequest\x12\xaa\x02\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x12\x16\n" +
+	"\x06schema\x18\x02 \x01(\tR\x06schema\x129\n" +
+	"\vsample_rate\x18\x03 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\vsample_rate\"J\n" +
+	"\x19SchemaShadowWriteResponse\x12-\n" +
+	"\x06shadow\x18\x01 \x01(\v2\x15.base.v1.SchemaShadowR\x06shadow\"\xc6\x02\n" +
+	"\x17SchemaShadowReadRequest\x12\xaa\x02\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\"I\n" +
+	"\x18SchemaShadowReadResponse\x12-\n" +
+	"\x06shadow\x18\x01 \x01(\v2\x15.base.v1.SchemaShadowR\x06shadow\"\xc9\x02\n" +
+	"\x1aSchemaShadowPromoteRequest\x12\xaa\x02\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\"q\n" +
+	"\x1bSchemaShadowPromoteResponse\x12&\n" +
+	"\x0eschema_version\x18\x01 \x01(\tR\x0eschema_version\x12*\n" +
+	"\x10previous_version\x18\x02 \x01(\tR\x10previous_version\"\xca\x02\n" +
+	"\x1bSchemaShadowRollbackRequest\x12\xaa\x02\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\"F\n" +
+	"\x1cSchemaShadowRollbackResponse\x12&\n" +
+	"\x0eschema_version\x18\x01 \x01(\tR\x0eschema_version\"\xab\x03\n" +
+	"\x10SchemaTagRequest\x12\xaa\x02\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x122\n" +
+	"\x0eschema_version\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01(\x80\x01R\x0eschema_version\x126\n" +
+	"\x03tag\x18\x03 \x01(\tB$\xfaB!r\x1f(@2\x18^[a-zA-Z0-9_\\-\\.]{1,64}$\xd0\x01\x00R\x03tag\"g\n" +
+	"\x11SchemaTagResponse\x12&\n" +
+	"\x0eschema_version\x18\x01 \x01(\tR\x0eschema_version\x12*\n" +
+	"\x10previous_version\x18\x02 \x01(\tR\x10previous_version\"\xf9\x02\n" +
+	"\x12SchemaUntagRequest\x12\xaa\x02\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x126\n" +
+	"\x03tag\x18\x02 \x01(\tB$\xfaB!r\x1f(@2\x18^[a-zA-Z0-9_\\-\\.]{1,64}$\xd0\x01\x00R\x03tag\"=\n" +
+	"\x13SchemaUntagResponse\x12&\n" +
+	"\x0eschema_version\x18\x01 \x01(\tR\x0eschema_version\"\x8c\x03\n" +
+	"\x15SchemaActivateRequest\x12\xaa\x02\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x12&\n" +
+	"\x0eschema_version\x18\x02 \x01(\tR\x0eschema_version\x12\x1e\n" +
+	"\n" +
+	"schema_tag\x18\x03 \x01(\tR\n" +
+	"schema_tag\"l\n" +
+	"\x16SchemaActivateResponse\x12&\n" +
+	"\x0eschema_version\x18\x01 \x01(\tR\x0eschema_version\x12*\n" +
+	"\x10previous_version\x18\x02 \x01(\tR\x10previous_version\"\xd4\x04\n" +
 	"\x10DataWriteRequest\x12\xaa\x02\n" +
 	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x12G\n" +
 	"\bmetadata\x18\x02 \x01(\v2!.base.v1.DataWriteRequestMetadataB\b\xfaB\x05\x8a\x01\x02\x10\x01R\bmetadata\x127\n" +
 	"\x06tuples\x18\x03 \x03(\v2\x0e.base.v1.TupleB\x0f\xfaB\f\x92\x01\t\b\x00\"\x05\x8a\x01\x02\x10\x01R\x06tuples\x12C\n" +
 	"\n" +
 	"attributes\x18\x04 \x03(\v2\x12.base.v1.AttributeB\x0f\xfaB\f\x92\x01\t\b\x00\"\x05\x8a\x01\x02\x10\x01R\n" +
-	"attributes\"B\n" +
+	"attributes\x12L\n" +
+	"\rpreconditions\x18\x05 \x03(\v2\x15.base.v1.PreconditionB\x0f\xfaB\f\x92\x01\t\x10d\"\x05\x8a\x01\x02\x10\x01R\rpreconditions\"\xb0\x01\n" +
 	"\x18DataWriteRequestMetadata\x12&\n" +
-	"\x0eschema_version\x18\x01 \x01(\tR\x0eschema_version\"\xa0\x01\n" +
+	"\x0eschema_version\x18\x01 \x01(\tR\x0eschema_version\x122\n" +
+	"\x0fidempotency_key\x18\x02 \x01(\tB\b\xfaB\x05r\x03(\x80\x01R\x0fidempotency_key\x128\n" +
+	"\bmetadata\x18\x03 \x01(\v2\x1c.base.v1.TransactionMetadataR\bmetadata\"\xbc\x01\n" +
 	"\x11DataWriteResponse\x12\x8a\x01\n" +
 	"\n" +
 	"snap_token\x18\x01 \x01(\tBj\x92Ag2eThe snap token to avoid stale cache, see more details on [Snap Tokens](../../operations/snap-tokens).R\n" +
-	"snap_token\"\xd1\x03\n" +
+	"snap_token\x12\x1a\n" +
+	"\bwarnings\x18\x02 \x03(\tR\bwarnings\"\x9b\x02\n" +
+	"\x11DataImportRequest\x12L\n" +
+	"\ttenant_id\x18\x01 \x01(\tB.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x12>\n" +
+	"\bmetadata\x18\x02 \x01(\v2\".base.v1.DataImportRequestMetadataR\bmetadata\x125\n" +
+	"\x06tuples\x18\x03 \x03(\v2\x0e.base.v1.TupleB\r\xfaB\n" +
+	"\x92\x01\a\"\x05\x8a\x01\x02\b\x01R\x06tuples\x12A\n" +
+	"\n" +
+	"attributes\x18\x04 \x03(\v2\x12.base.v1.AttributeB\r\xfaB\n" +
+	"\x92\x01\a\"\x05\x8a\x01\x02\b\x01R\n" +
+	"attributes\"m\n" +
+	"\x19DataImportRequestMetadata\x12&\n" +
+	"\x0eschema_version\x18\x01 \x01(\tR\x0eschema_version\x12(\n" +
+	"\x0fcommit_interval\x18\x02 \x01(\rR\x0fcommit_interval\"[\n" +
+	"\x13DataImportRejection\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\x04R\bposition\x12\x10\n" +
+	"\x03row\x18\x02 \x01(\tR\x03row\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xc0\x02\n" +
+	"\x12DataImportResponse\x12\x1e\n" +
+	"\n" +
+	"snap_token\x18\x01 \x01(\tR\n" +
+	"snap_token\x12\x16\n" +
+	"\x06tuples\x18\x02 \x01(\x04R\x06tuples\x12\x1e\n" +
+	"\n" +
+	"attributes\x18\x03 \x01(\x04R\n" +
+	"attributes\x12\x1a\n" +
+	"\brejected\x18\x04 \x01(\x04R\brejected\x12<\n" +
+	"\n" +
+	"rejections\x18\x05 \x03(\v2\x1c.base.v1.DataImportRejectionR\n" +
+	"rejections\x12\x18\n" +
+	"\acommits\x18\x06 \x01(\x04R\acommits\x12*\n" +
+	"\x10committed_tuples\x18\a \x01(\x04R\x10committed_tuples\x122\n" +
+	"\x14committed_attributes\x18\b \x01(\x04R\x14committed_attributes\"\xd1\x03\n" +
 	"\x18RelationshipWriteRequest\x12\xaa\x02\n" +
 	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x12O\n" +
 	"\bmetadata\x18\x02 \x01(\v2).base.v1.RelationshipWriteRequestMetadataB\b\xfaB\x05\x8a\x01\x02\x10\x01R\bmetadata\x127\n" +
 	"\x06tuples\x18\x03 \x03(\v2\x0e.base.v1.TupleB\x0f\xfaB\f\x92\x01\t\b\x01\"\x05\x8a\x01\x02\x10\x01R\x06tuples\"J\n" +
 	" RelationshipWriteRequestMetadata\x12&\n" +
-	"\x0eschema_version\x18\x01 \x01(\tR\x0eschema_version\"\xa7\x01\n" +
+	"\x0eschema_version\x18\x01 \x01(\tR\x0eschema_version\"\xc3\x01\n" +
 	"\x19RelationshipWriteResponse\x12\x89\x01\n" +
 	"\n" +
 	"snap_token\x18\x01 \x01(\tBi\x92Af2dThe snap token to avoid stale cache, see more details on [Snap Tokens](../../operations/snap-tokens)R\n" +
-	"snap_token\"\xad\x04\n" +
+	"snap_token\x12\x1a\n" +
+	"\bwarnings\x18\x02 \x03(\tR\bwarnings\"\xad\x04\n" +
 	"\x17RelationshipReadRequest\x12\xaa\x02\n" +
 	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x12N\n" +
 	"\bmetadata\x18\x02 \x01(\v2(.base.v1.RelationshipReadRequestMetadataB\b\xfaB\x05\x8a\x01\x02\x10\x01R\bmetadata\x126\n" +
 	"\x06filter\x18\x03 \x01(\v2\x14.base.v1.TupleFilterB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06filter\x12'\n" +
 	"\tpage_size\x18\x04 \x01(\rB\t\xfaB\x06*\x04(\x01@\x01R\tpage_size\x124\n" +
-	"\x10continuous_token\x18\x05 \x01(\tB\b\xfaB\x05r\x03\xd0\x01\x01R\x10continuous_token\"\xad\x01\n" +
+	"\x10continuous_token\x18\x05 \x01(\tB\b\xfaB\x05r\x03\xd0\x01\x01R\x10continuous_token\"\xc3\x04\n" +
 	"\x1fRelationshipReadRequestMetadata\x12\x89\x01\n" +
 	"\n" +
 	"snap_token\x18\x01 \x01(\tBi\x92Af2dThe snap token to avoid stale cache, see more details on [Snap Tokens](../../operations/snap-tokens)R\n" +
-	"snap_token\"n\n" +
+	"snap_token\x12\xcf\x01\n" +
+	"\aat_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x98\x01\x92A\x94\x012\x91\x01Time to read the data at when snap_token is not set, see more details on [Point-in-Time Reads](../../operations/snap-tokens#point-in-time-reads).R\aat_time\x12\xc1\x01\n" +
+	"\vconsistency\x18\x03 \x01(\v2\x14.base.v1.ConsistencyB\x88\x01\x92A\x84\x012\x81\x01Consistency of the snapshot the read is answered at, see more details on [Consistency](../../operations/snap-tokens#consistency).R\vconsistency\"n\n" +
 	"\x18RelationshipReadResponse\x12&\n" +
 	"\x06tuples\x18\x01 \x03(\v2\x0e.base.v1.TupleR\x06tuples\x12*\n" +
 	"\x10continuous_token\x18\x02 \x01(\tR\x10continuous_token\"\xab\x04\n" +
@@ -4123,20 +8216,118 @@ const file_base_v1_service_proto_rawDesc = "" +
 	"\bmetadata\x18\x02 \x01(\v2%.base.v1.AttributeReadRequestMetadataB\b\xfaB\x05\x8a\x01\x02\x10\x01R\bmetadata\x12:\n" +
 	"\x06filter\x18\x03 \x01(\v2\x18.base.v1.AttributeFilterB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06filter\x12'\n" +
 	"\tpage_size\x18\x04 \x01(\rB\t\xfaB\x06*\x04(\x01@\x01R\tpage_size\x124\n" +
-	"\x10continuous_token\x18\x05 \x01(\tB\b\xfaB\x05r\x03\xd0\x01\x01R\x10continuous_token\"\xaa\x01\n" +
+	"\x10continuous_token\x18\x05 \x01(\tB\b\xfaB\x05r\x03\xd0\x01\x01R\x10continuous_token\"\xee\x02\n" +
 	"\x1cAttributeReadRequestMetadata\x12\x89\x01\n" +
 	"\n" +
 	"snap_token\x18\x01 \x01(\tBi\x92Af2dThe snap token to avoid stale cache, see more details on [Snap Tokens](../../operations/snap-tokens)R\n" +
-	"snap_token\"w\n" +
+	"snap_token\x12\xc1\x01\n" +
+	"\vconsistency\x18\x02 \x01(\v2\x14.base.v1.ConsistencyB\x88\x01\x92A\x84\x012\x81\x01Consistency of the snapshot the read is answered at, see more details on [Consistency](../../operations/snap-tokens#consistency).R\vconsistency\"w\n" +
 	"\x15AttributeReadResponse\x122\n" +
 	"\n" +
 	"attributes\x18\x01 \x03(\v2\x12.base.v1.AttributeR\n" +
 	"attributes\x12*\n" +
-	"\x10continuous_token\x18\x02 \x01(\tR\x10continuous_token\"\xd4\x03\n" +
+	"\x10continuous_token\x18\x02 \x01(\tR\x10continuous_token\"\xb7\x04\n" +
+	"\x16DataReadHistoryRequest\x12\xaa\x02\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x12:\n" +
+	"\ftuple_filter\x18\x02 \x01(\v2\x14.base.v1.TupleFilterH\x00R\ftuple_filter\x12F\n" +
+	"\x10attribute_filter\x18\x03 \x01(\v2\x18.base.v1.AttributeFilterH\x00R\x10attribute_filter\x12'\n" +
+	"\tpage_size\x18\x04 \x01(\rB\t\xfaB\x06*\x04(\x01@\x01R\tpage_size\x124\n" +
+	"\x10continuous_token\x18\x05 \x01(\tB\b\xfaB\x05r\x03\xd0\x01\x01R\x10continuous_tokenB\r\n" +
+	"\x06filter\x12\x03\xf8B\x01\"\xcf\x03\n" +
+	"\x11DataHistoryRecord\x12&\n" +
+	"\x05tuple\x18\x01 \x01(\v2\x0e.base.v1.TupleH\x00R\x05tuple\x122\n" +
+	"\tattribute\x18\x02 \x01(\v2\x12.base.v1.AttributeH\x00R\tattribute\x12$\n" +
+	"\rcreated_tx_id\x18\x03 \x01(\x04R\rcreated_tx_id\x12:\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12$\n" +
+	"\rexpired_tx_id\x18\x05 \x01(\x04R\rexpired_tx_id\x12:\n" +
+	"\n" +
+	"expired_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expired_at\x12H\n" +
+	"\x10created_metadata\x18\a \x01(\v2\x1c.base.v1.TransactionMetadataR\x10created_metadata\x12H\n" +
+	"\x10expired_metadata\x18\b \x01(\v2\x1c.base.v1.TransactionMetadataR\x10expired_metadataB\x06\n" +
+	"\x04item\"{\n" +
+	"\x17DataReadHistoryResponse\x124\n" +
+	"\arecords\x18\x01 \x03(\v2\x1a.base.v1.DataHistoryRecordR\arecords\x12*\n" +
+	"\x10continuous_token\x18\x02 \x01(\tR\x10continuous_token\"\xd2\x04\n" +
+	"\x11DataVerifyRequest\x12\xaa\x02\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x12H\n" +
+	"\bmetadata\x18\x02 \x01(\v2\".base.v1.DataVerifyRequestMetadataB\b\xfaB\x05\x8a\x01\x02\x10\x01R\bmetadata\x12*\n" +
+	"\tpage_size\x18\x03 \x01(\rB\f\xfaB\t*\a\x18\xe8\a(\x01@\x01R\tpage_size\x12;\n" +
+	"\x06action\x18\x04 \x01(\x0e2\x19.base.v1.DataVerifyActionB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06action\x12]\n" +
+	"\x14quarantine_tenant_id\x18\x05 \x01(\tB)\xfaB&r$(\x80\x012\x1c^[a-zA-Z0-9_\\-@\\.:+]{1,128}$\xd0\x01\x01R\x14quarantine_tenant_id\"\x9d\x01\n" +
+	"\x19DataVerifyRequestMetadata\x12&\n" +
+	"\x0eschema_version\x18\x01 \x01(\tR\x0eschema_version\x12\x1e\n" +
+	"\n" +
+	"snap_token\x18\x02 \x01(\tR\n" +
+	"snap_token\x128\n" +
+	"\bmetadata\x18\x03 \x01(\v2\x1c.base.v1.TransactionMetadataR\bmetadata\"\x8b\x01\n" +
+	"\rDataViolation\x12&\n" +
+	"\x05tuple\x18\x01 \x01(\v2\x0e.base.v1.TupleH\x00R\x05tuple\x122\n" +
+	"\tattribute\x18\x02 \x01(\v2\x12.base.v1.AttributeH\x00R\tattribute\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reasonB\x06\n" +
+	"\x04item\"\xdb\x01\n" +
+	"\x11DataVerifySummary\x12$\n" +
+	"\rrelationships\x18\x01 \x01(\x04R\rrelationships\x12\x1e\n" +
+	"\n" +
+	"attributes\x18\x02 \x01(\x04R\n" +
+	"attributes\x12\x1e\n" +
+	"\n" +
+	"violations\x18\x03 \x01(\x04R\n" +
+	"violations\x12\x18\n" +
+	"\aremoved\x18\x04 \x01(\x04R\aremoved\x12&\n" +
+	"\x0eschema_version\x18\x05 \x01(\tR\x0eschema_version\x12\x1e\n" +
+	"\n" +
+	"snap_token\x18\x06 \x01(\tR\n" +
+	"snap_token\"\x8e\x01\n" +
+	"\x12DataVerifyResponse\x126\n" +
+	"\tviolation\x18\x01 \x01(\v2\x16.base.v1.DataViolationH\x00R\tviolation\x126\n" +
+	"\asummary\x18\x02 \x01(\v2\x1a.base.v1.DataVerifySummaryH\x00R\asummaryB\b\n" +
+	"\x06result\"\xd6\x03\n" +
+	"\x12DataAnalyzeRequest\x12\xaa\x02\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x12I\n" +
+	"\bmetadata\x18\x02 \x01(\v2#.base.v1.DataAnalyzeRequestMetadataB\b\xfaB\x05\x8a\x01\x02\x10\x01R\bmetadata\x12*\n" +
+	"\tpage_size\x18\x03 \x01(\rB\f\xfaB\t*\a\x18\xe8\a(\x01@\x01R\tpage_size\x12\x1c\n" +
+	"\x03top\x18\x04 \x01(\rB\n" +
+	"\xfaB\a*\x05\x18\xe8\a@\x01R\x03top\"d\n" +
+	"\x1aDataAnalyzeRequestMetadata\x12&\n" +
+	"\x0eschema_version\x18\x01 \x01(\tR\x0eschema_version\x12\x1e\n" +
+	"\n" +
+	"snap_token\x18\x02 \x01(\tR\n" +
+	"snap_token\"+\n" +
+	"\tDataCycle\x12\x1e\n" +
+	"\n" +
+	"entity_ids\x18\x01 \x03(\tR\n" +
+	"entity_ids\"\xa9\x02\n" +
+	"\x15DataRecursiveRelation\x12 \n" +
+	"\ventity_type\x18\x01 \x01(\tR\ventity_type\x12\x1a\n" +
+	"\brelation\x18\x02 \x01(\tR\brelation\x12 \n" +
+	"\vpermissions\x18\x03 \x03(\tR\vpermissions\x12\x16\n" +
+	"\x06tuples\x18\x04 \x01(\x04R\x06tuples\x12\x1c\n" +
+	"\tmax_depth\x18\x05 \x01(\rR\tmax_depth\x12,\n" +
+	"\x11deepest_entity_id\x18\x06 \x01(\tR\x11deepest_entity_id\x12 \n" +
+	"\vcycle_count\x18\a \x01(\x04R\vcycle_count\x12*\n" +
+	"\x06cycles\x18\b \x03(\v2\x12.base.v1.DataCycleR\x06cycles\"h\n" +
+	"\vDataHotspot\x12'\n" +
+	"\x06entity\x18\x01 \x01(\v2\x0f.base.v1.EntityR\x06entity\x12\x1a\n" +
+	"\brelation\x18\x02 \x01(\tR\brelation\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x04R\x05count\"\xb3\x02\n" +
+	"\x13DataAnalyzeResponse\x12&\n" +
+	"\x0eschema_version\x18\x01 \x01(\tR\x0eschema_version\x12\x1e\n" +
+	"\n" +
+	"snap_token\x18\x02 \x01(\tR\n" +
+	"snap_token\x12$\n" +
+	"\rrelationships\x18\x03 \x01(\x04R\rrelationships\x12P\n" +
+	"\x13recursive_relations\x18\x04 \x03(\v2\x1e.base.v1.DataRecursiveRelationR\x13recursive_relations\x12.\n" +
+	"\afan_out\x18\x05 \x03(\v2\x14.base.v1.DataHotspotR\afan_out\x12,\n" +
+	"\x06fan_in\x18\x06 \x03(\v2\x14.base.v1.DataHotspotR\x06fan_in\"\xdc\x04\n" +
 	"\x11DataDeleteRequest\x12\xaa\x02\n" +
 	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x12B\n" +
 	"\ftuple_filter\x18\x02 \x01(\v2\x14.base.v1.TupleFilterB\b\xfaB\x05\x8a\x01\x02\x10\x01R\ftuple_filter\x12N\n" +
-	"\x10attribute_filter\x18\x03 \x01(\v2\x18.base.v1.AttributeFilterB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x10attribute_filter\"\xa0\x01\n" +
+	"\x10attribute_filter\x18\x03 \x01(\v2\x18.base.v1.AttributeFilterB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x10attribute_filter\x12L\n" +
+	"\rpreconditions\x18\x04 \x03(\v2\x15.base.v1.PreconditionB\x0f\xfaB\f\x92\x01\t\x10d\"\x05\x8a\x01\x02\x10\x01R\rpreconditions\x128\n" +
+	"\bmetadata\x18\x05 \x01(\v2\x1c.base.v1.TransactionMetadataR\bmetadata\"\xa0\x01\n" +
 	"\x12DataDeleteResponse\x12\x89\x01\n" +
 	"\n" +
 	"snap_token\x18\x01 \x01(\tBi\x92Af2dThe snap token to avoid stale cache, see more details on [Snap Tokens](../../operations/snap-tokens)R\n" +
@@ -4147,18 +8338,22 @@ const file_base_v1_service_proto_rawDesc = "" +
 	"\x1aRelationshipDeleteResponse\x12\x89\x01\n" +
 	"\n" +
 	"snap_token\x18\x01 \x01(\tBi\x92Af2dThe snap token to avoid stale cache, see more details on [Snap Tokens](../../operations/snap-tokens)R\n" +
-	"snap_token\"\xd9\x03\n" +
+	"snap_token\"\x95\x05\n" +
 	"\x10BundleRunRequest\x12\xaa\x02\n" +
 	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12F\n" +
-	"\targuments\x18\x03 \x03(\v2(.base.v1.BundleRunRequest.ArgumentsEntryR\targuments\x1a<\n" +
+	"\targuments\x18\x03 \x03(\v2(.base.v1.BundleRunRequest.ArgumentsEntryR\targuments\x122\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tB\b\xfaB\x05r\x03(\x80\x01R\x0fidempotency_key\x12L\n" +
+	"\rpreconditions\x18\x04 \x03(\v2\x15.base.v1.PreconditionB\x0f\xfaB\f\x92\x01\t\x10d\"\x05\x8a\x01\x02\x10\x01R\rpreconditions\x128\n" +
+	"\bmetadata\x18\x06 \x01(\v2\x1c.base.v1.TransactionMetadataR\bmetadata\x1a<\n" +
 	"\x0eArgumentsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9f\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbb\x01\n" +
 	"\x11BundleRunResponse\x12\x89\x01\n" +
 	"\n" +
 	"snap_token\x18\x01 \x01(\tBi\x92Af2dThe snap token to avoid stale cache, see more details on [Snap Tokens](../../operations/snap-tokens)R\n" +
-	"snap_token\"\xf0\x02\n" +
+	"snap_token\x12\x1a\n" +
+	"\bwarnings\x18\x02 \x03(\tR\bwarnings\"\xf0\x02\n" +
 	"\x12BundleWriteRequest\x12\xaa\x02\n" +
 	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x12-\n" +
 	"\abundles\x18\x02 \x03(\v2\x13.base.v1.DataBundleR\abundles\"+\n" +
@@ -4189,7 +8384,43 @@ const file_base_v1_service_proto_rawDesc = "" +
 	"\x10continuous_token\x18\x02 \x01(\tB\b\xfaB\x05r\x03\xd0\x01\x01R\x10continuous_token\"k\n" +
 	"\x12TenantListResponse\x12)\n" +
 	"\atenants\x18\x01 \x03(\v2\x0f.base.v1.TenantR\atenants\x12*\n" +
-	"\x10continuous_token\x18\x02 \x01(\tR\x10continuous_token2\xafN\n" +
+	"\x10continuous_token\x18\x02 \x01(\tR\x10continuous_token\"\xb3\x01\n" +
+	"\vAuditFilter\x12\x16\n" +
+	"\x06actors\x18\x01 \x03(\tR\x06actors\x12\x18\n" +
+	"\amethods\x18\x02 \x03(\tR\amethods\x12:\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"start_time\x126\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bend_time\"\xce\x03\n" +
+	"\x10AuditListRequest\x12\xaa\x02\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x12,\n" +
+	"\x06filter\x18\x02 \x01(\v2\x14.base.v1.AuditFilterR\x06filter\x12)\n" +
+	"\tpage_size\x18\x03 \x01(\rB\v\xfaB\b*\x06\x18d(\x01@\x01R\tpage_size\x124\n" +
+	"\x10continuous_token\x18\x04 \x01(\tB\b\xfaB\x05r\x03\xd0\x01\x01R\x10continuous_token\"o\n" +
+	"\x11AuditListResponse\x12.\n" +
+	"\arecords\x18\x01 \x03(\v2\x14.base.v1.AuditRecordR\arecords\x12*\n" +
+	"\x10continuous_token\x18\x02 \x01(\tR\x10continuous_token\"\xef\x04\n" +
+	"\x18AuditAccessReviewRequest\x12\xaa\x02\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\x8b\x02\x92A\xd9\x012\xd6\x01Identifier of the tenant, if you are not using multi-tenancy (have only one tenant) use pre-inserted tenant <code>t1</code> for this field. Required, and must match the pattern \\“[a-zA-Z0-9-,]+\\“, max 64 bytes.\xfaB+r)(\x80\x012!^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$\xd0\x01\x00R\ttenant_id\x12O\n" +
+	"\bmetadata\x18\x02 \x01(\v2).base.v1.AuditAccessReviewRequestMetadataB\b\xfaB\x05\x8a\x01\x02\x10\x01R\bmetadata\x12G\n" +
+	"\fentity_types\x18\x03 \x03(\tB#\xfaB \x92\x01\x1d\b\x01\x10d\"\x17r\x15(@2\x11^[a-zA-Z_]{1,64}$R\fentity_types\x12C\n" +
+	"\vpermissions\x18\x04 \x03(\tB!\xfaB\x1e\x92\x01\x1b\x10d\"\x17r\x15(@2\x11^[a-zA-Z_]{1,64}$R\vpermissions\x12G\n" +
+	"\rsubject_types\x18\x05 \x03(\tB!\xfaB\x1e\x92\x01\x1b\x10d\"\x17r\x15(@2\x11^[a-zA-Z_]{1,64}$R\rsubject_types\"\xb6\x02\n" +
+	" AuditAccessReviewRequestMetadata\x12&\n" +
+	"\x0eschema_version\x18\x01 \x01(\tR\x0eschema_version\x12\x8a\x01\n" +
+	"\n" +
+	"snap_token\x18\x02 \x01(\tBj\x92Ag2eThe snap token to avoid stale cache, see more details on [Snap Tokens](../../operations/snap-tokens).R\n" +
+	"snap_token\x12]\n" +
+	"\x05depth\x18\x03 \x01(\x05BG\x92A=2;Query limit when if recursive database queries got in loop.\xfaB\x04\x1a\x02(\x03R\x05depth\"m\n" +
+	"\x19AuditAccessReviewResponse\x120\n" +
+	"\x05entry\x18\x01 \x01(\v2\x1a.base.v1.AccessReviewEntryR\x05entry\x12\x1e\n" +
+	"\n" +
+	"snap_token\x18\x02 \x01(\tR\n" +
+	"snap_token*s\n" +
+	"\x10DataVerifyAction\x12\x1d\n" +
+	"\x19DATA_VERIFY_ACTION_REPORT\x10\x00\x12\x1d\n" +
+	"\x19DATA_VERIFY_ACTION_DELETE\x10\x01\x12!\n" +
+	"\x1dDATA_VERIFY_ACTION_QUARANTINE\x10\x022\xcaU\n" +
 	"\n" +
 	"Permission\x12\xe8\r\n" +
 	"\x05Check\x12\x1f.base.v1.PermissionCheckRequest\x1a .base.v1.PermissionCheckResponse\"\x9b\r\x92A\xe3\f\n" +
@@ -4494,7 +8725,10 @@ const file_base_v1_service_proto_rawDesc = "" +
 	"    for await (const response of res) {\n" +
 	"        // response.entityId\n" +
 	"    }\n" +
-	"}\x82\xd3\xe4\x93\x02=:\x01*\"8/v1/tenants/{tenant_id}/permissions/lookup-entity-stream0\x01\x12\xe3\r\n" +
+	"}\x82\xd3\xe4\x93\x02=:\x01*\"8/v1/tenants/{tenant_id}/permissions/lookup-entity-stream0\x01\x12\x85\x04\n" +
+	"\x12LookupEntitlements\x12,.base.v1.PermissionLookupEntitlementsRequest\x1a3.base.v1.PermissionLookupEntitlementsStreamResponse\"\x89\x03\x92A\xc3\x02\n" +
+	"\n" +
+	"Permission\x12\x13lookup entitlements\x1a\xff\x01Streams the entity type, permission and entity id of every entitlement of the subject, ordered by entity type, permission and entity id. The stream ends with a RESOURCE_EXHAUSTED error once the request evaluated more permission checks than its cost limit.*\x1epermissions.lookupEntitlements\x82\xd3\xe4\x93\x02<:\x01*\"7/v1/tenants/{tenant_id}/permissions/lookup-entitlements0\x01\x12\xe3\r\n" +
 	"\rLookupSubject\x12'.base.v1.PermissionLookupSubjectRequest\x1a(.base.v1.PermissionLookupSubjectResponse\"\xfe\f\x92A\xbd\f\n" +
 	"\n" +
 	"Permission\x12\x0elookup-subject*\x19permissions.lookupSubjectj\x83\f\n" +
@@ -4658,7 +8892,10 @@ const file_base_v1_service_proto_rawDesc = "" +
 	"    \"id\": \"1\",\n" +
 	"    \"relation\": \"\"\n" +
 	"  }\n" +
-	"}'\x82\xd3\xe4\x93\x02;:\x01*\"6/v1/tenants/{tenant_id}/permissions/subject-permission2\x84\b\n" +
+	"}'\x82\xd3\xe4\x93\x02;:\x01*\"6/v1/tenants/{tenant_id}/permissions/subject-permission\x12\x90\x03\n" +
+	"\bSimulate\x12\".base.v1.PermissionSimulateRequest\x1a#.base.v1.PermissionSimulateResponse\"\xba\x02\x92A\xff\x01\n" +
+	"\n" +
+	"Permission\x12\bsimulate\x1a\xd0\x01Answers the request once on the stored data and once with the changes overlaid on it, at the same snap token and schema version, and returns the permissions gained and lost by the changes. Nothing is written.*\x14permissions.simulate\x82\xd3\xe4\x93\x021:\x01*\",/v1/tenants/{tenant_id}/permissions/simulate2\x84\b\n" +
 	"\x05Watch\x12\xfa\a\n" +
 	"\x05Watch\x12\x15.base.v1.WatchRequest\x1a\x16.base.v1.WatchResponse\"\xbf\a\x92A\x93\a\n" +
 	"\x05Watch\x12\rwatch changes*\vwatch.watchj\xed\x06\n" +
@@ -4712,7 +8949,7 @@ const file_base_v1_service_proto_rawDesc = "" +
 	"        // response.changes\n" +
 	"    }\n" +
 	"}\n" +
-	"\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/tenants/{tenant_id}/watch0\x012\xac\x1f\n" +
+	"\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/tenants/{tenant_id}/watch0\x012\x8b5\n" +
 	"\x06Schema\x12\xd3\x10\n" +
 	"\x05Write\x12\x1b.base.v1.SchemaWriteRequest\x1a\x1c.base.v1.SchemaWriteResponse\"\x8e\x10\x92A\xda\x0f\n" +
 	"\x06Schema\x12\fwrite schema*\rschemas.writej\xb2\x0f\n" +
@@ -4868,7 +9105,37 @@ const file_base_v1_service_proto_rawDesc = "" +
 	"--data-raw '{\n" +
 	"    \"page_size\": 20,\n" +
 	"    \"continuous_token\": \"\"\n" +
-	"}'\x82\xd3\xe4\x93\x02):\x01*\"$/v1/tenants/{tenant_id}/schemas/list2\xe5D\n" +
+	"}'\x82\xd3\xe4\x93\x02):\x01*\"$/v1/tenants/{tenant_id}/schemas/list\x12\xc5\x05\n" +
+	"\x04Lint\x12\x1a.base.v1.SchemaLintRequest\x1a\x1b.base.v1.SchemaLintResponse\"\x83\x05\x92A\xd0\x04\n" +
+	"\x06Schema\x12\vlint schema\x1a\xbd\x01Reports relations, attributes and rules that are never used, permissions that can never be granted, recursive permissions without a base case and exclusions whose base can never be granted.*\fschemas.lintj\xea\x02\n" +
+	"\rx-codeSamples\x12\xd8\x022\xd5\x02\n" +
+	"\xd2\x02*\xcf\x02\n" +
+	"\x0f\n" +
+	"\x05label\x12\x06\x1a\x04cURL\n" +
+	"\x0e\n" +
+	"\x04lang\x12\x06\x1a\x04curl\n" +
+	"\xab\x02\n" +
+	"\x06source\x12\xa0\x02\x1a\x9d\x02curl --location --request POST 'localhost:3476/v1/tenants/{tenant_id}/schemas/lint' \\\n" +
+	"--header 'Content-Type: application/json' \\\n" +
+	"--data-raw '{\n" +
+	"    \"schema\": \"entity user {}\\n\\nentity document {\\n    relation owner @user\\n    relation viewer @user\\n\\n    permission edit = owner\\n}\"\n" +
+	"}'\x82\xd3\xe4\x93\x02):\x01*\"$/v1/tenants/{tenant_id}/schemas/lint\x12\x8f\x03\n" +
+	"\vShadowWrite\x12!.base.v1.SchemaShadowWriteRequest\x1a\".base.v1.SchemaShadowWriteResponse\"\xb8\x02\x92A\xfd\x01\n" +
+	"\x06Schema\x12\x13write shadow schema\x1a\xc7\x01Writes a schema that is not served, against which a sample of the live checks are evaluated again to report the decisions that would change. Replaces the current shadow version of the tenant, if any.*\x14schemas.shadow.write\x82\xd3\xe4\x93\x021:\x01*\",/v1/tenants/{tenant_id}/schemas/shadow/write\x12\xbd\x01\n" +
+	"\n" +
+	"ShadowRead\x12 .base.v1.SchemaShadowReadRequest\x1a!.base.v1.SchemaShadowReadResponse\"j\x92A1\n" +
+	"\x06Schema\x12\x12read shadow schema*\x13schemas.shadow.read\x82\xd3\xe4\x93\x020:\x01*\"+/v1/tenants/{tenant_id}/schemas/shadow/read\x12\xe6\x02\n" +
+	"\rShadowPromote\x12#.base.v1.SchemaShadowPromoteRequest\x1a$.base.v1.SchemaShadowPromoteResponse\"\x89\x02\x92A\xcc\x01\n" +
+	"\x06Schema\x12\x15promote shadow schema\x1a\x92\x01Serves the shadow version. Fails if another version was written since the shadow version, as its decisions were not compared against that version.*\x16schemas.shadow.promote\x82\xd3\xe4\x93\x023:\x01*\"./v1/tenants/{tenant_id}/schemas/shadow/promote\x12\xd5\x01\n" +
+	"\x0eShadowRollback\x12$.base.v1.SchemaShadowRollbackRequest\x1a%.base.v1.SchemaShadowRollbackResponse\"v\x92A9\n" +
+	"\x06Schema\x12\x16rollback shadow schema*\x17schemas.shadow.rollback\x82\xd3\xe4\x93\x024:\x01*\"//v1/tenants/{tenant_id}/schemas/shadow/rollback\x12\xbc\x02\n" +
+	"\x03Tag\x12\x19.base.v1.SchemaTagRequest\x1a\x1a.base.v1.SchemaTagResponse\"\xfd\x01\x92A\xcb\x01\n" +
+	"\x06Schema\x12\n" +
+	"tag schema\x1a\xa7\x01Points the tag to the schema version, moving it if it pointed to another version. Requests can select the version of a tag with the schema_tag field of their metadata.*\vschemas.tag\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/tenants/{tenant_id}/schemas/tag\x12\x9c\x01\n" +
+	"\x05Untag\x12\x1b.base.v1.SchemaUntagRequest\x1a\x1c.base.v1.SchemaUntagResponse\"X\x92A%\n" +
+	"\x06Schema\x12\funtag schema*\rschemas.untag\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/tenants/{tenant_id}/schemas/untag\x12\xa3\x03\n" +
+	"\bActivate\x12\x1e.base.v1.SchemaActivateRequest\x1a\x1f.base.v1.SchemaActivateResponse\"\xd5\x02\x92A\x9e\x02\n" +
+	"\x06Schema\x12\x0factivate schema\x1a\xf0\x01Serves the given schema version, or the version of the given tag, to the requests that do not select a version, which rolls back to a previous version without writing it again. Writing or promoting a schema makes the written version active.*\x10schemas.activate\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/tenants/{tenant_id}/schemas/activate2\xbdM\n" +
 	"\x04Data\x12\xb6\x15\n" +
 	"\x05Write\x12\x19.base.v1.DataWriteRequest\x1a\x1a.base.v1.DataWriteResponse\"\xf5\x14\x92A\xc4\x14\n" +
 	"\x04Data\x12\n" +
@@ -5299,7 +9566,14 @@ const file_base_v1_service_proto_rawDesc = "" +
 	"        \"creatorID\": \"564\",\n" +
 	"        \"organizationID\": \"789\"\n" +
 	"    }\n" +
-	"}'\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/tenants/{tenant_id}/data/run-bundle2\xc0!\n" +
+	"}'\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/tenants/{tenant_id}/data/run-bundle\x12\xd4\x02\n" +
+	"\vReadHistory\x12\x1f.base.v1.DataReadHistoryRequest\x1a .base.v1.DataReadHistoryResponse\"\x81\x02\x92A\xce\x01\n" +
+	"\x04Data\x12\fread history\x1a\xa9\x01Lists the versions of the tuples or attributes matching the filter, in the order they were written. Versions expired before the garbage collection window are not listed.*\fdata.history\x82\xd3\xe4\x93\x02):\x01*\"$/v1/tenants/{tenant_id}/data/history\x12\xc6\x02\n" +
+	"\x06Verify\x12\x1a.base.v1.DataVerifyRequest\x1a\x1b.base.v1.DataVerifyResponse\"\x80\x02\x92A\xce\x01\n" +
+	"\x04Data\x12\vverify data\x1a\xab\x01Validates the tuples and attributes of the tenant against a schema version and reports the ones that violate it. Violations can be deleted or moved to a quarantine tenant.*\vdata.verify\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/tenants/{tenant_id}/data/verify0\x01\x12\xee\x02\n" +
+	"\aAnalyze\x12\x1b.base.v1.DataAnalyzeRequest\x1a\x1c.base.v1.DataAnalyzeResponse\"\xa7\x02\x92A\xf4\x01\n" +
+	"\x04Data\x12\fanalyze data\x1a\xcf\x01Detects cycles and measures the longest chains of the recursive relations of the schema, such as folder parents, in the stored tuples, and lists the entities and subjects with the highest fan-out and fan-in.*\fdata.analyze\x82\xd3\xe4\x93\x02):\x01*\"$/v1/tenants/{tenant_id}/data/analyze\x12E\n" +
+	"\x06Import\x12\x1a.base.v1.DataImportRequest\x1a\x1b.base.v1.DataImportResponse\"\x00(\x012\xc0!\n" +
 	"\x06Bundle\x12\x80\x15\n" +
 	"\x05Write\x12\x1b.base.v1.BundleWriteRequest\x1a\x1c.base.v1.BundleWriteResponse\"\xbb\x14\x92A\x88\x14\n" +
 	"\x06Bundle\x12\fwrite bundle*\fbundle.writej\xe1\x13\n" +
@@ -5574,7 +9848,40 @@ const file_base_v1_service_proto_rawDesc = "" +
 	"--data-raw '{\n" +
 	"    \"page_size\": 20,\n" +
 	"    \"continuous_token\": \"\"\n" +
-	"}'\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/tenants/listB\x8a\x01\n" +
+	"}'\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/tenants/list2\xb4\t\n" +
+	"\x05Audit\x12\xb9\x06\n" +
+	"\x04List\x12\x19.base.v1.AuditListRequest\x1a\x1a.base.v1.AuditListResponse\"\xf9\x05\x92A\xc8\x05\n" +
+	"\x05Audit\x12\x12list audit records\x1auLists the data, schema, bundle and tenant mutations made in a tenant, newest first. Requires the postgres audit sink.*\n" +
+	"audit.listj\xa7\x04\n" +
+	"\rx-codeSamples\x12\x95\x042\x92\x04\n" +
+	"\x88\x02*\x85\x02\n" +
+	"\r\n" +
+	"\x05label\x12\x04\x1a\x02go\n" +
+	"\f\n" +
+	"\x04lang\x12\x04\x1a\x02go\n" +
+	"\xe5\x01\n" +
+	"\x06source\x12\xda\x01\x1a\xd7\x01ar, err := client.Audit.List(context.Background(), &v1.AuditListRequest{\n" +
+	"    TenantId: \"t1\",\n" +
+	"    Filter: &v1.AuditFilter{\n" +
+	"        Methods: []string{\"Data.Write\"},\n" +
+	"    },\n" +
+	"    PageSize: 20,\n" +
+	"    ContinuousToken: \"\",\n" +
+	"})\n" +
+	"\x84\x02*\x81\x02\n" +
+	"\x0f\n" +
+	"\x05label\x12\x06\x1a\x04cURL\n" +
+	"\x0e\n" +
+	"\x04lang\x12\x06\x1a\x04curl\n" +
+	"\xdd\x01\n" +
+	"\x06source\x12\xd2\x01\x1a\xcf\x01curl --location --request POST 'localhost:3476/v1/tenants/{tenant_id}/audit/list' \\\n" +
+	"--header 'Content-Type: application/json' \\\n" +
+	"--data-raw '{\n" +
+	"    \"filter\": {\"methods\": [\"Data.Write\"]},\n" +
+	"    \"page_size\": 20\n" +
+	"}'\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/tenants/{tenant_id}/audit/list\x12\xee\x02\n" +
+	"\fAccessReview\x12!.base.v1.AuditAccessReviewRequest\x1a\".base.v1.AuditAccessReviewResponse\"\x94\x02\x92A\xda\x01\n" +
+	"\x05Audit\x12\raccess review\x1a\xad\x01Streams the subjects holding each permission on each entity of the given entity types at one snap token, and whether they hold it directly or inherit it, for access reviews.*\x12audit.accessReview\x82\xd3\xe4\x93\x020:\x01*\"+/v1/tenants/{tenant_id}/audit/access-review0\x01B\x8a\x01\n" +
 	"\vcom.base.v1B\fServiceProtoP\x01Z0github.com/Permify/permify/pkg/pb/base/v1;basev1\xa2\x02\x03BXX\xaa\x02\aBase.V1\xca\x02\aBase\\V1\xe2\x02\x13Base\\V1\\GPBMetadata\xea\x02\bBase::V1b\x06proto3"
 
 var (
@@ -5589,220 +9896,381 @@ func file_base_v1_service_proto_rawDescGZIP() []byte {
 	return file_base_v1_service_proto_rawDescData
 }
 
-var file_base_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 70)
+var file_base_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_base_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 118)
 var file_base_v1_service_proto_goTypes = []any{
-	(*PermissionCheckRequest)(nil),                     // 0: base.v1.PermissionCheckRequest
-	(*PermissionCheckRequestMetadata)(nil),             // 1: base.v1.PermissionCheckRequestMetadata
-	(*PermissionCheckResponse)(nil),                    // 2: base.v1.PermissionCheckResponse
-	(*PermissionCheckResponseMetadata)(nil),            // 3: base.v1.PermissionCheckResponseMetadata
-	(*PermissionBulkCheckRequestItem)(nil),             // 4: base.v1.PermissionBulkCheckRequestItem
-	(*PermissionBulkCheckRequest)(nil),                 // 5: base.v1.PermissionBulkCheckRequest
-	(*PermissionBulkCheckResponse)(nil),                // 6: base.v1.PermissionBulkCheckResponse
-	(*PermissionExpandRequest)(nil),                    // 7: base.v1.PermissionExpandRequest
-	(*PermissionExpandRequestMetadata)(nil),            // 8: base.v1.PermissionExpandRequestMetadata
-	(*PermissionExpandResponse)(nil),                   // 9: base.v1.PermissionExpandResponse
-	(*PermissionLookupEntityRequest)(nil),              // 10: base.v1.PermissionLookupEntityRequest
-	(*PermissionLookupEntityRequestMetadata)(nil),      // 11: base.v1.PermissionLookupEntityRequestMetadata
-	(*PermissionLookupEntityResponse)(nil),             // 12: base.v1.PermissionLookupEntityResponse
-	(*PermissionLookupEntityStreamResponse)(nil),       // 13: base.v1.PermissionLookupEntityStreamResponse
-	(*PermissionEntityFilterRequest)(nil),              // 14: base.v1.PermissionEntityFilterRequest
-	(*PermissionEntityFilterRequestMetadata)(nil),      // 15: base.v1.PermissionEntityFilterRequestMetadata
-	(*PermissionLookupSubjectRequest)(nil),             // 16: base.v1.PermissionLookupSubjectRequest
-	(*PermissionLookupSubjectRequestMetadata)(nil),     // 17: base.v1.PermissionLookupSubjectRequestMetadata
-	(*PermissionLookupSubjectResponse)(nil),            // 18: base.v1.PermissionLookupSubjectResponse
-	(*PermissionSubjectPermissionRequest)(nil),         // 19: base.v1.PermissionSubjectPermissionRequest
-	(*PermissionSubjectPermissionRequestMetadata)(nil), // 20: base.v1.PermissionSubjectPermissionRequestMetadata
-	(*PermissionSubjectPermissionResponse)(nil),        // 21: base.v1.PermissionSubjectPermissionResponse
-	(*WatchRequest)(nil),                               // 22: base.v1.WatchRequest
-	(*WatchResponse)(nil),                              // 23: base.v1.WatchResponse
-	(*SchemaWriteRequest)(nil),                         // 24: base.v1.SchemaWriteRequest
-	(*SchemaWriteResponse)(nil),                        // 25: base.v1.SchemaWriteResponse
-	(*SchemaPartialWriteRequest)(nil),                  // 26: base.v1.SchemaPartialWriteRequest
-	(*SchemaPartialWriteRequestMetadata)(nil),          // 27: base.v1.SchemaPartialWriteRequestMetadata
-	(*SchemaPartialWriteResponse)(nil),                 // 28: base.v1.SchemaPartialWriteResponse
-	(*SchemaReadRequest)(nil),                          // 29: base.v1.SchemaReadRequest
-	(*SchemaReadRequestMetadata)(nil),                  // 30: base.v1.SchemaReadRequestMetadata
-	(*SchemaReadResponse)(nil),                         // 31: base.v1.SchemaReadResponse
-	(*SchemaListRequest)(nil),                          // 32: base.v1.SchemaListRequest
-	(*SchemaListResponse)(nil),                         // 33: base.v1.SchemaListResponse
-	(*SchemaList)(nil),                                 // 34: base.v1.SchemaList
-	(*DataWriteRequest)(nil),                           // 35: base.v1.DataWriteRequest
-	(*DataWriteRequestMetadata)(nil),                   // 36: base.v1.DataWriteRequestMetadata
-	(*DataWriteResponse)(nil),                          // 37: base.v1.DataWriteResponse
-	(*RelationshipWriteRequest)(nil),                   // 38: base.v1.RelationshipWriteRequest
-	(*RelationshipWriteRequestMetadata)(nil),           // 39: base.v1.RelationshipWriteRequestMetadata
-	(*RelationshipWriteResponse)(nil),                  // 40: base.v1.RelationshipWriteResponse
-	(*RelationshipReadRequest)(nil),                    // 41: base.v1.RelationshipReadRequest
-	(*RelationshipReadRequestMetadata)(nil),            // 42: base.v1.RelationshipReadRequestMetadata
-	(*RelationshipReadResponse)(nil),                   // 43: base.v1.RelationshipReadResponse
-	(*AttributeReadRequest)(nil),                       // 44: base.v1.AttributeReadRequest
-	(*AttributeReadRequestMetadata)(nil),               // 45: base.v1.AttributeReadRequestMetadata
-	(*AttributeReadResponse)(nil),                      // 46: base.v1.AttributeReadResponse
-	(*DataDeleteRequest)(nil),                          // 47: base.v1.DataDeleteRequest
-	(*DataDeleteResponse)(nil),                         // 48: base.v1.DataDeleteResponse
-	(*RelationshipDeleteRequest)(nil),                  // 49: base.v1.RelationshipDeleteRequest
-	(*RelationshipDeleteResponse)(nil),                 // 50: base.v1.RelationshipDeleteResponse
-	(*BundleRunRequest)(nil),                           // 51: base.v1.BundleRunRequest
-	(*BundleRunResponse)(nil),                          // 52: base.v1.BundleRunResponse
-	(*BundleWriteRequest)(nil),                         // 53: base.v1.BundleWriteRequest
-	(*BundleWriteResponse)(nil),                        // 54: base.v1.BundleWriteResponse
-	(*BundleReadRequest)(nil),                          // 55: base.v1.BundleReadRequest
-	(*BundleReadResponse)(nil),                         // 56: base.v1.BundleReadResponse
-	(*BundleDeleteRequest)(nil),                        // 57: base.v1.BundleDeleteRequest
-	(*BundleDeleteResponse)(nil),                       // 58: base.v1.BundleDeleteResponse
-	(*TenantCreateRequest)(nil),                        // 59: base.v1.TenantCreateRequest
-	(*TenantCreateResponse)(nil),                       // 60: base.v1.TenantCreateResponse
-	(*TenantDeleteRequest)(nil),                        // 61: base.v1.TenantDeleteRequest
-	(*TenantDeleteResponse)(nil),                       // 62: base.v1.TenantDeleteResponse
-	(*TenantListRequest)(nil),                          // 63: base.v1.TenantListRequest
-	(*TenantListResponse)(nil),                         // 64: base.v1.TenantListResponse
-	nil,                                                // 65: base.v1.PermissionLookupEntityRequest.ScopeEntry
-	nil,                                                // 66: base.v1.PermissionEntityFilterRequest.ScopeEntry
-	nil,                                                // 67: base.v1.PermissionSubjectPermissionResponse.ResultsEntry
-	nil,                                                // 68: base.v1.SchemaPartialWriteRequest.PartialsEntry
-	nil,                                                // 69: base.v1.BundleRunRequest.ArgumentsEntry
-	(*Entity)(nil),                                     // 70: base.v1.Entity
-	(*Subject)(nil),                                    // 71: base.v1.Subject
-	(*Context)(nil),                                    // 72: base.v1.Context
-	(*Argument)(nil),                                   // 73: base.v1.Argument
-	(CheckResult)(0),                                   // 74: base.v1.CheckResult
-	(*Expand)(nil),                                     // 75: base.v1.Expand
-	(*Entrance)(nil),                                   // 76: base.v1.Entrance
-	(*RelationReference)(nil),                          // 77: base.v1.RelationReference
-	(*DataChanges)(nil),                                // 78: base.v1.DataChanges
-	(*SchemaDefinition)(nil),                           // 79: base.v1.SchemaDefinition
-	(*Tuple)(nil),                                      // 80: base.v1.Tuple
-	(*Attribute)(nil),                                  // 81: base.v1.Attribute
-	(*TupleFilter)(nil),                                // 82: base.v1.TupleFilter
-	(*AttributeFilter)(nil),                            // 83: base.v1.AttributeFilter
-	(*DataBundle)(nil),                                 // 84: base.v1.DataBundle
-	(*Tenant)(nil),                                     // 85: base.v1.Tenant
-	(*StringArrayValue)(nil),                           // 86: base.v1.StringArrayValue
-	(*Partials)(nil),                                   // 87: base.v1.Partials
+	(DataVerifyAction)(0),                               // 0: base.v1.DataVerifyAction
+	(SchemaLintFinding_Severity)(0),                     // 1: base.v1.SchemaLintFinding.Severity
+	(*PermissionCheckRequest)(nil),                      // 2: base.v1.PermissionCheckRequest
+	(*PermissionCheckRequestMetadata)(nil),              // 3: base.v1.PermissionCheckRequestMetadata
+	(*PermissionCheckResponse)(nil),                     // 4: base.v1.PermissionCheckResponse
+	(*PermissionCheckResponseMetadata)(nil),             // 5: base.v1.PermissionCheckResponseMetadata
+	(*PermissionBulkCheckRequestItem)(nil),              // 6: base.v1.PermissionBulkCheckRequestItem
+	(*PermissionBulkCheckRequest)(nil),                  // 7: base.v1.PermissionBulkCheckRequest
+	(*PermissionBulkCheckResponse)(nil),                 // 8: base.v1.PermissionBulkCheckResponse
+	(*PermissionExpandRequest)(nil),                     // 9: base.v1.PermissionExpandRequest
+	(*PermissionExpandRequestMetadata)(nil),             // 10: base.v1.PermissionExpandRequestMetadata
+	(*PermissionExpandResponse)(nil),                    // 11: base.v1.PermissionExpandResponse
+	(*PermissionLookupEntityRequest)(nil),               // 12: base.v1.PermissionLookupEntityRequest
+	(*PermissionLookupEntityRequestMetadata)(nil),       // 13: base.v1.PermissionLookupEntityRequestMetadata
+	(*PermissionLookupEntityResponse)(nil),              // 14: base.v1.PermissionLookupEntityResponse
+	(*PermissionLookupEntityStreamResponse)(nil),        // 15: base.v1.PermissionLookupEntityStreamResponse
+	(*PermissionEntityFilterRequest)(nil),               // 16: base.v1.PermissionEntityFilterRequest
+	(*PermissionEntityFilterRequestMetadata)(nil),       // 17: base.v1.PermissionEntityFilterRequestMetadata
+	(*PermissionLookupSubjectRequest)(nil),              // 18: base.v1.PermissionLookupSubjectRequest
+	(*PermissionLookupSubjectRequestMetadata)(nil),      // 19: base.v1.PermissionLookupSubjectRequestMetadata
+	(*PermissionLookupSubjectResponse)(nil),             // 20: base.v1.PermissionLookupSubjectResponse
+	(*PermissionSubjectPermissionRequest)(nil),          // 21: base.v1.PermissionSubjectPermissionRequest
+	(*PermissionSubjectPermissionRequestMetadata)(nil),  // 22: base.v1.PermissionSubjectPermissionRequestMetadata
+	(*PermissionSubjectPermissionResponse)(nil),         // 23: base.v1.PermissionSubjectPermissionResponse
+	(*PermissionLookupEntitlementsRequest)(nil),         // 24: base.v1.PermissionLookupEntitlementsRequest
+	(*PermissionLookupEntitlementsRequestMetadata)(nil), // 25: base.v1.PermissionLookupEntitlementsRequestMetadata
+	(*PermissionLookupEntitlementsStreamResponse)(nil),  // 26: base.v1.PermissionLookupEntitlementsStreamResponse
+	(*PermissionSimulateRequest)(nil),                   // 27: base.v1.PermissionSimulateRequest
+	(*SimulationChanges)(nil),                           // 28: base.v1.SimulationChanges
+	(*PermissionSimulateResponse)(nil),                  // 29: base.v1.PermissionSimulateResponse
+	(*WatchRequest)(nil),                                // 30: base.v1.WatchRequest
+	(*WatchResponse)(nil),                               // 31: base.v1.WatchResponse
+	(*SchemaWriteRequest)(nil),                          // 32: base.v1.SchemaWriteRequest
+	(*SchemaWriteResponse)(nil),                         // 33: base.v1.SchemaWriteResponse
+	(*SchemaPartialWriteRequest)(nil),                   // 34: base.v1.SchemaPartialWriteRequest
+	(*SchemaPartialWriteRequestMetadata)(nil),           // 35: base.v1.SchemaPartialWriteRequestMetadata
+	(*SchemaPartialWriteResponse)(nil),                  // 36: base.v1.SchemaPartialWriteResponse
+	(*SchemaReadRequest)(nil),                           // 37: base.v1.SchemaReadRequest
+	(*SchemaReadRequestMetadata)(nil),                   // 38: base.v1.SchemaReadRequestMetadata
+	(*SchemaReadResponse)(nil),                          // 39: base.v1.SchemaReadResponse
+	(*SchemaListRequest)(nil),                           // 40: base.v1.SchemaListRequest
+	(*SchemaListResponse)(nil),                          // 41: base.v1.SchemaListResponse
+	(*SchemaList)(nil),                                  // 42: base.v1.SchemaList
+	(*SchemaLintRequest)(nil),                           // 43: base.v1.SchemaLintRequest
+	(*SchemaLintResponse)(nil),                          // 44: base.v1.SchemaLintResponse
+	(*SchemaLintFinding)(nil),                           // 45: base.v1.SchemaLintFinding
+	(*SchemaShadow)(nil),                                // 46: base.v1.SchemaShadow
+	(*SchemaShadowWriteRequest)(nil),                    // 47: base.v1.SchemaShadowWriteRequest
+	(*SchemaShadowWriteResponse)(nil),                   // 48: base.v1.SchemaShadowWriteResponse
+	(*SchemaShadowReadRequest)(nil),                     // 49: base.v1.SchemaShadowReadRequest
+	(*SchemaShadowReadResponse)(nil),                    // 50: base.v1.SchemaShadowReadResponse
+	(*SchemaShadowPromoteRequest)(nil),                  // 51: base.v1.SchemaShadowPromoteRequest
+	(*SchemaShadowPromoteResponse)(nil),                 // 52: base.v1.SchemaShadowPromoteResponse
+	(*SchemaShadowRollbackRequest)(nil),                 // 53: base.v1.SchemaShadowRollbackRequest
+	(*SchemaShadowRollbackResponse)(nil),                // 54: base.v1.SchemaShadowRollbackResponse
+	(*SchemaTagRequest)(nil),                            // 55: base.v1.SchemaTagRequest
+	(*SchemaTagResponse)(nil),                           // 56: base.v1.SchemaTagResponse
+	(*SchemaUntagRequest)(nil),                          // 57: base.v1.SchemaUntagRequest
+	(*SchemaUntagResponse)(nil),                         // 58: base.v1.SchemaUntagResponse
+	(*SchemaActivateRequest)(nil),                       // 59: base.v1.SchemaActivateRequest
+	(*SchemaActivateResponse)(nil),                      // 60: base.v1.SchemaActivateResponse
+	(*DataWriteRequest)(nil),                            // 61: base.v1.DataWriteRequest
+	(*DataWriteRequestMetadata)(nil),                    // 62: base.v1.DataWriteRequestMetadata
+	(*DataWriteResponse)(nil),                           // 63: base.v1.DataWriteResponse
+	(*DataImportRequest)(nil),                           // 64: base.v1.DataImportRequest
+	(*DataImportRequestMetadata)(nil),                   // 65: base.v1.DataImportRequestMetadata
+	(*DataImportRejection)(nil),                         // 66: base.v1.DataImportRejection
+	(*DataImportResponse)(nil),                          // 67: base.v1.DataImportResponse
+	(*RelationshipWriteRequest)(nil),                    // 68: base.v1.RelationshipWriteRequest
+	(*RelationshipWriteRequestMetadata)(nil),            // 69: base.v1.RelationshipWriteRequestMetadata
+	(*RelationshipWriteResponse)(nil),                   // 70: base.v1.RelationshipWriteResponse
+	(*RelationshipReadRequest)(nil),                     // 71: base.v1.RelationshipReadRequest
+	(*RelationshipReadRequestMetadata)(nil),             // 72: base.v1.RelationshipReadRequestMetadata
+	(*RelationshipReadResponse)(nil),                    // 73: base.v1.RelationshipReadResponse
+	(*AttributeReadRequest)(nil),                        // 74: base.v1.AttributeReadRequest
+	(*AttributeReadRequestMetadata)(nil),                // 75: base.v1.AttributeReadRequestMetadata
+	(*AttributeReadResponse)(nil),                       // 76: base.v1.AttributeReadResponse
+	(*DataReadHistoryRequest)(nil),                      // 77: base.v1.DataReadHistoryRequest
+	(*DataHistoryRecord)(nil),                           // 78: base.v1.DataHistoryRecord
+	(*DataReadHistoryResponse)(nil),                     // 79: base.v1.DataReadHistoryResponse
+	(*DataVerifyRequest)(nil),                           // 80: base.v1.DataVerifyRequest
+	(*DataVerifyRequestMetadata)(nil),                   // 81: base.v1.DataVerifyRequestMetadata
+	(*DataViolation)(nil),                               // 82: base.v1.DataViolation
+	(*DataVerifySummary)(nil),                           // 83: base.v1.DataVerifySummary
+	(*DataVerifyResponse)(nil),                          // 84: base.v1.DataVerifyResponse
+	(*DataAnalyzeRequest)(nil),                          // 85: base.v1.DataAnalyzeRequest
+	(*DataAnalyzeRequestMetadata)(nil),                  // 86: base.v1.DataAnalyzeRequestMetadata
+	(*DataCycle)(nil),                                   // 87: base.v1.DataCycle
+	(*DataRecursiveRelation)(nil),                       // 88: base.v1.DataRecursiveRelation
+	(*DataHotspot)(nil),                                 // 89: base.v1.DataHotspot
+	(*DataAnalyzeResponse)(nil),                         // 90: base.v1.DataAnalyzeResponse
+	(*DataDeleteRequest)(nil),                           // 91: base.v1.DataDeleteRequest
+	(*DataDeleteResponse)(nil),                          // 92: base.v1.DataDeleteResponse
+	(*RelationshipDeleteRequest)(nil),                   // 93: base.v1.RelationshipDeleteRequest
+	(*RelationshipDeleteResponse)(nil),                  // 94: base.v1.RelationshipDeleteResponse
+	(*BundleRunRequest)(nil),                            // 95: base.v1.BundleRunRequest
+	(*BundleRunResponse)(nil),                           // 96: base.v1.BundleRunResponse
+	(*BundleWriteRequest)(nil),                          // 97: base.v1.BundleWriteRequest
+	(*BundleWriteResponse)(nil),                         // 98: base.v1.BundleWriteResponse
+	(*BundleReadRequest)(nil),                           // 99: base.v1.BundleReadRequest
+	(*BundleReadResponse)(nil),                          // 100: base.v1.BundleReadResponse
+	(*BundleDeleteRequest)(nil),                         // 101: base.v1.BundleDeleteRequest
+	(*BundleDeleteResponse)(nil),                        // 102: base.v1.BundleDeleteResponse
+	(*TenantCreateRequest)(nil),                         // 103: base.v1.TenantCreateRequest
+	(*TenantCreateResponse)(nil),                        // 104: base.v1.TenantCreateResponse
+	(*TenantDeleteRequest)(nil),                         // 105: base.v1.TenantDeleteRequest
+	(*TenantDeleteResponse)(nil),                        // 106: base.v1.TenantDeleteResponse
+	(*TenantListRequest)(nil),                           // 107: base.v1.TenantListRequest
+	(*TenantListResponse)(nil),                          // 108: base.v1.TenantListResponse
+	(*AuditFilter)(nil),                                 // 109: base.v1.AuditFilter
+	(*AuditListRequest)(nil),                            // 110: base.v1.AuditListRequest
+	(*AuditListResponse)(nil),                           // 111: base.v1.AuditListResponse
+	(*AuditAccessReviewRequest)(nil),                    // 112: base.v1.AuditAccessReviewRequest
+	(*AuditAccessReviewRequestMetadata)(nil),            // 113: base.v1.AuditAccessReviewRequestMetadata
+	(*AuditAccessReviewResponse)(nil),                   // 114: base.v1.AuditAccessReviewResponse
+	nil,                                                 // 115: base.v1.PermissionLookupEntityRequest.ScopeEntry
+	nil,                                                 // 116: base.v1.PermissionEntityFilterRequest.ScopeEntry
+	nil,                                                 // 117: base.v1.PermissionSubjectPermissionResponse.ResultsEntry
+	nil,                                                 // 118: base.v1.SchemaPartialWriteRequest.PartialsEntry
+	nil,                                                 // 119: base.v1.BundleRunRequest.ArgumentsEntry
+	(*Entity)(nil),                                      // 120: base.v1.Entity
+	(*Subject)(nil),                                     // 121: base.v1.Subject
+	(*Context)(nil),                                     // 122: base.v1.Context
+	(*Argument)(nil),                                    // 123: base.v1.Argument
+	(*timestamppb.Timestamp)(nil),                       // 124: google.protobuf.Timestamp
+	(*Consistency)(nil),                                 // 125: base.v1.Consistency
+	(CheckResult)(0),                                    // 126: base.v1.CheckResult
+	(*Expand)(nil),                                      // 127: base.v1.Expand
+	(*AttributePredicate)(nil),                          // 128: base.v1.AttributePredicate
+	(*Entrance)(nil),                                    // 129: base.v1.Entrance
+	(*RelationReference)(nil),                           // 130: base.v1.RelationReference
+	(*Tuple)(nil),                                       // 131: base.v1.Tuple
+	(*Attribute)(nil),                                   // 132: base.v1.Attribute
+	(*DataChanges)(nil),                                 // 133: base.v1.DataChanges
+	(*TransactionMetadata)(nil),                         // 134: base.v1.TransactionMetadata
+	(*SchemaDefinition)(nil),                            // 135: base.v1.SchemaDefinition
+	(*Precondition)(nil),                                // 136: base.v1.Precondition
+	(*TupleFilter)(nil),                                 // 137: base.v1.TupleFilter
+	(*AttributeFilter)(nil),                             // 138: base.v1.AttributeFilter
+	(*DataBundle)(nil),                                  // 139: base.v1.DataBundle
+	(*Tenant)(nil),                                      // 140: base.v1.Tenant
+	(*AuditRecord)(nil),                                 // 141: base.v1.AuditRecord
+	(*AccessReviewEntry)(nil),                           // 142: base.v1.AccessReviewEntry
+	(*StringArrayValue)(nil),                            // 143: base.v1.StringArrayValue
+	(*Partials)(nil),                                    // 144: base.v1.Partials
 }
 var file_base_v1_service_proto_depIdxs = []int32{
-	1,  // 0: base.v1.PermissionCheckRequest.metadata:type_name -> base.v1.PermissionCheckRequestMetadata
-	70, // 1: base.v1.PermissionCheckRequest.entity:type_name -> base.v1.Entity
-	71, // 2: base.v1.PermissionCheckRequest.subject:type_name -> base.v1.Subject
-	72, // 3: base.v1.PermissionCheckRequest.context:type_name -> base.v1.Context
-	73, // 4: base.v1.PermissionCheckRequest.arguments:type_name -> base.v1.Argument
-	74, // 5: base.v1.PermissionCheckResponse.can:type_name -> base.v1.CheckResult
-	3,  // 6: base.v1.PermissionCheckResponse.metadata:type_name -> base.v1.PermissionCheckResponseMetadata
-	70, // 7: base.v1.PermissionBulkCheckRequestItem.entity:type_name -> base.v1.Entity
-	71, // 8: base.v1.PermissionBulkCheckRequestItem.subject:type_name -> base.v1.Subject
-	1,  // 9: base.v1.PermissionBulkCheckRequest.metadata:type_name -> base.v1.PermissionCheckRequestMetadata
-	4,  // 10: base.v1.PermissionBulkCheckRequest.items:type_name -> base.v1.PermissionBulkCheckRequestItem
-	72, // 11: base.v1.PermissionBulkCheckRequest.context:type_name -> base.v1.Context
-	73, // 12: base.v1.PermissionBulkCheckRequest.arguments:type_name -> base.v1.Argument
-	2,  // 13: base.v1.PermissionBulkCheckResponse.results:type_name -> base.v1.PermissionCheckResponse
-	8,  // 14: base.v1.PermissionExpandRequest.metadata:type_name -> base.v1.PermissionExpandRequestMetadata
-	70, // 15: base.v1.PermissionExpandRequest.entity:type_name -> base.v1.Entity
-	72, // 16: base.v1.PermissionExpandRequest.context:type_name -> base.v1.Context
-	73, // 17: base.v1.PermissionExpandRequest.arguments:type_name -> base.v1.Argument
-	75, // 18: base.v1.PermissionExpandResponse.tree:type_name -> base.v1.Expand
-	11, // 19: base.v1.PermissionLookupEntityRequest.metadata:type_name -> base.v1.PermissionLookupEntityRequestMetadata
-	71, // 20: base.v1.PermissionLookupEntityRequest.subject:type_name -> base.v1.Subject
-	72, // 21: base.v1.PermissionLookupEntityRequest.context:type_name -> base.v1.Context
-	65, // 22: base.v1.PermissionLookupEntityRequest.scope:type_name -> base.v1.PermissionLookupEntityRequest.ScopeEntry
-	15, // 23: base.v1.PermissionEntityFilterRequest.metadata:type_name -> base.v1.PermissionEntityFilterRequestMetadata
-	76, // 24: base.v1.PermissionEntityFilterRequest.entrance:type_name -> base.v1.Entrance
-	71, // 25: base.v1.PermissionEntityFilterRequest.subject:type_name -> base.v1.Subject
-	72, // 26: base.v1.PermissionEntityFilterRequest.context:type_name -> base.v1.Context
-	66, // 27: base.v1.PermissionEntityFilterRequest.scope:type_name -> base.v1.PermissionEntityFilterRequest.ScopeEntry
-	17, // 28: base.v1.PermissionLookupSubjectRequest.metadata:type_name -> base.v1.PermissionLookupSubjectRequestMetadata
-	70, // 29: base.v1.PermissionLookupSubjectRequest.entity:type_name -> base.v1.Entity
-	77, // 30: base.v1.PermissionLookupSubjectRequest.subject_reference:type_name -> base.v1.RelationReference
-	72, // 31: base.v1.PermissionLookupSubjectRequest.context:type_name -> base.v1.Context
-	73, // 32: base.v1.PermissionLookupSubjectRequest.arguments:type_name -> base.v1.Argument
-	20, // 33: base.v1.PermissionSubjectPermissionRequest.metadata:type_name -> base.v1.PermissionSubjectPermissionRequestMetadata
-	70, // 34: base.v1.PermissionSubjectPermissionRequest.entity:type_name -> base.v1.Entity
-	71, // 35: base.v1.PermissionSubjectPermissionRequest.subject:type_name -> base.v1.Subject
-	72, // 36: base.v1.PermissionSubjectPermissionRequest.context:type_name -> base.v1.Context
-	67, // 37: base.v1.PermissionSubjectPermissionResponse.results:type_name -> base.v1.PermissionSubjectPermissionResponse.ResultsEntry
-	78, // 38: base.v1.WatchResponse.changes:type_name -> base.v1.DataChanges
-	27, // 39: base.v1.SchemaPartialWriteRequest.metadata:type_name -> base.v1.SchemaPartialWriteRequestMetadata
-	68, // 40: base.v1.SchemaPartialWriteRequest.partials:type_name -> base.v1.SchemaPartialWriteRequest.PartialsEntry
-	30, // 41: base.v1.SchemaReadRequest.metadata:type_name -> base.v1.SchemaReadRequestMetadata
-	79, // 42: base.v1.SchemaReadResponse.schema:type_name -> base.v1.SchemaDefinition
-	34, // 43: base.v1.SchemaListResponse.schemas:type_name -> base.v1.SchemaList
-	36, // 44: base.v1.DataWriteRequest.metadata:type_name -> base.v1.DataWriteRequestMetadata
-	80, // 45: base.v1.DataWriteRequest.tuples:type_name -> base.v1.Tuple
-	81, // 46: base.v1.DataWriteRequest.attributes:type_name -> base.v1.Attribute
-	39, // 47: base.v1.RelationshipWriteRequest.metadata:type_name -> base.v1.RelationshipWriteRequestMetadata
-	80, // 48: base.v1.RelationshipWriteRequest.tuples:type_name -> base.v1.Tuple
-	42, // 49: base.v1.RelationshipReadRequest.metadata:type_name -> base.v1.RelationshipReadRequestMetadata
-	82, // 50: base.v1.RelationshipReadRequest.filter:type_name -> base.v1.TupleFilter
-	80, // 51: base.v1.RelationshipReadResponse.tuples:type_name -> base.v1.Tuple
-	45, // 52: base.v1.AttributeReadRequest.metadata:type_name -> base.v1.AttributeReadRequestMetadata
-	83, // 53: base.v1.AttributeReadRequest.filter:type_name -> base.v1.AttributeFilter
-	81, // 54: base.v1.AttributeReadResponse.attributes:type_name -> base.v1.Attribute
-	82, // 55: base.v1.DataDeleteRequest.tuple_filter:type_name -> base.v1.TupleFilter
-	83, // 56: base.v1.DataDeleteRequest.attribute_filter:type_name -> base.v1.AttributeFilter
-	82, // 57: base.v1.RelationshipDeleteRequest.filter:type_name -> base.v1.TupleFilter
-	69, // 58: base.v1.BundleRunRequest.arguments:type_name -> base.v1.BundleRunRequest.ArgumentsEntry
-	84, // 59: base.v1.BundleWriteRequest.bundles:type_name -> base.v1.DataBundle
-	84, // 60: base.v1.BundleReadResponse.bundle:type_name -> base.v1.DataBundle
-	85, // 61: base.v1.TenantCreateResponse.tenant:type_name -> base.v1.Tenant
-	85, // 62: base.v1.TenantListResponse.tenants:type_name -> base.v1.Tenant
-	86, // 63: base.v1.PermissionLookupEntityRequest.ScopeEntry.value:type_name -> base.v1.StringArrayValue
-	86, // 64: base.v1.PermissionEntityFilterRequest.ScopeEntry.value:type_name -> base.v1.StringArrayValue
-	74, // 65: base.v1.PermissionSubjectPermissionResponse.ResultsEntry.value:type_name -> base.v1.CheckResult
-	87, // 66: base.v1.SchemaPartialWriteRequest.PartialsEntry.value:type_name -> base.v1.Partials
-	0,  // 67: base.v1.Permission.Check:input_type -> base.v1.PermissionCheckRequest
-	5,  // 68: base.v1.Permission.BulkCheck:input_type -> base.v1.PermissionBulkCheckRequest
-	7,  // 69: base.v1.Permission.Expand:input_type -> base.v1.PermissionExpandRequest
-	10, // 70: base.v1.Permission.LookupEntity:input_type -> base.v1.PermissionLookupEntityRequest
-	10, // 71: base.v1.Permission.LookupEntityStream:input_type -> base.v1.PermissionLookupEntityRequest
-	16, // 72: base.v1.Permission.LookupSubject:input_type -> base.v1.PermissionLookupSubjectRequest
-	19, // 73: base.v1.Permission.SubjectPermission:input_type -> base.v1.PermissionSubjectPermissionRequest
-	22, // 74: base.v1.Watch.Watch:input_type -> base.v1.WatchRequest
-	24, // 75: base.v1.Schema.Write:input_type -> base.v1.SchemaWriteRequest
-	26, // 76: base.v1.Schema.PartialWrite:input_type -> base.v1.SchemaPartialWriteRequest
-	29, // 77: base.v1.Schema.Read:input_type -> base.v1.SchemaReadRequest
-	32, // 78: base.v1.Schema.List:input_type -> base.v1.SchemaListRequest
-	35, // 79: base.v1.Data.Write:input_type -> base.v1.DataWriteRequest
-	38, // 80: base.v1.Data.WriteRelationships:input_type -> base.v1.RelationshipWriteRequest
-	41, // 81: base.v1.Data.ReadRelationships:input_type -> base.v1.RelationshipReadRequest
-	44, // 82: base.v1.Data.ReadAttributes:input_type -> base.v1.AttributeReadRequest
-	47, // 83: base.v1.Data.Delete:input_type -> base.v1.DataDeleteRequest
-	49, // 84: base.v1.Data.DeleteRelationships:input_type -> base.v1.RelationshipDeleteRequest
-	51, // 85: base.v1.Data.RunBundle:input_type -> base.v1.BundleRunRequest
-	53, // 86: base.v1.Bundle.Write:input_type -> base.v1.BundleWriteRequest
-	55, // 87: base.v1.Bundle.Read:input_type -> base.v1.BundleReadRequest
-	57, // 88: base.v1.Bundle.Delete:input_type -> base.v1.BundleDeleteRequest
-	59, // 89: base.v1.Tenancy.Create:input_type -> base.v1.TenantCreateRequest
-	61, // 90: base.v1.Tenancy.Delete:input_type -> base.v1.TenantDeleteRequest
-	63, // 91: base.v1.Tenancy.List:input_type -> base.v1.TenantListRequest
-	2,  // 92: base.v1.Permission.Check:output_type -> base.v1.PermissionCheckResponse
-	6,  // 93: base.v1.Permission.BulkCheck:output_type -> base.v1.PermissionBulkCheckResponse
-	9,  // 94: base.v1.Permission.Expand:output_type -> base.v1.PermissionExpandResponse
-	12, // 95: base.v1.Permission.LookupEntity:output_type -> base.v1.PermissionLookupEntityResponse
-	13, // 96: base.v1.Permission.LookupEntityStream:output_type -> base.v1.PermissionLookupEntityStreamResponse
-	18, // 97: base.v1.Permission.LookupSubject:output_type -> base.v1.PermissionLookupSubjectResponse
-	21, // 98: base.v1.Permission.SubjectPermission:output_type -> base.v1.PermissionSubjectPermissionResponse
-	23, // 99: base.v1.Watch.Watch:output_type -> base.v1.WatchResponse
-	25, // 100: base.v1.Schema.Write:output_type -> base.v1.SchemaWriteResponse
-	28, // 101: base.v1.Schema.PartialWrite:output_type -> base.v1.SchemaPartialWriteResponse
-	31, // 102: base.v1.Schema.Read:output_type -> base.v1.SchemaReadResponse
-	33, // 103: base.v1.Schema.List:output_type -> base.v1.SchemaListResponse
-	37, // 104: base.v1.Data.Write:output_type -> base.v1.DataWriteResponse
-	40, // 105: base.v1.Data.WriteRelationships:output_type -> base.v1.RelationshipWriteResponse
-	43, // 106: base.v1.Data.ReadRelationships:output_type -> base.v1.RelationshipReadResponse
-	46, // 107: base.v1.Data.ReadAttributes:output_type -> base.v1.AttributeReadResponse
-	48, // 108: base.v1.Data.Delete:output_type -> base.v1.DataDeleteResponse
-	50, // 109: base.v1.Data.DeleteRelationships:output_type -> base.v1.RelationshipDeleteResponse
-	52, // 110: base.v1.Data.RunBundle:output_type -> base.v1.BundleRunResponse
-	54, // 111: base.v1.Bundle.Write:output_type -> base.v1.BundleWriteResponse
-	56, // 112: base.v1.Bundle.Read:output_type -> base.v1.BundleReadResponse
-	58, // 113: base.v1.Bundle.Delete:output_type -> base.v1.BundleDeleteResponse
-	60, // 114: base.v1.Tenancy.Create:output_type -> base.v1.TenantCreateResponse
-	62, // 115: base.v1.Tenancy.Delete:output_type -> base.v1.TenantDeleteResponse
-	64, // 116: base.v1.Tenancy.List:output_type -> base.v1.TenantListResponse
-	92, // [92:117] is the sub-list for method output_type
-	67, // [67:92] is the sub-list for method input_type
-	67, // [67:67] is the sub-list for extension type_name
-	67, // [67:67] is the sub-list for extension extendee
-	0,  // [0:67] is the sub-list for field type_name
+	3,   // 0: base.v1.PermissionCheckRequest.metadata:type_name -> base.v1.PermissionCheckRequestMetadata
+	120, // 1: base.v1.PermissionCheckRequest.entity:type_name -> base.v1.Entity
+	121, // 2: base.v1.PermissionCheckRequest.subject:type_name -> base.v1.Subject
+	122, // 3: base.v1.PermissionCheckRequest.context:type_name -> base.v1.Context
+	123, // 4: base.v1.PermissionCheckRequest.arguments:type_name -> base.v1.Argument
+	124, // 5: base.v1.PermissionCheckRequestMetadata.at_time:type_name -> google.protobuf.Timestamp
+	125, // 6: base.v1.PermissionCheckRequestMetadata.consistency:type_name -> base.v1.Consistency
+	126, // 7: base.v1.PermissionCheckResponse.can:type_name -> base.v1.CheckResult
+	5,   // 8: base.v1.PermissionCheckResponse.metadata:type_name -> base.v1.PermissionCheckResponseMetadata
+	120, // 9: base.v1.PermissionBulkCheckRequestItem.entity:type_name -> base.v1.Entity
+	121, // 10: base.v1.PermissionBulkCheckRequestItem.subject:type_name -> base.v1.Subject
+	3,   // 11: base.v1.PermissionBulkCheckRequest.metadata:type_name -> base.v1.PermissionCheckRequestMetadata
+	6,   // 12: base.v1.PermissionBulkCheckRequest.items:type_name -> base.v1.PermissionBulkCheckRequestItem
+	122, // 13: base.v1.PermissionBulkCheckRequest.context:type_name -> base.v1.Context
+	123, // 14: base.v1.PermissionBulkCheckRequest.arguments:type_name -> base.v1.Argument
+	4,   // 15: base.v1.PermissionBulkCheckResponse.results:type_name -> base.v1.PermissionCheckResponse
+	10,  // 16: base.v1.PermissionExpandRequest.metadata:type_name -> base.v1.PermissionExpandRequestMetadata
+	120, // 17: base.v1.PermissionExpandRequest.entity:type_name -> base.v1.Entity
+	122, // 18: base.v1.PermissionExpandRequest.context:type_name -> base.v1.Context
+	123, // 19: base.v1.PermissionExpandRequest.arguments:type_name -> base.v1.Argument
+	124, // 20: base.v1.PermissionExpandRequestMetadata.at_time:type_name -> google.protobuf.Timestamp
+	125, // 21: base.v1.PermissionExpandRequestMetadata.consistency:type_name -> base.v1.Consistency
+	127, // 22: base.v1.PermissionExpandResponse.tree:type_name -> base.v1.Expand
+	13,  // 23: base.v1.PermissionLookupEntityRequest.metadata:type_name -> base.v1.PermissionLookupEntityRequestMetadata
+	121, // 24: base.v1.PermissionLookupEntityRequest.subject:type_name -> base.v1.Subject
+	122, // 25: base.v1.PermissionLookupEntityRequest.context:type_name -> base.v1.Context
+	115, // 26: base.v1.PermissionLookupEntityRequest.scope:type_name -> base.v1.PermissionLookupEntityRequest.ScopeEntry
+	128, // 27: base.v1.PermissionLookupEntityRequest.predicates:type_name -> base.v1.AttributePredicate
+	124, // 28: base.v1.PermissionLookupEntityRequestMetadata.at_time:type_name -> google.protobuf.Timestamp
+	125, // 29: base.v1.PermissionLookupEntityRequestMetadata.consistency:type_name -> base.v1.Consistency
+	17,  // 30: base.v1.PermissionEntityFilterRequest.metadata:type_name -> base.v1.PermissionEntityFilterRequestMetadata
+	129, // 31: base.v1.PermissionEntityFilterRequest.entrance:type_name -> base.v1.Entrance
+	121, // 32: base.v1.PermissionEntityFilterRequest.subject:type_name -> base.v1.Subject
+	122, // 33: base.v1.PermissionEntityFilterRequest.context:type_name -> base.v1.Context
+	116, // 34: base.v1.PermissionEntityFilterRequest.scope:type_name -> base.v1.PermissionEntityFilterRequest.ScopeEntry
+	19,  // 35: base.v1.PermissionLookupSubjectRequest.metadata:type_name -> base.v1.PermissionLookupSubjectRequestMetadata
+	120, // 36: base.v1.PermissionLookupSubjectRequest.entity:type_name -> base.v1.Entity
+	130, // 37: base.v1.PermissionLookupSubjectRequest.subject_reference:type_name -> base.v1.RelationReference
+	122, // 38: base.v1.PermissionLookupSubjectRequest.context:type_name -> base.v1.Context
+	123, // 39: base.v1.PermissionLookupSubjectRequest.arguments:type_name -> base.v1.Argument
+	128, // 40: base.v1.PermissionLookupSubjectRequest.predicates:type_name -> base.v1.AttributePredicate
+	124, // 41: base.v1.PermissionLookupSubjectRequestMetadata.at_time:type_name -> google.protobuf.Timestamp
+	125, // 42: base.v1.PermissionLookupSubjectRequestMetadata.consistency:type_name -> base.v1.Consistency
+	22,  // 43: base.v1.PermissionSubjectPermissionRequest.metadata:type_name -> base.v1.PermissionSubjectPermissionRequestMetadata
+	120, // 44: base.v1.PermissionSubjectPermissionRequest.entity:type_name -> base.v1.Entity
+	121, // 45: base.v1.PermissionSubjectPermissionRequest.subject:type_name -> base.v1.Subject
+	122, // 46: base.v1.PermissionSubjectPermissionRequest.context:type_name -> base.v1.Context
+	125, // 47: base.v1.PermissionSubjectPermissionRequestMetadata.consistency:type_name -> base.v1.Consistency
+	117, // 48: base.v1.PermissionSubjectPermissionResponse.results:type_name -> base.v1.PermissionSubjectPermissionResponse.ResultsEntry
+	25,  // 49: base.v1.PermissionLookupEntitlementsRequest.metadata:type_name -> base.v1.PermissionLookupEntitlementsRequestMetadata
+	121, // 50: base.v1.PermissionLookupEntitlementsRequest.subject:type_name -> base.v1.Subject
+	122, // 51: base.v1.PermissionLookupEntitlementsRequest.context:type_name -> base.v1.Context
+	125, // 52: base.v1.PermissionLookupEntitlementsRequestMetadata.consistency:type_name -> base.v1.Consistency
+	28,  // 53: base.v1.PermissionSimulateRequest.changes:type_name -> base.v1.SimulationChanges
+	2,   // 54: base.v1.PermissionSimulateRequest.check:type_name -> base.v1.PermissionCheckRequest
+	12,  // 55: base.v1.PermissionSimulateRequest.lookup_entity:type_name -> base.v1.PermissionLookupEntityRequest
+	18,  // 56: base.v1.PermissionSimulateRequest.lookup_subject:type_name -> base.v1.PermissionLookupSubjectRequest
+	21,  // 57: base.v1.PermissionSimulateRequest.subject_permission:type_name -> base.v1.PermissionSubjectPermissionRequest
+	131, // 58: base.v1.SimulationChanges.write_tuples:type_name -> base.v1.Tuple
+	132, // 59: base.v1.SimulationChanges.write_attributes:type_name -> base.v1.Attribute
+	131, // 60: base.v1.SimulationChanges.delete_tuples:type_name -> base.v1.Tuple
+	132, // 61: base.v1.SimulationChanges.delete_attributes:type_name -> base.v1.Attribute
+	133, // 62: base.v1.WatchResponse.changes:type_name -> base.v1.DataChanges
+	134, // 63: base.v1.SchemaWriteRequest.metadata:type_name -> base.v1.TransactionMetadata
+	35,  // 64: base.v1.SchemaPartialWriteRequest.metadata:type_name -> base.v1.SchemaPartialWriteRequestMetadata
+	118, // 65: base.v1.SchemaPartialWriteRequest.partials:type_name -> base.v1.SchemaPartialWriteRequest.PartialsEntry
+	38,  // 66: base.v1.SchemaReadRequest.metadata:type_name -> base.v1.SchemaReadRequestMetadata
+	135, // 67: base.v1.SchemaReadResponse.schema:type_name -> base.v1.SchemaDefinition
+	42,  // 68: base.v1.SchemaListResponse.schemas:type_name -> base.v1.SchemaList
+	134, // 69: base.v1.SchemaList.metadata:type_name -> base.v1.TransactionMetadata
+	45,  // 70: base.v1.SchemaLintResponse.findings:type_name -> base.v1.SchemaLintFinding
+	1,   // 71: base.v1.SchemaLintFinding.severity:type_name -> base.v1.SchemaLintFinding.Severity
+	46,  // 72: base.v1.SchemaShadowWriteResponse.shadow:type_name -> base.v1.SchemaShadow
+	46,  // 73: base.v1.SchemaShadowReadResponse.shadow:type_name -> base.v1.SchemaShadow
+	62,  // 74: base.v1.DataWriteRequest.metadata:type_name -> base.v1.DataWriteRequestMetadata
+	131, // 75: base.v1.DataWriteRequest.tuples:type_name -> base.v1.Tuple
+	132, // 76: base.v1.DataWriteRequest.attributes:type_name -> base.v1.Attribute
+	136, // 77: base.v1.DataWriteRequest.preconditions:type_name -> base.v1.Precondition
+	134, // 78: base.v1.DataWriteRequestMetadata.metadata:type_name -> base.v1.TransactionMetadata
+	65,  // 79: base.v1.DataImportRequest.metadata:type_name -> base.v1.DataImportRequestMetadata
+	131, // 80: base.v1.DataImportRequest.tuples:type_name -> base.v1.Tuple
+	132, // 81: base.v1.DataImportRequest.attributes:type_name -> base.v1.Attribute
+	66,  // 82: base.v1.DataImportResponse.rejections:type_name -> base.v1.DataImportRejection
+	69,  // 83: base.v1.RelationshipWriteRequest.metadata:type_name -> base.v1.RelationshipWriteRequestMetadata
+	131, // 84: base.v1.RelationshipWriteRequest.tuples:type_name -> base.v1.Tuple
+	72,  // 85: base.v1.RelationshipReadRequest.metadata:type_name -> base.v1.RelationshipReadRequestMetadata
+	137, // 86: base.v1.RelationshipReadRequest.filter:type_name -> base.v1.TupleFilter
+	124, // 87: base.v1.RelationshipReadRequestMetadata.at_time:type_name -> google.protobuf.Timestamp
+	125, // 88: base.v1.RelationshipReadRequestMetadata.consistency:type_name -> base.v1.Consistency
+	131, // 89: base.v1.RelationshipReadResponse.tuples:type_name -> base.v1.Tuple
+	75,  // 90: base.v1.AttributeReadRequest.metadata:type_name -> base.v1.AttributeReadRequestMetadata
+	138, // 91: base.v1.AttributeReadRequest.filter:type_name -> base.v1.AttributeFilter
+	125, // 92: base.v1.AttributeReadRequestMetadata.consistency:type_name -> base.v1.Consistency
+	132, // 93: base.v1.AttributeReadResponse.attributes:type_name -> base.v1.Attribute
+	137, // 94: base.v1.DataReadHistoryRequest.tuple_filter:type_name -> base.v1.TupleFilter
+	138, // 95: base.v1.DataReadHistoryRequest.attribute_filter:type_name -> base.v1.AttributeFilter
+	131, // 96: base.v1.DataHistoryRecord.tuple:type_name -> base.v1.Tuple
+	132, // 97: base.v1.DataHistoryRecord.attribute:type_name -> base.v1.Attribute
+	124, // 98: base.v1.DataHistoryRecord.created_at:type_name -> google.protobuf.Timestamp
+	124, // 99: base.v1.DataHistoryRecord.expired_at:type_name -> google.protobuf.Timestamp
+	134, // 100: base.v1.DataHistoryRecord.created_metadata:type_name -> base.v1.TransactionMetadata
+	134, // 101: base.v1.DataHistoryRecord.expired_metadata:type_name -> base.v1.TransactionMetadata
+	78,  // 102: base.v1.DataReadHistoryResponse.records:type_name -> base.v1.DataHistoryRecord
+	81,  // 103: base.v1.DataVerifyRequest.metadata:type_name -> base.v1.DataVerifyRequestMetadata
+	0,   // 104: base.v1.DataVerifyRequest.action:type_name -> base.v1.DataVerifyAction
+	134, // 105: base.v1.DataVerifyRequestMetadata.metadata:type_name -> base.v1.TransactionMetadata
+	131, // 106: base.v1.DataViolation.tuple:type_name -> base.v1.Tuple
+	132, // 107: base.v1.DataViolation.attribute:type_name -> base.v1.Attribute
+	82,  // 108: base.v1.DataVerifyResponse.violation:type_name -> base.v1.DataViolation
+	83,  // 109: base.v1.DataVerifyResponse.summary:type_name -> base.v1.DataVerifySummary
+	86,  // 110: base.v1.DataAnalyzeRequest.metadata:type_name -> base.v1.DataAnalyzeRequestMetadata
+	87,  // 111: base.v1.DataRecursiveRelation.cycles:type_name -> base.v1.DataCycle
+	120, // 112: base.v1.DataHotspot.entity:type_name -> base.v1.Entity
+	88,  // 113: base.v1.DataAnalyzeResponse.recursive_relations:type_name -> base.v1.DataRecursiveRelation
+	89,  // 114: base.v1.DataAnalyzeResponse.fan_out:type_name -> base.v1.DataHotspot
+	89,  // 115: base.v1.DataAnalyzeResponse.fan_in:type_name -> base.v1.DataHotspot
+	137, // 116: base.v1.DataDeleteRequest.tuple_filter:type_name -> base.v1.TupleFilter
+	138, // 117: base.v1.DataDeleteRequest.attribute_filter:type_name -> base.v1.AttributeFilter
+	136, // 118: base.v1.DataDeleteRequest.preconditions:type_name -> base.v1.Precondition
+	134, // 119: base.v1.DataDeleteRequest.metadata:type_name -> base.v1.TransactionMetadata
+	137, // 120: base.v1.RelationshipDeleteRequest.filter:type_name -> base.v1.TupleFilter
+	119, // 121: base.v1.BundleRunRequest.arguments:type_name -> base.v1.BundleRunRequest.ArgumentsEntry
+	136, // 122: base.v1.BundleRunRequest.preconditions:type_name -> base.v1.Precondition
+	134, // 123: base.v1.BundleRunRequest.metadata:type_name -> base.v1.TransactionMetadata
+	139, // 124: base.v1.BundleWriteRequest.bundles:type_name -> base.v1.DataBundle
+	139, // 125: base.v1.BundleReadResponse.bundle:type_name -> base.v1.DataBundle
+	140, // 126: base.v1.TenantCreateResponse.tenant:type_name -> base.v1.Tenant
+	140, // 127: base.v1.TenantListResponse.tenants:type_name -> base.v1.Tenant
+	124, // 128: base.v1.AuditFilter.start_time:type_name -> google.protobuf.Timestamp
+	124, // 129: base.v1.AuditFilter.end_time:type_name -> google.protobuf.Timestamp
+	109, // 130: base.v1.AuditListRequest.filter:type_name -> base.v1.AuditFilter
+	141, // 131: base.v1.AuditListResponse.records:type_name -> base.v1.AuditRecord
+	113, // 132: base.v1.AuditAccessReviewRequest.metadata:type_name -> base.v1.AuditAccessReviewRequestMetadata
+	142, // 133: base.v1.AuditAccessReviewResponse.entry:type_name -> base.v1.AccessReviewEntry
+	143, // 134: base.v1.PermissionLookupEntityRequest.ScopeEntry.value:type_name -> base.v1.StringArrayValue
+	143, // 135: base.v1.PermissionEntityFilterRequest.ScopeEntry.value:type_name -> base.v1.StringArrayValue
+	126, // 136: base.v1.PermissionSubjectPermissionResponse.ResultsEntry.value:type_name -> base.v1.CheckResult
+	144, // 137: base.v1.SchemaPartialWriteRequest.PartialsEntry.value:type_name -> base.v1.Partials
+	2,   // 138: base.v1.Permission.Check:input_type -> base.v1.PermissionCheckRequest
+	7,   // 139: base.v1.Permission.BulkCheck:input_type -> base.v1.PermissionBulkCheckRequest
+	9,   // 140: base.v1.Permission.Expand:input_type -> base.v1.PermissionExpandRequest
+	12,  // 141: base.v1.Permission.LookupEntity:input_type -> base.v1.PermissionLookupEntityRequest
+	12,  // 142: base.v1.Permission.LookupEntityStream:input_type -> base.v1.PermissionLookupEntityRequest
+	24,  // 143: base.v1.Permission.LookupEntitlements:input_type -> base.v1.PermissionLookupEntitlementsRequest
+	18,  // 144: base.v1.Permission.LookupSubject:input_type -> base.v1.PermissionLookupSubjectRequest
+	21,  // 145: base.v1.Permission.SubjectPermission:input_type -> base.v1.PermissionSubjectPermissionRequest
+	27,  // 146: base.v1.Permission.Simulate:input_type -> base.v1.PermissionSimulateRequest
+	30,  // 147: base.v1.Watch.Watch:input_type -> base.v1.WatchRequest
+	32,  // 148: base.v1.Schema.Write:input_type -> base.v1.SchemaWriteRequest
+	34,  // 149: base.v1.Schema.PartialWrite:input_type -> base.v1.SchemaPartialWriteRequest
+	37,  // 150: base.v1.Schema.Read:input_type -> base.v1.SchemaReadRequest
+	40,  // 151: base.v1.Schema.List:input_type -> base.v1.SchemaListRequest
+	43,  // 152: base.v1.Schema.Lint:input_type -> base.v1.SchemaLintRequest
+	47,  // 153: base.v1.Schema.ShadowWrite:input_type -> base.v1.SchemaShadowWriteRequest
+	49,  // 154: base.v1.Schema.ShadowRead:input_type -> base.v1.SchemaShadowReadRequest
+	51,  // 155: base.v1.Schema.ShadowPromote:input_type -> base.v1.SchemaShadowPromoteRequest
+	53,  // 156: base.v1.Schema.ShadowRollback:input_type -> base.v1.SchemaShadowRollbackRequest
+	55,  // 157: base.v1.Schema.Tag:input_type -> base.v1.SchemaTagRequest
+	57,  // 158: base.v1.Schema.Untag:input_type -> base.v1.SchemaUntagRequest
+	59,  // 159: base.v1.Schema.Activate:input_type -> base.v1.SchemaActivateRequest
+	61,  // 160: base.v1.Data.Write:input_type -> base.v1.DataWriteRequest
+	68,  // 161: base.v1.Data.WriteRelationships:input_type -> base.v1.RelationshipWriteRequest
+	71,  // 162: base.v1.Data.ReadRelationships:input_type -> base.v1.RelationshipReadRequest
+	74,  // 163: base.v1.Data.ReadAttributes:input_type -> base.v1.AttributeReadRequest
+	91,  // 164: base.v1.Data.Delete:input_type -> base.v1.DataDeleteRequest
+	93,  // 165: base.v1.Data.DeleteRelationships:input_type -> base.v1.RelationshipDeleteRequest
+	95,  // 166: base.v1.Data.RunBundle:input_type -> base.v1.BundleRunRequest
+	77,  // 167: base.v1.Data.ReadHistory:input_type -> base.v1.DataReadHistoryRequest
+	80,  // 168: base.v1.Data.Verify:input_type -> base.v1.DataVerifyRequest
+	85,  // 169: base.v1.Data.Analyze:input_type -> base.v1.DataAnalyzeRequest
+	64,  // 170: base.v1.Data.Import:input_type -> base.v1.DataImportRequest
+	97,  // 171: base.v1.Bundle.Write:input_type -> base.v1.BundleWriteRequest
+	99,  // 172: base.v1.Bundle.Read:input_type -> base.v1.BundleReadRequest
+	101, // 173: base.v1.Bundle.Delete:input_type -> base.v1.BundleDeleteRequest
+	103, // 174: base.v1.Tenancy.Create:input_type -> base.v1.TenantCreateRequest
+	105, // 175: base.v1.Tenancy.Delete:input_type -> base.v1.TenantDeleteRequest
+	107, // 176: base.v1.Tenancy.List:input_type -> base.v1.TenantListRequest
+	110, // 177: base.v1.Audit.List:input_type -> base.v1.AuditListRequest
+	112, // 178: base.v1.Audit.AccessReview:input_type -> base.v1.AuditAccessReviewRequest
+	4,   // 179: base.v1.Permission.Check:output_type -> base.v1.PermissionCheckResponse
+	8,   // 180: base.v1.Permission.BulkCheck:output_type -> base.v1.PermissionBulkCheckResponse
+	11,  // 181: base.v1.Permission.Expand:output_type -> base.v1.PermissionExpandResponse
+	14,  // 182: base.v1.Permission.LookupEntity:output_type -> base.v1.PermissionLookupEntityResponse
+	15,  // 183: base.v1.Permission.LookupEntityStream:output_type -> base.v1.PermissionLookupEntityStreamResponse
+	26,  // 184: base.v1.Permission.LookupEntitlements:output_type -> base.v1.PermissionLookupEntitlementsStreamResponse
+	20,  // 185: base.v1.Permission.LookupSubject:output_type -> base.v1.PermissionLookupSubjectResponse
+	23,  // 186: base.v1.Permission.SubjectPermission:output_type -> base.v1.PermissionSubjectPermissionResponse
+	29,  // 187: base.v1.Permission.Simulate:output_type -> base.v1.PermissionSimulateResponse
+	31,  // 188: base.v1.Watch.Watch:output_type -> base.v1.WatchResponse
+	33,  // 189: base.v1.Schema.Write:output_type -> base.v1.SchemaWriteResponse
+	36,  // 190: base.v1.Schema.PartialWrite:output_type -> base.v1.SchemaPartialWriteResponse
+	39,  // 191: base.v1.Schema.Read:output_type -> base.v1.SchemaReadResponse
+	41,  // 192: base.v1.Schema.List:output_type -> base.v1.SchemaListResponse
+	44,  // 193: base.v1.Schema.Lint:output_type -> base.v1.SchemaLintResponse
+	48,  // 194: base.v1.Schema.ShadowWrite:output_type -> base.v1.SchemaShadowWriteResponse
+	50,  // 195: base.v1.Schema.ShadowRead:output_type -> base.v1.SchemaShadowReadResponse
+	52,  // 196: base.v1.Schema.ShadowPromote:output_type -> base.v1.SchemaShadowPromoteResponse
+	54,  // 197: base.v1.Schema.ShadowRollback:output_type -> base.v1.SchemaShadowRollbackResponse
+	56,  // 198: base.v1.Schema.Tag:output_type -> base.v1.SchemaTagResponse
+	58,  // 199: base.v1.Schema.Untag:output_type -> base.v1.SchemaUntagResponse
+	60,  // 200: base.v1.Schema.Activate:output_type -> base.v1.SchemaActivateResponse
+	63,  // 201: base.v1.Data.Write:output_type -> base.v1.DataWriteResponse
+	70,  // 202: base.v1.Data.WriteRelationships:output_type -> base.v1.RelationshipWriteResponse
+	73,  // 203: base.v1.Data.ReadRelationships:output_type -> base.v1.RelationshipReadResponse
+	76,  // 204: base.v1.Data.ReadAttributes:output_type -> base.v1.AttributeReadResponse
+	92,  // 205: base.v1.Data.Delete:output_type -> base.v1.DataDeleteResponse
+	94,  // 206: base.v1.Data.DeleteRelationships:output_type -> base.v1.RelationshipDeleteResponse
+	96,  // 207: base.v1.Data.RunBundle:output_type -> base.v1.BundleRunResponse
+	79,  // 208: base.v1.Data.ReadHistory:output_type -> base.v1.DataReadHistoryResponse
+	84,  // 209: base.v1.Data.Verify:output_type -> base.v1.DataVerifyResponse
+	90,  // 210: base.v1.Data.Analyze:output_type -> base.v1.DataAnalyzeResponse
+	67,  // 211: base.v1.Data.Import:output_type -> base.v1.DataImportResponse
+	98,  // 212: base.v1.Bundle.Write:output_type -> base.v1.BundleWriteResponse
+	100, // 213: base.v1.Bundle.Read:output_type -> base.v1.BundleReadResponse
+	102, // 214: base.v1.Bundle.Delete:output_type -> base.v1.BundleDeleteResponse
+	104, // 215: base.v1.Tenancy.Create:output_type -> base.v1.TenantCreateResponse
+	106, // 216: base.v1.Tenancy.Delete:output_type -> base.v1.TenantDeleteResponse
+	108, // 217: base.v1.Tenancy.List:output_type -> base.v1.TenantListResponse
+	111, // 218: base.v1.Audit.List:output_type -> base.v1.AuditListResponse
+	114, // 219: base.v1.Audit.AccessReview:output_type -> base.v1.AuditAccessReviewResponse
+	179, // [179:220] is the sub-list for method output_type
+	138, // [138:179] is the sub-list for method input_type
+	138, // [138:138] is the sub-list for extension type_name
+	138, // [138:138] is the sub-list for extension extendee
+	0,   // [0:138] is the sub-list for field type_name
 }
 
 func init() { file_base_v1_service_proto_init() }
@@ -5811,18 +10279,41 @@ func file_base_v1_service_proto_init() {
 		return
 	}
 	file_base_v1_base_proto_init()
+	file_base_v1_service_proto_msgTypes[25].OneofWrappers = []any{
+		(*PermissionSimulateRequest_Check)(nil),
+		(*PermissionSimulateRequest_LookupEntity)(nil),
+		(*PermissionSimulateRequest_LookupSubject)(nil),
+		(*PermissionSimulateRequest_SubjectPermission)(nil),
+	}
+	file_base_v1_service_proto_msgTypes[75].OneofWrappers = []any{
+		(*DataReadHistoryRequest_TupleFilter)(nil),
+		(*DataReadHistoryRequest_AttributeFilter)(nil),
+	}
+	file_base_v1_service_proto_msgTypes[76].OneofWrappers = []any{
+		(*DataHistoryRecord_Tuple)(nil),
+		(*DataHistoryRecord_Attribute)(nil),
+	}
+	file_base_v1_service_proto_msgTypes[80].OneofWrappers = []any{
+		(*DataViolation_Tuple)(nil),
+		(*DataViolation_Attribute)(nil),
+	}
+	file_base_v1_service_proto_msgTypes[82].OneofWrappers = []any{
+		(*DataVerifyResponse_Violation)(nil),
+		(*DataVerifyResponse_Summary)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_base_v1_service_proto_rawDesc), len(file_base_v1_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   70,
+			NumEnums:      2,
+			NumMessages:   118,
 			NumExtensions: 0,
-			NumServices:   6,
+			NumServices:   7,
 		},
 		GoTypes:           file_base_v1_service_proto_goTypes,
 		DependencyIndexes: file_base_v1_service_proto_depIdxs,
+		EnumInfos:         file_base_v1_service_proto_enumTypes,
 		MessageInfos:      file_base_v1_service_proto_msgTypes,
 	}.Build()
 	File_base_v1_service_proto = out.File
@@ -405,6 +405,66 @@ func (m *PermissionCheckRequestMetadata) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for SchemaTag
+
+	if all {
+		switch v := interface{}(m.GetAtTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionCheckRequestMetadataValidationError{
+					field:  "AtTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionCheckRequestMetadataValidationError{
+					field:  "AtTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAtTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionCheckRequestMetadataValidationError{
+				field:  "AtTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetConsistency()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionCheckRequestMetadataValidationError{
+					field:  "Consistency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionCheckRequestMetadataValidationError{
+					field:  "Consistency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConsistency()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionCheckRequestMetadataValidationError{
+				field:  "Consistency",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return PermissionCheckRequestMetadataMultiError(errors)
 	}
@@ -1665,6 +1725,66 @@ func (m *PermissionExpandRequestMetadata) validate(all bool) error {
 
 	// no validation rules for SnapToken
 
+	// no validation rules for SchemaTag
+
+	if all {
+		switch v := interface{}(m.GetAtTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionExpandRequestMetadataValidationError{
+					field:  "AtTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionExpandRequestMetadataValidationError{
+					field:  "AtTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAtTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionExpandRequestMetadataValidationError{
+				field:  "AtTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetConsistency()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionExpandRequestMetadataValidationError{
+					field:  "Consistency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionExpandRequestMetadataValidationError{
+					field:  "Consistency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConsistency()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionExpandRequestMetadataValidationError{
+				field:  "Consistency",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return PermissionExpandRequestMetadataMultiError(errors)
 	}
@@ -2139,6 +2259,51 @@ func (m *PermissionLookupEntityRequest) validate(all bool) error {
 
 	}
 
+	if len(m.GetPredicates()) > 10 {
+		err := PermissionLookupEntityRequestValidationError{
+			field:  "Predicates",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetPredicates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PermissionLookupEntityRequestValidationError{
+						field:  fmt.Sprintf("Predicates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PermissionLookupEntityRequestValidationError{
+						field:  fmt.Sprintf("Predicates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PermissionLookupEntityRequestValidationError{
+					field:  fmt.Sprintf("Predicates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return PermissionLookupEntityRequestMultiError(errors)
 	}
@@ -2264,6 +2429,66 @@ func (m *PermissionLookupEntityRequestMetadata) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for SchemaTag
+
+	if all {
+		switch v := interface{}(m.GetAtTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionLookupEntityRequestMetadataValidationError{
+					field:  "AtTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionLookupEntityRequestMetadataValidationError{
+					field:  "AtTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAtTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionLookupEntityRequestMetadataValidationError{
+				field:  "AtTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetConsistency()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionLookupEntityRequestMetadataValidationError{
+					field:  "Consistency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionLookupEntityRequestMetadataValidationError{
+					field:  "Consistency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConsistency()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionLookupEntityRequestMetadataValidationError{
+				field:  "Consistency",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return PermissionLookupEntityRequestMetadataMultiError(errors)
 	}
@@ -3252,6 +3477,51 @@ func (m *PermissionLookupSubjectRequest) validate(all bool) error {
 
 	}
 
+	if len(m.GetPredicates()) > 10 {
+		err := PermissionLookupSubjectRequestValidationError{
+			field:  "Predicates",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetPredicates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PermissionLookupSubjectRequestValidationError{
+						field:  fmt.Sprintf("Predicates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PermissionLookupSubjectRequestValidationError{
+						field:  fmt.Sprintf("Predicates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PermissionLookupSubjectRequestValidationError{
+					field:  fmt.Sprintf("Predicates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return PermissionLookupSubjectRequestMultiError(errors)
 	}
@@ -3376,6 +3646,66 @@ func (m *PermissionLookupSubjectRequestMetadata) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for SchemaTag
+
+	if all {
+		switch v := interface{}(m.GetAtTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionLookupSubjectRequestMetadataValidationError{
+					field:  "AtTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionLookupSubjectRequestMetadataValidationError{
+					field:  "AtTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAtTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionLookupSubjectRequestMetadataValidationError{
+				field:  "AtTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetConsistency()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionLookupSubjectRequestMetadataValidationError{
+					field:  "Consistency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionLookupSubjectRequestMetadataValidationError{
+					field:  "Consistency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConsistency()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionLookupSubjectRequestMetadataValidationError{
+				field:  "Consistency",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return PermissionLookupSubjectRequestMetadataMultiError(errors)
 	}
@@ -3882,18 +4212,49 @@ func (m *PermissionSubjectPermissionRequestMetadata) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(errors) > 0 {
-		return PermissionSubjectPermissionRequestMetadataMultiError(errors)
-	}
-
-	return nil
-}
+	// no validation rules for SchemaTag
 
-// PermissionSubjectPermissionRequestMetadataMultiError is an error wrapping
-// multiple validation errors returned by
-// PermissionSubjectPermissionRequestMetadata.ValidateAll() if the designated
-// constraints aren't met.
-type PermissionSubjectPermissionRequestMetadataMultiError []error
+	if all {
+		switch v := interface{}(m.GetConsistency()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionSubjectPermissionRequestMetadataValidationError{
+					field:  "Consistency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionSubjectPermissionRequestMetadataValidationError{
+					field:  "Consistency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConsistency()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionSubjectPermissionRequestMetadataValidationError{
+				field:  "Consistency",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PermissionSubjectPermissionRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionSubjectPermissionRequestMetadataMultiError is an error wrapping
+// multiple validation errors returned by
+// PermissionSubjectPermissionRequestMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type PermissionSubjectPermissionRequestMetadataMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
 func (m PermissionSubjectPermissionRequestMetadataMultiError) Error() string {
@@ -4071,22 +4432,23 @@ var _ interface {
 	ErrorName() string
 } = PermissionSubjectPermissionResponseValidationError{}
 
-// Validate checks the field values on WatchRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *WatchRequest) Validate() error {
+// Validate checks the field values on PermissionLookupEntitlementsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PermissionLookupEntitlementsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WatchRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in WatchRequestMultiError, or
-// nil if none found.
-func (m *WatchRequest) ValidateAll() error {
+// ValidateAll checks the field values on PermissionLookupEntitlementsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PermissionLookupEntitlementsRequestMultiError, or nil if none found.
+func (m *PermissionLookupEntitlementsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WatchRequest) validate(all bool) error {
+func (m *PermissionLookupEntitlementsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4094,7 +4456,7 @@ func (m *WatchRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetTenantId()) > 128 {
-		err := WatchRequestValidationError{
+		err := PermissionLookupEntitlementsRequestValidationError{
 			field:  "TenantId",
 			reason: "value length must be at most 128 bytes",
 		}
@@ -4104,8 +4466,8 @@ func (m *WatchRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_WatchRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := WatchRequestValidationError{
+	if !_PermissionLookupEntitlementsRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := PermissionLookupEntitlementsRequestValidationError{
 			field:  "TenantId",
 			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
 		}
@@ -4115,21 +4477,240 @@ func (m *WatchRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for SnapToken
+	if m.GetMetadata() == nil {
+		err := PermissionLookupEntitlementsRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionLookupEntitlementsRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionLookupEntitlementsRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionLookupEntitlementsRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetSubject() == nil {
+		err := PermissionLookupEntitlementsRequestValidationError{
+			field:  "Subject",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSubject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionLookupEntitlementsRequestValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionLookupEntitlementsRequestValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionLookupEntitlementsRequestValidationError{
+				field:  "Subject",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetEntityTypes()) > 100 {
+		err := PermissionLookupEntitlementsRequestValidationError{
+			field:  "EntityTypes",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetEntityTypes() {
+		_, _ = idx, item
+
+		if len(item) > 64 {
+			err := PermissionLookupEntitlementsRequestValidationError{
+				field:  fmt.Sprintf("EntityTypes[%v]", idx),
+				reason: "value length must be at most 64 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_PermissionLookupEntitlementsRequest_EntityTypes_Pattern.MatchString(item) {
+			err := PermissionLookupEntitlementsRequestValidationError{
+				field:  fmt.Sprintf("EntityTypes[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetPermissions()) > 100 {
+		err := PermissionLookupEntitlementsRequestValidationError{
+			field:  "Permissions",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetPermissions() {
+		_, _ = idx, item
+
+		if len(item) > 64 {
+			err := PermissionLookupEntitlementsRequestValidationError{
+				field:  fmt.Sprintf("Permissions[%v]", idx),
+				reason: "value length must be at most 64 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_PermissionLookupEntitlementsRequest_Permissions_Pattern.MatchString(item) {
+			err := PermissionLookupEntitlementsRequestValidationError{
+				field:  fmt.Sprintf("Permissions[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetContext()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionLookupEntitlementsRequestValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionLookupEntitlementsRequestValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContext()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionLookupEntitlementsRequestValidationError{
+				field:  "Context",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() < 1 {
+			err := PermissionLookupEntitlementsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetContinuousToken() != "" {
+
+	}
+
+	if m.GetCostLimit() != 0 {
+
+		if val := m.GetCostLimit(); val < 1 || val > 100000 {
+			err := PermissionLookupEntitlementsRequestValidationError{
+				field:  "CostLimit",
+				reason: "value must be inside range [1, 100000]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return WatchRequestMultiError(errors)
+		return PermissionLookupEntitlementsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WatchRequestMultiError is an error wrapping multiple validation errors
-// returned by WatchRequest.ValidateAll() if the designated constraints aren't met.
-type WatchRequestMultiError []error
+// PermissionLookupEntitlementsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// PermissionLookupEntitlementsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PermissionLookupEntitlementsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WatchRequestMultiError) Error() string {
+func (m PermissionLookupEntitlementsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4138,11 +4719,12 @@ func (m WatchRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WatchRequestMultiError) AllErrors() []error { return m }
+func (m PermissionLookupEntitlementsRequestMultiError) AllErrors() []error { return m }
 
-// WatchRequestValidationError is the validation error returned by
-// WatchRequest.Validate if the designated constraints aren't met.
-type WatchRequestValidationError struct {
+// PermissionLookupEntitlementsRequestValidationError is the validation error
+// returned by PermissionLookupEntitlementsRequest.Validate if the designated
+// constraints aren't met.
+type PermissionLookupEntitlementsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4150,22 +4732,24 @@ type WatchRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e WatchRequestValidationError) Field() string { return e.field }
+func (e PermissionLookupEntitlementsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WatchRequestValidationError) Reason() string { return e.reason }
+func (e PermissionLookupEntitlementsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WatchRequestValidationError) Cause() error { return e.cause }
+func (e PermissionLookupEntitlementsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WatchRequestValidationError) Key() bool { return e.key }
+func (e PermissionLookupEntitlementsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WatchRequestValidationError) ErrorName() string { return "WatchRequestValidationError" }
+func (e PermissionLookupEntitlementsRequestValidationError) ErrorName() string {
+	return "PermissionLookupEntitlementsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e WatchRequestValidationError) Error() string {
+func (e PermissionLookupEntitlementsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4177,14 +4761,14 @@ func (e WatchRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWatchRequest.%s: %s%s",
+		"invalid %sPermissionLookupEntitlementsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WatchRequestValidationError{}
+var _ error = PermissionLookupEntitlementsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4192,55 +4776,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WatchRequestValidationError{}
+} = PermissionLookupEntitlementsRequestValidationError{}
 
-var _WatchRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+var _PermissionLookupEntitlementsRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
 
-// Validate checks the field values on WatchResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+var _PermissionLookupEntitlementsRequest_EntityTypes_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+
+var _PermissionLookupEntitlementsRequest_Permissions_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+
+// Validate checks the field values on
+// PermissionLookupEntitlementsRequestMetadata with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *WatchResponse) Validate() error {
+func (m *PermissionLookupEntitlementsRequestMetadata) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WatchResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in WatchResponseMultiError, or
-// nil if none found.
-func (m *WatchResponse) ValidateAll() error {
+// ValidateAll checks the field values on
+// PermissionLookupEntitlementsRequestMetadata with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// PermissionLookupEntitlementsRequestMetadataMultiError, or nil if none found.
+func (m *PermissionLookupEntitlementsRequestMetadata) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WatchResponse) validate(all bool) error {
+func (m *PermissionLookupEntitlementsRequestMetadata) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for SchemaVersion
+
+	// no validation rules for SnapToken
+
+	if m.GetDepth() < 3 {
+		err := PermissionLookupEntitlementsRequestMetadataValidationError{
+			field:  "Depth",
+			reason: "value must be greater than or equal to 3",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SchemaTag
+
 	if all {
-		switch v := interface{}(m.GetChanges()).(type) {
+		switch v := interface{}(m.GetConsistency()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, WatchResponseValidationError{
-					field:  "Changes",
+				errors = append(errors, PermissionLookupEntitlementsRequestMetadataValidationError{
+					field:  "Consistency",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, WatchResponseValidationError{
-					field:  "Changes",
+				errors = append(errors, PermissionLookupEntitlementsRequestMetadataValidationError{
+					field:  "Consistency",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetChanges()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetConsistency()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return WatchResponseValidationError{
-				field:  "Changes",
+			return PermissionLookupEntitlementsRequestMetadataValidationError{
+				field:  "Consistency",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -4248,19 +4855,20 @@ func (m *WatchResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return WatchResponseMultiError(errors)
+		return PermissionLookupEntitlementsRequestMetadataMultiError(errors)
 	}
 
 	return nil
 }
 
-// WatchResponseMultiError is an error wrapping multiple validation errors
-// returned by WatchResponse.ValidateAll() if the designated constraints
-// aren't met.
-type WatchResponseMultiError []error
+// PermissionLookupEntitlementsRequestMetadataMultiError is an error wrapping
+// multiple validation errors returned by
+// PermissionLookupEntitlementsRequestMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type PermissionLookupEntitlementsRequestMetadataMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WatchResponseMultiError) Error() string {
+func (m PermissionLookupEntitlementsRequestMetadataMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4269,11 +4877,12 @@ func (m WatchResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WatchResponseMultiError) AllErrors() []error { return m }
+func (m PermissionLookupEntitlementsRequestMetadataMultiError) AllErrors() []error { return m }
 
-// WatchResponseValidationError is the validation error returned by
-// WatchResponse.Validate if the designated constraints aren't met.
-type WatchResponseValidationError struct {
+// PermissionLookupEntitlementsRequestMetadataValidationError is the validation
+// error returned by PermissionLookupEntitlementsRequestMetadata.Validate if
+// the designated constraints aren't met.
+type PermissionLookupEntitlementsRequestMetadataValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4281,22 +4890,24 @@ type WatchResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e WatchResponseValidationError) Field() string { return e.field }
+func (e PermissionLookupEntitlementsRequestMetadataValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WatchResponseValidationError) Reason() string { return e.reason }
+func (e PermissionLookupEntitlementsRequestMetadataValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WatchResponseValidationError) Cause() error { return e.cause }
+func (e PermissionLookupEntitlementsRequestMetadataValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WatchResponseValidationError) Key() bool { return e.key }
+func (e PermissionLookupEntitlementsRequestMetadataValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WatchResponseValidationError) ErrorName() string { return "WatchResponseValidationError" }
+func (e PermissionLookupEntitlementsRequestMetadataValidationError) ErrorName() string {
+	return "PermissionLookupEntitlementsRequestMetadataValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e WatchResponseValidationError) Error() string {
+func (e PermissionLookupEntitlementsRequestMetadataValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4308,14 +4919,14 @@ func (e WatchResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWatchResponse.%s: %s%s",
+		"invalid %sPermissionLookupEntitlementsRequestMetadata.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WatchResponseValidationError{}
+var _ error = PermissionLookupEntitlementsRequestMetadataValidationError{}
 
 var _ interface {
 	Field() string
@@ -4323,68 +4934,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WatchResponseValidationError{}
+} = PermissionLookupEntitlementsRequestMetadataValidationError{}
 
-// Validate checks the field values on SchemaWriteRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SchemaWriteRequest) Validate() error {
+// Validate checks the field values on
+// PermissionLookupEntitlementsStreamResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PermissionLookupEntitlementsStreamResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaWriteRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SchemaWriteRequestMultiError, or nil if none found.
-func (m *SchemaWriteRequest) ValidateAll() error {
+// ValidateAll checks the field values on
+// PermissionLookupEntitlementsStreamResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// PermissionLookupEntitlementsStreamResponseMultiError, or nil if none found.
+func (m *PermissionLookupEntitlementsStreamResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaWriteRequest) validate(all bool) error {
+func (m *PermissionLookupEntitlementsStreamResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 128 {
-		err := SchemaWriteRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 128 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for EntityType
 
-	if !_SchemaWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := SchemaWriteRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Permission
 
-	// no validation rules for Schema
+	// no validation rules for EntityId
+
+	// no validation rules for ContinuousToken
 
 	if len(errors) > 0 {
-		return SchemaWriteRequestMultiError(errors)
+		return PermissionLookupEntitlementsStreamResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaWriteRequestMultiError is an error wrapping multiple validation errors
-// returned by SchemaWriteRequest.ValidateAll() if the designated constraints
-// aren't met.
-type SchemaWriteRequestMultiError []error
+// PermissionLookupEntitlementsStreamResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// PermissionLookupEntitlementsStreamResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PermissionLookupEntitlementsStreamResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaWriteRequestMultiError) Error() string {
+func (m PermissionLookupEntitlementsStreamResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4393,11 +4991,12 @@ func (m SchemaWriteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaWriteRequestMultiError) AllErrors() []error { return m }
+func (m PermissionLookupEntitlementsStreamResponseMultiError) AllErrors() []error { return m }
 
-// SchemaWriteRequestValidationError is the validation error returned by
-// SchemaWriteRequest.Validate if the designated constraints aren't met.
-type SchemaWriteRequestValidationError struct {
+// PermissionLookupEntitlementsStreamResponseValidationError is the validation
+// error returned by PermissionLookupEntitlementsStreamResponse.Validate if
+// the designated constraints aren't met.
+type PermissionLookupEntitlementsStreamResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4405,24 +5004,24 @@ type SchemaWriteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SchemaWriteRequestValidationError) Field() string { return e.field }
+func (e PermissionLookupEntitlementsStreamResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaWriteRequestValidationError) Reason() string { return e.reason }
+func (e PermissionLookupEntitlementsStreamResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaWriteRequestValidationError) Cause() error { return e.cause }
+func (e PermissionLookupEntitlementsStreamResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaWriteRequestValidationError) Key() bool { return e.key }
+func (e PermissionLookupEntitlementsStreamResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaWriteRequestValidationError) ErrorName() string {
-	return "SchemaWriteRequestValidationError"
+func (e PermissionLookupEntitlementsStreamResponseValidationError) ErrorName() string {
+	return "PermissionLookupEntitlementsStreamResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SchemaWriteRequestValidationError) Error() string {
+func (e PermissionLookupEntitlementsStreamResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4434,14 +5033,14 @@ func (e SchemaWriteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaWriteRequest.%s: %s%s",
+		"invalid %sPermissionLookupEntitlementsStreamResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaWriteRequestValidationError{}
+var _ error = PermissionLookupEntitlementsStreamResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4449,48 +5048,182 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaWriteRequestValidationError{}
-
-var _SchemaWriteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+} = PermissionLookupEntitlementsStreamResponseValidationError{}
 
-// Validate checks the field values on SchemaWriteResponse with the rules
+// Validate checks the field values on PermissionSimulateRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SchemaWriteResponse) Validate() error {
+func (m *PermissionSimulateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaWriteResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PermissionSimulateRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SchemaWriteResponseMultiError, or nil if none found.
-func (m *SchemaWriteResponse) ValidateAll() error {
+// PermissionSimulateRequestMultiError, or nil if none found.
+func (m *PermissionSimulateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaWriteResponse) validate(all bool) error {
+func (m *PermissionSimulateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SchemaVersion
+	if len(m.GetTenantId()) > 128 {
+		err := PermissionSimulateRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_PermissionSimulateRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := PermissionSimulateRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetChanges() == nil {
+		err := PermissionSimulateRequestValidationError{
+			field:  "Changes",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetChanges()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionSimulateRequestValidationError{
+					field:  "Changes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionSimulateRequestValidationError{
+					field:  "Changes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChanges()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionSimulateRequestValidationError{
+				field:  "Changes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	oneofRequestPresent := false
+	switch v := m.Request.(type) {
+	case *PermissionSimulateRequest_Check:
+		if v == nil {
+			err := PermissionSimulateRequestValidationError{
+				field:  "Request",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofRequestPresent = true
+
+		// skipping validation for check
+
+	case *PermissionSimulateRequest_LookupEntity:
+		if v == nil {
+			err := PermissionSimulateRequestValidationError{
+				field:  "Request",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofRequestPresent = true
+
+		// skipping validation for lookup_entity
+
+	case *PermissionSimulateRequest_LookupSubject:
+		if v == nil {
+			err := PermissionSimulateRequestValidationError{
+				field:  "Request",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofRequestPresent = true
+
+		// skipping validation for lookup_subject
+
+	case *PermissionSimulateRequest_SubjectPermission:
+		if v == nil {
+			err := PermissionSimulateRequestValidationError{
+				field:  "Request",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofRequestPresent = true
+
+		// skipping validation for subject_permission
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofRequestPresent {
+		err := PermissionSimulateRequestValidationError{
+			field:  "Request",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return SchemaWriteResponseMultiError(errors)
+		return PermissionSimulateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaWriteResponseMultiError is an error wrapping multiple validation
-// errors returned by SchemaWriteResponse.ValidateAll() if the designated
-// constraints aren't met.
-type SchemaWriteResponseMultiError []error
+// PermissionSimulateRequestMultiError is an error wrapping multiple validation
+// errors returned by PermissionSimulateRequest.ValidateAll() if the
+// designated constraints aren't met.
+type PermissionSimulateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaWriteResponseMultiError) Error() string {
+func (m PermissionSimulateRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4499,11 +5232,11 @@ func (m SchemaWriteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaWriteResponseMultiError) AllErrors() []error { return m }
+func (m PermissionSimulateRequestMultiError) AllErrors() []error { return m }
 
-// SchemaWriteResponseValidationError is the validation error returned by
-// SchemaWriteResponse.Validate if the designated constraints aren't met.
-type SchemaWriteResponseValidationError struct {
+// PermissionSimulateRequestValidationError is the validation error returned by
+// PermissionSimulateRequest.Validate if the designated constraints aren't met.
+type PermissionSimulateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4511,24 +5244,24 @@ type SchemaWriteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SchemaWriteResponseValidationError) Field() string { return e.field }
+func (e PermissionSimulateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaWriteResponseValidationError) Reason() string { return e.reason }
+func (e PermissionSimulateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaWriteResponseValidationError) Cause() error { return e.cause }
+func (e PermissionSimulateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaWriteResponseValidationError) Key() bool { return e.key }
+func (e PermissionSimulateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaWriteResponseValidationError) ErrorName() string {
-	return "SchemaWriteResponseValidationError"
+func (e PermissionSimulateRequestValidationError) ErrorName() string {
+	return "PermissionSimulateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SchemaWriteResponseValidationError) Error() string {
+func (e PermissionSimulateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4540,14 +5273,14 @@ func (e SchemaWriteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaWriteResponse.%s: %s%s",
+		"invalid %sPermissionSimulateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaWriteResponseValidationError{}
+var _ error = PermissionSimulateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4555,152 +5288,182 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaWriteResponseValidationError{}
+} = PermissionSimulateRequestValidationError{}
 
-// Validate checks the field values on SchemaPartialWriteRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SchemaPartialWriteRequest) Validate() error {
+var _PermissionSimulateRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on SimulationChanges with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SimulationChanges) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaPartialWriteRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SimulationChanges with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SchemaPartialWriteRequestMultiError, or nil if none found.
-func (m *SchemaPartialWriteRequest) ValidateAll() error {
+// SimulationChangesMultiError, or nil if none found.
+func (m *SimulationChanges) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaPartialWriteRequest) validate(all bool) error {
+func (m *SimulationChanges) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 128 {
-		err := SchemaPartialWriteRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 128 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetWriteTuples() {
+		_, _ = idx, item
 
-	if !_SchemaPartialWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := SchemaPartialWriteRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SimulationChangesValidationError{
+						field:  fmt.Sprintf("WriteTuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SimulationChangesValidationError{
+						field:  fmt.Sprintf("WriteTuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SimulationChangesValidationError{
+					field:  fmt.Sprintf("WriteTuples[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
 
-	if m.GetMetadata() == nil {
-		err := SchemaPartialWriteRequestValidationError{
-			field:  "Metadata",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SchemaPartialWriteRequestValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetWriteAttributes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SimulationChangesValidationError{
+						field:  fmt.Sprintf("WriteAttributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SimulationChangesValidationError{
+						field:  fmt.Sprintf("WriteAttributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SchemaPartialWriteRequestValidationError{
-					field:  "Metadata",
+				return SimulationChangesValidationError{
+					field:  fmt.Sprintf("WriteAttributes[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SchemaPartialWriteRequestValidationError{
-				field:  "Metadata",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
-	{
-		sorted_keys := make([]string, len(m.GetPartials()))
-		i := 0
-		for key := range m.GetPartials() {
-			sorted_keys[i] = key
-			i++
+	for idx, item := range m.GetDeleteTuples() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SimulationChangesValidationError{
+						field:  fmt.Sprintf("DeleteTuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SimulationChangesValidationError{
+						field:  fmt.Sprintf("DeleteTuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SimulationChangesValidationError{
+					field:  fmt.Sprintf("DeleteTuples[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetPartials()[key]
-			_ = val
 
-			// no validation rules for Partials[key]
+	}
 
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, SchemaPartialWriteRequestValidationError{
-							field:  fmt.Sprintf("Partials[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, SchemaPartialWriteRequestValidationError{
-							field:  fmt.Sprintf("Partials[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
+	for idx, item := range m.GetDeleteAttributes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SimulationChangesValidationError{
+						field:  fmt.Sprintf("DeleteAttributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
 				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					return SchemaPartialWriteRequestValidationError{
-						field:  fmt.Sprintf("Partials[%v]", key),
+					errors = append(errors, SimulationChangesValidationError{
+						field:  fmt.Sprintf("DeleteAttributes[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
-					}
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SimulationChangesValidationError{
+					field:  fmt.Sprintf("DeleteAttributes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
 				}
 			}
-
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return SchemaPartialWriteRequestMultiError(errors)
+		return SimulationChangesMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaPartialWriteRequestMultiError is an error wrapping multiple validation
-// errors returned by SchemaPartialWriteRequest.ValidateAll() if the
-// designated constraints aren't met.
-type SchemaPartialWriteRequestMultiError []error
+// SimulationChangesMultiError is an error wrapping multiple validation errors
+// returned by SimulationChanges.ValidateAll() if the designated constraints
+// aren't met.
+type SimulationChangesMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaPartialWriteRequestMultiError) Error() string {
+func (m SimulationChangesMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4709,11 +5472,11 @@ func (m SchemaPartialWriteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaPartialWriteRequestMultiError) AllErrors() []error { return m }
+func (m SimulationChangesMultiError) AllErrors() []error { return m }
 
-// SchemaPartialWriteRequestValidationError is the validation error returned by
-// SchemaPartialWriteRequest.Validate if the designated constraints aren't met.
-type SchemaPartialWriteRequestValidationError struct {
+// SimulationChangesValidationError is the validation error returned by
+// SimulationChanges.Validate if the designated constraints aren't met.
+type SimulationChangesValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4721,24 +5484,24 @@ type SchemaPartialWriteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SchemaPartialWriteRequestValidationError) Field() string { return e.field }
+func (e SimulationChangesValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaPartialWriteRequestValidationError) Reason() string { return e.reason }
+func (e SimulationChangesValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaPartialWriteRequestValidationError) Cause() error { return e.cause }
+func (e SimulationChangesValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaPartialWriteRequestValidationError) Key() bool { return e.key }
+func (e SimulationChangesValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaPartialWriteRequestValidationError) ErrorName() string {
-	return "SchemaPartialWriteRequestValidationError"
+func (e SimulationChangesValidationError) ErrorName() string {
+	return "SimulationChangesValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SchemaPartialWriteRequestValidationError) Error() string {
+func (e SimulationChangesValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4750,14 +5513,14 @@ func (e SchemaPartialWriteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaPartialWriteRequest.%s: %s%s",
+		"invalid %sSimulationChanges.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaPartialWriteRequestValidationError{}
+var _ error = SimulationChangesValidationError{}
 
 var _ interface {
 	Field() string
@@ -4765,50 +5528,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaPartialWriteRequestValidationError{}
+} = SimulationChangesValidationError{}
 
-var _SchemaPartialWriteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
-
-// Validate checks the field values on SchemaPartialWriteRequestMetadata with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *SchemaPartialWriteRequestMetadata) Validate() error {
+// Validate checks the field values on PermissionSimulateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PermissionSimulateResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaPartialWriteRequestMetadata
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// SchemaPartialWriteRequestMetadataMultiError, or nil if none found.
-func (m *SchemaPartialWriteRequestMetadata) ValidateAll() error {
+// ValidateAll checks the field values on PermissionSimulateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PermissionSimulateResponseMultiError, or nil if none found.
+func (m *PermissionSimulateResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaPartialWriteRequestMetadata) validate(all bool) error {
+func (m *PermissionSimulateResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for SnapToken
+
 	// no validation rules for SchemaVersion
 
 	if len(errors) > 0 {
-		return SchemaPartialWriteRequestMetadataMultiError(errors)
+		return PermissionSimulateResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaPartialWriteRequestMetadataMultiError is an error wrapping multiple
-// validation errors returned by
-// SchemaPartialWriteRequestMetadata.ValidateAll() if the designated
-// constraints aren't met.
-type SchemaPartialWriteRequestMetadataMultiError []error
+// PermissionSimulateResponseMultiError is an error wrapping multiple
+// validation errors returned by PermissionSimulateResponse.ValidateAll() if
+// the designated constraints aren't met.
+type PermissionSimulateResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaPartialWriteRequestMetadataMultiError) Error() string {
+func (m PermissionSimulateResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4817,12 +5578,11 @@ func (m SchemaPartialWriteRequestMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaPartialWriteRequestMetadataMultiError) AllErrors() []error { return m }
+func (m PermissionSimulateResponseMultiError) AllErrors() []error { return m }
 
-// SchemaPartialWriteRequestMetadataValidationError is the validation error
-// returned by SchemaPartialWriteRequestMetadata.Validate if the designated
-// constraints aren't met.
-type SchemaPartialWriteRequestMetadataValidationError struct {
+// PermissionSimulateResponseValidationError is the validation error returned
+// by PermissionSimulateResponse.Validate if the designated constraints aren't met.
+type PermissionSimulateResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4830,24 +5590,24 @@ type SchemaPartialWriteRequestMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e SchemaPartialWriteRequestMetadataValidationError) Field() string { return e.field }
+func (e PermissionSimulateResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaPartialWriteRequestMetadataValidationError) Reason() string { return e.reason }
+func (e PermissionSimulateResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaPartialWriteRequestMetadataValidationError) Cause() error { return e.cause }
+func (e PermissionSimulateResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaPartialWriteRequestMetadataValidationError) Key() bool { return e.key }
+func (e PermissionSimulateResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaPartialWriteRequestMetadataValidationError) ErrorName() string {
-	return "SchemaPartialWriteRequestMetadataValidationError"
+func (e PermissionSimulateResponseValidationError) ErrorName() string {
+	return "PermissionSimulateResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SchemaPartialWriteRequestMetadataValidationError) Error() string {
+func (e PermissionSimulateResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4859,14 +5619,14 @@ func (e SchemaPartialWriteRequestMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaPartialWriteRequestMetadata.%s: %s%s",
+		"invalid %sPermissionSimulateResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaPartialWriteRequestMetadataValidationError{}
+var _ error = PermissionSimulateResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4874,46 +5634,67 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaPartialWriteRequestMetadataValidationError{}
+} = PermissionSimulateResponseValidationError{}
 
-// Validate checks the field values on SchemaPartialWriteResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SchemaPartialWriteResponse) Validate() error {
+// Validate checks the field values on WatchRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WatchRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaPartialWriteResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SchemaPartialWriteResponseMultiError, or nil if none found.
-func (m *SchemaPartialWriteResponse) ValidateAll() error {
+// ValidateAll checks the field values on WatchRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WatchRequestMultiError, or
+// nil if none found.
+func (m *WatchRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaPartialWriteResponse) validate(all bool) error {
+func (m *WatchRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SchemaVersion
-
+	if len(m.GetTenantId()) > 128 {
+		err := WatchRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_WatchRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := WatchRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SnapToken
+
 	if len(errors) > 0 {
-		return SchemaPartialWriteResponseMultiError(errors)
+		return WatchRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaPartialWriteResponseMultiError is an error wrapping multiple
-// validation errors returned by SchemaPartialWriteResponse.ValidateAll() if
-// the designated constraints aren't met.
-type SchemaPartialWriteResponseMultiError []error
+// WatchRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchRequest.ValidateAll() if the designated constraints aren't met.
+type WatchRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaPartialWriteResponseMultiError) Error() string {
+func (m WatchRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4922,11 +5703,11 @@ func (m SchemaPartialWriteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaPartialWriteResponseMultiError) AllErrors() []error { return m }
+func (m WatchRequestMultiError) AllErrors() []error { return m }
 
-// SchemaPartialWriteResponseValidationError is the validation error returned
-// by SchemaPartialWriteResponse.Validate if the designated constraints aren't met.
-type SchemaPartialWriteResponseValidationError struct {
+// WatchRequestValidationError is the validation error returned by
+// WatchRequest.Validate if the designated constraints aren't met.
+type WatchRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4934,24 +5715,22 @@ type SchemaPartialWriteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SchemaPartialWriteResponseValidationError) Field() string { return e.field }
+func (e WatchRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaPartialWriteResponseValidationError) Reason() string { return e.reason }
+func (e WatchRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaPartialWriteResponseValidationError) Cause() error { return e.cause }
+func (e WatchRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaPartialWriteResponseValidationError) Key() bool { return e.key }
+func (e WatchRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaPartialWriteResponseValidationError) ErrorName() string {
-	return "SchemaPartialWriteResponseValidationError"
-}
+func (e WatchRequestValidationError) ErrorName() string { return "WatchRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SchemaPartialWriteResponseValidationError) Error() string {
+func (e WatchRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4963,14 +5742,14 @@ func (e SchemaPartialWriteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaPartialWriteResponse.%s: %s%s",
+		"invalid %sWatchRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaPartialWriteResponseValidationError{}
+var _ error = WatchRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4978,86 +5757,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaPartialWriteResponseValidationError{}
+} = WatchRequestValidationError{}
 
-// Validate checks the field values on SchemaReadRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *SchemaReadRequest) Validate() error {
+var _WatchRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on WatchResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WatchResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaReadRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SchemaReadRequestMultiError, or nil if none found.
-func (m *SchemaReadRequest) ValidateAll() error {
+// ValidateAll checks the field values on WatchResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WatchResponseMultiError, or
+// nil if none found.
+func (m *WatchResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaReadRequest) validate(all bool) error {
+func (m *WatchResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 128 {
-		err := SchemaReadRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 128 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_SchemaReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := SchemaReadRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetMetadata() == nil {
-		err := SchemaReadRequestValidationError{
-			field:  "Metadata",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
+		switch v := interface{}(m.GetChanges()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SchemaReadRequestValidationError{
-					field:  "Metadata",
+				errors = append(errors, WatchResponseValidationError{
+					field:  "Changes",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SchemaReadRequestValidationError{
-					field:  "Metadata",
+				errors = append(errors, WatchResponseValidationError{
+					field:  "Changes",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetChanges()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SchemaReadRequestValidationError{
-				field:  "Metadata",
+			return WatchResponseValidationError{
+				field:  "Changes",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -5065,19 +5813,19 @@ func (m *SchemaReadRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SchemaReadRequestMultiError(errors)
+		return WatchResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaReadRequestMultiError is an error wrapping multiple validation errors
-// returned by SchemaReadRequest.ValidateAll() if the designated constraints
+// WatchResponseMultiError is an error wrapping multiple validation errors
+// returned by WatchResponse.ValidateAll() if the designated constraints
 // aren't met.
-type SchemaReadRequestMultiError []error
+type WatchResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaReadRequestMultiError) Error() string {
+func (m WatchResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5086,11 +5834,11 @@ func (m SchemaReadRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaReadRequestMultiError) AllErrors() []error { return m }
+func (m WatchResponseMultiError) AllErrors() []error { return m }
 
-// SchemaReadRequestValidationError is the validation error returned by
-// SchemaReadRequest.Validate if the designated constraints aren't met.
-type SchemaReadRequestValidationError struct {
+// WatchResponseValidationError is the validation error returned by
+// WatchResponse.Validate if the designated constraints aren't met.
+type WatchResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5098,24 +5846,22 @@ type SchemaReadRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SchemaReadRequestValidationError) Field() string { return e.field }
+func (e WatchResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaReadRequestValidationError) Reason() string { return e.reason }
+func (e WatchResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaReadRequestValidationError) Cause() error { return e.cause }
+func (e WatchResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaReadRequestValidationError) Key() bool { return e.key }
+func (e WatchResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaReadRequestValidationError) ErrorName() string {
-	return "SchemaReadRequestValidationError"
-}
+func (e WatchResponseValidationError) ErrorName() string { return "WatchResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SchemaReadRequestValidationError) Error() string {
+func (e WatchResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5127,14 +5873,14 @@ func (e SchemaReadRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaReadRequest.%s: %s%s",
+		"invalid %sWatchResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaReadRequestValidationError{}
+var _ error = WatchResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5142,48 +5888,97 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaReadRequestValidationError{}
-
-var _SchemaReadRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+} = WatchResponseValidationError{}
 
-// Validate checks the field values on SchemaReadRequestMetadata with the rules
+// Validate checks the field values on SchemaWriteRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SchemaReadRequestMetadata) Validate() error {
+func (m *SchemaWriteRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaReadRequestMetadata with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SchemaWriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SchemaReadRequestMetadataMultiError, or nil if none found.
-func (m *SchemaReadRequestMetadata) ValidateAll() error {
+// SchemaWriteRequestMultiError, or nil if none found.
+func (m *SchemaWriteRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaReadRequestMetadata) validate(all bool) error {
+func (m *SchemaWriteRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SchemaVersion
+	if len(m.GetTenantId()) > 128 {
+		err := SchemaWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Schema
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaWriteRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaWriteRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaWriteRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return SchemaReadRequestMetadataMultiError(errors)
+		return SchemaWriteRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaReadRequestMetadataMultiError is an error wrapping multiple validation
-// errors returned by SchemaReadRequestMetadata.ValidateAll() if the
-// designated constraints aren't met.
-type SchemaReadRequestMetadataMultiError []error
+// SchemaWriteRequestMultiError is an error wrapping multiple validation errors
+// returned by SchemaWriteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaWriteRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaReadRequestMetadataMultiError) Error() string {
+func (m SchemaWriteRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5192,36 +5987,36 @@ func (m SchemaReadRequestMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaReadRequestMetadataMultiError) AllErrors() []error { return m }
+func (m SchemaWriteRequestMultiError) AllErrors() []error { return m }
 
-// SchemaReadRequestMetadataValidationError is the validation error returned by
-// SchemaReadRequestMetadata.Validate if the designated constraints aren't met.
-type SchemaReadRequestMetadataValidationError struct {
-	field  string
+// SchemaWriteRequestValidationError is the validation error returned by
+// SchemaWriteRequest.Validate if the designated constraints aren't met.
+type SchemaWriteRequestValidationError struct {
+	field  string
 	reason string
 	cause  error
 	key    bool
 }
 
 // Field function returns field value.
-func (e SchemaReadRequestMetadataValidationError) Field() string { return e.field }
+func (e SchemaWriteRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaReadRequestMetadataValidationError) Reason() string { return e.reason }
+func (e SchemaWriteRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaReadRequestMetadataValidationError) Cause() error { return e.cause }
+func (e SchemaWriteRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaReadRequestMetadataValidationError) Key() bool { return e.key }
+func (e SchemaWriteRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaReadRequestMetadataValidationError) ErrorName() string {
-	return "SchemaReadRequestMetadataValidationError"
+func (e SchemaWriteRequestValidationError) ErrorName() string {
+	return "SchemaWriteRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SchemaReadRequestMetadataValidationError) Error() string {
+func (e SchemaWriteRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5233,14 +6028,14 @@ func (e SchemaReadRequestMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaReadRequestMetadata.%s: %s%s",
+		"invalid %sSchemaWriteRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaReadRequestMetadataValidationError{}
+var _ error = SchemaWriteRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5248,73 +6043,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaReadRequestMetadataValidationError{}
+} = SchemaWriteRequestValidationError{}
 
-// Validate checks the field values on SchemaReadResponse with the rules
+var _SchemaWriteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on SchemaWriteResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SchemaReadResponse) Validate() error {
+func (m *SchemaWriteResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaReadResponse with the rules
+// ValidateAll checks the field values on SchemaWriteResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SchemaReadResponseMultiError, or nil if none found.
-func (m *SchemaReadResponse) ValidateAll() error {
+// SchemaWriteResponseMultiError, or nil if none found.
+func (m *SchemaWriteResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaReadResponse) validate(all bool) error {
+func (m *SchemaWriteResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSchema()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SchemaReadResponseValidationError{
-					field:  "Schema",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SchemaReadResponseValidationError{
-					field:  "Schema",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SchemaReadResponseValidationError{
-				field:  "Schema",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for SchemaVersion
 
 	if len(errors) > 0 {
-		return SchemaReadResponseMultiError(errors)
+		return SchemaWriteResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaReadResponseMultiError is an error wrapping multiple validation errors
-// returned by SchemaReadResponse.ValidateAll() if the designated constraints
-// aren't met.
-type SchemaReadResponseMultiError []error
+// SchemaWriteResponseMultiError is an error wrapping multiple validation
+// errors returned by SchemaWriteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaWriteResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaReadResponseMultiError) Error() string {
+func (m SchemaWriteResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5323,11 +6093,11 @@ func (m SchemaReadResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaReadResponseMultiError) AllErrors() []error { return m }
+func (m SchemaWriteResponseMultiError) AllErrors() []error { return m }
 
-// SchemaReadResponseValidationError is the validation error returned by
-// SchemaReadResponse.Validate if the designated constraints aren't met.
-type SchemaReadResponseValidationError struct {
+// SchemaWriteResponseValidationError is the validation error returned by
+// SchemaWriteResponse.Validate if the designated constraints aren't met.
+type SchemaWriteResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5335,24 +6105,24 @@ type SchemaReadResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SchemaReadResponseValidationError) Field() string { return e.field }
+func (e SchemaWriteResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaReadResponseValidationError) Reason() string { return e.reason }
+func (e SchemaWriteResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaReadResponseValidationError) Cause() error { return e.cause }
+func (e SchemaWriteResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaReadResponseValidationError) Key() bool { return e.key }
+func (e SchemaWriteResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaReadResponseValidationError) ErrorName() string {
-	return "SchemaReadResponseValidationError"
+func (e SchemaWriteResponseValidationError) ErrorName() string {
+	return "SchemaWriteResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SchemaReadResponseValidationError) Error() string {
+func (e SchemaWriteResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5364,14 +6134,14 @@ func (e SchemaReadResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaReadResponse.%s: %s%s",
+		"invalid %sSchemaWriteResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaReadResponseValidationError{}
+var _ error = SchemaWriteResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5379,24 +6149,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaReadResponseValidationError{}
+} = SchemaWriteResponseValidationError{}
 
-// Validate checks the field values on SchemaListRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *SchemaListRequest) Validate() error {
+// Validate checks the field values on SchemaPartialWriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaPartialWriteRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaListRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SchemaPartialWriteRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SchemaListRequestMultiError, or nil if none found.
-func (m *SchemaListRequest) ValidateAll() error {
+// SchemaPartialWriteRequestMultiError, or nil if none found.
+func (m *SchemaPartialWriteRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaListRequest) validate(all bool) error {
+func (m *SchemaPartialWriteRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -5404,7 +6174,7 @@ func (m *SchemaListRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetTenantId()) > 128 {
-		err := SchemaListRequestValidationError{
+		err := SchemaPartialWriteRequestValidationError{
 			field:  "TenantId",
 			reason: "value length must be at most 128 bytes",
 		}
@@ -5414,8 +6184,8 @@ func (m *SchemaListRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_SchemaListRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := SchemaListRequestValidationError{
+	if !_SchemaPartialWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaPartialWriteRequestValidationError{
 			field:  "TenantId",
 			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
 		}
@@ -5425,39 +6195,106 @@ func (m *SchemaListRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPageSize() != 0 {
+	if m.GetMetadata() == nil {
+		err := SchemaPartialWriteRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if m.GetPageSize() < 1 {
-			err := SchemaListRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be greater than or equal to 1",
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaPartialWriteRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaPartialWriteRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaPartialWriteRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
-
 	}
 
-	if m.GetContinuousToken() != "" {
+	{
+		sorted_keys := make([]string, len(m.GetPartials()))
+		i := 0
+		for key := range m.GetPartials() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetPartials()[key]
+			_ = val
+
+			// no validation rules for Partials[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, SchemaPartialWriteRequestValidationError{
+							field:  fmt.Sprintf("Partials[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, SchemaPartialWriteRequestValidationError{
+							field:  fmt.Sprintf("Partials[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return SchemaPartialWriteRequestValidationError{
+						field:  fmt.Sprintf("Partials[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
 
+		}
 	}
 
 	if len(errors) > 0 {
-		return SchemaListRequestMultiError(errors)
+		return SchemaPartialWriteRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaListRequestMultiError is an error wrapping multiple validation errors
-// returned by SchemaListRequest.ValidateAll() if the designated constraints
-// aren't met.
-type SchemaListRequestMultiError []error
+// SchemaPartialWriteRequestMultiError is an error wrapping multiple validation
+// errors returned by SchemaPartialWriteRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SchemaPartialWriteRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaListRequestMultiError) Error() string {
+func (m SchemaPartialWriteRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5466,11 +6303,11 @@ func (m SchemaListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaListRequestMultiError) AllErrors() []error { return m }
+func (m SchemaPartialWriteRequestMultiError) AllErrors() []error { return m }
 
-// SchemaListRequestValidationError is the validation error returned by
-// SchemaListRequest.Validate if the designated constraints aren't met.
-type SchemaListRequestValidationError struct {
+// SchemaPartialWriteRequestValidationError is the validation error returned by
+// SchemaPartialWriteRequest.Validate if the designated constraints aren't met.
+type SchemaPartialWriteRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5478,24 +6315,24 @@ type SchemaListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SchemaListRequestValidationError) Field() string { return e.field }
+func (e SchemaPartialWriteRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaListRequestValidationError) Reason() string { return e.reason }
+func (e SchemaPartialWriteRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaListRequestValidationError) Cause() error { return e.cause }
+func (e SchemaPartialWriteRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaListRequestValidationError) Key() bool { return e.key }
+func (e SchemaPartialWriteRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaListRequestValidationError) ErrorName() string {
-	return "SchemaListRequestValidationError"
+func (e SchemaPartialWriteRequestValidationError) ErrorName() string {
+	return "SchemaPartialWriteRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SchemaListRequestValidationError) Error() string {
+func (e SchemaPartialWriteRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5507,14 +6344,14 @@ func (e SchemaListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaListRequest.%s: %s%s",
+		"invalid %sSchemaPartialWriteRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaListRequestValidationError{}
+var _ error = SchemaPartialWriteRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5522,84 +6359,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaListRequestValidationError{}
+} = SchemaPartialWriteRequestValidationError{}
 
-var _SchemaListRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+var _SchemaPartialWriteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
 
-// Validate checks the field values on SchemaListResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SchemaListResponse) Validate() error {
+// Validate checks the field values on SchemaPartialWriteRequestMetadata with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SchemaPartialWriteRequestMetadata) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaListResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SchemaListResponseMultiError, or nil if none found.
-func (m *SchemaListResponse) ValidateAll() error {
+// ValidateAll checks the field values on SchemaPartialWriteRequestMetadata
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SchemaPartialWriteRequestMetadataMultiError, or nil if none found.
+func (m *SchemaPartialWriteRequestMetadata) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaListResponse) validate(all bool) error {
+func (m *SchemaPartialWriteRequestMetadata) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Head
-
-	for idx, item := range m.GetSchemas() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SchemaListResponseValidationError{
-						field:  fmt.Sprintf("Schemas[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SchemaListResponseValidationError{
-						field:  fmt.Sprintf("Schemas[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SchemaListResponseValidationError{
-					field:  fmt.Sprintf("Schemas[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for ContinuousToken
+	// no validation rules for SchemaVersion
 
 	if len(errors) > 0 {
-		return SchemaListResponseMultiError(errors)
+		return SchemaPartialWriteRequestMetadataMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaListResponseMultiError is an error wrapping multiple validation errors
-// returned by SchemaListResponse.ValidateAll() if the designated constraints
-// aren't met.
-type SchemaListResponseMultiError []error
+// SchemaPartialWriteRequestMetadataMultiError is an error wrapping multiple
+// validation errors returned by
+// SchemaPartialWriteRequestMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaPartialWriteRequestMetadataMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaListResponseMultiError) Error() string {
+func (m SchemaPartialWriteRequestMetadataMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5608,11 +6411,12 @@ func (m SchemaListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaListResponseMultiError) AllErrors() []error { return m }
+func (m SchemaPartialWriteRequestMetadataMultiError) AllErrors() []error { return m }
 
-// SchemaListResponseValidationError is the validation error returned by
-// SchemaListResponse.Validate if the designated constraints aren't met.
-type SchemaListResponseValidationError struct {
+// SchemaPartialWriteRequestMetadataValidationError is the validation error
+// returned by SchemaPartialWriteRequestMetadata.Validate if the designated
+// constraints aren't met.
+type SchemaPartialWriteRequestMetadataValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5620,24 +6424,24 @@ type SchemaListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SchemaListResponseValidationError) Field() string { return e.field }
+func (e SchemaPartialWriteRequestMetadataValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaListResponseValidationError) Reason() string { return e.reason }
+func (e SchemaPartialWriteRequestMetadataValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaListResponseValidationError) Cause() error { return e.cause }
+func (e SchemaPartialWriteRequestMetadataValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaListResponseValidationError) Key() bool { return e.key }
+func (e SchemaPartialWriteRequestMetadataValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaListResponseValidationError) ErrorName() string {
-	return "SchemaListResponseValidationError"
+func (e SchemaPartialWriteRequestMetadataValidationError) ErrorName() string {
+	return "SchemaPartialWriteRequestMetadataValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SchemaListResponseValidationError) Error() string {
+func (e SchemaPartialWriteRequestMetadataValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5649,14 +6453,14 @@ func (e SchemaListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaListResponse.%s: %s%s",
+		"invalid %sSchemaPartialWriteRequestMetadata.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaListResponseValidationError{}
+var _ error = SchemaPartialWriteRequestMetadataValidationError{}
 
 var _ interface {
 	Field() string
@@ -5664,47 +6468,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaListResponseValidationError{}
+} = SchemaPartialWriteRequestMetadataValidationError{}
 
-// Validate checks the field values on SchemaList with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SchemaList) Validate() error {
+// Validate checks the field values on SchemaPartialWriteResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaPartialWriteResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaList with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SchemaListMultiError, or
-// nil if none found.
-func (m *SchemaList) ValidateAll() error {
+// ValidateAll checks the field values on SchemaPartialWriteResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaPartialWriteResponseMultiError, or nil if none found.
+func (m *SchemaPartialWriteResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaList) validate(all bool) error {
+func (m *SchemaPartialWriteResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Version
-
-	// no validation rules for CreatedAt
+	// no validation rules for SchemaVersion
 
 	if len(errors) > 0 {
-		return SchemaListMultiError(errors)
+		return SchemaPartialWriteResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaListMultiError is an error wrapping multiple validation errors
-// returned by SchemaList.ValidateAll() if the designated constraints aren't met.
-type SchemaListMultiError []error
+// SchemaPartialWriteResponseMultiError is an error wrapping multiple
+// validation errors returned by SchemaPartialWriteResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SchemaPartialWriteResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaListMultiError) Error() string {
+func (m SchemaPartialWriteResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5713,11 +6516,11 @@ func (m SchemaListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaListMultiError) AllErrors() []error { return m }
+func (m SchemaPartialWriteResponseMultiError) AllErrors() []error { return m }
 
-// SchemaListValidationError is the validation error returned by
-// SchemaList.Validate if the designated constraints aren't met.
-type SchemaListValidationError struct {
+// SchemaPartialWriteResponseValidationError is the validation error returned
+// by SchemaPartialWriteResponse.Validate if the designated constraints aren't met.
+type SchemaPartialWriteResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5725,22 +6528,24 @@ type SchemaListValidationError struct {
 }
 
 // Field function returns field value.
-func (e SchemaListValidationError) Field() string { return e.field }
+func (e SchemaPartialWriteResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaListValidationError) Reason() string { return e.reason }
+func (e SchemaPartialWriteResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaListValidationError) Cause() error { return e.cause }
+func (e SchemaPartialWriteResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaListValidationError) Key() bool { return e.key }
+func (e SchemaPartialWriteResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaListValidationError) ErrorName() string { return "SchemaListValidationError" }
+func (e SchemaPartialWriteResponseValidationError) ErrorName() string {
+	return "SchemaPartialWriteResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SchemaListValidationError) Error() string {
+func (e SchemaPartialWriteResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5752,14 +6557,14 @@ func (e SchemaListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaList.%s: %s%s",
+		"invalid %sSchemaPartialWriteResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaListValidationError{}
+var _ error = SchemaPartialWriteResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5767,24 +6572,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaListValidationError{}
+} = SchemaPartialWriteResponseValidationError{}
 
-// Validate checks the field values on DataWriteRequest with the rules defined
+// Validate checks the field values on SchemaReadRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *DataWriteRequest) Validate() error {
+func (m *SchemaReadRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DataWriteRequest with the rules
+// ValidateAll checks the field values on SchemaReadRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DataWriteRequestMultiError, or nil if none found.
-func (m *DataWriteRequest) ValidateAll() error {
+// SchemaReadRequestMultiError, or nil if none found.
+func (m *SchemaReadRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DataWriteRequest) validate(all bool) error {
+func (m *SchemaReadRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -5792,7 +6597,7 @@ func (m *DataWriteRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetTenantId()) > 128 {
-		err := DataWriteRequestValidationError{
+		err := SchemaReadRequestValidationError{
 			field:  "TenantId",
 			reason: "value length must be at most 128 bytes",
 		}
@@ -5802,8 +6607,8 @@ func (m *DataWriteRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_DataWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := DataWriteRequestValidationError{
+	if !_SchemaReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaReadRequestValidationError{
 			field:  "TenantId",
 			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
 		}
@@ -5814,7 +6619,7 @@ func (m *DataWriteRequest) validate(all bool) error {
 	}
 
 	if m.GetMetadata() == nil {
-		err := DataWriteRequestValidationError{
+		err := SchemaReadRequestValidationError{
 			field:  "Metadata",
 			reason: "value is required",
 		}
@@ -5828,7 +6633,7 @@ func (m *DataWriteRequest) validate(all bool) error {
 		switch v := interface{}(m.GetMetadata()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DataWriteRequestValidationError{
+				errors = append(errors, SchemaReadRequestValidationError{
 					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -5836,7 +6641,7 @@ func (m *DataWriteRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DataWriteRequestValidationError{
+				errors = append(errors, SchemaReadRequestValidationError{
 					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -5845,7 +6650,7 @@ func (m *DataWriteRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DataWriteRequestValidationError{
+			return SchemaReadRequestValidationError{
 				field:  "Metadata",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -5853,123 +6658,33 @@ func (m *DataWriteRequest) validate(all bool) error {
 		}
 	}
 
-	for idx, item := range m.GetTuples() {
-		_, _ = idx, item
+	if len(errors) > 0 {
+		return SchemaReadRequestMultiError(errors)
+	}
 
-		if item == nil {
-			err := DataWriteRequestValidationError{
-				field:  fmt.Sprintf("Tuples[%v]", idx),
-				reason: "value is required",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	return nil
+}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, DataWriteRequestValidationError{
-						field:  fmt.Sprintf("Tuples[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, DataWriteRequestValidationError{
-						field:  fmt.Sprintf("Tuples[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return DataWriteRequestValidationError{
-					field:  fmt.Sprintf("Tuples[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// SchemaReadRequestMultiError is an error wrapping multiple validation errors
+// returned by SchemaReadRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaReadRequestMultiError []error
 
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaReadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	for idx, item := range m.GetAttributes() {
-		_, _ = idx, item
+// AllErrors returns a list of validation violation errors.
+func (m SchemaReadRequestMultiError) AllErrors() []error { return m }
 
-		if item == nil {
-			err := DataWriteRequestValidationError{
-				field:  fmt.Sprintf("Attributes[%v]", idx),
-				reason: "value is required",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, DataWriteRequestValidationError{
-						field:  fmt.Sprintf("Attributes[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, DataWriteRequestValidationError{
-						field:  fmt.Sprintf("Attributes[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return DataWriteRequestValidationError{
-					field:  fmt.Sprintf("Attributes[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if len(errors) > 0 {
-		return DataWriteRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// DataWriteRequestMultiError is an error wrapping multiple validation errors
-// returned by DataWriteRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DataWriteRequestMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m DataWriteRequestMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m DataWriteRequestMultiError) AllErrors() []error { return m }
-
-// DataWriteRequestValidationError is the validation error returned by
-// DataWriteRequest.Validate if the designated constraints aren't met.
-type DataWriteRequestValidationError struct {
+// SchemaReadRequestValidationError is the validation error returned by
+// SchemaReadRequest.Validate if the designated constraints aren't met.
+type SchemaReadRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5977,22 +6692,24 @@ type DataWriteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DataWriteRequestValidationError) Field() string { return e.field }
+func (e SchemaReadRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DataWriteRequestValidationError) Reason() string { return e.reason }
+func (e SchemaReadRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DataWriteRequestValidationError) Cause() error { return e.cause }
+func (e SchemaReadRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DataWriteRequestValidationError) Key() bool { return e.key }
+func (e SchemaReadRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DataWriteRequestValidationError) ErrorName() string { return "DataWriteRequestValidationError" }
+func (e SchemaReadRequestValidationError) ErrorName() string {
+	return "SchemaReadRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DataWriteRequestValidationError) Error() string {
+func (e SchemaReadRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6004,14 +6721,14 @@ func (e DataWriteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDataWriteRequest.%s: %s%s",
+		"invalid %sSchemaReadRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DataWriteRequestValidationError{}
+var _ error = SchemaReadRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6019,26 +6736,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DataWriteRequestValidationError{}
+} = SchemaReadRequestValidationError{}
 
-var _DataWriteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+var _SchemaReadRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
 
-// Validate checks the field values on DataWriteRequestMetadata with the rules
+// Validate checks the field values on SchemaReadRequestMetadata with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DataWriteRequestMetadata) Validate() error {
+func (m *SchemaReadRequestMetadata) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DataWriteRequestMetadata with the
+// ValidateAll checks the field values on SchemaReadRequestMetadata with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DataWriteRequestMetadataMultiError, or nil if none found.
-func (m *DataWriteRequestMetadata) ValidateAll() error {
+// SchemaReadRequestMetadataMultiError, or nil if none found.
+func (m *SchemaReadRequestMetadata) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DataWriteRequestMetadata) validate(all bool) error {
+func (m *SchemaReadRequestMetadata) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6047,20 +6764,22 @@ func (m *DataWriteRequestMetadata) validate(all bool) error {
 
 	// no validation rules for SchemaVersion
 
+	// no validation rules for SchemaTag
+
 	if len(errors) > 0 {
-		return DataWriteRequestMetadataMultiError(errors)
+		return SchemaReadRequestMetadataMultiError(errors)
 	}
 
 	return nil
 }
 
-// DataWriteRequestMetadataMultiError is an error wrapping multiple validation
-// errors returned by DataWriteRequestMetadata.ValidateAll() if the designated
-// constraints aren't met.
-type DataWriteRequestMetadataMultiError []error
+// SchemaReadRequestMetadataMultiError is an error wrapping multiple validation
+// errors returned by SchemaReadRequestMetadata.ValidateAll() if the
+// designated constraints aren't met.
+type SchemaReadRequestMetadataMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DataWriteRequestMetadataMultiError) Error() string {
+func (m SchemaReadRequestMetadataMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6069,11 +6788,11 @@ func (m DataWriteRequestMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DataWriteRequestMetadataMultiError) AllErrors() []error { return m }
+func (m SchemaReadRequestMetadataMultiError) AllErrors() []error { return m }
 
-// DataWriteRequestMetadataValidationError is the validation error returned by
-// DataWriteRequestMetadata.Validate if the designated constraints aren't met.
-type DataWriteRequestMetadataValidationError struct {
+// SchemaReadRequestMetadataValidationError is the validation error returned by
+// SchemaReadRequestMetadata.Validate if the designated constraints aren't met.
+type SchemaReadRequestMetadataValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6081,24 +6800,24 @@ type DataWriteRequestMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e DataWriteRequestMetadataValidationError) Field() string { return e.field }
+func (e SchemaReadRequestMetadataValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DataWriteRequestMetadataValidationError) Reason() string { return e.reason }
+func (e SchemaReadRequestMetadataValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DataWriteRequestMetadataValidationError) Cause() error { return e.cause }
+func (e SchemaReadRequestMetadataValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DataWriteRequestMetadataValidationError) Key() bool { return e.key }
+func (e SchemaReadRequestMetadataValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DataWriteRequestMetadataValidationError) ErrorName() string {
-	return "DataWriteRequestMetadataValidationError"
+func (e SchemaReadRequestMetadataValidationError) ErrorName() string {
+	return "SchemaReadRequestMetadataValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DataWriteRequestMetadataValidationError) Error() string {
+func (e SchemaReadRequestMetadataValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6110,14 +6829,14 @@ func (e DataWriteRequestMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDataWriteRequestMetadata.%s: %s%s",
+		"invalid %sSchemaReadRequestMetadata.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DataWriteRequestMetadataValidationError{}
+var _ error = SchemaReadRequestMetadataValidationError{}
 
 var _ interface {
 	Field() string
@@ -6125,46 +6844,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DataWriteRequestMetadataValidationError{}
+} = SchemaReadRequestMetadataValidationError{}
 
-// Validate checks the field values on DataWriteResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DataWriteResponse) Validate() error {
+// Validate checks the field values on SchemaReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaReadResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DataWriteResponse with the rules
+// ValidateAll checks the field values on SchemaReadResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DataWriteResponseMultiError, or nil if none found.
-func (m *DataWriteResponse) ValidateAll() error {
+// SchemaReadResponseMultiError, or nil if none found.
+func (m *SchemaReadResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DataWriteResponse) validate(all bool) error {
+func (m *SchemaReadResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SnapToken
+	if all {
+		switch v := interface{}(m.GetSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaReadResponseValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaReadResponseValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaReadResponseValidationError{
+				field:  "Schema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DataWriteResponseMultiError(errors)
+		return SchemaReadResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DataWriteResponseMultiError is an error wrapping multiple validation errors
-// returned by DataWriteResponse.ValidateAll() if the designated constraints
+// SchemaReadResponseMultiError is an error wrapping multiple validation errors
+// returned by SchemaReadResponse.ValidateAll() if the designated constraints
 // aren't met.
-type DataWriteResponseMultiError []error
+type SchemaReadResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DataWriteResponseMultiError) Error() string {
+func (m SchemaReadResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6173,11 +6919,11 @@ func (m DataWriteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DataWriteResponseMultiError) AllErrors() []error { return m }
+func (m SchemaReadResponseMultiError) AllErrors() []error { return m }
 
-// DataWriteResponseValidationError is the validation error returned by
-// DataWriteResponse.Validate if the designated constraints aren't met.
-type DataWriteResponseValidationError struct {
+// SchemaReadResponseValidationError is the validation error returned by
+// SchemaReadResponse.Validate if the designated constraints aren't met.
+type SchemaReadResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6185,24 +6931,24 @@ type DataWriteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DataWriteResponseValidationError) Field() string { return e.field }
+func (e SchemaReadResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DataWriteResponseValidationError) Reason() string { return e.reason }
+func (e SchemaReadResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DataWriteResponseValidationError) Cause() error { return e.cause }
+func (e SchemaReadResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DataWriteResponseValidationError) Key() bool { return e.key }
+func (e SchemaReadResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DataWriteResponseValidationError) ErrorName() string {
-	return "DataWriteResponseValidationError"
+func (e SchemaReadResponseValidationError) ErrorName() string {
+	return "SchemaReadResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DataWriteResponseValidationError) Error() string {
+func (e SchemaReadResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6214,14 +6960,14 @@ func (e DataWriteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDataWriteResponse.%s: %s%s",
+		"invalid %sSchemaReadResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DataWriteResponseValidationError{}
+var _ error = SchemaReadResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6229,24 +6975,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DataWriteResponseValidationError{}
+} = SchemaReadResponseValidationError{}
 
-// Validate checks the field values on RelationshipWriteRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipWriteRequest) Validate() error {
+// Validate checks the field values on SchemaListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipWriteRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SchemaListRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RelationshipWriteRequestMultiError, or nil if none found.
-func (m *RelationshipWriteRequest) ValidateAll() error {
+// SchemaListRequestMultiError, or nil if none found.
+func (m *SchemaListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipWriteRequest) validate(all bool) error {
+func (m *SchemaListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6254,7 +7000,7 @@ func (m *RelationshipWriteRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetTenantId()) > 128 {
-		err := RelationshipWriteRequestValidationError{
+		err := SchemaListRequestValidationError{
 			field:  "TenantId",
 			reason: "value length must be at most 128 bytes",
 		}
@@ -6264,8 +7010,8 @@ func (m *RelationshipWriteRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_RelationshipWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := RelationshipWriteRequestValidationError{
+	if !_SchemaListRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaListRequestValidationError{
 			field:  "TenantId",
 			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
 		}
@@ -6275,10 +7021,6756 @@ func (m *RelationshipWriteRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetMetadata() == nil {
-		err := RelationshipWriteRequestValidationError{
-			field:  "Metadata",
-			reason: "value is required",
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() < 1 {
+			err := SchemaListRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetContinuousToken() != "" {
+
+	}
+
+	if len(errors) > 0 {
+		return SchemaListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaListRequestMultiError is an error wrapping multiple validation errors
+// returned by SchemaListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaListRequestMultiError) AllErrors() []error { return m }
+
+// SchemaListRequestValidationError is the validation error returned by
+// SchemaListRequest.Validate if the designated constraints aren't met.
+type SchemaListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaListRequestValidationError) ErrorName() string {
+	return "SchemaListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaListRequestValidationError{}
+
+var _SchemaListRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on SchemaListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaListResponseMultiError, or nil if none found.
+func (m *SchemaListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Head
+
+	for idx, item := range m.GetSchemas() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaListResponseValidationError{
+						field:  fmt.Sprintf("Schemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaListResponseValidationError{
+						field:  fmt.Sprintf("Schemas[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaListResponseValidationError{
+					field:  fmt.Sprintf("Schemas[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ContinuousToken
+
+	if len(errors) > 0 {
+		return SchemaListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaListResponseMultiError is an error wrapping multiple validation errors
+// returned by SchemaListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaListResponseMultiError) AllErrors() []error { return m }
+
+// SchemaListResponseValidationError is the validation error returned by
+// SchemaListResponse.Validate if the designated constraints aren't met.
+type SchemaListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaListResponseValidationError) ErrorName() string {
+	return "SchemaListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaListResponseValidationError{}
+
+// Validate checks the field values on SchemaList with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SchemaList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaList with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SchemaListMultiError, or
+// nil if none found.
+func (m *SchemaList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Active
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaListValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaListValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaListValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SchemaListMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaListMultiError is an error wrapping multiple validation errors
+// returned by SchemaList.ValidateAll() if the designated constraints aren't met.
+type SchemaListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaListMultiError) AllErrors() []error { return m }
+
+// SchemaListValidationError is the validation error returned by
+// SchemaList.Validate if the designated constraints aren't met.
+type SchemaListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaListValidationError) ErrorName() string { return "SchemaListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchemaListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaListValidationError{}
+
+// Validate checks the field values on SchemaLintRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaLintRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaLintRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaLintRequestMultiError, or nil if none found.
+func (m *SchemaLintRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaLintRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := SchemaLintRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaLintRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaLintRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Schema
+
+	if len(errors) > 0 {
+		return SchemaLintRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaLintRequestMultiError is an error wrapping multiple validation errors
+// returned by SchemaLintRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaLintRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaLintRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaLintRequestMultiError) AllErrors() []error { return m }
+
+// SchemaLintRequestValidationError is the validation error returned by
+// SchemaLintRequest.Validate if the designated constraints aren't met.
+type SchemaLintRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaLintRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaLintRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaLintRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaLintRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaLintRequestValidationError) ErrorName() string {
+	return "SchemaLintRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaLintRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaLintRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaLintRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaLintRequestValidationError{}
+
+var _SchemaLintRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on SchemaLintResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaLintResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaLintResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaLintResponseMultiError, or nil if none found.
+func (m *SchemaLintResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaLintResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFindings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaLintResponseValidationError{
+						field:  fmt.Sprintf("Findings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaLintResponseValidationError{
+						field:  fmt.Sprintf("Findings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaLintResponseValidationError{
+					field:  fmt.Sprintf("Findings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SchemaLintResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaLintResponseMultiError is an error wrapping multiple validation errors
+// returned by SchemaLintResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaLintResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaLintResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaLintResponseMultiError) AllErrors() []error { return m }
+
+// SchemaLintResponseValidationError is the validation error returned by
+// SchemaLintResponse.Validate if the designated constraints aren't met.
+type SchemaLintResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaLintResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaLintResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaLintResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaLintResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaLintResponseValidationError) ErrorName() string {
+	return "SchemaLintResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaLintResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaLintResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaLintResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaLintResponseValidationError{}
+
+// Validate checks the field values on SchemaLintFinding with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaLintFinding) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaLintFinding with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaLintFindingMultiError, or nil if none found.
+func (m *SchemaLintFinding) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaLintFinding) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Rule
+
+	// no validation rules for Severity
+
+	// no validation rules for Message
+
+	// no validation rules for Line
+
+	// no validation rules for Column
+
+	if len(errors) > 0 {
+		return SchemaLintFindingMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaLintFindingMultiError is an error wrapping multiple validation errors
+// returned by SchemaLintFinding.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaLintFindingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaLintFindingMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaLintFindingMultiError) AllErrors() []error { return m }
+
+// SchemaLintFindingValidationError is the validation error returned by
+// SchemaLintFinding.Validate if the designated constraints aren't met.
+type SchemaLintFindingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaLintFindingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaLintFindingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaLintFindingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaLintFindingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaLintFindingValidationError) ErrorName() string {
+	return "SchemaLintFindingValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaLintFindingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaLintFinding.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaLintFindingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaLintFindingValidationError{}
+
+// Validate checks the field values on SchemaShadow with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SchemaShadow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaShadow with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SchemaShadowMultiError, or
+// nil if none found.
+func (m *SchemaShadow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaShadow) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	// no validation rules for ServedVersion
+
+	// no validation rules for SampleRate
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return SchemaShadowMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaShadowMultiError is an error wrapping multiple validation errors
+// returned by SchemaShadow.ValidateAll() if the designated constraints aren't met.
+type SchemaShadowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaShadowMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaShadowMultiError) AllErrors() []error { return m }
+
+// SchemaShadowValidationError is the validation error returned by
+// SchemaShadow.Validate if the designated constraints aren't met.
+type SchemaShadowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaShadowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaShadowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaShadowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaShadowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaShadowValidationError) ErrorName() string { return "SchemaShadowValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchemaShadowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaShadow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaShadowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaShadowValidationError{}
+
+// Validate checks the field values on SchemaShadowWriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaShadowWriteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaShadowWriteRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaShadowWriteRequestMultiError, or nil if none found.
+func (m *SchemaShadowWriteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaShadowWriteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := SchemaShadowWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaShadowWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaShadowWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Schema
+
+	if val := m.GetSampleRate(); val < 0 || val > 1 {
+		err := SchemaShadowWriteRequestValidationError{
+			field:  "SampleRate",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SchemaShadowWriteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaShadowWriteRequestMultiError is an error wrapping multiple validation
+// errors returned by SchemaShadowWriteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaShadowWriteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaShadowWriteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaShadowWriteRequestMultiError) AllErrors() []error { return m }
+
+// SchemaShadowWriteRequestValidationError is the validation error returned by
+// SchemaShadowWriteRequest.Validate if the designated constraints aren't met.
+type SchemaShadowWriteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaShadowWriteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaShadowWriteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaShadowWriteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaShadowWriteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaShadowWriteRequestValidationError) ErrorName() string {
+	return "SchemaShadowWriteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaShadowWriteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaShadowWriteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaShadowWriteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaShadowWriteRequestValidationError{}
+
+var _SchemaShadowWriteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on SchemaShadowWriteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaShadowWriteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaShadowWriteResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaShadowWriteResponseMultiError, or nil if none found.
+func (m *SchemaShadowWriteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaShadowWriteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetShadow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaShadowWriteResponseValidationError{
+					field:  "Shadow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaShadowWriteResponseValidationError{
+					field:  "Shadow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetShadow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaShadowWriteResponseValidationError{
+				field:  "Shadow",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SchemaShadowWriteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaShadowWriteResponseMultiError is an error wrapping multiple validation
+// errors returned by SchemaShadowWriteResponse.ValidateAll() if the
+// designated constraints aren't met.
+type SchemaShadowWriteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaShadowWriteResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaShadowWriteResponseMultiError) AllErrors() []error { return m }
+
+// SchemaShadowWriteResponseValidationError is the validation error returned by
+// SchemaShadowWriteResponse.Validate if the designated constraints aren't met.
+type SchemaShadowWriteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaShadowWriteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaShadowWriteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaShadowWriteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaShadowWriteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaShadowWriteResponseValidationError) ErrorName() string {
+	return "SchemaShadowWriteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaShadowWriteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaShadowWriteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaShadowWriteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaShadowWriteResponseValidationError{}
+
+// Validate checks the field values on SchemaShadowReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaShadowReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaShadowReadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaShadowReadRequestMultiError, or nil if none found.
+func (m *SchemaShadowReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaShadowReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := SchemaShadowReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaShadowReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaShadowReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SchemaShadowReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaShadowReadRequestMultiError is an error wrapping multiple validation
+// errors returned by SchemaShadowReadRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaShadowReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaShadowReadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaShadowReadRequestMultiError) AllErrors() []error { return m }
+
+// SchemaShadowReadRequestValidationError is the validation error returned by
+// SchemaShadowReadRequest.Validate if the designated constraints aren't met.
+type SchemaShadowReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaShadowReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaShadowReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaShadowReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaShadowReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaShadowReadRequestValidationError) ErrorName() string {
+	return "SchemaShadowReadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaShadowReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaShadowReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaShadowReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaShadowReadRequestValidationError{}
+
+var _SchemaShadowReadRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on SchemaShadowReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaShadowReadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaShadowReadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaShadowReadResponseMultiError, or nil if none found.
+func (m *SchemaShadowReadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaShadowReadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetShadow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaShadowReadResponseValidationError{
+					field:  "Shadow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaShadowReadResponseValidationError{
+					field:  "Shadow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetShadow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaShadowReadResponseValidationError{
+				field:  "Shadow",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SchemaShadowReadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaShadowReadResponseMultiError is an error wrapping multiple validation
+// errors returned by SchemaShadowReadResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaShadowReadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaShadowReadResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaShadowReadResponseMultiError) AllErrors() []error { return m }
+
+// SchemaShadowReadResponseValidationError is the validation error returned by
+// SchemaShadowReadResponse.Validate if the designated constraints aren't met.
+type SchemaShadowReadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaShadowReadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaShadowReadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaShadowReadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaShadowReadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaShadowReadResponseValidationError) ErrorName() string {
+	return "SchemaShadowReadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaShadowReadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaShadowReadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaShadowReadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaShadowReadResponseValidationError{}
+
+// Validate checks the field values on SchemaShadowPromoteRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaShadowPromoteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaShadowPromoteRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaShadowPromoteRequestMultiError, or nil if none found.
+func (m *SchemaShadowPromoteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaShadowPromoteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := SchemaShadowPromoteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaShadowPromoteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaShadowPromoteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SchemaShadowPromoteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaShadowPromoteRequestMultiError is an error wrapping multiple
+// validation errors returned by SchemaShadowPromoteRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SchemaShadowPromoteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaShadowPromoteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaShadowPromoteRequestMultiError) AllErrors() []error { return m }
+
+// SchemaShadowPromoteRequestValidationError is the validation error returned
+// by SchemaShadowPromoteRequest.Validate if the designated constraints aren't met.
+type SchemaShadowPromoteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaShadowPromoteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaShadowPromoteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaShadowPromoteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaShadowPromoteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaShadowPromoteRequestValidationError) ErrorName() string {
+	return "SchemaShadowPromoteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaShadowPromoteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaShadowPromoteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaShadowPromoteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaShadowPromoteRequestValidationError{}
+
+var _SchemaShadowPromoteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on SchemaShadowPromoteResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaShadowPromoteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaShadowPromoteResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaShadowPromoteResponseMultiError, or nil if none found.
+func (m *SchemaShadowPromoteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaShadowPromoteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for PreviousVersion
+
+	if len(errors) > 0 {
+		return SchemaShadowPromoteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaShadowPromoteResponseMultiError is an error wrapping multiple
+// validation errors returned by SchemaShadowPromoteResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SchemaShadowPromoteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaShadowPromoteResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaShadowPromoteResponseMultiError) AllErrors() []error { return m }
+
+// SchemaShadowPromoteResponseValidationError is the validation error returned
+// by SchemaShadowPromoteResponse.Validate if the designated constraints
+// aren't met.
+type SchemaShadowPromoteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaShadowPromoteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaShadowPromoteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaShadowPromoteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaShadowPromoteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaShadowPromoteResponseValidationError) ErrorName() string {
+	return "SchemaShadowPromoteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaShadowPromoteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaShadowPromoteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaShadowPromoteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaShadowPromoteResponseValidationError{}
+
+// Validate checks the field values on SchemaShadowRollbackRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaShadowRollbackRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaShadowRollbackRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaShadowRollbackRequestMultiError, or nil if none found.
+func (m *SchemaShadowRollbackRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaShadowRollbackRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := SchemaShadowRollbackRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaShadowRollbackRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaShadowRollbackRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SchemaShadowRollbackRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaShadowRollbackRequestMultiError is an error wrapping multiple
+// validation errors returned by SchemaShadowRollbackRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SchemaShadowRollbackRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaShadowRollbackRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaShadowRollbackRequestMultiError) AllErrors() []error { return m }
+
+// SchemaShadowRollbackRequestValidationError is the validation error returned
+// by SchemaShadowRollbackRequest.Validate if the designated constraints
+// aren't met.
+type SchemaShadowRollbackRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaShadowRollbackRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaShadowRollbackRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaShadowRollbackRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaShadowRollbackRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaShadowRollbackRequestValidationError) ErrorName() string {
+	return "SchemaShadowRollbackRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaShadowRollbackRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaShadowRollbackRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaShadowRollbackRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaShadowRollbackRequestValidationError{}
+
+var _SchemaShadowRollbackRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on SchemaShadowRollbackResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaShadowRollbackResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaShadowRollbackResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaShadowRollbackResponseMultiError, or nil if none found.
+func (m *SchemaShadowRollbackResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaShadowRollbackResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	if len(errors) > 0 {
+		return SchemaShadowRollbackResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaShadowRollbackResponseMultiError is an error wrapping multiple
+// validation errors returned by SchemaShadowRollbackResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SchemaShadowRollbackResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaShadowRollbackResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaShadowRollbackResponseMultiError) AllErrors() []error { return m }
+
+// SchemaShadowRollbackResponseValidationError is the validation error returned
+// by SchemaShadowRollbackResponse.Validate if the designated constraints
+// aren't met.
+type SchemaShadowRollbackResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaShadowRollbackResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaShadowRollbackResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaShadowRollbackResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaShadowRollbackResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaShadowRollbackResponseValidationError) ErrorName() string {
+	return "SchemaShadowRollbackResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaShadowRollbackResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaShadowRollbackResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaShadowRollbackResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaShadowRollbackResponseValidationError{}
+
+// Validate checks the field values on SchemaTagRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaTagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaTagRequestMultiError, or nil if none found.
+func (m *SchemaTagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaTagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := SchemaTagRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaTagRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaTagRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSchemaVersion()) < 1 {
+		err := SchemaTagRequestValidationError{
+			field:  "SchemaVersion",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetSchemaVersion()) > 128 {
+		err := SchemaTagRequestValidationError{
+			field:  "SchemaVersion",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTag()) > 64 {
+		err := SchemaTagRequestValidationError{
+			field:  "Tag",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaTagRequest_Tag_Pattern.MatchString(m.GetTag()) {
+		err := SchemaTagRequestValidationError{
+			field:  "Tag",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9_\\\\-\\\\.]{1,64}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SchemaTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaTagRequestMultiError is an error wrapping multiple validation errors
+// returned by SchemaTagRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaTagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaTagRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaTagRequestMultiError) AllErrors() []error { return m }
+
+// SchemaTagRequestValidationError is the validation error returned by
+// SchemaTagRequest.Validate if the designated constraints aren't met.
+type SchemaTagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaTagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaTagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaTagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaTagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaTagRequestValidationError) ErrorName() string { return "SchemaTagRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchemaTagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaTagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaTagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaTagRequestValidationError{}
+
+var _SchemaTagRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+var _SchemaTagRequest_Tag_Pattern = regexp.MustCompile("^[a-zA-Z0-9_\\-\\.]{1,64}$")
+
+// Validate checks the field values on SchemaTagResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaTagResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaTagResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaTagResponseMultiError, or nil if none found.
+func (m *SchemaTagResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaTagResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for PreviousVersion
+
+	if len(errors) > 0 {
+		return SchemaTagResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaTagResponseMultiError is an error wrapping multiple validation errors
+// returned by SchemaTagResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaTagResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaTagResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaTagResponseMultiError) AllErrors() []error { return m }
+
+// SchemaTagResponseValidationError is the validation error returned by
+// SchemaTagResponse.Validate if the designated constraints aren't met.
+type SchemaTagResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaTagResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaTagResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaTagResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaTagResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaTagResponseValidationError) ErrorName() string {
+	return "SchemaTagResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaTagResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaTagResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaTagResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaTagResponseValidationError{}
+
+// Validate checks the field values on SchemaUntagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaUntagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaUntagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaUntagRequestMultiError, or nil if none found.
+func (m *SchemaUntagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaUntagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := SchemaUntagRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaUntagRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaUntagRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTag()) > 64 {
+		err := SchemaUntagRequestValidationError{
+			field:  "Tag",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaUntagRequest_Tag_Pattern.MatchString(m.GetTag()) {
+		err := SchemaUntagRequestValidationError{
+			field:  "Tag",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9_\\\\-\\\\.]{1,64}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SchemaUntagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaUntagRequestMultiError is an error wrapping multiple validation errors
+// returned by SchemaUntagRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaUntagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaUntagRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaUntagRequestMultiError) AllErrors() []error { return m }
+
+// SchemaUntagRequestValidationError is the validation error returned by
+// SchemaUntagRequest.Validate if the designated constraints aren't met.
+type SchemaUntagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaUntagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaUntagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaUntagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaUntagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaUntagRequestValidationError) ErrorName() string {
+	return "SchemaUntagRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaUntagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaUntagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaUntagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaUntagRequestValidationError{}
+
+var _SchemaUntagRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+var _SchemaUntagRequest_Tag_Pattern = regexp.MustCompile("^[a-zA-Z0-9_\\-\\.]{1,64}$")
+
+// Validate checks the field values on SchemaUntagResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaUntagResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaUntagResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaUntagResponseMultiError, or nil if none found.
+func (m *SchemaUntagResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaUntagResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	if len(errors) > 0 {
+		return SchemaUntagResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaUntagResponseMultiError is an error wrapping multiple validation
+// errors returned by SchemaUntagResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaUntagResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaUntagResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaUntagResponseMultiError) AllErrors() []error { return m }
+
+// SchemaUntagResponseValidationError is the validation error returned by
+// SchemaUntagResponse.Validate if the designated constraints aren't met.
+type SchemaUntagResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaUntagResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaUntagResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaUntagResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaUntagResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaUntagResponseValidationError) ErrorName() string {
+	return "SchemaUntagResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaUntagResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaUntagResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaUntagResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaUntagResponseValidationError{}
+
+// Validate checks the field values on SchemaActivateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaActivateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaActivateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaActivateRequestMultiError, or nil if none found.
+func (m *SchemaActivateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaActivateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := SchemaActivateRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaActivateRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaActivateRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for SchemaTag
+
+	if len(errors) > 0 {
+		return SchemaActivateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaActivateRequestMultiError is an error wrapping multiple validation
+// errors returned by SchemaActivateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaActivateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaActivateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaActivateRequestMultiError) AllErrors() []error { return m }
+
+// SchemaActivateRequestValidationError is the validation error returned by
+// SchemaActivateRequest.Validate if the designated constraints aren't met.
+type SchemaActivateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaActivateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaActivateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaActivateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaActivateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaActivateRequestValidationError) ErrorName() string {
+	return "SchemaActivateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaActivateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaActivateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaActivateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaActivateRequestValidationError{}
+
+var _SchemaActivateRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on SchemaActivateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaActivateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaActivateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaActivateResponseMultiError, or nil if none found.
+func (m *SchemaActivateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaActivateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for PreviousVersion
+
+	if len(errors) > 0 {
+		return SchemaActivateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaActivateResponseMultiError is an error wrapping multiple validation
+// errors returned by SchemaActivateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaActivateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaActivateResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaActivateResponseMultiError) AllErrors() []error { return m }
+
+// SchemaActivateResponseValidationError is the validation error returned by
+// SchemaActivateResponse.Validate if the designated constraints aren't met.
+type SchemaActivateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaActivateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaActivateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaActivateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaActivateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaActivateResponseValidationError) ErrorName() string {
+	return "SchemaActivateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaActivateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaActivateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaActivateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaActivateResponseValidationError{}
+
+// Validate checks the field values on DataWriteRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DataWriteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataWriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataWriteRequestMultiError, or nil if none found.
+func (m *DataWriteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataWriteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := DataWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DataWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := DataWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := DataWriteRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataWriteRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataWriteRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataWriteRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTuples() {
+		_, _ = idx, item
+
+		if item == nil {
+			err := DataWriteRequestValidationError{
+				field:  fmt.Sprintf("Tuples[%v]", idx),
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataWriteRequestValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataWriteRequestValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataWriteRequestValidationError{
+					field:  fmt.Sprintf("Tuples[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetAttributes() {
+		_, _ = idx, item
+
+		if item == nil {
+			err := DataWriteRequestValidationError{
+				field:  fmt.Sprintf("Attributes[%v]", idx),
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataWriteRequestValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataWriteRequestValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataWriteRequestValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(m.GetPreconditions()) > 100 {
+		err := DataWriteRequestValidationError{
+			field:  "Preconditions",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetPreconditions() {
+		_, _ = idx, item
+
+		if item == nil {
+			err := DataWriteRequestValidationError{
+				field:  fmt.Sprintf("Preconditions[%v]", idx),
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataWriteRequestValidationError{
+						field:  fmt.Sprintf("Preconditions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataWriteRequestValidationError{
+						field:  fmt.Sprintf("Preconditions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataWriteRequestValidationError{
+					field:  fmt.Sprintf("Preconditions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DataWriteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataWriteRequestMultiError is an error wrapping multiple validation errors
+// returned by DataWriteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DataWriteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataWriteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataWriteRequestMultiError) AllErrors() []error { return m }
+
+// DataWriteRequestValidationError is the validation error returned by
+// DataWriteRequest.Validate if the designated constraints aren't met.
+type DataWriteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataWriteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataWriteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataWriteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataWriteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataWriteRequestValidationError) ErrorName() string { return "DataWriteRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DataWriteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataWriteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataWriteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataWriteRequestValidationError{}
+
+var _DataWriteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on DataWriteRequestMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DataWriteRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataWriteRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataWriteRequestMetadataMultiError, or nil if none found.
+func (m *DataWriteRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataWriteRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	if len(m.GetIdempotencyKey()) > 128 {
+		err := DataWriteRequestMetadataValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataWriteRequestMetadataValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataWriteRequestMetadataValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataWriteRequestMetadataValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DataWriteRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataWriteRequestMetadataMultiError is an error wrapping multiple validation
+// errors returned by DataWriteRequestMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type DataWriteRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataWriteRequestMetadataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataWriteRequestMetadataMultiError) AllErrors() []error { return m }
+
+// DataWriteRequestMetadataValidationError is the validation error returned by
+// DataWriteRequestMetadata.Validate if the designated constraints aren't met.
+type DataWriteRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataWriteRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataWriteRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataWriteRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataWriteRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataWriteRequestMetadataValidationError) ErrorName() string {
+	return "DataWriteRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataWriteRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataWriteRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataWriteRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataWriteRequestMetadataValidationError{}
+
+// Validate checks the field values on DataWriteResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DataWriteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataWriteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataWriteResponseMultiError, or nil if none found.
+func (m *DataWriteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataWriteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return DataWriteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataWriteResponseMultiError is an error wrapping multiple validation errors
+// returned by DataWriteResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DataWriteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataWriteResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataWriteResponseMultiError) AllErrors() []error { return m }
+
+// DataWriteResponseValidationError is the validation error returned by
+// DataWriteResponse.Validate if the designated constraints aren't met.
+type DataWriteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataWriteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataWriteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataWriteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataWriteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataWriteResponseValidationError) ErrorName() string {
+	return "DataWriteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataWriteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataWriteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataWriteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataWriteResponseValidationError{}
+
+// Validate checks the field values on DataImportRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DataImportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataImportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataImportRequestMultiError, or nil if none found.
+func (m *DataImportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataImportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := DataImportRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DataImportRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := DataImportRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataImportRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataImportRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataImportRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTuples() {
+		_, _ = idx, item
+
+		// skipping validation for tuples
+
+	}
+
+	for idx, item := range m.GetAttributes() {
+		_, _ = idx, item
+
+		// skipping validation for attributes
+
+	}
+
+	if len(errors) > 0 {
+		return DataImportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataImportRequestMultiError is an error wrapping multiple validation errors
+// returned by DataImportRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DataImportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataImportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataImportRequestMultiError) AllErrors() []error { return m }
+
+// DataImportRequestValidationError is the validation error returned by
+// DataImportRequest.Validate if the designated constraints aren't met.
+type DataImportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataImportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataImportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataImportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataImportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataImportRequestValidationError) ErrorName() string {
+	return "DataImportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataImportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataImportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataImportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataImportRequestValidationError{}
+
+var _DataImportRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on DataImportRequestMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DataImportRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataImportRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataImportRequestMetadataMultiError, or nil if none found.
+func (m *DataImportRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataImportRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for CommitInterval
+
+	if len(errors) > 0 {
+		return DataImportRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataImportRequestMetadataMultiError is an error wrapping multiple validation
+// errors returned by DataImportRequestMetadata.ValidateAll() if the
+// designated constraints aren't met.
+type DataImportRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataImportRequestMetadataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataImportRequestMetadataMultiError) AllErrors() []error { return m }
+
+// DataImportRequestMetadataValidationError is the validation error returned by
+// DataImportRequestMetadata.Validate if the designated constraints aren't met.
+type DataImportRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataImportRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataImportRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataImportRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataImportRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataImportRequestMetadataValidationError) ErrorName() string {
+	return "DataImportRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataImportRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataImportRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataImportRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataImportRequestMetadataValidationError{}
+
+// Validate checks the field values on DataImportRejection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DataImportRejection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataImportRejection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataImportRejectionMultiError, or nil if none found.
+func (m *DataImportRejection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataImportRejection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Position
+
+	// no validation rules for Row
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return DataImportRejectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataImportRejectionMultiError is an error wrapping multiple validation
+// errors returned by DataImportRejection.ValidateAll() if the designated
+// constraints aren't met.
+type DataImportRejectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataImportRejectionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataImportRejectionMultiError) AllErrors() []error { return m }
+
+// DataImportRejectionValidationError is the validation error returned by
+// DataImportRejection.Validate if the designated constraints aren't met.
+type DataImportRejectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataImportRejectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataImportRejectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataImportRejectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataImportRejectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataImportRejectionValidationError) ErrorName() string {
+	return "DataImportRejectionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataImportRejectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataImportRejection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataImportRejectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataImportRejectionValidationError{}
+
+// Validate checks the field values on DataImportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DataImportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataImportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataImportResponseMultiError, or nil if none found.
+func (m *DataImportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataImportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapToken
+
+	// no validation rules for Tuples
+
+	// no validation rules for Attributes
+
+	// no validation rules for Rejected
+
+	for idx, item := range m.GetRejections() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataImportResponseValidationError{
+						field:  fmt.Sprintf("Rejections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataImportResponseValidationError{
+						field:  fmt.Sprintf("Rejections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataImportResponseValidationError{
+					field:  fmt.Sprintf("Rejections[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Commits
+
+	// no validation rules for CommittedTuples
+
+	// no validation rules for CommittedAttributes
+
+	if len(errors) > 0 {
+		return DataImportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataImportResponseMultiError is an error wrapping multiple validation errors
+// returned by DataImportResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DataImportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataImportResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataImportResponseMultiError) AllErrors() []error { return m }
+
+// DataImportResponseValidationError is the validation error returned by
+// DataImportResponse.Validate if the designated constraints aren't met.
+type DataImportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataImportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataImportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataImportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataImportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataImportResponseValidationError) ErrorName() string {
+	return "DataImportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataImportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataImportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataImportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataImportResponseValidationError{}
+
+// Validate checks the field values on RelationshipWriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipWriteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipWriteRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipWriteRequestMultiError, or nil if none found.
+func (m *RelationshipWriteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipWriteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := RelationshipWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RelationshipWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := RelationshipWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := RelationshipWriteRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipWriteRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipWriteRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipWriteRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetTuples()) < 1 {
+		err := RelationshipWriteRequestValidationError{
+			field:  "Tuples",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTuples() {
+		_, _ = idx, item
+
+		if item == nil {
+			err := RelationshipWriteRequestValidationError{
+				field:  fmt.Sprintf("Tuples[%v]", idx),
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationshipWriteRequestValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationshipWriteRequestValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationshipWriteRequestValidationError{
+					field:  fmt.Sprintf("Tuples[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RelationshipWriteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipWriteRequestMultiError is an error wrapping multiple validation
+// errors returned by RelationshipWriteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipWriteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipWriteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipWriteRequestMultiError) AllErrors() []error { return m }
+
+// RelationshipWriteRequestValidationError is the validation error returned by
+// RelationshipWriteRequest.Validate if the designated constraints aren't met.
+type RelationshipWriteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipWriteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipWriteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipWriteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipWriteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipWriteRequestValidationError) ErrorName() string {
+	return "RelationshipWriteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipWriteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipWriteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipWriteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipWriteRequestValidationError{}
+
+var _RelationshipWriteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on RelationshipWriteRequestMetadata with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RelationshipWriteRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipWriteRequestMetadata with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RelationshipWriteRequestMetadataMultiError, or nil if none found.
+func (m *RelationshipWriteRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipWriteRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	if len(errors) > 0 {
+		return RelationshipWriteRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipWriteRequestMetadataMultiError is an error wrapping multiple
+// validation errors returned by
+// RelationshipWriteRequestMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipWriteRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipWriteRequestMetadataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipWriteRequestMetadataMultiError) AllErrors() []error { return m }
+
+// RelationshipWriteRequestMetadataValidationError is the validation error
+// returned by RelationshipWriteRequestMetadata.Validate if the designated
+// constraints aren't met.
+type RelationshipWriteRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipWriteRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipWriteRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipWriteRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipWriteRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipWriteRequestMetadataValidationError) ErrorName() string {
+	return "RelationshipWriteRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipWriteRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipWriteRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipWriteRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipWriteRequestMetadataValidationError{}
+
+// Validate checks the field values on RelationshipWriteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipWriteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipWriteResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipWriteResponseMultiError, or nil if none found.
+func (m *RelationshipWriteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipWriteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return RelationshipWriteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipWriteResponseMultiError is an error wrapping multiple validation
+// errors returned by RelationshipWriteResponse.ValidateAll() if the
+// designated constraints aren't met.
+type RelationshipWriteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipWriteResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipWriteResponseMultiError) AllErrors() []error { return m }
+
+// RelationshipWriteResponseValidationError is the validation error returned by
+// RelationshipWriteResponse.Validate if the designated constraints aren't met.
+type RelationshipWriteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipWriteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipWriteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipWriteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipWriteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipWriteResponseValidationError) ErrorName() string {
+	return "RelationshipWriteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipWriteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipWriteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipWriteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipWriteResponseValidationError{}
+
+// Validate checks the field values on RelationshipReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipReadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipReadRequestMultiError, or nil if none found.
+func (m *RelationshipReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := RelationshipReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RelationshipReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := RelationshipReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := RelationshipReadRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipReadRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipReadRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipReadRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetFilter() == nil {
+		err := RelationshipReadRequestValidationError{
+			field:  "Filter",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipReadRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipReadRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipReadRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() < 1 {
+			err := RelationshipReadRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetContinuousToken() != "" {
+
+	}
+
+	if len(errors) > 0 {
+		return RelationshipReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipReadRequestMultiError is an error wrapping multiple validation
+// errors returned by RelationshipReadRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipReadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipReadRequestMultiError) AllErrors() []error { return m }
+
+// RelationshipReadRequestValidationError is the validation error returned by
+// RelationshipReadRequest.Validate if the designated constraints aren't met.
+type RelationshipReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipReadRequestValidationError) ErrorName() string {
+	return "RelationshipReadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipReadRequestValidationError{}
+
+var _RelationshipReadRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on RelationshipReadRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipReadRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipReadRequestMetadata with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RelationshipReadRequestMetadataMultiError, or nil if none found.
+func (m *RelationshipReadRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipReadRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapToken
+
+	if all {
+		switch v := interface{}(m.GetAtTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipReadRequestMetadataValidationError{
+					field:  "AtTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipReadRequestMetadataValidationError{
+					field:  "AtTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAtTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipReadRequestMetadataValidationError{
+				field:  "AtTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetConsistency()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipReadRequestMetadataValidationError{
+					field:  "Consistency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipReadRequestMetadataValidationError{
+					field:  "Consistency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConsistency()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipReadRequestMetadataValidationError{
+				field:  "Consistency",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RelationshipReadRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipReadRequestMetadataMultiError is an error wrapping multiple
+// validation errors returned by RelationshipReadRequestMetadata.ValidateAll()
+// if the designated constraints aren't met.
+type RelationshipReadRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipReadRequestMetadataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipReadRequestMetadataMultiError) AllErrors() []error { return m }
+
+// RelationshipReadRequestMetadataValidationError is the validation error
+// returned by RelationshipReadRequestMetadata.Validate if the designated
+// constraints aren't met.
+type RelationshipReadRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipReadRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipReadRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipReadRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipReadRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipReadRequestMetadataValidationError) ErrorName() string {
+	return "RelationshipReadRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipReadRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipReadRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipReadRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipReadRequestMetadataValidationError{}
+
+// Validate checks the field values on RelationshipReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipReadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipReadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipReadResponseMultiError, or nil if none found.
+func (m *RelationshipReadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipReadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTuples() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationshipReadResponseValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationshipReadResponseValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationshipReadResponseValidationError{
+					field:  fmt.Sprintf("Tuples[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ContinuousToken
+
+	if len(errors) > 0 {
+		return RelationshipReadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipReadResponseMultiError is an error wrapping multiple validation
+// errors returned by RelationshipReadResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipReadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipReadResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipReadResponseMultiError) AllErrors() []error { return m }
+
+// RelationshipReadResponseValidationError is the validation error returned by
+// RelationshipReadResponse.Validate if the designated constraints aren't met.
+type RelationshipReadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipReadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipReadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipReadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipReadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipReadResponseValidationError) ErrorName() string {
+	return "RelationshipReadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipReadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipReadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipReadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipReadResponseValidationError{}
+
+// Validate checks the field values on AttributeReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttributeReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttributeReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttributeReadRequestMultiError, or nil if none found.
+func (m *AttributeReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := AttributeReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AttributeReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := AttributeReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := AttributeReadRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeReadRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeReadRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeReadRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetFilter() == nil {
+		err := AttributeReadRequestValidationError{
+			field:  "Filter",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeReadRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeReadRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeReadRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() < 1 {
+			err := AttributeReadRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetContinuousToken() != "" {
+
+	}
+
+	if len(errors) > 0 {
+		return AttributeReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeReadRequestMultiError is an error wrapping multiple validation
+// errors returned by AttributeReadRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AttributeReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeReadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeReadRequestMultiError) AllErrors() []error { return m }
+
+// AttributeReadRequestValidationError is the validation error returned by
+// AttributeReadRequest.Validate if the designated constraints aren't met.
+type AttributeReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttributeReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeReadRequestValidationError) ErrorName() string {
+	return "AttributeReadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeReadRequestValidationError{}
+
+var _AttributeReadRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on AttributeReadRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttributeReadRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttributeReadRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttributeReadRequestMetadataMultiError, or nil if none found.
+func (m *AttributeReadRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeReadRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapToken
+
+	if all {
+		switch v := interface{}(m.GetConsistency()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeReadRequestMetadataValidationError{
+					field:  "Consistency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeReadRequestMetadataValidationError{
+					field:  "Consistency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConsistency()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeReadRequestMetadataValidationError{
+				field:  "Consistency",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AttributeReadRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeReadRequestMetadataMultiError is an error wrapping multiple
+// validation errors returned by AttributeReadRequestMetadata.ValidateAll() if
+// the designated constraints aren't met.
+type AttributeReadRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeReadRequestMetadataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeReadRequestMetadataMultiError) AllErrors() []error { return m }
+
+// AttributeReadRequestMetadataValidationError is the validation error returned
+// by AttributeReadRequestMetadata.Validate if the designated constraints
+// aren't met.
+type AttributeReadRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeReadRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeReadRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttributeReadRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeReadRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeReadRequestMetadataValidationError) ErrorName() string {
+	return "AttributeReadRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeReadRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeReadRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeReadRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeReadRequestMetadataValidationError{}
+
+// Validate checks the field values on AttributeReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttributeReadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttributeReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttributeReadResponseMultiError, or nil if none found.
+func (m *AttributeReadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeReadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAttributes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AttributeReadResponseValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AttributeReadResponseValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AttributeReadResponseValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ContinuousToken
+
+	if len(errors) > 0 {
+		return AttributeReadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeReadResponseMultiError is an error wrapping multiple validation
+// errors returned by AttributeReadResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AttributeReadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeReadResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeReadResponseMultiError) AllErrors() []error { return m }
+
+// AttributeReadResponseValidationError is the validation error returned by
+// AttributeReadResponse.Validate if the designated constraints aren't met.
+type AttributeReadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeReadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeReadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttributeReadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeReadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeReadResponseValidationError) ErrorName() string {
+	return "AttributeReadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeReadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeReadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeReadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeReadResponseValidationError{}
+
+// Validate checks the field values on DataReadHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DataReadHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataReadHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataReadHistoryRequestMultiError, or nil if none found.
+func (m *DataReadHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataReadHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := DataReadHistoryRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DataReadHistoryRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := DataReadHistoryRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() < 1 {
+			err := DataReadHistoryRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetContinuousToken() != "" {
+
+	}
+
+	oneofFilterPresent := false
+	switch v := m.Filter.(type) {
+	case *DataReadHistoryRequest_TupleFilter:
+		if v == nil {
+			err := DataReadHistoryRequestValidationError{
+				field:  "Filter",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofFilterPresent = true
+
+		if all {
+			switch v := interface{}(m.GetTupleFilter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataReadHistoryRequestValidationError{
+						field:  "TupleFilter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataReadHistoryRequestValidationError{
+						field:  "TupleFilter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTupleFilter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataReadHistoryRequestValidationError{
+					field:  "TupleFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DataReadHistoryRequest_AttributeFilter:
+		if v == nil {
+			err := DataReadHistoryRequestValidationError{
+				field:  "Filter",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofFilterPresent = true
+
+		if all {
+			switch v := interface{}(m.GetAttributeFilter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataReadHistoryRequestValidationError{
+						field:  "AttributeFilter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataReadHistoryRequestValidationError{
+						field:  "AttributeFilter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAttributeFilter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataReadHistoryRequestValidationError{
+					field:  "AttributeFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofFilterPresent {
+		err := DataReadHistoryRequestValidationError{
+			field:  "Filter",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DataReadHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataReadHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by DataReadHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DataReadHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataReadHistoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataReadHistoryRequestMultiError) AllErrors() []error { return m }
+
+// DataReadHistoryRequestValidationError is the validation error returned by
+// DataReadHistoryRequest.Validate if the designated constraints aren't met.
+type DataReadHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataReadHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataReadHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataReadHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataReadHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataReadHistoryRequestValidationError) ErrorName() string {
+	return "DataReadHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataReadHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataReadHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataReadHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataReadHistoryRequestValidationError{}
+
+var _DataReadHistoryRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on DataHistoryRecord with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DataHistoryRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataHistoryRecord with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataHistoryRecordMultiError, or nil if none found.
+func (m *DataHistoryRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataHistoryRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CreatedTxId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataHistoryRecordValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataHistoryRecordValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataHistoryRecordValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ExpiredTxId
+
+	if all {
+		switch v := interface{}(m.GetExpiredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataHistoryRecordValidationError{
+					field:  "ExpiredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataHistoryRecordValidationError{
+					field:  "ExpiredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataHistoryRecordValidationError{
+				field:  "ExpiredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataHistoryRecordValidationError{
+					field:  "CreatedMetadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataHistoryRecordValidationError{
+					field:  "CreatedMetadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataHistoryRecordValidationError{
+				field:  "CreatedMetadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiredMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataHistoryRecordValidationError{
+					field:  "ExpiredMetadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataHistoryRecordValidationError{
+					field:  "ExpiredMetadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiredMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataHistoryRecordValidationError{
+				field:  "ExpiredMetadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.Item.(type) {
+	case *DataHistoryRecord_Tuple:
+		if v == nil {
+			err := DataHistoryRecordValidationError{
+				field:  "Item",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTuple()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataHistoryRecordValidationError{
+						field:  "Tuple",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataHistoryRecordValidationError{
+						field:  "Tuple",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTuple()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataHistoryRecordValidationError{
+					field:  "Tuple",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DataHistoryRecord_Attribute:
+		if v == nil {
+			err := DataHistoryRecordValidationError{
+				field:  "Item",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAttribute()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataHistoryRecordValidationError{
+						field:  "Attribute",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataHistoryRecordValidationError{
+						field:  "Attribute",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAttribute()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataHistoryRecordValidationError{
+					field:  "Attribute",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return DataHistoryRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataHistoryRecordMultiError is an error wrapping multiple validation errors
+// returned by DataHistoryRecord.ValidateAll() if the designated constraints
+// aren't met.
+type DataHistoryRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataHistoryRecordMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataHistoryRecordMultiError) AllErrors() []error { return m }
+
+// DataHistoryRecordValidationError is the validation error returned by
+// DataHistoryRecord.Validate if the designated constraints aren't met.
+type DataHistoryRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataHistoryRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataHistoryRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataHistoryRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataHistoryRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataHistoryRecordValidationError) ErrorName() string {
+	return "DataHistoryRecordValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataHistoryRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataHistoryRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataHistoryRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataHistoryRecordValidationError{}
+
+// Validate checks the field values on DataReadHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DataReadHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataReadHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataReadHistoryResponseMultiError, or nil if none found.
+func (m *DataReadHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataReadHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataReadHistoryResponseValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataReadHistoryResponseValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataReadHistoryResponseValidationError{
+					field:  fmt.Sprintf("Records[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ContinuousToken
+
+	if len(errors) > 0 {
+		return DataReadHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataReadHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by DataReadHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DataReadHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataReadHistoryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataReadHistoryResponseMultiError) AllErrors() []error { return m }
+
+// DataReadHistoryResponseValidationError is the validation error returned by
+// DataReadHistoryResponse.Validate if the designated constraints aren't met.
+type DataReadHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataReadHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataReadHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataReadHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataReadHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataReadHistoryResponseValidationError) ErrorName() string {
+	return "DataReadHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataReadHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataReadHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataReadHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataReadHistoryResponseValidationError{}
+
+// Validate checks the field values on DataVerifyRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DataVerifyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataVerifyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataVerifyRequestMultiError, or nil if none found.
+func (m *DataVerifyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataVerifyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := DataVerifyRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DataVerifyRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := DataVerifyRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := DataVerifyRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataVerifyRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataVerifyRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataVerifyRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if val := m.GetPageSize(); val < 1 || val > 1000 {
+			err := DataVerifyRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 1000]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := DataVerifyAction_name[int32(m.GetAction())]; !ok {
+		err := DataVerifyRequestValidationError{
+			field:  "Action",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQuarantineTenantId() != "" {
+
+		if len(m.GetQuarantineTenantId()) > 128 {
+			err := DataVerifyRequestValidationError{
+				field:  "QuarantineTenantId",
+				reason: "value length must be at most 128 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_DataVerifyRequest_QuarantineTenantId_Pattern.MatchString(m.GetQuarantineTenantId()) {
+			err := DataVerifyRequestValidationError{
+				field:  "QuarantineTenantId",
+				reason: "value does not match regex pattern \"^[a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DataVerifyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataVerifyRequestMultiError is an error wrapping multiple validation errors
+// returned by DataVerifyRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DataVerifyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataVerifyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataVerifyRequestMultiError) AllErrors() []error { return m }
+
+// DataVerifyRequestValidationError is the validation error returned by
+// DataVerifyRequest.Validate if the designated constraints aren't met.
+type DataVerifyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataVerifyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataVerifyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataVerifyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataVerifyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataVerifyRequestValidationError) ErrorName() string {
+	return "DataVerifyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataVerifyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataVerifyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataVerifyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataVerifyRequestValidationError{}
+
+var _DataVerifyRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+var _DataVerifyRequest_QuarantineTenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9_\\-@\\.:+]{1,128}$")
+
+// Validate checks the field values on DataVerifyRequestMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DataVerifyRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataVerifyRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataVerifyRequestMetadataMultiError, or nil if none found.
+func (m *DataVerifyRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataVerifyRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for SnapToken
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataVerifyRequestMetadataValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataVerifyRequestMetadataValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataVerifyRequestMetadataValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DataVerifyRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataVerifyRequestMetadataMultiError is an error wrapping multiple validation
+// errors returned by DataVerifyRequestMetadata.ValidateAll() if the
+// designated constraints aren't met.
+type DataVerifyRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataVerifyRequestMetadataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataVerifyRequestMetadataMultiError) AllErrors() []error { return m }
+
+// DataVerifyRequestMetadataValidationError is the validation error returned by
+// DataVerifyRequestMetadata.Validate if the designated constraints aren't met.
+type DataVerifyRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataVerifyRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataVerifyRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataVerifyRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataVerifyRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataVerifyRequestMetadataValidationError) ErrorName() string {
+	return "DataVerifyRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataVerifyRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataVerifyRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataVerifyRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataVerifyRequestMetadataValidationError{}
+
+// Validate checks the field values on DataViolation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DataViolation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataViolation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DataViolationMultiError, or
+// nil if none found.
+func (m *DataViolation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataViolation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Reason
+
+	switch v := m.Item.(type) {
+	case *DataViolation_Tuple:
+		if v == nil {
+			err := DataViolationValidationError{
+				field:  "Item",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTuple()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataViolationValidationError{
+						field:  "Tuple",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataViolationValidationError{
+						field:  "Tuple",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTuple()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataViolationValidationError{
+					field:  "Tuple",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DataViolation_Attribute:
+		if v == nil {
+			err := DataViolationValidationError{
+				field:  "Item",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAttribute()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataViolationValidationError{
+						field:  "Attribute",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataViolationValidationError{
+						field:  "Attribute",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAttribute()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataViolationValidationError{
+					field:  "Attribute",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return DataViolationMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataViolationMultiError is an error wrapping multiple validation errors
+// returned by DataViolation.ValidateAll() if the designated constraints
+// aren't met.
+type DataViolationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataViolationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataViolationMultiError) AllErrors() []error { return m }
+
+// DataViolationValidationError is the validation error returned by
+// DataViolation.Validate if the designated constraints aren't met.
+type DataViolationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataViolationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataViolationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataViolationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataViolationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataViolationValidationError) ErrorName() string { return "DataViolationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DataViolationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataViolation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataViolationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataViolationValidationError{}
+
+// Validate checks the field values on DataVerifySummary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DataVerifySummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataVerifySummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataVerifySummaryMultiError, or nil if none found.
+func (m *DataVerifySummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataVerifySummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Relationships
+
+	// no validation rules for Attributes
+
+	// no validation rules for Violations
+
+	// no validation rules for Removed
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return DataVerifySummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataVerifySummaryMultiError is an error wrapping multiple validation errors
+// returned by DataVerifySummary.ValidateAll() if the designated constraints
+// aren't met.
+type DataVerifySummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataVerifySummaryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataVerifySummaryMultiError) AllErrors() []error { return m }
+
+// DataVerifySummaryValidationError is the validation error returned by
+// DataVerifySummary.Validate if the designated constraints aren't met.
+type DataVerifySummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataVerifySummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataVerifySummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataVerifySummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataVerifySummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataVerifySummaryValidationError) ErrorName() string {
+	return "DataVerifySummaryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataVerifySummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataVerifySummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataVerifySummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataVerifySummaryValidationError{}
+
+// Validate checks the field values on DataVerifyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DataVerifyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataVerifyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataVerifyResponseMultiError, or nil if none found.
+func (m *DataVerifyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataVerifyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Result.(type) {
+	case *DataVerifyResponse_Violation:
+		if v == nil {
+			err := DataVerifyResponseValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetViolation()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataVerifyResponseValidationError{
+						field:  "Violation",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataVerifyResponseValidationError{
+						field:  "Violation",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetViolation()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataVerifyResponseValidationError{
+					field:  "Violation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DataVerifyResponse_Summary:
+		if v == nil {
+			err := DataVerifyResponseValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSummary()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataVerifyResponseValidationError{
+						field:  "Summary",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataVerifyResponseValidationError{
+						field:  "Summary",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSummary()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataVerifyResponseValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return DataVerifyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataVerifyResponseMultiError is an error wrapping multiple validation errors
+// returned by DataVerifyResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DataVerifyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataVerifyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataVerifyResponseMultiError) AllErrors() []error { return m }
+
+// DataVerifyResponseValidationError is the validation error returned by
+// DataVerifyResponse.Validate if the designated constraints aren't met.
+type DataVerifyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataVerifyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataVerifyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataVerifyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataVerifyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataVerifyResponseValidationError) ErrorName() string {
+	return "DataVerifyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataVerifyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataVerifyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataVerifyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataVerifyResponseValidationError{}
+
+// Validate checks the field values on DataAnalyzeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DataAnalyzeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataAnalyzeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataAnalyzeRequestMultiError, or nil if none found.
+func (m *DataAnalyzeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataAnalyzeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := DataAnalyzeRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DataAnalyzeRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := DataAnalyzeRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := DataAnalyzeRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -6286,74 +13778,403 @@ func (m *RelationshipWriteRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RelationshipWriteRequestValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, RelationshipWriteRequestValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RelationshipWriteRequestValidationError{
-				field:  "Metadata",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataAnalyzeRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataAnalyzeRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataAnalyzeRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if val := m.GetPageSize(); val < 1 || val > 1000 {
+			err := DataAnalyzeRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 1000]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetTop() != 0 {
+
+		if m.GetTop() > 1000 {
+			err := DataAnalyzeRequestValidationError{
+				field:  "Top",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DataAnalyzeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataAnalyzeRequestMultiError is an error wrapping multiple validation errors
+// returned by DataAnalyzeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DataAnalyzeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataAnalyzeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataAnalyzeRequestMultiError) AllErrors() []error { return m }
+
+// DataAnalyzeRequestValidationError is the validation error returned by
+// DataAnalyzeRequest.Validate if the designated constraints aren't met.
+type DataAnalyzeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataAnalyzeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataAnalyzeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataAnalyzeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataAnalyzeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataAnalyzeRequestValidationError) ErrorName() string {
+	return "DataAnalyzeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataAnalyzeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataAnalyzeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataAnalyzeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataAnalyzeRequestValidationError{}
+
+var _DataAnalyzeRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on DataAnalyzeRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DataAnalyzeRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataAnalyzeRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataAnalyzeRequestMetadataMultiError, or nil if none found.
+func (m *DataAnalyzeRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataAnalyzeRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return DataAnalyzeRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataAnalyzeRequestMetadataMultiError is an error wrapping multiple
+// validation errors returned by DataAnalyzeRequestMetadata.ValidateAll() if
+// the designated constraints aren't met.
+type DataAnalyzeRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataAnalyzeRequestMetadataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataAnalyzeRequestMetadataMultiError) AllErrors() []error { return m }
+
+// DataAnalyzeRequestMetadataValidationError is the validation error returned
+// by DataAnalyzeRequestMetadata.Validate if the designated constraints aren't met.
+type DataAnalyzeRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataAnalyzeRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataAnalyzeRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataAnalyzeRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataAnalyzeRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataAnalyzeRequestMetadataValidationError) ErrorName() string {
+	return "DataAnalyzeRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataAnalyzeRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
 	}
 
-	if len(m.GetTuples()) < 1 {
-		err := RelationshipWriteRequestValidationError{
-			field:  "Tuples",
-			reason: "value must contain at least 1 item(s)",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	return fmt.Sprintf(
+		"invalid %sDataAnalyzeRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataAnalyzeRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataAnalyzeRequestMetadataValidationError{}
+
+// Validate checks the field values on DataCycle with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DataCycle) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataCycle with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DataCycleMultiError, or nil
+// if none found.
+func (m *DataCycle) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataCycle) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	for idx, item := range m.GetTuples() {
-		_, _ = idx, item
+	var errors []error
 
-		if item == nil {
-			err := RelationshipWriteRequestValidationError{
-				field:  fmt.Sprintf("Tuples[%v]", idx),
-				reason: "value is required",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	if len(errors) > 0 {
+		return DataCycleMultiError(errors)
+	}
+
+	return nil
+}
+
+// DataCycleMultiError is an error wrapping multiple validation errors returned
+// by DataCycle.ValidateAll() if the designated constraints aren't met.
+type DataCycleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DataCycleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DataCycleMultiError) AllErrors() []error { return m }
+
+// DataCycleValidationError is the validation error returned by
+// DataCycle.Validate if the designated constraints aren't met.
+type DataCycleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataCycleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataCycleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataCycleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataCycleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataCycleValidationError) ErrorName() string { return "DataCycleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DataCycleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataCycle.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataCycleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataCycleValidationError{}
+
+// Validate checks the field values on DataRecursiveRelation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DataRecursiveRelation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DataRecursiveRelation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataRecursiveRelationMultiError, or nil if none found.
+func (m *DataRecursiveRelation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DataRecursiveRelation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EntityType
+
+	// no validation rules for Relation
+
+	// no validation rules for Tuples
+
+	// no validation rules for MaxDepth
+
+	// no validation rules for DeepestEntityId
+
+	// no validation rules for CycleCount
+
+	for idx, item := range m.GetCycles() {
+		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RelationshipWriteRequestValidationError{
-						field:  fmt.Sprintf("Tuples[%v]", idx),
+					errors = append(errors, DataRecursiveRelationValidationError{
+						field:  fmt.Sprintf("Cycles[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, RelationshipWriteRequestValidationError{
-						field:  fmt.Sprintf("Tuples[%v]", idx),
+					errors = append(errors, DataRecursiveRelationValidationError{
+						field:  fmt.Sprintf("Cycles[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -6361,8 +14182,8 @@ func (m *RelationshipWriteRequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return RelationshipWriteRequestValidationError{
-					field:  fmt.Sprintf("Tuples[%v]", idx),
+				return DataRecursiveRelationValidationError{
+					field:  fmt.Sprintf("Cycles[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -6372,19 +14193,19 @@ func (m *RelationshipWriteRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return RelationshipWriteRequestMultiError(errors)
+		return DataRecursiveRelationMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipWriteRequestMultiError is an error wrapping multiple validation
-// errors returned by RelationshipWriteRequest.ValidateAll() if the designated
+// DataRecursiveRelationMultiError is an error wrapping multiple validation
+// errors returned by DataRecursiveRelation.ValidateAll() if the designated
 // constraints aren't met.
-type RelationshipWriteRequestMultiError []error
+type DataRecursiveRelationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipWriteRequestMultiError) Error() string {
+func (m DataRecursiveRelationMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6393,11 +14214,11 @@ func (m RelationshipWriteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipWriteRequestMultiError) AllErrors() []error { return m }
+func (m DataRecursiveRelationMultiError) AllErrors() []error { return m }
 
-// RelationshipWriteRequestValidationError is the validation error returned by
-// RelationshipWriteRequest.Validate if the designated constraints aren't met.
-type RelationshipWriteRequestValidationError struct {
+// DataRecursiveRelationValidationError is the validation error returned by
+// DataRecursiveRelation.Validate if the designated constraints aren't met.
+type DataRecursiveRelationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6405,24 +14226,24 @@ type RelationshipWriteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipWriteRequestValidationError) Field() string { return e.field }
+func (e DataRecursiveRelationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipWriteRequestValidationError) Reason() string { return e.reason }
+func (e DataRecursiveRelationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipWriteRequestValidationError) Cause() error { return e.cause }
+func (e DataRecursiveRelationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipWriteRequestValidationError) Key() bool { return e.key }
+func (e DataRecursiveRelationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipWriteRequestValidationError) ErrorName() string {
-	return "RelationshipWriteRequestValidationError"
+func (e DataRecursiveRelationValidationError) ErrorName() string {
+	return "DataRecursiveRelationValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipWriteRequestValidationError) Error() string {
+func (e DataRecursiveRelationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6434,14 +14255,14 @@ func (e RelationshipWriteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipWriteRequest.%s: %s%s",
+		"invalid %sDataRecursiveRelation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipWriteRequestValidationError{}
+var _ error = DataRecursiveRelationValidationError{}
 
 var _ interface {
 	Field() string
@@ -6449,50 +14270,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipWriteRequestValidationError{}
+} = DataRecursiveRelationValidationError{}
 
-var _RelationshipWriteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
-
-// Validate checks the field values on RelationshipWriteRequestMetadata with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *RelationshipWriteRequestMetadata) Validate() error {
+// Validate checks the field values on DataHotspot with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DataHotspot) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipWriteRequestMetadata with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RelationshipWriteRequestMetadataMultiError, or nil if none found.
-func (m *RelationshipWriteRequestMetadata) ValidateAll() error {
+// ValidateAll checks the field values on DataHotspot with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DataHotspotMultiError, or
+// nil if none found.
+func (m *DataHotspot) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipWriteRequestMetadata) validate(all bool) error {
+func (m *DataHotspot) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SchemaVersion
+	if all {
+		switch v := interface{}(m.GetEntity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataHotspotValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataHotspotValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataHotspotValidationError{
+				field:  "Entity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Relation
+
+	// no validation rules for Count
 
 	if len(errors) > 0 {
-		return RelationshipWriteRequestMetadataMultiError(errors)
+		return DataHotspotMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipWriteRequestMetadataMultiError is an error wrapping multiple
-// validation errors returned by
-// RelationshipWriteRequestMetadata.ValidateAll() if the designated
-// constraints aren't met.
-type RelationshipWriteRequestMetadataMultiError []error
+// DataHotspotMultiError is an error wrapping multiple validation errors
+// returned by DataHotspot.ValidateAll() if the designated constraints aren't met.
+type DataHotspotMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipWriteRequestMetadataMultiError) Error() string {
+func (m DataHotspotMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6501,12 +14348,11 @@ func (m RelationshipWriteRequestMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipWriteRequestMetadataMultiError) AllErrors() []error { return m }
+func (m DataHotspotMultiError) AllErrors() []error { return m }
 
-// RelationshipWriteRequestMetadataValidationError is the validation error
-// returned by RelationshipWriteRequestMetadata.Validate if the designated
-// constraints aren't met.
-type RelationshipWriteRequestMetadataValidationError struct {
+// DataHotspotValidationError is the validation error returned by
+// DataHotspot.Validate if the designated constraints aren't met.
+type DataHotspotValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6514,24 +14360,22 @@ type RelationshipWriteRequestMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipWriteRequestMetadataValidationError) Field() string { return e.field }
+func (e DataHotspotValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipWriteRequestMetadataValidationError) Reason() string { return e.reason }
+func (e DataHotspotValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipWriteRequestMetadataValidationError) Cause() error { return e.cause }
+func (e DataHotspotValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipWriteRequestMetadataValidationError) Key() bool { return e.key }
+func (e DataHotspotValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipWriteRequestMetadataValidationError) ErrorName() string {
-	return "RelationshipWriteRequestMetadataValidationError"
-}
+func (e DataHotspotValidationError) ErrorName() string { return "DataHotspotValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RelationshipWriteRequestMetadataValidationError) Error() string {
+func (e DataHotspotValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6543,14 +14387,14 @@ func (e RelationshipWriteRequestMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipWriteRequestMetadata.%s: %s%s",
+		"invalid %sDataHotspot.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipWriteRequestMetadataValidationError{}
+var _ error = DataHotspotValidationError{}
 
 var _ interface {
 	Field() string
@@ -6558,46 +14402,152 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipWriteRequestMetadataValidationError{}
+} = DataHotspotValidationError{}
 
-// Validate checks the field values on RelationshipWriteResponse with the rules
+// Validate checks the field values on DataAnalyzeResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipWriteResponse) Validate() error {
+func (m *DataAnalyzeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipWriteResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DataAnalyzeResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RelationshipWriteResponseMultiError, or nil if none found.
-func (m *RelationshipWriteResponse) ValidateAll() error {
+// DataAnalyzeResponseMultiError, or nil if none found.
+func (m *DataAnalyzeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipWriteResponse) validate(all bool) error {
+func (m *DataAnalyzeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for SchemaVersion
+
 	// no validation rules for SnapToken
 
+	// no validation rules for Relationships
+
+	for idx, item := range m.GetRecursiveRelations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataAnalyzeResponseValidationError{
+						field:  fmt.Sprintf("RecursiveRelations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataAnalyzeResponseValidationError{
+						field:  fmt.Sprintf("RecursiveRelations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataAnalyzeResponseValidationError{
+					field:  fmt.Sprintf("RecursiveRelations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetFanOut() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataAnalyzeResponseValidationError{
+						field:  fmt.Sprintf("FanOut[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataAnalyzeResponseValidationError{
+						field:  fmt.Sprintf("FanOut[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataAnalyzeResponseValidationError{
+					field:  fmt.Sprintf("FanOut[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetFanIn() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataAnalyzeResponseValidationError{
+						field:  fmt.Sprintf("FanIn[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataAnalyzeResponseValidationError{
+						field:  fmt.Sprintf("FanIn[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataAnalyzeResponseValidationError{
+					field:  fmt.Sprintf("FanIn[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return RelationshipWriteResponseMultiError(errors)
+		return DataAnalyzeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipWriteResponseMultiError is an error wrapping multiple validation
-// errors returned by RelationshipWriteResponse.ValidateAll() if the
-// designated constraints aren't met.
-type RelationshipWriteResponseMultiError []error
+// DataAnalyzeResponseMultiError is an error wrapping multiple validation
+// errors returned by DataAnalyzeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DataAnalyzeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipWriteResponseMultiError) Error() string {
+func (m DataAnalyzeResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6606,11 +14556,11 @@ func (m RelationshipWriteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipWriteResponseMultiError) AllErrors() []error { return m }
+func (m DataAnalyzeResponseMultiError) AllErrors() []error { return m }
 
-// RelationshipWriteResponseValidationError is the validation error returned by
-// RelationshipWriteResponse.Validate if the designated constraints aren't met.
-type RelationshipWriteResponseValidationError struct {
+// DataAnalyzeResponseValidationError is the validation error returned by
+// DataAnalyzeResponse.Validate if the designated constraints aren't met.
+type DataAnalyzeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6618,24 +14568,24 @@ type RelationshipWriteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipWriteResponseValidationError) Field() string { return e.field }
+func (e DataAnalyzeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipWriteResponseValidationError) Reason() string { return e.reason }
+func (e DataAnalyzeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipWriteResponseValidationError) Cause() error { return e.cause }
+func (e DataAnalyzeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipWriteResponseValidationError) Key() bool { return e.key }
+func (e DataAnalyzeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipWriteResponseValidationError) ErrorName() string {
-	return "RelationshipWriteResponseValidationError"
+func (e DataAnalyzeResponseValidationError) ErrorName() string {
+	return "DataAnalyzeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipWriteResponseValidationError) Error() string {
+func (e DataAnalyzeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6647,14 +14597,14 @@ func (e RelationshipWriteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipWriteResponse.%s: %s%s",
+		"invalid %sDataAnalyzeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipWriteResponseValidationError{}
+var _ error = DataAnalyzeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6662,24 +14612,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipWriteResponseValidationError{}
+} = DataAnalyzeResponseValidationError{}
 
-// Validate checks the field values on RelationshipReadRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipReadRequest) Validate() error {
+// Validate checks the field values on DataDeleteRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DataDeleteRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipReadRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DataDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RelationshipReadRequestMultiError, or nil if none found.
-func (m *RelationshipReadRequest) ValidateAll() error {
+// DataDeleteRequestMultiError, or nil if none found.
+func (m *DataDeleteRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipReadRequest) validate(all bool) error {
+func (m *DataDeleteRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6687,7 +14637,7 @@ func (m *RelationshipReadRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetTenantId()) > 128 {
-		err := RelationshipReadRequestValidationError{
+		err := DataDeleteRequestValidationError{
 			field:  "TenantId",
 			reason: "value length must be at most 128 bytes",
 		}
@@ -6697,8 +14647,8 @@ func (m *RelationshipReadRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_RelationshipReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := RelationshipReadRequestValidationError{
+	if !_DataDeleteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := DataDeleteRequestValidationError{
 			field:  "TenantId",
 			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
 		}
@@ -6708,9 +14658,9 @@ func (m *RelationshipReadRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetMetadata() == nil {
-		err := RelationshipReadRequestValidationError{
-			field:  "Metadata",
+	if m.GetTupleFilter() == nil {
+		err := DataDeleteRequestValidationError{
+			field:  "TupleFilter",
 			reason: "value is required",
 		}
 		if !all {
@@ -6720,37 +14670,37 @@ func (m *RelationshipReadRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
+		switch v := interface{}(m.GetTupleFilter()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RelationshipReadRequestValidationError{
-					field:  "Metadata",
+				errors = append(errors, DataDeleteRequestValidationError{
+					field:  "TupleFilter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RelationshipReadRequestValidationError{
-					field:  "Metadata",
+				errors = append(errors, DataDeleteRequestValidationError{
+					field:  "TupleFilter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTupleFilter()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RelationshipReadRequestValidationError{
-				field:  "Metadata",
+			return DataDeleteRequestValidationError{
+				field:  "TupleFilter",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if m.GetFilter() == nil {
-		err := RelationshipReadRequestValidationError{
-			field:  "Filter",
+	if m.GetAttributeFilter() == nil {
+		err := DataDeleteRequestValidationError{
+			field:  "AttributeFilter",
 			reason: "value is required",
 		}
 		if !all {
@@ -6760,40 +14710,52 @@ func (m *RelationshipReadRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetFilter()).(type) {
+		switch v := interface{}(m.GetAttributeFilter()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RelationshipReadRequestValidationError{
-					field:  "Filter",
+				errors = append(errors, DataDeleteRequestValidationError{
+					field:  "AttributeFilter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RelationshipReadRequestValidationError{
-					field:  "Filter",
+				errors = append(errors, DataDeleteRequestValidationError{
+					field:  "AttributeFilter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetAttributeFilter()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RelationshipReadRequestValidationError{
-				field:  "Filter",
+			return DataDeleteRequestValidationError{
+				field:  "AttributeFilter",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if m.GetPageSize() != 0 {
+	if len(m.GetPreconditions()) > 100 {
+		err := DataDeleteRequestValidationError{
+			field:  "Preconditions",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if m.GetPageSize() < 1 {
-			err := RelationshipReadRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be greater than or equal to 1",
+	for idx, item := range m.GetPreconditions() {
+		_, _ = idx, item
+
+		if item == nil {
+			err := DataDeleteRequestValidationError{
+				field:  fmt.Sprintf("Preconditions[%v]", idx),
+				reason: "value is required",
 			}
 			if !all {
 				return err
@@ -6801,26 +14763,80 @@ func (m *RelationshipReadRequest) validate(all bool) error {
 			errors = append(errors, err)
 		}
 
-	}
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DataDeleteRequestValidationError{
+						field:  fmt.Sprintf("Preconditions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DataDeleteRequestValidationError{
+						field:  fmt.Sprintf("Preconditions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DataDeleteRequestValidationError{
+					field:  fmt.Sprintf("Preconditions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	if m.GetContinuousToken() != "" {
+	}
 
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataDeleteRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataDeleteRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataDeleteRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if len(errors) > 0 {
-		return RelationshipReadRequestMultiError(errors)
+		return DataDeleteRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipReadRequestMultiError is an error wrapping multiple validation
-// errors returned by RelationshipReadRequest.ValidateAll() if the designated
-// constraints aren't met.
-type RelationshipReadRequestMultiError []error
+// DataDeleteRequestMultiError is an error wrapping multiple validation errors
+// returned by DataDeleteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DataDeleteRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipReadRequestMultiError) Error() string {
+func (m DataDeleteRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6829,11 +14845,11 @@ func (m RelationshipReadRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipReadRequestMultiError) AllErrors() []error { return m }
+func (m DataDeleteRequestMultiError) AllErrors() []error { return m }
 
-// RelationshipReadRequestValidationError is the validation error returned by
-// RelationshipReadRequest.Validate if the designated constraints aren't met.
-type RelationshipReadRequestValidationError struct {
+// DataDeleteRequestValidationError is the validation error returned by
+// DataDeleteRequest.Validate if the designated constraints aren't met.
+type DataDeleteRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6841,24 +14857,24 @@ type RelationshipReadRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipReadRequestValidationError) Field() string { return e.field }
+func (e DataDeleteRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipReadRequestValidationError) Reason() string { return e.reason }
+func (e DataDeleteRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipReadRequestValidationError) Cause() error { return e.cause }
+func (e DataDeleteRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipReadRequestValidationError) Key() bool { return e.key }
+func (e DataDeleteRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipReadRequestValidationError) ErrorName() string {
-	return "RelationshipReadRequestValidationError"
+func (e DataDeleteRequestValidationError) ErrorName() string {
+	return "DataDeleteRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipReadRequestValidationError) Error() string {
+func (e DataDeleteRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6870,14 +14886,14 @@ func (e RelationshipReadRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipReadRequest.%s: %s%s",
+		"invalid %sDataDeleteRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipReadRequestValidationError{}
+var _ error = DataDeleteRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6885,26 +14901,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipReadRequestValidationError{}
+} = DataDeleteRequestValidationError{}
 
-var _RelationshipReadRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+var _DataDeleteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
 
-// Validate checks the field values on RelationshipReadRequestMetadata with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DataDeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipReadRequestMetadata) Validate() error {
+func (m *DataDeleteResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipReadRequestMetadata with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RelationshipReadRequestMetadataMultiError, or nil if none found.
-func (m *RelationshipReadRequestMetadata) ValidateAll() error {
+// ValidateAll checks the field values on DataDeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DataDeleteResponseMultiError, or nil if none found.
+func (m *DataDeleteResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipReadRequestMetadata) validate(all bool) error {
+func (m *DataDeleteResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6914,19 +14930,19 @@ func (m *RelationshipReadRequestMetadata) validate(all bool) error {
 	// no validation rules for SnapToken
 
 	if len(errors) > 0 {
-		return RelationshipReadRequestMetadataMultiError(errors)
+		return DataDeleteResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipReadRequestMetadataMultiError is an error wrapping multiple
-// validation errors returned by RelationshipReadRequestMetadata.ValidateAll()
-// if the designated constraints aren't met.
-type RelationshipReadRequestMetadataMultiError []error
+// DataDeleteResponseMultiError is an error wrapping multiple validation errors
+// returned by DataDeleteResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DataDeleteResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipReadRequestMetadataMultiError) Error() string {
+func (m DataDeleteResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6935,12 +14951,11 @@ func (m RelationshipReadRequestMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipReadRequestMetadataMultiError) AllErrors() []error { return m }
+func (m DataDeleteResponseMultiError) AllErrors() []error { return m }
 
-// RelationshipReadRequestMetadataValidationError is the validation error
-// returned by RelationshipReadRequestMetadata.Validate if the designated
-// constraints aren't met.
-type RelationshipReadRequestMetadataValidationError struct {
+// DataDeleteResponseValidationError is the validation error returned by
+// DataDeleteResponse.Validate if the designated constraints aren't met.
+type DataDeleteResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6948,24 +14963,24 @@ type RelationshipReadRequestMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipReadRequestMetadataValidationError) Field() string { return e.field }
+func (e DataDeleteResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipReadRequestMetadataValidationError) Reason() string { return e.reason }
+func (e DataDeleteResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipReadRequestMetadataValidationError) Cause() error { return e.cause }
+func (e DataDeleteResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipReadRequestMetadataValidationError) Key() bool { return e.key }
+func (e DataDeleteResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipReadRequestMetadataValidationError) ErrorName() string {
-	return "RelationshipReadRequestMetadataValidationError"
+func (e DataDeleteResponseValidationError) ErrorName() string {
+	return "DataDeleteResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipReadRequestMetadataValidationError) Error() string {
+func (e DataDeleteResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6977,14 +14992,14 @@ func (e RelationshipReadRequestMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipReadRequestMetadata.%s: %s%s",
+		"invalid %sDataDeleteResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipReadRequestMetadataValidationError{}
+var _ error = DataDeleteResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6992,80 +15007,201 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipReadRequestMetadataValidationError{}
+} = DataDeleteResponseValidationError{}
 
-// Validate checks the field values on RelationshipReadResponse with the rules
+// Validate checks the field values on RelationshipDeleteRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipReadResponse) Validate() error {
+func (m *RelationshipDeleteRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipReadResponse with the
+// ValidateAll checks the field values on RelationshipDeleteRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RelationshipReadResponseMultiError, or nil if none found.
-func (m *RelationshipReadResponse) ValidateAll() error {
+// RelationshipDeleteRequestMultiError, or nil if none found.
+func (m *RelationshipDeleteRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipReadResponse) validate(all bool) error {
+func (m *RelationshipDeleteRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetTuples() {
-		_, _ = idx, item
+	if len(m.GetTenantId()) > 128 {
+		err := RelationshipDeleteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RelationshipReadResponseValidationError{
-						field:  fmt.Sprintf("Tuples[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, RelationshipReadResponseValidationError{
-						field:  fmt.Sprintf("Tuples[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if !_RelationshipDeleteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := RelationshipDeleteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipDeleteRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return RelationshipReadResponseValidationError{
-					field:  fmt.Sprintf("Tuples[%v]", idx),
+				errors = append(errors, RelationshipDeleteRequestValidationError{
+					field:  "Filter",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipDeleteRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
+
+	if len(errors) > 0 {
+		return RelationshipDeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipDeleteRequestMultiError is an error wrapping multiple validation
+// errors returned by RelationshipDeleteRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RelationshipDeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipDeleteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipDeleteRequestMultiError) AllErrors() []error { return m }
+
+// RelationshipDeleteRequestValidationError is the validation error returned by
+// RelationshipDeleteRequest.Validate if the designated constraints aren't met.
+type RelationshipDeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipDeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipDeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipDeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipDeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipDeleteRequestValidationError) ErrorName() string {
+	return "RelationshipDeleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipDeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipDeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipDeleteRequestValidationError{}
+
+var _RelationshipDeleteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on RelationshipDeleteResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipDeleteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipDeleteResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipDeleteResponseMultiError, or nil if none found.
+func (m *RelationshipDeleteResponse) ValidateAll() error {
+	return m.validate(true)
+}
 
+func (m *RelationshipDeleteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	// no validation rules for ContinuousToken
+	var errors []error
+
+	// no validation rules for SnapToken
 
 	if len(errors) > 0 {
-		return RelationshipReadResponseMultiError(errors)
+		return RelationshipDeleteResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipReadResponseMultiError is an error wrapping multiple validation
-// errors returned by RelationshipReadResponse.ValidateAll() if the designated
-// constraints aren't met.
-type RelationshipReadResponseMultiError []error
+// RelationshipDeleteResponseMultiError is an error wrapping multiple
+// validation errors returned by RelationshipDeleteResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RelationshipDeleteResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipReadResponseMultiError) Error() string {
+func (m RelationshipDeleteResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7074,11 +15210,11 @@ func (m RelationshipReadResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipReadResponseMultiError) AllErrors() []error { return m }
+func (m RelationshipDeleteResponseMultiError) AllErrors() []error { return m }
 
-// RelationshipReadResponseValidationError is the validation error returned by
-// RelationshipReadResponse.Validate if the designated constraints aren't met.
-type RelationshipReadResponseValidationError struct {
+// RelationshipDeleteResponseValidationError is the validation error returned
+// by RelationshipDeleteResponse.Validate if the designated constraints aren't met.
+type RelationshipDeleteResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7086,24 +15222,24 @@ type RelationshipReadResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipReadResponseValidationError) Field() string { return e.field }
+func (e RelationshipDeleteResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipReadResponseValidationError) Reason() string { return e.reason }
+func (e RelationshipDeleteResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipReadResponseValidationError) Cause() error { return e.cause }
+func (e RelationshipDeleteResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipReadResponseValidationError) Key() bool { return e.key }
+func (e RelationshipDeleteResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipReadResponseValidationError) ErrorName() string {
-	return "RelationshipReadResponseValidationError"
+func (e RelationshipDeleteResponseValidationError) ErrorName() string {
+	return "RelationshipDeleteResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipReadResponseValidationError) Error() string {
+func (e RelationshipDeleteResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7115,14 +15251,14 @@ func (e RelationshipReadResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipReadResponse.%s: %s%s",
+		"invalid %sRelationshipDeleteResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipReadResponseValidationError{}
+var _ error = RelationshipDeleteResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7130,24 +15266,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipReadResponseValidationError{}
+} = RelationshipDeleteResponseValidationError{}
 
-// Validate checks the field values on AttributeReadRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AttributeReadRequest) Validate() error {
+// Validate checks the field values on BundleRunRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BundleRunRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AttributeReadRequest with the rules
+// ValidateAll checks the field values on BundleRunRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AttributeReadRequestMultiError, or nil if none found.
-func (m *AttributeReadRequest) ValidateAll() error {
+// BundleRunRequestMultiError, or nil if none found.
+func (m *BundleRunRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeReadRequest) validate(all bool) error {
+func (m *BundleRunRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7155,7 +15291,7 @@ func (m *AttributeReadRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetTenantId()) > 128 {
-		err := AttributeReadRequestValidationError{
+		err := BundleRunRequestValidationError{
 			field:  "TenantId",
 			reason: "value length must be at most 128 bytes",
 		}
@@ -7165,8 +15301,8 @@ func (m *AttributeReadRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_AttributeReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := AttributeReadRequestValidationError{
+	if !_BundleRunRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := BundleRunRequestValidationError{
 			field:  "TenantId",
 			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
 		}
@@ -7176,10 +15312,14 @@ func (m *AttributeReadRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetMetadata() == nil {
-		err := AttributeReadRequestValidationError{
-			field:  "Metadata",
-			reason: "value is required",
+	// no validation rules for Name
+
+	// no validation rules for Arguments
+
+	if len(m.GetIdempotencyKey()) > 128 {
+		err := BundleRunRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 128 bytes",
 		}
 		if !all {
 			return err
@@ -7187,108 +15327,105 @@ func (m *AttributeReadRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AttributeReadRequestValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	if len(m.GetPreconditions()) > 100 {
+		err := BundleRunRequestValidationError{
+			field:  "Preconditions",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetPreconditions() {
+		_, _ = idx, item
+
+		if item == nil {
+			err := BundleRunRequestValidationError{
+				field:  fmt.Sprintf("Preconditions[%v]", idx),
+				reason: "value is required",
 			}
-		case interface{ Validate() error }:
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BundleRunRequestValidationError{
+						field:  fmt.Sprintf("Preconditions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BundleRunRequestValidationError{
+						field:  fmt.Sprintf("Preconditions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AttributeReadRequestValidationError{
-					field:  "Metadata",
+				return BundleRunRequestValidationError{
+					field:  fmt.Sprintf("Preconditions[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AttributeReadRequestValidationError{
-				field:  "Metadata",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	if m.GetFilter() == nil {
-		err := AttributeReadRequestValidationError{
-			field:  "Filter",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
 	if all {
-		switch v := interface{}(m.GetFilter()).(type) {
+		switch v := interface{}(m.GetMetadata()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AttributeReadRequestValidationError{
-					field:  "Filter",
+				errors = append(errors, BundleRunRequestValidationError{
+					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AttributeReadRequestValidationError{
-					field:  "Filter",
+				errors = append(errors, BundleRunRequestValidationError{
+					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AttributeReadRequestValidationError{
-				field:  "Filter",
+			return BundleRunRequestValidationError{
+				field:  "Metadata",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if m.GetPageSize() != 0 {
-
-		if m.GetPageSize() < 1 {
-			err := AttributeReadRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be greater than or equal to 1",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	if m.GetContinuousToken() != "" {
-
-	}
-
 	if len(errors) > 0 {
-		return AttributeReadRequestMultiError(errors)
+		return BundleRunRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeReadRequestMultiError is an error wrapping multiple validation
-// errors returned by AttributeReadRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AttributeReadRequestMultiError []error
+// BundleRunRequestMultiError is an error wrapping multiple validation errors
+// returned by BundleRunRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BundleRunRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeReadRequestMultiError) Error() string {
+func (m BundleRunRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7297,11 +15434,11 @@ func (m AttributeReadRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeReadRequestMultiError) AllErrors() []error { return m }
+func (m BundleRunRequestMultiError) AllErrors() []error { return m }
 
-// AttributeReadRequestValidationError is the validation error returned by
-// AttributeReadRequest.Validate if the designated constraints aren't met.
-type AttributeReadRequestValidationError struct {
+// BundleRunRequestValidationError is the validation error returned by
+// BundleRunRequest.Validate if the designated constraints aren't met.
+type BundleRunRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7309,24 +15446,22 @@ type AttributeReadRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttributeReadRequestValidationError) Field() string { return e.field }
+func (e BundleRunRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttributeReadRequestValidationError) Reason() string { return e.reason }
+func (e BundleRunRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttributeReadRequestValidationError) Cause() error { return e.cause }
+func (e BundleRunRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttributeReadRequestValidationError) Key() bool { return e.key }
+func (e BundleRunRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeReadRequestValidationError) ErrorName() string {
-	return "AttributeReadRequestValidationError"
-}
+func (e BundleRunRequestValidationError) ErrorName() string { return "BundleRunRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AttributeReadRequestValidationError) Error() string {
+func (e BundleRunRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7338,14 +15473,14 @@ func (e AttributeReadRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeReadRequest.%s: %s%s",
+		"invalid %sBundleRunRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeReadRequestValidationError{}
+var _ error = BundleRunRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7353,26 +15488,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeReadRequestValidationError{}
+} = BundleRunRequestValidationError{}
 
-var _AttributeReadRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+var _BundleRunRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
 
-// Validate checks the field values on AttributeReadRequestMetadata with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AttributeReadRequestMetadata) Validate() error {
+// Validate checks the field values on BundleRunResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BundleRunResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AttributeReadRequestMetadata with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AttributeReadRequestMetadataMultiError, or nil if none found.
-func (m *AttributeReadRequestMetadata) ValidateAll() error {
+// ValidateAll checks the field values on BundleRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BundleRunResponseMultiError, or nil if none found.
+func (m *BundleRunResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeReadRequestMetadata) validate(all bool) error {
+func (m *BundleRunResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7382,19 +15517,19 @@ func (m *AttributeReadRequestMetadata) validate(all bool) error {
 	// no validation rules for SnapToken
 
 	if len(errors) > 0 {
-		return AttributeReadRequestMetadataMultiError(errors)
+		return BundleRunResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeReadRequestMetadataMultiError is an error wrapping multiple
-// validation errors returned by AttributeReadRequestMetadata.ValidateAll() if
-// the designated constraints aren't met.
-type AttributeReadRequestMetadataMultiError []error
+// BundleRunResponseMultiError is an error wrapping multiple validation errors
+// returned by BundleRunResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BundleRunResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeReadRequestMetadataMultiError) Error() string {
+func (m BundleRunResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7403,12 +15538,11 @@ func (m AttributeReadRequestMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeReadRequestMetadataMultiError) AllErrors() []error { return m }
+func (m BundleRunResponseMultiError) AllErrors() []error { return m }
 
-// AttributeReadRequestMetadataValidationError is the validation error returned
-// by AttributeReadRequestMetadata.Validate if the designated constraints
-// aren't met.
-type AttributeReadRequestMetadataValidationError struct {
+// BundleRunResponseValidationError is the validation error returned by
+// BundleRunResponse.Validate if the designated constraints aren't met.
+type BundleRunResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7416,24 +15550,24 @@ type AttributeReadRequestMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttributeReadRequestMetadataValidationError) Field() string { return e.field }
+func (e BundleRunResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttributeReadRequestMetadataValidationError) Reason() string { return e.reason }
+func (e BundleRunResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttributeReadRequestMetadataValidationError) Cause() error { return e.cause }
+func (e BundleRunResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttributeReadRequestMetadataValidationError) Key() bool { return e.key }
+func (e BundleRunResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeReadRequestMetadataValidationError) ErrorName() string {
-	return "AttributeReadRequestMetadataValidationError"
+func (e BundleRunResponseValidationError) ErrorName() string {
+	return "BundleRunResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeReadRequestMetadataValidationError) Error() string {
+func (e BundleRunResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7445,14 +15579,14 @@ func (e AttributeReadRequestMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeReadRequestMetadata.%s: %s%s",
+		"invalid %sBundleRunResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeReadRequestMetadataValidationError{}
+var _ error = BundleRunResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7460,47 +15594,69 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeReadRequestMetadataValidationError{}
+} = BundleRunResponseValidationError{}
 
-// Validate checks the field values on AttributeReadResponse with the rules
+// Validate checks the field values on BundleWriteRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AttributeReadResponse) Validate() error {
+func (m *BundleWriteRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AttributeReadResponse with the rules
+// ValidateAll checks the field values on BundleWriteRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AttributeReadResponseMultiError, or nil if none found.
-func (m *AttributeReadResponse) ValidateAll() error {
+// BundleWriteRequestMultiError, or nil if none found.
+func (m *BundleWriteRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeReadResponse) validate(all bool) error {
+func (m *BundleWriteRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetAttributes() {
+	if len(m.GetTenantId()) > 128 {
+		err := BundleWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_BundleWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := BundleWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetBundles() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AttributeReadResponseValidationError{
-						field:  fmt.Sprintf("Attributes[%v]", idx),
+					errors = append(errors, BundleWriteRequestValidationError{
+						field:  fmt.Sprintf("Bundles[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AttributeReadResponseValidationError{
-						field:  fmt.Sprintf("Attributes[%v]", idx),
+					errors = append(errors, BundleWriteRequestValidationError{
+						field:  fmt.Sprintf("Bundles[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -7508,8 +15664,8 @@ func (m *AttributeReadResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AttributeReadResponseValidationError{
-					field:  fmt.Sprintf("Attributes[%v]", idx),
+				return BundleWriteRequestValidationError{
+					field:  fmt.Sprintf("Bundles[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -7518,22 +15674,20 @@ func (m *AttributeReadResponse) validate(all bool) error {
 
 	}
 
-	// no validation rules for ContinuousToken
-
 	if len(errors) > 0 {
-		return AttributeReadResponseMultiError(errors)
+		return BundleWriteRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeReadResponseMultiError is an error wrapping multiple validation
-// errors returned by AttributeReadResponse.ValidateAll() if the designated
-// constraints aren't met.
-type AttributeReadResponseMultiError []error
+// BundleWriteRequestMultiError is an error wrapping multiple validation errors
+// returned by BundleWriteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BundleWriteRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeReadResponseMultiError) Error() string {
+func (m BundleWriteRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7542,11 +15696,11 @@ func (m AttributeReadResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeReadResponseMultiError) AllErrors() []error { return m }
+func (m BundleWriteRequestMultiError) AllErrors() []error { return m }
 
-// AttributeReadResponseValidationError is the validation error returned by
-// AttributeReadResponse.Validate if the designated constraints aren't met.
-type AttributeReadResponseValidationError struct {
+// BundleWriteRequestValidationError is the validation error returned by
+// BundleWriteRequest.Validate if the designated constraints aren't met.
+type BundleWriteRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7554,24 +15708,24 @@ type AttributeReadResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttributeReadResponseValidationError) Field() string { return e.field }
+func (e BundleWriteRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttributeReadResponseValidationError) Reason() string { return e.reason }
+func (e BundleWriteRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttributeReadResponseValidationError) Cause() error { return e.cause }
+func (e BundleWriteRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttributeReadResponseValidationError) Key() bool { return e.key }
+func (e BundleWriteRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeReadResponseValidationError) ErrorName() string {
-	return "AttributeReadResponseValidationError"
+func (e BundleWriteRequestValidationError) ErrorName() string {
+	return "BundleWriteRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeReadResponseValidationError) Error() string {
+func (e BundleWriteRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7583,14 +15737,14 @@ func (e AttributeReadResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeReadResponse.%s: %s%s",
+		"invalid %sBundleWriteRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeReadResponseValidationError{}
+var _ error = BundleWriteRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7598,146 +15752,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeReadResponseValidationError{}
+} = BundleWriteRequestValidationError{}
 
-// Validate checks the field values on DataDeleteRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DataDeleteRequest) Validate() error {
+var _BundleWriteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on BundleWriteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BundleWriteResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DataDeleteRequest with the rules
+// ValidateAll checks the field values on BundleWriteResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DataDeleteRequestMultiError, or nil if none found.
-func (m *DataDeleteRequest) ValidateAll() error {
+// BundleWriteResponseMultiError, or nil if none found.
+func (m *BundleWriteResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DataDeleteRequest) validate(all bool) error {
+func (m *BundleWriteResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 128 {
-		err := DataDeleteRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 128 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_DataDeleteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := DataDeleteRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetTupleFilter() == nil {
-		err := DataDeleteRequestValidationError{
-			field:  "TupleFilter",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetTupleFilter()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DataDeleteRequestValidationError{
-					field:  "TupleFilter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, DataDeleteRequestValidationError{
-					field:  "TupleFilter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTupleFilter()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DataDeleteRequestValidationError{
-				field:  "TupleFilter",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if m.GetAttributeFilter() == nil {
-		err := DataDeleteRequestValidationError{
-			field:  "AttributeFilter",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetAttributeFilter()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DataDeleteRequestValidationError{
-					field:  "AttributeFilter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, DataDeleteRequestValidationError{
-					field:  "AttributeFilter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAttributeFilter()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DataDeleteRequestValidationError{
-				field:  "AttributeFilter",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return DataDeleteRequestMultiError(errors)
+		return BundleWriteResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DataDeleteRequestMultiError is an error wrapping multiple validation errors
-// returned by DataDeleteRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DataDeleteRequestMultiError []error
+// BundleWriteResponseMultiError is an error wrapping multiple validation
+// errors returned by BundleWriteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BundleWriteResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DataDeleteRequestMultiError) Error() string {
+func (m BundleWriteResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7746,11 +15800,11 @@ func (m DataDeleteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DataDeleteRequestMultiError) AllErrors() []error { return m }
+func (m BundleWriteResponseMultiError) AllErrors() []error { return m }
 
-// DataDeleteRequestValidationError is the validation error returned by
-// DataDeleteRequest.Validate if the designated constraints aren't met.
-type DataDeleteRequestValidationError struct {
+// BundleWriteResponseValidationError is the validation error returned by
+// BundleWriteResponse.Validate if the designated constraints aren't met.
+type BundleWriteResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7758,24 +15812,24 @@ type DataDeleteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DataDeleteRequestValidationError) Field() string { return e.field }
+func (e BundleWriteResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DataDeleteRequestValidationError) Reason() string { return e.reason }
+func (e BundleWriteResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DataDeleteRequestValidationError) Cause() error { return e.cause }
+func (e BundleWriteResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DataDeleteRequestValidationError) Key() bool { return e.key }
+func (e BundleWriteResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DataDeleteRequestValidationError) ErrorName() string {
-	return "DataDeleteRequestValidationError"
+func (e BundleWriteResponseValidationError) ErrorName() string {
+	return "BundleWriteResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DataDeleteRequestValidationError) Error() string {
+func (e BundleWriteResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7787,14 +15841,14 @@ func (e DataDeleteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDataDeleteRequest.%s: %s%s",
+		"invalid %sBundleWriteResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DataDeleteRequestValidationError{}
+var _ error = BundleWriteResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7802,48 +15856,68 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DataDeleteRequestValidationError{}
-
-var _DataDeleteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+} = BundleWriteResponseValidationError{}
 
-// Validate checks the field values on DataDeleteResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DataDeleteResponse) Validate() error {
+// Validate checks the field values on BundleReadRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BundleReadRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DataDeleteResponse with the rules
+// ValidateAll checks the field values on BundleReadRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DataDeleteResponseMultiError, or nil if none found.
-func (m *DataDeleteResponse) ValidateAll() error {
+// BundleReadRequestMultiError, or nil if none found.
+func (m *BundleReadRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DataDeleteResponse) validate(all bool) error {
+func (m *BundleReadRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SnapToken
+	if len(m.GetTenantId()) > 128 {
+		err := BundleReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_BundleReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := BundleReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return DataDeleteResponseMultiError(errors)
+		return BundleReadRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DataDeleteResponseMultiError is an error wrapping multiple validation errors
-// returned by DataDeleteResponse.ValidateAll() if the designated constraints
+// BundleReadRequestMultiError is an error wrapping multiple validation errors
+// returned by BundleReadRequest.ValidateAll() if the designated constraints
 // aren't met.
-type DataDeleteResponseMultiError []error
+type BundleReadRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DataDeleteResponseMultiError) Error() string {
+func (m BundleReadRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7852,11 +15926,11 @@ func (m DataDeleteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DataDeleteResponseMultiError) AllErrors() []error { return m }
+func (m BundleReadRequestMultiError) AllErrors() []error { return m }
 
-// DataDeleteResponseValidationError is the validation error returned by
-// DataDeleteResponse.Validate if the designated constraints aren't met.
-type DataDeleteResponseValidationError struct {
+// BundleReadRequestValidationError is the validation error returned by
+// BundleReadRequest.Validate if the designated constraints aren't met.
+type BundleReadRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7864,24 +15938,24 @@ type DataDeleteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DataDeleteResponseValidationError) Field() string { return e.field }
+func (e BundleReadRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DataDeleteResponseValidationError) Reason() string { return e.reason }
+func (e BundleReadRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DataDeleteResponseValidationError) Cause() error { return e.cause }
+func (e BundleReadRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DataDeleteResponseValidationError) Key() bool { return e.key }
+func (e BundleReadRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DataDeleteResponseValidationError) ErrorName() string {
-	return "DataDeleteResponseValidationError"
+func (e BundleReadRequestValidationError) ErrorName() string {
+	return "BundleReadRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DataDeleteResponseValidationError) Error() string {
+func (e BundleReadRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7893,14 +15967,14 @@ func (e DataDeleteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDataDeleteResponse.%s: %s%s",
+		"invalid %sBundleReadRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DataDeleteResponseValidationError{}
+var _ error = BundleReadRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7908,75 +15982,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DataDeleteResponseValidationError{}
+} = BundleReadRequestValidationError{}
 
-// Validate checks the field values on RelationshipDeleteRequest with the rules
+var _BundleReadRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on BundleReadResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipDeleteRequest) Validate() error {
+func (m *BundleReadResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipDeleteRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on BundleReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RelationshipDeleteRequestMultiError, or nil if none found.
-func (m *RelationshipDeleteRequest) ValidateAll() error {
+// BundleReadResponseMultiError, or nil if none found.
+func (m *BundleReadResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipDeleteRequest) validate(all bool) error {
+func (m *BundleReadResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 128 {
-		err := RelationshipDeleteRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 128 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_RelationshipDeleteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := RelationshipDeleteRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if all {
-		switch v := interface{}(m.GetFilter()).(type) {
+		switch v := interface{}(m.GetBundle()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RelationshipDeleteRequestValidationError{
-					field:  "Filter",
+				errors = append(errors, BundleReadResponseValidationError{
+					field:  "Bundle",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RelationshipDeleteRequestValidationError{
-					field:  "Filter",
+				errors = append(errors, BundleReadResponseValidationError{
+					field:  "Bundle",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetBundle()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RelationshipDeleteRequestValidationError{
-				field:  "Filter",
+			return BundleReadResponseValidationError{
+				field:  "Bundle",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -7984,19 +16038,19 @@ func (m *RelationshipDeleteRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return RelationshipDeleteRequestMultiError(errors)
+		return BundleReadResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipDeleteRequestMultiError is an error wrapping multiple validation
-// errors returned by RelationshipDeleteRequest.ValidateAll() if the
-// designated constraints aren't met.
-type RelationshipDeleteRequestMultiError []error
+// BundleReadResponseMultiError is an error wrapping multiple validation errors
+// returned by BundleReadResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BundleReadResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipDeleteRequestMultiError) Error() string {
+func (m BundleReadResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8005,11 +16059,11 @@ func (m RelationshipDeleteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipDeleteRequestMultiError) AllErrors() []error { return m }
+func (m BundleReadResponseMultiError) AllErrors() []error { return m }
 
-// RelationshipDeleteRequestValidationError is the validation error returned by
-// RelationshipDeleteRequest.Validate if the designated constraints aren't met.
-type RelationshipDeleteRequestValidationError struct {
+// BundleReadResponseValidationError is the validation error returned by
+// BundleReadResponse.Validate if the designated constraints aren't met.
+type BundleReadResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8017,24 +16071,24 @@ type RelationshipDeleteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipDeleteRequestValidationError) Field() string { return e.field }
+func (e BundleReadResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipDeleteRequestValidationError) Reason() string { return e.reason }
+func (e BundleReadResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipDeleteRequestValidationError) Cause() error { return e.cause }
+func (e BundleReadResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipDeleteRequestValidationError) Key() bool { return e.key }
+func (e BundleReadResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipDeleteRequestValidationError) ErrorName() string {
-	return "RelationshipDeleteRequestValidationError"
+func (e BundleReadResponseValidationError) ErrorName() string {
+	return "BundleReadResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipDeleteRequestValidationError) Error() string {
+func (e BundleReadResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8046,14 +16100,14 @@ func (e RelationshipDeleteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipDeleteRequest.%s: %s%s",
+		"invalid %sBundleReadResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipDeleteRequestValidationError{}
+var _ error = BundleReadResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8061,48 +16115,68 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipDeleteRequestValidationError{}
-
-var _RelationshipDeleteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+} = BundleReadResponseValidationError{}
 
-// Validate checks the field values on RelationshipDeleteResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on BundleDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipDeleteResponse) Validate() error {
+func (m *BundleDeleteRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipDeleteResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on BundleDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RelationshipDeleteResponseMultiError, or nil if none found.
-func (m *RelationshipDeleteResponse) ValidateAll() error {
+// BundleDeleteRequestMultiError, or nil if none found.
+func (m *BundleDeleteRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipDeleteResponse) validate(all bool) error {
+func (m *BundleDeleteRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	// no validation rules for SnapToken
+	var errors []error
+
+	if len(m.GetTenantId()) > 128 {
+		err := BundleDeleteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_BundleDeleteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := BundleDeleteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return RelationshipDeleteResponseMultiError(errors)
+		return BundleDeleteRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipDeleteResponseMultiError is an error wrapping multiple
-// validation errors returned by RelationshipDeleteResponse.ValidateAll() if
-// the designated constraints aren't met.
-type RelationshipDeleteResponseMultiError []error
+// BundleDeleteRequestMultiError is an error wrapping multiple validation
+// errors returned by BundleDeleteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BundleDeleteRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipDeleteResponseMultiError) Error() string {
+func (m BundleDeleteRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8111,11 +16185,11 @@ func (m RelationshipDeleteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipDeleteResponseMultiError) AllErrors() []error { return m }
+func (m BundleDeleteRequestMultiError) AllErrors() []error { return m }
 
-// RelationshipDeleteResponseValidationError is the validation error returned
-// by RelationshipDeleteResponse.Validate if the designated constraints aren't met.
-type RelationshipDeleteResponseValidationError struct {
+// BundleDeleteRequestValidationError is the validation error returned by
+// BundleDeleteRequest.Validate if the designated constraints aren't met.
+type BundleDeleteRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8123,24 +16197,24 @@ type RelationshipDeleteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipDeleteResponseValidationError) Field() string { return e.field }
+func (e BundleDeleteRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipDeleteResponseValidationError) Reason() string { return e.reason }
+func (e BundleDeleteRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipDeleteResponseValidationError) Cause() error { return e.cause }
+func (e BundleDeleteRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipDeleteResponseValidationError) Key() bool { return e.key }
+func (e BundleDeleteRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipDeleteResponseValidationError) ErrorName() string {
-	return "RelationshipDeleteResponseValidationError"
+func (e BundleDeleteRequestValidationError) ErrorName() string {
+	return "BundleDeleteRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipDeleteResponseValidationError) Error() string {
+func (e BundleDeleteRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8152,14 +16226,14 @@ func (e RelationshipDeleteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipDeleteResponse.%s: %s%s",
+		"invalid %sBundleDeleteRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipDeleteResponseValidationError{}
+var _ error = BundleDeleteRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8167,70 +16241,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipDeleteResponseValidationError{}
+} = BundleDeleteRequestValidationError{}
 
-// Validate checks the field values on BundleRunRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *BundleRunRequest) Validate() error {
+var _BundleDeleteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+// Validate checks the field values on BundleDeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BundleDeleteResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BundleRunRequest with the rules
+// ValidateAll checks the field values on BundleDeleteResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// BundleRunRequestMultiError, or nil if none found.
-func (m *BundleRunRequest) ValidateAll() error {
+// BundleDeleteResponseMultiError, or nil if none found.
+func (m *BundleDeleteResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BundleRunRequest) validate(all bool) error {
+func (m *BundleDeleteResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 128 {
-		err := BundleRunRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 128 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_BundleRunRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := BundleRunRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	// no validation rules for Name
 
-	// no validation rules for Arguments
-
 	if len(errors) > 0 {
-		return BundleRunRequestMultiError(errors)
+		return BundleDeleteResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// BundleRunRequestMultiError is an error wrapping multiple validation errors
-// returned by BundleRunRequest.ValidateAll() if the designated constraints
-// aren't met.
-type BundleRunRequestMultiError []error
+// BundleDeleteResponseMultiError is an error wrapping multiple validation
+// errors returned by BundleDeleteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BundleDeleteResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BundleRunRequestMultiError) Error() string {
+func (m BundleDeleteResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8239,11 +16291,11 @@ func (m BundleRunRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BundleRunRequestMultiError) AllErrors() []error { return m }
+func (m BundleDeleteResponseMultiError) AllErrors() []error { return m }
 
-// BundleRunRequestValidationError is the validation error returned by
-// BundleRunRequest.Validate if the designated constraints aren't met.
-type BundleRunRequestValidationError struct {
+// BundleDeleteResponseValidationError is the validation error returned by
+// BundleDeleteResponse.Validate if the designated constraints aren't met.
+type BundleDeleteResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8251,22 +16303,24 @@ type BundleRunRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e BundleRunRequestValidationError) Field() string { return e.field }
+func (e BundleDeleteResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BundleRunRequestValidationError) Reason() string { return e.reason }
+func (e BundleDeleteResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BundleRunRequestValidationError) Cause() error { return e.cause }
+func (e BundleDeleteResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BundleRunRequestValidationError) Key() bool { return e.key }
+func (e BundleDeleteResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BundleRunRequestValidationError) ErrorName() string { return "BundleRunRequestValidationError" }
+func (e BundleDeleteResponseValidationError) ErrorName() string {
+	return "BundleDeleteResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e BundleRunRequestValidationError) Error() string {
+func (e BundleDeleteResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8278,14 +16332,14 @@ func (e BundleRunRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBundleRunRequest.%s: %s%s",
+		"invalid %sBundleDeleteResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BundleRunRequestValidationError{}
+var _ error = BundleDeleteResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8293,48 +16347,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BundleRunRequestValidationError{}
-
-var _BundleRunRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+} = BundleDeleteResponseValidationError{}
 
-// Validate checks the field values on BundleRunResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *BundleRunResponse) Validate() error {
+// Validate checks the field values on TenantCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TenantCreateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BundleRunResponse with the rules
+// ValidateAll checks the field values on TenantCreateRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// BundleRunResponseMultiError, or nil if none found.
-func (m *BundleRunResponse) ValidateAll() error {
+// TenantCreateRequestMultiError, or nil if none found.
+func (m *TenantCreateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BundleRunResponse) validate(all bool) error {
+func (m *TenantCreateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SnapToken
+	if len(m.GetId()) > 64 {
+		err := TenantCreateRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_TenantCreateRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := TenantCreateRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) > 64 {
+		err := TenantCreateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return BundleRunResponseMultiError(errors)
+		return TenantCreateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// BundleRunResponseMultiError is an error wrapping multiple validation errors
-// returned by BundleRunResponse.ValidateAll() if the designated constraints
-// aren't met.
-type BundleRunResponseMultiError []error
+// TenantCreateRequestMultiError is an error wrapping multiple validation
+// errors returned by TenantCreateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TenantCreateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BundleRunResponseMultiError) Error() string {
+func (m TenantCreateRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8343,11 +16426,11 @@ func (m BundleRunResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BundleRunResponseMultiError) AllErrors() []error { return m }
+func (m TenantCreateRequestMultiError) AllErrors() []error { return m }
 
-// BundleRunResponseValidationError is the validation error returned by
-// BundleRunResponse.Validate if the designated constraints aren't met.
-type BundleRunResponseValidationError struct {
+// TenantCreateRequestValidationError is the validation error returned by
+// TenantCreateRequest.Validate if the designated constraints aren't met.
+type TenantCreateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8355,24 +16438,24 @@ type BundleRunResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e BundleRunResponseValidationError) Field() string { return e.field }
+func (e TenantCreateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BundleRunResponseValidationError) Reason() string { return e.reason }
+func (e TenantCreateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BundleRunResponseValidationError) Cause() error { return e.cause }
+func (e TenantCreateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BundleRunResponseValidationError) Key() bool { return e.key }
+func (e TenantCreateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BundleRunResponseValidationError) ErrorName() string {
-	return "BundleRunResponseValidationError"
+func (e TenantCreateRequestValidationError) ErrorName() string {
+	return "TenantCreateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e BundleRunResponseValidationError) Error() string {
+func (e TenantCreateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8384,14 +16467,14 @@ func (e BundleRunResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBundleRunResponse.%s: %s%s",
+		"invalid %sTenantCreateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BundleRunResponseValidationError{}
+var _ error = TenantCreateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8399,100 +16482,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BundleRunResponseValidationError{}
+} = TenantCreateRequestValidationError{}
 
-// Validate checks the field values on BundleWriteRequest with the rules
+var _TenantCreateRequest_Id_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
+
+// Validate checks the field values on TenantCreateResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *BundleWriteRequest) Validate() error {
+func (m *TenantCreateResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BundleWriteRequest with the rules
+// ValidateAll checks the field values on TenantCreateResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// BundleWriteRequestMultiError, or nil if none found.
-func (m *BundleWriteRequest) ValidateAll() error {
+// TenantCreateResponseMultiError, or nil if none found.
+func (m *TenantCreateResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BundleWriteRequest) validate(all bool) error {
+func (m *TenantCreateResponse) validate(all bool) error {
 	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if len(m.GetTenantId()) > 128 {
-		err := BundleWriteRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 128 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_BundleWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := BundleWriteRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+		return nil
 	}
 
-	for idx, item := range m.GetBundles() {
-		_, _ = idx, item
+	var errors []error
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, BundleWriteRequestValidationError{
-						field:  fmt.Sprintf("Bundles[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, BundleWriteRequestValidationError{
-						field:  fmt.Sprintf("Bundles[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetTenant()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantCreateResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return BundleWriteRequestValidationError{
-					field:  fmt.Sprintf("Bundles[%v]", idx),
+				errors = append(errors, TenantCreateResponseValidationError{
+					field:  "Tenant",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantCreateResponseValidationError{
+				field:  "Tenant",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return BundleWriteRequestMultiError(errors)
+		return TenantCreateResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// BundleWriteRequestMultiError is an error wrapping multiple validation errors
-// returned by BundleWriteRequest.ValidateAll() if the designated constraints
-// aren't met.
-type BundleWriteRequestMultiError []error
+// TenantCreateResponseMultiError is an error wrapping multiple validation
+// errors returned by TenantCreateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TenantCreateResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BundleWriteRequestMultiError) Error() string {
+func (m TenantCreateResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8501,11 +16559,11 @@ func (m BundleWriteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BundleWriteRequestMultiError) AllErrors() []error { return m }
+func (m TenantCreateResponseMultiError) AllErrors() []error { return m }
 
-// BundleWriteRequestValidationError is the validation error returned by
-// BundleWriteRequest.Validate if the designated constraints aren't met.
-type BundleWriteRequestValidationError struct {
+// TenantCreateResponseValidationError is the validation error returned by
+// TenantCreateResponse.Validate if the designated constraints aren't met.
+type TenantCreateResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8513,24 +16571,24 @@ type BundleWriteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e BundleWriteRequestValidationError) Field() string { return e.field }
+func (e TenantCreateResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BundleWriteRequestValidationError) Reason() string { return e.reason }
+func (e TenantCreateResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BundleWriteRequestValidationError) Cause() error { return e.cause }
+func (e TenantCreateResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BundleWriteRequestValidationError) Key() bool { return e.key }
+func (e TenantCreateResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BundleWriteRequestValidationError) ErrorName() string {
-	return "BundleWriteRequestValidationError"
+func (e TenantCreateResponseValidationError) ErrorName() string {
+	return "TenantCreateResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e BundleWriteRequestValidationError) Error() string {
+func (e TenantCreateResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8542,14 +16600,14 @@ func (e BundleWriteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBundleWriteRequest.%s: %s%s",
+		"invalid %sTenantCreateResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BundleWriteRequestValidationError{}
+var _ error = TenantCreateResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8557,26 +16615,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BundleWriteRequestValidationError{}
-
-var _BundleWriteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+} = TenantCreateResponseValidationError{}
 
-// Validate checks the field values on BundleWriteResponse with the rules
+// Validate checks the field values on TenantDeleteRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *BundleWriteResponse) Validate() error {
+func (m *TenantDeleteRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BundleWriteResponse with the rules
+// ValidateAll checks the field values on TenantDeleteRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// BundleWriteResponseMultiError, or nil if none found.
-func (m *BundleWriteResponse) ValidateAll() error {
+// TenantDeleteRequestMultiError, or nil if none found.
+func (m *TenantDeleteRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BundleWriteResponse) validate(all bool) error {
+func (m *TenantDeleteRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8584,19 +16640,19 @@ func (m *BundleWriteResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return BundleWriteResponseMultiError(errors)
+		return TenantDeleteRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// BundleWriteResponseMultiError is an error wrapping multiple validation
-// errors returned by BundleWriteResponse.ValidateAll() if the designated
+// TenantDeleteRequestMultiError is an error wrapping multiple validation
+// errors returned by TenantDeleteRequest.ValidateAll() if the designated
 // constraints aren't met.
-type BundleWriteResponseMultiError []error
+type TenantDeleteRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BundleWriteResponseMultiError) Error() string {
+func (m TenantDeleteRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8605,11 +16661,11 @@ func (m BundleWriteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BundleWriteResponseMultiError) AllErrors() []error { return m }
+func (m TenantDeleteRequestMultiError) AllErrors() []error { return m }
 
-// BundleWriteResponseValidationError is the validation error returned by
-// BundleWriteResponse.Validate if the designated constraints aren't met.
-type BundleWriteResponseValidationError struct {
+// TenantDeleteRequestValidationError is the validation error returned by
+// TenantDeleteRequest.Validate if the designated constraints aren't met.
+type TenantDeleteRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8617,24 +16673,24 @@ type BundleWriteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e BundleWriteResponseValidationError) Field() string { return e.field }
+func (e TenantDeleteRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BundleWriteResponseValidationError) Reason() string { return e.reason }
+func (e TenantDeleteRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BundleWriteResponseValidationError) Cause() error { return e.cause }
+func (e TenantDeleteRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BundleWriteResponseValidationError) Key() bool { return e.key }
+func (e TenantDeleteRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BundleWriteResponseValidationError) ErrorName() string {
-	return "BundleWriteResponseValidationError"
+func (e TenantDeleteRequestValidationError) ErrorName() string {
+	return "TenantDeleteRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e BundleWriteResponseValidationError) Error() string {
+func (e TenantDeleteRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8646,14 +16702,14 @@ func (e BundleWriteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBundleWriteResponse.%s: %s%s",
+		"invalid %sTenantDeleteRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BundleWriteResponseValidationError{}
+var _ error = TenantDeleteRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8661,68 +16717,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BundleWriteResponseValidationError{}
+} = TenantDeleteRequestValidationError{}
 
-// Validate checks the field values on BundleReadRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *BundleReadRequest) Validate() error {
+// Validate checks the field values on TenantDeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TenantDeleteResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BundleReadRequest with the rules
+// ValidateAll checks the field values on TenantDeleteResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// BundleReadRequestMultiError, or nil if none found.
-func (m *BundleReadRequest) ValidateAll() error {
+// TenantDeleteResponseMultiError, or nil if none found.
+func (m *TenantDeleteResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BundleReadRequest) validate(all bool) error {
+func (m *TenantDeleteResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 128 {
-		err := BundleReadRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 128 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_BundleReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := BundleReadRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Name
+	// no validation rules for TenantId
 
 	if len(errors) > 0 {
-		return BundleReadRequestMultiError(errors)
+		return TenantDeleteResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// BundleReadRequestMultiError is an error wrapping multiple validation errors
-// returned by BundleReadRequest.ValidateAll() if the designated constraints
-// aren't met.
-type BundleReadRequestMultiError []error
+// TenantDeleteResponseMultiError is an error wrapping multiple validation
+// errors returned by TenantDeleteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TenantDeleteResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BundleReadRequestMultiError) Error() string {
+func (m TenantDeleteResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8731,11 +16765,11 @@ func (m BundleReadRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BundleReadRequestMultiError) AllErrors() []error { return m }
+func (m TenantDeleteResponseMultiError) AllErrors() []error { return m }
 
-// BundleReadRequestValidationError is the validation error returned by
-// BundleReadRequest.Validate if the designated constraints aren't met.
-type BundleReadRequestValidationError struct {
+// TenantDeleteResponseValidationError is the validation error returned by
+// TenantDeleteResponse.Validate if the designated constraints aren't met.
+type TenantDeleteResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8743,24 +16777,24 @@ type BundleReadRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e BundleReadRequestValidationError) Field() string { return e.field }
+func (e TenantDeleteResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BundleReadRequestValidationError) Reason() string { return e.reason }
+func (e TenantDeleteResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BundleReadRequestValidationError) Cause() error { return e.cause }
+func (e TenantDeleteResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BundleReadRequestValidationError) Key() bool { return e.key }
+func (e TenantDeleteResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BundleReadRequestValidationError) ErrorName() string {
-	return "BundleReadRequestValidationError"
+func (e TenantDeleteResponseValidationError) ErrorName() string {
+	return "TenantDeleteResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e BundleReadRequestValidationError) Error() string {
+func (e TenantDeleteResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8772,14 +16806,14 @@ func (e BundleReadRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBundleReadRequest.%s: %s%s",
+		"invalid %sTenantDeleteResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BundleReadRequestValidationError{}
+var _ error = TenantDeleteResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8787,75 +16821,63 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BundleReadRequestValidationError{}
-
-var _BundleReadRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+} = TenantDeleteResponseValidationError{}
 
-// Validate checks the field values on BundleReadResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *BundleReadResponse) Validate() error {
+// Validate checks the field values on TenantListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TenantListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BundleReadResponse with the rules
+// ValidateAll checks the field values on TenantListRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// BundleReadResponseMultiError, or nil if none found.
-func (m *BundleReadResponse) ValidateAll() error {
+// TenantListRequestMultiError, or nil if none found.
+func (m *TenantListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BundleReadResponse) validate(all bool) error {
+func (m *TenantListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
-
-	if all {
-		switch v := interface{}(m.GetBundle()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, BundleReadResponseValidationError{
-					field:  "Bundle",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, BundleReadResponseValidationError{
-					field:  "Bundle",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() < 1 {
+			err := TenantListRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be greater than or equal to 1",
 			}
-		}
-	} else if v, ok := interface{}(m.GetBundle()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return BundleReadResponseValidationError{
-				field:  "Bundle",
-				reason: "embedded message failed validation",
-				cause:  err,
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
+
+	}
+
+	if m.GetContinuousToken() != "" {
+
 	}
 
 	if len(errors) > 0 {
-		return BundleReadResponseMultiError(errors)
+		return TenantListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// BundleReadResponseMultiError is an error wrapping multiple validation errors
-// returned by BundleReadResponse.ValidateAll() if the designated constraints
+// TenantListRequestMultiError is an error wrapping multiple validation errors
+// returned by TenantListRequest.ValidateAll() if the designated constraints
 // aren't met.
-type BundleReadResponseMultiError []error
+type TenantListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BundleReadResponseMultiError) Error() string {
+func (m TenantListRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8864,11 +16886,11 @@ func (m BundleReadResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BundleReadResponseMultiError) AllErrors() []error { return m }
+func (m TenantListRequestMultiError) AllErrors() []error { return m }
 
-// BundleReadResponseValidationError is the validation error returned by
-// BundleReadResponse.Validate if the designated constraints aren't met.
-type BundleReadResponseValidationError struct {
+// TenantListRequestValidationError is the validation error returned by
+// TenantListRequest.Validate if the designated constraints aren't met.
+type TenantListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8876,24 +16898,24 @@ type BundleReadResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e BundleReadResponseValidationError) Field() string { return e.field }
+func (e TenantListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BundleReadResponseValidationError) Reason() string { return e.reason }
+func (e TenantListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BundleReadResponseValidationError) Cause() error { return e.cause }
+func (e TenantListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BundleReadResponseValidationError) Key() bool { return e.key }
+func (e TenantListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BundleReadResponseValidationError) ErrorName() string {
-	return "BundleReadResponseValidationError"
+func (e TenantListRequestValidationError) ErrorName() string {
+	return "TenantListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e BundleReadResponseValidationError) Error() string {
+func (e TenantListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8905,14 +16927,14 @@ func (e BundleReadResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBundleReadResponse.%s: %s%s",
+		"invalid %sTenantListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BundleReadResponseValidationError{}
+var _ error = TenantListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8920,68 +16942,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BundleReadResponseValidationError{}
+} = TenantListRequestValidationError{}
 
-// Validate checks the field values on BundleDeleteRequest with the rules
+// Validate checks the field values on TenantListResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *BundleDeleteRequest) Validate() error {
+func (m *TenantListResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BundleDeleteRequest with the rules
+// ValidateAll checks the field values on TenantListResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// BundleDeleteRequestMultiError, or nil if none found.
-func (m *BundleDeleteRequest) ValidateAll() error {
+// TenantListResponseMultiError, or nil if none found.
+func (m *TenantListResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BundleDeleteRequest) validate(all bool) error {
+func (m *TenantListResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 128 {
-		err := BundleDeleteRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 128 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetTenants() {
+		_, _ = idx, item
 
-	if !_BundleDeleteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := BundleDeleteRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TenantListResponseValidationError{
+						field:  fmt.Sprintf("Tenants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TenantListResponseValidationError{
+						field:  fmt.Sprintf("Tenants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TenantListResponseValidationError{
+					field:  fmt.Sprintf("Tenants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
-	// no validation rules for Name
+	// no validation rules for ContinuousToken
 
 	if len(errors) > 0 {
-		return BundleDeleteRequestMultiError(errors)
+		return TenantListResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// BundleDeleteRequestMultiError is an error wrapping multiple validation
-// errors returned by BundleDeleteRequest.ValidateAll() if the designated
-// constraints aren't met.
-type BundleDeleteRequestMultiError []error
+// TenantListResponseMultiError is an error wrapping multiple validation errors
+// returned by TenantListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TenantListResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BundleDeleteRequestMultiError) Error() string {
+func (m TenantListResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8990,11 +17024,11 @@ func (m BundleDeleteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BundleDeleteRequestMultiError) AllErrors() []error { return m }
+func (m TenantListResponseMultiError) AllErrors() []error { return m }
 
-// BundleDeleteRequestValidationError is the validation error returned by
-// BundleDeleteRequest.Validate if the designated constraints aren't met.
-type BundleDeleteRequestValidationError struct {
+// TenantListResponseValidationError is the validation error returned by
+// TenantListResponse.Validate if the designated constraints aren't met.
+type TenantListResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9002,24 +17036,24 @@ type BundleDeleteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e BundleDeleteRequestValidationError) Field() string { return e.field }
+func (e TenantListResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BundleDeleteRequestValidationError) Reason() string { return e.reason }
+func (e TenantListResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BundleDeleteRequestValidationError) Cause() error { return e.cause }
+func (e TenantListResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BundleDeleteRequestValidationError) Key() bool { return e.key }
+func (e TenantListResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BundleDeleteRequestValidationError) ErrorName() string {
-	return "BundleDeleteRequestValidationError"
+func (e TenantListResponseValidationError) ErrorName() string {
+	return "TenantListResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e BundleDeleteRequestValidationError) Error() string {
+func (e TenantListResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9031,14 +17065,14 @@ func (e BundleDeleteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBundleDeleteRequest.%s: %s%s",
+		"invalid %sTenantListResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BundleDeleteRequestValidationError{}
+var _ error = TenantListResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9046,48 +17080,101 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BundleDeleteRequestValidationError{}
-
-var _BundleDeleteRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+} = TenantListResponseValidationError{}
 
-// Validate checks the field values on BundleDeleteResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *BundleDeleteResponse) Validate() error {
+// Validate checks the field values on AuditFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditFilter) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BundleDeleteResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// BundleDeleteResponseMultiError, or nil if none found.
-func (m *BundleDeleteResponse) ValidateAll() error {
+// ValidateAll checks the field values on AuditFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditFilterMultiError, or
+// nil if none found.
+func (m *AuditFilter) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BundleDeleteResponse) validate(all bool) error {
+func (m *AuditFilter) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditFilterValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditFilterValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditFilterValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditFilterValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditFilterValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditFilterValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return BundleDeleteResponseMultiError(errors)
+		return AuditFilterMultiError(errors)
 	}
 
 	return nil
 }
 
-// BundleDeleteResponseMultiError is an error wrapping multiple validation
-// errors returned by BundleDeleteResponse.ValidateAll() if the designated
-// constraints aren't met.
-type BundleDeleteResponseMultiError []error
+// AuditFilterMultiError is an error wrapping multiple validation errors
+// returned by AuditFilter.ValidateAll() if the designated constraints aren't met.
+type AuditFilterMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BundleDeleteResponseMultiError) Error() string {
+func (m AuditFilterMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9096,11 +17183,11 @@ func (m BundleDeleteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BundleDeleteResponseMultiError) AllErrors() []error { return m }
+func (m AuditFilterMultiError) AllErrors() []error { return m }
 
-// BundleDeleteResponseValidationError is the validation error returned by
-// BundleDeleteResponse.Validate if the designated constraints aren't met.
-type BundleDeleteResponseValidationError struct {
+// AuditFilterValidationError is the validation error returned by
+// AuditFilter.Validate if the designated constraints aren't met.
+type AuditFilterValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9108,24 +17195,22 @@ type BundleDeleteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e BundleDeleteResponseValidationError) Field() string { return e.field }
+func (e AuditFilterValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BundleDeleteResponseValidationError) Reason() string { return e.reason }
+func (e AuditFilterValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BundleDeleteResponseValidationError) Cause() error { return e.cause }
+func (e AuditFilterValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BundleDeleteResponseValidationError) Key() bool { return e.key }
+func (e AuditFilterValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BundleDeleteResponseValidationError) ErrorName() string {
-	return "BundleDeleteResponseValidationError"
-}
+func (e AuditFilterValidationError) ErrorName() string { return "AuditFilterValidationError" }
 
 // Error satisfies the builtin error interface
-func (e BundleDeleteResponseValidationError) Error() string {
+func (e AuditFilterValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9137,14 +17222,14 @@ func (e BundleDeleteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBundleDeleteResponse.%s: %s%s",
+		"invalid %sAuditFilter.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BundleDeleteResponseValidationError{}
+var _ error = AuditFilterValidationError{}
 
 var _ interface {
 	Field() string
@@ -9152,34 +17237,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BundleDeleteResponseValidationError{}
+} = AuditFilterValidationError{}
 
-// Validate checks the field values on TenantCreateRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TenantCreateRequest) Validate() error {
+// Validate checks the field values on AuditListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuditListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantCreateRequest with the rules
+// ValidateAll checks the field values on AuditListRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TenantCreateRequestMultiError, or nil if none found.
-func (m *TenantCreateRequest) ValidateAll() error {
+// AuditListRequestMultiError, or nil if none found.
+func (m *AuditListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantCreateRequest) validate(all bool) error {
+func (m *AuditListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
-
-	if len(m.GetId()) > 64 {
-		err := TenantCreateRequestValidationError{
-			field:  "Id",
-			reason: "value length must be at most 64 bytes",
+
+	if len(m.GetTenantId()) > 128 {
+		err := AuditListRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
 		}
 		if !all {
 			return err
@@ -9187,10 +17272,10 @@ func (m *TenantCreateRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_TenantCreateRequest_Id_Pattern.MatchString(m.GetId()) {
-		err := TenantCreateRequestValidationError{
-			field:  "Id",
-			reason: "value does not match regex pattern \"[a-zA-Z0-9-,]+\"",
+	if !_AuditListRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := AuditListRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
 		}
 		if !all {
 			return err
@@ -9198,31 +17283,68 @@ func (m *TenantCreateRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(m.GetName()) > 64 {
-		err := TenantCreateRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at most 64 bytes",
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditListRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditListRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditListRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if val := m.GetPageSize(); val < 1 || val > 100 {
+			err := AuditListRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetContinuousToken() != "" {
+
 	}
 
 	if len(errors) > 0 {
-		return TenantCreateRequestMultiError(errors)
+		return AuditListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantCreateRequestMultiError is an error wrapping multiple validation
-// errors returned by TenantCreateRequest.ValidateAll() if the designated
-// constraints aren't met.
-type TenantCreateRequestMultiError []error
+// AuditListRequestMultiError is an error wrapping multiple validation errors
+// returned by AuditListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AuditListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantCreateRequestMultiError) Error() string {
+func (m AuditListRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9231,11 +17353,11 @@ func (m TenantCreateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantCreateRequestMultiError) AllErrors() []error { return m }
+func (m AuditListRequestMultiError) AllErrors() []error { return m }
 
-// TenantCreateRequestValidationError is the validation error returned by
-// TenantCreateRequest.Validate if the designated constraints aren't met.
-type TenantCreateRequestValidationError struct {
+// AuditListRequestValidationError is the validation error returned by
+// AuditListRequest.Validate if the designated constraints aren't met.
+type AuditListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9243,24 +17365,22 @@ type TenantCreateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantCreateRequestValidationError) Field() string { return e.field }
+func (e AuditListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantCreateRequestValidationError) Reason() string { return e.reason }
+func (e AuditListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantCreateRequestValidationError) Cause() error { return e.cause }
+func (e AuditListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantCreateRequestValidationError) Key() bool { return e.key }
+func (e AuditListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantCreateRequestValidationError) ErrorName() string {
-	return "TenantCreateRequestValidationError"
-}
+func (e AuditListRequestValidationError) ErrorName() string { return "AuditListRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TenantCreateRequestValidationError) Error() string {
+func (e AuditListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9272,14 +17392,14 @@ func (e TenantCreateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantCreateRequest.%s: %s%s",
+		"invalid %sAuditListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantCreateRequestValidationError{}
+var _ error = AuditListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9287,75 +17407,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantCreateRequestValidationError{}
+} = AuditListRequestValidationError{}
 
-var _TenantCreateRequest_Id_Pattern = regexp.MustCompile("[a-zA-Z0-9-,]+")
+var _AuditListRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
 
-// Validate checks the field values on TenantCreateResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TenantCreateResponse) Validate() error {
+// Validate checks the field values on AuditListResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AuditListResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantCreateResponse with the rules
+// ValidateAll checks the field values on AuditListResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TenantCreateResponseMultiError, or nil if none found.
-func (m *TenantCreateResponse) ValidateAll() error {
+// AuditListResponseMultiError, or nil if none found.
+func (m *AuditListResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantCreateResponse) validate(all bool) error {
+func (m *AuditListResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTenant()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TenantCreateResponseValidationError{
-					field:  "Tenant",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetRecords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AuditListResponseValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AuditListResponseValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TenantCreateResponseValidationError{
-					field:  "Tenant",
+				return AuditListResponseValidationError{
+					field:  fmt.Sprintf("Records[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TenantCreateResponseValidationError{
-				field:  "Tenant",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for ContinuousToken
+
 	if len(errors) > 0 {
-		return TenantCreateResponseMultiError(errors)
+		return AuditListResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantCreateResponseMultiError is an error wrapping multiple validation
-// errors returned by TenantCreateResponse.ValidateAll() if the designated
-// constraints aren't met.
-type TenantCreateResponseMultiError []error
+// AuditListResponseMultiError is an error wrapping multiple validation errors
+// returned by AuditListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AuditListResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantCreateResponseMultiError) Error() string {
+func (m AuditListResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9364,11 +17491,11 @@ func (m TenantCreateResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantCreateResponseMultiError) AllErrors() []error { return m }
+func (m AuditListResponseMultiError) AllErrors() []error { return m }
 
-// TenantCreateResponseValidationError is the validation error returned by
-// TenantCreateResponse.Validate if the designated constraints aren't met.
-type TenantCreateResponseValidationError struct {
+// AuditListResponseValidationError is the validation error returned by
+// AuditListResponse.Validate if the designated constraints aren't met.
+type AuditListResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9376,24 +17503,24 @@ type TenantCreateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantCreateResponseValidationError) Field() string { return e.field }
+func (e AuditListResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantCreateResponseValidationError) Reason() string { return e.reason }
+func (e AuditListResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantCreateResponseValidationError) Cause() error { return e.cause }
+func (e AuditListResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantCreateResponseValidationError) Key() bool { return e.key }
+func (e AuditListResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantCreateResponseValidationError) ErrorName() string {
-	return "TenantCreateResponseValidationError"
+func (e AuditListResponseValidationError) ErrorName() string {
+	return "AuditListResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TenantCreateResponseValidationError) Error() string {
+func (e AuditListResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9405,14 +17532,14 @@ func (e TenantCreateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantCreateResponse.%s: %s%s",
+		"invalid %sAuditListResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantCreateResponseValidationError{}
+var _ error = AuditListResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9420,148 +17547,220 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantCreateResponseValidationError{}
+} = AuditListResponseValidationError{}
 
-// Validate checks the field values on TenantDeleteRequest with the rules
+// Validate checks the field values on AuditAccessReviewRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TenantDeleteRequest) Validate() error {
+func (m *AuditAccessReviewRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantDeleteRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AuditAccessReviewRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TenantDeleteRequestMultiError, or nil if none found.
-func (m *TenantDeleteRequest) ValidateAll() error {
+// AuditAccessReviewRequestMultiError, or nil if none found.
+func (m *AuditAccessReviewRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantDeleteRequest) validate(all bool) error {
+func (m *AuditAccessReviewRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(errors) > 0 {
-		return TenantDeleteRequestMultiError(errors)
+	if len(m.GetTenantId()) > 128 {
+		err := AuditAccessReviewRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	return nil
-}
+	if !_AuditAccessReviewRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := AuditAccessReviewRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_\\\\-@\\\\.:+]{1,128}|\\\\*)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-// TenantDeleteRequestMultiError is an error wrapping multiple validation
-// errors returned by TenantDeleteRequest.ValidateAll() if the designated
-// constraints aren't met.
-type TenantDeleteRequestMultiError []error
+	if m.GetMetadata() == nil {
+		err := AuditAccessReviewRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m TenantDeleteRequestMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditAccessReviewRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditAccessReviewRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditAccessReviewRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
-	return strings.Join(msgs, "; ")
-}
 
-// AllErrors returns a list of validation violation errors.
-func (m TenantDeleteRequestMultiError) AllErrors() []error { return m }
+	if l := len(m.GetEntityTypes()); l < 1 || l > 100 {
+		err := AuditAccessReviewRequestValidationError{
+			field:  "EntityTypes",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-// TenantDeleteRequestValidationError is the validation error returned by
-// TenantDeleteRequest.Validate if the designated constraints aren't met.
-type TenantDeleteRequestValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
+	for idx, item := range m.GetEntityTypes() {
+		_, _ = idx, item
+
+		if len(item) > 64 {
+			err := AuditAccessReviewRequestValidationError{
+				field:  fmt.Sprintf("EntityTypes[%v]", idx),
+				reason: "value length must be at most 64 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_AuditAccessReviewRequest_EntityTypes_Pattern.MatchString(item) {
+			err := AuditAccessReviewRequestValidationError{
+				field:  fmt.Sprintf("EntityTypes[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// Field function returns field value.
-func (e TenantDeleteRequestValidationError) Field() string { return e.field }
+	}
 
-// Reason function returns reason value.
-func (e TenantDeleteRequestValidationError) Reason() string { return e.reason }
+	if len(m.GetPermissions()) > 100 {
+		err := AuditAccessReviewRequestValidationError{
+			field:  "Permissions",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-// Cause function returns cause value.
-func (e TenantDeleteRequestValidationError) Cause() error { return e.cause }
+	for idx, item := range m.GetPermissions() {
+		_, _ = idx, item
 
-// Key function returns key value.
-func (e TenantDeleteRequestValidationError) Key() bool { return e.key }
+		if len(item) > 64 {
+			err := AuditAccessReviewRequestValidationError{
+				field:  fmt.Sprintf("Permissions[%v]", idx),
+				reason: "value length must be at most 64 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// ErrorName returns error name.
-func (e TenantDeleteRequestValidationError) ErrorName() string {
-	return "TenantDeleteRequestValidationError"
-}
+		if !_AuditAccessReviewRequest_Permissions_Pattern.MatchString(item) {
+			err := AuditAccessReviewRequestValidationError{
+				field:  fmt.Sprintf("Permissions[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// Error satisfies the builtin error interface
-func (e TenantDeleteRequestValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	key := ""
-	if e.key {
-		key = "key for "
+	if len(m.GetSubjectTypes()) > 100 {
+		err := AuditAccessReviewRequestValidationError{
+			field:  "SubjectTypes",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	return fmt.Sprintf(
-		"invalid %sTenantDeleteRequest.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = TenantDeleteRequestValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = TenantDeleteRequestValidationError{}
+	for idx, item := range m.GetSubjectTypes() {
+		_, _ = idx, item
 
-// Validate checks the field values on TenantDeleteResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TenantDeleteResponse) Validate() error {
-	return m.validate(false)
-}
+		if len(item) > 64 {
+			err := AuditAccessReviewRequestValidationError{
+				field:  fmt.Sprintf("SubjectTypes[%v]", idx),
+				reason: "value length must be at most 64 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// ValidateAll checks the field values on TenantDeleteResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TenantDeleteResponseMultiError, or nil if none found.
-func (m *TenantDeleteResponse) ValidateAll() error {
-	return m.validate(true)
-}
+		if !_AuditAccessReviewRequest_SubjectTypes_Pattern.MatchString(item) {
+			err := AuditAccessReviewRequestValidationError{
+				field:  fmt.Sprintf("SubjectTypes[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-zA-Z_]{1,64}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-func (m *TenantDeleteResponse) validate(all bool) error {
-	if m == nil {
-		return nil
 	}
 
-	var errors []error
-
-	// no validation rules for TenantId
-
 	if len(errors) > 0 {
-		return TenantDeleteResponseMultiError(errors)
+		return AuditAccessReviewRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantDeleteResponseMultiError is an error wrapping multiple validation
-// errors returned by TenantDeleteResponse.ValidateAll() if the designated
+// AuditAccessReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by AuditAccessReviewRequest.ValidateAll() if the designated
 // constraints aren't met.
-type TenantDeleteResponseMultiError []error
+type AuditAccessReviewRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantDeleteResponseMultiError) Error() string {
+func (m AuditAccessReviewRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9570,11 +17769,11 @@ func (m TenantDeleteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantDeleteResponseMultiError) AllErrors() []error { return m }
+func (m AuditAccessReviewRequestMultiError) AllErrors() []error { return m }
 
-// TenantDeleteResponseValidationError is the validation error returned by
-// TenantDeleteResponse.Validate if the designated constraints aren't met.
-type TenantDeleteResponseValidationError struct {
+// AuditAccessReviewRequestValidationError is the validation error returned by
+// AuditAccessReviewRequest.Validate if the designated constraints aren't met.
+type AuditAccessReviewRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9582,24 +17781,24 @@ type TenantDeleteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantDeleteResponseValidationError) Field() string { return e.field }
+func (e AuditAccessReviewRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantDeleteResponseValidationError) Reason() string { return e.reason }
+func (e AuditAccessReviewRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantDeleteResponseValidationError) Cause() error { return e.cause }
+func (e AuditAccessReviewRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantDeleteResponseValidationError) Key() bool { return e.key }
+func (e AuditAccessReviewRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantDeleteResponseValidationError) ErrorName() string {
-	return "TenantDeleteResponseValidationError"
+func (e AuditAccessReviewRequestValidationError) ErrorName() string {
+	return "AuditAccessReviewRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TenantDeleteResponseValidationError) Error() string {
+func (e AuditAccessReviewRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9611,14 +17810,14 @@ func (e TenantDeleteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantDeleteResponse.%s: %s%s",
+		"invalid %sAuditAccessReviewRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantDeleteResponseValidationError{}
+var _ error = AuditAccessReviewRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9626,63 +17825,69 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantDeleteResponseValidationError{}
+} = AuditAccessReviewRequestValidationError{}
 
-// Validate checks the field values on TenantListRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TenantListRequest) Validate() error {
+var _AuditAccessReviewRequest_TenantId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_\\-@\\.:+]{1,128}|\\*)$")
+
+var _AuditAccessReviewRequest_EntityTypes_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+
+var _AuditAccessReviewRequest_Permissions_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+
+var _AuditAccessReviewRequest_SubjectTypes_Pattern = regexp.MustCompile("^[a-zA-Z_]{1,64}$")
+
+// Validate checks the field values on AuditAccessReviewRequestMetadata with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AuditAccessReviewRequestMetadata) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TenantListRequestMultiError, or nil if none found.
-func (m *TenantListRequest) ValidateAll() error {
+// ValidateAll checks the field values on AuditAccessReviewRequestMetadata with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AuditAccessReviewRequestMetadataMultiError, or nil if none found.
+func (m *AuditAccessReviewRequestMetadata) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantListRequest) validate(all bool) error {
+func (m *AuditAccessReviewRequestMetadata) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetPageSize() != 0 {
-
-		if m.GetPageSize() < 1 {
-			err := TenantListRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be greater than or equal to 1",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
+	// no validation rules for SchemaVersion
 
-	if m.GetContinuousToken() != "" {
+	// no validation rules for SnapToken
 
+	if m.GetDepth() < 3 {
+		err := AuditAccessReviewRequestMetadataValidationError{
+			field:  "Depth",
+			reason: "value must be greater than or equal to 3",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return TenantListRequestMultiError(errors)
+		return AuditAccessReviewRequestMetadataMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantListRequestMultiError is an error wrapping multiple validation errors
-// returned by TenantListRequest.ValidateAll() if the designated constraints
-// aren't met.
-type TenantListRequestMultiError []error
+// AuditAccessReviewRequestMetadataMultiError is an error wrapping multiple
+// validation errors returned by
+// AuditAccessReviewRequestMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type AuditAccessReviewRequestMetadataMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantListRequestMultiError) Error() string {
+func (m AuditAccessReviewRequestMetadataMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9691,11 +17896,12 @@ func (m TenantListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantListRequestMultiError) AllErrors() []error { return m }
+func (m AuditAccessReviewRequestMetadataMultiError) AllErrors() []error { return m }
 
-// TenantListRequestValidationError is the validation error returned by
-// TenantListRequest.Validate if the designated constraints aren't met.
-type TenantListRequestValidationError struct {
+// AuditAccessReviewRequestMetadataValidationError is the validation error
+// returned by AuditAccessReviewRequestMetadata.Validate if the designated
+// constraints aren't met.
+type AuditAccessReviewRequestMetadataValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9703,24 +17909,24 @@ type TenantListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantListRequestValidationError) Field() string { return e.field }
+func (e AuditAccessReviewRequestMetadataValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantListRequestValidationError) Reason() string { return e.reason }
+func (e AuditAccessReviewRequestMetadataValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantListRequestValidationError) Cause() error { return e.cause }
+func (e AuditAccessReviewRequestMetadataValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantListRequestValidationError) Key() bool { return e.key }
+func (e AuditAccessReviewRequestMetadataValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantListRequestValidationError) ErrorName() string {
-	return "TenantListRequestValidationError"
+func (e AuditAccessReviewRequestMetadataValidationError) ErrorName() string {
+	return "AuditAccessReviewRequestMetadataValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TenantListRequestValidationError) Error() string {
+func (e AuditAccessReviewRequestMetadataValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9732,14 +17938,14 @@ func (e TenantListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantListRequest.%s: %s%s",
+		"invalid %sAuditAccessReviewRequestMetadata.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantListRequestValidationError{}
+var _ error = AuditAccessReviewRequestMetadataValidationError{}
 
 var _ interface {
 	Field() string
@@ -9747,80 +17953,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantListRequestValidationError{}
+} = AuditAccessReviewRequestMetadataValidationError{}
 
-// Validate checks the field values on TenantListResponse with the rules
+// Validate checks the field values on AuditAccessReviewResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TenantListResponse) Validate() error {
+func (m *AuditAccessReviewResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantListResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AuditAccessReviewResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TenantListResponseMultiError, or nil if none found.
-func (m *TenantListResponse) ValidateAll() error {
+// AuditAccessReviewResponseMultiError, or nil if none found.
+func (m *AuditAccessReviewResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantListResponse) validate(all bool) error {
+func (m *AuditAccessReviewResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetTenants() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TenantListResponseValidationError{
-						field:  fmt.Sprintf("Tenants[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, TenantListResponseValidationError{
-						field:  fmt.Sprintf("Tenants[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetEntry()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditAccessReviewResponseValidationError{
+					field:  "Entry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return TenantListResponseValidationError{
-					field:  fmt.Sprintf("Tenants[%v]", idx),
+				errors = append(errors, AuditAccessReviewResponseValidationError{
+					field:  "Entry",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntry()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditAccessReviewResponseValidationError{
+				field:  "Entry",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for ContinuousToken
+	// no validation rules for SnapToken
 
 	if len(errors) > 0 {
-		return TenantListResponseMultiError(errors)
+		return AuditAccessReviewResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantListResponseMultiError is an error wrapping multiple validation errors
-// returned by TenantListResponse.ValidateAll() if the designated constraints
-// aren't met.
-type TenantListResponseMultiError []error
+// AuditAccessReviewResponseMultiError is an error wrapping multiple validation
+// errors returned by AuditAccessReviewResponse.ValidateAll() if the
+// designated constraints aren't met.
+type AuditAccessReviewResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantListResponseMultiError) Error() string {
+func (m AuditAccessReviewResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9829,11 +18030,11 @@ func (m TenantListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantListResponseMultiError) AllErrors() []error { return m }
+func (m AuditAccessReviewResponseMultiError) AllErrors() []error { return m }
 
-// TenantListResponseValidationError is the validation error returned by
-// TenantListResponse.Validate if the designated constraints aren't met.
-type TenantListResponseValidationError struct {
+// AuditAccessReviewResponseValidationError is the validation error returned by
+// AuditAccessReviewResponse.Validate if the designated constraints aren't met.
+type AuditAccessReviewResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9841,24 +18042,24 @@ type TenantListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantListResponseValidationError) Field() string { return e.field }
+func (e AuditAccessReviewResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantListResponseValidationError) Reason() string { return e.reason }
+func (e AuditAccessReviewResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantListResponseValidationError) Cause() error { return e.cause }
+func (e AuditAccessReviewResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantListResponseValidationError) Key() bool { return e.key }
+func (e AuditAccessReviewResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantListResponseValidationError) ErrorName() string {
-	return "TenantListResponseValidationError"
+func (e AuditAccessReviewResponseValidationError) ErrorName() string {
+	return "AuditAccessReviewResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TenantListResponseValidationError) Error() string {
+func (e AuditAccessReviewResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9870,14 +18071,14 @@ func (e TenantListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantListResponse.%s: %s%s",
+		"invalid %sAuditAccessReviewResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantListResponseValidationError{}
+var _ error = AuditAccessReviewResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9885,4 +18086,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantListResponseValidationError{}
+} = AuditAccessReviewResponseValidationError{}
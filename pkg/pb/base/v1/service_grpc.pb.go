@@ -24,8 +24,10 @@ const (
 	Permission_Expand_FullMethodName             = "/base.v1.Permission/Expand"
 	Permission_LookupEntity_FullMethodName       = "/base.v1.Permission/LookupEntity"
 	Permission_LookupEntityStream_FullMethodName = "/base.v1.Permission/LookupEntityStream"
+	Permission_LookupEntitlements_FullMethodName = "/base.v1.Permission/LookupEntitlements"
 	Permission_LookupSubject_FullMethodName      = "/base.v1.Permission/LookupSubject"
 	Permission_SubjectPermission_FullMethodName  = "/base.v1.Permission/SubjectPermission"
+	Permission_Simulate_FullMethodName           = "/base.v1.Permission/Simulate"
 )
 
 // PermissionClient is the client API for Permission service.
@@ -52,12 +54,18 @@ type PermissionClient interface {
 	// LookupEntityStream method receives a PermissionLookupEntityRequest and streams a series of PermissionLookupEntityStreamResponse messages.
 	// It is used to retrieve entities by their identifiers in a streaming fashion.
 	LookupEntityStream(ctx context.Context, in *PermissionLookupEntityRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PermissionLookupEntityStreamResponse], error)
+	// LookupEntitlements streams every permission a subject holds on every entity it can reach, optionally
+	// restricted to some entity types and permissions.
+	LookupEntitlements(ctx context.Context, in *PermissionLookupEntitlementsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PermissionLookupEntitlementsStreamResponse], error)
 	// LookupSubject method receives a PermissionLookupSubjectRequest and returns a PermissionLookupSubjectResponse.
 	// It is used to retrieve a subject by its identifier.
 	LookupSubject(ctx context.Context, in *PermissionLookupSubjectRequest, opts ...grpc.CallOption) (*PermissionLookupSubjectResponse, error)
 	// SubjectPermission method receives a PermissionSubjectPermissionRequest and returns a PermissionSubjectPermissionResponse.
 	// It is used to retrieve permissions related to a specific subject.
 	SubjectPermission(ctx context.Context, in *PermissionSubjectPermissionRequest, opts ...grpc.CallOption) (*PermissionSubjectPermissionResponse, error)
+	// Simulate answers a check, lookup or subject permission request as if hypothetical changes were made to
+	// the data of the tenant, and returns what the changes grant and revoke.
+	Simulate(ctx context.Context, in *PermissionSimulateRequest, opts ...grpc.CallOption) (*PermissionSimulateResponse, error)
 }
 
 type permissionClient struct {
@@ -127,6 +135,25 @@ func (c *permissionClient) LookupEntityStream(ctx context.Context, in *Permissio
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Permission_LookupEntityStreamClient = grpc.ServerStreamingClient[PermissionLookupEntityStreamResponse]
 
+func (c *permissionClient) LookupEntitlements(ctx context.Context, in *PermissionLookupEntitlementsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PermissionLookupEntitlementsStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Permission_ServiceDesc.Streams[1], Permission_LookupEntitlements_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[PermissionLookupEntitlementsRequest, PermissionLookupEntitlementsStreamResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Permission_LookupEntitlementsClient = grpc.ServerStreamingClient[PermissionLookupEntitlementsStreamResponse]
+
 func (c *permissionClient) LookupSubject(ctx context.Context, in *PermissionLookupSubjectRequest, opts ...grpc.CallOption) (*PermissionLookupSubjectResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PermissionLookupSubjectResponse)
@@ -147,6 +174,16 @@ func (c *permissionClient) SubjectPermission(ctx context.Context, in *Permission
 	return out, nil
 }
 
+func (c *permissionClient) Simulate(ctx context.Context, in *PermissionSimulateRequest, opts ...grpc.CallOption) (*PermissionSimulateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PermissionSimulateResponse)
+	err := c.cc.Invoke(ctx, Permission_Simulate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PermissionServer is the server API for Permission service.
 // All implementations must embed UnimplementedPermissionServer
 // for forward compatibility.
@@ -171,12 +208,18 @@ type PermissionServer interface {
 	// LookupEntityStream method receives a PermissionLookupEntityRequest and streams a series of PermissionLookupEntityStreamResponse messages.
 	// It is used to retrieve entities by their identifiers in a streaming fashion.
 	LookupEntityStream(*PermissionLookupEntityRequest, grpc.ServerStreamingServer[PermissionLookupEntityStreamResponse]) error
+	// LookupEntitlements streams every permission a subject holds on every entity it can reach, optionally
+	// restricted to some entity types and permissions.
+	LookupEntitlements(*PermissionLookupEntitlementsRequest, grpc.ServerStreamingServer[PermissionLookupEntitlementsStreamResponse]) error
 	// LookupSubject method receives a PermissionLookupSubjectRequest and returns a PermissionLookupSubjectResponse.
 	// It is used to retrieve a subject by its identifier.
 	LookupSubject(context.Context, *PermissionLookupSubjectRequest) (*PermissionLookupSubjectResponse, error)
 	// SubjectPermission method receives a PermissionSubjectPermissionRequest and returns a PermissionSubjectPermissionResponse.
 	// It is used to retrieve permissions related to a specific subject.
 	SubjectPermission(context.Context, *PermissionSubjectPermissionRequest) (*PermissionSubjectPermissionResponse, error)
+	// Simulate answers a check, lookup or subject permission request as if hypothetical changes were made to
+	// the data of the tenant, and returns what the changes grant and revoke.
+	Simulate(context.Context, *PermissionSimulateRequest) (*PermissionSimulateResponse, error)
 	mustEmbedUnimplementedPermissionServer()
 }
 
@@ -202,12 +245,18 @@ func (UnimplementedPermissionServer) LookupEntity(context.Context, *PermissionLo
 func (UnimplementedPermissionServer) LookupEntityStream(*PermissionLookupEntityRequest, grpc.ServerStreamingServer[PermissionLookupEntityStreamResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method LookupEntityStream not implemented")
 }
+func (UnimplementedPermissionServer) LookupEntitlements(*PermissionLookupEntitlementsRequest, grpc.ServerStreamingServer[PermissionLookupEntitlementsStreamResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method LookupEntitlements not implemented")
+}
 func (UnimplementedPermissionServer) LookupSubject(context.Context, *PermissionLookupSubjectRequest) (*PermissionLookupSubjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LookupSubject not implemented")
 }
 func (UnimplementedPermissionServer) SubjectPermission(context.Context, *PermissionSubjectPermissionRequest) (*PermissionSubjectPermissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubjectPermission not implemented")
 }
+func (UnimplementedPermissionServer) Simulate(context.Context, *PermissionSimulateRequest) (*PermissionSimulateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Simulate not implemented")
+}
 func (UnimplementedPermissionServer) mustEmbedUnimplementedPermissionServer() {}
 func (UnimplementedPermissionServer) testEmbeddedByValue()                    {}
 
@@ -312,6 +361,17 @@ func _Permission_LookupEntityStream_Handler(srv interface{}, stream grpc.ServerS
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Permission_LookupEntityStreamServer = grpc.ServerStreamingServer[PermissionLookupEntityStreamResponse]
 
+func _Permission_LookupEntitlements_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PermissionLookupEntitlementsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PermissionServer).LookupEntitlements(m, &grpc.GenericServerStream[PermissionLookupEntitlementsRequest, PermissionLookupEntitlementsStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Permission_LookupEntitlementsServer = grpc.ServerStreamingServer[PermissionLookupEntitlementsStreamResponse]
+
 func _Permission_LookupSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PermissionLookupSubjectRequest)
 	if err := dec(in); err != nil {
@@ -348,6 +408,24 @@ func _Permission_SubjectPermission_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Permission_Simulate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermissionSimulateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionServer).Simulate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Permission_Simulate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionServer).Simulate(ctx, req.(*PermissionSimulateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Permission_ServiceDesc is the grpc.ServiceDesc for Permission service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -379,6 +457,10 @@ var Permission_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubjectPermission",
 			Handler:    _Permission_SubjectPermission_Handler,
 		},
+		{
+			MethodName: "Simulate",
+			Handler:    _Permission_Simulate_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -386,6 +468,11 @@ var Permission_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Permission_LookupEntityStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "LookupEntitlements",
+			Handler:       _Permission_LookupEntitlements_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "base/v1/service.proto",
 }
@@ -504,10 +591,18 @@ var Watch_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	Schema_Write_FullMethodName        = "/base.v1.Schema/Write"
-	Schema_PartialWrite_FullMethodName = "/base.v1.Schema/PartialWrite"
-	Schema_Read_FullMethodName         = "/base.v1.Schema/Read"
-	Schema_List_FullMethodName         = "/base.v1.Schema/List"
+	Schema_Write_FullMethodName          = "/base.v1.Schema/Write"
+	Schema_PartialWrite_FullMethodName   = "/base.v1.Schema/PartialWrite"
+	Schema_Read_FullMethodName           = "/base.v1.Schema/Read"
+	Schema_List_FullMethodName           = "/base.v1.Schema/List"
+	Schema_Lint_FullMethodName           = "/base.v1.Schema/Lint"
+	Schema_ShadowWrite_FullMethodName    = "/base.v1.Schema/ShadowWrite"
+	Schema_ShadowRead_FullMethodName     = "/base.v1.Schema/ShadowRead"
+	Schema_ShadowPromote_FullMethodName  = "/base.v1.Schema/ShadowPromote"
+	Schema_ShadowRollback_FullMethodName = "/base.v1.Schema/ShadowRollback"
+	Schema_Tag_FullMethodName            = "/base.v1.Schema/Tag"
+	Schema_Untag_FullMethodName          = "/base.v1.Schema/Untag"
+	Schema_Activate_FullMethodName       = "/base.v1.Schema/Activate"
 )
 
 // SchemaClient is the client API for Schema service.
@@ -524,6 +619,25 @@ type SchemaClient interface {
 	Read(ctx context.Context, in *SchemaReadRequest, opts ...grpc.CallOption) (*SchemaReadResponse, error)
 	// List is an RPC that allows you to list all authorization models.
 	List(ctx context.Context, in *SchemaListRequest, opts ...grpc.CallOption) (*SchemaListResponse, error)
+	// Lint is an RPC that analyzes a schema without writing it and reports design issues,
+	// such as relations that are never used or permissions that can never be granted.
+	Lint(ctx context.Context, in *SchemaLintRequest, opts ...grpc.CallOption) (*SchemaLintResponse, error)
+	// ShadowWrite is an RPC that writes a candidate authorization model as the shadow version of a tenant.
+	// The shadow version is not served; a sample of the checks answered with the served version is evaluated
+	// again against it in the background, and the decisions that differ are reported.
+	ShadowWrite(ctx context.Context, in *SchemaShadowWriteRequest, opts ...grpc.CallOption) (*SchemaShadowWriteResponse, error)
+	// ShadowRead is an RPC that reads the shadow version of a tenant.
+	ShadowRead(ctx context.Context, in *SchemaShadowReadRequest, opts ...grpc.CallOption) (*SchemaShadowReadResponse, error)
+	// ShadowPromote is an RPC that makes the shadow version of a tenant its served version.
+	ShadowPromote(ctx context.Context, in *SchemaShadowPromoteRequest, opts ...grpc.CallOption) (*SchemaShadowPromoteResponse, error)
+	// ShadowRollback is an RPC that discards the shadow version of a tenant without serving it.
+	ShadowRollback(ctx context.Context, in *SchemaShadowRollbackRequest, opts ...grpc.CallOption) (*SchemaShadowRollbackResponse, error)
+	// Tag is an RPC that points a tag of a tenant to one of its schema versions.
+	Tag(ctx context.Context, in *SchemaTagRequest, opts ...grpc.CallOption) (*SchemaTagResponse, error)
+	// Untag is an RPC that deletes a tag of a tenant.
+	Untag(ctx context.Context, in *SchemaUntagRequest, opts ...grpc.CallOption) (*SchemaUntagResponse, error)
+	// Activate is an RPC that makes a schema version of a tenant the one served to the requests that do not select a version.
+	Activate(ctx context.Context, in *SchemaActivateRequest, opts ...grpc.CallOption) (*SchemaActivateResponse, error)
 }
 
 type schemaClient struct {
@@ -574,6 +688,86 @@ func (c *schemaClient) List(ctx context.Context, in *SchemaListRequest, opts ...
 	return out, nil
 }
 
+func (c *schemaClient) Lint(ctx context.Context, in *SchemaLintRequest, opts ...grpc.CallOption) (*SchemaLintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchemaLintResponse)
+	err := c.cc.Invoke(ctx, Schema_Lint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaClient) ShadowWrite(ctx context.Context, in *SchemaShadowWriteRequest, opts ...grpc.CallOption) (*SchemaShadowWriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchemaShadowWriteResponse)
+	err := c.cc.Invoke(ctx, Schema_ShadowWrite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaClient) ShadowRead(ctx context.Context, in *SchemaShadowReadRequest, opts ...grpc.CallOption) (*SchemaShadowReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchemaShadowReadResponse)
+	err := c.cc.Invoke(ctx, Schema_ShadowRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaClient) ShadowPromote(ctx context.Context, in *SchemaShadowPromoteRequest, opts ...grpc.CallOption) (*SchemaShadowPromoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchemaShadowPromoteResponse)
+	err := c.cc.Invoke(ctx, Schema_ShadowPromote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaClient) ShadowRollback(ctx context.Context, in *SchemaShadowRollbackRequest, opts ...grpc.CallOption) (*SchemaShadowRollbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchemaShadowRollbackResponse)
+	err := c.cc.Invoke(ctx, Schema_ShadowRollback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaClient) Tag(ctx context.Context, in *SchemaTagRequest, opts ...grpc.CallOption) (*SchemaTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchemaTagResponse)
+	err := c.cc.Invoke(ctx, Schema_Tag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaClient) Untag(ctx context.Context, in *SchemaUntagRequest, opts ...grpc.CallOption) (*SchemaUntagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchemaUntagResponse)
+	err := c.cc.Invoke(ctx, Schema_Untag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaClient) Activate(ctx context.Context, in *SchemaActivateRequest, opts ...grpc.CallOption) (*SchemaActivateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchemaActivateResponse)
+	err := c.cc.Invoke(ctx, Schema_Activate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SchemaServer is the server API for Schema service.
 // All implementations must embed UnimplementedSchemaServer
 // for forward compatibility.
@@ -588,6 +782,25 @@ type SchemaServer interface {
 	Read(context.Context, *SchemaReadRequest) (*SchemaReadResponse, error)
 	// List is an RPC that allows you to list all authorization models.
 	List(context.Context, *SchemaListRequest) (*SchemaListResponse, error)
+	// Lint is an RPC that analyzes a schema without writing it and reports design issues,
+	// such as relations that are never used or permissions that can never be granted.
+	Lint(context.Context, *SchemaLintRequest) (*SchemaLintResponse, error)
+	// ShadowWrite is an RPC that writes a candidate authorization model as the shadow version of a tenant.
+	// The shadow version is not served; a sample of the checks answered with the served version is evaluated
+	// again against it in the background, and the decisions that differ are reported.
+	ShadowWrite(context.Context, *SchemaShadowWriteRequest) (*SchemaShadowWriteResponse, error)
+	// ShadowRead is an RPC that reads the shadow version of a tenant.
+	ShadowRead(context.Context, *SchemaShadowReadRequest) (*SchemaShadowReadResponse, error)
+	// ShadowPromote is an RPC that makes the shadow version of a tenant its served version.
+	ShadowPromote(context.Context, *SchemaShadowPromoteRequest) (*SchemaShadowPromoteResponse, error)
+	// ShadowRollback is an RPC that discards the shadow version of a tenant without serving it.
+	ShadowRollback(context.Context, *SchemaShadowRollbackRequest) (*SchemaShadowRollbackResponse, error)
+	// Tag is an RPC that points a tag of a tenant to one of its schema versions.
+	Tag(context.Context, *SchemaTagRequest) (*SchemaTagResponse, error)
+	// Untag is an RPC that deletes a tag of a tenant.
+	Untag(context.Context, *SchemaUntagRequest) (*SchemaUntagResponse, error)
+	// Activate is an RPC that makes a schema version of a tenant the one served to the requests that do not select a version.
+	Activate(context.Context, *SchemaActivateRequest) (*SchemaActivateResponse, error)
 	mustEmbedUnimplementedSchemaServer()
 }
 
@@ -610,6 +823,30 @@ func (UnimplementedSchemaServer) Read(context.Context, *SchemaReadRequest) (*Sch
 func (UnimplementedSchemaServer) List(context.Context, *SchemaListRequest) (*SchemaListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (UnimplementedSchemaServer) Lint(context.Context, *SchemaLintRequest) (*SchemaLintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lint not implemented")
+}
+func (UnimplementedSchemaServer) ShadowWrite(context.Context, *SchemaShadowWriteRequest) (*SchemaShadowWriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShadowWrite not implemented")
+}
+func (UnimplementedSchemaServer) ShadowRead(context.Context, *SchemaShadowReadRequest) (*SchemaShadowReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShadowRead not implemented")
+}
+func (UnimplementedSchemaServer) ShadowPromote(context.Context, *SchemaShadowPromoteRequest) (*SchemaShadowPromoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShadowPromote not implemented")
+}
+func (UnimplementedSchemaServer) ShadowRollback(context.Context, *SchemaShadowRollbackRequest) (*SchemaShadowRollbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShadowRollback not implemented")
+}
+func (UnimplementedSchemaServer) Tag(context.Context, *SchemaTagRequest) (*SchemaTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tag not implemented")
+}
+func (UnimplementedSchemaServer) Untag(context.Context, *SchemaUntagRequest) (*SchemaUntagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Untag not implemented")
+}
+func (UnimplementedSchemaServer) Activate(context.Context, *SchemaActivateRequest) (*SchemaActivateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Activate not implemented")
+}
 func (UnimplementedSchemaServer) mustEmbedUnimplementedSchemaServer() {}
 func (UnimplementedSchemaServer) testEmbeddedByValue()                {}
 
@@ -703,6 +940,150 @@ func _Schema_List_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Schema_Lint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaLintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServer).Lint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Schema_Lint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServer).Lint(ctx, req.(*SchemaLintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Schema_ShadowWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaShadowWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServer).ShadowWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Schema_ShadowWrite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServer).ShadowWrite(ctx, req.(*SchemaShadowWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Schema_ShadowRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaShadowReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServer).ShadowRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Schema_ShadowRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServer).ShadowRead(ctx, req.(*SchemaShadowReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Schema_ShadowPromote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaShadowPromoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServer).ShadowPromote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Schema_ShadowPromote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServer).ShadowPromote(ctx, req.(*SchemaShadowPromoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Schema_ShadowRollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaShadowRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServer).ShadowRollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Schema_ShadowRollback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServer).ShadowRollback(ctx, req.(*SchemaShadowRollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Schema_Tag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServer).Tag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Schema_Tag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServer).Tag(ctx, req.(*SchemaTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Schema_Untag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaUntagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServer).Untag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Schema_Untag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServer).Untag(ctx, req.(*SchemaUntagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Schema_Activate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaActivateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServer).Activate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Schema_Activate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServer).Activate(ctx, req.(*SchemaActivateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Schema_ServiceDesc is the grpc.ServiceDesc for Schema service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -726,6 +1107,38 @@ var Schema_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _Schema_List_Handler,
 		},
+		{
+			MethodName: "Lint",
+			Handler:    _Schema_Lint_Handler,
+		},
+		{
+			MethodName: "ShadowWrite",
+			Handler:    _Schema_ShadowWrite_Handler,
+		},
+		{
+			MethodName: "ShadowRead",
+			Handler:    _Schema_ShadowRead_Handler,
+		},
+		{
+			MethodName: "ShadowPromote",
+			Handler:    _Schema_ShadowPromote_Handler,
+		},
+		{
+			MethodName: "ShadowRollback",
+			Handler:    _Schema_ShadowRollback_Handler,
+		},
+		{
+			MethodName: "Tag",
+			Handler:    _Schema_Tag_Handler,
+		},
+		{
+			MethodName: "Untag",
+			Handler:    _Schema_Untag_Handler,
+		},
+		{
+			MethodName: "Activate",
+			Handler:    _Schema_Activate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "base/v1/service.proto",
@@ -739,6 +1152,10 @@ const (
 	Data_Delete_FullMethodName              = "/base.v1.Data/Delete"
 	Data_DeleteRelationships_FullMethodName = "/base.v1.Data/DeleteRelationships"
 	Data_RunBundle_FullMethodName           = "/base.v1.Data/RunBundle"
+	Data_ReadHistory_FullMethodName         = "/base.v1.Data/ReadHistory"
+	Data_Verify_FullMethodName              = "/base.v1.Data/Verify"
+	Data_Analyze_FullMethodName             = "/base.v1.Data/Analyze"
+	Data_Import_FullMethodName              = "/base.v1.Data/Import"
 )
 
 // DataClient is the client API for Data service.
@@ -761,6 +1178,23 @@ type DataClient interface {
 	DeleteRelationships(ctx context.Context, in *RelationshipDeleteRequest, opts ...grpc.CallOption) (*RelationshipDeleteResponse, error)
 	// Executes or runs a specific bundle. This method is useful for processing or triggering actions based on the bundle's data.
 	RunBundle(ctx context.Context, in *BundleRunRequest, opts ...grpc.CallOption) (*BundleRunResponse, error)
+	// ReadHistory lists the versions of the tuples or attributes matching a filter, each with the transaction and time it was
+	// written at and, once superseded or deleted, the transaction and time it expired at.
+	ReadHistory(ctx context.Context, in *DataReadHistoryRequest, opts ...grpc.CallOption) (*DataReadHistoryResponse, error)
+	// Verify scans the tuples and attributes of a tenant in batches and validates them against a schema version.
+	// Violations are streamed back as they are found, followed by a summary, and are optionally deleted or moved
+	// to a quarantine tenant.
+	Verify(ctx context.Context, in *DataVerifyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DataVerifyResponse], error)
+	// Analyze scans the tuples of a tenant and reports, for every recursive relation of the schema, the cycles and the
+	// depth of the chains it forms in the data, along with the entities and subjects with the most tuples.
+	Analyze(ctx context.Context, in *DataAnalyzeRequest, opts ...grpc.CallOption) (*DataAnalyzeResponse, error)
+	// Import streams tuples and attributes into a tenant in bulk. Unlike Write, it is not bound by the
+	// per write size limit: rows are validated one by one, invalid rows are reported back instead of
+	// failing the import, and the rest are loaded in one or more transactions.
+	// When the import fails, the status carries a DataImportResponse detail reporting the transactions
+	// committed before the failure, without the rejected rows.
+	// It is available over gRPC only, since client streaming has no REST counterpart.
+	Import(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[DataImportRequest, DataImportResponse], error)
 }
 
 type dataClient struct {
@@ -841,6 +1275,58 @@ func (c *dataClient) RunBundle(ctx context.Context, in *BundleRunRequest, opts .
 	return out, nil
 }
 
+func (c *dataClient) ReadHistory(ctx context.Context, in *DataReadHistoryRequest, opts ...grpc.CallOption) (*DataReadHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DataReadHistoryResponse)
+	err := c.cc.Invoke(ctx, Data_ReadHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) Verify(ctx context.Context, in *DataVerifyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DataVerifyResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Data_ServiceDesc.Streams[0], Data_Verify_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DataVerifyRequest, DataVerifyResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Data_VerifyClient = grpc.ServerStreamingClient[DataVerifyResponse]
+
+func (c *dataClient) Analyze(ctx context.Context, in *DataAnalyzeRequest, opts ...grpc.CallOption) (*DataAnalyzeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DataAnalyzeResponse)
+	err := c.cc.Invoke(ctx, Data_Analyze_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) Import(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[DataImportRequest, DataImportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Data_ServiceDesc.Streams[1], Data_Import_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DataImportRequest, DataImportResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Data_ImportClient = grpc.ClientStreamingClient[DataImportRequest, DataImportResponse]
+
 // DataServer is the server API for Data service.
 // All implementations must embed UnimplementedDataServer
 // for forward compatibility.
@@ -861,6 +1347,23 @@ type DataServer interface {
 	DeleteRelationships(context.Context, *RelationshipDeleteRequest) (*RelationshipDeleteResponse, error)
 	// Executes or runs a specific bundle. This method is useful for processing or triggering actions based on the bundle's data.
 	RunBundle(context.Context, *BundleRunRequest) (*BundleRunResponse, error)
+	// ReadHistory lists the versions of the tuples or attributes matching a filter, each with the transaction and time it was
+	// written at and, once superseded or deleted, the transaction and time it expired at.
+	ReadHistory(context.Context, *DataReadHistoryRequest) (*DataReadHistoryResponse, error)
+	// Verify scans the tuples and attributes of a tenant in batches and validates them against a schema version.
+	// Violations are streamed back as they are found, followed by a summary, and are optionally deleted or moved
+	// to a quarantine tenant.
+	Verify(*DataVerifyRequest, grpc.ServerStreamingServer[DataVerifyResponse]) error
+	// Analyze scans the tuples of a tenant and reports, for every recursive relation of the schema, the cycles and the
+	// depth of the chains it forms in the data, along with the entities and subjects with the most tuples.
+	Analyze(context.Context, *DataAnalyzeRequest) (*DataAnalyzeResponse, error)
+	// Import streams tuples and attributes into a tenant in bulk. Unlike Write, it is not bound by the
+	// per write size limit: rows are validated one by one, invalid rows are reported back instead of
+	// failing the import, and the rest are loaded in one or more transactions.
+	// When the import fails, the status carries a DataImportResponse detail reporting the transactions
+	// committed before the failure, without the rejected rows.
+	// It is available over gRPC only, since client streaming has no REST counterpart.
+	Import(grpc.ClientStreamingServer[DataImportRequest, DataImportResponse]) error
 	mustEmbedUnimplementedDataServer()
 }
 
@@ -892,6 +1395,18 @@ func (UnimplementedDataServer) DeleteRelationships(context.Context, *Relationshi
 func (UnimplementedDataServer) RunBundle(context.Context, *BundleRunRequest) (*BundleRunResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunBundle not implemented")
 }
+func (UnimplementedDataServer) ReadHistory(context.Context, *DataReadHistoryRequest) (*DataReadHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadHistory not implemented")
+}
+func (UnimplementedDataServer) Verify(*DataVerifyRequest, grpc.ServerStreamingServer[DataVerifyResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Verify not implemented")
+}
+func (UnimplementedDataServer) Analyze(context.Context, *DataAnalyzeRequest) (*DataAnalyzeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Analyze not implemented")
+}
+func (UnimplementedDataServer) Import(grpc.ClientStreamingServer[DataImportRequest, DataImportResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
 func (UnimplementedDataServer) mustEmbedUnimplementedDataServer() {}
 func (UnimplementedDataServer) testEmbeddedByValue()              {}
 
@@ -1039,6 +1554,60 @@ func _Data_RunBundle_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Data_ReadHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataReadHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ReadHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ReadHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ReadHistory(ctx, req.(*DataReadHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_Verify_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DataVerifyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DataServer).Verify(m, &grpc.GenericServerStream[DataVerifyRequest, DataVerifyResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Data_VerifyServer = grpc.ServerStreamingServer[DataVerifyResponse]
+
+func _Data_Analyze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataAnalyzeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).Analyze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_Analyze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).Analyze(ctx, req.(*DataAnalyzeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_Import_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DataServer).Import(&grpc.GenericServerStream[DataImportRequest, DataImportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Data_ImportServer = grpc.ClientStreamingServer[DataImportRequest, DataImportResponse]
+
 // Data_ServiceDesc is the grpc.ServiceDesc for Data service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1074,8 +1643,27 @@ var Data_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RunBundle",
 			Handler:    _Data_RunBundle_Handler,
 		},
+		{
+			MethodName: "ReadHistory",
+			Handler:    _Data_ReadHistory_Handler,
+		},
+		{
+			MethodName: "Analyze",
+			Handler:    _Data_Analyze_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Verify",
+			Handler:       _Data_Verify_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Import",
+			Handler:       _Data_Import_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "base/v1/service.proto",
 }
 
@@ -1452,3 +2040,161 @@ var Tenancy_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "base/v1/service.proto",
 }
+
+const (
+	Audit_List_FullMethodName         = "/base.v1.Audit/List"
+	Audit_AccessReview_FullMethodName = "/base.v1.Audit/AccessReview"
+)
+
+// AuditClient is the client API for Audit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ** AUDIT SERVICE **
+// Audit service provides methods to query the audit trail of the mutations made through the API.
+type AuditClient interface {
+	// List is a unary RPC to get the audit records of a tenant, newest first.
+	// It requires an AuditListRequest and returns an AuditListResponse.
+	List(ctx context.Context, in *AuditListRequest, opts ...grpc.CallOption) (*AuditListResponse, error)
+	// AccessReview is a server streaming RPC enumerating who holds which permission on which entity.
+	// It requires an AuditAccessReviewRequest and streams AuditAccessReviewResponse messages.
+	AccessReview(ctx context.Context, in *AuditAccessReviewRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AuditAccessReviewResponse], error)
+}
+
+type auditClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditClient(cc grpc.ClientConnInterface) AuditClient {
+	return &auditClient{cc}
+}
+
+func (c *auditClient) List(ctx context.Context, in *AuditListRequest, opts ...grpc.CallOption) (*AuditListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditListResponse)
+	err := c.cc.Invoke(ctx, Audit_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditClient) AccessReview(ctx context.Context, in *AuditAccessReviewRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AuditAccessReviewResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Audit_ServiceDesc.Streams[0], Audit_AccessReview_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AuditAccessReviewRequest, AuditAccessReviewResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Audit_AccessReviewClient = grpc.ServerStreamingClient[AuditAccessReviewResponse]
+
+// AuditServer is the server API for Audit service.
+// All implementations must embed UnimplementedAuditServer
+// for forward compatibility.
+//
+// ** AUDIT SERVICE **
+// Audit service provides methods to query the audit trail of the mutations made through the API.
+type AuditServer interface {
+	// List is a unary RPC to get the audit records of a tenant, newest first.
+	// It requires an AuditListRequest and returns an AuditListResponse.
+	List(context.Context, *AuditListRequest) (*AuditListResponse, error)
+	// AccessReview is a server streaming RPC enumerating who holds which permission on which entity.
+	// It requires an AuditAccessReviewRequest and streams AuditAccessReviewResponse messages.
+	AccessReview(*AuditAccessReviewRequest, grpc.ServerStreamingServer[AuditAccessReviewResponse]) error
+	mustEmbedUnimplementedAuditServer()
+}
+
+// UnimplementedAuditServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuditServer struct{}
+
+func (UnimplementedAuditServer) List(context.Context, *AuditListRequest) (*AuditListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedAuditServer) AccessReview(*AuditAccessReviewRequest, grpc.ServerStreamingServer[AuditAccessReviewResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method AccessReview not implemented")
+}
+func (UnimplementedAuditServer) mustEmbedUnimplementedAuditServer() {}
+func (UnimplementedAuditServer) testEmbeddedByValue()               {}
+
+// UnsafeAuditServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuditServer will
+// result in compilation errors.
+type UnsafeAuditServer interface {
+	mustEmbedUnimplementedAuditServer()
+}
+
+func RegisterAuditServer(s grpc.ServiceRegistrar, srv AuditServer) {
+	// If the following call pancis, it indicates UnimplementedAuditServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Audit_ServiceDesc, srv)
+}
+
+func _Audit_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Audit_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServer).List(ctx, req.(*AuditListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Audit_AccessReview_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AuditAccessReviewRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AuditServer).AccessReview(m, &grpc.GenericServerStream[AuditAccessReviewRequest, AuditAccessReviewResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Audit_AccessReviewServer = grpc.ServerStreamingServer[AuditAccessReviewResponse]
+
+// Audit_ServiceDesc is the grpc.ServiceDesc for Audit service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Audit_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "base.v1.Audit",
+	HandlerType: (*AuditServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _Audit_List_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AccessReview",
+			Handler:       _Audit_AccessReview_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "base/v1/service.proto",
+}
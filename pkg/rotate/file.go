@@ -0,0 +1,96 @@
+package rotate
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// File - Append-only local file rotated by size
+type File struct {
+	mutex sync.Mutex
+
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	file *os.File
+	size int64
+}
+
+// New - Opens the file at the given path for appending. The file is rotated to path.1, path.2, ...
+// once it reaches maxSize megabytes, keeping maxBackups rotated files.
+func New(path string, maxSize, maxBackups int) (*File, error) {
+	if path == "" {
+		return nil, fmt.Errorf("rotated file must have a path")
+	}
+	f := &File{
+		path:       path,
+		maxSize:    int64(maxSize) * 1024 * 1024,
+		maxBackups: maxBackups,
+	}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// open - Opens the file for appending and records its current size
+func (f *File) open() error {
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+// rotate - Shifts the rotated files, dropping the oldest, and starts a new file
+func (f *File) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	if f.maxBackups > 0 {
+		for i := f.maxBackups - 1; i >= 1; i-- {
+			if err := os.Rename(fmt.Sprintf("%s.%d", f.path, i), fmt.Sprintf("%s.%d", f.path, i+1)); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+		if err := os.Rename(f.path, f.path+".1"); err != nil {
+			return err
+		}
+	} else if err := os.Remove(f.path); err != nil {
+		return err
+	}
+	return f.open()
+}
+
+// Write - Appends p, rotating the file first if p would grow it past its maximum size.
+// A single write is never split across files.
+func (f *File) Write(p []byte) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if f.maxSize > 0 && f.size > 0 && f.size+int64(len(p)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+// Close - Closes the file
+func (f *File) Close() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.file.Close()
+}
@@ -0,0 +1,66 @@
+package rotate
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// TestRotate -
+func TestRotate(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "rotate-suite")
+}
+
+var _ = Describe("rotate", func() {
+	Context("File", func() {
+		It("Case 1: appends lines and rotates the file", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "records.jsonl")
+			file, err := New(path, 1, 2)
+			Expect(err).ShouldNot(HaveOccurred())
+			// rotate after every line
+			file.maxSize = 1
+
+			for _, line := range []string{"l1", "l2", "l3", "l4"} {
+				_, err = file.Write([]byte(line + "\n"))
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+			Expect(file.Close()).Should(Succeed())
+
+			read := func(path string) string {
+				content, err := os.ReadFile(path)
+				Expect(err).ShouldNot(HaveOccurred())
+				return strings.TrimSpace(string(content))
+			}
+
+			Expect(read(path)).Should(Equal("l4"))
+			Expect(read(path + ".1")).Should(Equal("l3"))
+			Expect(read(path + ".2")).Should(Equal("l2"))
+			Expect(path + ".3").ShouldNot(BeAnExistingFile())
+		})
+
+		It("Case 2: appends to an existing file", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "records.jsonl")
+			Expect(os.WriteFile(path, []byte("l1\n"), 0o600)).Should(Succeed())
+
+			file, err := New(path, 1, 2)
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = file.Write([]byte("l2\n"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(file.Close()).Should(Succeed())
+
+			content, err := os.ReadFile(path)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(content)).Should(Equal("l1\nl2\n"))
+		})
+
+		It("Case 3: requires a path", func() {
+			_, err := New("", 1, 2)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
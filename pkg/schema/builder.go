@@ -244,6 +244,25 @@ func Call(name string, arguments ...*base.Argument) *base.Child {
 	}
 }
 
+// Count is a function that generates a child definition for a count aggregation
+// that compares the number of subjects of the given relation with the given value.
+func Count(relation string, comparison base.Count_Comparison, value uint32) *base.Child {
+	// Return a new child definition with the leaf type as a count.
+	return &base.Child{
+		Type: &base.Child_Leaf{
+			Leaf: &base.Leaf{
+				Type: &base.Leaf_Count{
+					Count: &base.Count{
+						Relation:   relation,
+						Comparison: comparison,
+						Value:      value,
+					},
+				},
+			},
+		},
+	}
+}
+
 // TupleToUserSet -
 // Returns a pointer to a base.Child struct, containing a Leaf struct with a TupleToUserSet struct,
 // that represents a child computation where the tuple set is passed to the computed user set.
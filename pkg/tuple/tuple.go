@@ -17,6 +17,7 @@ const (
 
 const (
 	ELLIPSIS = "..." // ellipsis string
+	WILDCARD = "*"   // subject id that stands for every subject of a type
 )
 
 const (
@@ -28,6 +29,11 @@ func IsDirectSubject(subject *base.Subject) bool {
 	return subject.GetRelation() == ""
 }
 
+// IsWildcard checks if the given subject stands for every subject of its type
+func IsWildcard(subject *base.Subject) bool {
+	return subject.GetId() == WILDCARD
+}
+
 // NormalizeRelation normalizes the relation, treating ellipsis as an empty string
 func NormalizeRelation(relation string) string {
 	if relation == ELLIPSIS {